@@ -14,6 +14,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -91,6 +92,8 @@ const (
 	ProgramStatus_PROGRAM_STATUS_DRAFT     ProgramStatus = 0
 	ProgramStatus_PROGRAM_STATUS_PUBLISHED ProgramStatus = 1
 	ProgramStatus_PROGRAM_STATUS_ARCHIVED  ProgramStatus = 2
+	ProgramStatus_PROGRAM_STATUS_IN_REVIEW ProgramStatus = 3
+	ProgramStatus_PROGRAM_STATUS_APPROVED  ProgramStatus = 4
 )
 
 // Enum value maps for ProgramStatus.
@@ -99,11 +102,15 @@ var (
 		0: "PROGRAM_STATUS_DRAFT",
 		1: "PROGRAM_STATUS_PUBLISHED",
 		2: "PROGRAM_STATUS_ARCHIVED",
+		3: "PROGRAM_STATUS_IN_REVIEW",
+		4: "PROGRAM_STATUS_APPROVED",
 	}
 	ProgramStatus_value = map[string]int32{
 		"PROGRAM_STATUS_DRAFT":     0,
 		"PROGRAM_STATUS_PUBLISHED": 1,
 		"PROGRAM_STATUS_ARCHIVED":  2,
+		"PROGRAM_STATUS_IN_REVIEW": 3,
+		"PROGRAM_STATUS_APPROVED":  4,
 	}
 )
 
@@ -141,6 +148,8 @@ const (
 	EpisodeStatus_EPISODE_STATUS_PUBLISHED EpisodeStatus = 1
 	EpisodeStatus_EPISODE_STATUS_SCHEDULED EpisodeStatus = 2
 	EpisodeStatus_EPISODE_STATUS_ARCHIVED  EpisodeStatus = 3
+	EpisodeStatus_EPISODE_STATUS_IN_REVIEW EpisodeStatus = 4
+	EpisodeStatus_EPISODE_STATUS_APPROVED  EpisodeStatus = 5
 )
 
 // Enum value maps for EpisodeStatus.
@@ -150,12 +159,16 @@ var (
 		1: "EPISODE_STATUS_PUBLISHED",
 		2: "EPISODE_STATUS_SCHEDULED",
 		3: "EPISODE_STATUS_ARCHIVED",
+		4: "EPISODE_STATUS_IN_REVIEW",
+		5: "EPISODE_STATUS_APPROVED",
 	}
 	EpisodeStatus_value = map[string]int32{
 		"EPISODE_STATUS_DRAFT":     0,
 		"EPISODE_STATUS_PUBLISHED": 1,
 		"EPISODE_STATUS_SCHEDULED": 2,
 		"EPISODE_STATUS_ARCHIVED":  3,
+		"EPISODE_STATUS_IN_REVIEW": 4,
+		"EPISODE_STATUS_APPROVED":  5,
 	}
 )
 
@@ -186,6 +199,227 @@ func (EpisodeStatus) EnumDescriptor() ([]byte, []int) {
 	return file_v1_cms_proto_rawDescGZIP(), []int{2}
 }
 
+type ContentType int32
+
+const (
+	ContentType_CONTENT_TYPE_UNSPECIFIED ContentType = 0
+	ContentType_CONTENT_TYPE_PROGRAM     ContentType = 1
+	ContentType_CONTENT_TYPE_EPISODE     ContentType = 2
+	ContentType_CONTENT_TYPE_CATEGORY    ContentType = 3 // Only used by the trash and translations
+	ContentType_CONTENT_TYPE_PERSON      ContentType = 4 // Only used by translations
+)
+
+// Enum value maps for ContentType.
+var (
+	ContentType_name = map[int32]string{
+		0: "CONTENT_TYPE_UNSPECIFIED",
+		1: "CONTENT_TYPE_PROGRAM",
+		2: "CONTENT_TYPE_EPISODE",
+		3: "CONTENT_TYPE_CATEGORY",
+		4: "CONTENT_TYPE_PERSON",
+	}
+	ContentType_value = map[string]int32{
+		"CONTENT_TYPE_UNSPECIFIED": 0,
+		"CONTENT_TYPE_PROGRAM":     1,
+		"CONTENT_TYPE_EPISODE":     2,
+		"CONTENT_TYPE_CATEGORY":    3,
+		"CONTENT_TYPE_PERSON":      4,
+	}
+)
+
+func (x ContentType) Enum() *ContentType {
+	p := new(ContentType)
+	*p = x
+	return p
+}
+
+func (x ContentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_cms_proto_enumTypes[3].Descriptor()
+}
+
+func (ContentType) Type() protoreflect.EnumType {
+	return &file_v1_cms_proto_enumTypes[3]
+}
+
+func (x ContentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentType.Descriptor instead.
+func (ContentType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{3}
+}
+
+type TranscriptFormat int32
+
+const (
+	TranscriptFormat_TRANSCRIPT_FORMAT_UNSPECIFIED TranscriptFormat = 0
+	TranscriptFormat_TRANSCRIPT_FORMAT_WEBVTT      TranscriptFormat = 1
+	TranscriptFormat_TRANSCRIPT_FORMAT_SRT         TranscriptFormat = 2
+	TranscriptFormat_TRANSCRIPT_FORMAT_TEXT        TranscriptFormat = 3 // Untimed, so it cannot be converted to the other formats
+	TranscriptFormat_TRANSCRIPT_FORMAT_JSON        TranscriptFormat = 4 // Podcasting 2.0 JSON transcript
+)
+
+// Enum value maps for TranscriptFormat.
+var (
+	TranscriptFormat_name = map[int32]string{
+		0: "TRANSCRIPT_FORMAT_UNSPECIFIED",
+		1: "TRANSCRIPT_FORMAT_WEBVTT",
+		2: "TRANSCRIPT_FORMAT_SRT",
+		3: "TRANSCRIPT_FORMAT_TEXT",
+		4: "TRANSCRIPT_FORMAT_JSON",
+	}
+	TranscriptFormat_value = map[string]int32{
+		"TRANSCRIPT_FORMAT_UNSPECIFIED": 0,
+		"TRANSCRIPT_FORMAT_WEBVTT":      1,
+		"TRANSCRIPT_FORMAT_SRT":         2,
+		"TRANSCRIPT_FORMAT_TEXT":        3,
+		"TRANSCRIPT_FORMAT_JSON":        4,
+	}
+)
+
+func (x TranscriptFormat) Enum() *TranscriptFormat {
+	p := new(TranscriptFormat)
+	*p = x
+	return p
+}
+
+func (x TranscriptFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TranscriptFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_cms_proto_enumTypes[4].Descriptor()
+}
+
+func (TranscriptFormat) Type() protoreflect.EnumType {
+	return &file_v1_cms_proto_enumTypes[4]
+}
+
+func (x TranscriptFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TranscriptFormat.Descriptor instead.
+func (TranscriptFormat) EnumDescriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{4}
+}
+
+type CreditRole int32
+
+const (
+	CreditRole_CREDIT_ROLE_UNSPECIFIED CreditRole = 0
+	CreditRole_CREDIT_ROLE_HOST        CreditRole = 1
+	CreditRole_CREDIT_ROLE_GUEST       CreditRole = 2
+	CreditRole_CREDIT_ROLE_PRODUCER    CreditRole = 3
+)
+
+// Enum value maps for CreditRole.
+var (
+	CreditRole_name = map[int32]string{
+		0: "CREDIT_ROLE_UNSPECIFIED",
+		1: "CREDIT_ROLE_HOST",
+		2: "CREDIT_ROLE_GUEST",
+		3: "CREDIT_ROLE_PRODUCER",
+	}
+	CreditRole_value = map[string]int32{
+		"CREDIT_ROLE_UNSPECIFIED": 0,
+		"CREDIT_ROLE_HOST":        1,
+		"CREDIT_ROLE_GUEST":       2,
+		"CREDIT_ROLE_PRODUCER":    3,
+	}
+)
+
+func (x CreditRole) Enum() *CreditRole {
+	p := new(CreditRole)
+	*p = x
+	return p
+}
+
+func (x CreditRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreditRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_cms_proto_enumTypes[5].Descriptor()
+}
+
+func (CreditRole) Type() protoreflect.EnumType {
+	return &file_v1_cms_proto_enumTypes[5]
+}
+
+func (x CreditRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreditRole.Descriptor instead.
+func (CreditRole) EnumDescriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{5}
+}
+
+// Why listeners cannot see a program or episode
+type UnavailableReason int32
+
+const (
+	UnavailableReason_UNAVAILABLE_REASON_UNSPECIFIED         UnavailableReason = 0
+	UnavailableReason_UNAVAILABLE_REASON_NOT_PUBLISHED       UnavailableReason = 1
+	UnavailableReason_UNAVAILABLE_REASON_NOT_STARTED         UnavailableReason = 2 // Before start_at
+	UnavailableReason_UNAVAILABLE_REASON_ENDED               UnavailableReason = 3 // At or after end_at
+	UnavailableReason_UNAVAILABLE_REASON_COUNTRY_NOT_ALLOWED UnavailableReason = 4 // Also when the country of the listener is unknown
+	UnavailableReason_UNAVAILABLE_REASON_COUNTRY_BLOCKED     UnavailableReason = 5
+)
+
+// Enum value maps for UnavailableReason.
+var (
+	UnavailableReason_name = map[int32]string{
+		0: "UNAVAILABLE_REASON_UNSPECIFIED",
+		1: "UNAVAILABLE_REASON_NOT_PUBLISHED",
+		2: "UNAVAILABLE_REASON_NOT_STARTED",
+		3: "UNAVAILABLE_REASON_ENDED",
+		4: "UNAVAILABLE_REASON_COUNTRY_NOT_ALLOWED",
+		5: "UNAVAILABLE_REASON_COUNTRY_BLOCKED",
+	}
+	UnavailableReason_value = map[string]int32{
+		"UNAVAILABLE_REASON_UNSPECIFIED":         0,
+		"UNAVAILABLE_REASON_NOT_PUBLISHED":       1,
+		"UNAVAILABLE_REASON_NOT_STARTED":         2,
+		"UNAVAILABLE_REASON_ENDED":               3,
+		"UNAVAILABLE_REASON_COUNTRY_NOT_ALLOWED": 4,
+		"UNAVAILABLE_REASON_COUNTRY_BLOCKED":     5,
+	}
+)
+
+func (x UnavailableReason) Enum() *UnavailableReason {
+	p := new(UnavailableReason)
+	*p = x
+	return p
+}
+
+func (x UnavailableReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnavailableReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_cms_proto_enumTypes[6].Descriptor()
+}
+
+func (UnavailableReason) Type() protoreflect.EnumType {
+	return &file_v1_cms_proto_enumTypes[6]
+}
+
+func (x UnavailableReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnavailableReason.Descriptor instead.
+func (UnavailableReason) EnumDescriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{6}
+}
+
 type ImportStatus int32
 
 const (
@@ -222,11 +456,11 @@ func (x ImportStatus) String() string {
 }
 
 func (ImportStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_cms_proto_enumTypes[3].Descriptor()
+	return file_v1_cms_proto_enumTypes[7].Descriptor()
 }
 
 func (ImportStatus) Type() protoreflect.EnumType {
-	return &file_v1_cms_proto_enumTypes[3]
+	return &file_v1_cms_proto_enumTypes[7]
 }
 
 func (x ImportStatus) Number() protoreflect.EnumNumber {
@@ -235,7 +469,56 @@ func (x ImportStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ImportStatus.Descriptor instead.
 func (ImportStatus) EnumDescriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{3}
+	return file_v1_cms_proto_rawDescGZIP(), []int{7}
+}
+
+type ImportEventType int32
+
+const (
+	ImportEventType_IMPORT_EVENT_TYPE_PROGRESS ImportEventType = 0
+	ImportEventType_IMPORT_EVENT_TYPE_WARNING  ImportEventType = 1
+	ImportEventType_IMPORT_EVENT_TYPE_ERROR    ImportEventType = 2
+)
+
+// Enum value maps for ImportEventType.
+var (
+	ImportEventType_name = map[int32]string{
+		0: "IMPORT_EVENT_TYPE_PROGRESS",
+		1: "IMPORT_EVENT_TYPE_WARNING",
+		2: "IMPORT_EVENT_TYPE_ERROR",
+	}
+	ImportEventType_value = map[string]int32{
+		"IMPORT_EVENT_TYPE_PROGRESS": 0,
+		"IMPORT_EVENT_TYPE_WARNING":  1,
+		"IMPORT_EVENT_TYPE_ERROR":    2,
+	}
+)
+
+func (x ImportEventType) Enum() *ImportEventType {
+	p := new(ImportEventType)
+	*p = x
+	return p
+}
+
+func (x ImportEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_cms_proto_enumTypes[8].Descriptor()
+}
+
+func (ImportEventType) Type() protoreflect.EnumType {
+	return &file_v1_cms_proto_enumTypes[8]
+}
+
+func (x ImportEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportEventType.Descriptor instead.
+func (ImportEventType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{8}
 }
 
 type Category struct {
@@ -248,6 +531,7 @@ type Category struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,proto3" json:"created_by,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ParentId      string                 `protobuf:"bytes,9,opt,name=parent_id,proto3" json:"parent_id,omitempty"` // Empty for top-level categories
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -338,28 +622,38 @@ func (x *Category) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *Category) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
 type Program struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,4,opt,name=category_id,proto3" json:"category_id,omitempty"`
-	Status        ProgramStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=thmanyah.v1.ProgramStatus" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
-	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=published_at,proto3" json:"published_at,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,proto3" json:"created_by,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,10,opt,name=updated_by,proto3" json:"updated_by,omitempty"`
-	ThumbnailUrl  string                 `protobuf:"bytes,11,opt,name=thumbnail_url,proto3" json:"thumbnail_url,omitempty"`
-	Tags          []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	SourceUrl     *string                `protobuf:"bytes,14,opt,name=source_url,proto3,oneof" json:"source_url,omitempty"` // Original source URL
-	EpisodesCount int32                  `protobuf:"varint,15,opt,name=episodes_count,proto3" json:"episodes_count,omitempty"`
-	IsFeatured    bool                   `protobuf:"varint,16,opt,name=is_featured,proto3" json:"is_featured,omitempty"`
-	ViewCount     int32                  `protobuf:"varint,17,opt,name=view_count,proto3" json:"view_count,omitempty"`
-	Rating        float64                `protobuf:"fixed64,18,opt,name=rating,proto3" json:"rating,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CategoryId         string                 `protobuf:"bytes,4,opt,name=category_id,proto3" json:"category_id,omitempty"`
+	Status             ProgramStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=thmanyah.v1.ProgramStatus" json:"status,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	PublishedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=published_at,proto3" json:"published_at,omitempty"`
+	CreatedBy          string                 `protobuf:"bytes,9,opt,name=created_by,proto3" json:"created_by,omitempty"`
+	UpdatedBy          string                 `protobuf:"bytes,10,opt,name=updated_by,proto3" json:"updated_by,omitempty"`
+	ThumbnailUrl       string                 `protobuf:"bytes,11,opt,name=thumbnail_url,proto3" json:"thumbnail_url,omitempty"`
+	Tags               []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata           map[string]string      `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SourceUrl          *string                `protobuf:"bytes,14,opt,name=source_url,proto3,oneof" json:"source_url,omitempty"` // Original source URL
+	EpisodesCount      int32                  `protobuf:"varint,15,opt,name=episodes_count,proto3" json:"episodes_count,omitempty"`
+	IsFeatured         bool                   `protobuf:"varint,16,opt,name=is_featured,proto3" json:"is_featured,omitempty"`
+	ViewCount          int32                  `protobuf:"varint,17,opt,name=view_count,proto3" json:"view_count,omitempty"`
+	Rating             float64                `protobuf:"fixed64,18,opt,name=rating,proto3" json:"rating,omitempty"`
+	CategoryIds        []string               `protobuf:"bytes,19,rep,name=category_ids,proto3" json:"category_ids,omitempty"` // Every category the program is in, category_id first
+	Availability       *Availability          `protobuf:"bytes,20,opt,name=availability,proto3" json:"availability,omitempty"`
+	UnavailableReasons []UnavailableReason    `protobuf:"varint,21,rep,packed,name=unavailable_reasons,proto3,enum=thmanyah.v1.UnavailableReason" json:"unavailable_reasons,omitempty"` // Why no listener can see it at the time of the reply, whatever their country
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Program) Reset() {
@@ -518,30 +812,55 @@ func (x *Program) GetRating() float64 {
 	return 0
 }
 
+func (x *Program) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *Program) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *Program) GetUnavailableReasons() []UnavailableReason {
+	if x != nil {
+		return x.UnavailableReasons
+	}
+	return nil
+}
+
 type Episode struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProgramId       string                 `protobuf:"bytes,2,opt,name=program_id,proto3" json:"program_id,omitempty"`
-	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	DurationSeconds int32                  `protobuf:"varint,5,opt,name=duration_seconds,proto3" json:"duration_seconds,omitempty"`
-	EpisodeNumber   int32                  `protobuf:"varint,6,opt,name=episode_number,proto3" json:"episode_number,omitempty"`
-	SeasonNumber    int32                  `protobuf:"varint,7,opt,name=season_number,proto3" json:"season_number,omitempty"`
-	Status          EpisodeStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=thmanyah.v1.EpisodeStatus" json:"status,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
-	PublishedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=published_at,proto3" json:"published_at,omitempty"`
-	ScheduledAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=scheduled_at,proto3" json:"scheduled_at,omitempty"`
-	CreatedBy       string                 `protobuf:"bytes,13,opt,name=created_by,proto3" json:"created_by,omitempty"`
-	UpdatedBy       string                 `protobuf:"bytes,14,opt,name=updated_by,proto3" json:"updated_by,omitempty"`
-	MediaUrl        string                 `protobuf:"bytes,15,opt,name=media_url,proto3" json:"media_url,omitempty"`
-	ThumbnailUrl    string                 `protobuf:"bytes,16,opt,name=thumbnail_url,proto3" json:"thumbnail_url,omitempty"`
-	Tags            []string               `protobuf:"bytes,17,rep,name=tags,proto3" json:"tags,omitempty"`
-	Metadata        map[string]string      `protobuf:"bytes,18,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ViewCount       int32                  `protobuf:"varint,19,opt,name=view_count,proto3" json:"view_count,omitempty"`
-	Rating          float64                `protobuf:"fixed64,20,opt,name=rating,proto3" json:"rating,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProgramId          string                 `protobuf:"bytes,2,opt,name=program_id,proto3" json:"program_id,omitempty"`
+	Title              string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	DurationSeconds    int32                  `protobuf:"varint,5,opt,name=duration_seconds,proto3" json:"duration_seconds,omitempty"`
+	EpisodeNumber      int32                  `protobuf:"varint,6,opt,name=episode_number,proto3" json:"episode_number,omitempty"`
+	SeasonNumber       int32                  `protobuf:"varint,7,opt,name=season_number,proto3" json:"season_number,omitempty"`
+	Status             EpisodeStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=thmanyah.v1.EpisodeStatus" json:"status,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	PublishedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=published_at,proto3" json:"published_at,omitempty"`
+	ScheduledAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=scheduled_at,proto3" json:"scheduled_at,omitempty"`
+	CreatedBy          string                 `protobuf:"bytes,13,opt,name=created_by,proto3" json:"created_by,omitempty"`
+	UpdatedBy          string                 `protobuf:"bytes,14,opt,name=updated_by,proto3" json:"updated_by,omitempty"`
+	MediaUrl           string                 `protobuf:"bytes,15,opt,name=media_url,proto3" json:"media_url,omitempty"`
+	ThumbnailUrl       string                 `protobuf:"bytes,16,opt,name=thumbnail_url,proto3" json:"thumbnail_url,omitempty"`
+	Tags               []string               `protobuf:"bytes,17,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata           map[string]string      `protobuf:"bytes,18,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ViewCount          int32                  `protobuf:"varint,19,opt,name=view_count,proto3" json:"view_count,omitempty"`
+	Rating             float64                `protobuf:"fixed64,20,opt,name=rating,proto3" json:"rating,omitempty"`
+	SeasonId           string                 `protobuf:"bytes,21,opt,name=season_id,proto3" json:"season_id,omitempty"`                                                                // The season with season_number
+	Chapters           []*Chapter             `protobuf:"bytes,22,rep,name=chapters,proto3" json:"chapters,omitempty"`                                                                  // Discover only, by start
+	Availability       *Availability          `protobuf:"bytes,23,opt,name=availability,proto3" json:"availability,omitempty"`                                                          // The rules of the program apply too
+	UnavailableReasons []UnavailableReason    `protobuf:"varint,24,rep,packed,name=unavailable_reasons,proto3,enum=thmanyah.v1.UnavailableReason" json:"unavailable_reasons,omitempty"` // Why no listener can see it at the time of the reply, whatever their country
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Episode) Reset() {
@@ -714,6 +1033,104 @@ func (x *Episode) GetRating() float64 {
 	return 0
 }
 
+func (x *Episode) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *Episode) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+func (x *Episode) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *Episode) GetUnavailableReasons() []UnavailableReason {
+	if x != nil {
+		return x.UnavailableReasons
+	}
+	return nil
+}
+
+// When and where listeners can see a program or episode. Countries are ISO 3166-1
+// alpha-2 codes. Without allowed_countries, every country but the blocked ones is.
+type Availability struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StartAt          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_at,proto3" json:"start_at,omitempty"` // Unset: already available
+	EndAt            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_at,proto3" json:"end_at,omitempty"`     // Unset: available for good
+	AllowedCountries []string               `protobuf:"bytes,3,rep,name=allowed_countries,proto3" json:"allowed_countries,omitempty"`
+	BlockedCountries []string               `protobuf:"bytes,4,rep,name=blocked_countries,proto3" json:"blocked_countries,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Availability) Reset() {
+	*x = Availability{}
+	mi := &file_v1_cms_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Availability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Availability) ProtoMessage() {}
+
+func (x *Availability) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Availability.ProtoReflect.Descriptor instead.
+func (*Availability) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Availability) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *Availability) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *Availability) GetAllowedCountries() []string {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+func (x *Availability) GetBlockedCountries() []string {
+	if x != nil {
+		return x.BlockedCountries
+	}
+	return nil
+}
+
 type CreateProgramRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -724,13 +1141,15 @@ type CreateProgramRequest struct {
 	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	SourceUrl     string                 `protobuf:"bytes,7,opt,name=source_url,proto3" json:"source_url,omitempty"`
 	IsFeatured    bool                   `protobuf:"varint,8,opt,name=is_featured,proto3" json:"is_featured,omitempty"`
+	CategoryIds   []string               `protobuf:"bytes,9,rep,name=category_ids,proto3" json:"category_ids,omitempty"` // Further categories besides category_id
+	Availability  *Availability          `protobuf:"bytes,10,opt,name=availability,proto3" json:"availability,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateProgramRequest) Reset() {
 	*x = CreateProgramRequest{}
-	mi := &file_v1_cms_proto_msgTypes[3]
+	mi := &file_v1_cms_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -742,7 +1161,7 @@ func (x *CreateProgramRequest) String() string {
 func (*CreateProgramRequest) ProtoMessage() {}
 
 func (x *CreateProgramRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[3]
+	mi := &file_v1_cms_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +1174,7 @@ func (x *CreateProgramRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProgramRequest.ProtoReflect.Descriptor instead.
 func (*CreateProgramRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{3}
+	return file_v1_cms_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateProgramRequest) GetTitle() string {
@@ -814,6 +1233,20 @@ func (x *CreateProgramRequest) GetIsFeatured() bool {
 	return false
 }
 
+func (x *CreateProgramRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *CreateProgramRequest) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
 type CreateProgramResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Program       *Program               `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
@@ -823,7 +1256,7 @@ type CreateProgramResponse struct {
 
 func (x *CreateProgramResponse) Reset() {
 	*x = CreateProgramResponse{}
-	mi := &file_v1_cms_proto_msgTypes[4]
+	mi := &file_v1_cms_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -835,7 +1268,7 @@ func (x *CreateProgramResponse) String() string {
 func (*CreateProgramResponse) ProtoMessage() {}
 
 func (x *CreateProgramResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[4]
+	mi := &file_v1_cms_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +1281,7 @@ func (x *CreateProgramResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProgramResponse.ProtoReflect.Descriptor instead.
 func (*CreateProgramResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{4}
+	return file_v1_cms_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateProgramResponse) GetProgram() *Program {
@@ -870,13 +1303,14 @@ type UpdateProgramRequest struct {
 	Metadata      map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	SourceUrl     *string                `protobuf:"bytes,9,opt,name=source_url,proto3,oneof" json:"source_url,omitempty"`
 	IsFeatured    *bool                  `protobuf:"varint,10,opt,name=is_featured,proto3,oneof" json:"is_featured,omitempty"`
+	Availability  *Availability          `protobuf:"bytes,11,opt,name=availability,proto3" json:"availability,omitempty"` // Replaces the rules when set; an empty one lifts them
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProgramRequest) Reset() {
 	*x = UpdateProgramRequest{}
-	mi := &file_v1_cms_proto_msgTypes[5]
+	mi := &file_v1_cms_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -888,7 +1322,7 @@ func (x *UpdateProgramRequest) String() string {
 func (*UpdateProgramRequest) ProtoMessage() {}
 
 func (x *UpdateProgramRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[5]
+	mi := &file_v1_cms_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,7 +1335,7 @@ func (x *UpdateProgramRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProgramRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProgramRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{5}
+	return file_v1_cms_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateProgramRequest) GetProgramId() string {
@@ -974,7 +1408,14 @@ func (x *UpdateProgramRequest) GetIsFeatured() bool {
 	return false
 }
 
-type UpdateProgramResponse struct {
+func (x *UpdateProgramRequest) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+type UpdateProgramResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Program       *Program               `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -983,7 +1424,7 @@ type UpdateProgramResponse struct {
 
 func (x *UpdateProgramResponse) Reset() {
 	*x = UpdateProgramResponse{}
-	mi := &file_v1_cms_proto_msgTypes[6]
+	mi := &file_v1_cms_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -995,7 +1436,7 @@ func (x *UpdateProgramResponse) String() string {
 func (*UpdateProgramResponse) ProtoMessage() {}
 
 func (x *UpdateProgramResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[6]
+	mi := &file_v1_cms_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1008,7 +1449,7 @@ func (x *UpdateProgramResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProgramResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProgramResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{6}
+	return file_v1_cms_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateProgramResponse) GetProgram() *Program {
@@ -1027,7 +1468,7 @@ type DeleteProgramRequest struct {
 
 func (x *DeleteProgramRequest) Reset() {
 	*x = DeleteProgramRequest{}
-	mi := &file_v1_cms_proto_msgTypes[7]
+	mi := &file_v1_cms_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1039,7 +1480,7 @@ func (x *DeleteProgramRequest) String() string {
 func (*DeleteProgramRequest) ProtoMessage() {}
 
 func (x *DeleteProgramRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[7]
+	mi := &file_v1_cms_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,7 +1493,7 @@ func (x *DeleteProgramRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProgramRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProgramRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{7}
+	return file_v1_cms_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteProgramRequest) GetProgramId() string {
@@ -1071,7 +1512,7 @@ type GetProgramRequest struct {
 
 func (x *GetProgramRequest) Reset() {
 	*x = GetProgramRequest{}
-	mi := &file_v1_cms_proto_msgTypes[8]
+	mi := &file_v1_cms_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1083,7 +1524,7 @@ func (x *GetProgramRequest) String() string {
 func (*GetProgramRequest) ProtoMessage() {}
 
 func (x *GetProgramRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[8]
+	mi := &file_v1_cms_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,7 +1537,7 @@ func (x *GetProgramRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProgramRequest.ProtoReflect.Descriptor instead.
 func (*GetProgramRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{8}
+	return file_v1_cms_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetProgramRequest) GetProgramId() string {
@@ -1115,7 +1556,7 @@ type GetProgramResponse struct {
 
 func (x *GetProgramResponse) Reset() {
 	*x = GetProgramResponse{}
-	mi := &file_v1_cms_proto_msgTypes[9]
+	mi := &file_v1_cms_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1127,7 +1568,7 @@ func (x *GetProgramResponse) String() string {
 func (*GetProgramResponse) ProtoMessage() {}
 
 func (x *GetProgramResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[9]
+	mi := &file_v1_cms_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,7 +1581,7 @@ func (x *GetProgramResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProgramResponse.ProtoReflect.Descriptor instead.
 func (*GetProgramResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{9}
+	return file_v1_cms_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetProgramResponse) GetProgram() *Program {
@@ -1167,7 +1608,7 @@ type ListProgramsRequest struct {
 
 func (x *ListProgramsRequest) Reset() {
 	*x = ListProgramsRequest{}
-	mi := &file_v1_cms_proto_msgTypes[10]
+	mi := &file_v1_cms_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1179,7 +1620,7 @@ func (x *ListProgramsRequest) String() string {
 func (*ListProgramsRequest) ProtoMessage() {}
 
 func (x *ListProgramsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[10]
+	mi := &file_v1_cms_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1633,7 @@ func (x *ListProgramsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProgramsRequest.ProtoReflect.Descriptor instead.
 func (*ListProgramsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{10}
+	return file_v1_cms_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListProgramsRequest) GetPage() int32 {
@@ -1270,7 +1711,7 @@ type ListProgramsResponse struct {
 
 func (x *ListProgramsResponse) Reset() {
 	*x = ListProgramsResponse{}
-	mi := &file_v1_cms_proto_msgTypes[11]
+	mi := &file_v1_cms_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1282,7 +1723,7 @@ func (x *ListProgramsResponse) String() string {
 func (*ListProgramsResponse) ProtoMessage() {}
 
 func (x *ListProgramsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[11]
+	mi := &file_v1_cms_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,7 +1736,7 @@ func (x *ListProgramsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProgramsResponse.ProtoReflect.Descriptor instead.
 func (*ListProgramsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{11}
+	return file_v1_cms_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListProgramsResponse) GetPrograms() []*Program {
@@ -1326,19 +1767,124 @@ func (x *ListProgramsResponse) GetPageSize() int32 {
 	return 0
 }
 
+type BatchGetProgramsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramIds    []string               `protobuf:"bytes,1,rep,name=program_ids,proto3" json:"program_ids,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"` // Discover only: overrides Accept-Language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProgramsRequest) Reset() {
+	*x = BatchGetProgramsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProgramsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProgramsRequest) ProtoMessage() {}
+
+func (x *BatchGetProgramsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProgramsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProgramsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchGetProgramsRequest) GetProgramIds() []string {
+	if x != nil {
+		return x.ProgramIds
+	}
+	return nil
+}
+
+func (x *BatchGetProgramsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type BatchGetProgramsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Programs      []*Program             `protobuf:"bytes,1,rep,name=programs,proto3" json:"programs,omitempty"` // In request order
+	NotFoundIds   []string               `protobuf:"bytes,2,rep,name=not_found_ids,proto3" json:"not_found_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProgramsResponse) Reset() {
+	*x = BatchGetProgramsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProgramsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProgramsResponse) ProtoMessage() {}
+
+func (x *BatchGetProgramsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProgramsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetProgramsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchGetProgramsResponse) GetPrograms() []*Program {
+	if x != nil {
+		return x.Programs
+	}
+	return nil
+}
+
+func (x *BatchGetProgramsResponse) GetNotFoundIds() []string {
+	if x != nil {
+		return x.NotFoundIds
+	}
+	return nil
+}
+
 type CreateCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Type          CategoryType           `protobuf:"varint,3,opt,name=type,proto3,enum=thmanyah.v1.CategoryType" json:"type,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ParentId      string                 `protobuf:"bytes,5,opt,name=parent_id,proto3" json:"parent_id,omitempty"` // Empty for a top-level category
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateCategoryRequest) Reset() {
 	*x = CreateCategoryRequest{}
-	mi := &file_v1_cms_proto_msgTypes[12]
+	mi := &file_v1_cms_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1350,7 +1896,7 @@ func (x *CreateCategoryRequest) String() string {
 func (*CreateCategoryRequest) ProtoMessage() {}
 
 func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[12]
+	mi := &file_v1_cms_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,7 +1909,7 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
 func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{12}
+	return file_v1_cms_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateCategoryRequest) GetName() string {
@@ -1394,6 +1940,13 @@ func (x *CreateCategoryRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
 type CreateCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -1403,7 +1956,7 @@ type CreateCategoryResponse struct {
 
 func (x *CreateCategoryResponse) Reset() {
 	*x = CreateCategoryResponse{}
-	mi := &file_v1_cms_proto_msgTypes[13]
+	mi := &file_v1_cms_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1415,7 +1968,7 @@ func (x *CreateCategoryResponse) String() string {
 func (*CreateCategoryResponse) ProtoMessage() {}
 
 func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[13]
+	mi := &file_v1_cms_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,7 +1981,7 @@ func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
 func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{13}
+	return file_v1_cms_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateCategoryResponse) GetCategory() *Category {
@@ -1451,7 +2004,7 @@ type UpdateCategoryRequest struct {
 
 func (x *UpdateCategoryRequest) Reset() {
 	*x = UpdateCategoryRequest{}
-	mi := &file_v1_cms_proto_msgTypes[14]
+	mi := &file_v1_cms_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1463,7 +2016,7 @@ func (x *UpdateCategoryRequest) String() string {
 func (*UpdateCategoryRequest) ProtoMessage() {}
 
 func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[14]
+	mi := &file_v1_cms_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1476,7 +2029,7 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{14}
+	return file_v1_cms_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdateCategoryRequest) GetCategoryId() string {
@@ -1523,7 +2076,7 @@ type UpdateCategoryResponse struct {
 
 func (x *UpdateCategoryResponse) Reset() {
 	*x = UpdateCategoryResponse{}
-	mi := &file_v1_cms_proto_msgTypes[15]
+	mi := &file_v1_cms_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1535,7 +2088,7 @@ func (x *UpdateCategoryResponse) String() string {
 func (*UpdateCategoryResponse) ProtoMessage() {}
 
 func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[15]
+	mi := &file_v1_cms_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1548,7 +2101,7 @@ func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{15}
+	return file_v1_cms_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateCategoryResponse) GetCategory() *Category {
@@ -1567,7 +2120,7 @@ type DeleteCategoryRequest struct {
 
 func (x *DeleteCategoryRequest) Reset() {
 	*x = DeleteCategoryRequest{}
-	mi := &file_v1_cms_proto_msgTypes[16]
+	mi := &file_v1_cms_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1579,7 +2132,7 @@ func (x *DeleteCategoryRequest) String() string {
 func (*DeleteCategoryRequest) ProtoMessage() {}
 
 func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[16]
+	mi := &file_v1_cms_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,7 +2145,7 @@ func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{16}
+	return file_v1_cms_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteCategoryRequest) GetCategoryId() string {
@@ -1611,7 +2164,7 @@ type GetCategoryRequest struct {
 
 func (x *GetCategoryRequest) Reset() {
 	*x = GetCategoryRequest{}
-	mi := &file_v1_cms_proto_msgTypes[17]
+	mi := &file_v1_cms_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1623,7 +2176,7 @@ func (x *GetCategoryRequest) String() string {
 func (*GetCategoryRequest) ProtoMessage() {}
 
 func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[17]
+	mi := &file_v1_cms_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1636,7 +2189,7 @@ func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
 func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{17}
+	return file_v1_cms_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetCategoryRequest) GetCategoryId() string {
@@ -1655,7 +2208,7 @@ type GetCategoryResponse struct {
 
 func (x *GetCategoryResponse) Reset() {
 	*x = GetCategoryResponse{}
-	mi := &file_v1_cms_proto_msgTypes[18]
+	mi := &file_v1_cms_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1667,7 +2220,7 @@ func (x *GetCategoryResponse) String() string {
 func (*GetCategoryResponse) ProtoMessage() {}
 
 func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[18]
+	mi := &file_v1_cms_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1680,7 +2233,7 @@ func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
 func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{18}
+	return file_v1_cms_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetCategoryResponse) GetCategory() *Category {
@@ -1703,7 +2256,7 @@ type ListCategoriesRequest struct {
 
 func (x *ListCategoriesRequest) Reset() {
 	*x = ListCategoriesRequest{}
-	mi := &file_v1_cms_proto_msgTypes[19]
+	mi := &file_v1_cms_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1715,7 +2268,7 @@ func (x *ListCategoriesRequest) String() string {
 func (*ListCategoriesRequest) ProtoMessage() {}
 
 func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[19]
+	mi := &file_v1_cms_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1728,7 +2281,7 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
 func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{19}
+	return file_v1_cms_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListCategoriesRequest) GetPage() int32 {
@@ -1778,7 +2331,7 @@ type ListCategoriesResponse struct {
 
 func (x *ListCategoriesResponse) Reset() {
 	*x = ListCategoriesResponse{}
-	mi := &file_v1_cms_proto_msgTypes[20]
+	mi := &file_v1_cms_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1790,7 +2343,7 @@ func (x *ListCategoriesResponse) String() string {
 func (*ListCategoriesResponse) ProtoMessage() {}
 
 func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[20]
+	mi := &file_v1_cms_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1803,7 +2356,7 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{20}
+	return file_v1_cms_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListCategoriesResponse) GetCategories() []*Category {
@@ -1834,37 +2387,29 @@ func (x *ListCategoriesResponse) GetPageSize() int32 {
 	return 0
 }
 
-type CreateEpisodeRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ProgramId       string                 `protobuf:"bytes,1,opt,name=program_id,proto3" json:"program_id,omitempty"`
-	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	DurationSeconds int32                  `protobuf:"varint,4,opt,name=duration_seconds,proto3" json:"duration_seconds,omitempty"`
-	EpisodeNumber   int32                  `protobuf:"varint,5,opt,name=episode_number,proto3" json:"episode_number,omitempty"`
-	SeasonNumber    int32                  `protobuf:"varint,6,opt,name=season_number,proto3" json:"season_number,omitempty"`
-	MediaUrl        string                 `protobuf:"bytes,7,opt,name=media_url,proto3" json:"media_url,omitempty"`
-	ThumbnailUrl    string                 `protobuf:"bytes,8,opt,name=thumbnail_url,proto3" json:"thumbnail_url,omitempty"`
-	Tags            []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	Metadata        map[string]string      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type BatchGetCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryIds   []string               `protobuf:"bytes,1,rep,name=category_ids,proto3" json:"category_ids,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"` // Discover only: overrides Accept-Language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEpisodeRequest) Reset() {
-	*x = CreateEpisodeRequest{}
-	mi := &file_v1_cms_proto_msgTypes[21]
+func (x *BatchGetCategoriesRequest) Reset() {
+	*x = BatchGetCategoriesRequest{}
+	mi := &file_v1_cms_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEpisodeRequest) String() string {
+func (x *BatchGetCategoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEpisodeRequest) ProtoMessage() {}
+func (*BatchGetCategoriesRequest) ProtoMessage() {}
 
-func (x *CreateEpisodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[21]
+func (x *BatchGetCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,56 +2420,169 @@ func (x *CreateEpisodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEpisodeRequest.ProtoReflect.Descriptor instead.
-func (*CreateEpisodeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use BatchGetCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateEpisodeRequest) GetProgramId() string {
+func (x *BatchGetCategoriesRequest) GetCategoryIds() []string {
 	if x != nil {
-		return x.ProgramId
+		return x.CategoryIds
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateEpisodeRequest) GetTitle() string {
+func (x *BatchGetCategoriesRequest) GetLocale() string {
 	if x != nil {
-		return x.Title
+		return x.Locale
 	}
 	return ""
 }
 
-func (x *CreateEpisodeRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type BatchGetCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"` // In request order
+	NotFoundIds   []string               `protobuf:"bytes,2,rep,name=not_found_ids,proto3" json:"not_found_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEpisodeRequest) GetDurationSeconds() int32 {
-	if x != nil {
-		return x.DurationSeconds
-	}
-	return 0
+func (x *BatchGetCategoriesResponse) Reset() {
+	*x = BatchGetCategoriesResponse{}
+	mi := &file_v1_cms_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEpisodeRequest) GetEpisodeNumber() int32 {
+func (x *BatchGetCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetCategoriesResponse) ProtoMessage() {}
+
+func (x *BatchGetCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[25]
 	if x != nil {
-		return x.EpisodeNumber
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateEpisodeRequest) GetSeasonNumber() int32 {
+// Deprecated: Use BatchGetCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BatchGetCategoriesResponse) GetCategories() []*Category {
 	if x != nil {
-		return x.SeasonNumber
+		return x.Categories
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateEpisodeRequest) GetMediaUrl() string {
+func (x *BatchGetCategoriesResponse) GetNotFoundIds() []string {
 	if x != nil {
-		return x.MediaUrl
+		return x.NotFoundIds
+	}
+	return nil
+}
+
+type CreateEpisodeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId       string                 `protobuf:"bytes,1,opt,name=program_id,proto3" json:"program_id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,4,opt,name=duration_seconds,proto3" json:"duration_seconds,omitempty"`
+	EpisodeNumber   int32                  `protobuf:"varint,5,opt,name=episode_number,proto3" json:"episode_number,omitempty"`
+	SeasonNumber    int32                  `protobuf:"varint,6,opt,name=season_number,proto3" json:"season_number,omitempty"`
+	MediaUrl        string                 `protobuf:"bytes,7,opt,name=media_url,proto3" json:"media_url,omitempty"`
+	ThumbnailUrl    string                 `protobuf:"bytes,8,opt,name=thumbnail_url,proto3" json:"thumbnail_url,omitempty"`
+	Tags            []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata        map[string]string      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Availability    *Availability          `protobuf:"bytes,11,opt,name=availability,proto3" json:"availability,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateEpisodeRequest) Reset() {
+	*x = CreateEpisodeRequest{}
+	mi := &file_v1_cms_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEpisodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEpisodeRequest) ProtoMessage() {}
+
+func (x *CreateEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateEpisodeRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *CreateEpisodeRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateEpisodeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateEpisodeRequest) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CreateEpisodeRequest) GetEpisodeNumber() int32 {
+	if x != nil {
+		return x.EpisodeNumber
+	}
+	return 0
+}
+
+func (x *CreateEpisodeRequest) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *CreateEpisodeRequest) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
 	}
 	return ""
 }
@@ -1950,6 +2608,13 @@ func (x *CreateEpisodeRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *CreateEpisodeRequest) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
 type CreateEpisodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Episode       *Episode               `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"`
@@ -1959,7 +2624,7 @@ type CreateEpisodeResponse struct {
 
 func (x *CreateEpisodeResponse) Reset() {
 	*x = CreateEpisodeResponse{}
-	mi := &file_v1_cms_proto_msgTypes[22]
+	mi := &file_v1_cms_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1971,7 +2636,7 @@ func (x *CreateEpisodeResponse) String() string {
 func (*CreateEpisodeResponse) ProtoMessage() {}
 
 func (x *CreateEpisodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[22]
+	mi := &file_v1_cms_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1984,7 +2649,7 @@ func (x *CreateEpisodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEpisodeResponse.ProtoReflect.Descriptor instead.
 func (*CreateEpisodeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{22}
+	return file_v1_cms_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CreateEpisodeResponse) GetEpisode() *Episode {
@@ -2008,13 +2673,14 @@ type UpdateEpisodeRequest struct {
 	Tags            []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
 	Metadata        map[string]string      `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ScheduledAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=scheduled_at,proto3,oneof" json:"scheduled_at,omitempty"`
+	Availability    *Availability          `protobuf:"bytes,13,opt,name=availability,proto3" json:"availability,omitempty"` // Replaces the rules when set; an empty one lifts them
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateEpisodeRequest) Reset() {
 	*x = UpdateEpisodeRequest{}
-	mi := &file_v1_cms_proto_msgTypes[23]
+	mi := &file_v1_cms_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2026,7 +2692,7 @@ func (x *UpdateEpisodeRequest) String() string {
 func (*UpdateEpisodeRequest) ProtoMessage() {}
 
 func (x *UpdateEpisodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[23]
+	mi := &file_v1_cms_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2039,7 +2705,7 @@ func (x *UpdateEpisodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEpisodeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEpisodeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{23}
+	return file_v1_cms_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateEpisodeRequest) GetEpisodeId() string {
@@ -2126,6 +2792,13 @@ func (x *UpdateEpisodeRequest) GetScheduledAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UpdateEpisodeRequest) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
 type UpdateEpisodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Episode       *Episode               `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"`
@@ -2135,7 +2808,7 @@ type UpdateEpisodeResponse struct {
 
 func (x *UpdateEpisodeResponse) Reset() {
 	*x = UpdateEpisodeResponse{}
-	mi := &file_v1_cms_proto_msgTypes[24]
+	mi := &file_v1_cms_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2147,7 +2820,7 @@ func (x *UpdateEpisodeResponse) String() string {
 func (*UpdateEpisodeResponse) ProtoMessage() {}
 
 func (x *UpdateEpisodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[24]
+	mi := &file_v1_cms_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2160,7 +2833,7 @@ func (x *UpdateEpisodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEpisodeResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEpisodeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{24}
+	return file_v1_cms_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateEpisodeResponse) GetEpisode() *Episode {
@@ -2170,28 +2843,29 @@ func (x *UpdateEpisodeResponse) GetEpisode() *Episode {
 	return nil
 }
 
-type DeleteEpisodeRequest struct {
+type RescheduleEpisodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scheduled_at,proto3" json:"scheduled_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEpisodeRequest) Reset() {
-	*x = DeleteEpisodeRequest{}
-	mi := &file_v1_cms_proto_msgTypes[25]
+func (x *RescheduleEpisodeRequest) Reset() {
+	*x = RescheduleEpisodeRequest{}
+	mi := &file_v1_cms_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEpisodeRequest) String() string {
+func (x *RescheduleEpisodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEpisodeRequest) ProtoMessage() {}
+func (*RescheduleEpisodeRequest) ProtoMessage() {}
 
-func (x *DeleteEpisodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[25]
+func (x *RescheduleEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2202,40 +2876,91 @@ func (x *DeleteEpisodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEpisodeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEpisodeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use RescheduleEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DeleteEpisodeRequest) GetEpisodeId() string {
+func (x *RescheduleEpisodeRequest) GetEpisodeId() string {
 	if x != nil {
 		return x.EpisodeId
 	}
 	return ""
 }
 
-type GetEpisodeRequest struct {
+func (x *RescheduleEpisodeRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+type RescheduleEpisodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episode       *Episode               `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleEpisodeResponse) Reset() {
+	*x = RescheduleEpisodeResponse{}
+	mi := &file_v1_cms_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleEpisodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleEpisodeResponse) ProtoMessage() {}
+
+func (x *RescheduleEpisodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleEpisodeResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleEpisodeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RescheduleEpisodeResponse) GetEpisode() *Episode {
+	if x != nil {
+		return x.Episode
+	}
+	return nil
+}
+
+type CancelEpisodeScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEpisodeRequest) Reset() {
-	*x = GetEpisodeRequest{}
-	mi := &file_v1_cms_proto_msgTypes[26]
+func (x *CancelEpisodeScheduleRequest) Reset() {
+	*x = CancelEpisodeScheduleRequest{}
+	mi := &file_v1_cms_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEpisodeRequest) String() string {
+func (x *CancelEpisodeScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEpisodeRequest) ProtoMessage() {}
+func (*CancelEpisodeScheduleRequest) ProtoMessage() {}
 
-func (x *GetEpisodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[26]
+func (x *CancelEpisodeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2246,40 +2971,40 @@ func (x *GetEpisodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEpisodeRequest.ProtoReflect.Descriptor instead.
-func (*GetEpisodeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CancelEpisodeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CancelEpisodeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetEpisodeRequest) GetEpisodeId() string {
+func (x *CancelEpisodeScheduleRequest) GetEpisodeId() string {
 	if x != nil {
 		return x.EpisodeId
 	}
 	return ""
 }
 
-type GetEpisodeResponse struct {
+type CancelEpisodeScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Episode       *Episode               `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEpisodeResponse) Reset() {
-	*x = GetEpisodeResponse{}
-	mi := &file_v1_cms_proto_msgTypes[27]
+func (x *CancelEpisodeScheduleResponse) Reset() {
+	*x = CancelEpisodeScheduleResponse{}
+	mi := &file_v1_cms_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEpisodeResponse) String() string {
+func (x *CancelEpisodeScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEpisodeResponse) ProtoMessage() {}
+func (*CancelEpisodeScheduleResponse) ProtoMessage() {}
 
-func (x *GetEpisodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[27]
+func (x *CancelEpisodeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2290,47 +3015,48 @@ func (x *GetEpisodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEpisodeResponse.ProtoReflect.Descriptor instead.
-func (*GetEpisodeResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CancelEpisodeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CancelEpisodeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetEpisodeResponse) GetEpisode() *Episode {
+func (x *CancelEpisodeScheduleResponse) GetEpisode() *Episode {
 	if x != nil {
 		return x.Episode
 	}
 	return nil
 }
 
-type ListEpisodesRequest struct {
+type StatusTransition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProgramId     string                 `protobuf:"bytes,1,opt,name=program_id,proto3" json:"program_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	Status        EpisodeStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=thmanyah.v1.EpisodeStatus" json:"status,omitempty"`
-	SearchQuery   string                 `protobuf:"bytes,5,opt,name=search_query,proto3" json:"search_query,omitempty"`
-	SeasonNumber  int32                  `protobuf:"varint,6,opt,name=season_number,proto3" json:"season_number,omitempty"`
-	SortBy        string                 `protobuf:"bytes,7,opt,name=sort_by,proto3" json:"sort_by,omitempty"`
-	SortOrder     string                 `protobuf:"bytes,8,opt,name=sort_order,proto3" json:"sort_order,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContentType   ContentType            `protobuf:"varint,2,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,3,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // submit, approve, reject, publish, schedule, ...
+	FromStatus    string                 `protobuf:"bytes,5,opt,name=from_status,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,6,opt,name=to_status,proto3" json:"to_status,omitempty"`
+	ActorId       string                 `protobuf:"bytes,7,opt,name=actor_id,proto3" json:"actor_id,omitempty"` // Empty for changes made by the scheduler
+	Comment       string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEpisodesRequest) Reset() {
-	*x = ListEpisodesRequest{}
-	mi := &file_v1_cms_proto_msgTypes[28]
+func (x *StatusTransition) Reset() {
+	*x = StatusTransition{}
+	mi := &file_v1_cms_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEpisodesRequest) String() string {
+func (x *StatusTransition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEpisodesRequest) ProtoMessage() {}
+func (*StatusTransition) ProtoMessage() {}
 
-func (x *ListEpisodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[28]
+func (x *StatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2341,92 +3067,98 @@ func (x *ListEpisodesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEpisodesRequest.ProtoReflect.Descriptor instead.
-func (*ListEpisodesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use StatusTransition.ProtoReflect.Descriptor instead.
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListEpisodesRequest) GetProgramId() string {
+func (x *StatusTransition) GetId() string {
 	if x != nil {
-		return x.ProgramId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListEpisodesRequest) GetPage() int32 {
+func (x *StatusTransition) GetContentType() ContentType {
 	if x != nil {
-		return x.Page
+		return x.ContentType
 	}
-	return 0
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
 }
 
-func (x *ListEpisodesRequest) GetPageSize() int32 {
+func (x *StatusTransition) GetContentId() string {
 	if x != nil {
-		return x.PageSize
+		return x.ContentId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListEpisodesRequest) GetStatus() EpisodeStatus {
+func (x *StatusTransition) GetAction() string {
 	if x != nil {
-		return x.Status
+		return x.Action
 	}
-	return EpisodeStatus_EPISODE_STATUS_DRAFT
+	return ""
 }
 
-func (x *ListEpisodesRequest) GetSearchQuery() string {
+func (x *StatusTransition) GetFromStatus() string {
 	if x != nil {
-		return x.SearchQuery
+		return x.FromStatus
 	}
 	return ""
 }
 
-func (x *ListEpisodesRequest) GetSeasonNumber() int32 {
+func (x *StatusTransition) GetToStatus() string {
 	if x != nil {
-		return x.SeasonNumber
+		return x.ToStatus
 	}
-	return 0
+	return ""
 }
 
-func (x *ListEpisodesRequest) GetSortBy() string {
+func (x *StatusTransition) GetActorId() string {
 	if x != nil {
-		return x.SortBy
+		return x.ActorId
 	}
 	return ""
 }
 
-func (x *ListEpisodesRequest) GetSortOrder() string {
+func (x *StatusTransition) GetComment() string {
 	if x != nil {
-		return x.SortOrder
+		return x.Comment
 	}
 	return ""
 }
 
-type ListEpisodesResponse struct {
+func (x *StatusTransition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SubmitForReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Episodes      []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEpisodesResponse) Reset() {
-	*x = ListEpisodesResponse{}
-	mi := &file_v1_cms_proto_msgTypes[29]
+func (x *SubmitForReviewRequest) Reset() {
+	*x = SubmitForReviewRequest{}
+	mi := &file_v1_cms_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEpisodesResponse) String() string {
+func (x *SubmitForReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEpisodesResponse) ProtoMessage() {}
+func (*SubmitForReviewRequest) ProtoMessage() {}
 
-func (x *ListEpisodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[29]
+func (x *SubmitForReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2437,66 +3169,56 @@ func (x *ListEpisodesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEpisodesResponse.ProtoReflect.Descriptor instead.
-func (*ListEpisodesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *ListEpisodesResponse) GetEpisodes() []*Episode {
-	if x != nil {
-		return x.Episodes
-	}
-	return nil
+// Deprecated: Use SubmitForReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitForReviewRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListEpisodesResponse) GetTotalCount() int32 {
+func (x *SubmitForReviewRequest) GetContentType() ContentType {
 	if x != nil {
-		return x.TotalCount
+		return x.ContentType
 	}
-	return 0
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
 }
 
-func (x *ListEpisodesResponse) GetPage() int32 {
+func (x *SubmitForReviewRequest) GetContentId() string {
 	if x != nil {
-		return x.Page
+		return x.ContentId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListEpisodesResponse) GetPageSize() int32 {
+func (x *SubmitForReviewRequest) GetComment() string {
 	if x != nil {
-		return x.PageSize
+		return x.Comment
 	}
-	return 0
+	return ""
 }
 
-type ImportDataRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SourceType        string                 `protobuf:"bytes,1,opt,name=source_type,proto3" json:"source_type,omitempty"` // youtube, rss, json, csv
-	SourceUrl         string                 `protobuf:"bytes,2,opt,name=source_url,proto3" json:"source_url,omitempty"`
-	SourceConfig      map[string]string      `protobuf:"bytes,3,rep,name=source_config,proto3" json:"source_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // API keys, credentials, etc.
-	DefaultCategoryId string                 `protobuf:"bytes,4,opt,name=default_category_id,proto3" json:"default_category_id,omitempty"`
-	DryRun            bool                   `protobuf:"varint,5,opt,name=dry_run,proto3" json:"dry_run,omitempty"`                                                                                      // If true, validate but don't import
-	FieldMapping      map[string]string      `protobuf:"bytes,6,rep,name=field_mapping,proto3" json:"field_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Map source fields to our fields
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ApproveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportDataRequest) Reset() {
-	*x = ImportDataRequest{}
-	mi := &file_v1_cms_proto_msgTypes[30]
+func (x *ApproveRequest) Reset() {
+	*x = ApproveRequest{}
+	mi := &file_v1_cms_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportDataRequest) String() string {
+func (x *ApproveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportDataRequest) ProtoMessage() {}
+func (*ApproveRequest) ProtoMessage() {}
 
-func (x *ImportDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[30]
+func (x *ApproveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2507,82 +3229,56 @@ func (x *ImportDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportDataRequest.ProtoReflect.Descriptor instead.
-func (*ImportDataRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ApproveRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ImportDataRequest) GetSourceType() string {
+func (x *ApproveRequest) GetContentType() ContentType {
 	if x != nil {
-		return x.SourceType
+		return x.ContentType
 	}
-	return ""
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
 }
 
-func (x *ImportDataRequest) GetSourceUrl() string {
+func (x *ApproveRequest) GetContentId() string {
 	if x != nil {
-		return x.SourceUrl
+		return x.ContentId
 	}
 	return ""
 }
 
-func (x *ImportDataRequest) GetSourceConfig() map[string]string {
-	if x != nil {
-		return x.SourceConfig
-	}
-	return nil
-}
-
-func (x *ImportDataRequest) GetDefaultCategoryId() string {
+func (x *ApproveRequest) GetComment() string {
 	if x != nil {
-		return x.DefaultCategoryId
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *ImportDataRequest) GetDryRun() bool {
-	if x != nil {
-		return x.DryRun
-	}
-	return false
-}
-
-func (x *ImportDataRequest) GetFieldMapping() map[string]string {
-	if x != nil {
-		return x.FieldMapping
-	}
-	return nil
-}
-
-type ImportDataResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ImportId       string                 `protobuf:"bytes,1,opt,name=import_id,proto3" json:"import_id,omitempty"`
-	Status         ImportStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=thmanyah.v1.ImportStatus" json:"status,omitempty"`
-	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	TotalItems     int32                  `protobuf:"varint,4,opt,name=total_items,proto3" json:"total_items,omitempty"`
-	ProcessedItems int32                  `protobuf:"varint,5,opt,name=processed_items,proto3" json:"processed_items,omitempty"`
-	SuccessCount   int32                  `protobuf:"varint,6,opt,name=success_count,proto3" json:"success_count,omitempty"`
-	ErrorCount     int32                  `protobuf:"varint,7,opt,name=error_count,proto3" json:"error_count,omitempty"`
-	Warnings       []string               `protobuf:"bytes,9,rep,name=warnings,proto3" json:"warnings,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type RejectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportDataResponse) Reset() {
-	*x = ImportDataResponse{}
-	mi := &file_v1_cms_proto_msgTypes[31]
+func (x *RejectRequest) Reset() {
+	*x = RejectRequest{}
+	mi := &file_v1_cms_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportDataResponse) String() string {
+func (x *RejectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportDataResponse) ProtoMessage() {}
+func (*RejectRequest) ProtoMessage() {}
 
-func (x *ImportDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[31]
+func (x *RejectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2593,94 +3289,115 @@ func (x *ImportDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportDataResponse.ProtoReflect.Descriptor instead.
-func (*ImportDataResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use RejectRequest.ProtoReflect.Descriptor instead.
+func (*RejectRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ImportDataResponse) GetImportId() string {
+func (x *RejectRequest) GetContentType() ContentType {
 	if x != nil {
-		return x.ImportId
+		return x.ContentType
 	}
-	return ""
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
 }
 
-func (x *ImportDataResponse) GetStatus() ImportStatus {
+func (x *RejectRequest) GetContentId() string {
 	if x != nil {
-		return x.Status
+		return x.ContentId
 	}
-	return ImportStatus_IMPORT_STATUS_PENDING
+	return ""
 }
 
-func (x *ImportDataResponse) GetMessage() string {
+func (x *RejectRequest) GetComment() string {
 	if x != nil {
-		return x.Message
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *ImportDataResponse) GetTotalItems() int32 {
-	if x != nil {
-		return x.TotalItems
-	}
-	return 0
+type ReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Program       *Program               `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"` // Set when the content is a program
+	Episode       *Episode               `protobuf:"bytes,2,opt,name=episode,proto3" json:"episode,omitempty"` // Set when the content is an episode
+	Transition    *StatusTransition      `protobuf:"bytes,3,opt,name=transition,proto3" json:"transition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportDataResponse) GetProcessedItems() int32 {
+func (x *ReviewResponse) Reset() {
+	*x = ReviewResponse{}
+	mi := &file_v1_cms_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewResponse) ProtoMessage() {}
+
+func (x *ReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[38]
 	if x != nil {
-		return x.ProcessedItems
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ImportDataResponse) GetSuccessCount() int32 {
+// Deprecated: Use ReviewResponse.ProtoReflect.Descriptor instead.
+func (*ReviewResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReviewResponse) GetProgram() *Program {
 	if x != nil {
-		return x.SuccessCount
+		return x.Program
 	}
-	return 0
+	return nil
 }
 
-func (x *ImportDataResponse) GetErrorCount() int32 {
+func (x *ReviewResponse) GetEpisode() *Episode {
 	if x != nil {
-		return x.ErrorCount
+		return x.Episode
 	}
-	return 0
+	return nil
 }
 
-func (x *ImportDataResponse) GetWarnings() []string {
+func (x *ReviewResponse) GetTransition() *StatusTransition {
 	if x != nil {
-		return x.Warnings
+		return x.Transition
 	}
 	return nil
 }
 
-type BulkUpdateProgramsRequest struct {
+type ListStatusTransitionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProgramIds    []string               `protobuf:"bytes,1,rep,name=program_ids,proto3" json:"program_ids,omitempty"`
-	Status        ProgramStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=thmanyah.v1.ProgramStatus" json:"status,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,proto3" json:"category_id,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	IsFeatured    bool                   `protobuf:"varint,6,opt,name=is_featured,proto3" json:"is_featured,omitempty"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,proto3" json:"content_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUpdateProgramsRequest) Reset() {
-	*x = BulkUpdateProgramsRequest{}
-	mi := &file_v1_cms_proto_msgTypes[32]
+func (x *ListStatusTransitionsRequest) Reset() {
+	*x = ListStatusTransitionsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpdateProgramsRequest) String() string {
+func (x *ListStatusTransitionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpdateProgramsRequest) ProtoMessage() {}
+func (*ListStatusTransitionsRequest) ProtoMessage() {}
 
-func (x *BulkUpdateProgramsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[32]
+func (x *ListStatusTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2691,77 +3408,99 @@ func (x *BulkUpdateProgramsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpdateProgramsRequest.ProtoReflect.Descriptor instead.
-func (*BulkUpdateProgramsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListStatusTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStatusTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *BulkUpdateProgramsRequest) GetProgramIds() []string {
+func (x *ListStatusTransitionsRequest) GetContentType() ContentType {
 	if x != nil {
-		return x.ProgramIds
+		return x.ContentType
 	}
-	return nil
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
 }
 
-func (x *BulkUpdateProgramsRequest) GetStatus() ProgramStatus {
+func (x *ListStatusTransitionsRequest) GetContentId() string {
 	if x != nil {
-		return x.Status
+		return x.ContentId
 	}
-	return ProgramStatus_PROGRAM_STATUS_DRAFT
+	return ""
 }
 
-func (x *BulkUpdateProgramsRequest) GetCategoryId() string {
-	if x != nil {
-		return x.CategoryId
-	}
-	return ""
+type ListStatusTransitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transitions   []*StatusTransition    `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUpdateProgramsRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *ListStatusTransitionsResponse) Reset() {
+	*x = ListStatusTransitionsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpdateProgramsRequest) GetMetadata() map[string]string {
+func (x *ListStatusTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusTransitionsResponse) ProtoMessage() {}
+
+func (x *ListStatusTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[40]
 	if x != nil {
-		return x.Metadata
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *BulkUpdateProgramsRequest) GetIsFeatured() bool {
+// Deprecated: Use ListStatusTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStatusTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListStatusTransitionsResponse) GetTransitions() []*StatusTransition {
 	if x != nil {
-		return x.IsFeatured
+		return x.Transitions
 	}
-	return false
+	return nil
 }
 
-type BulkUpdateProgramsResponse struct {
+type Revision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UpdatedCount  int32                  `protobuf:"varint,1,opt,name=updated_count,proto3" json:"updated_count,omitempty"`
-	FailedCount   int32                  `protobuf:"varint,2,opt,name=failed_count,proto3" json:"failed_count,omitempty"`
-	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContentType   ContentType            `protobuf:"varint,2,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,3,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Snapshot      *structpb.Struct       `protobuf:"bytes,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	ChangedFields []string               `protobuf:"bytes,6,rep,name=changed_fields,proto3" json:"changed_fields,omitempty"`      // Empty for the first revision
+	AuthorId      string                 `protobuf:"bytes,7,opt,name=author_id,proto3" json:"author_id,omitempty"`                // Empty for changes made by the scheduler
+	RestoredFrom  *int32                 `protobuf:"varint,8,opt,name=restored_from,proto3,oneof" json:"restored_from,omitempty"` // Version this revision restored
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUpdateProgramsResponse) Reset() {
-	*x = BulkUpdateProgramsResponse{}
-	mi := &file_v1_cms_proto_msgTypes[33]
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_v1_cms_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpdateProgramsResponse) String() string {
+func (x *Revision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpdateProgramsResponse) ProtoMessage() {}
+func (*Revision) ProtoMessage() {}
 
-func (x *BulkUpdateProgramsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[33]
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2772,54 +3511,98 @@ func (x *BulkUpdateProgramsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpdateProgramsResponse.ProtoReflect.Descriptor instead.
-func (*BulkUpdateProgramsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *BulkUpdateProgramsResponse) GetUpdatedCount() int32 {
+func (x *Revision) GetId() string {
 	if x != nil {
-		return x.UpdatedCount
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *BulkUpdateProgramsResponse) GetFailedCount() int32 {
+func (x *Revision) GetContentType() ContentType {
 	if x != nil {
-		return x.FailedCount
+		return x.ContentType
 	}
-	return 0
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
 }
 
-func (x *BulkUpdateProgramsResponse) GetErrors() []string {
+func (x *Revision) GetContentId() string {
 	if x != nil {
-		return x.Errors
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *Revision) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Revision) GetSnapshot() *structpb.Struct {
+	if x != nil {
+		return x.Snapshot
 	}
 	return nil
 }
 
-type BulkDeleteProgramsRequest struct {
+func (x *Revision) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *Revision) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Revision) GetRestoredFrom() int32 {
+	if x != nil && x.RestoredFrom != nil {
+		return *x.RestoredFrom
+	}
+	return 0
+}
+
+func (x *Revision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type FieldChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProgramIds    []string               `protobuf:"bytes,1,rep,name=program_ids,proto3" json:"program_ids,omitempty"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From          *structpb.Value        `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *structpb.Value        `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkDeleteProgramsRequest) Reset() {
-	*x = BulkDeleteProgramsRequest{}
-	mi := &file_v1_cms_proto_msgTypes[34]
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_v1_cms_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkDeleteProgramsRequest) String() string {
+func (x *FieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkDeleteProgramsRequest) ProtoMessage() {}
+func (*FieldChange) ProtoMessage() {}
 
-func (x *BulkDeleteProgramsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[34]
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2830,43 +3613,57 @@ func (x *BulkDeleteProgramsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkDeleteProgramsRequest.ProtoReflect.Descriptor instead.
-func (*BulkDeleteProgramsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *BulkDeleteProgramsRequest) GetProgramIds() []string {
+func (x *FieldChange) GetField() string {
 	if x != nil {
-		return x.ProgramIds
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetFrom() *structpb.Value {
+	if x != nil {
+		return x.From
 	}
 	return nil
 }
 
-type PaginationMetadata struct {
+func (x *FieldChange) GetTo() *structpb.Value {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListRevisionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,proto3" json:"total_count,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,proto3" json:"total_pages,omitempty"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaginationMetadata) Reset() {
-	*x = PaginationMetadata{}
-	mi := &file_v1_cms_proto_msgTypes[35]
+func (x *ListRevisionsRequest) Reset() {
+	*x = ListRevisionsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaginationMetadata) String() string {
+func (x *ListRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationMetadata) ProtoMessage() {}
+func (*ListRevisionsRequest) ProtoMessage() {}
 
-func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[35]
+func (x *ListRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2877,62 +3674,129 @@ func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
-func (*PaginationMetadata) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *PaginationMetadata) GetPage() int32 {
+func (x *ListRevisionsRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *ListRevisionsRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *ListRevisionsRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *PaginationMetadata) GetPageSize() int32 {
+func (x *ListRevisionsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *PaginationMetadata) GetTotalCount() int32 {
+type ListRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*Revision            `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevisionsResponse) Reset() {
+	*x = ListRevisionsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsResponse) ProtoMessage() {}
+
+func (x *ListRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListRevisionsResponse) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListRevisionsResponse) GetTotalCount() int32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *PaginationMetadata) GetTotalPages() int32 {
+func (x *ListRevisionsResponse) GetPage() int32 {
 	if x != nil {
-		return x.TotalPages
+		return x.Page
 	}
 	return 0
 }
 
-type SortOptions struct {
+func (x *ListRevisionsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetRevisionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	RevisionId    string                 `protobuf:"bytes,1,opt,name=revision_id,proto3" json:"revision_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SortOptions) Reset() {
-	*x = SortOptions{}
-	mi := &file_v1_cms_proto_msgTypes[36]
+func (x *GetRevisionRequest) Reset() {
+	*x = GetRevisionRequest{}
+	mi := &file_v1_cms_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SortOptions) String() string {
+func (x *GetRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SortOptions) ProtoMessage() {}
+func (*GetRevisionRequest) ProtoMessage() {}
 
-func (x *SortOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[36]
+func (x *GetRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2943,47 +3807,138 @@ func (x *SortOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SortOptions.ProtoReflect.Descriptor instead.
-func (*SortOptions) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SortOptions) GetField() string {
+func (x *GetRevisionRequest) GetRevisionId() string {
 	if x != nil {
-		return x.Field
+		return x.RevisionId
 	}
 	return ""
 }
 
-func (x *SortOptions) GetDirection() string {
+type GetRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *Revision              `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevisionResponse) Reset() {
+	*x = GetRevisionResponse{}
+	mi := &file_v1_cms_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevisionResponse) ProtoMessage() {}
+
+func (x *GetRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[46]
 	if x != nil {
-		return x.Direction
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetRevisionResponse) GetRevision() *Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type DiffRevisionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromRevisionId string                 `protobuf:"bytes,1,opt,name=from_revision_id,proto3" json:"from_revision_id,omitempty"`
+	ToRevisionId   string                 `protobuf:"bytes,2,opt,name=to_revision_id,proto3" json:"to_revision_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DiffRevisionsRequest) Reset() {
+	*x = DiffRevisionsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DiffRevisionsRequest) GetFromRevisionId() string {
+	if x != nil {
+		return x.FromRevisionId
 	}
 	return ""
 }
 
-type FilterOptions struct {
+func (x *DiffRevisionsRequest) GetToRevisionId() string {
+	if x != nil {
+		return x.ToRevisionId
+	}
+	return ""
+}
+
+type DiffRevisionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filters       map[string]*anypb.Any  `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	FromVersion   int32                  `protobuf:"varint,1,opt,name=from_version,proto3" json:"from_version,omitempty"`
+	ToVersion     int32                  `protobuf:"varint,2,opt,name=to_version,proto3" json:"to_version,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FilterOptions) Reset() {
-	*x = FilterOptions{}
-	mi := &file_v1_cms_proto_msgTypes[37]
+func (x *DiffRevisionsResponse) Reset() {
+	*x = DiffRevisionsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FilterOptions) String() string {
+func (x *DiffRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterOptions) ProtoMessage() {}
+func (*DiffRevisionsResponse) ProtoMessage() {}
 
-func (x *FilterOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[37]
+func (x *DiffRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2994,40 +3949,54 @@ func (x *FilterOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterOptions.ProtoReflect.Descriptor instead.
-func (*FilterOptions) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use DiffRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *FilterOptions) GetFilters() map[string]*anypb.Any {
+func (x *DiffRevisionsResponse) GetFromVersion() int32 {
 	if x != nil {
-		return x.Filters
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffRevisionsResponse) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *DiffRevisionsResponse) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
 	}
 	return nil
 }
 
-type EpisodeFileUpdateResponse struct {
+type RestoreRevisionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileUrl       string                 `protobuf:"bytes,1,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	RevisionId    string                 `protobuf:"bytes,1,opt,name=revision_id,proto3" json:"revision_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EpisodeFileUpdateResponse) Reset() {
-	*x = EpisodeFileUpdateResponse{}
-	mi := &file_v1_cms_proto_msgTypes[38]
+func (x *RestoreRevisionRequest) Reset() {
+	*x = RestoreRevisionRequest{}
+	mi := &file_v1_cms_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EpisodeFileUpdateResponse) String() string {
+func (x *RestoreRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpisodeFileUpdateResponse) ProtoMessage() {}
+func (*RestoreRevisionRequest) ProtoMessage() {}
 
-func (x *EpisodeFileUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_cms_proto_msgTypes[38]
+func (x *RestoreRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3038,28 +4007,6765 @@ func (x *EpisodeFileUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpisodeFileUpdateResponse.ProtoReflect.Descriptor instead.
-func (*EpisodeFileUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_v1_cms_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RestoreRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *EpisodeFileUpdateResponse) GetFileUrl() string {
+func (x *RestoreRevisionRequest) GetRevisionId() string {
 	if x != nil {
-		return x.FileUrl
+		return x.RevisionId
 	}
 	return ""
 }
 
-var File_v1_cms_proto protoreflect.FileDescriptor
+type RestoreRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Program       *Program               `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`   // Set when the content is a program
+	Episode       *Episode               `protobuf:"bytes,2,opt,name=episode,proto3" json:"episode,omitempty"`   // Set when the content is an episode
+	Revision      *Revision              `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"` // Unset when the content already matched the revision
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_v1_cms_proto_rawDesc = "" +
-	"\n" +
-	"\fv1/cms.proto\x12\vthmanyah.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x19google/protobuf/any.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1copenapi/v3/annotations.proto\"\x9e\x03\n" +
-	"\bCategory\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12-\n" +
-	"\x04type\x18\x04 \x01(\x0e2\x19.thmanyah.v1.CategoryTypeR\x04type\x12:\n" +
+func (x *RestoreRevisionResponse) Reset() {
+	*x = RestoreRevisionResponse{}
+	mi := &file_v1_cms_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RestoreRevisionResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *RestoreRevisionResponse) GetEpisode() *Episode {
+	if x != nil {
+		return x.Episode
+	}
+	return nil
+}
+
+func (x *RestoreRevisionResponse) GetRevision() *Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type TrashItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"` // Name of a category
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	mi := &file_v1_cms_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TrashItem) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *TrashItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"` // Unspecified lists everything
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_v1_cms_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListTrashRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *ListTrashRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TrashItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_v1_cms_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListTrashResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTrashResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type RestoreFromTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFromTrashRequest) Reset() {
+	*x = RestoreFromTrashRequest{}
+	mi := &file_v1_cms_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFromTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFromTrashRequest) ProtoMessage() {}
+
+func (x *RestoreFromTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFromTrashRequest.ProtoReflect.Descriptor instead.
+func (*RestoreFromTrashRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RestoreFromTrashRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *RestoreFromTrashRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+type RestoreFromTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // Set when the content is a category
+	Program       *Program               `protobuf:"bytes,2,opt,name=program,proto3" json:"program,omitempty"`   // Set when the content is a program
+	Episode       *Episode               `protobuf:"bytes,3,opt,name=episode,proto3" json:"episode,omitempty"`   // Set when the content is an episode
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFromTrashResponse) Reset() {
+	*x = RestoreFromTrashResponse{}
+	mi := &file_v1_cms_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFromTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFromTrashResponse) ProtoMessage() {}
+
+func (x *RestoreFromTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFromTrashResponse.ProtoReflect.Descriptor instead.
+func (*RestoreFromTrashResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RestoreFromTrashResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *RestoreFromTrashResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *RestoreFromTrashResponse) GetEpisode() *Episode {
+	if x != nil {
+		return x.Episode
+	}
+	return nil
+}
+
+type PurgeTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"` // Unspecified purges every kind
+	ContentId     *string                `protobuf:"bytes,2,opt,name=content_id,proto3,oneof" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashRequest) Reset() {
+	*x = PurgeTrashRequest{}
+	mi := &file_v1_cms_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashRequest) ProtoMessage() {}
+
+func (x *PurgeTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTrashRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PurgeTrashRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *PurgeTrashRequest) GetContentId() string {
+	if x != nil && x.ContentId != nil {
+		return *x.ContentId
+	}
+	return ""
+}
+
+type PurgeTrashResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CategoriesPurged int32                  `protobuf:"varint,1,opt,name=categories_purged,proto3" json:"categories_purged,omitempty"`
+	ProgramsPurged   int32                  `protobuf:"varint,2,opt,name=programs_purged,proto3" json:"programs_purged,omitempty"`
+	EpisodesPurged   int32                  `protobuf:"varint,3,opt,name=episodes_purged,proto3" json:"episodes_purged,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PurgeTrashResponse) Reset() {
+	*x = PurgeTrashResponse{}
+	mi := &file_v1_cms_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashResponse) ProtoMessage() {}
+
+func (x *PurgeTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTrashResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PurgeTrashResponse) GetCategoriesPurged() int32 {
+	if x != nil {
+		return x.CategoriesPurged
+	}
+	return 0
+}
+
+func (x *PurgeTrashResponse) GetProgramsPurged() int32 {
+	if x != nil {
+		return x.ProgramsPurged
+	}
+	return 0
+}
+
+func (x *PurgeTrashResponse) GetEpisodesPurged() int32 {
+	if x != nil {
+		return x.EpisodesPurged
+	}
+	return 0
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,proto3" json:"actor_id,omitempty"`             // Empty for anonymous callers
+	PrincipalType string                 `protobuf:"bytes,3,opt,name=principal_type,proto3" json:"principal_type,omitempty"` // anonymous, user or system
+	Operation     string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	EntityType    string                 `protobuf:"bytes,5,opt,name=entity_type,proto3" json:"entity_type,omitempty"` // user, category, program, episode, season, chapter, transcript, person, credit, import, tag, webhook or webhook_delivery
+	EntityId      string                 `protobuf:"bytes,6,opt,name=entity_id,proto3" json:"entity_id,omitempty"`     // Empty for bulk operations
+	Before        *structpb.Struct       `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`           // Changed fields before an update, or the deleted entity
+	After         *structpb.Struct       `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`             // Changed fields after an update, or the created entity
+	IpAddress     string                 `protobuf:"bytes,9,opt,name=ip_address,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,10,opt,name=user_agent,proto3" json:"user_agent,omitempty"`
+	RequestId     string                 `protobuf:"bytes,11,opt,name=request_id,proto3" json:"request_id,omitempty"`
+	Outcome       string                 `protobuf:"bytes,12,opt,name=outcome,proto3" json:"outcome,omitempty"`           // success or failure
+	ErrorReason   string                 `protobuf:"bytes,13,opt,name=error_reason,proto3" json:"error_reason,omitempty"` // Set when the operation failed
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_v1_cms_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPrincipalType() string {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() *structpb.Struct {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetAfter() *structpb.Struct {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetErrorReason() string {
+	if x != nil {
+		return x.ErrorReason
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,proto3" json:"actor_id,omitempty"`
+	PrincipalType string                 `protobuf:"bytes,2,opt,name=principal_type,proto3" json:"principal_type,omitempty"`
+	Operation     string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	EntityType    string                 `protobuf:"bytes,4,opt,name=entity_type,proto3" json:"entity_type,omitempty"`
+	EntityId      string                 `protobuf:"bytes,5,opt,name=entity_id,proto3" json:"entity_id,omitempty"`
+	Outcome       string                 `protobuf:"bytes,6,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	RequestId     string                 `protobuf:"bytes,7,opt,name=request_id,proto3" json:"request_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=from,proto3" json:"from,omitempty"` // Inclusive
+	To            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=to,proto3" json:"to,omitempty"`     // Exclusive
+	Page          int32                  `protobuf:"varint,10,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,11,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListAuditEventsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetPrincipalType() string {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CategoryNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Children      []*CategoryNode        `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_v1_cms_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CategoryNode) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type GetCategoryTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootId        string                 `protobuf:"bytes,1,opt,name=root_id,proto3" json:"root_id,omitempty"` // Empty for the whole tree
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_v1_cms_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeRequest) ProtoMessage() {}
+
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetCategoryTreeRequest) GetRootId() string {
+	if x != nil {
+		return x.RootId
+	}
+	return ""
+}
+
+type GetCategoryTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryNode        `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeResponse) Reset() {
+	*x = GetCategoryTreeResponse{}
+	mi := &file_v1_cms_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeResponse) ProtoMessage() {}
+
+func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetCategoryTreeResponse) GetCategories() []*CategoryNode {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type MoveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,proto3" json:"category_id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,proto3" json:"parent_id,omitempty"` // Empty moves it to the top level
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_v1_cms_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryRequest) ProtoMessage() {}
+
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MoveCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type MoveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryResponse) Reset() {
+	*x = MoveCategoryResponse{}
+	mi := &file_v1_cms_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryResponse) ProtoMessage() {}
+
+func (x *MoveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*MoveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MoveCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type SetProgramCategoriesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId         string                 `protobuf:"bytes,1,opt,name=program_id,proto3" json:"program_id,omitempty"`
+	PrimaryCategoryId string                 `protobuf:"bytes,2,opt,name=primary_category_id,proto3" json:"primary_category_id,omitempty"`
+	CategoryIds       []string               `protobuf:"bytes,3,rep,name=category_ids,proto3" json:"category_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetProgramCategoriesRequest) Reset() {
+	*x = SetProgramCategoriesRequest{}
+	mi := &file_v1_cms_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProgramCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProgramCategoriesRequest) ProtoMessage() {}
+
+func (x *SetProgramCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProgramCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*SetProgramCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetProgramCategoriesRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *SetProgramCategoriesRequest) GetPrimaryCategoryId() string {
+	if x != nil {
+		return x.PrimaryCategoryId
+	}
+	return ""
+}
+
+func (x *SetProgramCategoriesRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type SetProgramCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Program       *Program               `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProgramCategoriesResponse) Reset() {
+	*x = SetProgramCategoriesResponse{}
+	mi := &file_v1_cms_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProgramCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProgramCategoriesResponse) ProtoMessage() {}
+
+func (x *SetProgramCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProgramCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*SetProgramCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetProgramCategoriesResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Translations  map[string]string      `protobuf:"bytes,4,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Name by locale
+	Aliases       []string               `protobuf:"bytes,5,rep,name=aliases,proto3" json:"aliases,omitempty"`                                                                                     // Slugs of other spellings
+	ProgramCount  int32                  `protobuf:"varint,6,opt,name=program_count,proto3" json:"program_count,omitempty"`
+	EpisodeCount  int32                  `protobuf:"varint,7,opt,name=episode_count,proto3" json:"episode_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_v1_cms_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Tag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Tag) GetTranslations() map[string]string {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+func (x *Tag) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Tag) GetProgramCount() int32 {
+	if x != nil {
+		return x.ProgramCount
+	}
+	return 0
+}
+
+func (x *Tag) GetEpisodeCount() int32 {
+	if x != nil {
+		return x.EpisodeCount
+	}
+	return 0
+}
+
+func (x *Tag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tag) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	SearchQuery   string                 `protobuf:"bytes,3,opt,name=search_query,proto3" json:"search_query,omitempty"`
+	SortBy        string                 `protobuf:"bytes,4,opt,name=sort_by,proto3" json:"sort_by,omitempty"`
+	SortOrder     string                 `protobuf:"bytes,5,opt,name=sort_order,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListTagsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTagsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTagsRequest) GetSearchQuery() string {
+	if x != nil {
+		return x.SearchQuery
+	}
+	return ""
+}
+
+func (x *ListTagsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListTagsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTagsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListTagsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTagsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AutocompleteTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 20 when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteTagsRequest) Reset() {
+	*x = AutocompleteTagsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteTagsRequest) ProtoMessage() {}
+
+func (x *AutocompleteTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteTagsRequest.ProtoReflect.Descriptor instead.
+func (*AutocompleteTagsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AutocompleteTagsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *AutocompleteTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AutocompleteTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteTagsResponse) Reset() {
+	*x = AutocompleteTagsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteTagsResponse) ProtoMessage() {}
+
+func (x *AutocompleteTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteTagsResponse.ProtoReflect.Descriptor instead.
+func (*AutocompleteTagsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AutocompleteTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,proto3" json:"tag_id,omitempty"`
+	Translations  map[string]string      `protobuf:"bytes,2,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
+	mi := &file_v1_cms_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagRequest) ProtoMessage() {}
+
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetTranslations() map[string]string {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+func (x *UpdateTagRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type UpdateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagResponse) Reset() {
+	*x = UpdateTagResponse{}
+	mi := &file_v1_cms_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagResponse) ProtoMessage() {}
+
+func (x *UpdateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTagResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type RenameTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,proto3" json:"tag_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_v1_cms_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RenameTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_v1_cms_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RenameTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type MergeTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,proto3" json:"tag_id,omitempty"` // The tag that remains
+	SourceIds     []string               `protobuf:"bytes,2,rep,name=source_ids,proto3" json:"source_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MergeTagsRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *MergeTagsRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type MergeTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsResponse) ProtoMessage() {}
+
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MergeTagsResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type Translation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`           // BCP 47 language tag, such as ar or en-US
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`             // Name of a category
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"` // Empty falls back to the original description
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Translation) Reset() {
+	*x = Translation{}
+	mi := &file_v1_cms_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Translation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Translation) ProtoMessage() {}
+
+func (x *Translation) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Translation.ProtoReflect.Descriptor instead.
+func (*Translation) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Translation) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *Translation) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *Translation) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Translation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Translation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Translation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Translation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListTranslationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTranslationsRequest) Reset() {
+	*x = ListTranslationsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTranslationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTranslationsRequest) ProtoMessage() {}
+
+func (x *ListTranslationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTranslationsRequest.ProtoReflect.Descriptor instead.
+func (*ListTranslationsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListTranslationsRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *ListTranslationsRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+type ListTranslationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Translations  []*Translation         `protobuf:"bytes,1,rep,name=translations,proto3" json:"translations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTranslationsResponse) Reset() {
+	*x = ListTranslationsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTranslationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTranslationsResponse) ProtoMessage() {}
+
+func (x *ListTranslationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTranslationsResponse.ProtoReflect.Descriptor instead.
+func (*ListTranslationsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListTranslationsResponse) GetTranslations() []*Translation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+type SetTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	ContentType   ContentType            `protobuf:"varint,2,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,3,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTranslationRequest) Reset() {
+	*x = SetTranslationRequest{}
+	mi := &file_v1_cms_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTranslationRequest) ProtoMessage() {}
+
+func (x *SetTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTranslationRequest.ProtoReflect.Descriptor instead.
+func (*SetTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetTranslationRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *SetTranslationRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *SetTranslationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetTranslationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetTranslationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Translation   *Translation           `protobuf:"bytes,1,opt,name=translation,proto3" json:"translation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTranslationResponse) Reset() {
+	*x = SetTranslationResponse{}
+	mi := &file_v1_cms_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTranslationResponse) ProtoMessage() {}
+
+func (x *SetTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTranslationResponse.ProtoReflect.Descriptor instead.
+func (*SetTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SetTranslationResponse) GetTranslation() *Translation {
+	if x != nil {
+		return x.Translation
+	}
+	return nil
+}
+
+type DeleteTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	ContentType   ContentType            `protobuf:"varint,2,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,3,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTranslationRequest) Reset() {
+	*x = DeleteTranslationRequest{}
+	mi := &file_v1_cms_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *DeleteTranslationRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *DeleteTranslationRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+type Season struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProgramId      string                 `protobuf:"bytes,2,opt,name=program_id,proto3" json:"program_id,omitempty"`
+	SeasonNumber   int32                  `protobuf:"varint,3,opt,name=season_number,proto3" json:"season_number,omitempty"`
+	Title          string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ThumbnailUrl   string                 `protobuf:"bytes,6,opt,name=thumbnail_url,proto3" json:"thumbnail_url,omitempty"`
+	ReleaseStartAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=release_start_at,proto3" json:"release_start_at,omitempty"`
+	ReleaseEndAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=release_end_at,proto3" json:"release_end_at,omitempty"`
+	SortOrder      int32                  `protobuf:"varint,9,opt,name=sort_order,proto3" json:"sort_order,omitempty"`          // Seasons are listed by sort order, then number
+	EpisodesCount  int32                  `protobuf:"varint,10,opt,name=episodes_count,proto3" json:"episodes_count,omitempty"` // Not counting the trash
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	mi := &file_v1_cms_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Season) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Season) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *Season) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *Season) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Season) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Season) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Season) GetReleaseStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseStartAt
+	}
+	return nil
+}
+
+func (x *Season) GetReleaseEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseEndAt
+	}
+	return nil
+}
+
+func (x *Season) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *Season) GetEpisodesCount() int32 {
+	if x != nil {
+		return x.EpisodesCount
+	}
+	return 0
+}
+
+func (x *Season) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Season) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateSeasonRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId      string                 `protobuf:"bytes,1,opt,name=program_id,proto3" json:"program_id,omitempty"`
+	SeasonNumber   int32                  `protobuf:"varint,2,opt,name=season_number,proto3" json:"season_number,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ThumbnailUrl   string                 `protobuf:"bytes,5,opt,name=thumbnail_url,proto3" json:"thumbnail_url,omitempty"`
+	ReleaseStartAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=release_start_at,proto3" json:"release_start_at,omitempty"`
+	ReleaseEndAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=release_end_at,proto3" json:"release_end_at,omitempty"`
+	SortOrder      int32                  `protobuf:"varint,8,opt,name=sort_order,proto3" json:"sort_order,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSeasonRequest) Reset() {
+	*x = CreateSeasonRequest{}
+	mi := &file_v1_cms_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeasonRequest) ProtoMessage() {}
+
+func (x *CreateSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeasonRequest.ProtoReflect.Descriptor instead.
+func (*CreateSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateSeasonRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *CreateSeasonRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *CreateSeasonRequest) GetReleaseStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseStartAt
+	}
+	return nil
+}
+
+func (x *CreateSeasonRequest) GetReleaseEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseEndAt
+	}
+	return nil
+}
+
+func (x *CreateSeasonRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type CreateSeasonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        *Season                `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSeasonResponse) Reset() {
+	*x = CreateSeasonResponse{}
+	mi := &file_v1_cms_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeasonResponse) ProtoMessage() {}
+
+func (x *CreateSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeasonResponse.ProtoReflect.Descriptor instead.
+func (*CreateSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateSeasonResponse) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+type GetSeasonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonRequest) Reset() {
+	*x = GetSeasonRequest{}
+	mi := &file_v1_cms_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonRequest) ProtoMessage() {}
+
+func (x *GetSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetSeasonRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+type GetSeasonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        *Season                `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonResponse) Reset() {
+	*x = GetSeasonResponse{}
+	mi := &file_v1_cms_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonResponse) ProtoMessage() {}
+
+func (x *GetSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonResponse.ProtoReflect.Descriptor instead.
+func (*GetSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetSeasonResponse) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+type ListSeasonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     string                 `protobuf:"bytes,1,opt,name=program_id,proto3" json:"program_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonsRequest) Reset() {
+	*x = ListSeasonsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsRequest) ProtoMessage() {}
+
+func (x *ListSeasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListSeasonsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListSeasonsRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+type ListSeasonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seasons       []*Season              `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonsResponse) Reset() {
+	*x = ListSeasonsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsResponse) ProtoMessage() {}
+
+func (x *ListSeasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListSeasonsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListSeasonsResponse) GetSeasons() []*Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+type UpdateSeasonRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId       string                 `protobuf:"bytes,1,opt,name=season_id,proto3" json:"season_id,omitempty"`
+	SeasonNumber   *int32                 `protobuf:"varint,2,opt,name=season_number,proto3,oneof" json:"season_number,omitempty"`
+	Title          *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description    *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ThumbnailUrl   *string                `protobuf:"bytes,5,opt,name=thumbnail_url,proto3,oneof" json:"thumbnail_url,omitempty"`
+	ReleaseStartAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=release_start_at,proto3,oneof" json:"release_start_at,omitempty"`
+	ReleaseEndAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=release_end_at,proto3,oneof" json:"release_end_at,omitempty"`
+	SortOrder      *int32                 `protobuf:"varint,8,opt,name=sort_order,proto3,oneof" json:"sort_order,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateSeasonRequest) Reset() {
+	*x = UpdateSeasonRequest{}
+	mi := &file_v1_cms_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeasonRequest) ProtoMessage() {}
+
+func (x *UpdateSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeasonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UpdateSeasonRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *UpdateSeasonRequest) GetSeasonNumber() int32 {
+	if x != nil && x.SeasonNumber != nil {
+		return *x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *UpdateSeasonRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateSeasonRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSeasonRequest) GetThumbnailUrl() string {
+	if x != nil && x.ThumbnailUrl != nil {
+		return *x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *UpdateSeasonRequest) GetReleaseStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseStartAt
+	}
+	return nil
+}
+
+func (x *UpdateSeasonRequest) GetReleaseEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseEndAt
+	}
+	return nil
+}
+
+func (x *UpdateSeasonRequest) GetSortOrder() int32 {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return 0
+}
+
+type UpdateSeasonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        *Season                `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSeasonResponse) Reset() {
+	*x = UpdateSeasonResponse{}
+	mi := &file_v1_cms_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeasonResponse) ProtoMessage() {}
+
+func (x *UpdateSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeasonResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UpdateSeasonResponse) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+type DeleteSeasonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSeasonRequest) Reset() {
+	*x = DeleteSeasonRequest{}
+	mi := &file_v1_cms_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSeasonRequest) ProtoMessage() {}
+
+func (x *DeleteSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSeasonRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteSeasonRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+type ReorderEpisodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,proto3" json:"season_id,omitempty"`
+	EpisodeIds    []string               `protobuf:"bytes,2,rep,name=episode_ids,proto3" json:"episode_ids,omitempty"`    // In the new order
+	FirstNumber   int32                  `protobuf:"varint,3,opt,name=first_number,proto3" json:"first_number,omitempty"` // Number of the first episode, 1 when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderEpisodesRequest) Reset() {
+	*x = ReorderEpisodesRequest{}
+	mi := &file_v1_cms_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderEpisodesRequest) ProtoMessage() {}
+
+func (x *ReorderEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ReorderEpisodesRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *ReorderEpisodesRequest) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+func (x *ReorderEpisodesRequest) GetFirstNumber() int32 {
+	if x != nil {
+		return x.FirstNumber
+	}
+	return 0
+}
+
+type ReorderEpisodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episodes      []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"` // By their new number
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderEpisodesResponse) Reset() {
+	*x = ReorderEpisodesResponse{}
+	mi := &file_v1_cms_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderEpisodesResponse) ProtoMessage() {}
+
+func (x *ReorderEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReorderEpisodesResponse) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type ListSeasonEpisodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,proto3" json:"season_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	Status        EpisodeStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=thmanyah.v1.EpisodeStatus" json:"status,omitempty"`
+	SortBy        string                 `protobuf:"bytes,5,opt,name=sort_by,proto3" json:"sort_by,omitempty"`
+	SortOrder     string                 `protobuf:"bytes,6,opt,name=sort_order,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonEpisodesRequest) Reset() {
+	*x = ListSeasonEpisodesRequest{}
+	mi := &file_v1_cms_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonEpisodesRequest) ProtoMessage() {}
+
+func (x *ListSeasonEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*ListSeasonEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListSeasonEpisodesRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *ListSeasonEpisodesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSeasonEpisodesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSeasonEpisodesRequest) GetStatus() EpisodeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EpisodeStatus_EPISODE_STATUS_DRAFT
+}
+
+func (x *ListSeasonEpisodesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListSeasonEpisodesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+type ListSeasonEpisodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episodes      []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonEpisodesResponse) Reset() {
+	*x = ListSeasonEpisodesResponse{}
+	mi := &file_v1_cms_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonEpisodesResponse) ProtoMessage() {}
+
+func (x *ListSeasonEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*ListSeasonEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListSeasonEpisodesResponse) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+func (x *ListSeasonEpisodesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListSeasonEpisodesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSeasonEpisodesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Chapter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EpisodeId     string                 `protobuf:"bytes,2,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	StartSeconds  float64                `protobuf:"fixed64,3,opt,name=start_seconds,proto3" json:"start_seconds,omitempty"`
+	EndSeconds    *float64               `protobuf:"fixed64,4,opt,name=end_seconds,proto3,oneof" json:"end_seconds,omitempty"` // Unset until the next chapter starts
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,proto3" json:"image_url,omitempty"`
+	Url           string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chapter) Reset() {
+	*x = Chapter{}
+	mi := &file_v1_cms_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chapter) ProtoMessage() {}
+
+func (x *Chapter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chapter.ProtoReflect.Descriptor instead.
+func (*Chapter) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Chapter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Chapter) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *Chapter) GetStartSeconds() float64 {
+	if x != nil {
+		return x.StartSeconds
+	}
+	return 0
+}
+
+func (x *Chapter) GetEndSeconds() float64 {
+	if x != nil && x.EndSeconds != nil {
+		return *x.EndSeconds
+	}
+	return 0
+}
+
+func (x *Chapter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Chapter) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Chapter) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Chapter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Chapter) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ChapterInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartSeconds  float64                `protobuf:"fixed64,1,opt,name=start_seconds,proto3" json:"start_seconds,omitempty"`
+	EndSeconds    *float64               `protobuf:"fixed64,2,opt,name=end_seconds,proto3,oneof" json:"end_seconds,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,proto3" json:"image_url,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChapterInput) Reset() {
+	*x = ChapterInput{}
+	mi := &file_v1_cms_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChapterInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChapterInput) ProtoMessage() {}
+
+func (x *ChapterInput) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChapterInput.ProtoReflect.Descriptor instead.
+func (*ChapterInput) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ChapterInput) GetStartSeconds() float64 {
+	if x != nil {
+		return x.StartSeconds
+	}
+	return 0
+}
+
+func (x *ChapterInput) GetEndSeconds() float64 {
+	if x != nil && x.EndSeconds != nil {
+		return *x.EndSeconds
+	}
+	return 0
+}
+
+func (x *ChapterInput) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChapterInput) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ChapterInput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ListChaptersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChaptersRequest) Reset() {
+	*x = ListChaptersRequest{}
+	mi := &file_v1_cms_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChaptersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChaptersRequest) ProtoMessage() {}
+
+func (x *ListChaptersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChaptersRequest.ProtoReflect.Descriptor instead.
+func (*ListChaptersRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListChaptersRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+type ListChaptersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chapters      []*Chapter             `protobuf:"bytes,1,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChaptersResponse) Reset() {
+	*x = ListChaptersResponse{}
+	mi := &file_v1_cms_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChaptersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChaptersResponse) ProtoMessage() {}
+
+func (x *ListChaptersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChaptersResponse.ProtoReflect.Descriptor instead.
+func (*ListChaptersResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListChaptersResponse) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+type CreateChapterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	Chapter       *ChapterInput          `protobuf:"bytes,2,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChapterRequest) Reset() {
+	*x = CreateChapterRequest{}
+	mi := &file_v1_cms_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChapterRequest) ProtoMessage() {}
+
+func (x *CreateChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChapterRequest.ProtoReflect.Descriptor instead.
+func (*CreateChapterRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CreateChapterRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *CreateChapterRequest) GetChapter() *ChapterInput {
+	if x != nil {
+		return x.Chapter
+	}
+	return nil
+}
+
+type CreateChapterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chapter       *Chapter               `protobuf:"bytes,1,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChapterResponse) Reset() {
+	*x = CreateChapterResponse{}
+	mi := &file_v1_cms_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChapterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChapterResponse) ProtoMessage() {}
+
+func (x *CreateChapterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChapterResponse.ProtoReflect.Descriptor instead.
+func (*CreateChapterResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *CreateChapterResponse) GetChapter() *Chapter {
+	if x != nil {
+		return x.Chapter
+	}
+	return nil
+}
+
+type UpdateChapterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChapterId     string                 `protobuf:"bytes,1,opt,name=chapter_id,proto3" json:"chapter_id,omitempty"`
+	StartSeconds  *float64               `protobuf:"fixed64,2,opt,name=start_seconds,proto3,oneof" json:"start_seconds,omitempty"`
+	EndSeconds    *float64               `protobuf:"fixed64,3,opt,name=end_seconds,proto3,oneof" json:"end_seconds,omitempty"` // 0 removes the end
+	Title         *string                `protobuf:"bytes,4,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	ImageUrl      *string                `protobuf:"bytes,5,opt,name=image_url,proto3,oneof" json:"image_url,omitempty"`
+	Url           *string                `protobuf:"bytes,6,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChapterRequest) Reset() {
+	*x = UpdateChapterRequest{}
+	mi := &file_v1_cms_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChapterRequest) ProtoMessage() {}
+
+func (x *UpdateChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChapterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChapterRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *UpdateChapterRequest) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *UpdateChapterRequest) GetStartSeconds() float64 {
+	if x != nil && x.StartSeconds != nil {
+		return *x.StartSeconds
+	}
+	return 0
+}
+
+func (x *UpdateChapterRequest) GetEndSeconds() float64 {
+	if x != nil && x.EndSeconds != nil {
+		return *x.EndSeconds
+	}
+	return 0
+}
+
+func (x *UpdateChapterRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateChapterRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateChapterRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+type UpdateChapterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chapter       *Chapter               `protobuf:"bytes,1,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChapterResponse) Reset() {
+	*x = UpdateChapterResponse{}
+	mi := &file_v1_cms_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChapterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChapterResponse) ProtoMessage() {}
+
+func (x *UpdateChapterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChapterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChapterResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *UpdateChapterResponse) GetChapter() *Chapter {
+	if x != nil {
+		return x.Chapter
+	}
+	return nil
+}
+
+type DeleteChapterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChapterId     string                 `protobuf:"bytes,1,opt,name=chapter_id,proto3" json:"chapter_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChapterRequest) Reset() {
+	*x = DeleteChapterRequest{}
+	mi := &file_v1_cms_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChapterRequest) ProtoMessage() {}
+
+func (x *DeleteChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChapterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChapterRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *DeleteChapterRequest) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+type ReplaceChaptersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	Chapters      []*ChapterInput        `protobuf:"bytes,2,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceChaptersRequest) Reset() {
+	*x = ReplaceChaptersRequest{}
+	mi := &file_v1_cms_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceChaptersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceChaptersRequest) ProtoMessage() {}
+
+func (x *ReplaceChaptersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceChaptersRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceChaptersRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ReplaceChaptersRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *ReplaceChaptersRequest) GetChapters() []*ChapterInput {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+type ReplaceChaptersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chapters      []*Chapter             `protobuf:"bytes,1,rep,name=chapters,proto3" json:"chapters,omitempty"` // By start
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceChaptersResponse) Reset() {
+	*x = ReplaceChaptersResponse{}
+	mi := &file_v1_cms_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceChaptersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceChaptersResponse) ProtoMessage() {}
+
+func (x *ReplaceChaptersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceChaptersResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceChaptersResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReplaceChaptersResponse) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+type ImportChaptersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	ChaptersJson  string                 `protobuf:"bytes,2,opt,name=chapters_json,proto3" json:"chapters_json,omitempty"` // A Podcasting 2.0 JSON chapters document
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportChaptersRequest) Reset() {
+	*x = ImportChaptersRequest{}
+	mi := &file_v1_cms_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportChaptersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportChaptersRequest) ProtoMessage() {}
+
+func (x *ImportChaptersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportChaptersRequest.ProtoReflect.Descriptor instead.
+func (*ImportChaptersRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ImportChaptersRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *ImportChaptersRequest) GetChaptersJson() string {
+	if x != nil {
+		return x.ChaptersJson
+	}
+	return ""
+}
+
+type ImportChaptersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chapters      []*Chapter             `protobuf:"bytes,1,rep,name=chapters,proto3" json:"chapters,omitempty"` // By start
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportChaptersResponse) Reset() {
+	*x = ImportChaptersResponse{}
+	mi := &file_v1_cms_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportChaptersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportChaptersResponse) ProtoMessage() {}
+
+func (x *ImportChaptersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportChaptersResponse.ProtoReflect.Descriptor instead.
+func (*ImportChaptersResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ImportChaptersResponse) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+type Transcript struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EpisodeId     string                 `protobuf:"bytes,2,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	Format        TranscriptFormat       `protobuf:"varint,4,opt,name=format,proto3,enum=thmanyah.v1.TranscriptFormat" json:"format,omitempty"` // As uploaded
+	FileUrl       string                 `protobuf:"bytes,5,opt,name=file_url,proto3" json:"file_url,omitempty"`
+	CuesCount     int32                  `protobuf:"varint,6,opt,name=cues_count,proto3" json:"cues_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transcript) Reset() {
+	*x = Transcript{}
+	mi := &file_v1_cms_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transcript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transcript) ProtoMessage() {}
+
+func (x *Transcript) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transcript.ProtoReflect.Descriptor instead.
+func (*Transcript) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *Transcript) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transcript) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *Transcript) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Transcript) GetFormat() TranscriptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TranscriptFormat_TRANSCRIPT_FORMAT_UNSPECIFIED
+}
+
+func (x *Transcript) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *Transcript) GetCuesCount() int32 {
+	if x != nil {
+		return x.CuesCount
+	}
+	return 0
+}
+
+func (x *Transcript) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transcript) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type UploadTranscriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Format        TranscriptFormat       `protobuf:"varint,3,opt,name=format,proto3,enum=thmanyah.v1.TranscriptFormat" json:"format,omitempty"` // Detected when unspecified
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadTranscriptRequest) Reset() {
+	*x = UploadTranscriptRequest{}
+	mi := &file_v1_cms_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadTranscriptRequest) ProtoMessage() {}
+
+func (x *UploadTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*UploadTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *UploadTranscriptRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *UploadTranscriptRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UploadTranscriptRequest) GetFormat() TranscriptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TranscriptFormat_TRANSCRIPT_FORMAT_UNSPECIFIED
+}
+
+func (x *UploadTranscriptRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UploadTranscriptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transcript    *Transcript            `protobuf:"bytes,1,opt,name=transcript,proto3" json:"transcript,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadTranscriptResponse) Reset() {
+	*x = UploadTranscriptResponse{}
+	mi := &file_v1_cms_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadTranscriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadTranscriptResponse) ProtoMessage() {}
+
+func (x *UploadTranscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*UploadTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UploadTranscriptResponse) GetTranscript() *Transcript {
+	if x != nil {
+		return x.Transcript
+	}
+	return nil
+}
+
+type ListTranscriptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTranscriptsRequest) Reset() {
+	*x = ListTranscriptsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTranscriptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTranscriptsRequest) ProtoMessage() {}
+
+func (x *ListTranscriptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTranscriptsRequest.ProtoReflect.Descriptor instead.
+func (*ListTranscriptsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListTranscriptsRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+type ListTranscriptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transcripts   []*Transcript          `protobuf:"bytes,1,rep,name=transcripts,proto3" json:"transcripts,omitempty"` // By locale
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTranscriptsResponse) Reset() {
+	*x = ListTranscriptsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTranscriptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTranscriptsResponse) ProtoMessage() {}
+
+func (x *ListTranscriptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTranscriptsResponse.ProtoReflect.Descriptor instead.
+func (*ListTranscriptsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListTranscriptsResponse) GetTranscripts() []*Transcript {
+	if x != nil {
+		return x.Transcripts
+	}
+	return nil
+}
+
+type DeleteTranscriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTranscriptRequest) Reset() {
+	*x = DeleteTranscriptRequest{}
+	mi := &file_v1_cms_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTranscriptRequest) ProtoMessage() {}
+
+func (x *DeleteTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *DeleteTranscriptRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *DeleteTranscriptRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type Person struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	PhotoUrl      string                 `protobuf:"bytes,4,opt,name=photo_url,proto3" json:"photo_url,omitempty"`
+	Links         []*PersonLink          `protobuf:"bytes,5,rep,name=links,proto3" json:"links,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	mi := &file_v1_cms_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *Person) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Person) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Person) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Person) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *Person) GetLinks() []*PersonLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Person) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Person) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Person) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PersonLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonLink) Reset() {
+	*x = PersonLink{}
+	mi := &file_v1_cms_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonLink) ProtoMessage() {}
+
+func (x *PersonLink) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonLink.ProtoReflect.Descriptor instead.
+func (*PersonLink) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *PersonLink) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PersonLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// The links of a person, so an update can tell no links from leaving them alone
+type PersonLinks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*PersonLink          `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonLinks) Reset() {
+	*x = PersonLinks{}
+	mi := &file_v1_cms_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonLinks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonLinks) ProtoMessage() {}
+
+func (x *PersonLinks) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonLinks.ProtoReflect.Descriptor instead.
+func (*PersonLinks) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PersonLinks) GetLinks() []*PersonLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type Credit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PersonId      string                 `protobuf:"bytes,2,opt,name=person_id,proto3" json:"person_id,omitempty"`
+	ContentType   ContentType            `protobuf:"varint,3,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,4,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Role          CreditRole             `protobuf:"varint,5,opt,name=role,proto3,enum=thmanyah.v1.CreditRole" json:"role,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,6,opt,name=sort_order,proto3" json:"sort_order,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	Person        *Person                `protobuf:"bytes,9,opt,name=person,proto3" json:"person,omitempty"` // Only set when listing the credits of content
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Credit) Reset() {
+	*x = Credit{}
+	mi := &file_v1_cms_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Credit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credit) ProtoMessage() {}
+
+func (x *Credit) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credit.ProtoReflect.Descriptor instead.
+func (*Credit) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Credit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Credit) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+func (x *Credit) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *Credit) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *Credit) GetRole() CreditRole {
+	if x != nil {
+		return x.Role
+	}
+	return CreditRole_CREDIT_ROLE_UNSPECIFIED
+}
+
+func (x *Credit) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *Credit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Credit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Credit) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type CreatePersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	PhotoUrl      string                 `protobuf:"bytes,3,opt,name=photo_url,proto3" json:"photo_url,omitempty"`
+	Links         []*PersonLink          `protobuf:"bytes,4,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePersonRequest) Reset() {
+	*x = CreatePersonRequest{}
+	mi := &file_v1_cms_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePersonRequest) ProtoMessage() {}
+
+func (x *CreatePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePersonRequest.ProtoReflect.Descriptor instead.
+func (*CreatePersonRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CreatePersonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePersonRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *CreatePersonRequest) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *CreatePersonRequest) GetLinks() []*PersonLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type CreatePersonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePersonResponse) Reset() {
+	*x = CreatePersonResponse{}
+	mi := &file_v1_cms_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePersonResponse) ProtoMessage() {}
+
+func (x *CreatePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePersonResponse.ProtoReflect.Descriptor instead.
+func (*CreatePersonResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CreatePersonResponse) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type GetPersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PersonId      string                 `protobuf:"bytes,1,opt,name=person_id,proto3" json:"person_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonRequest) Reset() {
+	*x = GetPersonRequest{}
+	mi := &file_v1_cms_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonRequest) ProtoMessage() {}
+
+func (x *GetPersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetPersonRequest) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+type GetPersonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonResponse) Reset() {
+	*x = GetPersonResponse{}
+	mi := &file_v1_cms_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonResponse) ProtoMessage() {}
+
+func (x *GetPersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonResponse.ProtoReflect.Descriptor instead.
+func (*GetPersonResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetPersonResponse) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type ListPeopleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	SearchQuery   string                 `protobuf:"bytes,3,opt,name=search_query,proto3" json:"search_query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeopleRequest) Reset() {
+	*x = ListPeopleRequest{}
+	mi := &file_v1_cms_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeopleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeopleRequest) ProtoMessage() {}
+
+func (x *ListPeopleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeopleRequest.ProtoReflect.Descriptor instead.
+func (*ListPeopleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListPeopleRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPeopleRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPeopleRequest) GetSearchQuery() string {
+	if x != nil {
+		return x.SearchQuery
+	}
+	return ""
+}
+
+type ListPeopleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	People        []*Person              `protobuf:"bytes,1,rep,name=people,proto3" json:"people,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeopleResponse) Reset() {
+	*x = ListPeopleResponse{}
+	mi := &file_v1_cms_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeopleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeopleResponse) ProtoMessage() {}
+
+func (x *ListPeopleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeopleResponse.ProtoReflect.Descriptor instead.
+func (*ListPeopleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListPeopleResponse) GetPeople() []*Person {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+func (x *ListPeopleResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListPeopleResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPeopleResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type UpdatePersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PersonId      string                 `protobuf:"bytes,1,opt,name=person_id,proto3" json:"person_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Bio           *string                `protobuf:"bytes,3,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	PhotoUrl      *string                `protobuf:"bytes,4,opt,name=photo_url,proto3,oneof" json:"photo_url,omitempty"`
+	Links         *PersonLinks           `protobuf:"bytes,5,opt,name=links,proto3" json:"links,omitempty"` // Replaces all links when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePersonRequest) Reset() {
+	*x = UpdatePersonRequest{}
+	mi := &file_v1_cms_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePersonRequest) ProtoMessage() {}
+
+func (x *UpdatePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePersonRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePersonRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *UpdatePersonRequest) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+func (x *UpdatePersonRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePersonRequest) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *UpdatePersonRequest) GetPhotoUrl() string {
+	if x != nil && x.PhotoUrl != nil {
+		return *x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *UpdatePersonRequest) GetLinks() *PersonLinks {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type UpdatePersonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePersonResponse) Reset() {
+	*x = UpdatePersonResponse{}
+	mi := &file_v1_cms_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePersonResponse) ProtoMessage() {}
+
+func (x *UpdatePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePersonResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePersonResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *UpdatePersonResponse) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type DeletePersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PersonId      string                 `protobuf:"bytes,1,opt,name=person_id,proto3" json:"person_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePersonRequest) Reset() {
+	*x = DeletePersonRequest{}
+	mi := &file_v1_cms_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePersonRequest) ProtoMessage() {}
+
+func (x *DeletePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePersonRequest.ProtoReflect.Descriptor instead.
+func (*DeletePersonRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *DeletePersonRequest) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+type CreateCreditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PersonId      string                 `protobuf:"bytes,1,opt,name=person_id,proto3" json:"person_id,omitempty"`
+	ContentType   ContentType            `protobuf:"varint,2,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,3,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Role          CreditRole             `protobuf:"varint,4,opt,name=role,proto3,enum=thmanyah.v1.CreditRole" json:"role,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCreditRequest) Reset() {
+	*x = CreateCreditRequest{}
+	mi := &file_v1_cms_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCreditRequest) ProtoMessage() {}
+
+func (x *CreateCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCreditRequest.ProtoReflect.Descriptor instead.
+func (*CreateCreditRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *CreateCreditRequest) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+func (x *CreateCreditRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateCreditRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *CreateCreditRequest) GetRole() CreditRole {
+	if x != nil {
+		return x.Role
+	}
+	return CreditRole_CREDIT_ROLE_UNSPECIFIED
+}
+
+func (x *CreateCreditRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type CreateCreditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credit        *Credit                `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCreditResponse) Reset() {
+	*x = CreateCreditResponse{}
+	mi := &file_v1_cms_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCreditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCreditResponse) ProtoMessage() {}
+
+func (x *CreateCreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCreditResponse.ProtoReflect.Descriptor instead.
+func (*CreateCreditResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *CreateCreditResponse) GetCredit() *Credit {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+type ListCreditsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCreditsRequest) Reset() {
+	*x = ListCreditsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCreditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreditsRequest) ProtoMessage() {}
+
+func (x *ListCreditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreditsRequest.ProtoReflect.Descriptor instead.
+func (*ListCreditsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListCreditsRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *ListCreditsRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+type ListCreditsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credits       []*Credit              `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"` // By sort order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCreditsResponse) Reset() {
+	*x = ListCreditsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCreditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreditsResponse) ProtoMessage() {}
+
+func (x *ListCreditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreditsResponse.ProtoReflect.Descriptor instead.
+func (*ListCreditsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListCreditsResponse) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+type UpdateCreditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreditId      string                 `protobuf:"bytes,1,opt,name=credit_id,proto3" json:"credit_id,omitempty"`
+	Role          *CreditRole            `protobuf:"varint,2,opt,name=role,proto3,enum=thmanyah.v1.CreditRole,oneof" json:"role,omitempty"`
+	SortOrder     *int32                 `protobuf:"varint,3,opt,name=sort_order,proto3,oneof" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCreditRequest) Reset() {
+	*x = UpdateCreditRequest{}
+	mi := &file_v1_cms_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCreditRequest) ProtoMessage() {}
+
+func (x *UpdateCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCreditRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCreditRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *UpdateCreditRequest) GetCreditId() string {
+	if x != nil {
+		return x.CreditId
+	}
+	return ""
+}
+
+func (x *UpdateCreditRequest) GetRole() CreditRole {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return CreditRole_CREDIT_ROLE_UNSPECIFIED
+}
+
+func (x *UpdateCreditRequest) GetSortOrder() int32 {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return 0
+}
+
+type UpdateCreditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credit        *Credit                `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCreditResponse) Reset() {
+	*x = UpdateCreditResponse{}
+	mi := &file_v1_cms_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCreditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCreditResponse) ProtoMessage() {}
+
+func (x *UpdateCreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCreditResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCreditResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *UpdateCreditResponse) GetCredit() *Credit {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+type DeleteCreditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreditId      string                 `protobuf:"bytes,1,opt,name=credit_id,proto3" json:"credit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCreditRequest) Reset() {
+	*x = DeleteCreditRequest{}
+	mi := &file_v1_cms_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCreditRequest) ProtoMessage() {}
+
+func (x *DeleteCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCreditRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCreditRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *DeleteCreditRequest) GetCreditId() string {
+	if x != nil {
+		return x.CreditId
+	}
+	return ""
+}
+
+type ListCreditsByPersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PersonId      string                 `protobuf:"bytes,1,opt,name=person_id,proto3" json:"person_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCreditsByPersonRequest) Reset() {
+	*x = ListCreditsByPersonRequest{}
+	mi := &file_v1_cms_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCreditsByPersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreditsByPersonRequest) ProtoMessage() {}
+
+func (x *ListCreditsByPersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreditsByPersonRequest.ProtoReflect.Descriptor instead.
+func (*ListCreditsByPersonRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListCreditsByPersonRequest) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+func (x *ListCreditsByPersonRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCreditsByPersonRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCreditsByPersonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credits       []*Credit              `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"` // Most recently added first
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCreditsByPersonResponse) Reset() {
+	*x = ListCreditsByPersonResponse{}
+	mi := &file_v1_cms_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCreditsByPersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreditsByPersonResponse) ProtoMessage() {}
+
+func (x *ListCreditsByPersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreditsByPersonResponse.ProtoReflect.Descriptor instead.
+func (*ListCreditsByPersonResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListCreditsByPersonResponse) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *ListCreditsByPersonResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListCreditsByPersonResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCreditsByPersonResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CheckAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   ContentType            `protobuf:"varint,1,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"` // ISO 3166-1 alpha-2
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAvailabilityRequest) Reset() {
+	*x = CheckAvailabilityRequest{}
+	mi := &file_v1_cms_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAvailabilityRequest) ProtoMessage() {}
+
+func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *CheckAvailabilityRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *CheckAvailabilityRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *CheckAvailabilityRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type CheckAvailabilityResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Available      bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	ContentType    ContentType            `protobuf:"varint,2,opt,name=content_type,proto3,enum=thmanyah.v1.ContentType" json:"content_type,omitempty"`
+	ContentId      string                 `protobuf:"bytes,3,opt,name=content_id,proto3" json:"content_id,omitempty"`
+	Country        string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"` // Upper-cased; empty when none was given
+	CheckedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=checked_at,proto3" json:"checked_at,omitempty"`
+	Reasons        []UnavailableReason    `protobuf:"varint,6,rep,packed,name=reasons,proto3,enum=thmanyah.v1.UnavailableReason" json:"reasons,omitempty"`
+	ProgramReasons []UnavailableReason    `protobuf:"varint,7,rep,packed,name=program_reasons,proto3,enum=thmanyah.v1.UnavailableReason" json:"program_reasons,omitempty"` // Episodes only: why their program is unavailable
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckAvailabilityResponse) Reset() {
+	*x = CheckAvailabilityResponse{}
+	mi := &file_v1_cms_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAvailabilityResponse) ProtoMessage() {}
+
+func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *CheckAvailabilityResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *CheckAvailabilityResponse) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *CheckAvailabilityResponse) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *CheckAvailabilityResponse) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CheckAvailabilityResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityResponse) GetReasons() []UnavailableReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityResponse) GetProgramReasons() []UnavailableReason {
+	if x != nil {
+		return x.ProgramReasons
+	}
+	return nil
+}
+
+type DeleteEpisodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEpisodeRequest) Reset() {
+	*x = DeleteEpisodeRequest{}
+	mi := &file_v1_cms_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEpisodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEpisodeRequest) ProtoMessage() {}
+
+func (x *DeleteEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *DeleteEpisodeRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+type GetEpisodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodeRequest) Reset() {
+	*x = GetEpisodeRequest{}
+	mi := &file_v1_cms_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeRequest) ProtoMessage() {}
+
+func (x *GetEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetEpisodeRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+type GetEpisodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episode       *Episode               `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodeResponse) Reset() {
+	*x = GetEpisodeResponse{}
+	mi := &file_v1_cms_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeResponse) ProtoMessage() {}
+
+func (x *GetEpisodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeResponse.ProtoReflect.Descriptor instead.
+func (*GetEpisodeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GetEpisodeResponse) GetEpisode() *Episode {
+	if x != nil {
+		return x.Episode
+	}
+	return nil
+}
+
+type ListEpisodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     string                 `protobuf:"bytes,1,opt,name=program_id,proto3" json:"program_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	Status        EpisodeStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=thmanyah.v1.EpisodeStatus" json:"status,omitempty"`
+	SearchQuery   string                 `protobuf:"bytes,5,opt,name=search_query,proto3" json:"search_query,omitempty"`
+	SeasonNumber  int32                  `protobuf:"varint,6,opt,name=season_number,proto3" json:"season_number,omitempty"`
+	SortBy        string                 `protobuf:"bytes,7,opt,name=sort_by,proto3" json:"sort_by,omitempty"`
+	SortOrder     string                 `protobuf:"bytes,8,opt,name=sort_order,proto3" json:"sort_order,omitempty"`
+	SeasonId      string                 `protobuf:"bytes,9,opt,name=season_id,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEpisodesRequest) Reset() {
+	*x = ListEpisodesRequest{}
+	mi := &file_v1_cms_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpisodesRequest) ProtoMessage() {}
+
+func (x *ListEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*ListEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ListEpisodesRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *ListEpisodesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEpisodesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEpisodesRequest) GetStatus() EpisodeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EpisodeStatus_EPISODE_STATUS_DRAFT
+}
+
+func (x *ListEpisodesRequest) GetSearchQuery() string {
+	if x != nil {
+		return x.SearchQuery
+	}
+	return ""
+}
+
+func (x *ListEpisodesRequest) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *ListEpisodesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListEpisodesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListEpisodesRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+type ListEpisodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episodes      []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEpisodesResponse) Reset() {
+	*x = ListEpisodesResponse{}
+	mi := &file_v1_cms_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpisodesResponse) ProtoMessage() {}
+
+func (x *ListEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*ListEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ListEpisodesResponse) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+func (x *ListEpisodesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListEpisodesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEpisodesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type BatchGetEpisodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeIds    []string               `protobuf:"bytes,1,rep,name=episode_ids,proto3" json:"episode_ids,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"` // Discover only: overrides Accept-Language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetEpisodesRequest) Reset() {
+	*x = BatchGetEpisodesRequest{}
+	mi := &file_v1_cms_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetEpisodesRequest) ProtoMessage() {}
+
+func (x *BatchGetEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *BatchGetEpisodesRequest) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+func (x *BatchGetEpisodesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type BatchGetEpisodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Episodes      []*Episode             `protobuf:"bytes,1,rep,name=episodes,proto3" json:"episodes,omitempty"` // In request order
+	NotFoundIds   []string               `protobuf:"bytes,2,rep,name=not_found_ids,proto3" json:"not_found_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetEpisodesResponse) Reset() {
+	*x = BatchGetEpisodesResponse{}
+	mi := &file_v1_cms_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetEpisodesResponse) ProtoMessage() {}
+
+func (x *BatchGetEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *BatchGetEpisodesResponse) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+func (x *BatchGetEpisodesResponse) GetNotFoundIds() []string {
+	if x != nil {
+		return x.NotFoundIds
+	}
+	return nil
+}
+
+type ImportDataRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SourceType        string                 `protobuf:"bytes,1,opt,name=source_type,proto3" json:"source_type,omitempty"` // youtube, rss, json, csv
+	SourceUrl         string                 `protobuf:"bytes,2,opt,name=source_url,proto3" json:"source_url,omitempty"`
+	SourceConfig      map[string]string      `protobuf:"bytes,3,rep,name=source_config,proto3" json:"source_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // API keys, credentials, etc.
+	DefaultCategoryId string                 `protobuf:"bytes,4,opt,name=default_category_id,proto3" json:"default_category_id,omitempty"`
+	DryRun            bool                   `protobuf:"varint,5,opt,name=dry_run,proto3" json:"dry_run,omitempty"`                                                                                      // If true, validate but don't import
+	FieldMapping      map[string]string      `protobuf:"bytes,6,rep,name=field_mapping,proto3" json:"field_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Map source fields to our fields
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ImportDataRequest) Reset() {
+	*x = ImportDataRequest{}
+	mi := &file_v1_cms_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDataRequest) ProtoMessage() {}
+
+func (x *ImportDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDataRequest.ProtoReflect.Descriptor instead.
+func (*ImportDataRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ImportDataRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *ImportDataRequest) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *ImportDataRequest) GetSourceConfig() map[string]string {
+	if x != nil {
+		return x.SourceConfig
+	}
+	return nil
+}
+
+func (x *ImportDataRequest) GetDefaultCategoryId() string {
+	if x != nil {
+		return x.DefaultCategoryId
+	}
+	return ""
+}
+
+func (x *ImportDataRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportDataRequest) GetFieldMapping() map[string]string {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+type ImportDataResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ImportId       string                 `protobuf:"bytes,1,opt,name=import_id,proto3" json:"import_id,omitempty"`
+	Status         ImportStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=thmanyah.v1.ImportStatus" json:"status,omitempty"`
+	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	TotalItems     int32                  `protobuf:"varint,4,opt,name=total_items,proto3" json:"total_items,omitempty"`
+	ProcessedItems int32                  `protobuf:"varint,5,opt,name=processed_items,proto3" json:"processed_items,omitempty"`
+	SuccessCount   int32                  `protobuf:"varint,6,opt,name=success_count,proto3" json:"success_count,omitempty"`
+	ErrorCount     int32                  `protobuf:"varint,7,opt,name=error_count,proto3" json:"error_count,omitempty"`
+	Warnings       []string               `protobuf:"bytes,9,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportDataResponse) Reset() {
+	*x = ImportDataResponse{}
+	mi := &file_v1_cms_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDataResponse) ProtoMessage() {}
+
+func (x *ImportDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDataResponse.ProtoReflect.Descriptor instead.
+func (*ImportDataResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ImportDataResponse) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *ImportDataResponse) GetStatus() ImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportStatus_IMPORT_STATUS_PENDING
+}
+
+func (x *ImportDataResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportDataResponse) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ImportDataResponse) GetProcessedItems() int32 {
+	if x != nil {
+		return x.ProcessedItems
+	}
+	return 0
+}
+
+func (x *ImportDataResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *ImportDataResponse) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *ImportDataResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type WatchImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImportId      string                 `protobuf:"bytes,1,opt,name=import_id,proto3" json:"import_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchImportRequest) Reset() {
+	*x = WatchImportRequest{}
+	mi := &file_v1_cms_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchImportRequest) ProtoMessage() {}
+
+func (x *WatchImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchImportRequest.ProtoReflect.Descriptor instead.
+func (*WatchImportRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *WatchImportRequest) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+type ImportEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           ImportEventType        `protobuf:"varint,1,opt,name=type,proto3,enum=thmanyah.v1.ImportEventType" json:"type,omitempty"`
+	ImportId       string                 `protobuf:"bytes,2,opt,name=import_id,proto3" json:"import_id,omitempty"`
+	Status         ImportStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=thmanyah.v1.ImportStatus" json:"status,omitempty"`
+	TotalItems     int32                  `protobuf:"varint,4,opt,name=total_items,proto3" json:"total_items,omitempty"`
+	ProcessedItems int32                  `protobuf:"varint,5,opt,name=processed_items,proto3" json:"processed_items,omitempty"`
+	SuccessCount   int32                  `protobuf:"varint,6,opt,name=success_count,proto3" json:"success_count,omitempty"`
+	ErrorCount     int32                  `protobuf:"varint,7,opt,name=error_count,proto3" json:"error_count,omitempty"`
+	Message        string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"` // The warning or error text
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportEvent) Reset() {
+	*x = ImportEvent{}
+	mi := &file_v1_cms_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEvent) ProtoMessage() {}
+
+func (x *ImportEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEvent.ProtoReflect.Descriptor instead.
+func (*ImportEvent) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ImportEvent) GetType() ImportEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ImportEventType_IMPORT_EVENT_TYPE_PROGRESS
+}
+
+func (x *ImportEvent) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *ImportEvent) GetStatus() ImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportStatus_IMPORT_STATUS_PENDING
+}
+
+func (x *ImportEvent) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ImportEvent) GetProcessedItems() int32 {
+	if x != nil {
+		return x.ProcessedItems
+	}
+	return 0
+}
+
+func (x *ImportEvent) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *ImportEvent) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *ImportEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportEvent) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type BulkUpdateProgramsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramIds    []string               `protobuf:"bytes,1,rep,name=program_ids,proto3" json:"program_ids,omitempty"`
+	Status        ProgramStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=thmanyah.v1.ProgramStatus" json:"status,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,proto3" json:"category_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IsFeatured    bool                   `protobuf:"varint,6,opt,name=is_featured,proto3" json:"is_featured,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateProgramsRequest) Reset() {
+	*x = BulkUpdateProgramsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateProgramsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateProgramsRequest) ProtoMessage() {}
+
+func (x *BulkUpdateProgramsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateProgramsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateProgramsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *BulkUpdateProgramsRequest) GetProgramIds() []string {
+	if x != nil {
+		return x.ProgramIds
+	}
+	return nil
+}
+
+func (x *BulkUpdateProgramsRequest) GetStatus() ProgramStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProgramStatus_PROGRAM_STATUS_DRAFT
+}
+
+func (x *BulkUpdateProgramsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *BulkUpdateProgramsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BulkUpdateProgramsRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BulkUpdateProgramsRequest) GetIsFeatured() bool {
+	if x != nil {
+		return x.IsFeatured
+	}
+	return false
+}
+
+type BulkUpdateProgramsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedCount  int32                  `protobuf:"varint,1,opt,name=updated_count,proto3" json:"updated_count,omitempty"`
+	FailedCount   int32                  `protobuf:"varint,2,opt,name=failed_count,proto3" json:"failed_count,omitempty"`
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateProgramsResponse) Reset() {
+	*x = BulkUpdateProgramsResponse{}
+	mi := &file_v1_cms_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateProgramsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateProgramsResponse) ProtoMessage() {}
+
+func (x *BulkUpdateProgramsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateProgramsResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateProgramsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *BulkUpdateProgramsResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *BulkUpdateProgramsResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *BulkUpdateProgramsResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type BulkDeleteProgramsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramIds    []string               `protobuf:"bytes,1,rep,name=program_ids,proto3" json:"program_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteProgramsRequest) Reset() {
+	*x = BulkDeleteProgramsRequest{}
+	mi := &file_v1_cms_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteProgramsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteProgramsRequest) ProtoMessage() {}
+
+func (x *BulkDeleteProgramsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteProgramsRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteProgramsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *BulkDeleteProgramsRequest) GetProgramIds() []string {
+	if x != nil {
+		return x.ProgramIds
+	}
+	return nil
+}
+
+type PaginationMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaginationMetadata) Reset() {
+	*x = PaginationMetadata{}
+	mi := &file_v1_cms_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaginationMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationMetadata) ProtoMessage() {}
+
+func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
+func (*PaginationMetadata) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *PaginationMetadata) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PaginationMetadata) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PaginationMetadata) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *PaginationMetadata) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type SortOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortOptions) Reset() {
+	*x = SortOptions{}
+	mi := &file_v1_cms_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortOptions) ProtoMessage() {}
+
+func (x *SortOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortOptions.ProtoReflect.Descriptor instead.
+func (*SortOptions) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *SortOptions) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SortOptions) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type FilterOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filters       map[string]*anypb.Any  `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterOptions) Reset() {
+	*x = FilterOptions{}
+	mi := &file_v1_cms_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterOptions) ProtoMessage() {}
+
+func (x *FilterOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterOptions.ProtoReflect.Descriptor instead.
+func (*FilterOptions) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *FilterOptions) GetFilters() map[string]*anypb.Any {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type EpisodeFileUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileUrl       string                 `protobuf:"bytes,1,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EpisodeFileUpdateResponse) Reset() {
+	*x = EpisodeFileUpdateResponse{}
+	mi := &file_v1_cms_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EpisodeFileUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpisodeFileUpdateResponse) ProtoMessage() {}
+
+func (x *EpisodeFileUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_cms_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpisodeFileUpdateResponse.ProtoReflect.Descriptor instead.
+func (*EpisodeFileUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_v1_cms_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *EpisodeFileUpdateResponse) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+var File_v1_cms_proto protoreflect.FileDescriptor
+
+const file_v1_cms_proto_rawDesc = "" +
+	"\n" +
+	"\fv1/cms.proto\x12\vthmanyah.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x19google/protobuf/any.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1copenapi/v3/annotations.proto\"\xbc\x03\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12-\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x19.thmanyah.v1.CategoryTypeR\x04type\x12:\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"created_at\x12:\n" +
@@ -3069,10 +10775,11 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\a \x01(\tR\n" +
 	"created_by\x12?\n" +
-	"\bmetadata\x18\b \x03(\v2#.thmanyah.v1.Category.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\b \x03(\v2#.thmanyah.v1.Category.MetadataEntryR\bmetadata\x12\x1c\n" +
+	"\tparent_id\x18\t \x01(\tR\tparent_id\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9e\x06\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd3\a\n" +
 	"\aProgram\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\x05title\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05title\x12 \n" +
@@ -3104,11 +10811,14 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\n" +
 	"view_count\x18\x11 \x01(\x05R\n" +
 	"view_count\x12\x16\n" +
-	"\x06rating\x18\x12 \x01(\x01R\x06rating\x1a;\n" +
+	"\x06rating\x18\x12 \x01(\x01R\x06rating\x12\"\n" +
+	"\fcategory_ids\x18\x13 \x03(\tR\fcategory_ids\x12=\n" +
+	"\favailability\x18\x14 \x01(\v2\x19.thmanyah.v1.AvailabilityR\favailability\x12P\n" +
+	"\x13unavailable_reasons\x18\x15 \x03(\x0e2\x1e.thmanyah.v1.UnavailableReasonR\x13unavailable_reasons\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
-	"\v_source_url\"\x91\a\n" +
+	"\v_source_url\"\xf2\b\n" +
 	"\aEpisode\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\n" +
@@ -3142,10 +10852,19 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\n" +
 	"view_count\x18\x13 \x01(\x05R\n" +
 	"view_count\x12\x16\n" +
-	"\x06rating\x18\x14 \x01(\x01R\x06rating\x1a;\n" +
+	"\x06rating\x18\x14 \x01(\x01R\x06rating\x12\x1c\n" +
+	"\tseason_id\x18\x15 \x01(\tR\tseason_id\x120\n" +
+	"\bchapters\x18\x16 \x03(\v2\x14.thmanyah.v1.ChapterR\bchapters\x12=\n" +
+	"\favailability\x18\x17 \x01(\v2\x19.thmanyah.v1.AvailabilityR\favailability\x12P\n" +
+	"\x13unavailable_reasons\x18\x18 \x03(\x0e2\x1e.thmanyah.v1.UnavailableReasonR\x13unavailable_reasons\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x88\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x92\x02\n" +
+	"\fAvailability\x126\n" +
+	"\bstart_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bstart_at\x122\n" +
+	"\x06end_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06end_at\x12J\n" +
+	"\x11allowed_countries\x18\x03 \x03(\tB\x1c\xfaB\x19\x92\x01\x16\x10\xfa\x01\"\x11r\x0f2\r^[A-Za-z]{2}$R\x11allowed_countries\x12J\n" +
+	"\x11blocked_countries\x18\x04 \x03(\tB\x1c\xfaB\x19\x92\x01\x16\x10\xfa\x01\"\x11r\x0f2\r^[A-Za-z]{2}$R\x11blocked_countries\"\xfc\x03\n" +
 	"\x14CreateProgramRequest\x12\x1d\n" +
 	"\x05title\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12)\n" +
@@ -3156,12 +10875,15 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\n" +
 	"source_url\x18\a \x01(\tR\n" +
 	"source_url\x12 \n" +
-	"\vis_featured\x18\b \x01(\bR\vis_featured\x1a;\n" +
+	"\vis_featured\x18\b \x01(\bR\vis_featured\x123\n" +
+	"\fcategory_ids\x18\t \x03(\tB\x0f\xfaB\f\x92\x01\t\x10\x14\"\x05r\x03\xb0\x01\x01R\fcategory_ids\x12=\n" +
+	"\favailability\x18\n" +
+	" \x01(\v2\x19.thmanyah.v1.AvailabilityR\favailability\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"G\n" +
 	"\x15CreateProgramResponse\x12.\n" +
-	"\aprogram\x18\x01 \x01(\v2\x14.thmanyah.v1.ProgramR\aprogram\"\xdc\x04\n" +
+	"\aprogram\x18\x01 \x01(\v2\x14.thmanyah.v1.ProgramR\aprogram\"\x9b\x05\n" +
 	"\x14UpdateProgramRequest\x12'\n" +
 	"\n" +
 	"program_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -3177,7 +10899,8 @@ const file_v1_cms_proto_rawDesc = "" +
 	"source_url\x18\t \x01(\tH\x05R\n" +
 	"source_url\x88\x01\x01\x12%\n" +
 	"\vis_featured\x18\n" +
-	" \x01(\bH\x06R\vis_featured\x88\x01\x01\x1a;\n" +
+	" \x01(\bH\x06R\vis_featured\x88\x01\x01\x12=\n" +
+	"\favailability\x18\v \x01(\v2\x19.thmanyah.v1.AvailabilityR\favailability\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
@@ -3216,12 +10939,20 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\bprograms\x18\x01 \x03(\v2\x14.thmanyah.v1.ProgramR\bprograms\x12 \n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\vtotal_count\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1c\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"\x90\x02\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"\x90\x01\n" +
+	"\x17BatchGetProgramsRequest\x12,\n" +
+	"\vprogram_ids\x18\x01 \x03(\tB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10dR\vprogram_ids\x12G\n" +
+	"\x06locale\x18\x02 \x01(\tB/\xfaB,r*\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\x06locale\"r\n" +
+	"\x18BatchGetProgramsResponse\x120\n" +
+	"\bprograms\x18\x01 \x03(\v2\x14.thmanyah.v1.ProgramR\bprograms\x12$\n" +
+	"\rnot_found_ids\x18\x02 \x03(\tR\rnot_found_ids\"\xbb\x02\n" +
 	"\x15CreateCategoryRequest\x12\x1b\n" +
 	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12-\n" +
 	"\x04type\x18\x03 \x01(\x0e2\x19.thmanyah.v1.CategoryTypeR\x04type\x12L\n" +
-	"\bmetadata\x18\x04 \x03(\v20.thmanyah.v1.CreateCategoryRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x04 \x03(\v20.thmanyah.v1.CreateCategoryRequest.MetadataEntryR\bmetadata\x12)\n" +
+	"\tparent_id\x18\x05 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\tparent_id\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"K\n" +
@@ -3259,7 +10990,16 @@ const file_v1_cms_proto_rawDesc = "" +
 	"categories\x12 \n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\vtotal_count\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1c\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"\xf7\x03\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"\x94\x01\n" +
+	"\x19BatchGetCategoriesRequest\x12.\n" +
+	"\fcategory_ids\x18\x01 \x03(\tB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10dR\fcategory_ids\x12G\n" +
+	"\x06locale\x18\x02 \x01(\tB/\xfaB,r*\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\x06locale\"y\n" +
+	"\x1aBatchGetCategoriesResponse\x125\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x15.thmanyah.v1.CategoryR\n" +
+	"categories\x12$\n" +
+	"\rnot_found_ids\x18\x02 \x03(\tR\rnot_found_ids\"\xb6\x04\n" +
 	"\x14CreateEpisodeRequest\x12'\n" +
 	"\n" +
 	"program_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -3273,12 +11013,13 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\rthumbnail_url\x18\b \x01(\tR\rthumbnail_url\x12\x12\n" +
 	"\x04tags\x18\t \x03(\tR\x04tags\x12K\n" +
 	"\bmetadata\x18\n" +
-	" \x03(\v2/.thmanyah.v1.CreateEpisodeRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	" \x03(\v2/.thmanyah.v1.CreateEpisodeRequest.MetadataEntryR\bmetadata\x12=\n" +
+	"\favailability\x18\v \x01(\v2\x19.thmanyah.v1.AvailabilityR\favailability\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"G\n" +
 	"\x15CreateEpisodeResponse\x12.\n" +
-	"\aepisode\x18\x01 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\"\x84\x06\n" +
+	"\aepisode\x18\x01 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\"\xc3\x06\n" +
 	"\x14UpdateEpisodeRequest\x12'\n" +
 	"\n" +
 	"episode_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -3294,7 +11035,8 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\x04tags\x18\n" +
 	" \x03(\tR\x04tags\x12K\n" +
 	"\bmetadata\x18\v \x03(\v2/.thmanyah.v1.UpdateEpisodeRequest.MetadataEntryR\bmetadata\x12C\n" +
-	"\fscheduled_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\bR\fscheduled_at\x88\x01\x01\x1a;\n" +
+	"\fscheduled_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\bR\fscheduled_at\x88\x01\x01\x12=\n" +
+	"\favailability\x18\r \x01(\v2\x19.thmanyah.v1.AvailabilityR\favailability\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
@@ -3309,7 +11051,664 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\x0e_thumbnail_urlB\x0f\n" +
 	"\r_scheduled_at\"G\n" +
 	"\x15UpdateEpisodeResponse\x12.\n" +
-	"\aepisode\x18\x01 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\"?\n" +
+	"\aepisode\x18\x01 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\"\x8f\x01\n" +
+	"\x18RescheduleEpisodeRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"episode_id\x12J\n" +
+	"\fscheduled_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\n" +
+	"\xfaB\a\xb2\x01\x04\b\x01@\x01R\fscheduled_at\"K\n" +
+	"\x19RescheduleEpisodeResponse\x12.\n" +
+	"\aepisode\x18\x01 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\"G\n" +
+	"\x1cCancelEpisodeScheduleRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"episode_id\"O\n" +
+	"\x1dCancelEpisodeScheduleResponse\x12.\n" +
+	"\aepisode\x18\x01 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\"\xca\x02\n" +
+	"\x10StatusTransition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12<\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x18.thmanyah.v1.ContentTypeR\fcontent_type\x12\x1e\n" +
+	"\n" +
+	"content_id\x18\x03 \x01(\tR\n" +
+	"content_id\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12 \n" +
+	"\vfrom_status\x18\x05 \x01(\tR\vfrom_status\x12\x1c\n" +
+	"\tto_status\x18\x06 \x01(\tR\tto_status\x12\x1a\n" +
+	"\bactor_id\x18\a \x01(\tR\bactor_id\x12\x18\n" +
+	"\acomment\x18\b \x01(\tR\acomment\x12:\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"\xb0\x01\n" +
+	"\x16SubmitForReviewRequest\x12H\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\x12\"\n" +
+	"\acomment\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fR\acomment\"\xa8\x01\n" +
+	"\x0eApproveRequest\x12H\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\x12\"\n" +
+	"\acomment\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fR\acomment\"\xa9\x01\n" +
+	"\rRejectRequest\x12H\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\x12$\n" +
+	"\acomment\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xd0\x0fR\acomment\"\xaf\x01\n" +
+	"\x0eReviewResponse\x12.\n" +
+	"\aprogram\x18\x01 \x01(\v2\x14.thmanyah.v1.ProgramR\aprogram\x12.\n" +
+	"\aepisode\x18\x02 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\x12=\n" +
+	"\n" +
+	"transition\x18\x03 \x01(\v2\x1d.thmanyah.v1.StatusTransitionR\n" +
+	"transition\"\x92\x01\n" +
+	"\x1cListStatusTransitionsRequest\x12H\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\"`\n" +
+	"\x1dListStatusTransitionsResponse\x12?\n" +
+	"\vtransitions\x18\x01 \x03(\v2\x1d.thmanyah.v1.StatusTransitionR\vtransitions\"\x86\x03\n" +
+	"\bRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12<\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x18.thmanyah.v1.ContentTypeR\fcontent_type\x12\x1e\n" +
+	"\n" +
+	"content_id\x18\x03 \x01(\tR\n" +
+	"content_id\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x123\n" +
+	"\bsnapshot\x18\x05 \x01(\v2\x17.google.protobuf.StructR\bsnapshot\x12&\n" +
+	"\x0echanged_fields\x18\x06 \x03(\tR\x0echanged_fields\x12\x1c\n" +
+	"\tauthor_id\x18\a \x01(\tR\tauthor_id\x12)\n" +
+	"\rrestored_from\x18\b \x01(\x05H\x00R\rrestored_from\x88\x01\x01\x12:\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_atB\x10\n" +
+	"\x0e_restored_from\"w\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12*\n" +
+	"\x04from\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x04from\x12&\n" +
+	"\x02to\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x02to\"\xc5\x01\n" +
+	"\x14ListRevisionsRequest\x12H\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12%\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02\x18dR\tpage_size\"\xa0\x01\n" +
+	"\x15ListRevisionsResponse\x123\n" +
+	"\trevisions\x18\x01 \x03(\v2\x15.thmanyah.v1.RevisionR\trevisions\x12 \n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\vtotal_count\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1c\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"@\n" +
+	"\x12GetRevisionRequest\x12*\n" +
+	"\vrevision_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\vrevision_id\"H\n" +
+	"\x13GetRevisionResponse\x121\n" +
+	"\brevision\x18\x01 \x01(\v2\x15.thmanyah.v1.RevisionR\brevision\"~\n" +
+	"\x14DiffRevisionsRequest\x124\n" +
+	"\x10from_revision_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x10from_revision_id\x120\n" +
+	"\x0eto_revision_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x0eto_revision_id\"\x8f\x01\n" +
+	"\x15DiffRevisionsResponse\x12\"\n" +
+	"\ffrom_version\x18\x01 \x01(\x05R\ffrom_version\x12\x1e\n" +
+	"\n" +
+	"to_version\x18\x02 \x01(\x05R\n" +
+	"to_version\x122\n" +
+	"\achanges\x18\x03 \x03(\v2\x18.thmanyah.v1.FieldChangeR\achanges\"D\n" +
+	"\x16RestoreRevisionRequest\x12*\n" +
+	"\vrevision_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\vrevision_id\"\xac\x01\n" +
+	"\x17RestoreRevisionResponse\x12.\n" +
+	"\aprogram\x18\x01 \x01(\v2\x14.thmanyah.v1.ProgramR\aprogram\x12.\n" +
+	"\aepisode\x18\x02 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\x121\n" +
+	"\brevision\x18\x03 \x01(\v2\x15.thmanyah.v1.RevisionR\brevision\"\xab\x01\n" +
+	"\tTrashItem\x12<\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeR\fcontent_type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12:\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deleted_at\"\x95\x01\n" +
+	"\x10ListTrashRequest\x12F\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\fcontent_type\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12%\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02\x18dR\tpage_size\"\x95\x01\n" +
+	"\x11ListTrashResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.thmanyah.v1.TrashItemR\x05items\x12 \n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\vtotal_count\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1c\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"\x8d\x01\n" +
+	"\x17RestoreFromTrashRequest\x12H\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\"\xad\x01\n" +
+	"\x18RestoreFromTrashResponse\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.thmanyah.v1.CategoryR\bcategory\x12.\n" +
+	"\aprogram\x18\x02 \x01(\v2\x14.thmanyah.v1.ProgramR\aprogram\x12.\n" +
+	"\aepisode\x18\x03 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\"\x99\x01\n" +
+	"\x11PurgeTrashRequest\x12F\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\fcontent_type\x12-\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\n" +
+	"content_id\x88\x01\x01B\r\n" +
+	"\v_content_id\"\x96\x01\n" +
+	"\x12PurgeTrashResponse\x12,\n" +
+	"\x11categories_purged\x18\x01 \x01(\x05R\x11categories_purged\x12(\n" +
+	"\x0fprograms_purged\x18\x02 \x01(\x05R\x0fprograms_purged\x12(\n" +
+	"\x0fepisodes_purged\x18\x03 \x01(\x05R\x0fepisodes_purged\"\xf8\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bactor_id\x18\x02 \x01(\tR\bactor_id\x12&\n" +
+	"\x0eprincipal_type\x18\x03 \x01(\tR\x0eprincipal_type\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12 \n" +
+	"\ventity_type\x18\x05 \x01(\tR\ventity_type\x12\x1c\n" +
+	"\tentity_id\x18\x06 \x01(\tR\tentity_id\x12/\n" +
+	"\x06before\x18\a \x01(\v2\x17.google.protobuf.StructR\x06before\x12-\n" +
+	"\x05after\x18\b \x01(\v2\x17.google.protobuf.StructR\x05after\x12\x1e\n" +
+	"\n" +
+	"ip_address\x18\t \x01(\tR\n" +
+	"ip_address\x12\x1e\n" +
+	"\n" +
+	"user_agent\x18\n" +
+	" \x01(\tR\n" +
+	"user_agent\x12\x1e\n" +
+	"\n" +
+	"request_id\x18\v \x01(\tR\n" +
+	"request_id\x12\x18\n" +
+	"\aoutcome\x18\f \x01(\tR\aoutcome\x12\"\n" +
+	"\ferror_reason\x18\r \x01(\tR\ferror_reason\x12:\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"\xe3\x04\n" +
+	"\x16ListAuditEventsRequest\x12'\n" +
+	"\bactor_id\x18\x01 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\bactor_id\x12H\n" +
+	"\x0eprincipal_type\x18\x02 \x01(\tB \xfaB\x1dr\x1bR\x00R\tanonymousR\x04userR\x06systemR\x0eprincipal_type\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\x12\xa0\x01\n" +
+	"\ventity_type\x18\x04 \x01(\tB~\xfaB{ryR\x00R\x04userR\bcategoryR\aprogramR\aepisodeR\x06seasonR\achapterR\n" +
+	"transcriptR\x06personR\x06creditR\x06importR\x03tagR\awebhookR\x10webhook_deliveryR\ventity_type\x12)\n" +
+	"\tentity_id\x18\x05 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\tentity_id\x123\n" +
+	"\aoutcome\x18\x06 \x01(\tB\x19\xfaB\x16r\x14R\x00R\asuccessR\afailureR\aoutcome\x12\x1e\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\n" +
+	"request_id\x12.\n" +
+	"\x04from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x12\n" +
+	"\x04page\x18\n" +
+	" \x01(\x05R\x04page\x12%\n" +
+	"\tpage_size\x18\v \x01(\x05B\a\xfaB\x04\x1a\x02\x18dR\tpage_size\"\x9e\x01\n" +
+	"\x17ListAuditEventsResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.thmanyah.v1.AuditEventR\x06events\x12 \n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\vtotal_count\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1c\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"x\n" +
+	"\fCategoryNode\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.thmanyah.v1.CategoryR\bcategory\x125\n" +
+	"\bchildren\x18\x02 \x03(\v2\x19.thmanyah.v1.CategoryNodeR\bchildren\"?\n" +
+	"\x16GetCategoryTreeRequest\x12%\n" +
+	"\aroot_id\x18\x01 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\aroot_id\"T\n" +
+	"\x17GetCategoryTreeResponse\x129\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x19.thmanyah.v1.CategoryNodeR\n" +
+	"categories\"l\n" +
+	"\x13MoveCategoryRequest\x12*\n" +
+	"\vcategory_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\vcategory_id\x12)\n" +
+	"\tparent_id\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\tparent_id\"I\n" +
+	"\x14MoveCategoryResponse\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.thmanyah.v1.CategoryR\bcategory\"\xb8\x01\n" +
+	"\x1bSetProgramCategoriesRequest\x12(\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"program_id\x12:\n" +
+	"\x13primary_category_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x13primary_category_id\x123\n" +
+	"\fcategory_ids\x18\x03 \x03(\tB\x0f\xfaB\f\x92\x01\t\x10\x14\"\x05r\x03\xb0\x01\x01R\fcategory_ids\"N\n" +
+	"\x1cSetProgramCategoriesResponse\x12.\n" +
+	"\aprogram\x18\x01 \x01(\v2\x14.thmanyah.v1.ProgramR\aprogram\"\xa4\x03\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12F\n" +
+	"\ftranslations\x18\x04 \x03(\v2\".thmanyah.v1.Tag.TranslationsEntryR\ftranslations\x12\x18\n" +
+	"\aaliases\x18\x05 \x03(\tR\aaliases\x12$\n" +
+	"\rprogram_count\x18\x06 \x01(\x05R\rprogram_count\x12$\n" +
+	"\repisode_count\x18\a \x01(\x05R\repisode_count\x12:\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd4\x01\n" +
+	"\x0fListTagsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12%\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02\x18dR\tpage_size\x12\"\n" +
+	"\fsearch_query\x18\x03 \x01(\tR\fsearch_query\x12.\n" +
+	"\asort_by\x18\x04 \x01(\tB\x14\xfaB\x11r\x0fR\x00R\x04nameR\x05usageR\asort_by\x122\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\tB\x12\xfaB\x0fr\rR\x00R\x03ascR\x04descR\n" +
+	"sort_order\"\x8c\x01\n" +
+	"\x10ListTagsResponse\x12$\n" +
+	"\x04tags\x18\x01 \x03(\v2\x10.thmanyah.v1.TagR\x04tags\x12 \n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\vtotal_count\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1c\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"R\n" +
+	"\x17AutocompleteTagsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x14(\x00R\x05limit\"@\n" +
+	"\x18AutocompleteTagsResponse\x12$\n" +
+	"\x04tags\x18\x01 \x03(\v2\x10.thmanyah.v1.TagR\x04tags\"\xee\x01\n" +
+	"\x10UpdateTagRequest\x12 \n" +
+	"\x06tag_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06tag_id\x12S\n" +
+	"\ftranslations\x18\x02 \x03(\v2/.thmanyah.v1.UpdateTagRequest.TranslationsEntryR\ftranslations\x12\"\n" +
+	"\aaliases\x18\x03 \x03(\tB\b\xfaB\x05\x92\x01\x02\x102R\aaliases\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
+	"\x11UpdateTagResponse\x12\"\n" +
+	"\x03tag\x18\x01 \x01(\v2\x10.thmanyah.v1.TagR\x03tag\"S\n" +
+	"\x10RenameTagRequest\x12 \n" +
+	"\x06tag_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06tag_id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\"7\n" +
+	"\x11RenameTagResponse\x12\"\n" +
+	"\x03tag\x18\x01 \x01(\v2\x10.thmanyah.v1.TagR\x03tag\"g\n" +
+	"\x10MergeTagsRequest\x12 \n" +
+	"\x06tag_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06tag_id\x121\n" +
+	"\n" +
+	"source_ids\x18\x02 \x03(\tB\x11\xfaB\x0e\x92\x01\v\b\x01\x10d\"\x05r\x03\xb0\x01\x01R\n" +
+	"source_ids\"7\n" +
+	"\x11MergeTagsResponse\x12\"\n" +
+	"\x03tag\x18\x01 \x01(\v2\x10.thmanyah.v1.TagR\x03tag\"\xb3\x02\n" +
+	"\vTranslation\x12<\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeR\fcontent_type\x12\x1e\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tR\n" +
+	"content_id\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12:\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\x8d\x01\n" +
+	"\x17ListTranslationsRequest\x12H\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\"X\n" +
+	"\x18ListTranslationsResponse\x12<\n" +
+	"\ftranslations\x18\x01 \x03(\v2\x18.thmanyah.v1.TranslationR\ftranslations\"\x92\x02\n" +
+	"\x15SetTranslationRequest\x12D\n" +
+	"\x06locale\x18\x01 \x01(\tB,\xfaB)r'\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$R\x06locale\x12H\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\x12\x1d\n" +
+	"\x05title\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"T\n" +
+	"\x16SetTranslationResponse\x12:\n" +
+	"\vtranslation\x18\x01 \x01(\v2\x18.thmanyah.v1.TranslationR\vtranslation\"\xd4\x01\n" +
+	"\x18DeleteTranslationRequest\x12D\n" +
+	"\x06locale\x18\x01 \x01(\tB,\xfaB)r'\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$R\x06locale\x12H\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\"\x88\x04\n" +
+	"\x06Season\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"program_id\x18\x02 \x01(\tR\n" +
+	"program_id\x12$\n" +
+	"\rseason_number\x18\x03 \x01(\x05R\rseason_number\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12$\n" +
+	"\rthumbnail_url\x18\x06 \x01(\tR\rthumbnail_url\x12F\n" +
+	"\x10release_start_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x10release_start_at\x12B\n" +
+	"\x0erelease_end_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0erelease_end_at\x12\x1e\n" +
+	"\n" +
+	"sort_order\x18\t \x01(\x05R\n" +
+	"sort_order\x12&\n" +
+	"\x0eepisodes_count\x18\n" +
+	" \x01(\x05R\x0eepisodes_count\x12:\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\xf8\x02\n" +
+	"\x13CreateSeasonRequest\x12(\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"program_id\x12-\n" +
+	"\rseason_number\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\rseason_number\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12$\n" +
+	"\rthumbnail_url\x18\x05 \x01(\tR\rthumbnail_url\x12F\n" +
+	"\x10release_start_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10release_start_at\x12B\n" +
+	"\x0erelease_end_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0erelease_end_at\x12\x1e\n" +
+	"\n" +
+	"sort_order\x18\b \x01(\x05R\n" +
+	"sort_order\"C\n" +
+	"\x14CreateSeasonResponse\x12+\n" +
+	"\x06season\x18\x01 \x01(\v2\x13.thmanyah.v1.SeasonR\x06season\":\n" +
+	"\x10GetSeasonRequest\x12&\n" +
+	"\tseason_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tseason_id\"@\n" +
+	"\x11GetSeasonResponse\x12+\n" +
+	"\x06season\x18\x01 \x01(\v2\x13.thmanyah.v1.SeasonR\x06season\">\n" +
+	"\x12ListSeasonsRequest\x12(\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"program_id\"D\n" +
+	"\x13ListSeasonsResponse\x12-\n" +
+	"\aseasons\x18\x01 \x03(\v2\x13.thmanyah.v1.SeasonR\aseasons\"\x8e\x04\n" +
+	"\x13UpdateSeasonRequest\x12&\n" +
+	"\tseason_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tseason_id\x122\n" +
+	"\rseason_number\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01H\x00R\rseason_number\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x01R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12)\n" +
+	"\rthumbnail_url\x18\x05 \x01(\tH\x03R\rthumbnail_url\x88\x01\x01\x12K\n" +
+	"\x10release_start_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x10release_start_at\x88\x01\x01\x12G\n" +
+	"\x0erelease_end_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x05R\x0erelease_end_at\x88\x01\x01\x12#\n" +
+	"\n" +
+	"sort_order\x18\b \x01(\x05H\x06R\n" +
+	"sort_order\x88\x01\x01B\x10\n" +
+	"\x0e_season_numberB\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\x10\n" +
+	"\x0e_thumbnail_urlB\x13\n" +
+	"\x11_release_start_atB\x11\n" +
+	"\x0f_release_end_atB\r\n" +
+	"\v_sort_order\"C\n" +
+	"\x14UpdateSeasonResponse\x12+\n" +
+	"\x06season\x18\x01 \x01(\v2\x13.thmanyah.v1.SeasonR\x06season\"=\n" +
+	"\x13DeleteSeasonRequest\x12&\n" +
+	"\tseason_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tseason_id\"\x9c\x01\n" +
+	"\x16ReorderEpisodesRequest\x12&\n" +
+	"\tseason_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tseason_id\x12-\n" +
+	"\vepisode_ids\x18\x02 \x03(\tB\v\xfaB\b\x92\x01\x05\b\x01\x10\xe8\aR\vepisode_ids\x12+\n" +
+	"\ffirst_number\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\ffirst_number\"K\n" +
+	"\x17ReorderEpisodesResponse\x120\n" +
+	"\bepisodes\x18\x01 \x03(\v2\x14.thmanyah.v1.EpisodeR\bepisodes\"\xec\x01\n" +
+	"\x19ListSeasonEpisodesRequest\x12&\n" +
+	"\tseason_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tseason_id\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12%\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02\x18dR\tpage_size\x122\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1a.thmanyah.v1.EpisodeStatusR\x06status\x12\x18\n" +
+	"\asort_by\x18\x05 \x01(\tR\asort_by\x12\x1e\n" +
+	"\n" +
+	"sort_order\x18\x06 \x01(\tR\n" +
+	"sort_order\"\xa2\x01\n" +
+	"\x1aListSeasonEpisodesResponse\x120\n" +
+	"\bepisodes\x18\x01 \x03(\v2\x14.thmanyah.v1.EpisodeR\bepisodes\x12 \n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\vtotal_count\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1c\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"\xd4\x02\n" +
+	"\aChapter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tR\n" +
+	"episode_id\x12$\n" +
+	"\rstart_seconds\x18\x03 \x01(\x01R\rstart_seconds\x12%\n" +
+	"\vend_seconds\x18\x04 \x01(\x01H\x00R\vend_seconds\x88\x01\x01\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x1c\n" +
+	"\timage_url\x18\x06 \x01(\tR\timage_url\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12:\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_atB\x0e\n" +
+	"\f_end_seconds\"\xdd\x01\n" +
+	"\fChapterInput\x124\n" +
+	"\rstart_seconds\x18\x01 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\rstart_seconds\x125\n" +
+	"\vend_seconds\x18\x02 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\vend_seconds\x88\x01\x01\x12 \n" +
+	"\x05title\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xf4\x03R\x05title\x12\x1c\n" +
+	"\timage_url\x18\x04 \x01(\tR\timage_url\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03urlB\x0e\n" +
+	"\f_end_seconds\"?\n" +
+	"\x13ListChaptersRequest\x12(\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"episode_id\"H\n" +
+	"\x14ListChaptersResponse\x120\n" +
+	"\bchapters\x18\x01 \x03(\v2\x14.thmanyah.v1.ChapterR\bchapters\"\x7f\n" +
+	"\x14CreateChapterRequest\x12(\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"episode_id\x12=\n" +
+	"\achapter\x18\x02 \x01(\v2\x19.thmanyah.v1.ChapterInputB\b\xfaB\x05\x8a\x01\x02\x10\x01R\achapter\"G\n" +
+	"\x15CreateChapterResponse\x12.\n" +
+	"\achapter\x18\x01 \x01(\v2\x14.thmanyah.v1.ChapterR\achapter\"\xd5\x02\n" +
+	"\x14UpdateChapterRequest\x12(\n" +
+	"\n" +
+	"chapter_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"chapter_id\x129\n" +
+	"\rstart_seconds\x18\x02 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\rstart_seconds\x88\x01\x01\x125\n" +
+	"\vend_seconds\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\vend_seconds\x88\x01\x01\x12%\n" +
+	"\x05title\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xf4\x03H\x02R\x05title\x88\x01\x01\x12!\n" +
+	"\timage_url\x18\x05 \x01(\tH\x03R\timage_url\x88\x01\x01\x12\x15\n" +
+	"\x03url\x18\x06 \x01(\tH\x04R\x03url\x88\x01\x01B\x10\n" +
+	"\x0e_start_secondsB\x0e\n" +
+	"\f_end_secondsB\b\n" +
+	"\x06_titleB\f\n" +
+	"\n" +
+	"_image_urlB\x06\n" +
+	"\x04_url\"G\n" +
+	"\x15UpdateChapterResponse\x12.\n" +
+	"\achapter\x18\x01 \x01(\v2\x14.thmanyah.v1.ChapterR\achapter\"@\n" +
+	"\x14DeleteChapterRequest\x12(\n" +
+	"\n" +
+	"chapter_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"chapter_id\"\x84\x01\n" +
+	"\x16ReplaceChaptersRequest\x12(\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"episode_id\x12@\n" +
+	"\bchapters\x18\x02 \x03(\v2\x19.thmanyah.v1.ChapterInputB\t\xfaB\x06\x92\x01\x03\x10\xf4\x03R\bchapters\"K\n" +
+	"\x17ReplaceChaptersResponse\x120\n" +
+	"\bchapters\x18\x01 \x03(\v2\x14.thmanyah.v1.ChapterR\bchapters\"t\n" +
+	"\x15ImportChaptersRequest\x12(\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"episode_id\x121\n" +
+	"\rchapters_json\x18\x02 \x01(\tB\v\xfaB\br\x06\x10\x01\x18\x80\x80@R\rchapters_json\"J\n" +
+	"\x16ImportChaptersResponse\x120\n" +
+	"\bchapters\x18\x01 \x03(\v2\x14.thmanyah.v1.ChapterR\bchapters\"\xbf\x02\n" +
+	"\n" +
+	"Transcript\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tR\n" +
+	"episode_id\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x125\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x1d.thmanyah.v1.TranscriptFormatR\x06format\x12\x1a\n" +
+	"\bfile_url\x18\x05 \x01(\tR\bfile_url\x12\x1e\n" +
+	"\n" +
+	"cues_count\x18\x06 \x01(\x05R\n" +
+	"cues_count\x12:\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\xf2\x01\n" +
+	"\x17UploadTranscriptRequest\x12(\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"episode_id\x12D\n" +
+	"\x06locale\x18\x02 \x01(\tB,\xfaB)r'\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$R\x06locale\x12?\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1d.thmanyah.v1.TranscriptFormatB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06format\x12&\n" +
+	"\acontent\x18\x04 \x01(\tB\f\xfaB\tr\a\x10\x01\x18\x80\x80\xc0\x02R\acontent\"S\n" +
+	"\x18UploadTranscriptResponse\x127\n" +
+	"\n" +
+	"transcript\x18\x01 \x01(\v2\x17.thmanyah.v1.TranscriptR\n" +
+	"transcript\"B\n" +
+	"\x16ListTranscriptsRequest\x12(\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"episode_id\"T\n" +
+	"\x17ListTranscriptsResponse\x129\n" +
+	"\vtranscripts\x18\x01 \x03(\v2\x17.thmanyah.v1.TranscriptR\vtranscripts\"\x89\x01\n" +
+	"\x17DeleteTranscriptRequest\x12(\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"episode_id\x12D\n" +
+	"\x06locale\x18\x02 \x01(\tB,\xfaB)r'\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$R\x06locale\"\xa3\x02\n" +
+	"\x06Person\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x12\x1c\n" +
+	"\tphoto_url\x18\x04 \x01(\tR\tphoto_url\x12-\n" +
+	"\x05links\x18\x05 \x03(\v2\x17.thmanyah.v1.PersonLinkR\x05links\x12\x1e\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\n" +
+	"created_by\x12:\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"J\n" +
+	"\n" +
+	"PersonLink\x12\x1e\n" +
+	"\x05title\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x05title\x12\x1c\n" +
+	"\x03url\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x10R\x03url\"F\n" +
+	"\vPersonLinks\x127\n" +
+	"\x05links\x18\x01 \x03(\v2\x17.thmanyah.v1.PersonLinkB\b\xfaB\x05\x92\x01\x02\x10\x14R\x05links\"\x86\x03\n" +
+	"\x06Credit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tperson_id\x18\x02 \x01(\tR\tperson_id\x12<\n" +
+	"\fcontent_type\x18\x03 \x01(\x0e2\x18.thmanyah.v1.ContentTypeR\fcontent_type\x12\x1e\n" +
+	"\n" +
+	"content_id\x18\x04 \x01(\tR\n" +
+	"content_id\x12+\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x17.thmanyah.v1.CreditRoleR\x04role\x12\x1e\n" +
+	"\n" +
+	"sort_order\x18\x06 \x01(\x05R\n" +
+	"sort_order\x12:\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12+\n" +
+	"\x06person\x18\t \x01(\v2\x13.thmanyah.v1.PersonR\x06person\"\xa8\x01\n" +
+	"\x13CreatePersonRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\x04name\x12\x1a\n" +
+	"\x03bio\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x90NR\x03bio\x12\x1c\n" +
+	"\tphoto_url\x18\x03 \x01(\tR\tphoto_url\x127\n" +
+	"\x05links\x18\x04 \x03(\v2\x17.thmanyah.v1.PersonLinkB\b\xfaB\x05\x92\x01\x02\x10\x14R\x05links\"C\n" +
+	"\x14CreatePersonResponse\x12+\n" +
+	"\x06person\x18\x01 \x01(\v2\x13.thmanyah.v1.PersonR\x06person\":\n" +
+	"\x10GetPersonRequest\x12&\n" +
+	"\tperson_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tperson_id\"@\n" +
+	"\x11GetPersonResponse\x12+\n" +
+	"\x06person\x18\x01 \x01(\v2\x13.thmanyah.v1.PersonR\x06person\"r\n" +
+	"\x11ListPeopleRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12%\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02\x18dR\tpage_size\x12\"\n" +
+	"\fsearch_query\x18\x03 \x01(\tR\fsearch_query\"\x95\x01\n" +
+	"\x12ListPeopleResponse\x12+\n" +
+	"\x06people\x18\x01 \x03(\v2\x13.thmanyah.v1.PersonR\x06people\x12 \n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\vtotal_count\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1c\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"\xf5\x01\n" +
+	"\x13UpdatePersonRequest\x12&\n" +
+	"\tperson_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tperson_id\x12#\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01H\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\x03bio\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x90NH\x01R\x03bio\x88\x01\x01\x12!\n" +
+	"\tphoto_url\x18\x04 \x01(\tH\x02R\tphoto_url\x88\x01\x01\x12.\n" +
+	"\x05links\x18\x05 \x01(\v2\x18.thmanyah.v1.PersonLinksR\x05linksB\a\n" +
+	"\x05_nameB\x06\n" +
+	"\x04_bioB\f\n" +
+	"\n" +
+	"_photo_url\"C\n" +
+	"\x14UpdatePersonResponse\x12+\n" +
+	"\x06person\x18\x01 \x01(\v2\x13.thmanyah.v1.PersonR\x06person\"=\n" +
+	"\x13DeletePersonRequest\x12&\n" +
+	"\tperson_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tperson_id\"\x8a\x02\n" +
+	"\x13CreateCreditRequest\x12&\n" +
+	"\tperson_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tperson_id\x12H\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x18\x01\x18\x02R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\x127\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x17.thmanyah.v1.CreditRoleB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04role\x12\x1e\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x05R\n" +
+	"sort_order\"C\n" +
+	"\x14CreateCreditResponse\x12+\n" +
+	"\x06credit\x18\x01 \x01(\v2\x13.thmanyah.v1.CreditR\x06credit\"\x88\x01\n" +
+	"\x12ListCreditsRequest\x12H\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x18\x01\x18\x02R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\"D\n" +
+	"\x13ListCreditsResponse\x12-\n" +
+	"\acredits\x18\x01 \x03(\v2\x13.thmanyah.v1.CreditR\acredits\"\xb8\x01\n" +
+	"\x13UpdateCreditRequest\x12&\n" +
+	"\tcredit_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tcredit_id\x12<\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x17.thmanyah.v1.CreditRoleB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00H\x00R\x04role\x88\x01\x01\x12#\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05H\x01R\n" +
+	"sort_order\x88\x01\x01B\a\n" +
+	"\x05_roleB\r\n" +
+	"\v_sort_order\"C\n" +
+	"\x14UpdateCreditResponse\x12+\n" +
+	"\x06credit\x18\x01 \x01(\v2\x13.thmanyah.v1.CreditR\x06credit\"=\n" +
+	"\x13DeleteCreditRequest\x12&\n" +
+	"\tcredit_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tcredit_id\"\x7f\n" +
+	"\x1aListCreditsByPersonRequest\x12&\n" +
+	"\tperson_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tperson_id\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12%\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02\x18dR\tpage_size\"\xa0\x01\n" +
+	"\x1bListCreditsByPersonResponse\x12-\n" +
+	"\acredits\x18\x01 \x03(\v2\x13.thmanyah.v1.CreditR\acredits\x12 \n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\vtotal_count\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1c\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"\xc1\x01\n" +
+	"\x18CheckAvailabilityRequest\x12H\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x18.thmanyah.v1.ContentTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x18\x01\x18\x02R\fcontent_type\x12(\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"content_id\x121\n" +
+	"\acountry\x18\x03 \x01(\tB\x17\xfaB\x14r\x122\r^[A-Za-z]{2}$\xd0\x01\x01R\acountry\"\xf1\x02\n" +
+	"\x19CheckAvailabilityResponse\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12<\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x18.thmanyah.v1.ContentTypeR\fcontent_type\x12\x1e\n" +
+	"\n" +
+	"content_id\x18\x03 \x01(\tR\n" +
+	"content_id\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x12:\n" +
+	"\n" +
+	"checked_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"checked_at\x128\n" +
+	"\areasons\x18\x06 \x03(\x0e2\x1e.thmanyah.v1.UnavailableReasonR\areasons\x12H\n" +
+	"\x0fprogram_reasons\x18\a \x03(\x0e2\x1e.thmanyah.v1.UnavailableReasonR\x0fprogram_reasons\"?\n" +
 	"\x14DeleteEpisodeRequest\x12'\n" +
 	"\n" +
 	"episode_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -3319,7 +11718,7 @@ const file_v1_cms_proto_rawDesc = "" +
 	"episode_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
 	"episode_id\"D\n" +
 	"\x12GetEpisodeResponse\x12.\n" +
-	"\aepisode\x18\x01 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\"\xb1\x02\n" +
+	"\aepisode\x18\x01 \x01(\v2\x14.thmanyah.v1.EpisodeR\aepisode\"\xdc\x02\n" +
 	"\x13ListEpisodesRequest\x12'\n" +
 	"\n" +
 	"program_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -3332,12 +11731,20 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\asort_by\x18\a \x01(\tR\asort_by\x12\x1e\n" +
 	"\n" +
 	"sort_order\x18\b \x01(\tR\n" +
-	"sort_order\"\x9c\x01\n" +
+	"sort_order\x12)\n" +
+	"\tseason_id\x18\t \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\tseason_id\"\x9c\x01\n" +
 	"\x14ListEpisodesResponse\x120\n" +
 	"\bepisodes\x18\x01 \x03(\v2\x14.thmanyah.v1.EpisodeR\bepisodes\x12 \n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\vtotal_count\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1c\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"\xdc\x03\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\tpage_size\"\x90\x01\n" +
+	"\x17BatchGetEpisodesRequest\x12,\n" +
+	"\vepisode_ids\x18\x01 \x03(\tB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10dR\vepisode_ids\x12G\n" +
+	"\x06locale\x18\x02 \x01(\tB/\xfaB,r*\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\x06locale\"r\n" +
+	"\x18BatchGetEpisodesResponse\x120\n" +
+	"\bepisodes\x18\x01 \x03(\v2\x14.thmanyah.v1.EpisodeR\bepisodes\x12$\n" +
+	"\rnot_found_ids\x18\x02 \x03(\tR\rnot_found_ids\"\xdc\x03\n" +
 	"\x11ImportDataRequest\x12)\n" +
 	"\vsource_type\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vsource_type\x12\x1e\n" +
 	"\n" +
@@ -3361,7 +11768,21 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\x0fprocessed_items\x18\x05 \x01(\x05R\x0fprocessed_items\x12$\n" +
 	"\rsuccess_count\x18\x06 \x01(\x05R\rsuccess_count\x12 \n" +
 	"\verror_count\x18\a \x01(\x05R\verror_count\x12\x1a\n" +
-	"\bwarnings\x18\t \x03(\tR\bwarnings\"\xe2\x02\n" +
+	"\bwarnings\x18\t \x03(\tR\bwarnings\"<\n" +
+	"\x12WatchImportRequest\x12&\n" +
+	"\timport_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\timport_id\"\xfa\x02\n" +
+	"\vImportEvent\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.thmanyah.v1.ImportEventTypeR\x04type\x12\x1c\n" +
+	"\timport_id\x18\x02 \x01(\tR\timport_id\x121\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x19.thmanyah.v1.ImportStatusR\x06status\x12 \n" +
+	"\vtotal_items\x18\x04 \x01(\x05R\vtotal_items\x12(\n" +
+	"\x0fprocessed_items\x18\x05 \x01(\x05R\x0fprocessed_items\x12$\n" +
+	"\rsuccess_count\x18\x06 \x01(\x05R\rsuccess_count\x12 \n" +
+	"\verror_count\x18\a \x01(\x05R\verror_count\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x12:\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\xe2\x02\n" +
 	"\x19BulkUpdateProgramsRequest\x12*\n" +
 	"\vprogram_ids\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\vprogram_ids\x122\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1a.thmanyah.v1.ProgramStatusR\x06status\x12 \n" +
@@ -3399,157 +11820,950 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\x1aCATEGORY_TYPE_SPORTS_EVENT\x10\x02\x12\x1d\n" +
 	"\x19CATEGORY_TYPE_EDUCATIONAL\x10\x03\x12\x16\n" +
 	"\x12CATEGORY_TYPE_NEWS\x10\x04\x12\x1f\n" +
-	"\x1bCATEGORY_TYPE_ENTERTAINMENT\x10\x05*d\n" +
+	"\x1bCATEGORY_TYPE_ENTERTAINMENT\x10\x05*\x9f\x01\n" +
 	"\rProgramStatus\x12\x18\n" +
 	"\x14PROGRAM_STATUS_DRAFT\x10\x00\x12\x1c\n" +
 	"\x18PROGRAM_STATUS_PUBLISHED\x10\x01\x12\x1b\n" +
-	"\x17PROGRAM_STATUS_ARCHIVED\x10\x02*\x82\x01\n" +
+	"\x17PROGRAM_STATUS_ARCHIVED\x10\x02\x12\x1c\n" +
+	"\x18PROGRAM_STATUS_IN_REVIEW\x10\x03\x12\x1b\n" +
+	"\x17PROGRAM_STATUS_APPROVED\x10\x04*\xbd\x01\n" +
 	"\rEpisodeStatus\x12\x18\n" +
 	"\x14EPISODE_STATUS_DRAFT\x10\x00\x12\x1c\n" +
 	"\x18EPISODE_STATUS_PUBLISHED\x10\x01\x12\x1c\n" +
 	"\x18EPISODE_STATUS_SCHEDULED\x10\x02\x12\x1b\n" +
-	"\x17EPISODE_STATUS_ARCHIVED\x10\x03*~\n" +
+	"\x17EPISODE_STATUS_ARCHIVED\x10\x03\x12\x1c\n" +
+	"\x18EPISODE_STATUS_IN_REVIEW\x10\x04\x12\x1b\n" +
+	"\x17EPISODE_STATUS_APPROVED\x10\x05*\x93\x01\n" +
+	"\vContentType\x12\x1c\n" +
+	"\x18CONTENT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CONTENT_TYPE_PROGRAM\x10\x01\x12\x18\n" +
+	"\x14CONTENT_TYPE_EPISODE\x10\x02\x12\x19\n" +
+	"\x15CONTENT_TYPE_CATEGORY\x10\x03\x12\x17\n" +
+	"\x13CONTENT_TYPE_PERSON\x10\x04*\xa6\x01\n" +
+	"\x10TranscriptFormat\x12!\n" +
+	"\x1dTRANSCRIPT_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TRANSCRIPT_FORMAT_WEBVTT\x10\x01\x12\x19\n" +
+	"\x15TRANSCRIPT_FORMAT_SRT\x10\x02\x12\x1a\n" +
+	"\x16TRANSCRIPT_FORMAT_TEXT\x10\x03\x12\x1a\n" +
+	"\x16TRANSCRIPT_FORMAT_JSON\x10\x04*p\n" +
+	"\n" +
+	"CreditRole\x12\x1b\n" +
+	"\x17CREDIT_ROLE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10CREDIT_ROLE_HOST\x10\x01\x12\x15\n" +
+	"\x11CREDIT_ROLE_GUEST\x10\x02\x12\x18\n" +
+	"\x14CREDIT_ROLE_PRODUCER\x10\x03*\xf3\x01\n" +
+	"\x11UnavailableReason\x12\"\n" +
+	"\x1eUNAVAILABLE_REASON_UNSPECIFIED\x10\x00\x12$\n" +
+	" UNAVAILABLE_REASON_NOT_PUBLISHED\x10\x01\x12\"\n" +
+	"\x1eUNAVAILABLE_REASON_NOT_STARTED\x10\x02\x12\x1c\n" +
+	"\x18UNAVAILABLE_REASON_ENDED\x10\x03\x12*\n" +
+	"&UNAVAILABLE_REASON_COUNTRY_NOT_ALLOWED\x10\x04\x12&\n" +
+	"\"UNAVAILABLE_REASON_COUNTRY_BLOCKED\x10\x05*~\n" +
 	"\fImportStatus\x12\x19\n" +
 	"\x15IMPORT_STATUS_PENDING\x10\x00\x12\x1c\n" +
 	"\x18IMPORT_STATUS_PROCESSING\x10\x01\x12\x1b\n" +
 	"\x17IMPORT_STATUS_COMPLETED\x10\x02\x12\x18\n" +
-	"\x14IMPORT_STATUS_FAILED\x10\x032\xdf-\n" +
+	"\x14IMPORT_STATUS_FAILED\x10\x03*m\n" +
+	"\x0fImportEventType\x12\x1e\n" +
+	"\x1aIMPORT_EVENT_TYPE_PROGRESS\x10\x00\x12\x1d\n" +
+	"\x19IMPORT_EVENT_TYPE_WARNING\x10\x01\x12\x1b\n" +
+	"\x17IMPORT_EVENT_TYPE_ERROR\x10\x022\xe0\xf6\x01\n" +
 	"\n" +
 	"CmsService\x12\xb9\x02\n" +
 	"\rCreateProgram\x12!.thmanyah.v1.CreateProgramRequest\x1a\".thmanyah.v1.CreateProgramResponse\"\xe0\x01\xbaG\xbd\x01\x12\x14Create a new program\x1aeCreates a new program with the provided details including title, description, category, and metadata.B,\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/cms/programs\x12\xdc\x02\n" +
+	"\rUpdateProgram\x12!.thmanyah.v1.UpdateProgramRequest\x1a\".thmanyah.v1.UpdateProgramResponse\"\x83\x02\xbaG\xd3\x01\x12\x1aUpdate an existing program\x1aWUpdates an existing program with new information. Only provided fields will be updated.BJ\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Program not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02&:\x01*\x1a!/api/v1/cms/programs/{program_id}\x12\xd5\x02\n" +
+	"\rDeleteProgram\x12!.thmanyah.v1.DeleteProgramRequest\x1a\x16.google.protobuf.Empty\"\x88\x02\xbaG\xdb\x01\x12\x10Delete a program\x1aiMoves a program and all its episodes to the trash, from where they can be restored until they are purged.BJ\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Program not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#*!/api/v1/cms/programs/{program_id}\x12\xb7\x02\n" +
+	"\n" +
+	"GetProgram\x12\x1e.thmanyah.v1.GetProgramRequest\x1a\x1f.thmanyah.v1.GetProgramResponse\"\xe7\x01\xbaG\xba\x01\x12\x16Get a specific program\x1aBRetrieves detailed information about a specific program by its ID.BJ\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Program not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#\x12!/api/v1/cms/programs/{program_id}\x12\xb2\x02\n" +
+	"\fListPrograms\x12 .thmanyah.v1.ListProgramsRequest\x1a!.thmanyah.v1.ListProgramsResponse\"\xdc\x01\xbaG\xbc\x01\x12\rList programs\x1akRetrieves a paginated list of programs with optional filtering by category, status, tags, and search query.B,\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/cms/programs\x12\x94\x03\n" +
+	"\x10BatchGetPrograms\x12$.thmanyah.v1.BatchGetProgramsRequest\x1a%.thmanyah.v1.BatchGetProgramsResponse\"\xb2\x02\xbaG\x85\x02\x12\x14Get several programs\x1a\xac\x01Retrieves up to 100 programs by ID in one call. Found programs are returned in request order; IDs that do not exist are listed in not_found_ids instead of failing the call.B,\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/cms/programs/batch-get\x12\xaa\x02\n" +
+	"\x0eCreateCategory\x12\".thmanyah.v1.CreateCategoryRequest\x1a#.thmanyah.v1.CreateCategoryResponse\"\xce\x01\xbaG\xa9\x01\x12\x15Create a new category\x1aPCreates a new category for organizing programs with specified type and metadata.B,\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/cms/categories\x12\xe5\x02\n" +
+	"\x0eUpdateCategory\x12\".thmanyah.v1.UpdateCategoryRequest\x1a#.thmanyah.v1.UpdateCategoryResponse\"\x89\x02\xbaG\xd6\x01\x12\x1bUpdate an existing category\x1aXUpdates an existing category with new information. Only provided fields will be updated.BK\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14\n" +
+	"\x12Category not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02):\x01*\x1a$/api/v1/cms/categories/{category_id}\x12\xc6\x02\n" +
+	"\x0eDeleteCategory\x12\".thmanyah.v1.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\"\xf7\x01\xbaG\xc7\x01\x12\x11Delete a category\x1aSMoves a category to the trash. Categories still used by programs cannot be deleted.BK\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14\n" +
+	"\x12Category not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02&*$/api/v1/cms/categories/{category_id}\x12\xa4\x03\n" +
+	"\x0fGetCategoryTree\x12#.thmanyah.v1.GetCategoryTreeRequest\x1a$.thmanyah.v1.GetCategoryTreeResponse\"\xc5\x02\xbaG\x9e\x02\x12\x11Get category tree\x1a\x90\x01Returns the categories nested under their parents, siblings ordered by name. With root_id only that category and the ones below it are returned.Bd\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14\n" +
+	"\x12Category not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/cms/categories/tree\x12\xc0\x02\n" +
+	"\vGetCategory\x12\x1f.thmanyah.v1.GetCategoryRequest\x1a .thmanyah.v1.GetCategoryResponse\"\xed\x01\xbaG\xbd\x01\x12\x17Get a specific category\x1aCRetrieves detailed information about a specific category by its ID.BK\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14\n" +
+	"\x12Category not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02&\x12$/api/v1/cms/categories/{category_id}\x12\xab\x02\n" +
+	"\x0eListCategories\x12\".thmanyah.v1.ListCategoriesRequest\x1a#.thmanyah.v1.ListCategoriesResponse\"\xcf\x01\xbaG\xad\x01\x12\x0fList categories\x1aZRetrieves a paginated list of categories with optional filtering by type and search query.B,\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/cms/categories\x12\xa2\x03\n" +
+	"\x12BatchGetCategories\x12&.thmanyah.v1.BatchGetCategoriesRequest\x1a'.thmanyah.v1.BatchGetCategoriesResponse\"\xba\x02\xbaG\x8b\x02\x12\x16Get several categories\x1a\xb0\x01Retrieves up to 100 categories by ID in one call. Found categories are returned in request order; IDs that do not exist are listed in not_found_ids instead of failing the call.B,\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/cms/categories/batch-get\x12\xaf\x02\n" +
+	"\rCreateEpisode\x12!.thmanyah.v1.CreateEpisodeRequest\x1a\".thmanyah.v1.CreateEpisodeResponse\"\xd6\x01\xbaG\xb3\x01\x12\x14Create a new episode\x1a[Creates a new episode for a specific program with media URL, duration, and episode details.B,\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/cms/episodes\x12\xdc\x02\n" +
+	"\rUpdateEpisode\x12!.thmanyah.v1.UpdateEpisodeRequest\x1a\".thmanyah.v1.UpdateEpisodeResponse\"\x83\x02\xbaG\xd3\x01\x12\x1aUpdate an existing episode\x1aWUpdates an existing episode with new information. Only provided fields will be updated.BJ\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Episode not foundZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/cms/programs\x12\xdc\x02\n" +
-	"\rUpdateProgram\x12!.thmanyah.v1.UpdateProgramRequest\x1a\".thmanyah.v1.UpdateProgramResponse\"\x83\x02\xbaG\xd3\x01\x12\x1aUpdate an existing program\x1aWUpdates an existing program with new information. Only provided fields will be updated.BJ\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02&:\x01*\x1a!/api/v1/cms/episodes/{episode_id}\x12\xbd\x02\n" +
+	"\rDeleteEpisode\x12!.thmanyah.v1.DeleteEpisodeRequest\x1a\x16.google.protobuf.Empty\"\xf0\x01\xbaG\xc3\x01\x12\x11Delete an episode\x1aPMoves an episode to the trash, from where it can be restored until it is purged.BJ\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
 	"\x1fBad Request - Validation failed\x12\x1c\n" +
 	"\x03404\x12\x15\n" +
 	"\x13\n" +
-	"\x11Program not foundZ\x10\n" +
+	"\x11Episode not foundZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02&:\x01*\x1a!/api/v1/cms/programs/{program_id}\x12\xaa\x02\n" +
-	"\rDeleteProgram\x12!.thmanyah.v1.DeleteProgramRequest\x1a\x16.google.protobuf.Empty\"\xdd\x01\xbaG\xb0\x01\x12\x10Delete a program\x1a>Permanently deletes a program and all its associated episodes.BJ\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#*!/api/v1/cms/episodes/{episode_id}\x12\xb7\x02\n" +
+	"\n" +
+	"GetEpisode\x12\x1e.thmanyah.v1.GetEpisodeRequest\x1a\x1f.thmanyah.v1.GetEpisodeResponse\"\xe7\x01\xbaG\xba\x01\x12\x16Get a specific episode\x1aBRetrieves detailed information about a specific episode by its ID.BJ\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
 	"\x1fBad Request - Validation failed\x12\x1c\n" +
 	"\x03404\x12\x15\n" +
 	"\x13\n" +
-	"\x11Program not foundZ\x10\n" +
+	"\x11Episode not foundZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#*!/api/v1/cms/programs/{program_id}\x12\xb7\x02\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#\x12!/api/v1/cms/episodes/{episode_id}\x12\xe5\x02\n" +
+	"\fListEpisodes\x12 .thmanyah.v1.ListEpisodesRequest\x1a!.thmanyah.v1.ListEpisodesResponse\"\x8f\x02\xbaG\xd9\x01\x12\x1bList episodes for a program\x1azRetrieves a paginated list of episodes for a specific program with optional filtering by status, season, and search query.B,\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x0e\n" +
 	"\n" +
-	"GetProgram\x12\x1e.thmanyah.v1.GetProgramRequest\x1a\x1f.thmanyah.v1.GetProgramResponse\"\xe7\x01\xbaG\xba\x01\x12\x16Get a specific program\x1aBRetrieves detailed information about a specific program by its ID.BJ\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02,\x12*/api/v1/cms/programs/{program_id}/episodes\x12\x94\x03\n" +
+	"\x10BatchGetEpisodes\x12$.thmanyah.v1.BatchGetEpisodesRequest\x1a%.thmanyah.v1.BatchGetEpisodesResponse\"\xb2\x02\xbaG\x85\x02\x12\x14Get several episodes\x1a\xac\x01Retrieves up to 100 episodes by ID in one call. Found episodes are returned in request order; IDs that do not exist are listed in not_found_ids instead of failing the call.B,\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/cms/episodes/batch-get\x12\xb5\x04\n" +
+	"\x11RescheduleEpisode\x12%.thmanyah.v1.RescheduleEpisodeRequest\x1a&.thmanyah.v1.RescheduleEpisodeResponse\"\xd0\x03\xbaG\x95\x03\x12!Schedule or reschedule an episode\x1a\xbc\x01Schedules an approved episode, or moves a scheduled one, to be published at scheduled_at. The time must be in the future. Drafts can be scheduled directly only when review is not required.B\x9e\x01\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
 	"\x1fBad Request - Validation failed\x12\x1c\n" +
 	"\x03404\x12\x15\n" +
 	"\x13\n" +
-	"\x11Program not foundZ\x10\n" +
+	"\x11Episode not found\x12R\n" +
+	"\x03409\x12K\n" +
+	"I\n" +
+	"GConflict - The editorial workflow does not allow scheduling the episodeZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#\x12!/api/v1/cms/programs/{program_id}\x12\xb2\x02\n" +
-	"\fListPrograms\x12 .thmanyah.v1.ListProgramsRequest\x1a!.thmanyah.v1.ListProgramsResponse\"\xdc\x01\xbaG\xbc\x01\x12\rList programs\x1akRetrieves a paginated list of programs with optional filtering by category, status, tags, and search query.B,\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/cms/episodes/{episode_id}/reschedule\x12\xb7\x03\n" +
+	"\x15CancelEpisodeSchedule\x12).thmanyah.v1.CancelEpisodeScheduleRequest\x1a*.thmanyah.v1.CancelEpisodeScheduleResponse\"\xc6\x02\xbaG\x86\x02\x12\x1aCancel an episode schedule\x1a\x85\x01Takes a scheduled episode off the schedule so it is not published. It goes back to approved, or to draft when review is not required.BN\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Episode not found\x12.\n" +
+	"\x03409\x12'\n" +
+	"%\n" +
+	"#Conflict - Episode is not scheduledZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/cms/episodes/{episode_id}/cancel-schedule\x12\xa8\x03\n" +
+	"\x0fSubmitForReview\x12#.thmanyah.v1.SubmitForReviewRequest\x1a\x1b.thmanyah.v1.ReviewResponse\"\xd2\x02\xbaG\xa9\x02\x12\x19Submit content for review\x1aIMoves a draft program or episode to in review. Only the owner can submit.B\xae\x01\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x1fBad Request - Validation failed\x120\n" +
+	"\x03403\x12)\n" +
+	"'\n" +
+	"%Forbidden - Only the owner can submit\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Content not found\x120\n" +
+	"\x03409\x12)\n" +
+	"'\n" +
+	"%Conflict - The content is not a draftZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/cms/programs\x12\xaa\x02\n" +
-	"\x0eCreateCategory\x12\".thmanyah.v1.CreateCategoryRequest\x1a#.thmanyah.v1.CreateCategoryResponse\"\xce\x01\xbaG\xa9\x01\x12\x15Create a new category\x1aPCreates a new category for organizing programs with specified type and metadata.B,\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/cms/reviews/submit\x12\xf1\x03\n" +
+	"\aApprove\x12\x1b.thmanyah.v1.ApproveRequest\x1a\x1b.thmanyah.v1.ReviewResponse\"\xab\x03\xbaG\x81\x03\x12\x0fApprove content\x1a\xa7\x01Approves a program or episode in review so its owner can publish or schedule it. Only reviewers can approve, and not their own content unless self approval is allowed.B\xb1\x01\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x1fBad Request - Validation failed\x121\n" +
+	"\x03403\x12*\n" +
+	"(\n" +
+	"&Forbidden - Only reviewers can approve\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Content not found\x122\n" +
+	"\x03409\x12+\n" +
+	")\n" +
+	"'Conflict - The content is not in reviewZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/cms/categories\x12\xe5\x02\n" +
-	"\x0eUpdateCategory\x12\".thmanyah.v1.UpdateCategoryRequest\x1a#.thmanyah.v1.UpdateCategoryResponse\"\x89\x02\xbaG\xd6\x01\x12\x1bUpdate an existing category\x1aXUpdates an existing category with new information. Only provided fields will be updated.BK\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/cms/reviews/approve\x12\xe4\x03\n" +
+	"\x06Reject\x12\x1a.thmanyah.v1.RejectRequest\x1a\x1b.thmanyah.v1.ReviewResponse\"\xa0\x03\xbaG\xf7\x02\x12\x0eReject content\x1a\x93\x01Sends a program or episode in review, or approved but not yet published, back to draft. The comment is required and tells the owner what to change.B\xbc\x01\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failed\x12\x1d\n" +
-	"\x03404\x12\x16\n" +
-	"\x14\n" +
-	"\x12Category not foundZ\x10\n" +
+	"\x1fBad Request - Validation failed\x120\n" +
+	"\x03403\x12)\n" +
+	"'\n" +
+	"%Forbidden - Only reviewers can reject\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Content not found\x12>\n" +
+	"\x03409\x127\n" +
+	"5\n" +
+	"3Conflict - The content is not in review or approvedZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02):\x01*\x1a$/api/v1/cms/categories/{category_id}\x12\xc8\x02\n" +
-	"\x0eDeleteCategory\x12\".thmanyah.v1.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\"\xf9\x01\xbaG\xc9\x01\x12\x11Delete a category\x1aUPermanently deletes a category. Programs in this category will need to be reassigned.BK\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/cms/reviews/reject\x12\xde\x03\n" +
+	"\x15ListStatusTransitions\x12).thmanyah.v1.ListStatusTransitionsRequest\x1a*.thmanyah.v1.ListStatusTransitionsResponse\"\xed\x02\xbaG\xc2\x02\x12\x17List status transitions\x1a\x7fLists every status change of a program or episode, oldest first, with who made it and when. Visible to the owner and reviewers.B\x93\x01\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failed\x12\x1d\n" +
-	"\x03404\x12\x16\n" +
-	"\x14\n" +
-	"\x12Category not foundZ\x10\n" +
+	"\x1fBad Request - Validation failed\x12G\n" +
+	"\x03403\x12@\n" +
+	">\n" +
+	"<Forbidden - Only the owner and reviewers can see the history\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Content not foundZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02&*$/api/v1/cms/categories/{category_id}\x12\xc0\x02\n" +
-	"\vGetCategory\x12\x1f.thmanyah.v1.GetCategoryRequest\x1a .thmanyah.v1.GetCategoryResponse\"\xed\x01\xbaG\xbd\x01\x12\x17Get a specific category\x1aCRetrieves detailed information about a specific category by its ID.BK\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/cms/reviews/transitions\x12\x97\x03\n" +
+	"\rListRevisions\x12!.thmanyah.v1.ListRevisionsRequest\x1a\".thmanyah.v1.ListRevisionsResponse\"\xbe\x02\xbaG\x9d\x02\x12\x0eList revisions\x1aeLists the stored revisions of a program or episode, newest first. Visible to the owner and reviewers.B\x91\x01\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failed\x12\x1d\n" +
+	"\x1fBad Request - Validation failed\x12E\n" +
+	"\x03403\x12>\n" +
+	"<\n" +
+	":Forbidden - Only the owner and reviewers can see revisions\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Content not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/cms/revisions\x12\xd9\x02\n" +
+	"\vGetRevision\x12\x1f.thmanyah.v1.GetRevisionRequest\x1a .thmanyah.v1.GetRevisionResponse\"\x86\x02\xbaG\xd7\x01\x12\x0eGet a revision\x1aKRetrieves one revision with the full snapshot of the content at that point.Bf\x12E\n" +
+	"\x03403\x12>\n" +
+	"<\n" +
+	":Forbidden - Only the owner and reviewers can see revisions\x12\x1d\n" +
 	"\x03404\x12\x16\n" +
 	"\x14\n" +
-	"\x12Category not foundZ\x10\n" +
+	"\x12Revision not foundZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02&\x12$/api/v1/cms/categories/{category_id}\x12\xab\x02\n" +
-	"\x0eListCategories\x12\".thmanyah.v1.ListCategoriesRequest\x1a#.thmanyah.v1.ListCategoriesResponse\"\xcf\x01\xbaG\xad\x01\x12\x0fList categories\x1aZRetrieves a paginated list of categories with optional filtering by type and search query.B,\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02%\x12#/api/v1/cms/revisions/{revision_id}\x12\xdf\x03\n" +
+	"\rDiffRevisions\x12!.thmanyah.v1.DiffRevisionsRequest\x1a\".thmanyah.v1.DiffRevisionsResponse\"\x86\x03\xbaG\xbc\x02\x12\x12Diff two revisions\x1agLists the fields that differ between two revisions of the same content, with their values on each side.B\xaa\x01\x12B\n" +
+	"\x03400\x12;\n" +
+	"9\n" +
+	"7Bad Request - The revisions belong to different content\x12E\n" +
+	"\x03403\x12>\n" +
+	"<\n" +
+	":Forbidden - Only the owner and reviewers can see revisions\x12\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14\n" +
+	"\x12Revision not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02@\x12>/api/v1/cms/revisions/{from_revision_id}/diff/{to_revision_id}\x12\xd8\x03\n" +
+	"\x0fRestoreRevision\x12#.thmanyah.v1.RestoreRevisionRequest\x1a$.thmanyah.v1.RestoreRevisionResponse\"\xf9\x02\xbaG\xbf\x02\x12\x12Restore a revision\x1a\xc2\x01Puts the editable fields of a revision back and stores the result as a new revision. The status is not restored, since it only changes through the editorial workflow. Only the owner can restore.BR\x121\n" +
+	"\x03403\x12*\n" +
+	"(\n" +
+	"&Forbidden - Only the owner can restore\x12\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14\n" +
+	"\x12Revision not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/cms/revisions/{revision_id}/restore\x12\xf3\x02\n" +
+	"\tListTrash\x12\x1d.thmanyah.v1.ListTrashRequest\x1a\x1e.thmanyah.v1.ListTrashResponse\"\xa6\x02\xbaG\x89\x02\x12\n" +
+	"List trash\x1a\xa1\x01Lists the categories, programs and episodes the current user deleted, most recently deleted first. Episodes deleted with their program are not listed separately.BE\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorizedZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/cms/categories\x12\xaf\x02\n" +
-	"\rCreateEpisode\x12!.thmanyah.v1.CreateEpisodeRequest\x1a\".thmanyah.v1.CreateEpisodeResponse\"\xd6\x01\xbaG\xb3\x01\x12\x14Create a new episode\x1a[Creates a new episode for a specific program with media URL, duration, and episode details.B,\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/cms/trash\x12\xfc\x03\n" +
+	"\x10RestoreFromTrash\x12$.thmanyah.v1.RestoreFromTrashRequest\x1a%.thmanyah.v1.RestoreFromTrashResponse\"\x9a\x03\xbaG\xf2\x02\x12\x12Restore from trash\x1a\xb3\x01Takes deleted content out of the trash. Restoring a program also restores the episodes deleted with it. Content whose category or program is still in the trash cannot be restored.B\x93\x01\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Not in the trash\x12/\n" +
+	"\x03409\x12(\n" +
+	"&\n" +
+	"$Conflict - Parent still in the trashZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/cms/episodes\x12\xdc\x02\n" +
-	"\rUpdateEpisode\x12!.thmanyah.v1.UpdateEpisodeRequest\x1a\".thmanyah.v1.UpdateEpisodeResponse\"\x83\x02\xbaG\xd3\x01\x12\x1aUpdate an existing episode\x1aWUpdates an existing episode with new information. Only provided fields will be updated.BJ\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/cms/trash/restore\x12\xdb\x03\n" +
+	"\n" +
+	"PurgeTrash\x12\x1e.thmanyah.v1.PurgeTrashRequest\x1a\x1f.thmanyah.v1.PurgeTrashResponse\"\x8b\x03\xbaG\xe5\x02\x12\vPurge trash\x1a\xdf\x01Deletes trashed content for good, including its history and uploaded files. Without a content_id it empties the whole trash, or the part holding content_type. Content is also purged automatically after the retention period.Bb\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failed\x12\x1c\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Not in the trashZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/cms/trash/purge\x12\x93\x04\n" +
+	"\x0fListAuditEvents\x12#.thmanyah.v1.ListAuditEventsRequest\x1a$.thmanyah.v1.ListAuditEventsResponse\"\xb4\x03\xbaG\x90\x03\x12\x11List audit events\x1a\xfd\x01Lists recorded calls of mutating operations, newest first, with who made them, what they changed and whether they succeeded. Only admins may read the audit log. The same filters export every matching event as NDJSON from /api/v1/cms/audit/events/export.Bi\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\"\n" +
+	"\x03403\x12\x1b\n" +
+	"\x19\n" +
+	"\x17Forbidden - Admins onlyZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/cms/audit/events\x12\xa6\x04\n" +
+	"\fMoveCategory\x12 .thmanyah.v1.MoveCategoryRequest\x1a!.thmanyah.v1.MoveCategoryResponse\"\xd0\x03\xbaG\x98\x03\x12\rMove category\x1a\xb3\x01Puts a category under another category, or at the top level without a parent_id. Its subcategories move along. A category cannot be moved under itself or one of its subcategories.B\xbe\x01\x12K\n" +
+	"\x03400\x12D\n" +
+	"B\n" +
+	"@Bad Request - Validation failed or the move would create a cycle\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12-\n" +
+	"\x03403\x12&\n" +
+	"$\n" +
+	"\"Forbidden - Not the category owner\x12'\n" +
+	"\x03404\x12 \n" +
+	"\x1e\n" +
+	"\x1cCategory or parent not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/cms/categories/{category_id}/move\x12\x96\x04\n" +
+	"\x14SetProgramCategories\x12(.thmanyah.v1.SetProgramCategoriesRequest\x1a).thmanyah.v1.SetProgramCategoriesResponse\"\xa8\x03\xbaG\xed\x02\x12\x16Set program categories\x1a\xa0\x01Replaces the categories a program appears in. primary_category_id becomes its category_id; category_ids are the other categories and may repeat the primary one.B\x9d\x01\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12,\n" +
+	"\x03403\x12%\n" +
+	"#\n" +
+	"!Forbidden - Not the program owner\x12(\n" +
+	"\x03404\x12!\n" +
+	"\x1f\n" +
+	"\x1dProgram or category not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x021:\x01*\x1a,/api/v1/cms/programs/{program_id}/categories\x12\xbc\x02\n" +
+	"\bListTags\x12\x1c.thmanyah.v1.ListTagsRequest\x1a\x1d.thmanyah.v1.ListTagsResponse\"\xf2\x01\xbaG\xd6\x01\x12\tList tags\x1apLists the tag taxonomy with how many programs and episodes use each tag, by name or, with sort_by usage, by use.BE\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorizedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/cms/tags\x12\xd0\x02\n" +
+	"\x10AutocompleteTags\x12$.thmanyah.v1.AutocompleteTagsRequest\x1a%.thmanyah.v1.AutocompleteTagsResponse\"\xee\x01\xbaG\xc5\x01\x12\x11Autocomplete tags\x1aWSuggests tags whose name, alias or translation starts with prefix, the most used first.BE\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorizedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/cms/tags/autocomplete\x12\xf4\x03\n" +
+	"\tUpdateTag\x12\x1d.thmanyah.v1.UpdateTagRequest\x1a\x1e.thmanyah.v1.UpdateTagResponse\"\xa7\x03\xbaG\xff\x02\x12\n" +
+	"Update tag\x1a\x9b\x01Replaces the translations and aliases of a tag. Tags matching an alias or translation are saved under the tag name. Only reviewers can change the taxonomy.B\xc0\x01\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12%\n" +
+	"\x03403\x12\x1e\n" +
+	"\x1c\n" +
+	"\x1aForbidden - Not a reviewer\x12\x18\n" +
+	"\x03404\x12\x11\n" +
+	"\x0f\n" +
+	"\rTag not found\x128\n" +
+	"\x03409\x121\n" +
+	"/\n" +
+	"-Conflict - Another tag has one of the aliasesZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/v1/cms/tags/{tag_id}\x12\xe2\x03\n" +
+	"\tRenameTag\x12\x1d.thmanyah.v1.RenameTagRequest\x1a\x1e.thmanyah.v1.RenameTagResponse\"\x95\x03\xbaG\xe6\x02\x12\n" +
+	"Rename tag\x1a\x82\x01Gives a tag a new name on every program and episode using it. The old name stays an alias. Only reviewers can change the taxonomy.B\xc0\x01\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12%\n" +
+	"\x03403\x12\x1e\n" +
+	"\x1c\n" +
+	"\x1aForbidden - Not a reviewer\x12\x18\n" +
+	"\x03404\x12\x11\n" +
+	"\x0f\n" +
+	"\rTag not found\x128\n" +
+	"\x03409\x121\n" +
+	"/\n" +
+	"-Conflict - Another tag has this name or aliasZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/cms/tags/{tag_id}/rename\x12\xe9\x03\n" +
+	"\tMergeTags\x12\x1d.thmanyah.v1.MergeTagsRequest\x1a\x1e.thmanyah.v1.MergeTagsResponse\"\x9c\x03\xbaG\xee\x02\x12\n" +
+	"Merge tags\x1a\xc4\x01Merges the source tags into the tag: programs and episodes tagged with them are tagged with it instead, their names become its aliases and they are deleted. Only reviewers can change the taxonomy.B\x86\x01\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12%\n" +
+	"\x03403\x12\x1e\n" +
+	"\x1c\n" +
+	"\x1aForbidden - Not a reviewer\x12\x18\n" +
+	"\x03404\x12\x11\n" +
+	"\x0f\n" +
+	"\rTag not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/cms/tags/{tag_id}/merge\x12\xd5\x02\n" +
+	"\x10ListTranslations\x12$.thmanyah.v1.ListTranslationsRequest\x1a%.thmanyah.v1.ListTranslationsResponse\"\xf3\x01\xbaG\xcf\x01\x12\x11List translations\x1aCLists the translations of a category, program or episode by locale.Bc\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1c\n" +
 	"\x03404\x12\x15\n" +
 	"\x13\n" +
-	"\x11Episode not foundZ\x10\n" +
+	"\x11Content not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/cms/translations\x12\x8b\x05\n" +
+	"\x0eSetTranslation\x12\".thmanyah.v1.SetTranslationRequest\x1a#.thmanyah.v1.SetTranslationResponse\"\xaf\x04\xbaG\xff\x03\x12\x11Set a translation\x1a\xaa\x02Adds or replaces the title and description of a category, program or episode in a locale. For categories, the title is the name. Discover responses show content in the locale asked for, falling back to its base language and then to the original text. Only the owner of the content can translate it.B\xaa\x01\x12<\n" +
+	"\x03400\x125\n" +
+	"3\n" +
+	"1Bad Request - Validation failed or invalid locale\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the content\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Content not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02&:\x01*\x1a!/api/v1/cms/translations/{locale}\x12\xdb\x03\n" +
+	"\x11DeleteTranslation\x12%.thmanyah.v1.DeleteTranslationRequest\x1a\x16.google.protobuf.Empty\"\x86\x03\xbaG\xd9\x02\x12\x14Delete a translation\x1asRemoves the translation of a category, program or episode in a locale. Only the owner of the content can delete it.B\xb9\x01\x12<\n" +
+	"\x03400\x125\n" +
+	"3\n" +
+	"1Bad Request - Validation failed or invalid locale\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the content\x12+\n" +
+	"\x03404\x12$\n" +
+	"\"\n" +
+	" Content or translation not foundZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02&:\x01*\x1a!/api/v1/cms/episodes/{episode_id}\x12\x9b\x02\n" +
-	"\rDeleteEpisode\x12!.thmanyah.v1.DeleteEpisodeRequest\x1a\x16.google.protobuf.Empty\"\xce\x01\xbaG\xa1\x01\x12\x11Delete an episode\x1a.Permanently deletes an episode from a program.BJ\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#*!/api/v1/cms/translations/{locale}\x12\x8a\x05\n" +
+	"\fCreateSeason\x12 .thmanyah.v1.CreateSeasonRequest\x1a!.thmanyah.v1.CreateSeasonResponse\"\xb4\x04\xbaG\xfc\x03\x12\x0fCreate a season\x1a\xf2\x01Adds a season to a program, with its own title, description, artwork, release window and sort order. Seasons are also added on their own when an episode is stored with a new season number. Only the owner of the program can change its seasons.B\xe1\x01\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failed\x12\x1c\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the program\x12\x1c\n" +
 	"\x03404\x12\x15\n" +
 	"\x13\n" +
-	"\x11Episode not foundZ\x10\n" +
+	"\x11Program not found\x12G\n" +
+	"\x03409\x12@\n" +
+	">\n" +
+	"<Conflict - The program already has a season with this numberZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#*!/api/v1/cms/episodes/{episode_id}\x12\xb7\x02\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/cms/programs/{program_id}/seasons\x12\x94\x03\n" +
+	"\vListSeasons\x12\x1f.thmanyah.v1.ListSeasonsRequest\x1a .thmanyah.v1.ListSeasonsResponse\"\xc1\x02\xbaG\x8c\x02\x12\x1dList the seasons of a program\x1atLists the seasons of a program by sort order, then season number, with how many episodes each has outside the trash.Bc\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Program not foundZ\x10\n" +
+	"\x0e\n" +
 	"\n" +
-	"GetEpisode\x12\x1e.thmanyah.v1.GetEpisodeRequest\x1a\x1f.thmanyah.v1.GetEpisodeResponse\"\xe7\x01\xbaG\xba\x01\x12\x16Get a specific episode\x1aBRetrieves detailed information about a specific episode by its ID.BJ\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02+\x12)/api/v1/cms/programs/{program_id}/seasons\x12\x9b\x02\n" +
+	"\tGetSeason\x12\x1d.thmanyah.v1.GetSeasonRequest\x1a\x1e.thmanyah.v1.GetSeasonResponse\"\xce\x01\xbaG\xa3\x01\x12\fGet a season\x1a\x1dRetrieves a season by its ID.Bb\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failed\x12\x1c\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Season not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/cms/seasons/{season_id}\x12\xfb\x03\n" +
+	"\fUpdateSeason\x12 .thmanyah.v1.UpdateSeasonRequest\x1a!.thmanyah.v1.UpdateSeasonResponse\"\xa5\x03\xbaG\xf7\x02\x12\x0fUpdate a season\x1aoUpdates the fields of a season that are set. A new season number is carried over to the episodes of the season.B\xe0\x01\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the program\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Season not found\x12G\n" +
+	"\x03409\x12@\n" +
+	">\n" +
+	"<Conflict - The program already has a season with this numberZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/api/v1/cms/seasons/{season_id}\x12\xd2\x03\n" +
+	"\fDeleteSeason\x12 .thmanyah.v1.DeleteSeasonRequest\x1a\x16.google.protobuf.Empty\"\x87\x03\xbaG\xdc\x02\x12\x0fDelete a season\x1ahDeletes an empty season for good. Seasons with episodes, including ones in the trash, cannot be deleted.B\xcc\x01\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the program\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Season not found\x123\n" +
+	"\x03409\x12,\n" +
+	"*\n" +
+	"(Conflict - The season still has episodesZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02!*\x1f/api/v1/cms/seasons/{season_id}\x12\xe6\x05\n" +
+	"\x0fReorderEpisodes\x12#.thmanyah.v1.ReorderEpisodesRequest\x1a$.thmanyah.v1.ReorderEpisodesResponse\"\x87\x05\xbaG\xc8\x04\x12 Reorder the episodes of a season\x1a\xbc\x02Numbers the listed episodes from first_number on in the given order, in one transaction, so episodes can be swapped or inserted in the middle. episode_ids must list every episode of the season; listing an episode from another season of the program moves it to this one. Returns the episodes with their final numbers.B\xd2\x01\x12Z\n" +
+	"\x03400\x12S\n" +
+	"Q\n" +
+	"OBad Request - Validation failed, or the order leaves out episodes of the season\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the program\x12&\n" +
+	"\x03404\x12\x1f\n" +
+	"\x1d\n" +
+	"\x1bSeason or episode not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/cms/seasons/{season_id}/episodes/reorder\x12\x83\x03\n" +
+	"\x12ListSeasonEpisodes\x12&.thmanyah.v1.ListSeasonEpisodesRequest\x1a'.thmanyah.v1.ListSeasonEpisodesResponse\"\x9b\x02\xbaG\xe7\x01\x12\x1dList the episodes of a season\x1aPLists the episodes of a season, by episode number unless sort_by says otherwise.Bb\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Season not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02*\x12(/api/v1/cms/seasons/{season_id}/episodes\x12\xa9\x02\n" +
+	"\fListChapters\x12 .thmanyah.v1.ListChaptersRequest\x1a!.thmanyah.v1.ListChaptersResponse\"\xd3\x01\xbaG\x9d\x01\x12\x1fList the chapters of an episode\x1a/Lists the chapters of an episode by start time.B7\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1c\n" +
 	"\x03404\x12\x15\n" +
 	"\x13\n" +
 	"\x11Episode not foundZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#\x12!/api/v1/cms/episodes/{episode_id}\x12\xe5\x02\n" +
-	"\fListEpisodes\x12 .thmanyah.v1.ListEpisodesRequest\x1a!.thmanyah.v1.ListEpisodesResponse\"\x8f\x02\xbaG\xd9\x01\x12\x1bList episodes for a program\x1azRetrieves a paginated list of episodes for a specific program with optional filtering by status, season, and search query.B,\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02,\x12*/api/v1/cms/episodes/{episode_id}/chapters\x12\x83\x04\n" +
+	"\rCreateChapter\x12!.thmanyah.v1.CreateChapterRequest\x1a\".thmanyah.v1.CreateChapterResponse\"\xaa\x03\xbaG\xf1\x02\x12\x1bAdd a chapter to an episode\x1aqAdds a chapter to an episode. Chapters must start and end within the duration of the episode, and cannot overlap.B\xcc\x01\x12^\n" +
+	"\x03400\x12W\n" +
+	"U\n" +
+	"SBad Request - Validation failed, or the chapter is out of range or overlaps another\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the episode\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Episode not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/cms/episodes/{episode_id}/chapters\x12\xdf\x03\n" +
+	"\rUpdateChapter\x12!.thmanyah.v1.UpdateChapterRequest\x1a\".thmanyah.v1.UpdateChapterResponse\"\x86\x03\xbaG\xd6\x02\x12\x10Update a chapter\x1aaUpdates the fields of a chapter that are set. An end_seconds of 0 removes the end of the chapter.B\xcc\x01\x12^\n" +
+	"\x03400\x12W\n" +
+	"U\n" +
+	"SBad Request - Validation failed, or the chapter is out of range or overlaps another\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the episode\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Chapter not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02&:\x01*\x1a!/api/v1/cms/chapters/{chapter_id}\x12\xae\x02\n" +
+	"\rDeleteChapter\x12!.thmanyah.v1.DeleteChapterRequest\x1a\x16.google.protobuf.Empty\"\xe1\x01\xbaG\xb4\x01\x12\x10Delete a chapter\x1a Deletes a chapter of an episode.Bl\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the episode\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Chapter not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#*!/api/v1/cms/chapters/{chapter_id}\x12\xe9\x03\n" +
+	"\x0fReplaceChapters\x12#.thmanyah.v1.ReplaceChaptersRequest\x1a$.thmanyah.v1.ReplaceChaptersResponse\"\x8a\x03\xbaG\xd1\x02\x12\"Replace the chapters of an episode\x1aLReplaces all chapters of an episode in one go. No chapters removes them all.B\xca\x01\x12\\\n" +
+	"\x03400\x12U\n" +
+	"S\n" +
+	"QBad Request - Validation failed, or a chapter is out of range or overlaps another\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the episode\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Episode not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02/:\x01*\x1a*/api/v1/cms/episodes/{episode_id}/chapters\x12\xe2\x04\n" +
+	"\x0eImportChapters\x12\".thmanyah.v1.ImportChaptersRequest\x1a#.thmanyah.v1.ImportChaptersResponse\"\x86\x04\xbaG\xc6\x03\x12(Import chapters from Podcasting 2.0 JSON\x1a\xaf\x01Replaces all chapters of an episode by the ones of a Podcasting 2.0 JSON chapters document. The same document is served by GET /api/v1/cms/episodes/{episode_id}/chapters.json.B\xd5\x01\x12g\n" +
+	"\x03400\x12`\n" +
+	"^\n" +
+	"\\Bad Request - Not a JSON chapters document, or a chapter is out of range or overlaps another\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the episode\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Episode not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/cms/episodes/{episode_id}/chapters/import\x12\x8a\x06\n" +
+	"\x10UploadTranscript\x12$.thmanyah.v1.UploadTranscriptRequest\x1a%.thmanyah.v1.UploadTranscriptResponse\"\xa8\x05\xbaG\xe3\x04\x12#Upload the transcript of an episode\x1a\xdf\x02Adds or replaces the transcript of an episode in a locale. WebVTT, SRT, plain text and Podcasting 2.0 JSON transcripts are accepted; without a format, it is detected from the content. The file is served as uploaded or converted to another format by GET /api/v1/cms/episodes/{episode_id}/transcripts/{locale}/file, and its text is searched by discover.B\xc7\x01\x12Y\n" +
+	"\x03400\x12R\n" +
+	"P\n" +
+	"NBad Request - Invalid locale, or the content is not a transcript in its format\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the episode\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Episode not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02;:\x01*\x1a6/api/v1/cms/episodes/{episode_id}/transcripts/{locale}\x12\xb7\x02\n" +
+	"\x0fListTranscripts\x12#.thmanyah.v1.ListTranscriptsRequest\x1a$.thmanyah.v1.ListTranscriptsResponse\"\xd8\x01\xbaG\x9f\x01\x12\"List the transcripts of an episode\x1a.Lists the transcripts of an episode by locale.B7\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13\n" +
+	"\x11Episode not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02/\x12-/api/v1/cms/episodes/{episode_id}/transcripts\x12\xb3\x03\n" +
+	"\x10DeleteTranscript\x12$.thmanyah.v1.DeleteTranscriptRequest\x1a\x16.google.protobuf.Empty\"\xe0\x02\xbaG\x9e\x02\x12#Delete the transcript of an episode\x1a?Removes the transcript of an episode in a locale, and its file.B\xa3\x01\x12'\n" +
+	"\x03400\x12 \n" +
+	"\x1e\n" +
+	"\x1cBad Request - Invalid locale\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the episode\x12*\n" +
+	"\x03404\x12#\n" +
+	"!\n" +
+	"\x1fEpisode or transcript not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x028*6/api/v1/cms/episodes/{episode_id}/transcripts/{locale}\x12\xf1\x02\n" +
+	"\fCreatePerson\x12 .thmanyah.v1.CreatePersonRequest\x1a!.thmanyah.v1.CreatePersonResponse\"\x9b\x02\xbaG\xfa\x01\x12\fAdd a person\x1a\x90\x01Adds a host, guest or producer to the people directory, which all programs share. Their name and bio can be translated with content type person.BE\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
-	"\x1fBad Request - Validation failedZ\x10\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorizedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/cms/people\x12\xfa\x01\n" +
+	"\tGetPerson\x12\x1d.thmanyah.v1.GetPersonRequest\x1a\x1e.thmanyah.v1.GetPersonResponse\"\xad\x01\xbaG\x83\x01\x12\fGet a person\x1a)Returns a person of the people directory.B6\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Person not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/cms/people/{person_id}\x12\xa6\x02\n" +
+	"\n" +
+	"ListPeople\x12\x1e.thmanyah.v1.ListPeopleRequest\x1a\x1f.thmanyah.v1.ListPeopleResponse\"\xd6\x01\xbaG\xb8\x01\x12\vList people\x1aPLists the people directory by name. A search query matches anywhere in the name.BE\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorizedZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/cms/people\x12\x80\x04\n" +
+	"\fUpdatePerson\x12 .thmanyah.v1.UpdatePersonRequest\x1a!.thmanyah.v1.UpdatePersonResponse\"\xaa\x03\xbaG\xfd\x02\x12\x0fUpdate a person\x1a\xa6\x01Changes a person of the people directory. Only the one who added them and reviewers can. A new name is searched for in the programs and episodes they are credited on.B\xae\x01\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12J\n" +
+	"\x03403\x12C\n" +
+	"A\n" +
+	"?Forbidden - Neither the one who added the person nor a reviewer\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Person not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/cms/people/{person_id}\x12\xa8\x03\n" +
+	"\fDeletePerson\x12 .thmanyah.v1.DeletePersonRequest\x1a\x16.google.protobuf.Empty\"\xdd\x02\xbaG\xb3\x02\x12\x0fDelete a person\x1a\x88\x01Removes a person from the people directory for good, with their credits and translations. Only the one who added them and reviewers can.B\x82\x01\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12J\n" +
+	"\x03403\x12C\n" +
+	"A\n" +
+	"?Forbidden - Neither the one who added the person nor a reviewer\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Person not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02 *\x1e/api/v1/cms/people/{person_id}\x12\xdb\x04\n" +
+	"\fCreateCredit\x12 .thmanyah.v1.CreateCreditRequest\x1a!.thmanyah.v1.CreateCreditResponse\"\x85\x04\xbaG\xe3\x03\x12\x0fCredit a person\x1a\xb0\x01Credits a person on a program or episode as host, guest or producer. Only the owner of the content can. Discover search finds content by the names of the people credited on it.B\x8a\x02\x12B\n" +
+	"\x03400\x12;\n" +
+	"9\n" +
+	"7Bad Request - Validation failed or invalid content type\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the content\x12/\n" +
+	"\x03404\x12(\n" +
+	"&\n" +
+	"$Person, program or episode not found\x12E\n" +
+	"\x03409\x12>\n" +
+	"<\n" +
+	":Conflict - The person already has this role on the contentZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/cms/credits\x12\xd7\x02\n" +
+	"\vListCredits\x12\x1f.thmanyah.v1.ListCreditsRequest\x1a .thmanyah.v1.ListCreditsResponse\"\x84\x02\xbaG\xe5\x01\x12\x1bList the credits of content\x1aALists the people credited on a program or episode, by sort order.Bq\x12-\n" +
+	"\x03400\x12&\n" +
+	"$\n" +
+	"\"Bad Request - Invalid content type\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12'\n" +
+	"\x03404\x12 \n" +
+	"\x1e\n" +
+	"\x1cProgram or episode not foundZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02,\x12*/api/v1/cms/programs/{program_id}/episodes\x12\xd7\x02\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/cms/credits\x12\xd8\x03\n" +
+	"\fUpdateCredit\x12 .thmanyah.v1.UpdateCreditRequest\x1a!.thmanyah.v1.UpdateCreditResponse\"\x82\x03\xbaG\xd4\x02\x12\x0fUpdate a credit\x1aNChanges the role or sort order of a credit. Only the owner of the content can.B\xde\x01\x12*\n" +
+	"\x03400\x12#\n" +
+	"!\n" +
+	"\x1fBad Request - Validation failed\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the content\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Credit not found\x12E\n" +
+	"\x03409\x12>\n" +
+	"<\n" +
+	":Conflict - The person already has this role on the contentZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/api/v1/cms/credits/{credit_id}\x12\xbc\x02\n" +
+	"\fDeleteCredit\x12 .thmanyah.v1.DeleteCreditRequest\x1a\x16.google.protobuf.Empty\"\xf1\x01\xbaG\xc6\x01\x12\x0fDelete a credit\x1a4Removes a credit. Only the owner of the content can.Bk\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x123\n" +
+	"\x03403\x12,\n" +
+	"*\n" +
+	"(Forbidden - Not the owner of the content\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Credit not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02!*\x1f/api/v1/cms/credits/{credit_id}\x12\x97\x03\n" +
+	"\x13ListCreditsByPerson\x12'.thmanyah.v1.ListCreditsByPersonRequest\x1a(.thmanyah.v1.ListCreditsByPersonResponse\"\xac\x02\xbaG\xfa\x01\x12\x1cList the credits of a person\x1a\x8f\x01Lists the programs and episodes a person is credited on, most recently added first, including content that is not published or is in the trash.B6\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12\n" +
+	"\x10Person not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02(\x12&/api/v1/cms/people/{person_id}/credits\x12\xed\x04\n" +
+	"\x11CheckAvailability\x12%.thmanyah.v1.CheckAvailabilityRequest\x1a&.thmanyah.v1.CheckAvailabilityResponse\"\x88\x04\xbaG\xe4\x03\x12 Check why content is unavailable\x1a\xaf\x02Tells whether listeners in a country can see a program or episode now and, if not, why: it is not published, outside its availability window, or not licensed in the country. Episodes are also hidden while their program is unavailable. Without a country, only the parts that apply everywhere are checked.B|\x128\n" +
+	"\x03400\x121\n" +
+	"/\n" +
+	"-Bad Request - Invalid content type or country\x12\x17\n" +
+	"\x03401\x12\x10\n" +
+	"\x0e\n" +
+	"\fUnauthorized\x12'\n" +
+	"\x03404\x12 \n" +
+	"\x1e\n" +
+	"\x1cProgram or episode not foundZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/cms/availability\x12\xd7\x02\n" +
 	"\n" +
 	"ImportData\x12\x1e.thmanyah.v1.ImportDataRequest\x1a\x1f.thmanyah.v1.ImportDataResponse\"\x87\x02\xbaG\xe6\x01\x12!Import data from external sources\x1a\x80\x01Imports programs and episodes from external sources like YouTube, RSS feeds, JSON, or CSV files with configurable field mapping.B,\x12*\n" +
 	"\x03400\x12#\n" +
@@ -3557,15 +12771,22 @@ const file_v1_cms_proto_rawDesc = "" +
 	"\x1fBad Request - Validation failedZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/cms/import\x12\xe5\x02\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/cms/import\x12\xb5\x03\n" +
+	"\vWatchImport\x12\x1f.thmanyah.v1.WatchImportRequest\x1a\x18.thmanyah.v1.ImportEvent\"\xe8\x02\xbaG\xb6\x02\x12\x15Watch import progress\x1a\xda\x01Streams progress, warnings and errors of an import as they happen. Over HTTP the stream is served as text/event-stream. The first event is the current state and the stream closes once the import is completed or failed.B.\x12,\n" +
+	"\x03404\x12%\n" +
+	"#\n" +
+	"!Not Found - Import does not existZ\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02(\x12&/api/v1/cms/imports/{import_id}/events0\x01\x12\xe5\x02\n" +
 	"\x12BulkUpdatePrograms\x12&.thmanyah.v1.BulkUpdateProgramsRequest\x1a'.thmanyah.v1.BulkUpdateProgramsResponse\"\xfd\x01\xbaG\xce\x01\x12\x14Bulk update programs\x1avUpdates multiple programs at once with the same changes, useful for batch operations like changing status or category.B,\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
 	"\x1fBad Request - Validation failedZ\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/cms/programs/bulk-update\x12\xb5\x02\n" +
-	"\x12BulkDeletePrograms\x12&.thmanyah.v1.BulkDeleteProgramsRequest\x1a\x16.google.protobuf.Empty\"\xde\x01\xbaG\xaf\x01\x12\x14Bulk delete programs\x1aWPermanently deletes multiple programs at once, including all their associated episodes.B,\x12*\n" +
+	"bearerAuth\x12\x00\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/cms/programs/bulk-update\x12\xa9\x02\n" +
+	"\x12BulkDeletePrograms\x12&.thmanyah.v1.BulkDeleteProgramsRequest\x1a\x16.google.protobuf.Empty\"\xd2\x01\xbaG\xa3\x01\x12\x14Bulk delete programs\x1aKMoves multiple programs at once to the trash, including all their episodes.B,\x12*\n" +
 	"\x03400\x12#\n" +
 	"!\n" +
 	"\x1fBad Request - Validation failedZ\x10\n" +
@@ -3590,159 +12811,538 @@ func file_v1_cms_proto_rawDescGZIP() []byte {
 	return file_v1_cms_proto_rawDescData
 }
 
-var file_v1_cms_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_v1_cms_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_v1_cms_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_v1_cms_proto_msgTypes = make([]protoimpl.MessageInfo, 175)
 var file_v1_cms_proto_goTypes = []any{
-	(CategoryType)(0),                  // 0: thmanyah.v1.CategoryType
-	(ProgramStatus)(0),                 // 1: thmanyah.v1.ProgramStatus
-	(EpisodeStatus)(0),                 // 2: thmanyah.v1.EpisodeStatus
-	(ImportStatus)(0),                  // 3: thmanyah.v1.ImportStatus
-	(*Category)(nil),                   // 4: thmanyah.v1.Category
-	(*Program)(nil),                    // 5: thmanyah.v1.Program
-	(*Episode)(nil),                    // 6: thmanyah.v1.Episode
-	(*CreateProgramRequest)(nil),       // 7: thmanyah.v1.CreateProgramRequest
-	(*CreateProgramResponse)(nil),      // 8: thmanyah.v1.CreateProgramResponse
-	(*UpdateProgramRequest)(nil),       // 9: thmanyah.v1.UpdateProgramRequest
-	(*UpdateProgramResponse)(nil),      // 10: thmanyah.v1.UpdateProgramResponse
-	(*DeleteProgramRequest)(nil),       // 11: thmanyah.v1.DeleteProgramRequest
-	(*GetProgramRequest)(nil),          // 12: thmanyah.v1.GetProgramRequest
-	(*GetProgramResponse)(nil),         // 13: thmanyah.v1.GetProgramResponse
-	(*ListProgramsRequest)(nil),        // 14: thmanyah.v1.ListProgramsRequest
-	(*ListProgramsResponse)(nil),       // 15: thmanyah.v1.ListProgramsResponse
-	(*CreateCategoryRequest)(nil),      // 16: thmanyah.v1.CreateCategoryRequest
-	(*CreateCategoryResponse)(nil),     // 17: thmanyah.v1.CreateCategoryResponse
-	(*UpdateCategoryRequest)(nil),      // 18: thmanyah.v1.UpdateCategoryRequest
-	(*UpdateCategoryResponse)(nil),     // 19: thmanyah.v1.UpdateCategoryResponse
-	(*DeleteCategoryRequest)(nil),      // 20: thmanyah.v1.DeleteCategoryRequest
-	(*GetCategoryRequest)(nil),         // 21: thmanyah.v1.GetCategoryRequest
-	(*GetCategoryResponse)(nil),        // 22: thmanyah.v1.GetCategoryResponse
-	(*ListCategoriesRequest)(nil),      // 23: thmanyah.v1.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil),     // 24: thmanyah.v1.ListCategoriesResponse
-	(*CreateEpisodeRequest)(nil),       // 25: thmanyah.v1.CreateEpisodeRequest
-	(*CreateEpisodeResponse)(nil),      // 26: thmanyah.v1.CreateEpisodeResponse
-	(*UpdateEpisodeRequest)(nil),       // 27: thmanyah.v1.UpdateEpisodeRequest
-	(*UpdateEpisodeResponse)(nil),      // 28: thmanyah.v1.UpdateEpisodeResponse
-	(*DeleteEpisodeRequest)(nil),       // 29: thmanyah.v1.DeleteEpisodeRequest
-	(*GetEpisodeRequest)(nil),          // 30: thmanyah.v1.GetEpisodeRequest
-	(*GetEpisodeResponse)(nil),         // 31: thmanyah.v1.GetEpisodeResponse
-	(*ListEpisodesRequest)(nil),        // 32: thmanyah.v1.ListEpisodesRequest
-	(*ListEpisodesResponse)(nil),       // 33: thmanyah.v1.ListEpisodesResponse
-	(*ImportDataRequest)(nil),          // 34: thmanyah.v1.ImportDataRequest
-	(*ImportDataResponse)(nil),         // 35: thmanyah.v1.ImportDataResponse
-	(*BulkUpdateProgramsRequest)(nil),  // 36: thmanyah.v1.BulkUpdateProgramsRequest
-	(*BulkUpdateProgramsResponse)(nil), // 37: thmanyah.v1.BulkUpdateProgramsResponse
-	(*BulkDeleteProgramsRequest)(nil),  // 38: thmanyah.v1.BulkDeleteProgramsRequest
-	(*PaginationMetadata)(nil),         // 39: thmanyah.v1.PaginationMetadata
-	(*SortOptions)(nil),                // 40: thmanyah.v1.SortOptions
-	(*FilterOptions)(nil),              // 41: thmanyah.v1.FilterOptions
-	(*EpisodeFileUpdateResponse)(nil),  // 42: thmanyah.v1.EpisodeFileUpdateResponse
-	nil,                                // 43: thmanyah.v1.Category.MetadataEntry
-	nil,                                // 44: thmanyah.v1.Program.MetadataEntry
-	nil,                                // 45: thmanyah.v1.Episode.MetadataEntry
-	nil,                                // 46: thmanyah.v1.CreateProgramRequest.MetadataEntry
-	nil,                                // 47: thmanyah.v1.UpdateProgramRequest.MetadataEntry
-	nil,                                // 48: thmanyah.v1.CreateCategoryRequest.MetadataEntry
-	nil,                                // 49: thmanyah.v1.UpdateCategoryRequest.MetadataEntry
-	nil,                                // 50: thmanyah.v1.CreateEpisodeRequest.MetadataEntry
-	nil,                                // 51: thmanyah.v1.UpdateEpisodeRequest.MetadataEntry
-	nil,                                // 52: thmanyah.v1.ImportDataRequest.SourceConfigEntry
-	nil,                                // 53: thmanyah.v1.ImportDataRequest.FieldMappingEntry
-	nil,                                // 54: thmanyah.v1.BulkUpdateProgramsRequest.MetadataEntry
-	nil,                                // 55: thmanyah.v1.FilterOptions.FiltersEntry
-	(*timestamppb.Timestamp)(nil),      // 56: google.protobuf.Timestamp
-	(*anypb.Any)(nil),                  // 57: google.protobuf.Any
-	(*emptypb.Empty)(nil),              // 58: google.protobuf.Empty
+	(CategoryType)(0),                     // 0: thmanyah.v1.CategoryType
+	(ProgramStatus)(0),                    // 1: thmanyah.v1.ProgramStatus
+	(EpisodeStatus)(0),                    // 2: thmanyah.v1.EpisodeStatus
+	(ContentType)(0),                      // 3: thmanyah.v1.ContentType
+	(TranscriptFormat)(0),                 // 4: thmanyah.v1.TranscriptFormat
+	(CreditRole)(0),                       // 5: thmanyah.v1.CreditRole
+	(UnavailableReason)(0),                // 6: thmanyah.v1.UnavailableReason
+	(ImportStatus)(0),                     // 7: thmanyah.v1.ImportStatus
+	(ImportEventType)(0),                  // 8: thmanyah.v1.ImportEventType
+	(*Category)(nil),                      // 9: thmanyah.v1.Category
+	(*Program)(nil),                       // 10: thmanyah.v1.Program
+	(*Episode)(nil),                       // 11: thmanyah.v1.Episode
+	(*Availability)(nil),                  // 12: thmanyah.v1.Availability
+	(*CreateProgramRequest)(nil),          // 13: thmanyah.v1.CreateProgramRequest
+	(*CreateProgramResponse)(nil),         // 14: thmanyah.v1.CreateProgramResponse
+	(*UpdateProgramRequest)(nil),          // 15: thmanyah.v1.UpdateProgramRequest
+	(*UpdateProgramResponse)(nil),         // 16: thmanyah.v1.UpdateProgramResponse
+	(*DeleteProgramRequest)(nil),          // 17: thmanyah.v1.DeleteProgramRequest
+	(*GetProgramRequest)(nil),             // 18: thmanyah.v1.GetProgramRequest
+	(*GetProgramResponse)(nil),            // 19: thmanyah.v1.GetProgramResponse
+	(*ListProgramsRequest)(nil),           // 20: thmanyah.v1.ListProgramsRequest
+	(*ListProgramsResponse)(nil),          // 21: thmanyah.v1.ListProgramsResponse
+	(*BatchGetProgramsRequest)(nil),       // 22: thmanyah.v1.BatchGetProgramsRequest
+	(*BatchGetProgramsResponse)(nil),      // 23: thmanyah.v1.BatchGetProgramsResponse
+	(*CreateCategoryRequest)(nil),         // 24: thmanyah.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),        // 25: thmanyah.v1.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),         // 26: thmanyah.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),        // 27: thmanyah.v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),         // 28: thmanyah.v1.DeleteCategoryRequest
+	(*GetCategoryRequest)(nil),            // 29: thmanyah.v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),           // 30: thmanyah.v1.GetCategoryResponse
+	(*ListCategoriesRequest)(nil),         // 31: thmanyah.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),        // 32: thmanyah.v1.ListCategoriesResponse
+	(*BatchGetCategoriesRequest)(nil),     // 33: thmanyah.v1.BatchGetCategoriesRequest
+	(*BatchGetCategoriesResponse)(nil),    // 34: thmanyah.v1.BatchGetCategoriesResponse
+	(*CreateEpisodeRequest)(nil),          // 35: thmanyah.v1.CreateEpisodeRequest
+	(*CreateEpisodeResponse)(nil),         // 36: thmanyah.v1.CreateEpisodeResponse
+	(*UpdateEpisodeRequest)(nil),          // 37: thmanyah.v1.UpdateEpisodeRequest
+	(*UpdateEpisodeResponse)(nil),         // 38: thmanyah.v1.UpdateEpisodeResponse
+	(*RescheduleEpisodeRequest)(nil),      // 39: thmanyah.v1.RescheduleEpisodeRequest
+	(*RescheduleEpisodeResponse)(nil),     // 40: thmanyah.v1.RescheduleEpisodeResponse
+	(*CancelEpisodeScheduleRequest)(nil),  // 41: thmanyah.v1.CancelEpisodeScheduleRequest
+	(*CancelEpisodeScheduleResponse)(nil), // 42: thmanyah.v1.CancelEpisodeScheduleResponse
+	(*StatusTransition)(nil),              // 43: thmanyah.v1.StatusTransition
+	(*SubmitForReviewRequest)(nil),        // 44: thmanyah.v1.SubmitForReviewRequest
+	(*ApproveRequest)(nil),                // 45: thmanyah.v1.ApproveRequest
+	(*RejectRequest)(nil),                 // 46: thmanyah.v1.RejectRequest
+	(*ReviewResponse)(nil),                // 47: thmanyah.v1.ReviewResponse
+	(*ListStatusTransitionsRequest)(nil),  // 48: thmanyah.v1.ListStatusTransitionsRequest
+	(*ListStatusTransitionsResponse)(nil), // 49: thmanyah.v1.ListStatusTransitionsResponse
+	(*Revision)(nil),                      // 50: thmanyah.v1.Revision
+	(*FieldChange)(nil),                   // 51: thmanyah.v1.FieldChange
+	(*ListRevisionsRequest)(nil),          // 52: thmanyah.v1.ListRevisionsRequest
+	(*ListRevisionsResponse)(nil),         // 53: thmanyah.v1.ListRevisionsResponse
+	(*GetRevisionRequest)(nil),            // 54: thmanyah.v1.GetRevisionRequest
+	(*GetRevisionResponse)(nil),           // 55: thmanyah.v1.GetRevisionResponse
+	(*DiffRevisionsRequest)(nil),          // 56: thmanyah.v1.DiffRevisionsRequest
+	(*DiffRevisionsResponse)(nil),         // 57: thmanyah.v1.DiffRevisionsResponse
+	(*RestoreRevisionRequest)(nil),        // 58: thmanyah.v1.RestoreRevisionRequest
+	(*RestoreRevisionResponse)(nil),       // 59: thmanyah.v1.RestoreRevisionResponse
+	(*TrashItem)(nil),                     // 60: thmanyah.v1.TrashItem
+	(*ListTrashRequest)(nil),              // 61: thmanyah.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),             // 62: thmanyah.v1.ListTrashResponse
+	(*RestoreFromTrashRequest)(nil),       // 63: thmanyah.v1.RestoreFromTrashRequest
+	(*RestoreFromTrashResponse)(nil),      // 64: thmanyah.v1.RestoreFromTrashResponse
+	(*PurgeTrashRequest)(nil),             // 65: thmanyah.v1.PurgeTrashRequest
+	(*PurgeTrashResponse)(nil),            // 66: thmanyah.v1.PurgeTrashResponse
+	(*AuditEvent)(nil),                    // 67: thmanyah.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),        // 68: thmanyah.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),       // 69: thmanyah.v1.ListAuditEventsResponse
+	(*CategoryNode)(nil),                  // 70: thmanyah.v1.CategoryNode
+	(*GetCategoryTreeRequest)(nil),        // 71: thmanyah.v1.GetCategoryTreeRequest
+	(*GetCategoryTreeResponse)(nil),       // 72: thmanyah.v1.GetCategoryTreeResponse
+	(*MoveCategoryRequest)(nil),           // 73: thmanyah.v1.MoveCategoryRequest
+	(*MoveCategoryResponse)(nil),          // 74: thmanyah.v1.MoveCategoryResponse
+	(*SetProgramCategoriesRequest)(nil),   // 75: thmanyah.v1.SetProgramCategoriesRequest
+	(*SetProgramCategoriesResponse)(nil),  // 76: thmanyah.v1.SetProgramCategoriesResponse
+	(*Tag)(nil),                           // 77: thmanyah.v1.Tag
+	(*ListTagsRequest)(nil),               // 78: thmanyah.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),              // 79: thmanyah.v1.ListTagsResponse
+	(*AutocompleteTagsRequest)(nil),       // 80: thmanyah.v1.AutocompleteTagsRequest
+	(*AutocompleteTagsResponse)(nil),      // 81: thmanyah.v1.AutocompleteTagsResponse
+	(*UpdateTagRequest)(nil),              // 82: thmanyah.v1.UpdateTagRequest
+	(*UpdateTagResponse)(nil),             // 83: thmanyah.v1.UpdateTagResponse
+	(*RenameTagRequest)(nil),              // 84: thmanyah.v1.RenameTagRequest
+	(*RenameTagResponse)(nil),             // 85: thmanyah.v1.RenameTagResponse
+	(*MergeTagsRequest)(nil),              // 86: thmanyah.v1.MergeTagsRequest
+	(*MergeTagsResponse)(nil),             // 87: thmanyah.v1.MergeTagsResponse
+	(*Translation)(nil),                   // 88: thmanyah.v1.Translation
+	(*ListTranslationsRequest)(nil),       // 89: thmanyah.v1.ListTranslationsRequest
+	(*ListTranslationsResponse)(nil),      // 90: thmanyah.v1.ListTranslationsResponse
+	(*SetTranslationRequest)(nil),         // 91: thmanyah.v1.SetTranslationRequest
+	(*SetTranslationResponse)(nil),        // 92: thmanyah.v1.SetTranslationResponse
+	(*DeleteTranslationRequest)(nil),      // 93: thmanyah.v1.DeleteTranslationRequest
+	(*Season)(nil),                        // 94: thmanyah.v1.Season
+	(*CreateSeasonRequest)(nil),           // 95: thmanyah.v1.CreateSeasonRequest
+	(*CreateSeasonResponse)(nil),          // 96: thmanyah.v1.CreateSeasonResponse
+	(*GetSeasonRequest)(nil),              // 97: thmanyah.v1.GetSeasonRequest
+	(*GetSeasonResponse)(nil),             // 98: thmanyah.v1.GetSeasonResponse
+	(*ListSeasonsRequest)(nil),            // 99: thmanyah.v1.ListSeasonsRequest
+	(*ListSeasonsResponse)(nil),           // 100: thmanyah.v1.ListSeasonsResponse
+	(*UpdateSeasonRequest)(nil),           // 101: thmanyah.v1.UpdateSeasonRequest
+	(*UpdateSeasonResponse)(nil),          // 102: thmanyah.v1.UpdateSeasonResponse
+	(*DeleteSeasonRequest)(nil),           // 103: thmanyah.v1.DeleteSeasonRequest
+	(*ReorderEpisodesRequest)(nil),        // 104: thmanyah.v1.ReorderEpisodesRequest
+	(*ReorderEpisodesResponse)(nil),       // 105: thmanyah.v1.ReorderEpisodesResponse
+	(*ListSeasonEpisodesRequest)(nil),     // 106: thmanyah.v1.ListSeasonEpisodesRequest
+	(*ListSeasonEpisodesResponse)(nil),    // 107: thmanyah.v1.ListSeasonEpisodesResponse
+	(*Chapter)(nil),                       // 108: thmanyah.v1.Chapter
+	(*ChapterInput)(nil),                  // 109: thmanyah.v1.ChapterInput
+	(*ListChaptersRequest)(nil),           // 110: thmanyah.v1.ListChaptersRequest
+	(*ListChaptersResponse)(nil),          // 111: thmanyah.v1.ListChaptersResponse
+	(*CreateChapterRequest)(nil),          // 112: thmanyah.v1.CreateChapterRequest
+	(*CreateChapterResponse)(nil),         // 113: thmanyah.v1.CreateChapterResponse
+	(*UpdateChapterRequest)(nil),          // 114: thmanyah.v1.UpdateChapterRequest
+	(*UpdateChapterResponse)(nil),         // 115: thmanyah.v1.UpdateChapterResponse
+	(*DeleteChapterRequest)(nil),          // 116: thmanyah.v1.DeleteChapterRequest
+	(*ReplaceChaptersRequest)(nil),        // 117: thmanyah.v1.ReplaceChaptersRequest
+	(*ReplaceChaptersResponse)(nil),       // 118: thmanyah.v1.ReplaceChaptersResponse
+	(*ImportChaptersRequest)(nil),         // 119: thmanyah.v1.ImportChaptersRequest
+	(*ImportChaptersResponse)(nil),        // 120: thmanyah.v1.ImportChaptersResponse
+	(*Transcript)(nil),                    // 121: thmanyah.v1.Transcript
+	(*UploadTranscriptRequest)(nil),       // 122: thmanyah.v1.UploadTranscriptRequest
+	(*UploadTranscriptResponse)(nil),      // 123: thmanyah.v1.UploadTranscriptResponse
+	(*ListTranscriptsRequest)(nil),        // 124: thmanyah.v1.ListTranscriptsRequest
+	(*ListTranscriptsResponse)(nil),       // 125: thmanyah.v1.ListTranscriptsResponse
+	(*DeleteTranscriptRequest)(nil),       // 126: thmanyah.v1.DeleteTranscriptRequest
+	(*Person)(nil),                        // 127: thmanyah.v1.Person
+	(*PersonLink)(nil),                    // 128: thmanyah.v1.PersonLink
+	(*PersonLinks)(nil),                   // 129: thmanyah.v1.PersonLinks
+	(*Credit)(nil),                        // 130: thmanyah.v1.Credit
+	(*CreatePersonRequest)(nil),           // 131: thmanyah.v1.CreatePersonRequest
+	(*CreatePersonResponse)(nil),          // 132: thmanyah.v1.CreatePersonResponse
+	(*GetPersonRequest)(nil),              // 133: thmanyah.v1.GetPersonRequest
+	(*GetPersonResponse)(nil),             // 134: thmanyah.v1.GetPersonResponse
+	(*ListPeopleRequest)(nil),             // 135: thmanyah.v1.ListPeopleRequest
+	(*ListPeopleResponse)(nil),            // 136: thmanyah.v1.ListPeopleResponse
+	(*UpdatePersonRequest)(nil),           // 137: thmanyah.v1.UpdatePersonRequest
+	(*UpdatePersonResponse)(nil),          // 138: thmanyah.v1.UpdatePersonResponse
+	(*DeletePersonRequest)(nil),           // 139: thmanyah.v1.DeletePersonRequest
+	(*CreateCreditRequest)(nil),           // 140: thmanyah.v1.CreateCreditRequest
+	(*CreateCreditResponse)(nil),          // 141: thmanyah.v1.CreateCreditResponse
+	(*ListCreditsRequest)(nil),            // 142: thmanyah.v1.ListCreditsRequest
+	(*ListCreditsResponse)(nil),           // 143: thmanyah.v1.ListCreditsResponse
+	(*UpdateCreditRequest)(nil),           // 144: thmanyah.v1.UpdateCreditRequest
+	(*UpdateCreditResponse)(nil),          // 145: thmanyah.v1.UpdateCreditResponse
+	(*DeleteCreditRequest)(nil),           // 146: thmanyah.v1.DeleteCreditRequest
+	(*ListCreditsByPersonRequest)(nil),    // 147: thmanyah.v1.ListCreditsByPersonRequest
+	(*ListCreditsByPersonResponse)(nil),   // 148: thmanyah.v1.ListCreditsByPersonResponse
+	(*CheckAvailabilityRequest)(nil),      // 149: thmanyah.v1.CheckAvailabilityRequest
+	(*CheckAvailabilityResponse)(nil),     // 150: thmanyah.v1.CheckAvailabilityResponse
+	(*DeleteEpisodeRequest)(nil),          // 151: thmanyah.v1.DeleteEpisodeRequest
+	(*GetEpisodeRequest)(nil),             // 152: thmanyah.v1.GetEpisodeRequest
+	(*GetEpisodeResponse)(nil),            // 153: thmanyah.v1.GetEpisodeResponse
+	(*ListEpisodesRequest)(nil),           // 154: thmanyah.v1.ListEpisodesRequest
+	(*ListEpisodesResponse)(nil),          // 155: thmanyah.v1.ListEpisodesResponse
+	(*BatchGetEpisodesRequest)(nil),       // 156: thmanyah.v1.BatchGetEpisodesRequest
+	(*BatchGetEpisodesResponse)(nil),      // 157: thmanyah.v1.BatchGetEpisodesResponse
+	(*ImportDataRequest)(nil),             // 158: thmanyah.v1.ImportDataRequest
+	(*ImportDataResponse)(nil),            // 159: thmanyah.v1.ImportDataResponse
+	(*WatchImportRequest)(nil),            // 160: thmanyah.v1.WatchImportRequest
+	(*ImportEvent)(nil),                   // 161: thmanyah.v1.ImportEvent
+	(*BulkUpdateProgramsRequest)(nil),     // 162: thmanyah.v1.BulkUpdateProgramsRequest
+	(*BulkUpdateProgramsResponse)(nil),    // 163: thmanyah.v1.BulkUpdateProgramsResponse
+	(*BulkDeleteProgramsRequest)(nil),     // 164: thmanyah.v1.BulkDeleteProgramsRequest
+	(*PaginationMetadata)(nil),            // 165: thmanyah.v1.PaginationMetadata
+	(*SortOptions)(nil),                   // 166: thmanyah.v1.SortOptions
+	(*FilterOptions)(nil),                 // 167: thmanyah.v1.FilterOptions
+	(*EpisodeFileUpdateResponse)(nil),     // 168: thmanyah.v1.EpisodeFileUpdateResponse
+	nil,                                   // 169: thmanyah.v1.Category.MetadataEntry
+	nil,                                   // 170: thmanyah.v1.Program.MetadataEntry
+	nil,                                   // 171: thmanyah.v1.Episode.MetadataEntry
+	nil,                                   // 172: thmanyah.v1.CreateProgramRequest.MetadataEntry
+	nil,                                   // 173: thmanyah.v1.UpdateProgramRequest.MetadataEntry
+	nil,                                   // 174: thmanyah.v1.CreateCategoryRequest.MetadataEntry
+	nil,                                   // 175: thmanyah.v1.UpdateCategoryRequest.MetadataEntry
+	nil,                                   // 176: thmanyah.v1.CreateEpisodeRequest.MetadataEntry
+	nil,                                   // 177: thmanyah.v1.UpdateEpisodeRequest.MetadataEntry
+	nil,                                   // 178: thmanyah.v1.Tag.TranslationsEntry
+	nil,                                   // 179: thmanyah.v1.UpdateTagRequest.TranslationsEntry
+	nil,                                   // 180: thmanyah.v1.ImportDataRequest.SourceConfigEntry
+	nil,                                   // 181: thmanyah.v1.ImportDataRequest.FieldMappingEntry
+	nil,                                   // 182: thmanyah.v1.BulkUpdateProgramsRequest.MetadataEntry
+	nil,                                   // 183: thmanyah.v1.FilterOptions.FiltersEntry
+	(*timestamppb.Timestamp)(nil),         // 184: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),               // 185: google.protobuf.Struct
+	(*structpb.Value)(nil),                // 186: google.protobuf.Value
+	(*anypb.Any)(nil),                     // 187: google.protobuf.Any
+	(*emptypb.Empty)(nil),                 // 188: google.protobuf.Empty
 }
 var file_v1_cms_proto_depIdxs = []int32{
-	0,  // 0: thmanyah.v1.Category.type:type_name -> thmanyah.v1.CategoryType
-	56, // 1: thmanyah.v1.Category.created_at:type_name -> google.protobuf.Timestamp
-	56, // 2: thmanyah.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
-	43, // 3: thmanyah.v1.Category.metadata:type_name -> thmanyah.v1.Category.MetadataEntry
-	1,  // 4: thmanyah.v1.Program.status:type_name -> thmanyah.v1.ProgramStatus
-	56, // 5: thmanyah.v1.Program.created_at:type_name -> google.protobuf.Timestamp
-	56, // 6: thmanyah.v1.Program.updated_at:type_name -> google.protobuf.Timestamp
-	56, // 7: thmanyah.v1.Program.published_at:type_name -> google.protobuf.Timestamp
-	44, // 8: thmanyah.v1.Program.metadata:type_name -> thmanyah.v1.Program.MetadataEntry
-	2,  // 9: thmanyah.v1.Episode.status:type_name -> thmanyah.v1.EpisodeStatus
-	56, // 10: thmanyah.v1.Episode.created_at:type_name -> google.protobuf.Timestamp
-	56, // 11: thmanyah.v1.Episode.updated_at:type_name -> google.protobuf.Timestamp
-	56, // 12: thmanyah.v1.Episode.published_at:type_name -> google.protobuf.Timestamp
-	56, // 13: thmanyah.v1.Episode.scheduled_at:type_name -> google.protobuf.Timestamp
-	45, // 14: thmanyah.v1.Episode.metadata:type_name -> thmanyah.v1.Episode.MetadataEntry
-	46, // 15: thmanyah.v1.CreateProgramRequest.metadata:type_name -> thmanyah.v1.CreateProgramRequest.MetadataEntry
-	5,  // 16: thmanyah.v1.CreateProgramResponse.program:type_name -> thmanyah.v1.Program
-	1,  // 17: thmanyah.v1.UpdateProgramRequest.status:type_name -> thmanyah.v1.ProgramStatus
-	47, // 18: thmanyah.v1.UpdateProgramRequest.metadata:type_name -> thmanyah.v1.UpdateProgramRequest.MetadataEntry
-	5,  // 19: thmanyah.v1.UpdateProgramResponse.program:type_name -> thmanyah.v1.Program
-	5,  // 20: thmanyah.v1.GetProgramResponse.program:type_name -> thmanyah.v1.Program
-	1,  // 21: thmanyah.v1.ListProgramsRequest.status:type_name -> thmanyah.v1.ProgramStatus
-	5,  // 22: thmanyah.v1.ListProgramsResponse.programs:type_name -> thmanyah.v1.Program
-	0,  // 23: thmanyah.v1.CreateCategoryRequest.type:type_name -> thmanyah.v1.CategoryType
-	48, // 24: thmanyah.v1.CreateCategoryRequest.metadata:type_name -> thmanyah.v1.CreateCategoryRequest.MetadataEntry
-	4,  // 25: thmanyah.v1.CreateCategoryResponse.category:type_name -> thmanyah.v1.Category
-	0,  // 26: thmanyah.v1.UpdateCategoryRequest.type:type_name -> thmanyah.v1.CategoryType
-	49, // 27: thmanyah.v1.UpdateCategoryRequest.metadata:type_name -> thmanyah.v1.UpdateCategoryRequest.MetadataEntry
-	4,  // 28: thmanyah.v1.UpdateCategoryResponse.category:type_name -> thmanyah.v1.Category
-	4,  // 29: thmanyah.v1.GetCategoryResponse.category:type_name -> thmanyah.v1.Category
-	0,  // 30: thmanyah.v1.ListCategoriesRequest.type:type_name -> thmanyah.v1.CategoryType
-	4,  // 31: thmanyah.v1.ListCategoriesResponse.categories:type_name -> thmanyah.v1.Category
-	50, // 32: thmanyah.v1.CreateEpisodeRequest.metadata:type_name -> thmanyah.v1.CreateEpisodeRequest.MetadataEntry
-	6,  // 33: thmanyah.v1.CreateEpisodeResponse.episode:type_name -> thmanyah.v1.Episode
-	2,  // 34: thmanyah.v1.UpdateEpisodeRequest.status:type_name -> thmanyah.v1.EpisodeStatus
-	51, // 35: thmanyah.v1.UpdateEpisodeRequest.metadata:type_name -> thmanyah.v1.UpdateEpisodeRequest.MetadataEntry
-	56, // 36: thmanyah.v1.UpdateEpisodeRequest.scheduled_at:type_name -> google.protobuf.Timestamp
-	6,  // 37: thmanyah.v1.UpdateEpisodeResponse.episode:type_name -> thmanyah.v1.Episode
-	6,  // 38: thmanyah.v1.GetEpisodeResponse.episode:type_name -> thmanyah.v1.Episode
-	2,  // 39: thmanyah.v1.ListEpisodesRequest.status:type_name -> thmanyah.v1.EpisodeStatus
-	6,  // 40: thmanyah.v1.ListEpisodesResponse.episodes:type_name -> thmanyah.v1.Episode
-	52, // 41: thmanyah.v1.ImportDataRequest.source_config:type_name -> thmanyah.v1.ImportDataRequest.SourceConfigEntry
-	53, // 42: thmanyah.v1.ImportDataRequest.field_mapping:type_name -> thmanyah.v1.ImportDataRequest.FieldMappingEntry
-	3,  // 43: thmanyah.v1.ImportDataResponse.status:type_name -> thmanyah.v1.ImportStatus
-	1,  // 44: thmanyah.v1.BulkUpdateProgramsRequest.status:type_name -> thmanyah.v1.ProgramStatus
-	54, // 45: thmanyah.v1.BulkUpdateProgramsRequest.metadata:type_name -> thmanyah.v1.BulkUpdateProgramsRequest.MetadataEntry
-	55, // 46: thmanyah.v1.FilterOptions.filters:type_name -> thmanyah.v1.FilterOptions.FiltersEntry
-	57, // 47: thmanyah.v1.FilterOptions.FiltersEntry.value:type_name -> google.protobuf.Any
-	7,  // 48: thmanyah.v1.CmsService.CreateProgram:input_type -> thmanyah.v1.CreateProgramRequest
-	9,  // 49: thmanyah.v1.CmsService.UpdateProgram:input_type -> thmanyah.v1.UpdateProgramRequest
-	11, // 50: thmanyah.v1.CmsService.DeleteProgram:input_type -> thmanyah.v1.DeleteProgramRequest
-	12, // 51: thmanyah.v1.CmsService.GetProgram:input_type -> thmanyah.v1.GetProgramRequest
-	14, // 52: thmanyah.v1.CmsService.ListPrograms:input_type -> thmanyah.v1.ListProgramsRequest
-	16, // 53: thmanyah.v1.CmsService.CreateCategory:input_type -> thmanyah.v1.CreateCategoryRequest
-	18, // 54: thmanyah.v1.CmsService.UpdateCategory:input_type -> thmanyah.v1.UpdateCategoryRequest
-	20, // 55: thmanyah.v1.CmsService.DeleteCategory:input_type -> thmanyah.v1.DeleteCategoryRequest
-	21, // 56: thmanyah.v1.CmsService.GetCategory:input_type -> thmanyah.v1.GetCategoryRequest
-	23, // 57: thmanyah.v1.CmsService.ListCategories:input_type -> thmanyah.v1.ListCategoriesRequest
-	25, // 58: thmanyah.v1.CmsService.CreateEpisode:input_type -> thmanyah.v1.CreateEpisodeRequest
-	27, // 59: thmanyah.v1.CmsService.UpdateEpisode:input_type -> thmanyah.v1.UpdateEpisodeRequest
-	29, // 60: thmanyah.v1.CmsService.DeleteEpisode:input_type -> thmanyah.v1.DeleteEpisodeRequest
-	30, // 61: thmanyah.v1.CmsService.GetEpisode:input_type -> thmanyah.v1.GetEpisodeRequest
-	32, // 62: thmanyah.v1.CmsService.ListEpisodes:input_type -> thmanyah.v1.ListEpisodesRequest
-	34, // 63: thmanyah.v1.CmsService.ImportData:input_type -> thmanyah.v1.ImportDataRequest
-	36, // 64: thmanyah.v1.CmsService.BulkUpdatePrograms:input_type -> thmanyah.v1.BulkUpdateProgramsRequest
-	38, // 65: thmanyah.v1.CmsService.BulkDeletePrograms:input_type -> thmanyah.v1.BulkDeleteProgramsRequest
-	8,  // 66: thmanyah.v1.CmsService.CreateProgram:output_type -> thmanyah.v1.CreateProgramResponse
-	10, // 67: thmanyah.v1.CmsService.UpdateProgram:output_type -> thmanyah.v1.UpdateProgramResponse
-	58, // 68: thmanyah.v1.CmsService.DeleteProgram:output_type -> google.protobuf.Empty
-	13, // 69: thmanyah.v1.CmsService.GetProgram:output_type -> thmanyah.v1.GetProgramResponse
-	15, // 70: thmanyah.v1.CmsService.ListPrograms:output_type -> thmanyah.v1.ListProgramsResponse
-	17, // 71: thmanyah.v1.CmsService.CreateCategory:output_type -> thmanyah.v1.CreateCategoryResponse
-	19, // 72: thmanyah.v1.CmsService.UpdateCategory:output_type -> thmanyah.v1.UpdateCategoryResponse
-	58, // 73: thmanyah.v1.CmsService.DeleteCategory:output_type -> google.protobuf.Empty
-	22, // 74: thmanyah.v1.CmsService.GetCategory:output_type -> thmanyah.v1.GetCategoryResponse
-	24, // 75: thmanyah.v1.CmsService.ListCategories:output_type -> thmanyah.v1.ListCategoriesResponse
-	26, // 76: thmanyah.v1.CmsService.CreateEpisode:output_type -> thmanyah.v1.CreateEpisodeResponse
-	28, // 77: thmanyah.v1.CmsService.UpdateEpisode:output_type -> thmanyah.v1.UpdateEpisodeResponse
-	58, // 78: thmanyah.v1.CmsService.DeleteEpisode:output_type -> google.protobuf.Empty
-	31, // 79: thmanyah.v1.CmsService.GetEpisode:output_type -> thmanyah.v1.GetEpisodeResponse
-	33, // 80: thmanyah.v1.CmsService.ListEpisodes:output_type -> thmanyah.v1.ListEpisodesResponse
-	35, // 81: thmanyah.v1.CmsService.ImportData:output_type -> thmanyah.v1.ImportDataResponse
-	37, // 82: thmanyah.v1.CmsService.BulkUpdatePrograms:output_type -> thmanyah.v1.BulkUpdateProgramsResponse
-	58, // 83: thmanyah.v1.CmsService.BulkDeletePrograms:output_type -> google.protobuf.Empty
-	66, // [66:84] is the sub-list for method output_type
-	48, // [48:66] is the sub-list for method input_type
-	48, // [48:48] is the sub-list for extension type_name
-	48, // [48:48] is the sub-list for extension extendee
-	0,  // [0:48] is the sub-list for field type_name
+	0,   // 0: thmanyah.v1.Category.type:type_name -> thmanyah.v1.CategoryType
+	184, // 1: thmanyah.v1.Category.created_at:type_name -> google.protobuf.Timestamp
+	184, // 2: thmanyah.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
+	169, // 3: thmanyah.v1.Category.metadata:type_name -> thmanyah.v1.Category.MetadataEntry
+	1,   // 4: thmanyah.v1.Program.status:type_name -> thmanyah.v1.ProgramStatus
+	184, // 5: thmanyah.v1.Program.created_at:type_name -> google.protobuf.Timestamp
+	184, // 6: thmanyah.v1.Program.updated_at:type_name -> google.protobuf.Timestamp
+	184, // 7: thmanyah.v1.Program.published_at:type_name -> google.protobuf.Timestamp
+	170, // 8: thmanyah.v1.Program.metadata:type_name -> thmanyah.v1.Program.MetadataEntry
+	12,  // 9: thmanyah.v1.Program.availability:type_name -> thmanyah.v1.Availability
+	6,   // 10: thmanyah.v1.Program.unavailable_reasons:type_name -> thmanyah.v1.UnavailableReason
+	2,   // 11: thmanyah.v1.Episode.status:type_name -> thmanyah.v1.EpisodeStatus
+	184, // 12: thmanyah.v1.Episode.created_at:type_name -> google.protobuf.Timestamp
+	184, // 13: thmanyah.v1.Episode.updated_at:type_name -> google.protobuf.Timestamp
+	184, // 14: thmanyah.v1.Episode.published_at:type_name -> google.protobuf.Timestamp
+	184, // 15: thmanyah.v1.Episode.scheduled_at:type_name -> google.protobuf.Timestamp
+	171, // 16: thmanyah.v1.Episode.metadata:type_name -> thmanyah.v1.Episode.MetadataEntry
+	108, // 17: thmanyah.v1.Episode.chapters:type_name -> thmanyah.v1.Chapter
+	12,  // 18: thmanyah.v1.Episode.availability:type_name -> thmanyah.v1.Availability
+	6,   // 19: thmanyah.v1.Episode.unavailable_reasons:type_name -> thmanyah.v1.UnavailableReason
+	184, // 20: thmanyah.v1.Availability.start_at:type_name -> google.protobuf.Timestamp
+	184, // 21: thmanyah.v1.Availability.end_at:type_name -> google.protobuf.Timestamp
+	172, // 22: thmanyah.v1.CreateProgramRequest.metadata:type_name -> thmanyah.v1.CreateProgramRequest.MetadataEntry
+	12,  // 23: thmanyah.v1.CreateProgramRequest.availability:type_name -> thmanyah.v1.Availability
+	10,  // 24: thmanyah.v1.CreateProgramResponse.program:type_name -> thmanyah.v1.Program
+	1,   // 25: thmanyah.v1.UpdateProgramRequest.status:type_name -> thmanyah.v1.ProgramStatus
+	173, // 26: thmanyah.v1.UpdateProgramRequest.metadata:type_name -> thmanyah.v1.UpdateProgramRequest.MetadataEntry
+	12,  // 27: thmanyah.v1.UpdateProgramRequest.availability:type_name -> thmanyah.v1.Availability
+	10,  // 28: thmanyah.v1.UpdateProgramResponse.program:type_name -> thmanyah.v1.Program
+	10,  // 29: thmanyah.v1.GetProgramResponse.program:type_name -> thmanyah.v1.Program
+	1,   // 30: thmanyah.v1.ListProgramsRequest.status:type_name -> thmanyah.v1.ProgramStatus
+	10,  // 31: thmanyah.v1.ListProgramsResponse.programs:type_name -> thmanyah.v1.Program
+	10,  // 32: thmanyah.v1.BatchGetProgramsResponse.programs:type_name -> thmanyah.v1.Program
+	0,   // 33: thmanyah.v1.CreateCategoryRequest.type:type_name -> thmanyah.v1.CategoryType
+	174, // 34: thmanyah.v1.CreateCategoryRequest.metadata:type_name -> thmanyah.v1.CreateCategoryRequest.MetadataEntry
+	9,   // 35: thmanyah.v1.CreateCategoryResponse.category:type_name -> thmanyah.v1.Category
+	0,   // 36: thmanyah.v1.UpdateCategoryRequest.type:type_name -> thmanyah.v1.CategoryType
+	175, // 37: thmanyah.v1.UpdateCategoryRequest.metadata:type_name -> thmanyah.v1.UpdateCategoryRequest.MetadataEntry
+	9,   // 38: thmanyah.v1.UpdateCategoryResponse.category:type_name -> thmanyah.v1.Category
+	9,   // 39: thmanyah.v1.GetCategoryResponse.category:type_name -> thmanyah.v1.Category
+	0,   // 40: thmanyah.v1.ListCategoriesRequest.type:type_name -> thmanyah.v1.CategoryType
+	9,   // 41: thmanyah.v1.ListCategoriesResponse.categories:type_name -> thmanyah.v1.Category
+	9,   // 42: thmanyah.v1.BatchGetCategoriesResponse.categories:type_name -> thmanyah.v1.Category
+	176, // 43: thmanyah.v1.CreateEpisodeRequest.metadata:type_name -> thmanyah.v1.CreateEpisodeRequest.MetadataEntry
+	12,  // 44: thmanyah.v1.CreateEpisodeRequest.availability:type_name -> thmanyah.v1.Availability
+	11,  // 45: thmanyah.v1.CreateEpisodeResponse.episode:type_name -> thmanyah.v1.Episode
+	2,   // 46: thmanyah.v1.UpdateEpisodeRequest.status:type_name -> thmanyah.v1.EpisodeStatus
+	177, // 47: thmanyah.v1.UpdateEpisodeRequest.metadata:type_name -> thmanyah.v1.UpdateEpisodeRequest.MetadataEntry
+	184, // 48: thmanyah.v1.UpdateEpisodeRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	12,  // 49: thmanyah.v1.UpdateEpisodeRequest.availability:type_name -> thmanyah.v1.Availability
+	11,  // 50: thmanyah.v1.UpdateEpisodeResponse.episode:type_name -> thmanyah.v1.Episode
+	184, // 51: thmanyah.v1.RescheduleEpisodeRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	11,  // 52: thmanyah.v1.RescheduleEpisodeResponse.episode:type_name -> thmanyah.v1.Episode
+	11,  // 53: thmanyah.v1.CancelEpisodeScheduleResponse.episode:type_name -> thmanyah.v1.Episode
+	3,   // 54: thmanyah.v1.StatusTransition.content_type:type_name -> thmanyah.v1.ContentType
+	184, // 55: thmanyah.v1.StatusTransition.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 56: thmanyah.v1.SubmitForReviewRequest.content_type:type_name -> thmanyah.v1.ContentType
+	3,   // 57: thmanyah.v1.ApproveRequest.content_type:type_name -> thmanyah.v1.ContentType
+	3,   // 58: thmanyah.v1.RejectRequest.content_type:type_name -> thmanyah.v1.ContentType
+	10,  // 59: thmanyah.v1.ReviewResponse.program:type_name -> thmanyah.v1.Program
+	11,  // 60: thmanyah.v1.ReviewResponse.episode:type_name -> thmanyah.v1.Episode
+	43,  // 61: thmanyah.v1.ReviewResponse.transition:type_name -> thmanyah.v1.StatusTransition
+	3,   // 62: thmanyah.v1.ListStatusTransitionsRequest.content_type:type_name -> thmanyah.v1.ContentType
+	43,  // 63: thmanyah.v1.ListStatusTransitionsResponse.transitions:type_name -> thmanyah.v1.StatusTransition
+	3,   // 64: thmanyah.v1.Revision.content_type:type_name -> thmanyah.v1.ContentType
+	185, // 65: thmanyah.v1.Revision.snapshot:type_name -> google.protobuf.Struct
+	184, // 66: thmanyah.v1.Revision.created_at:type_name -> google.protobuf.Timestamp
+	186, // 67: thmanyah.v1.FieldChange.from:type_name -> google.protobuf.Value
+	186, // 68: thmanyah.v1.FieldChange.to:type_name -> google.protobuf.Value
+	3,   // 69: thmanyah.v1.ListRevisionsRequest.content_type:type_name -> thmanyah.v1.ContentType
+	50,  // 70: thmanyah.v1.ListRevisionsResponse.revisions:type_name -> thmanyah.v1.Revision
+	50,  // 71: thmanyah.v1.GetRevisionResponse.revision:type_name -> thmanyah.v1.Revision
+	51,  // 72: thmanyah.v1.DiffRevisionsResponse.changes:type_name -> thmanyah.v1.FieldChange
+	10,  // 73: thmanyah.v1.RestoreRevisionResponse.program:type_name -> thmanyah.v1.Program
+	11,  // 74: thmanyah.v1.RestoreRevisionResponse.episode:type_name -> thmanyah.v1.Episode
+	50,  // 75: thmanyah.v1.RestoreRevisionResponse.revision:type_name -> thmanyah.v1.Revision
+	3,   // 76: thmanyah.v1.TrashItem.content_type:type_name -> thmanyah.v1.ContentType
+	184, // 77: thmanyah.v1.TrashItem.deleted_at:type_name -> google.protobuf.Timestamp
+	3,   // 78: thmanyah.v1.ListTrashRequest.content_type:type_name -> thmanyah.v1.ContentType
+	60,  // 79: thmanyah.v1.ListTrashResponse.items:type_name -> thmanyah.v1.TrashItem
+	3,   // 80: thmanyah.v1.RestoreFromTrashRequest.content_type:type_name -> thmanyah.v1.ContentType
+	9,   // 81: thmanyah.v1.RestoreFromTrashResponse.category:type_name -> thmanyah.v1.Category
+	10,  // 82: thmanyah.v1.RestoreFromTrashResponse.program:type_name -> thmanyah.v1.Program
+	11,  // 83: thmanyah.v1.RestoreFromTrashResponse.episode:type_name -> thmanyah.v1.Episode
+	3,   // 84: thmanyah.v1.PurgeTrashRequest.content_type:type_name -> thmanyah.v1.ContentType
+	185, // 85: thmanyah.v1.AuditEvent.before:type_name -> google.protobuf.Struct
+	185, // 86: thmanyah.v1.AuditEvent.after:type_name -> google.protobuf.Struct
+	184, // 87: thmanyah.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	184, // 88: thmanyah.v1.ListAuditEventsRequest.from:type_name -> google.protobuf.Timestamp
+	184, // 89: thmanyah.v1.ListAuditEventsRequest.to:type_name -> google.protobuf.Timestamp
+	67,  // 90: thmanyah.v1.ListAuditEventsResponse.events:type_name -> thmanyah.v1.AuditEvent
+	9,   // 91: thmanyah.v1.CategoryNode.category:type_name -> thmanyah.v1.Category
+	70,  // 92: thmanyah.v1.CategoryNode.children:type_name -> thmanyah.v1.CategoryNode
+	70,  // 93: thmanyah.v1.GetCategoryTreeResponse.categories:type_name -> thmanyah.v1.CategoryNode
+	9,   // 94: thmanyah.v1.MoveCategoryResponse.category:type_name -> thmanyah.v1.Category
+	10,  // 95: thmanyah.v1.SetProgramCategoriesResponse.program:type_name -> thmanyah.v1.Program
+	178, // 96: thmanyah.v1.Tag.translations:type_name -> thmanyah.v1.Tag.TranslationsEntry
+	184, // 97: thmanyah.v1.Tag.created_at:type_name -> google.protobuf.Timestamp
+	184, // 98: thmanyah.v1.Tag.updated_at:type_name -> google.protobuf.Timestamp
+	77,  // 99: thmanyah.v1.ListTagsResponse.tags:type_name -> thmanyah.v1.Tag
+	77,  // 100: thmanyah.v1.AutocompleteTagsResponse.tags:type_name -> thmanyah.v1.Tag
+	179, // 101: thmanyah.v1.UpdateTagRequest.translations:type_name -> thmanyah.v1.UpdateTagRequest.TranslationsEntry
+	77,  // 102: thmanyah.v1.UpdateTagResponse.tag:type_name -> thmanyah.v1.Tag
+	77,  // 103: thmanyah.v1.RenameTagResponse.tag:type_name -> thmanyah.v1.Tag
+	77,  // 104: thmanyah.v1.MergeTagsResponse.tag:type_name -> thmanyah.v1.Tag
+	3,   // 105: thmanyah.v1.Translation.content_type:type_name -> thmanyah.v1.ContentType
+	184, // 106: thmanyah.v1.Translation.created_at:type_name -> google.protobuf.Timestamp
+	184, // 107: thmanyah.v1.Translation.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 108: thmanyah.v1.ListTranslationsRequest.content_type:type_name -> thmanyah.v1.ContentType
+	88,  // 109: thmanyah.v1.ListTranslationsResponse.translations:type_name -> thmanyah.v1.Translation
+	3,   // 110: thmanyah.v1.SetTranslationRequest.content_type:type_name -> thmanyah.v1.ContentType
+	88,  // 111: thmanyah.v1.SetTranslationResponse.translation:type_name -> thmanyah.v1.Translation
+	3,   // 112: thmanyah.v1.DeleteTranslationRequest.content_type:type_name -> thmanyah.v1.ContentType
+	184, // 113: thmanyah.v1.Season.release_start_at:type_name -> google.protobuf.Timestamp
+	184, // 114: thmanyah.v1.Season.release_end_at:type_name -> google.protobuf.Timestamp
+	184, // 115: thmanyah.v1.Season.created_at:type_name -> google.protobuf.Timestamp
+	184, // 116: thmanyah.v1.Season.updated_at:type_name -> google.protobuf.Timestamp
+	184, // 117: thmanyah.v1.CreateSeasonRequest.release_start_at:type_name -> google.protobuf.Timestamp
+	184, // 118: thmanyah.v1.CreateSeasonRequest.release_end_at:type_name -> google.protobuf.Timestamp
+	94,  // 119: thmanyah.v1.CreateSeasonResponse.season:type_name -> thmanyah.v1.Season
+	94,  // 120: thmanyah.v1.GetSeasonResponse.season:type_name -> thmanyah.v1.Season
+	94,  // 121: thmanyah.v1.ListSeasonsResponse.seasons:type_name -> thmanyah.v1.Season
+	184, // 122: thmanyah.v1.UpdateSeasonRequest.release_start_at:type_name -> google.protobuf.Timestamp
+	184, // 123: thmanyah.v1.UpdateSeasonRequest.release_end_at:type_name -> google.protobuf.Timestamp
+	94,  // 124: thmanyah.v1.UpdateSeasonResponse.season:type_name -> thmanyah.v1.Season
+	11,  // 125: thmanyah.v1.ReorderEpisodesResponse.episodes:type_name -> thmanyah.v1.Episode
+	2,   // 126: thmanyah.v1.ListSeasonEpisodesRequest.status:type_name -> thmanyah.v1.EpisodeStatus
+	11,  // 127: thmanyah.v1.ListSeasonEpisodesResponse.episodes:type_name -> thmanyah.v1.Episode
+	184, // 128: thmanyah.v1.Chapter.created_at:type_name -> google.protobuf.Timestamp
+	184, // 129: thmanyah.v1.Chapter.updated_at:type_name -> google.protobuf.Timestamp
+	108, // 130: thmanyah.v1.ListChaptersResponse.chapters:type_name -> thmanyah.v1.Chapter
+	109, // 131: thmanyah.v1.CreateChapterRequest.chapter:type_name -> thmanyah.v1.ChapterInput
+	108, // 132: thmanyah.v1.CreateChapterResponse.chapter:type_name -> thmanyah.v1.Chapter
+	108, // 133: thmanyah.v1.UpdateChapterResponse.chapter:type_name -> thmanyah.v1.Chapter
+	109, // 134: thmanyah.v1.ReplaceChaptersRequest.chapters:type_name -> thmanyah.v1.ChapterInput
+	108, // 135: thmanyah.v1.ReplaceChaptersResponse.chapters:type_name -> thmanyah.v1.Chapter
+	108, // 136: thmanyah.v1.ImportChaptersResponse.chapters:type_name -> thmanyah.v1.Chapter
+	4,   // 137: thmanyah.v1.Transcript.format:type_name -> thmanyah.v1.TranscriptFormat
+	184, // 138: thmanyah.v1.Transcript.created_at:type_name -> google.protobuf.Timestamp
+	184, // 139: thmanyah.v1.Transcript.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 140: thmanyah.v1.UploadTranscriptRequest.format:type_name -> thmanyah.v1.TranscriptFormat
+	121, // 141: thmanyah.v1.UploadTranscriptResponse.transcript:type_name -> thmanyah.v1.Transcript
+	121, // 142: thmanyah.v1.ListTranscriptsResponse.transcripts:type_name -> thmanyah.v1.Transcript
+	128, // 143: thmanyah.v1.Person.links:type_name -> thmanyah.v1.PersonLink
+	184, // 144: thmanyah.v1.Person.created_at:type_name -> google.protobuf.Timestamp
+	184, // 145: thmanyah.v1.Person.updated_at:type_name -> google.protobuf.Timestamp
+	128, // 146: thmanyah.v1.PersonLinks.links:type_name -> thmanyah.v1.PersonLink
+	3,   // 147: thmanyah.v1.Credit.content_type:type_name -> thmanyah.v1.ContentType
+	5,   // 148: thmanyah.v1.Credit.role:type_name -> thmanyah.v1.CreditRole
+	184, // 149: thmanyah.v1.Credit.created_at:type_name -> google.protobuf.Timestamp
+	184, // 150: thmanyah.v1.Credit.updated_at:type_name -> google.protobuf.Timestamp
+	127, // 151: thmanyah.v1.Credit.person:type_name -> thmanyah.v1.Person
+	128, // 152: thmanyah.v1.CreatePersonRequest.links:type_name -> thmanyah.v1.PersonLink
+	127, // 153: thmanyah.v1.CreatePersonResponse.person:type_name -> thmanyah.v1.Person
+	127, // 154: thmanyah.v1.GetPersonResponse.person:type_name -> thmanyah.v1.Person
+	127, // 155: thmanyah.v1.ListPeopleResponse.people:type_name -> thmanyah.v1.Person
+	129, // 156: thmanyah.v1.UpdatePersonRequest.links:type_name -> thmanyah.v1.PersonLinks
+	127, // 157: thmanyah.v1.UpdatePersonResponse.person:type_name -> thmanyah.v1.Person
+	3,   // 158: thmanyah.v1.CreateCreditRequest.content_type:type_name -> thmanyah.v1.ContentType
+	5,   // 159: thmanyah.v1.CreateCreditRequest.role:type_name -> thmanyah.v1.CreditRole
+	130, // 160: thmanyah.v1.CreateCreditResponse.credit:type_name -> thmanyah.v1.Credit
+	3,   // 161: thmanyah.v1.ListCreditsRequest.content_type:type_name -> thmanyah.v1.ContentType
+	130, // 162: thmanyah.v1.ListCreditsResponse.credits:type_name -> thmanyah.v1.Credit
+	5,   // 163: thmanyah.v1.UpdateCreditRequest.role:type_name -> thmanyah.v1.CreditRole
+	130, // 164: thmanyah.v1.UpdateCreditResponse.credit:type_name -> thmanyah.v1.Credit
+	130, // 165: thmanyah.v1.ListCreditsByPersonResponse.credits:type_name -> thmanyah.v1.Credit
+	3,   // 166: thmanyah.v1.CheckAvailabilityRequest.content_type:type_name -> thmanyah.v1.ContentType
+	3,   // 167: thmanyah.v1.CheckAvailabilityResponse.content_type:type_name -> thmanyah.v1.ContentType
+	184, // 168: thmanyah.v1.CheckAvailabilityResponse.checked_at:type_name -> google.protobuf.Timestamp
+	6,   // 169: thmanyah.v1.CheckAvailabilityResponse.reasons:type_name -> thmanyah.v1.UnavailableReason
+	6,   // 170: thmanyah.v1.CheckAvailabilityResponse.program_reasons:type_name -> thmanyah.v1.UnavailableReason
+	11,  // 171: thmanyah.v1.GetEpisodeResponse.episode:type_name -> thmanyah.v1.Episode
+	2,   // 172: thmanyah.v1.ListEpisodesRequest.status:type_name -> thmanyah.v1.EpisodeStatus
+	11,  // 173: thmanyah.v1.ListEpisodesResponse.episodes:type_name -> thmanyah.v1.Episode
+	11,  // 174: thmanyah.v1.BatchGetEpisodesResponse.episodes:type_name -> thmanyah.v1.Episode
+	180, // 175: thmanyah.v1.ImportDataRequest.source_config:type_name -> thmanyah.v1.ImportDataRequest.SourceConfigEntry
+	181, // 176: thmanyah.v1.ImportDataRequest.field_mapping:type_name -> thmanyah.v1.ImportDataRequest.FieldMappingEntry
+	7,   // 177: thmanyah.v1.ImportDataResponse.status:type_name -> thmanyah.v1.ImportStatus
+	8,   // 178: thmanyah.v1.ImportEvent.type:type_name -> thmanyah.v1.ImportEventType
+	7,   // 179: thmanyah.v1.ImportEvent.status:type_name -> thmanyah.v1.ImportStatus
+	184, // 180: thmanyah.v1.ImportEvent.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 181: thmanyah.v1.BulkUpdateProgramsRequest.status:type_name -> thmanyah.v1.ProgramStatus
+	182, // 182: thmanyah.v1.BulkUpdateProgramsRequest.metadata:type_name -> thmanyah.v1.BulkUpdateProgramsRequest.MetadataEntry
+	183, // 183: thmanyah.v1.FilterOptions.filters:type_name -> thmanyah.v1.FilterOptions.FiltersEntry
+	187, // 184: thmanyah.v1.FilterOptions.FiltersEntry.value:type_name -> google.protobuf.Any
+	13,  // 185: thmanyah.v1.CmsService.CreateProgram:input_type -> thmanyah.v1.CreateProgramRequest
+	15,  // 186: thmanyah.v1.CmsService.UpdateProgram:input_type -> thmanyah.v1.UpdateProgramRequest
+	17,  // 187: thmanyah.v1.CmsService.DeleteProgram:input_type -> thmanyah.v1.DeleteProgramRequest
+	18,  // 188: thmanyah.v1.CmsService.GetProgram:input_type -> thmanyah.v1.GetProgramRequest
+	20,  // 189: thmanyah.v1.CmsService.ListPrograms:input_type -> thmanyah.v1.ListProgramsRequest
+	22,  // 190: thmanyah.v1.CmsService.BatchGetPrograms:input_type -> thmanyah.v1.BatchGetProgramsRequest
+	24,  // 191: thmanyah.v1.CmsService.CreateCategory:input_type -> thmanyah.v1.CreateCategoryRequest
+	26,  // 192: thmanyah.v1.CmsService.UpdateCategory:input_type -> thmanyah.v1.UpdateCategoryRequest
+	28,  // 193: thmanyah.v1.CmsService.DeleteCategory:input_type -> thmanyah.v1.DeleteCategoryRequest
+	71,  // 194: thmanyah.v1.CmsService.GetCategoryTree:input_type -> thmanyah.v1.GetCategoryTreeRequest
+	29,  // 195: thmanyah.v1.CmsService.GetCategory:input_type -> thmanyah.v1.GetCategoryRequest
+	31,  // 196: thmanyah.v1.CmsService.ListCategories:input_type -> thmanyah.v1.ListCategoriesRequest
+	33,  // 197: thmanyah.v1.CmsService.BatchGetCategories:input_type -> thmanyah.v1.BatchGetCategoriesRequest
+	35,  // 198: thmanyah.v1.CmsService.CreateEpisode:input_type -> thmanyah.v1.CreateEpisodeRequest
+	37,  // 199: thmanyah.v1.CmsService.UpdateEpisode:input_type -> thmanyah.v1.UpdateEpisodeRequest
+	151, // 200: thmanyah.v1.CmsService.DeleteEpisode:input_type -> thmanyah.v1.DeleteEpisodeRequest
+	152, // 201: thmanyah.v1.CmsService.GetEpisode:input_type -> thmanyah.v1.GetEpisodeRequest
+	154, // 202: thmanyah.v1.CmsService.ListEpisodes:input_type -> thmanyah.v1.ListEpisodesRequest
+	156, // 203: thmanyah.v1.CmsService.BatchGetEpisodes:input_type -> thmanyah.v1.BatchGetEpisodesRequest
+	39,  // 204: thmanyah.v1.CmsService.RescheduleEpisode:input_type -> thmanyah.v1.RescheduleEpisodeRequest
+	41,  // 205: thmanyah.v1.CmsService.CancelEpisodeSchedule:input_type -> thmanyah.v1.CancelEpisodeScheduleRequest
+	44,  // 206: thmanyah.v1.CmsService.SubmitForReview:input_type -> thmanyah.v1.SubmitForReviewRequest
+	45,  // 207: thmanyah.v1.CmsService.Approve:input_type -> thmanyah.v1.ApproveRequest
+	46,  // 208: thmanyah.v1.CmsService.Reject:input_type -> thmanyah.v1.RejectRequest
+	48,  // 209: thmanyah.v1.CmsService.ListStatusTransitions:input_type -> thmanyah.v1.ListStatusTransitionsRequest
+	52,  // 210: thmanyah.v1.CmsService.ListRevisions:input_type -> thmanyah.v1.ListRevisionsRequest
+	54,  // 211: thmanyah.v1.CmsService.GetRevision:input_type -> thmanyah.v1.GetRevisionRequest
+	56,  // 212: thmanyah.v1.CmsService.DiffRevisions:input_type -> thmanyah.v1.DiffRevisionsRequest
+	58,  // 213: thmanyah.v1.CmsService.RestoreRevision:input_type -> thmanyah.v1.RestoreRevisionRequest
+	61,  // 214: thmanyah.v1.CmsService.ListTrash:input_type -> thmanyah.v1.ListTrashRequest
+	63,  // 215: thmanyah.v1.CmsService.RestoreFromTrash:input_type -> thmanyah.v1.RestoreFromTrashRequest
+	65,  // 216: thmanyah.v1.CmsService.PurgeTrash:input_type -> thmanyah.v1.PurgeTrashRequest
+	68,  // 217: thmanyah.v1.CmsService.ListAuditEvents:input_type -> thmanyah.v1.ListAuditEventsRequest
+	73,  // 218: thmanyah.v1.CmsService.MoveCategory:input_type -> thmanyah.v1.MoveCategoryRequest
+	75,  // 219: thmanyah.v1.CmsService.SetProgramCategories:input_type -> thmanyah.v1.SetProgramCategoriesRequest
+	78,  // 220: thmanyah.v1.CmsService.ListTags:input_type -> thmanyah.v1.ListTagsRequest
+	80,  // 221: thmanyah.v1.CmsService.AutocompleteTags:input_type -> thmanyah.v1.AutocompleteTagsRequest
+	82,  // 222: thmanyah.v1.CmsService.UpdateTag:input_type -> thmanyah.v1.UpdateTagRequest
+	84,  // 223: thmanyah.v1.CmsService.RenameTag:input_type -> thmanyah.v1.RenameTagRequest
+	86,  // 224: thmanyah.v1.CmsService.MergeTags:input_type -> thmanyah.v1.MergeTagsRequest
+	89,  // 225: thmanyah.v1.CmsService.ListTranslations:input_type -> thmanyah.v1.ListTranslationsRequest
+	91,  // 226: thmanyah.v1.CmsService.SetTranslation:input_type -> thmanyah.v1.SetTranslationRequest
+	93,  // 227: thmanyah.v1.CmsService.DeleteTranslation:input_type -> thmanyah.v1.DeleteTranslationRequest
+	95,  // 228: thmanyah.v1.CmsService.CreateSeason:input_type -> thmanyah.v1.CreateSeasonRequest
+	99,  // 229: thmanyah.v1.CmsService.ListSeasons:input_type -> thmanyah.v1.ListSeasonsRequest
+	97,  // 230: thmanyah.v1.CmsService.GetSeason:input_type -> thmanyah.v1.GetSeasonRequest
+	101, // 231: thmanyah.v1.CmsService.UpdateSeason:input_type -> thmanyah.v1.UpdateSeasonRequest
+	103, // 232: thmanyah.v1.CmsService.DeleteSeason:input_type -> thmanyah.v1.DeleteSeasonRequest
+	104, // 233: thmanyah.v1.CmsService.ReorderEpisodes:input_type -> thmanyah.v1.ReorderEpisodesRequest
+	106, // 234: thmanyah.v1.CmsService.ListSeasonEpisodes:input_type -> thmanyah.v1.ListSeasonEpisodesRequest
+	110, // 235: thmanyah.v1.CmsService.ListChapters:input_type -> thmanyah.v1.ListChaptersRequest
+	112, // 236: thmanyah.v1.CmsService.CreateChapter:input_type -> thmanyah.v1.CreateChapterRequest
+	114, // 237: thmanyah.v1.CmsService.UpdateChapter:input_type -> thmanyah.v1.UpdateChapterRequest
+	116, // 238: thmanyah.v1.CmsService.DeleteChapter:input_type -> thmanyah.v1.DeleteChapterRequest
+	117, // 239: thmanyah.v1.CmsService.ReplaceChapters:input_type -> thmanyah.v1.ReplaceChaptersRequest
+	119, // 240: thmanyah.v1.CmsService.ImportChapters:input_type -> thmanyah.v1.ImportChaptersRequest
+	122, // 241: thmanyah.v1.CmsService.UploadTranscript:input_type -> thmanyah.v1.UploadTranscriptRequest
+	124, // 242: thmanyah.v1.CmsService.ListTranscripts:input_type -> thmanyah.v1.ListTranscriptsRequest
+	126, // 243: thmanyah.v1.CmsService.DeleteTranscript:input_type -> thmanyah.v1.DeleteTranscriptRequest
+	131, // 244: thmanyah.v1.CmsService.CreatePerson:input_type -> thmanyah.v1.CreatePersonRequest
+	133, // 245: thmanyah.v1.CmsService.GetPerson:input_type -> thmanyah.v1.GetPersonRequest
+	135, // 246: thmanyah.v1.CmsService.ListPeople:input_type -> thmanyah.v1.ListPeopleRequest
+	137, // 247: thmanyah.v1.CmsService.UpdatePerson:input_type -> thmanyah.v1.UpdatePersonRequest
+	139, // 248: thmanyah.v1.CmsService.DeletePerson:input_type -> thmanyah.v1.DeletePersonRequest
+	140, // 249: thmanyah.v1.CmsService.CreateCredit:input_type -> thmanyah.v1.CreateCreditRequest
+	142, // 250: thmanyah.v1.CmsService.ListCredits:input_type -> thmanyah.v1.ListCreditsRequest
+	144, // 251: thmanyah.v1.CmsService.UpdateCredit:input_type -> thmanyah.v1.UpdateCreditRequest
+	146, // 252: thmanyah.v1.CmsService.DeleteCredit:input_type -> thmanyah.v1.DeleteCreditRequest
+	147, // 253: thmanyah.v1.CmsService.ListCreditsByPerson:input_type -> thmanyah.v1.ListCreditsByPersonRequest
+	149, // 254: thmanyah.v1.CmsService.CheckAvailability:input_type -> thmanyah.v1.CheckAvailabilityRequest
+	158, // 255: thmanyah.v1.CmsService.ImportData:input_type -> thmanyah.v1.ImportDataRequest
+	160, // 256: thmanyah.v1.CmsService.WatchImport:input_type -> thmanyah.v1.WatchImportRequest
+	162, // 257: thmanyah.v1.CmsService.BulkUpdatePrograms:input_type -> thmanyah.v1.BulkUpdateProgramsRequest
+	164, // 258: thmanyah.v1.CmsService.BulkDeletePrograms:input_type -> thmanyah.v1.BulkDeleteProgramsRequest
+	14,  // 259: thmanyah.v1.CmsService.CreateProgram:output_type -> thmanyah.v1.CreateProgramResponse
+	16,  // 260: thmanyah.v1.CmsService.UpdateProgram:output_type -> thmanyah.v1.UpdateProgramResponse
+	188, // 261: thmanyah.v1.CmsService.DeleteProgram:output_type -> google.protobuf.Empty
+	19,  // 262: thmanyah.v1.CmsService.GetProgram:output_type -> thmanyah.v1.GetProgramResponse
+	21,  // 263: thmanyah.v1.CmsService.ListPrograms:output_type -> thmanyah.v1.ListProgramsResponse
+	23,  // 264: thmanyah.v1.CmsService.BatchGetPrograms:output_type -> thmanyah.v1.BatchGetProgramsResponse
+	25,  // 265: thmanyah.v1.CmsService.CreateCategory:output_type -> thmanyah.v1.CreateCategoryResponse
+	27,  // 266: thmanyah.v1.CmsService.UpdateCategory:output_type -> thmanyah.v1.UpdateCategoryResponse
+	188, // 267: thmanyah.v1.CmsService.DeleteCategory:output_type -> google.protobuf.Empty
+	72,  // 268: thmanyah.v1.CmsService.GetCategoryTree:output_type -> thmanyah.v1.GetCategoryTreeResponse
+	30,  // 269: thmanyah.v1.CmsService.GetCategory:output_type -> thmanyah.v1.GetCategoryResponse
+	32,  // 270: thmanyah.v1.CmsService.ListCategories:output_type -> thmanyah.v1.ListCategoriesResponse
+	34,  // 271: thmanyah.v1.CmsService.BatchGetCategories:output_type -> thmanyah.v1.BatchGetCategoriesResponse
+	36,  // 272: thmanyah.v1.CmsService.CreateEpisode:output_type -> thmanyah.v1.CreateEpisodeResponse
+	38,  // 273: thmanyah.v1.CmsService.UpdateEpisode:output_type -> thmanyah.v1.UpdateEpisodeResponse
+	188, // 274: thmanyah.v1.CmsService.DeleteEpisode:output_type -> google.protobuf.Empty
+	153, // 275: thmanyah.v1.CmsService.GetEpisode:output_type -> thmanyah.v1.GetEpisodeResponse
+	155, // 276: thmanyah.v1.CmsService.ListEpisodes:output_type -> thmanyah.v1.ListEpisodesResponse
+	157, // 277: thmanyah.v1.CmsService.BatchGetEpisodes:output_type -> thmanyah.v1.BatchGetEpisodesResponse
+	40,  // 278: thmanyah.v1.CmsService.RescheduleEpisode:output_type -> thmanyah.v1.RescheduleEpisodeResponse
+	42,  // 279: thmanyah.v1.CmsService.CancelEpisodeSchedule:output_type -> thmanyah.v1.CancelEpisodeScheduleResponse
+	47,  // 280: thmanyah.v1.CmsService.SubmitForReview:output_type -> thmanyah.v1.ReviewResponse
+	47,  // 281: thmanyah.v1.CmsService.Approve:output_type -> thmanyah.v1.ReviewResponse
+	47,  // 282: thmanyah.v1.CmsService.Reject:output_type -> thmanyah.v1.ReviewResponse
+	49,  // 283: thmanyah.v1.CmsService.ListStatusTransitions:output_type -> thmanyah.v1.ListStatusTransitionsResponse
+	53,  // 284: thmanyah.v1.CmsService.ListRevisions:output_type -> thmanyah.v1.ListRevisionsResponse
+	55,  // 285: thmanyah.v1.CmsService.GetRevision:output_type -> thmanyah.v1.GetRevisionResponse
+	57,  // 286: thmanyah.v1.CmsService.DiffRevisions:output_type -> thmanyah.v1.DiffRevisionsResponse
+	59,  // 287: thmanyah.v1.CmsService.RestoreRevision:output_type -> thmanyah.v1.RestoreRevisionResponse
+	62,  // 288: thmanyah.v1.CmsService.ListTrash:output_type -> thmanyah.v1.ListTrashResponse
+	64,  // 289: thmanyah.v1.CmsService.RestoreFromTrash:output_type -> thmanyah.v1.RestoreFromTrashResponse
+	66,  // 290: thmanyah.v1.CmsService.PurgeTrash:output_type -> thmanyah.v1.PurgeTrashResponse
+	69,  // 291: thmanyah.v1.CmsService.ListAuditEvents:output_type -> thmanyah.v1.ListAuditEventsResponse
+	74,  // 292: thmanyah.v1.CmsService.MoveCategory:output_type -> thmanyah.v1.MoveCategoryResponse
+	76,  // 293: thmanyah.v1.CmsService.SetProgramCategories:output_type -> thmanyah.v1.SetProgramCategoriesResponse
+	79,  // 294: thmanyah.v1.CmsService.ListTags:output_type -> thmanyah.v1.ListTagsResponse
+	81,  // 295: thmanyah.v1.CmsService.AutocompleteTags:output_type -> thmanyah.v1.AutocompleteTagsResponse
+	83,  // 296: thmanyah.v1.CmsService.UpdateTag:output_type -> thmanyah.v1.UpdateTagResponse
+	85,  // 297: thmanyah.v1.CmsService.RenameTag:output_type -> thmanyah.v1.RenameTagResponse
+	87,  // 298: thmanyah.v1.CmsService.MergeTags:output_type -> thmanyah.v1.MergeTagsResponse
+	90,  // 299: thmanyah.v1.CmsService.ListTranslations:output_type -> thmanyah.v1.ListTranslationsResponse
+	92,  // 300: thmanyah.v1.CmsService.SetTranslation:output_type -> thmanyah.v1.SetTranslationResponse
+	188, // 301: thmanyah.v1.CmsService.DeleteTranslation:output_type -> google.protobuf.Empty
+	96,  // 302: thmanyah.v1.CmsService.CreateSeason:output_type -> thmanyah.v1.CreateSeasonResponse
+	100, // 303: thmanyah.v1.CmsService.ListSeasons:output_type -> thmanyah.v1.ListSeasonsResponse
+	98,  // 304: thmanyah.v1.CmsService.GetSeason:output_type -> thmanyah.v1.GetSeasonResponse
+	102, // 305: thmanyah.v1.CmsService.UpdateSeason:output_type -> thmanyah.v1.UpdateSeasonResponse
+	188, // 306: thmanyah.v1.CmsService.DeleteSeason:output_type -> google.protobuf.Empty
+	105, // 307: thmanyah.v1.CmsService.ReorderEpisodes:output_type -> thmanyah.v1.ReorderEpisodesResponse
+	107, // 308: thmanyah.v1.CmsService.ListSeasonEpisodes:output_type -> thmanyah.v1.ListSeasonEpisodesResponse
+	111, // 309: thmanyah.v1.CmsService.ListChapters:output_type -> thmanyah.v1.ListChaptersResponse
+	113, // 310: thmanyah.v1.CmsService.CreateChapter:output_type -> thmanyah.v1.CreateChapterResponse
+	115, // 311: thmanyah.v1.CmsService.UpdateChapter:output_type -> thmanyah.v1.UpdateChapterResponse
+	188, // 312: thmanyah.v1.CmsService.DeleteChapter:output_type -> google.protobuf.Empty
+	118, // 313: thmanyah.v1.CmsService.ReplaceChapters:output_type -> thmanyah.v1.ReplaceChaptersResponse
+	120, // 314: thmanyah.v1.CmsService.ImportChapters:output_type -> thmanyah.v1.ImportChaptersResponse
+	123, // 315: thmanyah.v1.CmsService.UploadTranscript:output_type -> thmanyah.v1.UploadTranscriptResponse
+	125, // 316: thmanyah.v1.CmsService.ListTranscripts:output_type -> thmanyah.v1.ListTranscriptsResponse
+	188, // 317: thmanyah.v1.CmsService.DeleteTranscript:output_type -> google.protobuf.Empty
+	132, // 318: thmanyah.v1.CmsService.CreatePerson:output_type -> thmanyah.v1.CreatePersonResponse
+	134, // 319: thmanyah.v1.CmsService.GetPerson:output_type -> thmanyah.v1.GetPersonResponse
+	136, // 320: thmanyah.v1.CmsService.ListPeople:output_type -> thmanyah.v1.ListPeopleResponse
+	138, // 321: thmanyah.v1.CmsService.UpdatePerson:output_type -> thmanyah.v1.UpdatePersonResponse
+	188, // 322: thmanyah.v1.CmsService.DeletePerson:output_type -> google.protobuf.Empty
+	141, // 323: thmanyah.v1.CmsService.CreateCredit:output_type -> thmanyah.v1.CreateCreditResponse
+	143, // 324: thmanyah.v1.CmsService.ListCredits:output_type -> thmanyah.v1.ListCreditsResponse
+	145, // 325: thmanyah.v1.CmsService.UpdateCredit:output_type -> thmanyah.v1.UpdateCreditResponse
+	188, // 326: thmanyah.v1.CmsService.DeleteCredit:output_type -> google.protobuf.Empty
+	148, // 327: thmanyah.v1.CmsService.ListCreditsByPerson:output_type -> thmanyah.v1.ListCreditsByPersonResponse
+	150, // 328: thmanyah.v1.CmsService.CheckAvailability:output_type -> thmanyah.v1.CheckAvailabilityResponse
+	159, // 329: thmanyah.v1.CmsService.ImportData:output_type -> thmanyah.v1.ImportDataResponse
+	161, // 330: thmanyah.v1.CmsService.WatchImport:output_type -> thmanyah.v1.ImportEvent
+	163, // 331: thmanyah.v1.CmsService.BulkUpdatePrograms:output_type -> thmanyah.v1.BulkUpdateProgramsResponse
+	188, // 332: thmanyah.v1.CmsService.BulkDeletePrograms:output_type -> google.protobuf.Empty
+	259, // [259:333] is the sub-list for method output_type
+	185, // [185:259] is the sub-list for method input_type
+	185, // [185:185] is the sub-list for extension type_name
+	185, // [185:185] is the sub-list for extension extendee
+	0,   // [0:185] is the sub-list for field type_name
 }
 
 func init() { file_v1_cms_proto_init() }
@@ -3751,16 +13351,24 @@ func file_v1_cms_proto_init() {
 		return
 	}
 	file_v1_cms_proto_msgTypes[1].OneofWrappers = []any{}
-	file_v1_cms_proto_msgTypes[5].OneofWrappers = []any{}
-	file_v1_cms_proto_msgTypes[14].OneofWrappers = []any{}
-	file_v1_cms_proto_msgTypes[23].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[6].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[17].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[28].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[41].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[56].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[92].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[99].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[100].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[105].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[128].OneofWrappers = []any{}
+	file_v1_cms_proto_msgTypes[135].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_cms_proto_rawDesc), len(file_v1_cms_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   52,
+			NumEnums:      9,
+			NumMessages:   175,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _cms_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on Category with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -136,6 +139,8 @@ func (m *Category) validate(all bool) error {
 
 	// no validation rules for Metadata
 
+	// no validation rules for ParentId
+
 	if len(errors) > 0 {
 		return CategoryMultiError(errors)
 	}
@@ -365,6 +370,35 @@ func (m *Program) validate(all bool) error {
 
 	// no validation rules for Rating
 
+	if all {
+		switch v := interface{}(m.GetAvailability()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProgramValidationError{
+					field:  "Availability",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProgramValidationError{
+					field:  "Availability",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAvailability()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProgramValidationError{
+				field:  "Availability",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.SourceUrl != nil {
 		// no validation rules for SourceUrl
 	}
@@ -658,6 +692,71 @@ func (m *Episode) validate(all bool) error {
 
 	// no validation rules for Rating
 
+	// no validation rules for SeasonId
+
+	for idx, item := range m.GetChapters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EpisodeValidationError{
+						field:  fmt.Sprintf("Chapters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EpisodeValidationError{
+						field:  fmt.Sprintf("Chapters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EpisodeValidationError{
+					field:  fmt.Sprintf("Chapters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAvailability()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EpisodeValidationError{
+					field:  "Availability",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EpisodeValidationError{
+					field:  "Availability",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAvailability()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EpisodeValidationError{
+				field:  "Availability",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return EpisodeMultiError(errors)
 	}
@@ -735,32 +834,90 @@ var _ interface {
 	ErrorName() string
 } = EpisodeValidationError{}
 
-// Validate checks the field values on CreateProgramRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateProgramRequest) Validate() error {
+// Validate checks the field values on Availability with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Availability) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProgramRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateProgramRequestMultiError, or nil if none found.
-func (m *CreateProgramRequest) ValidateAll() error {
+// ValidateAll checks the field values on Availability with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AvailabilityMultiError, or
+// nil if none found.
+func (m *Availability) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProgramRequest) validate(all bool) error {
+func (m *Availability) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetTitle()) < 1 {
-		err := CreateProgramRequestValidationError{
-			field:  "Title",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetStartAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AvailabilityValidationError{
+					field:  "StartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AvailabilityValidationError{
+					field:  "StartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AvailabilityValidationError{
+				field:  "StartAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AvailabilityValidationError{
+					field:  "EndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AvailabilityValidationError{
+					field:  "EndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AvailabilityValidationError{
+				field:  "EndAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetAllowedCountries()) > 250 {
+		err := AvailabilityValidationError{
+			field:  "AllowedCountries",
+			reason: "value must contain no more than 250 item(s)",
 		}
 		if !all {
 			return err
@@ -768,12 +925,26 @@ func (m *CreateProgramRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Description
+	for idx, item := range m.GetAllowedCountries() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetCategoryId()) < 1 {
-		err := CreateProgramRequestValidationError{
-			field:  "CategoryId",
-			reason: "value length must be at least 1 runes",
+		if !_Availability_AllowedCountries_Pattern.MatchString(item) {
+			err := AvailabilityValidationError{
+				field:  fmt.Sprintf("AllowedCountries[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetBlockedCountries()) > 250 {
+		err := AvailabilityValidationError{
+			field:  "BlockedCountries",
+			reason: "value must contain no more than 250 item(s)",
 		}
 		if !all {
 			return err
@@ -781,28 +952,35 @@ func (m *CreateProgramRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ThumbnailUrl
-
-	// no validation rules for Metadata
+	for idx, item := range m.GetBlockedCountries() {
+		_, _ = idx, item
 
-	// no validation rules for SourceUrl
+		if !_Availability_BlockedCountries_Pattern.MatchString(item) {
+			err := AvailabilityValidationError{
+				field:  fmt.Sprintf("BlockedCountries[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for IsFeatured
+	}
 
 	if len(errors) > 0 {
-		return CreateProgramRequestMultiError(errors)
+		return AvailabilityMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProgramRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateProgramRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateProgramRequestMultiError []error
+// AvailabilityMultiError is an error wrapping multiple validation errors
+// returned by Availability.ValidateAll() if the designated constraints aren't met.
+type AvailabilityMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProgramRequestMultiError) Error() string {
+func (m AvailabilityMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -811,11 +989,11 @@ func (m CreateProgramRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProgramRequestMultiError) AllErrors() []error { return m }
+func (m AvailabilityMultiError) AllErrors() []error { return m }
 
-// CreateProgramRequestValidationError is the validation error returned by
-// CreateProgramRequest.Validate if the designated constraints aren't met.
-type CreateProgramRequestValidationError struct {
+// AvailabilityValidationError is the validation error returned by
+// Availability.Validate if the designated constraints aren't met.
+type AvailabilityValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -823,24 +1001,22 @@ type CreateProgramRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProgramRequestValidationError) Field() string { return e.field }
+func (e AvailabilityValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProgramRequestValidationError) Reason() string { return e.reason }
+func (e AvailabilityValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProgramRequestValidationError) Cause() error { return e.cause }
+func (e AvailabilityValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProgramRequestValidationError) Key() bool { return e.key }
+func (e AvailabilityValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProgramRequestValidationError) ErrorName() string {
-	return "CreateProgramRequestValidationError"
-}
+func (e AvailabilityValidationError) ErrorName() string { return "AvailabilityValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateProgramRequestValidationError) Error() string {
+func (e AvailabilityValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -852,14 +1028,14 @@ func (e CreateProgramRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProgramRequest.%s: %s%s",
+		"invalid %sAvailability.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProgramRequestValidationError{}
+var _ error = AvailabilityValidationError{}
 
 var _ interface {
 	Field() string
@@ -867,53 +1043,117 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProgramRequestValidationError{}
+} = AvailabilityValidationError{}
 
-// Validate checks the field values on CreateProgramResponse with the rules
+var _Availability_AllowedCountries_Pattern = regexp.MustCompile("^[A-Za-z]{2}$")
+
+var _Availability_BlockedCountries_Pattern = regexp.MustCompile("^[A-Za-z]{2}$")
+
+// Validate checks the field values on CreateProgramRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateProgramResponse) Validate() error {
+func (m *CreateProgramRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProgramResponse with the rules
+// ValidateAll checks the field values on CreateProgramRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateProgramResponseMultiError, or nil if none found.
-func (m *CreateProgramResponse) ValidateAll() error {
+// CreateProgramRequestMultiError, or nil if none found.
+func (m *CreateProgramRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProgramResponse) validate(all bool) error {
+func (m *CreateProgramRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetTitle()) < 1 {
+		err := CreateProgramRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if utf8.RuneCountInString(m.GetCategoryId()) < 1 {
+		err := CreateProgramRequestValidationError{
+			field:  "CategoryId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ThumbnailUrl
+
+	// no validation rules for Metadata
+
+	// no validation rules for SourceUrl
+
+	// no validation rules for IsFeatured
+
+	if len(m.GetCategoryIds()) > 20 {
+		err := CreateProgramRequestValidationError{
+			field:  "CategoryIds",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCategoryIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = CreateProgramRequestValidationError{
+				field:  fmt.Sprintf("CategoryIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if all {
-		switch v := interface{}(m.GetProgram()).(type) {
+		switch v := interface{}(m.GetAvailability()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateProgramResponseValidationError{
-					field:  "Program",
+				errors = append(errors, CreateProgramRequestValidationError{
+					field:  "Availability",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateProgramResponseValidationError{
-					field:  "Program",
+				errors = append(errors, CreateProgramRequestValidationError{
+					field:  "Availability",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProgram()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAvailability()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateProgramResponseValidationError{
-				field:  "Program",
+			return CreateProgramRequestValidationError{
+				field:  "Availability",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -921,14 +1161,153 @@ func (m *CreateProgramResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateProgramResponseMultiError(errors)
+		return CreateProgramRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProgramResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateProgramResponse.ValidateAll() if the designated
+func (m *CreateProgramRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateProgramRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateProgramRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProgramRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateProgramRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateProgramRequestMultiError) AllErrors() []error { return m }
+
+// CreateProgramRequestValidationError is the validation error returned by
+// CreateProgramRequest.Validate if the designated constraints aren't met.
+type CreateProgramRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateProgramRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateProgramRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateProgramRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateProgramRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateProgramRequestValidationError) ErrorName() string {
+	return "CreateProgramRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateProgramRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateProgramRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateProgramRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateProgramRequestValidationError{}
+
+// Validate checks the field values on CreateProgramResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateProgramResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateProgramResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProgramResponseMultiError, or nil if none found.
+func (m *CreateProgramResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateProgramResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProgram()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProgramResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProgramResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgram()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProgramResponseValidationError{
+				field:  "Program",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateProgramResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateProgramResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateProgramResponse.ValidateAll() if the designated
 // constraints aren't met.
 type CreateProgramResponseMultiError []error
 
@@ -1035,6 +1414,35 @@ func (m *UpdateProgramRequest) validate(all bool) error {
 
 	// no validation rules for Metadata
 
+	if all {
+		switch v := interface{}(m.GetAvailability()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProgramRequestValidationError{
+					field:  "Availability",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProgramRequestValidationError{
+					field:  "Availability",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAvailability()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProgramRequestValidationError{
+				field:  "Availability",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Title != nil {
 		// no validation rules for Title
 	}
@@ -1900,32 +2308,32 @@ var _ interface {
 	ErrorName() string
 } = ListProgramsResponseValidationError{}
 
-// Validate checks the field values on CreateCategoryRequest with the rules
+// Validate checks the field values on BatchGetProgramsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateCategoryRequest) Validate() error {
+func (m *BatchGetProgramsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateCategoryRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchGetProgramsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateCategoryRequestMultiError, or nil if none found.
-func (m *CreateCategoryRequest) ValidateAll() error {
+// BatchGetProgramsRequestMultiError, or nil if none found.
+func (m *BatchGetProgramsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateCategoryRequest) validate(all bool) error {
+func (m *BatchGetProgramsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := CreateCategoryRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
+	if l := len(m.GetProgramIds()); l < 1 || l > 100 {
+		err := BatchGetProgramsRequestValidationError{
+			field:  "ProgramIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
 		}
 		if !all {
 			return err
@@ -1933,26 +2341,46 @@ func (m *CreateCategoryRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Description
+	if m.GetLocale() != "" {
 
-	// no validation rules for Type
+		if utf8.RuneCountInString(m.GetLocale()) > 35 {
+			err := BatchGetProgramsRequestValidationError{
+				field:  "Locale",
+				reason: "value length must be at most 35 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for Metadata
+		if !_BatchGetProgramsRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+			err := BatchGetProgramsRequestValidationError{
+				field:  "Locale",
+				reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return CreateCategoryRequestMultiError(errors)
+		return BatchGetProgramsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateCategoryRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateCategoryRequest.ValidateAll() if the designated
+// BatchGetProgramsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProgramsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateCategoryRequestMultiError []error
+type BatchGetProgramsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateCategoryRequestMultiError) Error() string {
+func (m BatchGetProgramsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1961,11 +2389,11 @@ func (m CreateCategoryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateCategoryRequestMultiError) AllErrors() []error { return m }
+func (m BatchGetProgramsRequestMultiError) AllErrors() []error { return m }
 
-// CreateCategoryRequestValidationError is the validation error returned by
-// CreateCategoryRequest.Validate if the designated constraints aren't met.
-type CreateCategoryRequestValidationError struct {
+// BatchGetProgramsRequestValidationError is the validation error returned by
+// BatchGetProgramsRequest.Validate if the designated constraints aren't met.
+type BatchGetProgramsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1973,24 +2401,24 @@ type CreateCategoryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateCategoryRequestValidationError) Field() string { return e.field }
+func (e BatchGetProgramsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateCategoryRequestValidationError) Reason() string { return e.reason }
+func (e BatchGetProgramsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateCategoryRequestValidationError) Cause() error { return e.cause }
+func (e BatchGetProgramsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateCategoryRequestValidationError) Key() bool { return e.key }
+func (e BatchGetProgramsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateCategoryRequestValidationError) ErrorName() string {
-	return "CreateCategoryRequestValidationError"
+func (e BatchGetProgramsRequestValidationError) ErrorName() string {
+	return "BatchGetProgramsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateCategoryRequestValidationError) Error() string {
+func (e BatchGetProgramsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2002,14 +2430,14 @@ func (e CreateCategoryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateCategoryRequest.%s: %s%s",
+		"invalid %sBatchGetProgramsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateCategoryRequestValidationError{}
+var _ error = BatchGetProgramsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2017,61 +2445,342 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateCategoryRequestValidationError{}
+} = BatchGetProgramsRequestValidationError{}
 
-// Validate checks the field values on CreateCategoryResponse with the rules
+var _BatchGetProgramsRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on BatchGetProgramsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateCategoryResponse) Validate() error {
+func (m *BatchGetProgramsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateCategoryResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchGetProgramsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateCategoryResponseMultiError, or nil if none found.
-func (m *CreateCategoryResponse) ValidateAll() error {
+// BatchGetProgramsResponseMultiError, or nil if none found.
+func (m *BatchGetProgramsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateCategoryResponse) validate(all bool) error {
+func (m *BatchGetProgramsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCategory()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateCategoryResponseValidationError{
-					field:  "Category",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetPrograms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetProgramsResponseValidationError{
+						field:  fmt.Sprintf("Programs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetProgramsResponseValidationError{
+						field:  fmt.Sprintf("Programs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateCategoryResponseValidationError{
-					field:  "Category",
+				return BatchGetProgramsResponseValidationError{
+					field:  fmt.Sprintf("Programs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateCategoryResponseValidationError{
-				field:  "Category",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateCategoryResponseMultiError(errors)
+		return BatchGetProgramsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetProgramsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProgramsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetProgramsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetProgramsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetProgramsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetProgramsResponseValidationError is the validation error returned by
+// BatchGetProgramsResponse.Validate if the designated constraints aren't met.
+type BatchGetProgramsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetProgramsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetProgramsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetProgramsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetProgramsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetProgramsResponseValidationError) ErrorName() string {
+	return "BatchGetProgramsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetProgramsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetProgramsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetProgramsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetProgramsResponseValidationError{}
+
+// Validate checks the field values on CreateCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCategoryRequestMultiError, or nil if none found.
+func (m *CreateCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateCategoryRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for Type
+
+	// no validation rules for Metadata
+
+	if m.GetParentId() != "" {
+
+		if err := m._validateUuid(m.GetParentId()); err != nil {
+			err = CreateCategoryRequestValidationError{
+				field:  "ParentId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateCategoryRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCategoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCategoryRequestMultiError) AllErrors() []error { return m }
+
+// CreateCategoryRequestValidationError is the validation error returned by
+// CreateCategoryRequest.Validate if the designated constraints aren't met.
+type CreateCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCategoryRequestValidationError) ErrorName() string {
+	return "CreateCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCategoryRequestValidationError{}
+
+// Validate checks the field values on CreateCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCategoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCategoryResponseMultiError, or nil if none found.
+func (m *CreateCategoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCategoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCategoryResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCategoryResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCategoryResponseValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCategoryResponseMultiError(errors)
 	}
 
 	return nil
@@ -3028,31 +3737,308 @@ var _ interface {
 	ErrorName() string
 } = ListCategoriesResponseValidationError{}
 
-// Validate checks the field values on CreateEpisodeRequest with the rules
+// Validate checks the field values on BatchGetCategoriesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateEpisodeRequest) Validate() error {
+func (m *BatchGetCategoriesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateEpisodeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchGetCategoriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateEpisodeRequestMultiError, or nil if none found.
-func (m *CreateEpisodeRequest) ValidateAll() error {
+// BatchGetCategoriesRequestMultiError, or nil if none found.
+func (m *BatchGetCategoriesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateEpisodeRequest) validate(all bool) error {
+func (m *BatchGetCategoriesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetProgramId()) < 1 {
-		err := CreateEpisodeRequestValidationError{
-			field:  "ProgramId",
+	if l := len(m.GetCategoryIds()); l < 1 || l > 100 {
+		err := BatchGetCategoriesRequestValidationError{
+			field:  "CategoryIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLocale() != "" {
+
+		if utf8.RuneCountInString(m.GetLocale()) > 35 {
+			err := BatchGetCategoriesRequestValidationError{
+				field:  "Locale",
+				reason: "value length must be at most 35 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_BatchGetCategoriesRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+			err := BatchGetCategoriesRequestValidationError{
+				field:  "Locale",
+				reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetCategoriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetCategoriesRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetCategoriesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type BatchGetCategoriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetCategoriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetCategoriesRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetCategoriesRequestValidationError is the validation error returned by
+// BatchGetCategoriesRequest.Validate if the designated constraints aren't met.
+type BatchGetCategoriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetCategoriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetCategoriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetCategoriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetCategoriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetCategoriesRequestValidationError) ErrorName() string {
+	return "BatchGetCategoriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetCategoriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetCategoriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetCategoriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetCategoriesRequestValidationError{}
+
+var _BatchGetCategoriesRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on BatchGetCategoriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetCategoriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetCategoriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetCategoriesResponseMultiError, or nil if none found.
+func (m *BatchGetCategoriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetCategoriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetCategoriesResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetCategoriesResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetCategoriesResponseValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetCategoriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetCategoriesResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchGetCategoriesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BatchGetCategoriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetCategoriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetCategoriesResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetCategoriesResponseValidationError is the validation error returned
+// by BatchGetCategoriesResponse.Validate if the designated constraints aren't met.
+type BatchGetCategoriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetCategoriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetCategoriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetCategoriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetCategoriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetCategoriesResponseValidationError) ErrorName() string {
+	return "BatchGetCategoriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetCategoriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetCategoriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetCategoriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetCategoriesResponseValidationError{}
+
+// Validate checks the field values on CreateEpisodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateEpisodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateEpisodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateEpisodeRequestMultiError, or nil if none found.
+func (m *CreateEpisodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateEpisodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProgramId()) < 1 {
+		err := CreateEpisodeRequestValidationError{
+			field:  "ProgramId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3113,6 +4099,35 @@ func (m *CreateEpisodeRequest) validate(all bool) error {
 
 	// no validation rules for Metadata
 
+	if all {
+		switch v := interface{}(m.GetAvailability()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateEpisodeRequestValidationError{
+					field:  "Availability",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateEpisodeRequestValidationError{
+					field:  "Availability",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAvailability()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateEpisodeRequestValidationError{
+				field:  "Availability",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateEpisodeRequestMultiError(errors)
 	}
@@ -3359,6 +4374,35 @@ func (m *UpdateEpisodeRequest) validate(all bool) error {
 
 	// no validation rules for Metadata
 
+	if all {
+		switch v := interface{}(m.GetAvailability()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateEpisodeRequestValidationError{
+					field:  "Availability",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateEpisodeRequestValidationError{
+					field:  "Availability",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAvailability()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateEpisodeRequestValidationError{
+				field:  "Availability",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Title != nil {
 		// no validation rules for Title
 	}
@@ -3635,22 +4679,22 @@ var _ interface {
 	ErrorName() string
 } = UpdateEpisodeResponseValidationError{}
 
-// Validate checks the field values on DeleteEpisodeRequest with the rules
+// Validate checks the field values on RescheduleEpisodeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteEpisodeRequest) Validate() error {
+func (m *RescheduleEpisodeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteEpisodeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RescheduleEpisodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteEpisodeRequestMultiError, or nil if none found.
-func (m *DeleteEpisodeRequest) ValidateAll() error {
+// RescheduleEpisodeRequestMultiError, or nil if none found.
+func (m *RescheduleEpisodeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteEpisodeRequest) validate(all bool) error {
+func (m *RescheduleEpisodeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3658,7 +4702,7 @@ func (m *DeleteEpisodeRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetEpisodeId()) < 1 {
-		err := DeleteEpisodeRequestValidationError{
+		err := RescheduleEpisodeRequestValidationError{
 			field:  "EpisodeId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -3668,20 +4712,61 @@ func (m *DeleteEpisodeRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetScheduledAt() == nil {
+		err := RescheduleEpisodeRequestValidationError{
+			field:  "ScheduledAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if t := m.GetScheduledAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = RescheduleEpisodeRequestValidationError{
+				field:  "ScheduledAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) <= 0 {
+				err := RescheduleEpisodeRequestValidationError{
+					field:  "ScheduledAt",
+					reason: "value must be greater than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteEpisodeRequestMultiError(errors)
+		return RescheduleEpisodeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteEpisodeRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteEpisodeRequest.ValidateAll() if the designated
+// RescheduleEpisodeRequestMultiError is an error wrapping multiple validation
+// errors returned by RescheduleEpisodeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteEpisodeRequestMultiError []error
+type RescheduleEpisodeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteEpisodeRequestMultiError) Error() string {
+func (m RescheduleEpisodeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3690,11 +4775,11 @@ func (m DeleteEpisodeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteEpisodeRequestMultiError) AllErrors() []error { return m }
+func (m RescheduleEpisodeRequestMultiError) AllErrors() []error { return m }
 
-// DeleteEpisodeRequestValidationError is the validation error returned by
-// DeleteEpisodeRequest.Validate if the designated constraints aren't met.
-type DeleteEpisodeRequestValidationError struct {
+// RescheduleEpisodeRequestValidationError is the validation error returned by
+// RescheduleEpisodeRequest.Validate if the designated constraints aren't met.
+type RescheduleEpisodeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3702,24 +4787,24 @@ type DeleteEpisodeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteEpisodeRequestValidationError) Field() string { return e.field }
+func (e RescheduleEpisodeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteEpisodeRequestValidationError) Reason() string { return e.reason }
+func (e RescheduleEpisodeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteEpisodeRequestValidationError) Cause() error { return e.cause }
+func (e RescheduleEpisodeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteEpisodeRequestValidationError) Key() bool { return e.key }
+func (e RescheduleEpisodeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteEpisodeRequestValidationError) ErrorName() string {
-	return "DeleteEpisodeRequestValidationError"
+func (e RescheduleEpisodeRequestValidationError) ErrorName() string {
+	return "RescheduleEpisodeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteEpisodeRequestValidationError) Error() string {
+func (e RescheduleEpisodeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3731,14 +4816,14 @@ func (e DeleteEpisodeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteEpisodeRequest.%s: %s%s",
+		"invalid %sRescheduleEpisodeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteEpisodeRequestValidationError{}
+var _ error = RescheduleEpisodeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3746,24 +4831,155 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteEpisodeRequestValidationError{}
+} = RescheduleEpisodeRequestValidationError{}
 
-// Validate checks the field values on GetEpisodeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetEpisodeRequest) Validate() error {
+// Validate checks the field values on RescheduleEpisodeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RescheduleEpisodeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetEpisodeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RescheduleEpisodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetEpisodeRequestMultiError, or nil if none found.
-func (m *GetEpisodeRequest) ValidateAll() error {
+// RescheduleEpisodeResponseMultiError, or nil if none found.
+func (m *RescheduleEpisodeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetEpisodeRequest) validate(all bool) error {
+func (m *RescheduleEpisodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEpisode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RescheduleEpisodeResponseValidationError{
+					field:  "Episode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RescheduleEpisodeResponseValidationError{
+					field:  "Episode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEpisode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RescheduleEpisodeResponseValidationError{
+				field:  "Episode",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RescheduleEpisodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RescheduleEpisodeResponseMultiError is an error wrapping multiple validation
+// errors returned by RescheduleEpisodeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RescheduleEpisodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RescheduleEpisodeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RescheduleEpisodeResponseMultiError) AllErrors() []error { return m }
+
+// RescheduleEpisodeResponseValidationError is the validation error returned by
+// RescheduleEpisodeResponse.Validate if the designated constraints aren't met.
+type RescheduleEpisodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RescheduleEpisodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RescheduleEpisodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RescheduleEpisodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RescheduleEpisodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RescheduleEpisodeResponseValidationError) ErrorName() string {
+	return "RescheduleEpisodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RescheduleEpisodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRescheduleEpisodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RescheduleEpisodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RescheduleEpisodeResponseValidationError{}
+
+// Validate checks the field values on CancelEpisodeScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelEpisodeScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelEpisodeScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelEpisodeScheduleRequestMultiError, or nil if none found.
+func (m *CancelEpisodeScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelEpisodeScheduleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3771,7 +4987,7 @@ func (m *GetEpisodeRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetEpisodeId()) < 1 {
-		err := GetEpisodeRequestValidationError{
+		err := CancelEpisodeScheduleRequestValidationError{
 			field:  "EpisodeId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -3782,19 +4998,19 @@ func (m *GetEpisodeRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetEpisodeRequestMultiError(errors)
+		return CancelEpisodeScheduleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetEpisodeRequestMultiError is an error wrapping multiple validation errors
-// returned by GetEpisodeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetEpisodeRequestMultiError []error
+// CancelEpisodeScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelEpisodeScheduleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CancelEpisodeScheduleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetEpisodeRequestMultiError) Error() string {
+func (m CancelEpisodeScheduleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3803,11 +5019,12 @@ func (m GetEpisodeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetEpisodeRequestMultiError) AllErrors() []error { return m }
+func (m CancelEpisodeScheduleRequestMultiError) AllErrors() []error { return m }
 
-// GetEpisodeRequestValidationError is the validation error returned by
-// GetEpisodeRequest.Validate if the designated constraints aren't met.
-type GetEpisodeRequestValidationError struct {
+// CancelEpisodeScheduleRequestValidationError is the validation error returned
+// by CancelEpisodeScheduleRequest.Validate if the designated constraints
+// aren't met.
+type CancelEpisodeScheduleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3815,24 +5032,24 @@ type GetEpisodeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetEpisodeRequestValidationError) Field() string { return e.field }
+func (e CancelEpisodeScheduleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetEpisodeRequestValidationError) Reason() string { return e.reason }
+func (e CancelEpisodeScheduleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetEpisodeRequestValidationError) Cause() error { return e.cause }
+func (e CancelEpisodeScheduleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetEpisodeRequestValidationError) Key() bool { return e.key }
+func (e CancelEpisodeScheduleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetEpisodeRequestValidationError) ErrorName() string {
-	return "GetEpisodeRequestValidationError"
+func (e CancelEpisodeScheduleRequestValidationError) ErrorName() string {
+	return "CancelEpisodeScheduleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetEpisodeRequestValidationError) Error() string {
+func (e CancelEpisodeScheduleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3844,14 +5061,14 @@ func (e GetEpisodeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetEpisodeRequest.%s: %s%s",
+		"invalid %sCancelEpisodeScheduleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetEpisodeRequestValidationError{}
+var _ error = CancelEpisodeScheduleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3859,24 +5076,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetEpisodeRequestValidationError{}
+} = CancelEpisodeScheduleRequestValidationError{}
 
-// Validate checks the field values on GetEpisodeResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CancelEpisodeScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetEpisodeResponse) Validate() error {
+func (m *CancelEpisodeScheduleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetEpisodeResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetEpisodeResponseMultiError, or nil if none found.
-func (m *GetEpisodeResponse) ValidateAll() error {
+// ValidateAll checks the field values on CancelEpisodeScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelEpisodeScheduleResponseMultiError, or nil if none found.
+func (m *CancelEpisodeScheduleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetEpisodeResponse) validate(all bool) error {
+func (m *CancelEpisodeScheduleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3887,7 +5104,7 @@ func (m *GetEpisodeResponse) validate(all bool) error {
 		switch v := interface{}(m.GetEpisode()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetEpisodeResponseValidationError{
+				errors = append(errors, CancelEpisodeScheduleResponseValidationError{
 					field:  "Episode",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3895,7 +5112,7 @@ func (m *GetEpisodeResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetEpisodeResponseValidationError{
+				errors = append(errors, CancelEpisodeScheduleResponseValidationError{
 					field:  "Episode",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3904,7 +5121,7 @@ func (m *GetEpisodeResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetEpisode()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetEpisodeResponseValidationError{
+			return CancelEpisodeScheduleResponseValidationError{
 				field:  "Episode",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3913,19 +5130,19 @@ func (m *GetEpisodeResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetEpisodeResponseMultiError(errors)
+		return CancelEpisodeScheduleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetEpisodeResponseMultiError is an error wrapping multiple validation errors
-// returned by GetEpisodeResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetEpisodeResponseMultiError []error
+// CancelEpisodeScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelEpisodeScheduleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CancelEpisodeScheduleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetEpisodeResponseMultiError) Error() string {
+func (m CancelEpisodeScheduleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3934,11 +5151,12 @@ func (m GetEpisodeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetEpisodeResponseMultiError) AllErrors() []error { return m }
+func (m CancelEpisodeScheduleResponseMultiError) AllErrors() []error { return m }
 
-// GetEpisodeResponseValidationError is the validation error returned by
-// GetEpisodeResponse.Validate if the designated constraints aren't met.
-type GetEpisodeResponseValidationError struct {
+// CancelEpisodeScheduleResponseValidationError is the validation error
+// returned by CancelEpisodeScheduleResponse.Validate if the designated
+// constraints aren't met.
+type CancelEpisodeScheduleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3946,24 +5164,24 @@ type GetEpisodeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetEpisodeResponseValidationError) Field() string { return e.field }
+func (e CancelEpisodeScheduleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetEpisodeResponseValidationError) Reason() string { return e.reason }
+func (e CancelEpisodeScheduleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetEpisodeResponseValidationError) Cause() error { return e.cause }
+func (e CancelEpisodeScheduleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetEpisodeResponseValidationError) Key() bool { return e.key }
+func (e CancelEpisodeScheduleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetEpisodeResponseValidationError) ErrorName() string {
-	return "GetEpisodeResponseValidationError"
+func (e CancelEpisodeScheduleResponseValidationError) ErrorName() string {
+	return "CancelEpisodeScheduleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetEpisodeResponseValidationError) Error() string {
+func (e CancelEpisodeScheduleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3975,14 +5193,14 @@ func (e GetEpisodeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetEpisodeResponse.%s: %s%s",
+		"invalid %sCancelEpisodeScheduleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetEpisodeResponseValidationError{}
+var _ error = CancelEpisodeScheduleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3990,64 +5208,16366 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetEpisodeResponseValidationError{}
+} = CancelEpisodeScheduleResponseValidationError{}
 
-// Validate checks the field values on ListEpisodesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListEpisodesRequest) Validate() error {
+// Validate checks the field values on StatusTransition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StatusTransition) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEpisodesRequest with the rules
+// ValidateAll checks the field values on StatusTransition with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEpisodesRequestMultiError, or nil if none found.
-func (m *ListEpisodesRequest) ValidateAll() error {
+// StatusTransitionMultiError, or nil if none found.
+func (m *StatusTransition) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEpisodesRequest) validate(all bool) error {
+func (m *StatusTransition) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetProgramId()) < 1 {
-		err := ListEpisodesRequestValidationError{
-			field:  "ProgramId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Id
 
-	// no validation rules for Page
+	// no validation rules for ContentType
 
-	if m.GetPageSize() > 100 {
-		err := ListEpisodesRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be less than or equal to 100",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ContentId
 
-	// no validation rules for Status
+	// no validation rules for Action
 
-	// no validation rules for SearchQuery
+	// no validation rules for FromStatus
 
-	// no validation rules for SeasonNumber
+	// no validation rules for ToStatus
+
+	// no validation rules for ActorId
+
+	// no validation rules for Comment
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatusTransitionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatusTransitionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatusTransitionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatusTransitionMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusTransitionMultiError is an error wrapping multiple validation errors
+// returned by StatusTransition.ValidateAll() if the designated constraints
+// aren't met.
+type StatusTransitionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusTransitionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusTransitionMultiError) AllErrors() []error { return m }
+
+// StatusTransitionValidationError is the validation error returned by
+// StatusTransition.Validate if the designated constraints aren't met.
+type StatusTransitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusTransitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusTransitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusTransitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusTransitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusTransitionValidationError) ErrorName() string { return "StatusTransitionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusTransitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusTransition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusTransitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusTransitionValidationError{}
+
+// Validate checks the field values on SubmitForReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitForReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitForReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitForReviewRequestMultiError, or nil if none found.
+func (m *SubmitForReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitForReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _SubmitForReviewRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
+		err := SubmitForReviewRequestValidationError{
+			field:  "ContentType",
+			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := SubmitForReviewRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = SubmitForReviewRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 2000 {
+		err := SubmitForReviewRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubmitForReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SubmitForReviewRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SubmitForReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitForReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitForReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitForReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitForReviewRequestMultiError) AllErrors() []error { return m }
+
+// SubmitForReviewRequestValidationError is the validation error returned by
+// SubmitForReviewRequest.Validate if the designated constraints aren't met.
+type SubmitForReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitForReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitForReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitForReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitForReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitForReviewRequestValidationError) ErrorName() string {
+	return "SubmitForReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitForReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitForReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitForReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitForReviewRequestValidationError{}
+
+var _SubmitForReviewRequest_ContentType_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ApproveRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ApproveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ApproveRequestMultiError,
+// or nil if none found.
+func (m *ApproveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ApproveRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
+		err := ApproveRequestValidationError{
+			field:  "ContentType",
+			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := ApproveRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = ApproveRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 2000 {
+		err := ApproveRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApproveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ApproveRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ApproveRequestMultiError is an error wrapping multiple validation errors
+// returned by ApproveRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ApproveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveRequestMultiError) AllErrors() []error { return m }
+
+// ApproveRequestValidationError is the validation error returned by
+// ApproveRequest.Validate if the designated constraints aren't met.
+type ApproveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveRequestValidationError) ErrorName() string { return "ApproveRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApproveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveRequestValidationError{}
+
+var _ApproveRequest_ContentType_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on RejectRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RejectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RejectRequestMultiError, or
+// nil if none found.
+func (m *RejectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _RejectRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
+		err := RejectRequestValidationError{
+			field:  "ContentType",
+			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := RejectRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = RejectRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetComment()); l < 1 || l > 2000 {
+		err := RejectRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be between 1 and 2000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RejectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RejectRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RejectRequestMultiError is an error wrapping multiple validation errors
+// returned by RejectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RejectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectRequestMultiError) AllErrors() []error { return m }
+
+// RejectRequestValidationError is the validation error returned by
+// RejectRequest.Validate if the designated constraints aren't met.
+type RejectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectRequestValidationError) ErrorName() string { return "RejectRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RejectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectRequestValidationError{}
+
+var _RejectRequest_ContentType_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ReviewResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewResponseMultiError,
+// or nil if none found.
+func (m *ReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProgram()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgram()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewResponseValidationError{
+				field:  "Program",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEpisode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewResponseValidationError{
+					field:  "Episode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewResponseValidationError{
+					field:  "Episode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEpisode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewResponseValidationError{
+				field:  "Episode",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTransition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewResponseValidationError{
+					field:  "Transition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewResponseValidationError{
+					field:  "Transition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewResponseValidationError{
+				field:  "Transition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewResponseMultiError is an error wrapping multiple validation errors
+// returned by ReviewResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewResponseMultiError) AllErrors() []error { return m }
+
+// ReviewResponseValidationError is the validation error returned by
+// ReviewResponse.Validate if the designated constraints aren't met.
+type ReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewResponseValidationError) ErrorName() string { return "ReviewResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewResponseValidationError{}
+
+// Validate checks the field values on ListStatusTransitionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStatusTransitionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStatusTransitionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStatusTransitionsRequestMultiError, or nil if none found.
+func (m *ListStatusTransitionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStatusTransitionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListStatusTransitionsRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
+		err := ListStatusTransitionsRequestValidationError{
+			field:  "ContentType",
+			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := ListStatusTransitionsRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = ListStatusTransitionsRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListStatusTransitionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListStatusTransitionsRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListStatusTransitionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListStatusTransitionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListStatusTransitionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStatusTransitionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStatusTransitionsRequestMultiError) AllErrors() []error { return m }
+
+// ListStatusTransitionsRequestValidationError is the validation error returned
+// by ListStatusTransitionsRequest.Validate if the designated constraints
+// aren't met.
+type ListStatusTransitionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStatusTransitionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStatusTransitionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStatusTransitionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStatusTransitionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStatusTransitionsRequestValidationError) ErrorName() string {
+	return "ListStatusTransitionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStatusTransitionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStatusTransitionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStatusTransitionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStatusTransitionsRequestValidationError{}
+
+var _ListStatusTransitionsRequest_ContentType_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListStatusTransitionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStatusTransitionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStatusTransitionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListStatusTransitionsResponseMultiError, or nil if none found.
+func (m *ListStatusTransitionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStatusTransitionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransitions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStatusTransitionsResponseValidationError{
+						field:  fmt.Sprintf("Transitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStatusTransitionsResponseValidationError{
+						field:  fmt.Sprintf("Transitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStatusTransitionsResponseValidationError{
+					field:  fmt.Sprintf("Transitions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListStatusTransitionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStatusTransitionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListStatusTransitionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListStatusTransitionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStatusTransitionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStatusTransitionsResponseMultiError) AllErrors() []error { return m }
+
+// ListStatusTransitionsResponseValidationError is the validation error
+// returned by ListStatusTransitionsResponse.Validate if the designated
+// constraints aren't met.
+type ListStatusTransitionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStatusTransitionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStatusTransitionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStatusTransitionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStatusTransitionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStatusTransitionsResponseValidationError) ErrorName() string {
+	return "ListStatusTransitionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStatusTransitionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStatusTransitionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStatusTransitionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStatusTransitionsResponseValidationError{}
+
+// Validate checks the field values on Revision with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Revision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Revision with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevisionMultiError, or nil
+// if none found.
+func (m *Revision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Revision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ContentType
+
+	// no validation rules for ContentId
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevisionValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AuthorId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevisionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.RestoredFrom != nil {
+		// no validation rules for RestoredFrom
+	}
+
+	if len(errors) > 0 {
+		return RevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevisionMultiError is an error wrapping multiple validation errors returned
+// by Revision.ValidateAll() if the designated constraints aren't met.
+type RevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevisionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevisionMultiError) AllErrors() []error { return m }
+
+// RevisionValidationError is the validation error returned by
+// Revision.Validate if the designated constraints aren't met.
+type RevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevisionValidationError) ErrorName() string { return "RevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevisionValidationError{}
+
+// Validate checks the field values on FieldChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FieldChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldChangeMultiError, or
+// nil if none found.
+func (m *FieldChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FieldChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldChangeValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldChangeValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FieldChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// FieldChangeMultiError is an error wrapping multiple validation errors
+// returned by FieldChange.ValidateAll() if the designated constraints aren't met.
+type FieldChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FieldChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FieldChangeMultiError) AllErrors() []error { return m }
+
+// FieldChangeValidationError is the validation error returned by
+// FieldChange.Validate if the designated constraints aren't met.
+type FieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldChangeValidationError) ErrorName() string { return "FieldChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldChangeValidationError{}
+
+// Validate checks the field values on ListRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRevisionsRequestMultiError, or nil if none found.
+func (m *ListRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListRevisionsRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
+		err := ListRevisionsRequestValidationError{
+			field:  "ContentType",
+			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := ListRevisionsRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = ListRevisionsRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	if m.GetPageSize() > 100 {
+		err := ListRevisionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListRevisionsRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRevisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListRevisionsRequestValidationError is the validation error returned by
+// ListRevisionsRequest.Validate if the designated constraints aren't met.
+type ListRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRevisionsRequestValidationError) ErrorName() string {
+	return "ListRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRevisionsRequestValidationError{}
+
+var _ListRevisionsRequest_ContentType_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRevisionsResponseMultiError, or nil if none found.
+func (m *ListRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRevisionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRevisionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRevisionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListRevisionsResponseValidationError is the validation error returned by
+// ListRevisionsResponse.Validate if the designated constraints aren't met.
+type ListRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRevisionsResponseValidationError) ErrorName() string {
+	return "ListRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRevisionsResponseValidationError{}
+
+// Validate checks the field values on GetRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevisionRequestMultiError, or nil if none found.
+func (m *GetRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRevisionId()); err != nil {
+		err = GetRevisionRequestValidationError{
+			field:  "RevisionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetRevisionRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetRevisionRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRevisionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevisionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevisionRequestMultiError) AllErrors() []error { return m }
+
+// GetRevisionRequestValidationError is the validation error returned by
+// GetRevisionRequest.Validate if the designated constraints aren't met.
+type GetRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevisionRequestValidationError) ErrorName() string {
+	return "GetRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevisionRequestValidationError{}
+
+// Validate checks the field values on GetRevisionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRevisionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevisionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevisionResponseMultiError, or nil if none found.
+func (m *GetRevisionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevisionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRevision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRevisionResponseValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRevisionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRevisionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRevisionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRevisionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevisionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevisionResponseMultiError) AllErrors() []error { return m }
+
+// GetRevisionResponseValidationError is the validation error returned by
+// GetRevisionResponse.Validate if the designated constraints aren't met.
+type GetRevisionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevisionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevisionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevisionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevisionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevisionResponseValidationError) ErrorName() string {
+	return "GetRevisionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRevisionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevisionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevisionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevisionResponseValidationError{}
+
+// Validate checks the field values on DiffRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffRevisionsRequestMultiError, or nil if none found.
+func (m *DiffRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetFromRevisionId()); err != nil {
+		err = DiffRevisionsRequestValidationError{
+			field:  "FromRevisionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetToRevisionId()); err != nil {
+		err = DiffRevisionsRequestValidationError{
+			field:  "ToRevisionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DiffRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DiffRevisionsRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DiffRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by DiffRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DiffRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffRevisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// DiffRevisionsRequestValidationError is the validation error returned by
+// DiffRevisionsRequest.Validate if the designated constraints aren't met.
+type DiffRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffRevisionsRequestValidationError) ErrorName() string {
+	return "DiffRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffRevisionsRequestValidationError{}
+
+// Validate checks the field values on DiffRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffRevisionsResponseMultiError, or nil if none found.
+func (m *DiffRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromVersion
+
+	// no validation rules for ToVersion
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DiffRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffRevisionsResponseMultiError is an error wrapping multiple validation
+// errors returned by DiffRevisionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DiffRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffRevisionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// DiffRevisionsResponseValidationError is the validation error returned by
+// DiffRevisionsResponse.Validate if the designated constraints aren't met.
+type DiffRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffRevisionsResponseValidationError) ErrorName() string {
+	return "DiffRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffRevisionsResponseValidationError{}
+
+// Validate checks the field values on RestoreRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreRevisionRequestMultiError, or nil if none found.
+func (m *RestoreRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRevisionId()); err != nil {
+		err = RestoreRevisionRequestValidationError{
+			field:  "RevisionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreRevisionRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreRevisionRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreRevisionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreRevisionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreRevisionRequestMultiError) AllErrors() []error { return m }
+
+// RestoreRevisionRequestValidationError is the validation error returned by
+// RestoreRevisionRequest.Validate if the designated constraints aren't met.
+type RestoreRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreRevisionRequestValidationError) ErrorName() string {
+	return "RestoreRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreRevisionRequestValidationError{}
+
+// Validate checks the field values on RestoreRevisionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreRevisionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreRevisionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreRevisionResponseMultiError, or nil if none found.
+func (m *RestoreRevisionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreRevisionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProgram()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreRevisionResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreRevisionResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgram()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreRevisionResponseValidationError{
+				field:  "Program",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEpisode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreRevisionResponseValidationError{
+					field:  "Episode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreRevisionResponseValidationError{
+					field:  "Episode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEpisode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreRevisionResponseValidationError{
+				field:  "Episode",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreRevisionResponseValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreRevisionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreRevisionResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreRevisionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreRevisionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreRevisionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreRevisionResponseMultiError) AllErrors() []error { return m }
+
+// RestoreRevisionResponseValidationError is the validation error returned by
+// RestoreRevisionResponse.Validate if the designated constraints aren't met.
+type RestoreRevisionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreRevisionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreRevisionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreRevisionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreRevisionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreRevisionResponseValidationError) ErrorName() string {
+	return "RestoreRevisionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreRevisionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreRevisionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreRevisionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreRevisionResponseValidationError{}
+
+// Validate checks the field values on TrashItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrashItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrashItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrashItemMultiError, or nil
+// if none found.
+func (m *TrashItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrashItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentType
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrashItemValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrashItemValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrashItemValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TrashItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrashItemMultiError is an error wrapping multiple validation errors returned
+// by TrashItem.ValidateAll() if the designated constraints aren't met.
+type TrashItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrashItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrashItemMultiError) AllErrors() []error { return m }
+
+// TrashItemValidationError is the validation error returned by
+// TrashItem.Validate if the designated constraints aren't met.
+type TrashItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrashItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrashItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrashItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrashItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrashItemValidationError) ErrorName() string { return "TrashItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrashItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrashItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrashItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrashItemValidationError{}
+
+// Validate checks the field values on ListTrashRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTrashRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrashRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrashRequestMultiError, or nil if none found.
+func (m *ListTrashRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrashRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := ListTrashRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	if m.GetPageSize() > 100 {
+		err := ListTrashRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTrashRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrashRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTrashRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTrashRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrashRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrashRequestMultiError) AllErrors() []error { return m }
+
+// ListTrashRequestValidationError is the validation error returned by
+// ListTrashRequest.Validate if the designated constraints aren't met.
+type ListTrashRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrashRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrashRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrashRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrashRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrashRequestValidationError) ErrorName() string { return "ListTrashRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTrashRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrashRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrashRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrashRequestValidationError{}
+
+// Validate checks the field values on ListTrashResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTrashResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrashResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrashResponseMultiError, or nil if none found.
+func (m *ListTrashResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrashResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTrashResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTrashResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTrashResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListTrashResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrashResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTrashResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTrashResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrashResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrashResponseMultiError) AllErrors() []error { return m }
+
+// ListTrashResponseValidationError is the validation error returned by
+// ListTrashResponse.Validate if the designated constraints aren't met.
+type ListTrashResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrashResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrashResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrashResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrashResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrashResponseValidationError) ErrorName() string {
+	return "ListTrashResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrashResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrashResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrashResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrashResponseValidationError{}
+
+// Validate checks the field values on RestoreFromTrashRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreFromTrashRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreFromTrashRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreFromTrashRequestMultiError, or nil if none found.
+func (m *RestoreFromTrashRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreFromTrashRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _RestoreFromTrashRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
+		err := RestoreFromTrashRequestValidationError{
+			field:  "ContentType",
+			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := RestoreFromTrashRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = RestoreFromTrashRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreFromTrashRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreFromTrashRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreFromTrashRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreFromTrashRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreFromTrashRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreFromTrashRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreFromTrashRequestMultiError) AllErrors() []error { return m }
+
+// RestoreFromTrashRequestValidationError is the validation error returned by
+// RestoreFromTrashRequest.Validate if the designated constraints aren't met.
+type RestoreFromTrashRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreFromTrashRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreFromTrashRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreFromTrashRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreFromTrashRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreFromTrashRequestValidationError) ErrorName() string {
+	return "RestoreFromTrashRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreFromTrashRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreFromTrashRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreFromTrashRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreFromTrashRequestValidationError{}
+
+var _RestoreFromTrashRequest_ContentType_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on RestoreFromTrashResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreFromTrashResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreFromTrashResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreFromTrashResponseMultiError, or nil if none found.
+func (m *RestoreFromTrashResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreFromTrashResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreFromTrashResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreFromTrashResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreFromTrashResponseValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetProgram()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreFromTrashResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreFromTrashResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgram()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreFromTrashResponseValidationError{
+				field:  "Program",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEpisode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreFromTrashResponseValidationError{
+					field:  "Episode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreFromTrashResponseValidationError{
+					field:  "Episode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEpisode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreFromTrashResponseValidationError{
+				field:  "Episode",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreFromTrashResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreFromTrashResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreFromTrashResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreFromTrashResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreFromTrashResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreFromTrashResponseMultiError) AllErrors() []error { return m }
+
+// RestoreFromTrashResponseValidationError is the validation error returned by
+// RestoreFromTrashResponse.Validate if the designated constraints aren't met.
+type RestoreFromTrashResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreFromTrashResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreFromTrashResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreFromTrashResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreFromTrashResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreFromTrashResponseValidationError) ErrorName() string {
+	return "RestoreFromTrashResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreFromTrashResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreFromTrashResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreFromTrashResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreFromTrashResponseValidationError{}
+
+// Validate checks the field values on PurgeTrashRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTrashRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTrashRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTrashRequestMultiError, or nil if none found.
+func (m *PurgeTrashRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTrashRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := PurgeTrashRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.ContentId != nil {
+
+		if err := m._validateUuid(m.GetContentId()); err != nil {
+			err = PurgeTrashRequestValidationError{
+				field:  "ContentId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PurgeTrashRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *PurgeTrashRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// PurgeTrashRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgeTrashRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeTrashRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTrashRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTrashRequestMultiError) AllErrors() []error { return m }
+
+// PurgeTrashRequestValidationError is the validation error returned by
+// PurgeTrashRequest.Validate if the designated constraints aren't met.
+type PurgeTrashRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTrashRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTrashRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTrashRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTrashRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTrashRequestValidationError) ErrorName() string {
+	return "PurgeTrashRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeTrashRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTrashRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTrashRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTrashRequestValidationError{}
+
+// Validate checks the field values on PurgeTrashResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTrashResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTrashResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTrashResponseMultiError, or nil if none found.
+func (m *PurgeTrashResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTrashResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CategoriesPurged
+
+	// no validation rules for ProgramsPurged
+
+	// no validation rules for EpisodesPurged
+
+	if len(errors) > 0 {
+		return PurgeTrashResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeTrashResponseMultiError is an error wrapping multiple validation errors
+// returned by PurgeTrashResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeTrashResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTrashResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTrashResponseMultiError) AllErrors() []error { return m }
+
+// PurgeTrashResponseValidationError is the validation error returned by
+// PurgeTrashResponse.Validate if the designated constraints aren't met.
+type PurgeTrashResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTrashResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTrashResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTrashResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTrashResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTrashResponseValidationError) ErrorName() string {
+	return "PurgeTrashResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeTrashResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTrashResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTrashResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTrashResponseValidationError{}
+
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ActorId
+
+	// no validation rules for PrincipalType
+
+	// no validation rules for Operation
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IpAddress
+
+	// no validation rules for UserAgent
+
+	// no validation rules for RequestId
+
+	// no validation rules for Outcome
+
+	// no validation rules for ErrorReason
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventMultiError) AllErrors() []error { return m }
+
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventValidationError{}
+
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetActorId() != "" {
+
+		if err := m._validateUuid(m.GetActorId()); err != nil {
+			err = ListAuditEventsRequestValidationError{
+				field:  "ActorId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _ListAuditEventsRequest_PrincipalType_InLookup[m.GetPrincipalType()]; !ok {
+		err := ListAuditEventsRequestValidationError{
+			field:  "PrincipalType",
+			reason: "value must be in list [ anonymous user system]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Operation
+
+	if _, ok := _ListAuditEventsRequest_EntityType_InLookup[m.GetEntityType()]; !ok {
+		err := ListAuditEventsRequestValidationError{
+			field:  "EntityType",
+			reason: "value must be in list [ user category program episode season chapter transcript person credit import tag webhook webhook_delivery]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEntityId() != "" {
+
+		if err := m._validateUuid(m.GetEntityId()); err != nil {
+			err = ListAuditEventsRequestValidationError{
+				field:  "EntityId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _ListAuditEventsRequest_Outcome_InLookup[m.GetOutcome()]; !ok {
+		err := ListAuditEventsRequestValidationError{
+			field:  "Outcome",
+			reason: "value must be in list [ success failure]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RequestId
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Page
+
+	if m.GetPageSize() > 100 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListAuditEventsRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+var _ListAuditEventsRequest_PrincipalType_InLookup = map[string]struct{}{
+	"":          {},
+	"anonymous": {},
+	"user":      {},
+	"system":    {},
+}
+
+var _ListAuditEventsRequest_EntityType_InLookup = map[string]struct{}{
+	"":                 {},
+	"user":             {},
+	"category":         {},
+	"program":          {},
+	"episode":          {},
+	"season":           {},
+	"chapter":          {},
+	"transcript":       {},
+	"person":           {},
+	"credit":           {},
+	"import":           {},
+	"tag":              {},
+	"webhook":          {},
+	"webhook_delivery": {},
+}
+
+var _ListAuditEventsRequest_Outcome_InLookup = map[string]struct{}{
+	"":        {},
+	"success": {},
+	"failure": {},
+}
+
+// Validate checks the field values on ListAuditEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListAuditEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsResponseValidationError{}
+
+// Validate checks the field values on CategoryNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryNodeMultiError, or
+// nil if none found.
+func (m *CategoryNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryNodeValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryNodeValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryNodeValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoryNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoryNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CategoryNodeValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CategoryNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryNodeMultiError is an error wrapping multiple validation errors
+// returned by CategoryNode.ValidateAll() if the designated constraints aren't met.
+type CategoryNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryNodeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryNodeMultiError) AllErrors() []error { return m }
+
+// CategoryNodeValidationError is the validation error returned by
+// CategoryNode.Validate if the designated constraints aren't met.
+type CategoryNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryNodeValidationError) ErrorName() string { return "CategoryNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryNodeValidationError{}
+
+// Validate checks the field values on GetCategoryTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryTreeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryTreeRequestMultiError, or nil if none found.
+func (m *GetCategoryTreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryTreeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRootId() != "" {
+
+		if err := m._validateUuid(m.GetRootId()); err != nil {
+			err = GetCategoryTreeRequestValidationError{
+				field:  "RootId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCategoryTreeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetCategoryTreeRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetCategoryTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryTreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryTreeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryTreeRequestMultiError) AllErrors() []error { return m }
+
+// GetCategoryTreeRequestValidationError is the validation error returned by
+// GetCategoryTreeRequest.Validate if the designated constraints aren't met.
+type GetCategoryTreeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryTreeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryTreeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryTreeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryTreeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryTreeRequestValidationError) ErrorName() string {
+	return "GetCategoryTreeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryTreeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryTreeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryTreeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryTreeRequestValidationError{}
+
+// Validate checks the field values on GetCategoryTreeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryTreeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryTreeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryTreeResponseMultiError, or nil if none found.
+func (m *GetCategoryTreeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryTreeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCategoryTreeResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCategoryTreeResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCategoryTreeResponseValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCategoryTreeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryTreeResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryTreeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryTreeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryTreeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryTreeResponseMultiError) AllErrors() []error { return m }
+
+// GetCategoryTreeResponseValidationError is the validation error returned by
+// GetCategoryTreeResponse.Validate if the designated constraints aren't met.
+type GetCategoryTreeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryTreeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryTreeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryTreeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryTreeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryTreeResponseValidationError) ErrorName() string {
+	return "GetCategoryTreeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryTreeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryTreeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryTreeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryTreeResponseValidationError{}
+
+// Validate checks the field values on MoveCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveCategoryRequestMultiError, or nil if none found.
+func (m *MoveCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCategoryId()); err != nil {
+		err = MoveCategoryRequestValidationError{
+			field:  "CategoryId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetParentId() != "" {
+
+		if err := m._validateUuid(m.GetParentId()); err != nil {
+			err = MoveCategoryRequestValidationError{
+				field:  "ParentId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MoveCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MoveCategoryRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MoveCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by MoveCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MoveCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveCategoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveCategoryRequestMultiError) AllErrors() []error { return m }
+
+// MoveCategoryRequestValidationError is the validation error returned by
+// MoveCategoryRequest.Validate if the designated constraints aren't met.
+type MoveCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveCategoryRequestValidationError) ErrorName() string {
+	return "MoveCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveCategoryRequestValidationError{}
+
+// Validate checks the field values on MoveCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveCategoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveCategoryResponseMultiError, or nil if none found.
+func (m *MoveCategoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveCategoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MoveCategoryResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MoveCategoryResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MoveCategoryResponseValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MoveCategoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveCategoryResponseMultiError is an error wrapping multiple validation
+// errors returned by MoveCategoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MoveCategoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveCategoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveCategoryResponseMultiError) AllErrors() []error { return m }
+
+// MoveCategoryResponseValidationError is the validation error returned by
+// MoveCategoryResponse.Validate if the designated constraints aren't met.
+type MoveCategoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveCategoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveCategoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveCategoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveCategoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveCategoryResponseValidationError) ErrorName() string {
+	return "MoveCategoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveCategoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveCategoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveCategoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveCategoryResponseValidationError{}
+
+// Validate checks the field values on SetProgramCategoriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProgramCategoriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProgramCategoriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProgramCategoriesRequestMultiError, or nil if none found.
+func (m *SetProgramCategoriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProgramCategoriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProgramId()); err != nil {
+		err = SetProgramCategoriesRequestValidationError{
+			field:  "ProgramId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetPrimaryCategoryId()); err != nil {
+		err = SetProgramCategoriesRequestValidationError{
+			field:  "PrimaryCategoryId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCategoryIds()) > 20 {
+		err := SetProgramCategoriesRequestValidationError{
+			field:  "CategoryIds",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCategoryIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = SetProgramCategoriesRequestValidationError{
+				field:  fmt.Sprintf("CategoryIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetProgramCategoriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetProgramCategoriesRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetProgramCategoriesRequestMultiError is an error wrapping multiple
+// validation errors returned by SetProgramCategoriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetProgramCategoriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProgramCategoriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProgramCategoriesRequestMultiError) AllErrors() []error { return m }
+
+// SetProgramCategoriesRequestValidationError is the validation error returned
+// by SetProgramCategoriesRequest.Validate if the designated constraints
+// aren't met.
+type SetProgramCategoriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProgramCategoriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProgramCategoriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProgramCategoriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProgramCategoriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProgramCategoriesRequestValidationError) ErrorName() string {
+	return "SetProgramCategoriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProgramCategoriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProgramCategoriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProgramCategoriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProgramCategoriesRequestValidationError{}
+
+// Validate checks the field values on SetProgramCategoriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProgramCategoriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProgramCategoriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProgramCategoriesResponseMultiError, or nil if none found.
+func (m *SetProgramCategoriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProgramCategoriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProgram()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetProgramCategoriesResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetProgramCategoriesResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgram()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetProgramCategoriesResponseValidationError{
+				field:  "Program",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetProgramCategoriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProgramCategoriesResponseMultiError is an error wrapping multiple
+// validation errors returned by SetProgramCategoriesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetProgramCategoriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProgramCategoriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProgramCategoriesResponseMultiError) AllErrors() []error { return m }
+
+// SetProgramCategoriesResponseValidationError is the validation error returned
+// by SetProgramCategoriesResponse.Validate if the designated constraints
+// aren't met.
+type SetProgramCategoriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProgramCategoriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProgramCategoriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProgramCategoriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProgramCategoriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProgramCategoriesResponseValidationError) ErrorName() string {
+	return "SetProgramCategoriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProgramCategoriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProgramCategoriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProgramCategoriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProgramCategoriesResponseValidationError{}
+
+// Validate checks the field values on Tag with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagMultiError, or nil if none found.
+func (m *Tag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Slug
+
+	// no validation rules for Translations
+
+	// no validation rules for ProgramCount
+
+	// no validation rules for EpisodeCount
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagMultiError is an error wrapping multiple validation errors returned by
+// Tag.ValidateAll() if the designated constraints aren't met.
+type TagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagMultiError) AllErrors() []error { return m }
+
+// TagValidationError is the validation error returned by Tag.Validate if the
+// designated constraints aren't met.
+type TagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagValidationError{}
+
+// Validate checks the field values on ListTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTagsRequestMultiError, or nil if none found.
+func (m *ListTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if m.GetPageSize() > 100 {
+		err := ListTagsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SearchQuery
+
+	if _, ok := _ListTagsRequest_SortBy_InLookup[m.GetSortBy()]; !ok {
+		err := ListTagsRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be in list [ name usage]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListTagsRequest_SortOrder_InLookup[m.GetSortOrder()]; !ok {
+		err := ListTagsRequestValidationError{
+			field:  "SortOrder",
+			reason: "value must be in list [ asc desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsRequestMultiError) AllErrors() []error { return m }
+
+// ListTagsRequestValidationError is the validation error returned by
+// ListTagsRequest.Validate if the designated constraints aren't met.
+type ListTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsRequestValidationError) ErrorName() string { return "ListTagsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsRequestValidationError{}
+
+var _ListTagsRequest_SortBy_InLookup = map[string]struct{}{
+	"":      {},
+	"name":  {},
+	"usage": {},
+}
+
+var _ListTagsRequest_SortOrder_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+// Validate checks the field values on ListTagsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTagsResponseMultiError, or nil if none found.
+func (m *ListTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTagsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTagsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsResponseMultiError) AllErrors() []error { return m }
+
+// ListTagsResponseValidationError is the validation error returned by
+// ListTagsResponse.Validate if the designated constraints aren't met.
+type ListTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsResponseValidationError) ErrorName() string { return "ListTagsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsResponseValidationError{}
+
+// Validate checks the field values on AutocompleteTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AutocompleteTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AutocompleteTagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AutocompleteTagsRequestMultiError, or nil if none found.
+func (m *AutocompleteTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AutocompleteTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Prefix
+
+	if val := m.GetLimit(); val < 0 || val > 20 {
+		err := AutocompleteTagsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 20]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AutocompleteTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AutocompleteTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by AutocompleteTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AutocompleteTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AutocompleteTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AutocompleteTagsRequestMultiError) AllErrors() []error { return m }
+
+// AutocompleteTagsRequestValidationError is the validation error returned by
+// AutocompleteTagsRequest.Validate if the designated constraints aren't met.
+type AutocompleteTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutocompleteTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutocompleteTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutocompleteTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutocompleteTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutocompleteTagsRequestValidationError) ErrorName() string {
+	return "AutocompleteTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutocompleteTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutocompleteTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutocompleteTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutocompleteTagsRequestValidationError{}
+
+// Validate checks the field values on AutocompleteTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AutocompleteTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AutocompleteTagsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AutocompleteTagsResponseMultiError, or nil if none found.
+func (m *AutocompleteTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AutocompleteTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AutocompleteTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AutocompleteTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutocompleteTagsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AutocompleteTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AutocompleteTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by AutocompleteTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AutocompleteTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AutocompleteTagsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AutocompleteTagsResponseMultiError) AllErrors() []error { return m }
+
+// AutocompleteTagsResponseValidationError is the validation error returned by
+// AutocompleteTagsResponse.Validate if the designated constraints aren't met.
+type AutocompleteTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutocompleteTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutocompleteTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutocompleteTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutocompleteTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutocompleteTagsResponseValidationError) ErrorName() string {
+	return "AutocompleteTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutocompleteTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutocompleteTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutocompleteTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutocompleteTagsResponseValidationError{}
+
+// Validate checks the field values on UpdateTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagRequestMultiError, or nil if none found.
+func (m *UpdateTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTagId()); err != nil {
+		err = UpdateTagRequestValidationError{
+			field:  "TagId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Translations
+
+	if len(m.GetAliases()) > 50 {
+		err := UpdateTagRequestValidationError{
+			field:  "Aliases",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateTagRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateTagRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTagRequestValidationError is the validation error returned by
+// UpdateTagRequest.Validate if the designated constraints aren't met.
+type UpdateTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagRequestValidationError) ErrorName() string { return "UpdateTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagRequestValidationError{}
+
+// Validate checks the field values on UpdateTagResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagResponseMultiError, or nil if none found.
+func (m *UpdateTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTagResponseValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTagResponseValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTagResponseValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateTagResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTagResponseValidationError is the validation error returned by
+// UpdateTagResponse.Validate if the designated constraints aren't met.
+type UpdateTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagResponseValidationError) ErrorName() string {
+	return "UpdateTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagResponseValidationError{}
+
+// Validate checks the field values on RenameTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenameTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameTagRequestMultiError, or nil if none found.
+func (m *RenameTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTagId()); err != nil {
+		err = RenameTagRequestValidationError{
+			field:  "TagId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := RenameTagRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RenameTagRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RenameTagRequestMultiError is an error wrapping multiple validation errors
+// returned by RenameTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenameTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameTagRequestMultiError) AllErrors() []error { return m }
+
+// RenameTagRequestValidationError is the validation error returned by
+// RenameTagRequest.Validate if the designated constraints aren't met.
+type RenameTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameTagRequestValidationError) ErrorName() string { return "RenameTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RenameTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameTagRequestValidationError{}
+
+// Validate checks the field values on RenameTagResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenameTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameTagResponseMultiError, or nil if none found.
+func (m *RenameTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenameTagResponseValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenameTagResponseValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenameTagResponseValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenameTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameTagResponseMultiError is an error wrapping multiple validation errors
+// returned by RenameTagResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RenameTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameTagResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameTagResponseMultiError) AllErrors() []error { return m }
+
+// RenameTagResponseValidationError is the validation error returned by
+// RenameTagResponse.Validate if the designated constraints aren't met.
+type RenameTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameTagResponseValidationError) ErrorName() string {
+	return "RenameTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameTagResponseValidationError{}
+
+// Validate checks the field values on MergeTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeTagsRequestMultiError, or nil if none found.
+func (m *MergeTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTagId()); err != nil {
+		err = MergeTagsRequestValidationError{
+			field:  "TagId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetSourceIds()); l < 1 || l > 100 {
+		err := MergeTagsRequestValidationError{
+			field:  "SourceIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSourceIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = MergeTagsRequestValidationError{
+				field:  fmt.Sprintf("SourceIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MergeTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MergeTagsRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MergeTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by MergeTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MergeTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeTagsRequestMultiError) AllErrors() []error { return m }
+
+// MergeTagsRequestValidationError is the validation error returned by
+// MergeTagsRequest.Validate if the designated constraints aren't met.
+type MergeTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeTagsRequestValidationError) ErrorName() string { return "MergeTagsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MergeTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeTagsRequestValidationError{}
+
+// Validate checks the field values on MergeTagsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeTagsResponseMultiError, or nil if none found.
+func (m *MergeTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MergeTagsResponseValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MergeTagsResponseValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MergeTagsResponseValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MergeTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeTagsResponseMultiError is an error wrapping multiple validation errors
+// returned by MergeTagsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MergeTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeTagsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeTagsResponseMultiError) AllErrors() []error { return m }
+
+// MergeTagsResponseValidationError is the validation error returned by
+// MergeTagsResponse.Validate if the designated constraints aren't met.
+type MergeTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeTagsResponseValidationError) ErrorName() string {
+	return "MergeTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeTagsResponseValidationError{}
+
+// Validate checks the field values on Translation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Translation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Translation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TranslationMultiError, or
+// nil if none found.
+func (m *Translation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Translation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentType
+
+	// no validation rules for ContentId
+
+	// no validation rules for Locale
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TranslationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TranslationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TranslationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TranslationValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TranslationValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TranslationValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TranslationMultiError(errors)
+	}
+
+	return nil
+}
+
+// TranslationMultiError is an error wrapping multiple validation errors
+// returned by Translation.ValidateAll() if the designated constraints aren't met.
+type TranslationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TranslationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TranslationMultiError) AllErrors() []error { return m }
+
+// TranslationValidationError is the validation error returned by
+// Translation.Validate if the designated constraints aren't met.
+type TranslationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TranslationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TranslationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TranslationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TranslationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TranslationValidationError) ErrorName() string { return "TranslationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TranslationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTranslation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TranslationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TranslationValidationError{}
+
+// Validate checks the field values on ListTranslationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTranslationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTranslationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTranslationsRequestMultiError, or nil if none found.
+func (m *ListTranslationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTranslationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListTranslationsRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
+		err := ListTranslationsRequestValidationError{
+			field:  "ContentType",
+			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := ListTranslationsRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = ListTranslationsRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTranslationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListTranslationsRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListTranslationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTranslationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTranslationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTranslationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTranslationsRequestMultiError) AllErrors() []error { return m }
+
+// ListTranslationsRequestValidationError is the validation error returned by
+// ListTranslationsRequest.Validate if the designated constraints aren't met.
+type ListTranslationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTranslationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTranslationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTranslationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTranslationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTranslationsRequestValidationError) ErrorName() string {
+	return "ListTranslationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTranslationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTranslationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTranslationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTranslationsRequestValidationError{}
+
+var _ListTranslationsRequest_ContentType_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListTranslationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTranslationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTranslationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTranslationsResponseMultiError, or nil if none found.
+func (m *ListTranslationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTranslationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTranslations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTranslationsResponseValidationError{
+						field:  fmt.Sprintf("Translations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTranslationsResponseValidationError{
+						field:  fmt.Sprintf("Translations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTranslationsResponseValidationError{
+					field:  fmt.Sprintf("Translations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTranslationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTranslationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTranslationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTranslationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTranslationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTranslationsResponseMultiError) AllErrors() []error { return m }
+
+// ListTranslationsResponseValidationError is the validation error returned by
+// ListTranslationsResponse.Validate if the designated constraints aren't met.
+type ListTranslationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTranslationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTranslationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTranslationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTranslationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTranslationsResponseValidationError) ErrorName() string {
+	return "ListTranslationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTranslationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTranslationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTranslationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTranslationsResponseValidationError{}
+
+// Validate checks the field values on SetTranslationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetTranslationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTranslationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTranslationRequestMultiError, or nil if none found.
+func (m *SetTranslationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTranslationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := SetTranslationRequestValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SetTranslationRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+		err := SetTranslationRequestValidationError{
+			field:  "Locale",
+			reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetTranslationRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
+		err := SetTranslationRequestValidationError{
+			field:  "ContentType",
+			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := SetTranslationRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = SetTranslationRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) < 1 {
+		err := SetTranslationRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return SetTranslationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetTranslationRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetTranslationRequestMultiError is an error wrapping multiple validation
+// errors returned by SetTranslationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetTranslationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTranslationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTranslationRequestMultiError) AllErrors() []error { return m }
+
+// SetTranslationRequestValidationError is the validation error returned by
+// SetTranslationRequest.Validate if the designated constraints aren't met.
+type SetTranslationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTranslationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTranslationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTranslationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTranslationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTranslationRequestValidationError) ErrorName() string {
+	return "SetTranslationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTranslationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTranslationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTranslationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTranslationRequestValidationError{}
+
+var _SetTranslationRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
+var _SetTranslationRequest_ContentType_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on SetTranslationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetTranslationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTranslationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTranslationResponseMultiError, or nil if none found.
+func (m *SetTranslationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTranslationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTranslation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetTranslationResponseValidationError{
+					field:  "Translation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetTranslationResponseValidationError{
+					field:  "Translation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTranslation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetTranslationResponseValidationError{
+				field:  "Translation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetTranslationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTranslationResponseMultiError is an error wrapping multiple validation
+// errors returned by SetTranslationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetTranslationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTranslationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTranslationResponseMultiError) AllErrors() []error { return m }
+
+// SetTranslationResponseValidationError is the validation error returned by
+// SetTranslationResponse.Validate if the designated constraints aren't met.
+type SetTranslationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTranslationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTranslationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTranslationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTranslationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTranslationResponseValidationError) ErrorName() string {
+	return "SetTranslationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTranslationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTranslationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTranslationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTranslationResponseValidationError{}
+
+// Validate checks the field values on DeleteTranslationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTranslationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTranslationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTranslationRequestMultiError, or nil if none found.
+func (m *DeleteTranslationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTranslationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := DeleteTranslationRequestValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteTranslationRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+		err := DeleteTranslationRequestValidationError{
+			field:  "Locale",
+			reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _DeleteTranslationRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
+		err := DeleteTranslationRequestValidationError{
+			field:  "ContentType",
+			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := DeleteTranslationRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = DeleteTranslationRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTranslationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteTranslationRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteTranslationRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTranslationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTranslationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTranslationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTranslationRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTranslationRequestValidationError is the validation error returned by
+// DeleteTranslationRequest.Validate if the designated constraints aren't met.
+type DeleteTranslationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTranslationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTranslationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTranslationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTranslationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTranslationRequestValidationError) ErrorName() string {
+	return "DeleteTranslationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTranslationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTranslationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTranslationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTranslationRequestValidationError{}
+
+var _DeleteTranslationRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
+var _DeleteTranslationRequest_ContentType_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on Season with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Season) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Season with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SeasonMultiError, or nil if none found.
+func (m *Season) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Season) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProgramId
+
+	// no validation rules for SeasonNumber
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for ThumbnailUrl
+
+	if all {
+		switch v := interface{}(m.GetReleaseStartAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "ReleaseStartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "ReleaseStartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseStartAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeasonValidationError{
+				field:  "ReleaseStartAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReleaseEndAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "ReleaseEndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "ReleaseEndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseEndAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeasonValidationError{
+				field:  "ReleaseEndAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SortOrder
+
+	// no validation rules for EpisodesCount
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeasonValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeasonValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SeasonMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeasonMultiError is an error wrapping multiple validation errors returned by
+// Season.ValidateAll() if the designated constraints aren't met.
+type SeasonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeasonMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeasonMultiError) AllErrors() []error { return m }
+
+// SeasonValidationError is the validation error returned by Season.Validate if
+// the designated constraints aren't met.
+type SeasonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeasonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeasonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeasonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeasonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeasonValidationError) ErrorName() string { return "SeasonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeasonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeason.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeasonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeasonValidationError{}
+
+// Validate checks the field values on CreateSeasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSeasonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSeasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSeasonRequestMultiError, or nil if none found.
+func (m *CreateSeasonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSeasonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProgramId()); err != nil {
+		err = CreateSeasonRequestValidationError{
+			field:  "ProgramId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSeasonNumber() < 1 {
+		err := CreateSeasonRequestValidationError{
+			field:  "SeasonNumber",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for ThumbnailUrl
+
+	if all {
+		switch v := interface{}(m.GetReleaseStartAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSeasonRequestValidationError{
+					field:  "ReleaseStartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSeasonRequestValidationError{
+					field:  "ReleaseStartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseStartAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSeasonRequestValidationError{
+				field:  "ReleaseStartAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReleaseEndAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSeasonRequestValidationError{
+					field:  "ReleaseEndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSeasonRequestValidationError{
+					field:  "ReleaseEndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseEndAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSeasonRequestValidationError{
+				field:  "ReleaseEndAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SortOrder
+
+	if len(errors) > 0 {
+		return CreateSeasonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateSeasonRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateSeasonRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSeasonRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSeasonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSeasonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSeasonRequestMultiError) AllErrors() []error { return m }
+
+// CreateSeasonRequestValidationError is the validation error returned by
+// CreateSeasonRequest.Validate if the designated constraints aren't met.
+type CreateSeasonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSeasonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSeasonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSeasonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSeasonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSeasonRequestValidationError) ErrorName() string {
+	return "CreateSeasonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSeasonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSeasonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSeasonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSeasonRequestValidationError{}
+
+// Validate checks the field values on CreateSeasonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSeasonResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSeasonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSeasonResponseMultiError, or nil if none found.
+func (m *CreateSeasonResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSeasonResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSeason()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSeasonResponseValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSeasonResponseValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeason()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSeasonResponseValidationError{
+				field:  "Season",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSeasonResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSeasonResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSeasonResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSeasonResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSeasonResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSeasonResponseMultiError) AllErrors() []error { return m }
+
+// CreateSeasonResponseValidationError is the validation error returned by
+// CreateSeasonResponse.Validate if the designated constraints aren't met.
+type CreateSeasonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSeasonResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSeasonResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSeasonResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSeasonResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSeasonResponseValidationError) ErrorName() string {
+	return "CreateSeasonResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSeasonResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSeasonResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSeasonResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSeasonResponseValidationError{}
+
+// Validate checks the field values on GetSeasonRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSeasonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSeasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSeasonRequestMultiError, or nil if none found.
+func (m *GetSeasonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSeasonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSeasonId()); err != nil {
+		err = GetSeasonRequestValidationError{
+			field:  "SeasonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSeasonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetSeasonRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetSeasonRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSeasonRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSeasonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSeasonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSeasonRequestMultiError) AllErrors() []error { return m }
+
+// GetSeasonRequestValidationError is the validation error returned by
+// GetSeasonRequest.Validate if the designated constraints aren't met.
+type GetSeasonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSeasonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSeasonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSeasonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSeasonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSeasonRequestValidationError) ErrorName() string { return "GetSeasonRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSeasonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSeasonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSeasonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSeasonRequestValidationError{}
+
+// Validate checks the field values on GetSeasonResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSeasonResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSeasonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSeasonResponseMultiError, or nil if none found.
+func (m *GetSeasonResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSeasonResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSeason()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSeasonResponseValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSeasonResponseValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeason()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSeasonResponseValidationError{
+				field:  "Season",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSeasonResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSeasonResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSeasonResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetSeasonResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSeasonResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSeasonResponseMultiError) AllErrors() []error { return m }
+
+// GetSeasonResponseValidationError is the validation error returned by
+// GetSeasonResponse.Validate if the designated constraints aren't met.
+type GetSeasonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSeasonResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSeasonResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSeasonResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSeasonResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSeasonResponseValidationError) ErrorName() string {
+	return "GetSeasonResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSeasonResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSeasonResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSeasonResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSeasonResponseValidationError{}
+
+// Validate checks the field values on ListSeasonsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSeasonsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSeasonsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSeasonsRequestMultiError, or nil if none found.
+func (m *ListSeasonsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSeasonsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProgramId()); err != nil {
+		err = ListSeasonsRequestValidationError{
+			field:  "ProgramId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListSeasonsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListSeasonsRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListSeasonsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSeasonsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListSeasonsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSeasonsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSeasonsRequestMultiError) AllErrors() []error { return m }
+
+// ListSeasonsRequestValidationError is the validation error returned by
+// ListSeasonsRequest.Validate if the designated constraints aren't met.
+type ListSeasonsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSeasonsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSeasonsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSeasonsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSeasonsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSeasonsRequestValidationError) ErrorName() string {
+	return "ListSeasonsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSeasonsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSeasonsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSeasonsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSeasonsRequestValidationError{}
+
+// Validate checks the field values on ListSeasonsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSeasonsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSeasonsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSeasonsResponseMultiError, or nil if none found.
+func (m *ListSeasonsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSeasonsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSeasons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSeasonsResponseValidationError{
+						field:  fmt.Sprintf("Seasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSeasonsResponseValidationError{
+						field:  fmt.Sprintf("Seasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSeasonsResponseValidationError{
+					field:  fmt.Sprintf("Seasons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSeasonsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSeasonsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSeasonsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSeasonsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSeasonsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSeasonsResponseMultiError) AllErrors() []error { return m }
+
+// ListSeasonsResponseValidationError is the validation error returned by
+// ListSeasonsResponse.Validate if the designated constraints aren't met.
+type ListSeasonsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSeasonsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSeasonsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSeasonsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSeasonsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSeasonsResponseValidationError) ErrorName() string {
+	return "ListSeasonsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSeasonsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSeasonsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSeasonsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSeasonsResponseValidationError{}
+
+// Validate checks the field values on UpdateSeasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSeasonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSeasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSeasonRequestMultiError, or nil if none found.
+func (m *UpdateSeasonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSeasonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSeasonId()); err != nil {
+		err = UpdateSeasonRequestValidationError{
+			field:  "SeasonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.SeasonNumber != nil {
+
+		if m.GetSeasonNumber() < 1 {
+			err := UpdateSeasonRequestValidationError{
+				field:  "SeasonNumber",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Title != nil {
+		// no validation rules for Title
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.ThumbnailUrl != nil {
+		// no validation rules for ThumbnailUrl
+	}
+
+	if m.ReleaseStartAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetReleaseStartAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateSeasonRequestValidationError{
+						field:  "ReleaseStartAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateSeasonRequestValidationError{
+						field:  "ReleaseStartAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReleaseStartAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateSeasonRequestValidationError{
+					field:  "ReleaseStartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ReleaseEndAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetReleaseEndAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateSeasonRequestValidationError{
+						field:  "ReleaseEndAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateSeasonRequestValidationError{
+						field:  "ReleaseEndAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReleaseEndAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateSeasonRequestValidationError{
+					field:  "ReleaseEndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.SortOrder != nil {
+		// no validation rules for SortOrder
+	}
+
+	if len(errors) > 0 {
+		return UpdateSeasonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateSeasonRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateSeasonRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSeasonRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSeasonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSeasonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSeasonRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSeasonRequestValidationError is the validation error returned by
+// UpdateSeasonRequest.Validate if the designated constraints aren't met.
+type UpdateSeasonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSeasonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSeasonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSeasonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSeasonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSeasonRequestValidationError) ErrorName() string {
+	return "UpdateSeasonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSeasonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSeasonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSeasonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSeasonRequestValidationError{}
+
+// Validate checks the field values on UpdateSeasonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSeasonResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSeasonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSeasonResponseMultiError, or nil if none found.
+func (m *UpdateSeasonResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSeasonResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSeason()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSeasonResponseValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSeasonResponseValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeason()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSeasonResponseValidationError{
+				field:  "Season",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSeasonResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSeasonResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateSeasonResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSeasonResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSeasonResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSeasonResponseMultiError) AllErrors() []error { return m }
+
+// UpdateSeasonResponseValidationError is the validation error returned by
+// UpdateSeasonResponse.Validate if the designated constraints aren't met.
+type UpdateSeasonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSeasonResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSeasonResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSeasonResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSeasonResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSeasonResponseValidationError) ErrorName() string {
+	return "UpdateSeasonResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSeasonResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSeasonResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSeasonResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSeasonResponseValidationError{}
+
+// Validate checks the field values on DeleteSeasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSeasonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSeasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSeasonRequestMultiError, or nil if none found.
+func (m *DeleteSeasonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSeasonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSeasonId()); err != nil {
+		err = DeleteSeasonRequestValidationError{
+			field:  "SeasonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSeasonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteSeasonRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteSeasonRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSeasonRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSeasonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSeasonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSeasonRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSeasonRequestValidationError is the validation error returned by
+// DeleteSeasonRequest.Validate if the designated constraints aren't met.
+type DeleteSeasonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSeasonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSeasonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSeasonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSeasonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSeasonRequestValidationError) ErrorName() string {
+	return "DeleteSeasonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSeasonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSeasonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSeasonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSeasonRequestValidationError{}
+
+// Validate checks the field values on ReorderEpisodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderEpisodesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderEpisodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderEpisodesRequestMultiError, or nil if none found.
+func (m *ReorderEpisodesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderEpisodesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSeasonId()); err != nil {
+		err = ReorderEpisodesRequestValidationError{
+			field:  "SeasonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetEpisodeIds()); l < 1 || l > 1000 {
+		err := ReorderEpisodesRequestValidationError{
+			field:  "EpisodeIds",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFirstNumber() < 0 {
+		err := ReorderEpisodesRequestValidationError{
+			field:  "FirstNumber",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReorderEpisodesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReorderEpisodesRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReorderEpisodesRequestMultiError is an error wrapping multiple validation
+// errors returned by ReorderEpisodesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReorderEpisodesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderEpisodesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderEpisodesRequestMultiError) AllErrors() []error { return m }
+
+// ReorderEpisodesRequestValidationError is the validation error returned by
+// ReorderEpisodesRequest.Validate if the designated constraints aren't met.
+type ReorderEpisodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderEpisodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderEpisodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderEpisodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderEpisodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderEpisodesRequestValidationError) ErrorName() string {
+	return "ReorderEpisodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderEpisodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderEpisodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderEpisodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderEpisodesRequestValidationError{}
+
+// Validate checks the field values on ReorderEpisodesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderEpisodesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderEpisodesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderEpisodesResponseMultiError, or nil if none found.
+func (m *ReorderEpisodesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderEpisodesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEpisodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReorderEpisodesResponseValidationError{
+						field:  fmt.Sprintf("Episodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReorderEpisodesResponseValidationError{
+						field:  fmt.Sprintf("Episodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReorderEpisodesResponseValidationError{
+					field:  fmt.Sprintf("Episodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReorderEpisodesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderEpisodesResponseMultiError is an error wrapping multiple validation
+// errors returned by ReorderEpisodesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReorderEpisodesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderEpisodesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderEpisodesResponseMultiError) AllErrors() []error { return m }
+
+// ReorderEpisodesResponseValidationError is the validation error returned by
+// ReorderEpisodesResponse.Validate if the designated constraints aren't met.
+type ReorderEpisodesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderEpisodesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderEpisodesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderEpisodesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderEpisodesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderEpisodesResponseValidationError) ErrorName() string {
+	return "ReorderEpisodesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderEpisodesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderEpisodesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderEpisodesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderEpisodesResponseValidationError{}
+
+// Validate checks the field values on ListSeasonEpisodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSeasonEpisodesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSeasonEpisodesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSeasonEpisodesRequestMultiError, or nil if none found.
+func (m *ListSeasonEpisodesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSeasonEpisodesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSeasonId()); err != nil {
+		err = ListSeasonEpisodesRequestValidationError{
+			field:  "SeasonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	if m.GetPageSize() > 100 {
+		err := ListSeasonEpisodesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortOrder
+
+	if len(errors) > 0 {
+		return ListSeasonEpisodesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListSeasonEpisodesRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListSeasonEpisodesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSeasonEpisodesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListSeasonEpisodesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSeasonEpisodesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSeasonEpisodesRequestMultiError) AllErrors() []error { return m }
+
+// ListSeasonEpisodesRequestValidationError is the validation error returned by
+// ListSeasonEpisodesRequest.Validate if the designated constraints aren't met.
+type ListSeasonEpisodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSeasonEpisodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSeasonEpisodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSeasonEpisodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSeasonEpisodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSeasonEpisodesRequestValidationError) ErrorName() string {
+	return "ListSeasonEpisodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSeasonEpisodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSeasonEpisodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSeasonEpisodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSeasonEpisodesRequestValidationError{}
+
+// Validate checks the field values on ListSeasonEpisodesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSeasonEpisodesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSeasonEpisodesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSeasonEpisodesResponseMultiError, or nil if none found.
+func (m *ListSeasonEpisodesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSeasonEpisodesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEpisodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSeasonEpisodesResponseValidationError{
+						field:  fmt.Sprintf("Episodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSeasonEpisodesResponseValidationError{
+						field:  fmt.Sprintf("Episodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSeasonEpisodesResponseValidationError{
+					field:  fmt.Sprintf("Episodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListSeasonEpisodesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSeasonEpisodesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListSeasonEpisodesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListSeasonEpisodesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSeasonEpisodesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSeasonEpisodesResponseMultiError) AllErrors() []error { return m }
+
+// ListSeasonEpisodesResponseValidationError is the validation error returned
+// by ListSeasonEpisodesResponse.Validate if the designated constraints aren't met.
+type ListSeasonEpisodesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSeasonEpisodesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSeasonEpisodesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSeasonEpisodesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSeasonEpisodesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSeasonEpisodesResponseValidationError) ErrorName() string {
+	return "ListSeasonEpisodesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSeasonEpisodesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSeasonEpisodesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSeasonEpisodesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSeasonEpisodesResponseValidationError{}
+
+// Validate checks the field values on Chapter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Chapter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Chapter with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ChapterMultiError, or nil if none found.
+func (m *Chapter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Chapter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EpisodeId
+
+	// no validation rules for StartSeconds
+
+	// no validation rules for Title
+
+	// no validation rules for ImageUrl
+
+	// no validation rules for Url
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChapterValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChapterValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChapterValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChapterValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChapterValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChapterValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.EndSeconds != nil {
+		// no validation rules for EndSeconds
+	}
+
+	if len(errors) > 0 {
+		return ChapterMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChapterMultiError is an error wrapping multiple validation errors returned
+// by Chapter.ValidateAll() if the designated constraints aren't met.
+type ChapterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChapterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChapterMultiError) AllErrors() []error { return m }
+
+// ChapterValidationError is the validation error returned by Chapter.Validate
+// if the designated constraints aren't met.
+type ChapterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChapterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChapterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChapterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChapterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChapterValidationError) ErrorName() string { return "ChapterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChapterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChapter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChapterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChapterValidationError{}
+
+// Validate checks the field values on ChapterInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChapterInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChapterInput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChapterInputMultiError, or
+// nil if none found.
+func (m *ChapterInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChapterInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStartSeconds() < 0 {
+		err := ChapterInputValidationError{
+			field:  "StartSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 500 {
+		err := ChapterInputValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ImageUrl
+
+	// no validation rules for Url
+
+	if m.EndSeconds != nil {
+
+		if m.GetEndSeconds() <= 0 {
+			err := ChapterInputValidationError{
+				field:  "EndSeconds",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ChapterInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChapterInputMultiError is an error wrapping multiple validation errors
+// returned by ChapterInput.ValidateAll() if the designated constraints aren't met.
+type ChapterInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChapterInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChapterInputMultiError) AllErrors() []error { return m }
+
+// ChapterInputValidationError is the validation error returned by
+// ChapterInput.Validate if the designated constraints aren't met.
+type ChapterInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChapterInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChapterInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChapterInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChapterInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChapterInputValidationError) ErrorName() string { return "ChapterInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChapterInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChapterInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChapterInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChapterInputValidationError{}
+
+// Validate checks the field values on ListChaptersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChaptersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChaptersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChaptersRequestMultiError, or nil if none found.
+func (m *ListChaptersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChaptersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEpisodeId()); err != nil {
+		err = ListChaptersRequestValidationError{
+			field:  "EpisodeId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListChaptersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListChaptersRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListChaptersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListChaptersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListChaptersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChaptersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChaptersRequestMultiError) AllErrors() []error { return m }
+
+// ListChaptersRequestValidationError is the validation error returned by
+// ListChaptersRequest.Validate if the designated constraints aren't met.
+type ListChaptersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChaptersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChaptersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChaptersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChaptersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChaptersRequestValidationError) ErrorName() string {
+	return "ListChaptersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChaptersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChaptersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChaptersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChaptersRequestValidationError{}
+
+// Validate checks the field values on ListChaptersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChaptersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChaptersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChaptersResponseMultiError, or nil if none found.
+func (m *ListChaptersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChaptersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChapters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListChaptersResponseValidationError{
+						field:  fmt.Sprintf("Chapters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListChaptersResponseValidationError{
+						field:  fmt.Sprintf("Chapters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListChaptersResponseValidationError{
+					field:  fmt.Sprintf("Chapters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListChaptersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChaptersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListChaptersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListChaptersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChaptersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChaptersResponseMultiError) AllErrors() []error { return m }
+
+// ListChaptersResponseValidationError is the validation error returned by
+// ListChaptersResponse.Validate if the designated constraints aren't met.
+type ListChaptersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChaptersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChaptersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChaptersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChaptersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChaptersResponseValidationError) ErrorName() string {
+	return "ListChaptersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChaptersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChaptersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChaptersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChaptersResponseValidationError{}
+
+// Validate checks the field values on CreateChapterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateChapterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateChapterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateChapterRequestMultiError, or nil if none found.
+func (m *CreateChapterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateChapterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEpisodeId()); err != nil {
+		err = CreateChapterRequestValidationError{
+			field:  "EpisodeId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChapter() == nil {
+		err := CreateChapterRequestValidationError{
+			field:  "Chapter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetChapter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateChapterRequestValidationError{
+					field:  "Chapter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateChapterRequestValidationError{
+					field:  "Chapter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChapter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateChapterRequestValidationError{
+				field:  "Chapter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateChapterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateChapterRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateChapterRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateChapterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateChapterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateChapterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateChapterRequestMultiError) AllErrors() []error { return m }
+
+// CreateChapterRequestValidationError is the validation error returned by
+// CreateChapterRequest.Validate if the designated constraints aren't met.
+type CreateChapterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateChapterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateChapterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateChapterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateChapterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateChapterRequestValidationError) ErrorName() string {
+	return "CreateChapterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateChapterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateChapterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateChapterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateChapterRequestValidationError{}
+
+// Validate checks the field values on CreateChapterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateChapterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateChapterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateChapterResponseMultiError, or nil if none found.
+func (m *CreateChapterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateChapterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChapter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateChapterResponseValidationError{
+					field:  "Chapter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateChapterResponseValidationError{
+					field:  "Chapter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChapter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateChapterResponseValidationError{
+				field:  "Chapter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateChapterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateChapterResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateChapterResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateChapterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateChapterResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateChapterResponseMultiError) AllErrors() []error { return m }
+
+// CreateChapterResponseValidationError is the validation error returned by
+// CreateChapterResponse.Validate if the designated constraints aren't met.
+type CreateChapterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateChapterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateChapterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateChapterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateChapterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateChapterResponseValidationError) ErrorName() string {
+	return "CreateChapterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateChapterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateChapterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateChapterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateChapterResponseValidationError{}
+
+// Validate checks the field values on UpdateChapterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateChapterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateChapterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateChapterRequestMultiError, or nil if none found.
+func (m *UpdateChapterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateChapterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetChapterId()); err != nil {
+		err = UpdateChapterRequestValidationError{
+			field:  "ChapterId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.StartSeconds != nil {
+
+		if m.GetStartSeconds() < 0 {
+			err := UpdateChapterRequestValidationError{
+				field:  "StartSeconds",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.EndSeconds != nil {
+
+		if m.GetEndSeconds() < 0 {
+			err := UpdateChapterRequestValidationError{
+				field:  "EndSeconds",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Title != nil {
+
+		if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 500 {
+			err := UpdateChapterRequestValidationError{
+				field:  "Title",
+				reason: "value length must be between 1 and 500 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ImageUrl != nil {
+		// no validation rules for ImageUrl
+	}
+
+	if m.Url != nil {
+		// no validation rules for Url
+	}
+
+	if len(errors) > 0 {
+		return UpdateChapterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateChapterRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateChapterRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateChapterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateChapterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateChapterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateChapterRequestMultiError) AllErrors() []error { return m }
+
+// UpdateChapterRequestValidationError is the validation error returned by
+// UpdateChapterRequest.Validate if the designated constraints aren't met.
+type UpdateChapterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateChapterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateChapterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateChapterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateChapterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateChapterRequestValidationError) ErrorName() string {
+	return "UpdateChapterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateChapterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateChapterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateChapterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateChapterRequestValidationError{}
+
+// Validate checks the field values on UpdateChapterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateChapterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateChapterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateChapterResponseMultiError, or nil if none found.
+func (m *UpdateChapterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateChapterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChapter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateChapterResponseValidationError{
+					field:  "Chapter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateChapterResponseValidationError{
+					field:  "Chapter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChapter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateChapterResponseValidationError{
+				field:  "Chapter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateChapterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateChapterResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateChapterResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateChapterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateChapterResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateChapterResponseMultiError) AllErrors() []error { return m }
+
+// UpdateChapterResponseValidationError is the validation error returned by
+// UpdateChapterResponse.Validate if the designated constraints aren't met.
+type UpdateChapterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateChapterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateChapterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateChapterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateChapterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateChapterResponseValidationError) ErrorName() string {
+	return "UpdateChapterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateChapterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateChapterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateChapterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateChapterResponseValidationError{}
+
+// Validate checks the field values on DeleteChapterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteChapterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteChapterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteChapterRequestMultiError, or nil if none found.
+func (m *DeleteChapterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteChapterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetChapterId()); err != nil {
+		err = DeleteChapterRequestValidationError{
+			field:  "ChapterId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteChapterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteChapterRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteChapterRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteChapterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteChapterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteChapterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteChapterRequestMultiError) AllErrors() []error { return m }
+
+// DeleteChapterRequestValidationError is the validation error returned by
+// DeleteChapterRequest.Validate if the designated constraints aren't met.
+type DeleteChapterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteChapterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteChapterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteChapterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteChapterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteChapterRequestValidationError) ErrorName() string {
+	return "DeleteChapterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteChapterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteChapterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteChapterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteChapterRequestValidationError{}
+
+// Validate checks the field values on ReplaceChaptersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaceChaptersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaceChaptersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplaceChaptersRequestMultiError, or nil if none found.
+func (m *ReplaceChaptersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaceChaptersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEpisodeId()); err != nil {
+		err = ReplaceChaptersRequestValidationError{
+			field:  "EpisodeId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetChapters()) > 500 {
+		err := ReplaceChaptersRequestValidationError{
+			field:  "Chapters",
+			reason: "value must contain no more than 500 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetChapters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplaceChaptersRequestValidationError{
+						field:  fmt.Sprintf("Chapters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplaceChaptersRequestValidationError{
+						field:  fmt.Sprintf("Chapters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplaceChaptersRequestValidationError{
+					field:  fmt.Sprintf("Chapters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReplaceChaptersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReplaceChaptersRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReplaceChaptersRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplaceChaptersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplaceChaptersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaceChaptersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaceChaptersRequestMultiError) AllErrors() []error { return m }
+
+// ReplaceChaptersRequestValidationError is the validation error returned by
+// ReplaceChaptersRequest.Validate if the designated constraints aren't met.
+type ReplaceChaptersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaceChaptersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaceChaptersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaceChaptersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaceChaptersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaceChaptersRequestValidationError) ErrorName() string {
+	return "ReplaceChaptersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaceChaptersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaceChaptersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplaceChaptersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaceChaptersRequestValidationError{}
+
+// Validate checks the field values on ReplaceChaptersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaceChaptersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaceChaptersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplaceChaptersResponseMultiError, or nil if none found.
+func (m *ReplaceChaptersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaceChaptersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChapters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplaceChaptersResponseValidationError{
+						field:  fmt.Sprintf("Chapters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplaceChaptersResponseValidationError{
+						field:  fmt.Sprintf("Chapters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplaceChaptersResponseValidationError{
+					field:  fmt.Sprintf("Chapters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReplaceChaptersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplaceChaptersResponseMultiError is an error wrapping multiple validation
+// errors returned by ReplaceChaptersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReplaceChaptersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaceChaptersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaceChaptersResponseMultiError) AllErrors() []error { return m }
+
+// ReplaceChaptersResponseValidationError is the validation error returned by
+// ReplaceChaptersResponse.Validate if the designated constraints aren't met.
+type ReplaceChaptersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaceChaptersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaceChaptersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaceChaptersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaceChaptersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaceChaptersResponseValidationError) ErrorName() string {
+	return "ReplaceChaptersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaceChaptersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaceChaptersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplaceChaptersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaceChaptersResponseValidationError{}
+
+// Validate checks the field values on ImportChaptersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportChaptersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportChaptersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportChaptersRequestMultiError, or nil if none found.
+func (m *ImportChaptersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportChaptersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEpisodeId()); err != nil {
+		err = ImportChaptersRequestValidationError{
+			field:  "EpisodeId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetChaptersJson()); l < 1 || l > 1048576 {
+		err := ImportChaptersRequestValidationError{
+			field:  "ChaptersJson",
+			reason: "value length must be between 1 and 1048576 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportChaptersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ImportChaptersRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ImportChaptersRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportChaptersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportChaptersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportChaptersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportChaptersRequestMultiError) AllErrors() []error { return m }
+
+// ImportChaptersRequestValidationError is the validation error returned by
+// ImportChaptersRequest.Validate if the designated constraints aren't met.
+type ImportChaptersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportChaptersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportChaptersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportChaptersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportChaptersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportChaptersRequestValidationError) ErrorName() string {
+	return "ImportChaptersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportChaptersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportChaptersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportChaptersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportChaptersRequestValidationError{}
+
+// Validate checks the field values on ImportChaptersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportChaptersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportChaptersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportChaptersResponseMultiError, or nil if none found.
+func (m *ImportChaptersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportChaptersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChapters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportChaptersResponseValidationError{
+						field:  fmt.Sprintf("Chapters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportChaptersResponseValidationError{
+						field:  fmt.Sprintf("Chapters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportChaptersResponseValidationError{
+					field:  fmt.Sprintf("Chapters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportChaptersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportChaptersResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportChaptersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportChaptersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportChaptersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportChaptersResponseMultiError) AllErrors() []error { return m }
+
+// ImportChaptersResponseValidationError is the validation error returned by
+// ImportChaptersResponse.Validate if the designated constraints aren't met.
+type ImportChaptersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportChaptersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportChaptersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportChaptersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportChaptersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportChaptersResponseValidationError) ErrorName() string {
+	return "ImportChaptersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportChaptersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportChaptersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportChaptersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportChaptersResponseValidationError{}
+
+// Validate checks the field values on Transcript with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Transcript) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Transcript with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TranscriptMultiError, or
+// nil if none found.
+func (m *Transcript) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Transcript) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EpisodeId
+
+	// no validation rules for Locale
+
+	// no validation rules for Format
+
+	// no validation rules for FileUrl
+
+	// no validation rules for CuesCount
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TranscriptValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TranscriptValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TranscriptValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TranscriptValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TranscriptValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TranscriptValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TranscriptMultiError(errors)
+	}
+
+	return nil
+}
+
+// TranscriptMultiError is an error wrapping multiple validation errors
+// returned by Transcript.ValidateAll() if the designated constraints aren't met.
+type TranscriptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TranscriptMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TranscriptMultiError) AllErrors() []error { return m }
+
+// TranscriptValidationError is the validation error returned by
+// Transcript.Validate if the designated constraints aren't met.
+type TranscriptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TranscriptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TranscriptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TranscriptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TranscriptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TranscriptValidationError) ErrorName() string { return "TranscriptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TranscriptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTranscript.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TranscriptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TranscriptValidationError{}
+
+// Validate checks the field values on UploadTranscriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadTranscriptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadTranscriptRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadTranscriptRequestMultiError, or nil if none found.
+func (m *UploadTranscriptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadTranscriptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEpisodeId()); err != nil {
+		err = UploadTranscriptRequestValidationError{
+			field:  "EpisodeId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := UploadTranscriptRequestValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UploadTranscriptRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+		err := UploadTranscriptRequestValidationError{
+			field:  "Locale",
+			reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TranscriptFormat_name[int32(m.GetFormat())]; !ok {
+		err := UploadTranscriptRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 5242880 {
+		err := UploadTranscriptRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 5242880 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadTranscriptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UploadTranscriptRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UploadTranscriptRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadTranscriptRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadTranscriptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadTranscriptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadTranscriptRequestMultiError) AllErrors() []error { return m }
+
+// UploadTranscriptRequestValidationError is the validation error returned by
+// UploadTranscriptRequest.Validate if the designated constraints aren't met.
+type UploadTranscriptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadTranscriptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadTranscriptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadTranscriptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadTranscriptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadTranscriptRequestValidationError) ErrorName() string {
+	return "UploadTranscriptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadTranscriptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadTranscriptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadTranscriptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadTranscriptRequestValidationError{}
+
+var _UploadTranscriptRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on UploadTranscriptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadTranscriptResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadTranscriptResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadTranscriptResponseMultiError, or nil if none found.
+func (m *UploadTranscriptResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadTranscriptResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTranscript()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadTranscriptResponseValidationError{
+					field:  "Transcript",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadTranscriptResponseValidationError{
+					field:  "Transcript",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTranscript()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadTranscriptResponseValidationError{
+				field:  "Transcript",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadTranscriptResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadTranscriptResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadTranscriptResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadTranscriptResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadTranscriptResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadTranscriptResponseMultiError) AllErrors() []error { return m }
+
+// UploadTranscriptResponseValidationError is the validation error returned by
+// UploadTranscriptResponse.Validate if the designated constraints aren't met.
+type UploadTranscriptResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadTranscriptResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadTranscriptResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadTranscriptResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadTranscriptResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadTranscriptResponseValidationError) ErrorName() string {
+	return "UploadTranscriptResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadTranscriptResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadTranscriptResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadTranscriptResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadTranscriptResponseValidationError{}
+
+// Validate checks the field values on ListTranscriptsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTranscriptsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTranscriptsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTranscriptsRequestMultiError, or nil if none found.
+func (m *ListTranscriptsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTranscriptsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEpisodeId()); err != nil {
+		err = ListTranscriptsRequestValidationError{
+			field:  "EpisodeId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTranscriptsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListTranscriptsRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListTranscriptsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTranscriptsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTranscriptsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTranscriptsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTranscriptsRequestMultiError) AllErrors() []error { return m }
+
+// ListTranscriptsRequestValidationError is the validation error returned by
+// ListTranscriptsRequest.Validate if the designated constraints aren't met.
+type ListTranscriptsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTranscriptsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTranscriptsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTranscriptsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTranscriptsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTranscriptsRequestValidationError) ErrorName() string {
+	return "ListTranscriptsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTranscriptsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTranscriptsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTranscriptsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTranscriptsRequestValidationError{}
+
+// Validate checks the field values on ListTranscriptsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTranscriptsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTranscriptsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTranscriptsResponseMultiError, or nil if none found.
+func (m *ListTranscriptsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTranscriptsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTranscripts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTranscriptsResponseValidationError{
+						field:  fmt.Sprintf("Transcripts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTranscriptsResponseValidationError{
+						field:  fmt.Sprintf("Transcripts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTranscriptsResponseValidationError{
+					field:  fmt.Sprintf("Transcripts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTranscriptsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTranscriptsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTranscriptsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTranscriptsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTranscriptsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTranscriptsResponseMultiError) AllErrors() []error { return m }
+
+// ListTranscriptsResponseValidationError is the validation error returned by
+// ListTranscriptsResponse.Validate if the designated constraints aren't met.
+type ListTranscriptsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTranscriptsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTranscriptsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTranscriptsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTranscriptsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTranscriptsResponseValidationError) ErrorName() string {
+	return "ListTranscriptsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTranscriptsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTranscriptsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTranscriptsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTranscriptsResponseValidationError{}
+
+// Validate checks the field values on DeleteTranscriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTranscriptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTranscriptRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTranscriptRequestMultiError, or nil if none found.
+func (m *DeleteTranscriptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTranscriptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEpisodeId()); err != nil {
+		err = DeleteTranscriptRequestValidationError{
+			field:  "EpisodeId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := DeleteTranscriptRequestValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteTranscriptRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+		err := DeleteTranscriptRequestValidationError{
+			field:  "Locale",
+			reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTranscriptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteTranscriptRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteTranscriptRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTranscriptRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTranscriptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTranscriptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTranscriptRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTranscriptRequestValidationError is the validation error returned by
+// DeleteTranscriptRequest.Validate if the designated constraints aren't met.
+type DeleteTranscriptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTranscriptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTranscriptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTranscriptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTranscriptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTranscriptRequestValidationError) ErrorName() string {
+	return "DeleteTranscriptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTranscriptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTranscriptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTranscriptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTranscriptRequestValidationError{}
+
+var _DeleteTranscriptRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on Person with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Person) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Person with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PersonMultiError, or nil if none found.
+func (m *Person) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Person) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Bio
+
+	// no validation rules for PhotoUrl
+
+	for idx, item := range m.GetLinks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PersonValidationError{
+						field:  fmt.Sprintf("Links[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PersonValidationError{
+						field:  fmt.Sprintf("Links[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PersonValidationError{
+					field:  fmt.Sprintf("Links[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PersonValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PersonValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PersonValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PersonValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PersonValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PersonValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PersonMultiError(errors)
+	}
+
+	return nil
+}
+
+// PersonMultiError is an error wrapping multiple validation errors returned by
+// Person.ValidateAll() if the designated constraints aren't met.
+type PersonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PersonMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PersonMultiError) AllErrors() []error { return m }
+
+// PersonValidationError is the validation error returned by Person.Validate if
+// the designated constraints aren't met.
+type PersonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PersonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PersonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PersonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PersonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PersonValidationError) ErrorName() string { return "PersonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PersonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPerson.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PersonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PersonValidationError{}
+
+// Validate checks the field values on PersonLink with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PersonLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PersonLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PersonLinkMultiError, or
+// nil if none found.
+func (m *PersonLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PersonLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTitle()) > 200 {
+		err := PersonLinkValidationError{
+			field:  "Title",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUrl()); l < 1 || l > 2048 {
+		err := PersonLinkValidationError{
+			field:  "Url",
+			reason: "value length must be between 1 and 2048 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PersonLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// PersonLinkMultiError is an error wrapping multiple validation errors
+// returned by PersonLink.ValidateAll() if the designated constraints aren't met.
+type PersonLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PersonLinkMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PersonLinkMultiError) AllErrors() []error { return m }
+
+// PersonLinkValidationError is the validation error returned by
+// PersonLink.Validate if the designated constraints aren't met.
+type PersonLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PersonLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PersonLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PersonLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PersonLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PersonLinkValidationError) ErrorName() string { return "PersonLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PersonLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPersonLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PersonLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PersonLinkValidationError{}
+
+// Validate checks the field values on PersonLinks with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PersonLinks) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PersonLinks with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PersonLinksMultiError, or
+// nil if none found.
+func (m *PersonLinks) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PersonLinks) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetLinks()) > 20 {
+		err := PersonLinksValidationError{
+			field:  "Links",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetLinks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PersonLinksValidationError{
+						field:  fmt.Sprintf("Links[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PersonLinksValidationError{
+						field:  fmt.Sprintf("Links[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PersonLinksValidationError{
+					field:  fmt.Sprintf("Links[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PersonLinksMultiError(errors)
+	}
+
+	return nil
+}
+
+// PersonLinksMultiError is an error wrapping multiple validation errors
+// returned by PersonLinks.ValidateAll() if the designated constraints aren't met.
+type PersonLinksMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PersonLinksMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PersonLinksMultiError) AllErrors() []error { return m }
+
+// PersonLinksValidationError is the validation error returned by
+// PersonLinks.Validate if the designated constraints aren't met.
+type PersonLinksValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PersonLinksValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PersonLinksValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PersonLinksValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PersonLinksValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PersonLinksValidationError) ErrorName() string { return "PersonLinksValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PersonLinksValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPersonLinks.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PersonLinksValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PersonLinksValidationError{}
+
+// Validate checks the field values on Credit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Credit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Credit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CreditMultiError, or nil if none found.
+func (m *Credit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Credit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PersonId
+
+	// no validation rules for ContentType
+
+	// no validation rules for ContentId
+
+	// no validation rules for Role
+
+	// no validation rules for SortOrder
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPerson()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerson()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditValidationError{
+				field:  "Person",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreditMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreditMultiError is an error wrapping multiple validation errors returned by
+// Credit.ValidateAll() if the designated constraints aren't met.
+type CreditMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreditMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreditMultiError) AllErrors() []error { return m }
+
+// CreditValidationError is the validation error returned by Credit.Validate if
+// the designated constraints aren't met.
+type CreditValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreditValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreditValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreditValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreditValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreditValidationError) ErrorName() string { return "CreditValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreditValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCredit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreditValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreditValidationError{}
+
+// Validate checks the field values on CreatePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePersonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePersonRequestMultiError, or nil if none found.
+func (m *CreatePersonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePersonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 200 {
+		err := CreatePersonRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBio()) > 10000 {
+		err := CreatePersonRequestValidationError{
+			field:  "Bio",
+			reason: "value length must be at most 10000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PhotoUrl
+
+	if len(m.GetLinks()) > 20 {
+		err := CreatePersonRequestValidationError{
+			field:  "Links",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetLinks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreatePersonRequestValidationError{
+						field:  fmt.Sprintf("Links[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreatePersonRequestValidationError{
+						field:  fmt.Sprintf("Links[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreatePersonRequestValidationError{
+					field:  fmt.Sprintf("Links[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreatePersonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePersonRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePersonRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePersonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePersonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePersonRequestMultiError) AllErrors() []error { return m }
+
+// CreatePersonRequestValidationError is the validation error returned by
+// CreatePersonRequest.Validate if the designated constraints aren't met.
+type CreatePersonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePersonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePersonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePersonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePersonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePersonRequestValidationError) ErrorName() string {
+	return "CreatePersonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePersonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePersonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePersonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePersonRequestValidationError{}
+
+// Validate checks the field values on CreatePersonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePersonResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePersonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePersonResponseMultiError, or nil if none found.
+func (m *CreatePersonResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePersonResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPerson()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePersonResponseValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePersonResponseValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerson()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePersonResponseValidationError{
+				field:  "Person",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePersonResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePersonResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePersonResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePersonResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePersonResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePersonResponseMultiError) AllErrors() []error { return m }
+
+// CreatePersonResponseValidationError is the validation error returned by
+// CreatePersonResponse.Validate if the designated constraints aren't met.
+type CreatePersonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePersonResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePersonResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePersonResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePersonResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePersonResponseValidationError) ErrorName() string {
+	return "CreatePersonResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePersonResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePersonResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePersonResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePersonResponseValidationError{}
+
+// Validate checks the field values on GetPersonRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPersonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPersonRequestMultiError, or nil if none found.
+func (m *GetPersonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPersonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPersonId()); err != nil {
+		err = GetPersonRequestValidationError{
+			field:  "PersonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPersonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPersonRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPersonRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPersonRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPersonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPersonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPersonRequestMultiError) AllErrors() []error { return m }
+
+// GetPersonRequestValidationError is the validation error returned by
+// GetPersonRequest.Validate if the designated constraints aren't met.
+type GetPersonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPersonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPersonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPersonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPersonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPersonRequestValidationError) ErrorName() string { return "GetPersonRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetPersonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPersonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPersonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPersonRequestValidationError{}
+
+// Validate checks the field values on GetPersonResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPersonResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPersonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPersonResponseMultiError, or nil if none found.
+func (m *GetPersonResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPersonResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPerson()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPersonResponseValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPersonResponseValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerson()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPersonResponseValidationError{
+				field:  "Person",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPersonResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPersonResponseMultiError is an error wrapping multiple validation errors
+// returned by GetPersonResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetPersonResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPersonResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPersonResponseMultiError) AllErrors() []error { return m }
+
+// GetPersonResponseValidationError is the validation error returned by
+// GetPersonResponse.Validate if the designated constraints aren't met.
+type GetPersonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPersonResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPersonResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPersonResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPersonResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPersonResponseValidationError) ErrorName() string {
+	return "GetPersonResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPersonResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPersonResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPersonResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPersonResponseValidationError{}
+
+// Validate checks the field values on ListPeopleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPeopleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPeopleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPeopleRequestMultiError, or nil if none found.
+func (m *ListPeopleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPeopleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if m.GetPageSize() > 100 {
+		err := ListPeopleRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SearchQuery
+
+	if len(errors) > 0 {
+		return ListPeopleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPeopleRequestMultiError is an error wrapping multiple validation errors
+// returned by ListPeopleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListPeopleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPeopleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPeopleRequestMultiError) AllErrors() []error { return m }
+
+// ListPeopleRequestValidationError is the validation error returned by
+// ListPeopleRequest.Validate if the designated constraints aren't met.
+type ListPeopleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPeopleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPeopleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPeopleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPeopleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPeopleRequestValidationError) ErrorName() string {
+	return "ListPeopleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPeopleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPeopleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPeopleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPeopleRequestValidationError{}
+
+// Validate checks the field values on ListPeopleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPeopleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPeopleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPeopleResponseMultiError, or nil if none found.
+func (m *ListPeopleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPeopleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPeople() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPeopleResponseValidationError{
+						field:  fmt.Sprintf("People[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPeopleResponseValidationError{
+						field:  fmt.Sprintf("People[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPeopleResponseValidationError{
+					field:  fmt.Sprintf("People[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListPeopleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPeopleResponseMultiError is an error wrapping multiple validation errors
+// returned by ListPeopleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListPeopleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPeopleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPeopleResponseMultiError) AllErrors() []error { return m }
+
+// ListPeopleResponseValidationError is the validation error returned by
+// ListPeopleResponse.Validate if the designated constraints aren't met.
+type ListPeopleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPeopleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPeopleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPeopleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPeopleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPeopleResponseValidationError) ErrorName() string {
+	return "ListPeopleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPeopleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPeopleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPeopleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPeopleResponseValidationError{}
+
+// Validate checks the field values on UpdatePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePersonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePersonRequestMultiError, or nil if none found.
+func (m *UpdatePersonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePersonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPersonId()); err != nil {
+		err = UpdatePersonRequestValidationError{
+			field:  "PersonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLinks()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePersonRequestValidationError{
+					field:  "Links",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePersonRequestValidationError{
+					field:  "Links",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLinks()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePersonRequestValidationError{
+				field:  "Links",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Name != nil {
+
+		if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 200 {
+			err := UpdatePersonRequestValidationError{
+				field:  "Name",
+				reason: "value length must be between 1 and 200 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Bio != nil {
+
+		if utf8.RuneCountInString(m.GetBio()) > 10000 {
+			err := UpdatePersonRequestValidationError{
+				field:  "Bio",
+				reason: "value length must be at most 10000 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PhotoUrl != nil {
+		// no validation rules for PhotoUrl
+	}
+
+	if len(errors) > 0 {
+		return UpdatePersonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdatePersonRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdatePersonRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePersonRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePersonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePersonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePersonRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePersonRequestValidationError is the validation error returned by
+// UpdatePersonRequest.Validate if the designated constraints aren't met.
+type UpdatePersonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePersonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePersonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePersonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePersonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePersonRequestValidationError) ErrorName() string {
+	return "UpdatePersonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePersonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePersonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePersonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePersonRequestValidationError{}
+
+// Validate checks the field values on UpdatePersonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePersonResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePersonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePersonResponseMultiError, or nil if none found.
+func (m *UpdatePersonResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePersonResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPerson()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePersonResponseValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePersonResponseValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerson()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePersonResponseValidationError{
+				field:  "Person",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePersonResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePersonResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePersonResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePersonResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePersonResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePersonResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePersonResponseValidationError is the validation error returned by
+// UpdatePersonResponse.Validate if the designated constraints aren't met.
+type UpdatePersonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePersonResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePersonResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePersonResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePersonResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePersonResponseValidationError) ErrorName() string {
+	return "UpdatePersonResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePersonResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePersonResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePersonResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePersonResponseValidationError{}
+
+// Validate checks the field values on DeletePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePersonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePersonRequestMultiError, or nil if none found.
+func (m *DeletePersonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePersonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPersonId()); err != nil {
+		err = DeletePersonRequestValidationError{
+			field:  "PersonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeletePersonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeletePersonRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeletePersonRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePersonRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePersonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePersonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePersonRequestMultiError) AllErrors() []error { return m }
+
+// DeletePersonRequestValidationError is the validation error returned by
+// DeletePersonRequest.Validate if the designated constraints aren't met.
+type DeletePersonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePersonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePersonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePersonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePersonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePersonRequestValidationError) ErrorName() string {
+	return "DeletePersonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePersonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePersonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePersonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePersonRequestValidationError{}
+
+// Validate checks the field values on CreateCreditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCreditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCreditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCreditRequestMultiError, or nil if none found.
+func (m *CreateCreditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCreditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPersonId()); err != nil {
+		err = CreateCreditRequestValidationError{
+			field:  "PersonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateCreditRequest_ContentType_InLookup[m.GetContentType()]; !ok {
+		err := CreateCreditRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be in list [CONTENT_TYPE_PROGRAM CONTENT_TYPE_EPISODE]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = CreateCreditRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateCreditRequest_Role_NotInLookup[m.GetRole()]; ok {
+		err := CreateCreditRequestValidationError{
+			field:  "Role",
+			reason: "value must not be in list [CREDIT_ROLE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := CreditRole_name[int32(m.GetRole())]; !ok {
+		err := CreateCreditRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SortOrder
+
+	if len(errors) > 0 {
+		return CreateCreditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateCreditRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateCreditRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCreditRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCreditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCreditRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCreditRequestMultiError) AllErrors() []error { return m }
+
+// CreateCreditRequestValidationError is the validation error returned by
+// CreateCreditRequest.Validate if the designated constraints aren't met.
+type CreateCreditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCreditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCreditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCreditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCreditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCreditRequestValidationError) ErrorName() string {
+	return "CreateCreditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCreditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCreditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCreditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCreditRequestValidationError{}
+
+var _CreateCreditRequest_ContentType_InLookup = map[ContentType]struct{}{
+	1: {},
+	2: {},
+}
+
+var _CreateCreditRequest_Role_NotInLookup = map[CreditRole]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateCreditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCreditResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCreditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCreditResponseMultiError, or nil if none found.
+func (m *CreateCreditResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCreditResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCredit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCreditResponseValidationError{
+					field:  "Credit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCreditResponseValidationError{
+					field:  "Credit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCredit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCreditResponseValidationError{
+				field:  "Credit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCreditResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCreditResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateCreditResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCreditResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCreditResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCreditResponseMultiError) AllErrors() []error { return m }
+
+// CreateCreditResponseValidationError is the validation error returned by
+// CreateCreditResponse.Validate if the designated constraints aren't met.
+type CreateCreditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCreditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCreditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCreditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCreditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCreditResponseValidationError) ErrorName() string {
+	return "CreateCreditResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCreditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCreditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCreditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCreditResponseValidationError{}
+
+// Validate checks the field values on ListCreditsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCreditsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCreditsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCreditsRequestMultiError, or nil if none found.
+func (m *ListCreditsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCreditsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListCreditsRequest_ContentType_InLookup[m.GetContentType()]; !ok {
+		err := ListCreditsRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be in list [CONTENT_TYPE_PROGRAM CONTENT_TYPE_EPISODE]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = ListCreditsRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCreditsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListCreditsRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListCreditsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListCreditsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListCreditsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCreditsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCreditsRequestMultiError) AllErrors() []error { return m }
+
+// ListCreditsRequestValidationError is the validation error returned by
+// ListCreditsRequest.Validate if the designated constraints aren't met.
+type ListCreditsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCreditsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCreditsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCreditsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCreditsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCreditsRequestValidationError) ErrorName() string {
+	return "ListCreditsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCreditsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCreditsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCreditsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCreditsRequestValidationError{}
+
+var _ListCreditsRequest_ContentType_InLookup = map[ContentType]struct{}{
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on ListCreditsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCreditsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCreditsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCreditsResponseMultiError, or nil if none found.
+func (m *ListCreditsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCreditsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCreditsResponseValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCreditsResponseValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCreditsResponseValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCreditsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCreditsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCreditsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCreditsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCreditsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCreditsResponseMultiError) AllErrors() []error { return m }
+
+// ListCreditsResponseValidationError is the validation error returned by
+// ListCreditsResponse.Validate if the designated constraints aren't met.
+type ListCreditsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCreditsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCreditsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCreditsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCreditsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCreditsResponseValidationError) ErrorName() string {
+	return "ListCreditsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCreditsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCreditsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCreditsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCreditsResponseValidationError{}
+
+// Validate checks the field values on UpdateCreditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCreditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCreditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCreditRequestMultiError, or nil if none found.
+func (m *UpdateCreditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCreditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCreditId()); err != nil {
+		err = UpdateCreditRequestValidationError{
+			field:  "CreditId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Role != nil {
+
+		if _, ok := _UpdateCreditRequest_Role_NotInLookup[m.GetRole()]; ok {
+			err := UpdateCreditRequestValidationError{
+				field:  "Role",
+				reason: "value must not be in list [CREDIT_ROLE_UNSPECIFIED]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := CreditRole_name[int32(m.GetRole())]; !ok {
+			err := UpdateCreditRequestValidationError{
+				field:  "Role",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.SortOrder != nil {
+		// no validation rules for SortOrder
+	}
+
+	if len(errors) > 0 {
+		return UpdateCreditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateCreditRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateCreditRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCreditRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCreditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCreditRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCreditRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCreditRequestValidationError is the validation error returned by
+// UpdateCreditRequest.Validate if the designated constraints aren't met.
+type UpdateCreditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCreditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCreditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCreditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCreditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCreditRequestValidationError) ErrorName() string {
+	return "UpdateCreditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCreditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCreditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCreditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCreditRequestValidationError{}
+
+var _UpdateCreditRequest_Role_NotInLookup = map[CreditRole]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on UpdateCreditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCreditResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCreditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCreditResponseMultiError, or nil if none found.
+func (m *UpdateCreditResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCreditResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCredit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCreditResponseValidationError{
+					field:  "Credit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCreditResponseValidationError{
+					field:  "Credit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCredit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCreditResponseValidationError{
+				field:  "Credit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCreditResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCreditResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateCreditResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCreditResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCreditResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCreditResponseMultiError) AllErrors() []error { return m }
+
+// UpdateCreditResponseValidationError is the validation error returned by
+// UpdateCreditResponse.Validate if the designated constraints aren't met.
+type UpdateCreditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCreditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCreditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCreditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCreditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCreditResponseValidationError) ErrorName() string {
+	return "UpdateCreditResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCreditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCreditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCreditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCreditResponseValidationError{}
+
+// Validate checks the field values on DeleteCreditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCreditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCreditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCreditRequestMultiError, or nil if none found.
+func (m *DeleteCreditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCreditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCreditId()); err != nil {
+		err = DeleteCreditRequestValidationError{
+			field:  "CreditId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCreditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteCreditRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteCreditRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCreditRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCreditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCreditRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCreditRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCreditRequestValidationError is the validation error returned by
+// DeleteCreditRequest.Validate if the designated constraints aren't met.
+type DeleteCreditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCreditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCreditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCreditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCreditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCreditRequestValidationError) ErrorName() string {
+	return "DeleteCreditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCreditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCreditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCreditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCreditRequestValidationError{}
+
+// Validate checks the field values on ListCreditsByPersonRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCreditsByPersonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCreditsByPersonRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCreditsByPersonRequestMultiError, or nil if none found.
+func (m *ListCreditsByPersonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCreditsByPersonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPersonId()); err != nil {
+		err = ListCreditsByPersonRequestValidationError{
+			field:  "PersonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	if m.GetPageSize() > 100 {
+		err := ListCreditsByPersonRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCreditsByPersonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListCreditsByPersonRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListCreditsByPersonRequestMultiError is an error wrapping multiple
+// validation errors returned by ListCreditsByPersonRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListCreditsByPersonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCreditsByPersonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCreditsByPersonRequestMultiError) AllErrors() []error { return m }
+
+// ListCreditsByPersonRequestValidationError is the validation error returned
+// by ListCreditsByPersonRequest.Validate if the designated constraints aren't met.
+type ListCreditsByPersonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCreditsByPersonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCreditsByPersonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCreditsByPersonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCreditsByPersonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCreditsByPersonRequestValidationError) ErrorName() string {
+	return "ListCreditsByPersonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCreditsByPersonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCreditsByPersonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCreditsByPersonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCreditsByPersonRequestValidationError{}
+
+// Validate checks the field values on ListCreditsByPersonResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCreditsByPersonResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCreditsByPersonResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCreditsByPersonResponseMultiError, or nil if none found.
+func (m *ListCreditsByPersonResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCreditsByPersonResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCreditsByPersonResponseValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCreditsByPersonResponseValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCreditsByPersonResponseValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListCreditsByPersonResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCreditsByPersonResponseMultiError is an error wrapping multiple
+// validation errors returned by ListCreditsByPersonResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListCreditsByPersonResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCreditsByPersonResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCreditsByPersonResponseMultiError) AllErrors() []error { return m }
+
+// ListCreditsByPersonResponseValidationError is the validation error returned
+// by ListCreditsByPersonResponse.Validate if the designated constraints
+// aren't met.
+type ListCreditsByPersonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCreditsByPersonResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCreditsByPersonResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCreditsByPersonResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCreditsByPersonResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCreditsByPersonResponseValidationError) ErrorName() string {
+	return "ListCreditsByPersonResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCreditsByPersonResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCreditsByPersonResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCreditsByPersonResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCreditsByPersonResponseValidationError{}
+
+// Validate checks the field values on CheckAvailabilityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckAvailabilityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckAvailabilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckAvailabilityRequestMultiError, or nil if none found.
+func (m *CheckAvailabilityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckAvailabilityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _CheckAvailabilityRequest_ContentType_InLookup[m.GetContentType()]; !ok {
+		err := CheckAvailabilityRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be in list [CONTENT_TYPE_PROGRAM CONTENT_TYPE_EPISODE]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = CheckAvailabilityRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCountry() != "" {
+
+		if !_CheckAvailabilityRequest_Country_Pattern.MatchString(m.GetCountry()) {
+			err := CheckAvailabilityRequestValidationError{
+				field:  "Country",
+				reason: "value does not match regex pattern \"^[A-Za-z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckAvailabilityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CheckAvailabilityRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CheckAvailabilityRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckAvailabilityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckAvailabilityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckAvailabilityRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckAvailabilityRequestMultiError) AllErrors() []error { return m }
+
+// CheckAvailabilityRequestValidationError is the validation error returned by
+// CheckAvailabilityRequest.Validate if the designated constraints aren't met.
+type CheckAvailabilityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckAvailabilityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckAvailabilityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckAvailabilityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckAvailabilityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckAvailabilityRequestValidationError) ErrorName() string {
+	return "CheckAvailabilityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckAvailabilityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckAvailabilityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckAvailabilityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckAvailabilityRequestValidationError{}
+
+var _CheckAvailabilityRequest_ContentType_InLookup = map[ContentType]struct{}{
+	1: {},
+	2: {},
+}
+
+var _CheckAvailabilityRequest_Country_Pattern = regexp.MustCompile("^[A-Za-z]{2}$")
+
+// Validate checks the field values on CheckAvailabilityResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckAvailabilityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckAvailabilityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckAvailabilityResponseMultiError, or nil if none found.
+func (m *CheckAvailabilityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckAvailabilityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Available
+
+	// no validation rules for ContentType
+
+	// no validation rules for ContentId
+
+	// no validation rules for Country
+
+	if all {
+		switch v := interface{}(m.GetCheckedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckAvailabilityResponseValidationError{
+					field:  "CheckedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckAvailabilityResponseValidationError{
+					field:  "CheckedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheckedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckAvailabilityResponseValidationError{
+				field:  "CheckedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckAvailabilityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckAvailabilityResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckAvailabilityResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CheckAvailabilityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckAvailabilityResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckAvailabilityResponseMultiError) AllErrors() []error { return m }
+
+// CheckAvailabilityResponseValidationError is the validation error returned by
+// CheckAvailabilityResponse.Validate if the designated constraints aren't met.
+type CheckAvailabilityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckAvailabilityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckAvailabilityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckAvailabilityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckAvailabilityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckAvailabilityResponseValidationError) ErrorName() string {
+	return "CheckAvailabilityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckAvailabilityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckAvailabilityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckAvailabilityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckAvailabilityResponseValidationError{}
+
+// Validate checks the field values on DeleteEpisodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteEpisodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteEpisodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteEpisodeRequestMultiError, or nil if none found.
+func (m *DeleteEpisodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteEpisodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEpisodeId()) < 1 {
+		err := DeleteEpisodeRequestValidationError{
+			field:  "EpisodeId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteEpisodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteEpisodeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteEpisodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteEpisodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteEpisodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteEpisodeRequestMultiError) AllErrors() []error { return m }
+
+// DeleteEpisodeRequestValidationError is the validation error returned by
+// DeleteEpisodeRequest.Validate if the designated constraints aren't met.
+type DeleteEpisodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteEpisodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteEpisodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteEpisodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteEpisodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteEpisodeRequestValidationError) ErrorName() string {
+	return "DeleteEpisodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteEpisodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteEpisodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteEpisodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteEpisodeRequestValidationError{}
+
+// Validate checks the field values on GetEpisodeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetEpisodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEpisodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEpisodeRequestMultiError, or nil if none found.
+func (m *GetEpisodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEpisodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEpisodeId()) < 1 {
+		err := GetEpisodeRequestValidationError{
+			field:  "EpisodeId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetEpisodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEpisodeRequestMultiError is an error wrapping multiple validation errors
+// returned by GetEpisodeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetEpisodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEpisodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEpisodeRequestMultiError) AllErrors() []error { return m }
+
+// GetEpisodeRequestValidationError is the validation error returned by
+// GetEpisodeRequest.Validate if the designated constraints aren't met.
+type GetEpisodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEpisodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEpisodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEpisodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEpisodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEpisodeRequestValidationError) ErrorName() string {
+	return "GetEpisodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEpisodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEpisodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEpisodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEpisodeRequestValidationError{}
+
+// Validate checks the field values on GetEpisodeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEpisodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEpisodeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEpisodeResponseMultiError, or nil if none found.
+func (m *GetEpisodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEpisodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEpisode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetEpisodeResponseValidationError{
+					field:  "Episode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetEpisodeResponseValidationError{
+					field:  "Episode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEpisode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetEpisodeResponseValidationError{
+				field:  "Episode",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetEpisodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEpisodeResponseMultiError is an error wrapping multiple validation errors
+// returned by GetEpisodeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetEpisodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEpisodeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEpisodeResponseMultiError) AllErrors() []error { return m }
+
+// GetEpisodeResponseValidationError is the validation error returned by
+// GetEpisodeResponse.Validate if the designated constraints aren't met.
+type GetEpisodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEpisodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEpisodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEpisodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEpisodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEpisodeResponseValidationError) ErrorName() string {
+	return "GetEpisodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEpisodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEpisodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEpisodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEpisodeResponseValidationError{}
+
+// Validate checks the field values on ListEpisodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEpisodesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEpisodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEpisodesRequestMultiError, or nil if none found.
+func (m *ListEpisodesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEpisodesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProgramId()) < 1 {
+		err := ListEpisodesRequestValidationError{
+			field:  "ProgramId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	if m.GetPageSize() > 100 {
+		err := ListEpisodesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for SearchQuery
+
+	// no validation rules for SeasonNumber
 
 	// no validation rules for SortBy
 
 	// no validation rules for SortOrder
 
+	if m.GetSeasonId() != "" {
+
+		if err := m._validateUuid(m.GetSeasonId()); err != nil {
+			err = ListEpisodesRequestValidationError{
+				field:  "SeasonId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ListEpisodesRequestMultiError(errors)
 	}
@@ -4055,6 +21575,14 @@ func (m *ListEpisodesRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *ListEpisodesRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // ListEpisodesRequestMultiError is an error wrapping multiple validation
 // errors returned by ListEpisodesRequest.ValidateAll() if the designated
 // constraints aren't met.
@@ -4072,9 +21600,290 @@ func (m ListEpisodesRequestMultiError) Error() string {
 // AllErrors returns a list of validation violation errors.
 func (m ListEpisodesRequestMultiError) AllErrors() []error { return m }
 
-// ListEpisodesRequestValidationError is the validation error returned by
-// ListEpisodesRequest.Validate if the designated constraints aren't met.
-type ListEpisodesRequestValidationError struct {
+// ListEpisodesRequestValidationError is the validation error returned by
+// ListEpisodesRequest.Validate if the designated constraints aren't met.
+type ListEpisodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEpisodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEpisodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEpisodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEpisodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEpisodesRequestValidationError) ErrorName() string {
+	return "ListEpisodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEpisodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEpisodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEpisodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEpisodesRequestValidationError{}
+
+// Validate checks the field values on ListEpisodesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEpisodesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEpisodesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEpisodesResponseMultiError, or nil if none found.
+func (m *ListEpisodesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEpisodesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEpisodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEpisodesResponseValidationError{
+						field:  fmt.Sprintf("Episodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEpisodesResponseValidationError{
+						field:  fmt.Sprintf("Episodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEpisodesResponseValidationError{
+					field:  fmt.Sprintf("Episodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListEpisodesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEpisodesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListEpisodesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListEpisodesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEpisodesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEpisodesResponseMultiError) AllErrors() []error { return m }
+
+// ListEpisodesResponseValidationError is the validation error returned by
+// ListEpisodesResponse.Validate if the designated constraints aren't met.
+type ListEpisodesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEpisodesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEpisodesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEpisodesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEpisodesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEpisodesResponseValidationError) ErrorName() string {
+	return "ListEpisodesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEpisodesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEpisodesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEpisodesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEpisodesResponseValidationError{}
+
+// Validate checks the field values on BatchGetEpisodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetEpisodesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetEpisodesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetEpisodesRequestMultiError, or nil if none found.
+func (m *BatchGetEpisodesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetEpisodesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetEpisodeIds()); l < 1 || l > 100 {
+		err := BatchGetEpisodesRequestValidationError{
+			field:  "EpisodeIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLocale() != "" {
+
+		if utf8.RuneCountInString(m.GetLocale()) > 35 {
+			err := BatchGetEpisodesRequestValidationError{
+				field:  "Locale",
+				reason: "value length must be at most 35 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_BatchGetEpisodesRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+			err := BatchGetEpisodesRequestValidationError{
+				field:  "Locale",
+				reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetEpisodesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetEpisodesRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetEpisodesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetEpisodesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetEpisodesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetEpisodesRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetEpisodesRequestValidationError is the validation error returned by
+// BatchGetEpisodesRequest.Validate if the designated constraints aren't met.
+type BatchGetEpisodesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4082,24 +21891,24 @@ type ListEpisodesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEpisodesRequestValidationError) Field() string { return e.field }
+func (e BatchGetEpisodesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEpisodesRequestValidationError) Reason() string { return e.reason }
+func (e BatchGetEpisodesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEpisodesRequestValidationError) Cause() error { return e.cause }
+func (e BatchGetEpisodesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEpisodesRequestValidationError) Key() bool { return e.key }
+func (e BatchGetEpisodesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEpisodesRequestValidationError) ErrorName() string {
-	return "ListEpisodesRequestValidationError"
+func (e BatchGetEpisodesRequestValidationError) ErrorName() string {
+	return "BatchGetEpisodesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEpisodesRequestValidationError) Error() string {
+func (e BatchGetEpisodesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4111,14 +21920,14 @@ func (e ListEpisodesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEpisodesRequest.%s: %s%s",
+		"invalid %sBatchGetEpisodesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEpisodesRequestValidationError{}
+var _ error = BatchGetEpisodesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4126,24 +21935,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEpisodesRequestValidationError{}
+} = BatchGetEpisodesRequestValidationError{}
 
-// Validate checks the field values on ListEpisodesResponse with the rules
+var _BatchGetEpisodesRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on BatchGetEpisodesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListEpisodesResponse) Validate() error {
+func (m *BatchGetEpisodesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEpisodesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchGetEpisodesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEpisodesResponseMultiError, or nil if none found.
-func (m *ListEpisodesResponse) ValidateAll() error {
+// BatchGetEpisodesResponseMultiError, or nil if none found.
+func (m *BatchGetEpisodesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEpisodesResponse) validate(all bool) error {
+func (m *BatchGetEpisodesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4157,7 +21968,7 @@ func (m *ListEpisodesResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListEpisodesResponseValidationError{
+					errors = append(errors, BatchGetEpisodesResponseValidationError{
 						field:  fmt.Sprintf("Episodes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -4165,7 +21976,7 @@ func (m *ListEpisodesResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListEpisodesResponseValidationError{
+					errors = append(errors, BatchGetEpisodesResponseValidationError{
 						field:  fmt.Sprintf("Episodes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -4174,7 +21985,7 @@ func (m *ListEpisodesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListEpisodesResponseValidationError{
+				return BatchGetEpisodesResponseValidationError{
 					field:  fmt.Sprintf("Episodes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4184,26 +21995,20 @@ func (m *ListEpisodesResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for TotalCount
-
-	// no validation rules for Page
-
-	// no validation rules for PageSize
-
 	if len(errors) > 0 {
-		return ListEpisodesResponseMultiError(errors)
+		return BatchGetEpisodesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEpisodesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListEpisodesResponse.ValidateAll() if the designated
+// BatchGetEpisodesResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetEpisodesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListEpisodesResponseMultiError []error
+type BatchGetEpisodesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEpisodesResponseMultiError) Error() string {
+func (m BatchGetEpisodesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4212,11 +22017,11 @@ func (m ListEpisodesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEpisodesResponseMultiError) AllErrors() []error { return m }
+func (m BatchGetEpisodesResponseMultiError) AllErrors() []error { return m }
 
-// ListEpisodesResponseValidationError is the validation error returned by
-// ListEpisodesResponse.Validate if the designated constraints aren't met.
-type ListEpisodesResponseValidationError struct {
+// BatchGetEpisodesResponseValidationError is the validation error returned by
+// BatchGetEpisodesResponse.Validate if the designated constraints aren't met.
+type BatchGetEpisodesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4224,24 +22029,24 @@ type ListEpisodesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEpisodesResponseValidationError) Field() string { return e.field }
+func (e BatchGetEpisodesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEpisodesResponseValidationError) Reason() string { return e.reason }
+func (e BatchGetEpisodesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEpisodesResponseValidationError) Cause() error { return e.cause }
+func (e BatchGetEpisodesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEpisodesResponseValidationError) Key() bool { return e.key }
+func (e BatchGetEpisodesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEpisodesResponseValidationError) ErrorName() string {
-	return "ListEpisodesResponseValidationError"
+func (e BatchGetEpisodesResponseValidationError) ErrorName() string {
+	return "BatchGetEpisodesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEpisodesResponseValidationError) Error() string {
+func (e BatchGetEpisodesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4253,14 +22058,14 @@ func (e ListEpisodesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEpisodesResponse.%s: %s%s",
+		"invalid %sBatchGetEpisodesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEpisodesResponseValidationError{}
+var _ error = BatchGetEpisodesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4268,7 +22073,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEpisodesResponseValidationError{}
+} = BatchGetEpisodesResponseValidationError{}
 
 // Validate checks the field values on ImportDataRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -4509,6 +22314,272 @@ var _ interface {
 	ErrorName() string
 } = ImportDataResponseValidationError{}
 
+// Validate checks the field values on WatchImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchImportRequestMultiError, or nil if none found.
+func (m *WatchImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetImportId()); err != nil {
+		err = WatchImportRequestValidationError{
+			field:  "ImportId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *WatchImportRequest) _validateUuid(uuid string) error {
+	if matched := _cms_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WatchImportRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchImportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchImportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchImportRequestMultiError) AllErrors() []error { return m }
+
+// WatchImportRequestValidationError is the validation error returned by
+// WatchImportRequest.Validate if the designated constraints aren't met.
+type WatchImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchImportRequestValidationError) ErrorName() string {
+	return "WatchImportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchImportRequestValidationError{}
+
+// Validate checks the field values on ImportEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportEventMultiError, or
+// nil if none found.
+func (m *ImportEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for ImportId
+
+	// no validation rules for Status
+
+	// no validation rules for TotalItems
+
+	// no validation rules for ProcessedItems
+
+	// no validation rules for SuccessCount
+
+	// no validation rules for ErrorCount
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportEventValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportEventValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportEventValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportEventMultiError is an error wrapping multiple validation errors
+// returned by ImportEvent.ValidateAll() if the designated constraints aren't met.
+type ImportEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportEventMultiError) AllErrors() []error { return m }
+
+// ImportEventValidationError is the validation error returned by
+// ImportEvent.Validate if the designated constraints aren't met.
+type ImportEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportEventValidationError) ErrorName() string { return "ImportEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportEventValidationError{}
+
 // Validate checks the field values on BulkUpdateProgramsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -20,24 +20,80 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CmsService_CreateProgram_FullMethodName      = "/thmanyah.v1.CmsService/CreateProgram"
-	CmsService_UpdateProgram_FullMethodName      = "/thmanyah.v1.CmsService/UpdateProgram"
-	CmsService_DeleteProgram_FullMethodName      = "/thmanyah.v1.CmsService/DeleteProgram"
-	CmsService_GetProgram_FullMethodName         = "/thmanyah.v1.CmsService/GetProgram"
-	CmsService_ListPrograms_FullMethodName       = "/thmanyah.v1.CmsService/ListPrograms"
-	CmsService_CreateCategory_FullMethodName     = "/thmanyah.v1.CmsService/CreateCategory"
-	CmsService_UpdateCategory_FullMethodName     = "/thmanyah.v1.CmsService/UpdateCategory"
-	CmsService_DeleteCategory_FullMethodName     = "/thmanyah.v1.CmsService/DeleteCategory"
-	CmsService_GetCategory_FullMethodName        = "/thmanyah.v1.CmsService/GetCategory"
-	CmsService_ListCategories_FullMethodName     = "/thmanyah.v1.CmsService/ListCategories"
-	CmsService_CreateEpisode_FullMethodName      = "/thmanyah.v1.CmsService/CreateEpisode"
-	CmsService_UpdateEpisode_FullMethodName      = "/thmanyah.v1.CmsService/UpdateEpisode"
-	CmsService_DeleteEpisode_FullMethodName      = "/thmanyah.v1.CmsService/DeleteEpisode"
-	CmsService_GetEpisode_FullMethodName         = "/thmanyah.v1.CmsService/GetEpisode"
-	CmsService_ListEpisodes_FullMethodName       = "/thmanyah.v1.CmsService/ListEpisodes"
-	CmsService_ImportData_FullMethodName         = "/thmanyah.v1.CmsService/ImportData"
-	CmsService_BulkUpdatePrograms_FullMethodName = "/thmanyah.v1.CmsService/BulkUpdatePrograms"
-	CmsService_BulkDeletePrograms_FullMethodName = "/thmanyah.v1.CmsService/BulkDeletePrograms"
+	CmsService_CreateProgram_FullMethodName         = "/thmanyah.v1.CmsService/CreateProgram"
+	CmsService_UpdateProgram_FullMethodName         = "/thmanyah.v1.CmsService/UpdateProgram"
+	CmsService_DeleteProgram_FullMethodName         = "/thmanyah.v1.CmsService/DeleteProgram"
+	CmsService_GetProgram_FullMethodName            = "/thmanyah.v1.CmsService/GetProgram"
+	CmsService_ListPrograms_FullMethodName          = "/thmanyah.v1.CmsService/ListPrograms"
+	CmsService_BatchGetPrograms_FullMethodName      = "/thmanyah.v1.CmsService/BatchGetPrograms"
+	CmsService_CreateCategory_FullMethodName        = "/thmanyah.v1.CmsService/CreateCategory"
+	CmsService_UpdateCategory_FullMethodName        = "/thmanyah.v1.CmsService/UpdateCategory"
+	CmsService_DeleteCategory_FullMethodName        = "/thmanyah.v1.CmsService/DeleteCategory"
+	CmsService_GetCategoryTree_FullMethodName       = "/thmanyah.v1.CmsService/GetCategoryTree"
+	CmsService_GetCategory_FullMethodName           = "/thmanyah.v1.CmsService/GetCategory"
+	CmsService_ListCategories_FullMethodName        = "/thmanyah.v1.CmsService/ListCategories"
+	CmsService_BatchGetCategories_FullMethodName    = "/thmanyah.v1.CmsService/BatchGetCategories"
+	CmsService_CreateEpisode_FullMethodName         = "/thmanyah.v1.CmsService/CreateEpisode"
+	CmsService_UpdateEpisode_FullMethodName         = "/thmanyah.v1.CmsService/UpdateEpisode"
+	CmsService_DeleteEpisode_FullMethodName         = "/thmanyah.v1.CmsService/DeleteEpisode"
+	CmsService_GetEpisode_FullMethodName            = "/thmanyah.v1.CmsService/GetEpisode"
+	CmsService_ListEpisodes_FullMethodName          = "/thmanyah.v1.CmsService/ListEpisodes"
+	CmsService_BatchGetEpisodes_FullMethodName      = "/thmanyah.v1.CmsService/BatchGetEpisodes"
+	CmsService_RescheduleEpisode_FullMethodName     = "/thmanyah.v1.CmsService/RescheduleEpisode"
+	CmsService_CancelEpisodeSchedule_FullMethodName = "/thmanyah.v1.CmsService/CancelEpisodeSchedule"
+	CmsService_SubmitForReview_FullMethodName       = "/thmanyah.v1.CmsService/SubmitForReview"
+	CmsService_Approve_FullMethodName               = "/thmanyah.v1.CmsService/Approve"
+	CmsService_Reject_FullMethodName                = "/thmanyah.v1.CmsService/Reject"
+	CmsService_ListStatusTransitions_FullMethodName = "/thmanyah.v1.CmsService/ListStatusTransitions"
+	CmsService_ListRevisions_FullMethodName         = "/thmanyah.v1.CmsService/ListRevisions"
+	CmsService_GetRevision_FullMethodName           = "/thmanyah.v1.CmsService/GetRevision"
+	CmsService_DiffRevisions_FullMethodName         = "/thmanyah.v1.CmsService/DiffRevisions"
+	CmsService_RestoreRevision_FullMethodName       = "/thmanyah.v1.CmsService/RestoreRevision"
+	CmsService_ListTrash_FullMethodName             = "/thmanyah.v1.CmsService/ListTrash"
+	CmsService_RestoreFromTrash_FullMethodName      = "/thmanyah.v1.CmsService/RestoreFromTrash"
+	CmsService_PurgeTrash_FullMethodName            = "/thmanyah.v1.CmsService/PurgeTrash"
+	CmsService_ListAuditEvents_FullMethodName       = "/thmanyah.v1.CmsService/ListAuditEvents"
+	CmsService_MoveCategory_FullMethodName          = "/thmanyah.v1.CmsService/MoveCategory"
+	CmsService_SetProgramCategories_FullMethodName  = "/thmanyah.v1.CmsService/SetProgramCategories"
+	CmsService_ListTags_FullMethodName              = "/thmanyah.v1.CmsService/ListTags"
+	CmsService_AutocompleteTags_FullMethodName      = "/thmanyah.v1.CmsService/AutocompleteTags"
+	CmsService_UpdateTag_FullMethodName             = "/thmanyah.v1.CmsService/UpdateTag"
+	CmsService_RenameTag_FullMethodName             = "/thmanyah.v1.CmsService/RenameTag"
+	CmsService_MergeTags_FullMethodName             = "/thmanyah.v1.CmsService/MergeTags"
+	CmsService_ListTranslations_FullMethodName      = "/thmanyah.v1.CmsService/ListTranslations"
+	CmsService_SetTranslation_FullMethodName        = "/thmanyah.v1.CmsService/SetTranslation"
+	CmsService_DeleteTranslation_FullMethodName     = "/thmanyah.v1.CmsService/DeleteTranslation"
+	CmsService_CreateSeason_FullMethodName          = "/thmanyah.v1.CmsService/CreateSeason"
+	CmsService_ListSeasons_FullMethodName           = "/thmanyah.v1.CmsService/ListSeasons"
+	CmsService_GetSeason_FullMethodName             = "/thmanyah.v1.CmsService/GetSeason"
+	CmsService_UpdateSeason_FullMethodName          = "/thmanyah.v1.CmsService/UpdateSeason"
+	CmsService_DeleteSeason_FullMethodName          = "/thmanyah.v1.CmsService/DeleteSeason"
+	CmsService_ReorderEpisodes_FullMethodName       = "/thmanyah.v1.CmsService/ReorderEpisodes"
+	CmsService_ListSeasonEpisodes_FullMethodName    = "/thmanyah.v1.CmsService/ListSeasonEpisodes"
+	CmsService_ListChapters_FullMethodName          = "/thmanyah.v1.CmsService/ListChapters"
+	CmsService_CreateChapter_FullMethodName         = "/thmanyah.v1.CmsService/CreateChapter"
+	CmsService_UpdateChapter_FullMethodName         = "/thmanyah.v1.CmsService/UpdateChapter"
+	CmsService_DeleteChapter_FullMethodName         = "/thmanyah.v1.CmsService/DeleteChapter"
+	CmsService_ReplaceChapters_FullMethodName       = "/thmanyah.v1.CmsService/ReplaceChapters"
+	CmsService_ImportChapters_FullMethodName        = "/thmanyah.v1.CmsService/ImportChapters"
+	CmsService_UploadTranscript_FullMethodName      = "/thmanyah.v1.CmsService/UploadTranscript"
+	CmsService_ListTranscripts_FullMethodName       = "/thmanyah.v1.CmsService/ListTranscripts"
+	CmsService_DeleteTranscript_FullMethodName      = "/thmanyah.v1.CmsService/DeleteTranscript"
+	CmsService_CreatePerson_FullMethodName          = "/thmanyah.v1.CmsService/CreatePerson"
+	CmsService_GetPerson_FullMethodName             = "/thmanyah.v1.CmsService/GetPerson"
+	CmsService_ListPeople_FullMethodName            = "/thmanyah.v1.CmsService/ListPeople"
+	CmsService_UpdatePerson_FullMethodName          = "/thmanyah.v1.CmsService/UpdatePerson"
+	CmsService_DeletePerson_FullMethodName          = "/thmanyah.v1.CmsService/DeletePerson"
+	CmsService_CreateCredit_FullMethodName          = "/thmanyah.v1.CmsService/CreateCredit"
+	CmsService_ListCredits_FullMethodName           = "/thmanyah.v1.CmsService/ListCredits"
+	CmsService_UpdateCredit_FullMethodName          = "/thmanyah.v1.CmsService/UpdateCredit"
+	CmsService_DeleteCredit_FullMethodName          = "/thmanyah.v1.CmsService/DeleteCredit"
+	CmsService_ListCreditsByPerson_FullMethodName   = "/thmanyah.v1.CmsService/ListCreditsByPerson"
+	CmsService_CheckAvailability_FullMethodName     = "/thmanyah.v1.CmsService/CheckAvailability"
+	CmsService_ImportData_FullMethodName            = "/thmanyah.v1.CmsService/ImportData"
+	CmsService_WatchImport_FullMethodName           = "/thmanyah.v1.CmsService/WatchImport"
+	CmsService_BulkUpdatePrograms_FullMethodName    = "/thmanyah.v1.CmsService/BulkUpdatePrograms"
+	CmsService_BulkDeletePrograms_FullMethodName    = "/thmanyah.v1.CmsService/BulkDeletePrograms"
 )
 
 // CmsServiceClient is the client API for CmsService service.
@@ -49,17 +105,73 @@ type CmsServiceClient interface {
 	DeleteProgram(ctx context.Context, in *DeleteProgramRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetProgram(ctx context.Context, in *GetProgramRequest, opts ...grpc.CallOption) (*GetProgramResponse, error)
 	ListPrograms(ctx context.Context, in *ListProgramsRequest, opts ...grpc.CallOption) (*ListProgramsResponse, error)
+	BatchGetPrograms(ctx context.Context, in *BatchGetProgramsRequest, opts ...grpc.CallOption) (*BatchGetProgramsResponse, error)
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
 	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error)
 	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error)
 	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	BatchGetCategories(ctx context.Context, in *BatchGetCategoriesRequest, opts ...grpc.CallOption) (*BatchGetCategoriesResponse, error)
 	CreateEpisode(ctx context.Context, in *CreateEpisodeRequest, opts ...grpc.CallOption) (*CreateEpisodeResponse, error)
 	UpdateEpisode(ctx context.Context, in *UpdateEpisodeRequest, opts ...grpc.CallOption) (*UpdateEpisodeResponse, error)
 	DeleteEpisode(ctx context.Context, in *DeleteEpisodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetEpisode(ctx context.Context, in *GetEpisodeRequest, opts ...grpc.CallOption) (*GetEpisodeResponse, error)
 	ListEpisodes(ctx context.Context, in *ListEpisodesRequest, opts ...grpc.CallOption) (*ListEpisodesResponse, error)
+	BatchGetEpisodes(ctx context.Context, in *BatchGetEpisodesRequest, opts ...grpc.CallOption) (*BatchGetEpisodesResponse, error)
+	RescheduleEpisode(ctx context.Context, in *RescheduleEpisodeRequest, opts ...grpc.CallOption) (*RescheduleEpisodeResponse, error)
+	CancelEpisodeSchedule(ctx context.Context, in *CancelEpisodeScheduleRequest, opts ...grpc.CallOption) (*CancelEpisodeScheduleResponse, error)
+	SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	Approve(ctx context.Context, in *ApproveRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	Reject(ctx context.Context, in *RejectRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	ListStatusTransitions(ctx context.Context, in *ListStatusTransitionsRequest, opts ...grpc.CallOption) (*ListStatusTransitionsResponse, error)
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error)
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error)
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreFromTrash(ctx context.Context, in *RestoreFromTrashRequest, opts ...grpc.CallOption) (*RestoreFromTrashResponse, error)
+	PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryResponse, error)
+	SetProgramCategories(ctx context.Context, in *SetProgramCategoriesRequest, opts ...grpc.CallOption) (*SetProgramCategoriesResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...grpc.CallOption) (*AutocompleteTagsResponse, error)
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error)
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error)
+	ListTranslations(ctx context.Context, in *ListTranslationsRequest, opts ...grpc.CallOption) (*ListTranslationsResponse, error)
+	SetTranslation(ctx context.Context, in *SetTranslationRequest, opts ...grpc.CallOption) (*SetTranslationResponse, error)
+	DeleteTranslation(ctx context.Context, in *DeleteTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*CreateSeasonResponse, error)
+	ListSeasons(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error)
+	GetSeason(ctx context.Context, in *GetSeasonRequest, opts ...grpc.CallOption) (*GetSeasonResponse, error)
+	UpdateSeason(ctx context.Context, in *UpdateSeasonRequest, opts ...grpc.CallOption) (*UpdateSeasonResponse, error)
+	DeleteSeason(ctx context.Context, in *DeleteSeasonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReorderEpisodes(ctx context.Context, in *ReorderEpisodesRequest, opts ...grpc.CallOption) (*ReorderEpisodesResponse, error)
+	ListSeasonEpisodes(ctx context.Context, in *ListSeasonEpisodesRequest, opts ...grpc.CallOption) (*ListSeasonEpisodesResponse, error)
+	ListChapters(ctx context.Context, in *ListChaptersRequest, opts ...grpc.CallOption) (*ListChaptersResponse, error)
+	CreateChapter(ctx context.Context, in *CreateChapterRequest, opts ...grpc.CallOption) (*CreateChapterResponse, error)
+	UpdateChapter(ctx context.Context, in *UpdateChapterRequest, opts ...grpc.CallOption) (*UpdateChapterResponse, error)
+	DeleteChapter(ctx context.Context, in *DeleteChapterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReplaceChapters(ctx context.Context, in *ReplaceChaptersRequest, opts ...grpc.CallOption) (*ReplaceChaptersResponse, error)
+	ImportChapters(ctx context.Context, in *ImportChaptersRequest, opts ...grpc.CallOption) (*ImportChaptersResponse, error)
+	UploadTranscript(ctx context.Context, in *UploadTranscriptRequest, opts ...grpc.CallOption) (*UploadTranscriptResponse, error)
+	ListTranscripts(ctx context.Context, in *ListTranscriptsRequest, opts ...grpc.CallOption) (*ListTranscriptsResponse, error)
+	DeleteTranscript(ctx context.Context, in *DeleteTranscriptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*CreatePersonResponse, error)
+	GetPerson(ctx context.Context, in *GetPersonRequest, opts ...grpc.CallOption) (*GetPersonResponse, error)
+	ListPeople(ctx context.Context, in *ListPeopleRequest, opts ...grpc.CallOption) (*ListPeopleResponse, error)
+	UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*UpdatePersonResponse, error)
+	DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateCredit(ctx context.Context, in *CreateCreditRequest, opts ...grpc.CallOption) (*CreateCreditResponse, error)
+	ListCredits(ctx context.Context, in *ListCreditsRequest, opts ...grpc.CallOption) (*ListCreditsResponse, error)
+	UpdateCredit(ctx context.Context, in *UpdateCreditRequest, opts ...grpc.CallOption) (*UpdateCreditResponse, error)
+	DeleteCredit(ctx context.Context, in *DeleteCreditRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListCreditsByPerson(ctx context.Context, in *ListCreditsByPersonRequest, opts ...grpc.CallOption) (*ListCreditsByPersonResponse, error)
+	CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...grpc.CallOption) (*CheckAvailabilityResponse, error)
 	ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error)
+	WatchImport(ctx context.Context, in *WatchImportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImportEvent], error)
 	BulkUpdatePrograms(ctx context.Context, in *BulkUpdateProgramsRequest, opts ...grpc.CallOption) (*BulkUpdateProgramsResponse, error)
 	BulkDeletePrograms(ctx context.Context, in *BulkDeleteProgramsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -122,6 +234,16 @@ func (c *cmsServiceClient) ListPrograms(ctx context.Context, in *ListProgramsReq
 	return out, nil
 }
 
+func (c *cmsServiceClient) BatchGetPrograms(ctx context.Context, in *BatchGetProgramsRequest, opts ...grpc.CallOption) (*BatchGetProgramsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetProgramsResponse)
+	err := c.cc.Invoke(ctx, CmsService_BatchGetPrograms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cmsServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateCategoryResponse)
@@ -152,6 +274,16 @@ func (c *cmsServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategor
 	return out, nil
 }
 
+func (c *cmsServiceClient) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryTreeResponse)
+	err := c.cc.Invoke(ctx, CmsService_GetCategoryTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cmsServiceClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCategoryResponse)
@@ -172,6 +304,16 @@ func (c *cmsServiceClient) ListCategories(ctx context.Context, in *ListCategorie
 	return out, nil
 }
 
+func (c *cmsServiceClient) BatchGetCategories(ctx context.Context, in *BatchGetCategoriesRequest, opts ...grpc.CallOption) (*BatchGetCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetCategoriesResponse)
+	err := c.cc.Invoke(ctx, CmsService_BatchGetCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cmsServiceClient) CreateEpisode(ctx context.Context, in *CreateEpisodeRequest, opts ...grpc.CallOption) (*CreateEpisodeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateEpisodeResponse)
@@ -222,409 +364,2162 @@ func (c *cmsServiceClient) ListEpisodes(ctx context.Context, in *ListEpisodesReq
 	return out, nil
 }
 
-func (c *cmsServiceClient) ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error) {
+func (c *cmsServiceClient) BatchGetEpisodes(ctx context.Context, in *BatchGetEpisodesRequest, opts ...grpc.CallOption) (*BatchGetEpisodesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ImportDataResponse)
-	err := c.cc.Invoke(ctx, CmsService_ImportData_FullMethodName, in, out, cOpts...)
+	out := new(BatchGetEpisodesResponse)
+	err := c.cc.Invoke(ctx, CmsService_BatchGetEpisodes_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cmsServiceClient) BulkUpdatePrograms(ctx context.Context, in *BulkUpdateProgramsRequest, opts ...grpc.CallOption) (*BulkUpdateProgramsResponse, error) {
+func (c *cmsServiceClient) RescheduleEpisode(ctx context.Context, in *RescheduleEpisodeRequest, opts ...grpc.CallOption) (*RescheduleEpisodeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BulkUpdateProgramsResponse)
-	err := c.cc.Invoke(ctx, CmsService_BulkUpdatePrograms_FullMethodName, in, out, cOpts...)
+	out := new(RescheduleEpisodeResponse)
+	err := c.cc.Invoke(ctx, CmsService_RescheduleEpisode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cmsServiceClient) BulkDeletePrograms(ctx context.Context, in *BulkDeleteProgramsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *cmsServiceClient) CancelEpisodeSchedule(ctx context.Context, in *CancelEpisodeScheduleRequest, opts ...grpc.CallOption) (*CancelEpisodeScheduleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, CmsService_BulkDeletePrograms_FullMethodName, in, out, cOpts...)
+	out := new(CancelEpisodeScheduleResponse)
+	err := c.cc.Invoke(ctx, CmsService_CancelEpisodeSchedule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CmsServiceServer is the server API for CmsService service.
-// All implementations must embed UnimplementedCmsServiceServer
-// for forward compatibility.
-type CmsServiceServer interface {
-	CreateProgram(context.Context, *CreateProgramRequest) (*CreateProgramResponse, error)
-	UpdateProgram(context.Context, *UpdateProgramRequest) (*UpdateProgramResponse, error)
-	DeleteProgram(context.Context, *DeleteProgramRequest) (*emptypb.Empty, error)
-	GetProgram(context.Context, *GetProgramRequest) (*GetProgramResponse, error)
-	ListPrograms(context.Context, *ListProgramsRequest) (*ListProgramsResponse, error)
-	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
-	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
-	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
-	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
-	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
-	CreateEpisode(context.Context, *CreateEpisodeRequest) (*CreateEpisodeResponse, error)
-	UpdateEpisode(context.Context, *UpdateEpisodeRequest) (*UpdateEpisodeResponse, error)
-	DeleteEpisode(context.Context, *DeleteEpisodeRequest) (*emptypb.Empty, error)
-	GetEpisode(context.Context, *GetEpisodeRequest) (*GetEpisodeResponse, error)
-	ListEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodesResponse, error)
-	ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error)
-	BulkUpdatePrograms(context.Context, *BulkUpdateProgramsRequest) (*BulkUpdateProgramsResponse, error)
-	BulkDeletePrograms(context.Context, *BulkDeleteProgramsRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedCmsServiceServer()
+func (c *cmsServiceClient) SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, CmsService_SubmitForReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedCmsServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCmsServiceServer struct{}
+func (c *cmsServiceClient) Approve(ctx context.Context, in *ApproveRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, CmsService_Approve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedCmsServiceServer) CreateProgram(context.Context, *CreateProgramRequest) (*CreateProgramResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProgram not implemented")
+func (c *cmsServiceClient) Reject(ctx context.Context, in *RejectRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, CmsService_Reject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) UpdateProgram(context.Context, *UpdateProgramRequest) (*UpdateProgramResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProgram not implemented")
+
+func (c *cmsServiceClient) ListStatusTransitions(ctx context.Context, in *ListStatusTransitionsRequest, opts ...grpc.CallOption) (*ListStatusTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStatusTransitionsResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListStatusTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) DeleteProgram(context.Context, *DeleteProgramRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProgram not implemented")
+
+func (c *cmsServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) GetProgram(context.Context, *GetProgramRequest) (*GetProgramResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProgram not implemented")
+
+func (c *cmsServiceClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevisionResponse)
+	err := c.cc.Invoke(ctx, CmsService_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) ListPrograms(context.Context, *ListProgramsRequest) (*ListProgramsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPrograms not implemented")
+
+func (c *cmsServiceClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffRevisionsResponse)
+	err := c.cc.Invoke(ctx, CmsService_DiffRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+
+func (c *cmsServiceClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreRevisionResponse)
+	err := c.cc.Invoke(ctx, CmsService_RestoreRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+
+func (c *cmsServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+
+func (c *cmsServiceClient) RestoreFromTrash(ctx context.Context, in *RestoreFromTrashRequest, opts ...grpc.CallOption) (*RestoreFromTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreFromTrashResponse)
+	err := c.cc.Invoke(ctx, CmsService_RestoreFromTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+
+func (c *cmsServiceClient) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTrashResponse)
+	err := c.cc.Invoke(ctx, CmsService_PurgeTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+
+func (c *cmsServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) CreateEpisode(context.Context, *CreateEpisodeRequest) (*CreateEpisodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEpisode not implemented")
+
+func (c *cmsServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCategoryResponse)
+	err := c.cc.Invoke(ctx, CmsService_MoveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) UpdateEpisode(context.Context, *UpdateEpisodeRequest) (*UpdateEpisodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpisode not implemented")
+
+func (c *cmsServiceClient) SetProgramCategories(ctx context.Context, in *SetProgramCategoriesRequest, opts ...grpc.CallOption) (*SetProgramCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProgramCategoriesResponse)
+	err := c.cc.Invoke(ctx, CmsService_SetProgramCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) DeleteEpisode(context.Context, *DeleteEpisodeRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteEpisode not implemented")
+
+func (c *cmsServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) GetEpisode(context.Context, *GetEpisodeRequest) (*GetEpisodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEpisode not implemented")
+
+func (c *cmsServiceClient) AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...grpc.CallOption) (*AutocompleteTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutocompleteTagsResponse)
+	err := c.cc.Invoke(ctx, CmsService_AutocompleteTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) ListEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEpisodes not implemented")
+
+func (c *cmsServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTagResponse)
+	err := c.cc.Invoke(ctx, CmsService_UpdateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCmsServiceServer) ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportData not implemented")
+
+func (c *cmsServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, CmsService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeTagsResponse)
+	err := c.cc.Invoke(ctx, CmsService_MergeTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ListTranslations(ctx context.Context, in *ListTranslationsRequest, opts ...grpc.CallOption) (*ListTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTranslationsResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) SetTranslation(ctx context.Context, in *SetTranslationRequest, opts ...grpc.CallOption) (*SetTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTranslationResponse)
+	err := c.cc.Invoke(ctx, CmsService_SetTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) DeleteTranslation(ctx context.Context, in *DeleteTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CmsService_DeleteTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*CreateSeasonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSeasonResponse)
+	err := c.cc.Invoke(ctx, CmsService_CreateSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ListSeasons(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeasonsResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListSeasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) GetSeason(ctx context.Context, in *GetSeasonRequest, opts ...grpc.CallOption) (*GetSeasonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeasonResponse)
+	err := c.cc.Invoke(ctx, CmsService_GetSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) UpdateSeason(ctx context.Context, in *UpdateSeasonRequest, opts ...grpc.CallOption) (*UpdateSeasonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSeasonResponse)
+	err := c.cc.Invoke(ctx, CmsService_UpdateSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) DeleteSeason(ctx context.Context, in *DeleteSeasonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CmsService_DeleteSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ReorderEpisodes(ctx context.Context, in *ReorderEpisodesRequest, opts ...grpc.CallOption) (*ReorderEpisodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderEpisodesResponse)
+	err := c.cc.Invoke(ctx, CmsService_ReorderEpisodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ListSeasonEpisodes(ctx context.Context, in *ListSeasonEpisodesRequest, opts ...grpc.CallOption) (*ListSeasonEpisodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeasonEpisodesResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListSeasonEpisodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ListChapters(ctx context.Context, in *ListChaptersRequest, opts ...grpc.CallOption) (*ListChaptersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChaptersResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListChapters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) CreateChapter(ctx context.Context, in *CreateChapterRequest, opts ...grpc.CallOption) (*CreateChapterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChapterResponse)
+	err := c.cc.Invoke(ctx, CmsService_CreateChapter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) UpdateChapter(ctx context.Context, in *UpdateChapterRequest, opts ...grpc.CallOption) (*UpdateChapterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChapterResponse)
+	err := c.cc.Invoke(ctx, CmsService_UpdateChapter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) DeleteChapter(ctx context.Context, in *DeleteChapterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CmsService_DeleteChapter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ReplaceChapters(ctx context.Context, in *ReplaceChaptersRequest, opts ...grpc.CallOption) (*ReplaceChaptersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceChaptersResponse)
+	err := c.cc.Invoke(ctx, CmsService_ReplaceChapters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ImportChapters(ctx context.Context, in *ImportChaptersRequest, opts ...grpc.CallOption) (*ImportChaptersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportChaptersResponse)
+	err := c.cc.Invoke(ctx, CmsService_ImportChapters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) UploadTranscript(ctx context.Context, in *UploadTranscriptRequest, opts ...grpc.CallOption) (*UploadTranscriptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadTranscriptResponse)
+	err := c.cc.Invoke(ctx, CmsService_UploadTranscript_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ListTranscripts(ctx context.Context, in *ListTranscriptsRequest, opts ...grpc.CallOption) (*ListTranscriptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTranscriptsResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListTranscripts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) DeleteTranscript(ctx context.Context, in *DeleteTranscriptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CmsService_DeleteTranscript_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*CreatePersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePersonResponse)
+	err := c.cc.Invoke(ctx, CmsService_CreatePerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) GetPerson(ctx context.Context, in *GetPersonRequest, opts ...grpc.CallOption) (*GetPersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPersonResponse)
+	err := c.cc.Invoke(ctx, CmsService_GetPerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ListPeople(ctx context.Context, in *ListPeopleRequest, opts ...grpc.CallOption) (*ListPeopleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPeopleResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListPeople_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*UpdatePersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePersonResponse)
+	err := c.cc.Invoke(ctx, CmsService_UpdatePerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CmsService_DeletePerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) CreateCredit(ctx context.Context, in *CreateCreditRequest, opts ...grpc.CallOption) (*CreateCreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCreditResponse)
+	err := c.cc.Invoke(ctx, CmsService_CreateCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ListCredits(ctx context.Context, in *ListCreditsRequest, opts ...grpc.CallOption) (*ListCreditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCreditsResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) UpdateCredit(ctx context.Context, in *UpdateCreditRequest, opts ...grpc.CallOption) (*UpdateCreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCreditResponse)
+	err := c.cc.Invoke(ctx, CmsService_UpdateCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) DeleteCredit(ctx context.Context, in *DeleteCreditRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CmsService_DeleteCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ListCreditsByPerson(ctx context.Context, in *ListCreditsByPersonRequest, opts ...grpc.CallOption) (*ListCreditsByPersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCreditsByPersonResponse)
+	err := c.cc.Invoke(ctx, CmsService_ListCreditsByPerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...grpc.CallOption) (*CheckAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAvailabilityResponse)
+	err := c.cc.Invoke(ctx, CmsService_CheckAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportDataResponse)
+	err := c.cc.Invoke(ctx, CmsService_ImportData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) WatchImport(ctx context.Context, in *WatchImportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImportEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CmsService_ServiceDesc.Streams[0], CmsService_WatchImport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchImportRequest, ImportEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CmsService_WatchImportClient = grpc.ServerStreamingClient[ImportEvent]
+
+func (c *cmsServiceClient) BulkUpdatePrograms(ctx context.Context, in *BulkUpdateProgramsRequest, opts ...grpc.CallOption) (*BulkUpdateProgramsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateProgramsResponse)
+	err := c.cc.Invoke(ctx, CmsService_BulkUpdatePrograms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmsServiceClient) BulkDeletePrograms(ctx context.Context, in *BulkDeleteProgramsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CmsService_BulkDeletePrograms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CmsServiceServer is the server API for CmsService service.
+// All implementations must embed UnimplementedCmsServiceServer
+// for forward compatibility.
+type CmsServiceServer interface {
+	CreateProgram(context.Context, *CreateProgramRequest) (*CreateProgramResponse, error)
+	UpdateProgram(context.Context, *UpdateProgramRequest) (*UpdateProgramResponse, error)
+	DeleteProgram(context.Context, *DeleteProgramRequest) (*emptypb.Empty, error)
+	GetProgram(context.Context, *GetProgramRequest) (*GetProgramResponse, error)
+	ListPrograms(context.Context, *ListProgramsRequest) (*ListProgramsResponse, error)
+	BatchGetPrograms(context.Context, *BatchGetProgramsRequest) (*BatchGetProgramsResponse, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
+	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
+	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	BatchGetCategories(context.Context, *BatchGetCategoriesRequest) (*BatchGetCategoriesResponse, error)
+	CreateEpisode(context.Context, *CreateEpisodeRequest) (*CreateEpisodeResponse, error)
+	UpdateEpisode(context.Context, *UpdateEpisodeRequest) (*UpdateEpisodeResponse, error)
+	DeleteEpisode(context.Context, *DeleteEpisodeRequest) (*emptypb.Empty, error)
+	GetEpisode(context.Context, *GetEpisodeRequest) (*GetEpisodeResponse, error)
+	ListEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodesResponse, error)
+	BatchGetEpisodes(context.Context, *BatchGetEpisodesRequest) (*BatchGetEpisodesResponse, error)
+	RescheduleEpisode(context.Context, *RescheduleEpisodeRequest) (*RescheduleEpisodeResponse, error)
+	CancelEpisodeSchedule(context.Context, *CancelEpisodeScheduleRequest) (*CancelEpisodeScheduleResponse, error)
+	SubmitForReview(context.Context, *SubmitForReviewRequest) (*ReviewResponse, error)
+	Approve(context.Context, *ApproveRequest) (*ReviewResponse, error)
+	Reject(context.Context, *RejectRequest) (*ReviewResponse, error)
+	ListStatusTransitions(context.Context, *ListStatusTransitionsRequest) (*ListStatusTransitionsResponse, error)
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error)
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreFromTrash(context.Context, *RestoreFromTrashRequest) (*RestoreFromTrashResponse, error)
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error)
+	SetProgramCategories(context.Context, *SetProgramCategoriesRequest) (*SetProgramCategoriesResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	AutocompleteTags(context.Context, *AutocompleteTagsRequest) (*AutocompleteTagsResponse, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
+	ListTranslations(context.Context, *ListTranslationsRequest) (*ListTranslationsResponse, error)
+	SetTranslation(context.Context, *SetTranslationRequest) (*SetTranslationResponse, error)
+	DeleteTranslation(context.Context, *DeleteTranslationRequest) (*emptypb.Empty, error)
+	CreateSeason(context.Context, *CreateSeasonRequest) (*CreateSeasonResponse, error)
+	ListSeasons(context.Context, *ListSeasonsRequest) (*ListSeasonsResponse, error)
+	GetSeason(context.Context, *GetSeasonRequest) (*GetSeasonResponse, error)
+	UpdateSeason(context.Context, *UpdateSeasonRequest) (*UpdateSeasonResponse, error)
+	DeleteSeason(context.Context, *DeleteSeasonRequest) (*emptypb.Empty, error)
+	ReorderEpisodes(context.Context, *ReorderEpisodesRequest) (*ReorderEpisodesResponse, error)
+	ListSeasonEpisodes(context.Context, *ListSeasonEpisodesRequest) (*ListSeasonEpisodesResponse, error)
+	ListChapters(context.Context, *ListChaptersRequest) (*ListChaptersResponse, error)
+	CreateChapter(context.Context, *CreateChapterRequest) (*CreateChapterResponse, error)
+	UpdateChapter(context.Context, *UpdateChapterRequest) (*UpdateChapterResponse, error)
+	DeleteChapter(context.Context, *DeleteChapterRequest) (*emptypb.Empty, error)
+	ReplaceChapters(context.Context, *ReplaceChaptersRequest) (*ReplaceChaptersResponse, error)
+	ImportChapters(context.Context, *ImportChaptersRequest) (*ImportChaptersResponse, error)
+	UploadTranscript(context.Context, *UploadTranscriptRequest) (*UploadTranscriptResponse, error)
+	ListTranscripts(context.Context, *ListTranscriptsRequest) (*ListTranscriptsResponse, error)
+	DeleteTranscript(context.Context, *DeleteTranscriptRequest) (*emptypb.Empty, error)
+	CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponse, error)
+	GetPerson(context.Context, *GetPersonRequest) (*GetPersonResponse, error)
+	ListPeople(context.Context, *ListPeopleRequest) (*ListPeopleResponse, error)
+	UpdatePerson(context.Context, *UpdatePersonRequest) (*UpdatePersonResponse, error)
+	DeletePerson(context.Context, *DeletePersonRequest) (*emptypb.Empty, error)
+	CreateCredit(context.Context, *CreateCreditRequest) (*CreateCreditResponse, error)
+	ListCredits(context.Context, *ListCreditsRequest) (*ListCreditsResponse, error)
+	UpdateCredit(context.Context, *UpdateCreditRequest) (*UpdateCreditResponse, error)
+	DeleteCredit(context.Context, *DeleteCreditRequest) (*emptypb.Empty, error)
+	ListCreditsByPerson(context.Context, *ListCreditsByPersonRequest) (*ListCreditsByPersonResponse, error)
+	CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error)
+	ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error)
+	WatchImport(*WatchImportRequest, grpc.ServerStreamingServer[ImportEvent]) error
+	BulkUpdatePrograms(context.Context, *BulkUpdateProgramsRequest) (*BulkUpdateProgramsResponse, error)
+	BulkDeletePrograms(context.Context, *BulkDeleteProgramsRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCmsServiceServer()
+}
+
+// UnimplementedCmsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCmsServiceServer struct{}
+
+func (UnimplementedCmsServiceServer) CreateProgram(context.Context, *CreateProgramRequest) (*CreateProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProgram not implemented")
+}
+func (UnimplementedCmsServiceServer) UpdateProgram(context.Context, *UpdateProgramRequest) (*UpdateProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProgram not implemented")
+}
+func (UnimplementedCmsServiceServer) DeleteProgram(context.Context, *DeleteProgramRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProgram not implemented")
+}
+func (UnimplementedCmsServiceServer) GetProgram(context.Context, *GetProgramRequest) (*GetProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProgram not implemented")
+}
+func (UnimplementedCmsServiceServer) ListPrograms(context.Context, *ListProgramsRequest) (*ListProgramsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrograms not implemented")
+}
+func (UnimplementedCmsServiceServer) BatchGetPrograms(context.Context, *BatchGetProgramsRequest) (*BatchGetProgramsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetPrograms not implemented")
+}
+func (UnimplementedCmsServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedCmsServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedCmsServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedCmsServiceServer) GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTree not implemented")
+}
+func (UnimplementedCmsServiceServer) GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedCmsServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedCmsServiceServer) BatchGetCategories(context.Context, *BatchGetCategoriesRequest) (*BatchGetCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetCategories not implemented")
+}
+func (UnimplementedCmsServiceServer) CreateEpisode(context.Context, *CreateEpisodeRequest) (*CreateEpisodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEpisode not implemented")
+}
+func (UnimplementedCmsServiceServer) UpdateEpisode(context.Context, *UpdateEpisodeRequest) (*UpdateEpisodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpisode not implemented")
+}
+func (UnimplementedCmsServiceServer) DeleteEpisode(context.Context, *DeleteEpisodeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEpisode not implemented")
+}
+func (UnimplementedCmsServiceServer) GetEpisode(context.Context, *GetEpisodeRequest) (*GetEpisodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpisode not implemented")
+}
+func (UnimplementedCmsServiceServer) ListEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEpisodes not implemented")
+}
+func (UnimplementedCmsServiceServer) BatchGetEpisodes(context.Context, *BatchGetEpisodesRequest) (*BatchGetEpisodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetEpisodes not implemented")
+}
+func (UnimplementedCmsServiceServer) RescheduleEpisode(context.Context, *RescheduleEpisodeRequest) (*RescheduleEpisodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleEpisode not implemented")
+}
+func (UnimplementedCmsServiceServer) CancelEpisodeSchedule(context.Context, *CancelEpisodeScheduleRequest) (*CancelEpisodeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEpisodeSchedule not implemented")
+}
+func (UnimplementedCmsServiceServer) SubmitForReview(context.Context, *SubmitForReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitForReview not implemented")
+}
+func (UnimplementedCmsServiceServer) Approve(context.Context, *ApproveRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedCmsServiceServer) Reject(context.Context, *RejectRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reject not implemented")
+}
+func (UnimplementedCmsServiceServer) ListStatusTransitions(context.Context, *ListStatusTransitionsRequest) (*ListStatusTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStatusTransitions not implemented")
+}
+func (UnimplementedCmsServiceServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedCmsServiceServer) GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedCmsServiceServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedCmsServiceServer) RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (UnimplementedCmsServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedCmsServiceServer) RestoreFromTrash(context.Context, *RestoreFromTrashRequest) (*RestoreFromTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFromTrash not implemented")
+}
+func (UnimplementedCmsServiceServer) PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedCmsServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedCmsServiceServer) MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedCmsServiceServer) SetProgramCategories(context.Context, *SetProgramCategoriesRequest) (*SetProgramCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProgramCategories not implemented")
+}
+func (UnimplementedCmsServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedCmsServiceServer) AutocompleteTags(context.Context, *AutocompleteTagsRequest) (*AutocompleteTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteTags not implemented")
+}
+func (UnimplementedCmsServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedCmsServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedCmsServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedCmsServiceServer) ListTranslations(context.Context, *ListTranslationsRequest) (*ListTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTranslations not implemented")
+}
+func (UnimplementedCmsServiceServer) SetTranslation(context.Context, *SetTranslationRequest) (*SetTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTranslation not implemented")
+}
+func (UnimplementedCmsServiceServer) DeleteTranslation(context.Context, *DeleteTranslationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTranslation not implemented")
+}
+func (UnimplementedCmsServiceServer) CreateSeason(context.Context, *CreateSeasonRequest) (*CreateSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeason not implemented")
+}
+func (UnimplementedCmsServiceServer) ListSeasons(context.Context, *ListSeasonsRequest) (*ListSeasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasons not implemented")
+}
+func (UnimplementedCmsServiceServer) GetSeason(context.Context, *GetSeasonRequest) (*GetSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeason not implemented")
+}
+func (UnimplementedCmsServiceServer) UpdateSeason(context.Context, *UpdateSeasonRequest) (*UpdateSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeason not implemented")
+}
+func (UnimplementedCmsServiceServer) DeleteSeason(context.Context, *DeleteSeasonRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeason not implemented")
+}
+func (UnimplementedCmsServiceServer) ReorderEpisodes(context.Context, *ReorderEpisodesRequest) (*ReorderEpisodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderEpisodes not implemented")
+}
+func (UnimplementedCmsServiceServer) ListSeasonEpisodes(context.Context, *ListSeasonEpisodesRequest) (*ListSeasonEpisodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasonEpisodes not implemented")
+}
+func (UnimplementedCmsServiceServer) ListChapters(context.Context, *ListChaptersRequest) (*ListChaptersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChapters not implemented")
+}
+func (UnimplementedCmsServiceServer) CreateChapter(context.Context, *CreateChapterRequest) (*CreateChapterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChapter not implemented")
+}
+func (UnimplementedCmsServiceServer) UpdateChapter(context.Context, *UpdateChapterRequest) (*UpdateChapterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChapter not implemented")
+}
+func (UnimplementedCmsServiceServer) DeleteChapter(context.Context, *DeleteChapterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChapter not implemented")
+}
+func (UnimplementedCmsServiceServer) ReplaceChapters(context.Context, *ReplaceChaptersRequest) (*ReplaceChaptersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceChapters not implemented")
+}
+func (UnimplementedCmsServiceServer) ImportChapters(context.Context, *ImportChaptersRequest) (*ImportChaptersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportChapters not implemented")
+}
+func (UnimplementedCmsServiceServer) UploadTranscript(context.Context, *UploadTranscriptRequest) (*UploadTranscriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadTranscript not implemented")
+}
+func (UnimplementedCmsServiceServer) ListTranscripts(context.Context, *ListTranscriptsRequest) (*ListTranscriptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTranscripts not implemented")
+}
+func (UnimplementedCmsServiceServer) DeleteTranscript(context.Context, *DeleteTranscriptRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTranscript not implemented")
+}
+func (UnimplementedCmsServiceServer) CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePerson not implemented")
+}
+func (UnimplementedCmsServiceServer) GetPerson(context.Context, *GetPersonRequest) (*GetPersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerson not implemented")
+}
+func (UnimplementedCmsServiceServer) ListPeople(context.Context, *ListPeopleRequest) (*ListPeopleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeople not implemented")
+}
+func (UnimplementedCmsServiceServer) UpdatePerson(context.Context, *UpdatePersonRequest) (*UpdatePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePerson not implemented")
+}
+func (UnimplementedCmsServiceServer) DeletePerson(context.Context, *DeletePersonRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePerson not implemented")
+}
+func (UnimplementedCmsServiceServer) CreateCredit(context.Context, *CreateCreditRequest) (*CreateCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredit not implemented")
+}
+func (UnimplementedCmsServiceServer) ListCredits(context.Context, *ListCreditsRequest) (*ListCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredits not implemented")
+}
+func (UnimplementedCmsServiceServer) UpdateCredit(context.Context, *UpdateCreditRequest) (*UpdateCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredit not implemented")
+}
+func (UnimplementedCmsServiceServer) DeleteCredit(context.Context, *DeleteCreditRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredit not implemented")
+}
+func (UnimplementedCmsServiceServer) ListCreditsByPerson(context.Context, *ListCreditsByPersonRequest) (*ListCreditsByPersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCreditsByPerson not implemented")
+}
+func (UnimplementedCmsServiceServer) CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAvailability not implemented")
+}
+func (UnimplementedCmsServiceServer) ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportData not implemented")
+}
+func (UnimplementedCmsServiceServer) WatchImport(*WatchImportRequest, grpc.ServerStreamingServer[ImportEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchImport not implemented")
+}
+func (UnimplementedCmsServiceServer) BulkUpdatePrograms(context.Context, *BulkUpdateProgramsRequest) (*BulkUpdateProgramsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdatePrograms not implemented")
+}
+func (UnimplementedCmsServiceServer) BulkDeletePrograms(context.Context, *BulkDeleteProgramsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeletePrograms not implemented")
+}
+func (UnimplementedCmsServiceServer) mustEmbedUnimplementedCmsServiceServer() {}
+func (UnimplementedCmsServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeCmsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CmsServiceServer will
+// result in compilation errors.
+type UnsafeCmsServiceServer interface {
+	mustEmbedUnimplementedCmsServiceServer()
+}
+
+func RegisterCmsServiceServer(s grpc.ServiceRegistrar, srv CmsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCmsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CmsService_ServiceDesc, srv)
+}
+
+func _CmsService_CreateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).CreateProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_CreateProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).CreateProgram(ctx, req.(*CreateProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_UpdateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).UpdateProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_UpdateProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).UpdateProgram(ctx, req.(*UpdateProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_DeleteProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).DeleteProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_DeleteProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).DeleteProgram(ctx, req.(*DeleteProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_GetProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).GetProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_GetProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).GetProgram(ctx, req.(*GetProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListPrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProgramsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListPrograms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListPrograms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListPrograms(ctx, req.(*ListProgramsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_BatchGetPrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetProgramsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).BatchGetPrograms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_BatchGetPrograms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).BatchGetPrograms(ctx, req.(*BatchGetProgramsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).GetCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_GetCategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).GetCategoryTree(ctx, req.(*GetCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_BatchGetCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).BatchGetCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_BatchGetCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).BatchGetCategories(ctx, req.(*BatchGetCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_CreateEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEpisodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).CreateEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_CreateEpisode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).CreateEpisode(ctx, req.(*CreateEpisodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_UpdateEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEpisodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).UpdateEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_UpdateEpisode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).UpdateEpisode(ctx, req.(*UpdateEpisodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_DeleteEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEpisodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).DeleteEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_DeleteEpisode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).DeleteEpisode(ctx, req.(*DeleteEpisodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_GetEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpisodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).GetEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_GetEpisode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).GetEpisode(ctx, req.(*GetEpisodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListEpisodes(ctx, req.(*ListEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_BatchGetEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).BatchGetEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_BatchGetEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).BatchGetEpisodes(ctx, req.(*BatchGetEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_RescheduleEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleEpisodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).RescheduleEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_RescheduleEpisode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).RescheduleEpisode(ctx, req.(*RescheduleEpisodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_CancelEpisodeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEpisodeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).CancelEpisodeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_CancelEpisodeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).CancelEpisodeSchedule(ctx, req.(*CancelEpisodeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_SubmitForReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitForReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).SubmitForReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_SubmitForReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).SubmitForReview(ctx, req.(*SubmitForReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_Approve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).Approve(ctx, req.(*ApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_Reject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).Reject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_Reject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).Reject(ctx, req.(*RejectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListStatusTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatusTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListStatusTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListStatusTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListStatusTransitions(ctx, req.(*ListStatusTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_RestoreRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_RestoreFromTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFromTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).RestoreFromTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_RestoreFromTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).RestoreFromTrash(ctx, req.(*RestoreFromTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_PurgeTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).PurgeTrash(ctx, req.(*PurgeTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_MoveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_SetProgramCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProgramCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).SetProgramCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_SetProgramCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).SetProgramCategories(ctx, req.(*SetProgramCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_AutocompleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).AutocompleteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_AutocompleteTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).AutocompleteTags(ctx, req.(*AutocompleteTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListTranslations(ctx, req.(*ListTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_SetTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).SetTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_SetTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).SetTranslation(ctx, req.(*SetTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_DeleteTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).DeleteTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_DeleteTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).DeleteTranslation(ctx, req.(*DeleteTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_CreateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).CreateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_CreateSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).CreateSeason(ctx, req.(*CreateSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListSeasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListSeasons(ctx, req.(*ListSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_GetSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).GetSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_GetSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).GetSeason(ctx, req.(*GetSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_UpdateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).UpdateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_UpdateSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).UpdateSeason(ctx, req.(*UpdateSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_DeleteSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).DeleteSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_DeleteSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).DeleteSeason(ctx, req.(*DeleteSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ReorderEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ReorderEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ReorderEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ReorderEpisodes(ctx, req.(*ReorderEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListSeasonEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeasonEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListSeasonEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListSeasonEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListSeasonEpisodes(ctx, req.(*ListSeasonEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CmsService_ListChapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChaptersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ListChapters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ListChapters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ListChapters(ctx, req.(*ListChaptersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCmsServiceServer) BulkUpdatePrograms(context.Context, *BulkUpdateProgramsRequest) (*BulkUpdateProgramsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdatePrograms not implemented")
+
+func _CmsService_CreateChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).CreateChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_CreateChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).CreateChapter(ctx, req.(*CreateChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCmsServiceServer) BulkDeletePrograms(context.Context, *BulkDeleteProgramsRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BulkDeletePrograms not implemented")
+
+func _CmsService_UpdateChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).UpdateChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_UpdateChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).UpdateChapter(ctx, req.(*UpdateChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCmsServiceServer) mustEmbedUnimplementedCmsServiceServer() {}
-func (UnimplementedCmsServiceServer) testEmbeddedByValue()                    {}
 
-// UnsafeCmsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CmsServiceServer will
-// result in compilation errors.
-type UnsafeCmsServiceServer interface {
-	mustEmbedUnimplementedCmsServiceServer()
+func _CmsService_DeleteChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmsServiceServer).DeleteChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_DeleteChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).DeleteChapter(ctx, req.(*DeleteChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCmsServiceServer(s grpc.ServiceRegistrar, srv CmsServiceServer) {
-	// If the following call pancis, it indicates UnimplementedCmsServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _CmsService_ReplaceChapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceChaptersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&CmsService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(CmsServiceServer).ReplaceChapters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CmsService_ReplaceChapters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmsServiceServer).ReplaceChapters(ctx, req.(*ReplaceChaptersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_CreateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProgramRequest)
+func _CmsService_ImportChapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportChaptersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).CreateProgram(ctx, in)
+		return srv.(CmsServiceServer).ImportChapters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_CreateProgram_FullMethodName,
+		FullMethod: CmsService_ImportChapters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).CreateProgram(ctx, req.(*CreateProgramRequest))
+		return srv.(CmsServiceServer).ImportChapters(ctx, req.(*ImportChaptersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_UpdateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProgramRequest)
+func _CmsService_UploadTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadTranscriptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).UpdateProgram(ctx, in)
+		return srv.(CmsServiceServer).UploadTranscript(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_UpdateProgram_FullMethodName,
+		FullMethod: CmsService_UploadTranscript_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).UpdateProgram(ctx, req.(*UpdateProgramRequest))
+		return srv.(CmsServiceServer).UploadTranscript(ctx, req.(*UploadTranscriptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_DeleteProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProgramRequest)
+func _CmsService_ListTranscripts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTranscriptsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).DeleteProgram(ctx, in)
+		return srv.(CmsServiceServer).ListTranscripts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_DeleteProgram_FullMethodName,
+		FullMethod: CmsService_ListTranscripts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).DeleteProgram(ctx, req.(*DeleteProgramRequest))
+		return srv.(CmsServiceServer).ListTranscripts(ctx, req.(*ListTranscriptsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_GetProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProgramRequest)
+func _CmsService_DeleteTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTranscriptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).GetProgram(ctx, in)
+		return srv.(CmsServiceServer).DeleteTranscript(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_GetProgram_FullMethodName,
+		FullMethod: CmsService_DeleteTranscript_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).GetProgram(ctx, req.(*GetProgramRequest))
+		return srv.(CmsServiceServer).DeleteTranscript(ctx, req.(*DeleteTranscriptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_ListPrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProgramsRequest)
+func _CmsService_CreatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).ListPrograms(ctx, in)
+		return srv.(CmsServiceServer).CreatePerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_ListPrograms_FullMethodName,
+		FullMethod: CmsService_CreatePerson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).ListPrograms(ctx, req.(*ListProgramsRequest))
+		return srv.(CmsServiceServer).CreatePerson(ctx, req.(*CreatePersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCategoryRequest)
+func _CmsService_GetPerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).CreateCategory(ctx, in)
+		return srv.(CmsServiceServer).GetPerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_CreateCategory_FullMethodName,
+		FullMethod: CmsService_GetPerson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+		return srv.(CmsServiceServer).GetPerson(ctx, req.(*GetPersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCategoryRequest)
+func _CmsService_ListPeople_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeopleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).UpdateCategory(ctx, in)
+		return srv.(CmsServiceServer).ListPeople(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_UpdateCategory_FullMethodName,
+		FullMethod: CmsService_ListPeople_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+		return srv.(CmsServiceServer).ListPeople(ctx, req.(*ListPeopleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCategoryRequest)
+func _CmsService_UpdatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).DeleteCategory(ctx, in)
+		return srv.(CmsServiceServer).UpdatePerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_DeleteCategory_FullMethodName,
+		FullMethod: CmsService_UpdatePerson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+		return srv.(CmsServiceServer).UpdatePerson(ctx, req.(*UpdatePersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoryRequest)
+func _CmsService_DeletePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).GetCategory(ctx, in)
+		return srv.(CmsServiceServer).DeletePerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_GetCategory_FullMethodName,
+		FullMethod: CmsService_DeletePerson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).GetCategory(ctx, req.(*GetCategoryRequest))
+		return srv.(CmsServiceServer).DeletePerson(ctx, req.(*DeletePersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCategoriesRequest)
+func _CmsService_CreateCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCreditRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).ListCategories(ctx, in)
+		return srv.(CmsServiceServer).CreateCredit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_ListCategories_FullMethodName,
+		FullMethod: CmsService_CreateCredit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+		return srv.(CmsServiceServer).CreateCredit(ctx, req.(*CreateCreditRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_CreateEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateEpisodeRequest)
+func _CmsService_ListCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCreditsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).CreateEpisode(ctx, in)
+		return srv.(CmsServiceServer).ListCredits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_CreateEpisode_FullMethodName,
+		FullMethod: CmsService_ListCredits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).CreateEpisode(ctx, req.(*CreateEpisodeRequest))
+		return srv.(CmsServiceServer).ListCredits(ctx, req.(*ListCreditsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_UpdateEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEpisodeRequest)
+func _CmsService_UpdateCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCreditRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).UpdateEpisode(ctx, in)
+		return srv.(CmsServiceServer).UpdateCredit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_UpdateEpisode_FullMethodName,
+		FullMethod: CmsService_UpdateCredit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).UpdateEpisode(ctx, req.(*UpdateEpisodeRequest))
+		return srv.(CmsServiceServer).UpdateCredit(ctx, req.(*UpdateCreditRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_DeleteEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEpisodeRequest)
+func _CmsService_DeleteCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCreditRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).DeleteEpisode(ctx, in)
+		return srv.(CmsServiceServer).DeleteCredit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_DeleteEpisode_FullMethodName,
+		FullMethod: CmsService_DeleteCredit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).DeleteEpisode(ctx, req.(*DeleteEpisodeRequest))
+		return srv.(CmsServiceServer).DeleteCredit(ctx, req.(*DeleteCreditRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_GetEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEpisodeRequest)
+func _CmsService_ListCreditsByPerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCreditsByPersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).GetEpisode(ctx, in)
+		return srv.(CmsServiceServer).ListCreditsByPerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_GetEpisode_FullMethodName,
+		FullMethod: CmsService_ListCreditsByPerson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).GetEpisode(ctx, req.(*GetEpisodeRequest))
+		return srv.(CmsServiceServer).ListCreditsByPerson(ctx, req.(*ListCreditsByPersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CmsService_ListEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEpisodesRequest)
+func _CmsService_CheckAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAvailabilityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CmsServiceServer).ListEpisodes(ctx, in)
+		return srv.(CmsServiceServer).CheckAvailability(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CmsService_ListEpisodes_FullMethodName,
+		FullMethod: CmsService_CheckAvailability_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CmsServiceServer).ListEpisodes(ctx, req.(*ListEpisodesRequest))
+		return srv.(CmsServiceServer).CheckAvailability(ctx, req.(*CheckAvailabilityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -647,6 +2542,17 @@ func _CmsService_ImportData_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CmsService_WatchImport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchImportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CmsServiceServer).WatchImport(m, &grpc.GenericServerStream[WatchImportRequest, ImportEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CmsService_WatchImportServer = grpc.ServerStreamingServer[ImportEvent]
+
 func _CmsService_BulkUpdatePrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BulkUpdateProgramsRequest)
 	if err := dec(in); err != nil {
@@ -710,6 +2616,10 @@ var CmsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPrograms",
 			Handler:    _CmsService_ListPrograms_Handler,
 		},
+		{
+			MethodName: "BatchGetPrograms",
+			Handler:    _CmsService_BatchGetPrograms_Handler,
+		},
 		{
 			MethodName: "CreateCategory",
 			Handler:    _CmsService_CreateCategory_Handler,
@@ -722,6 +2632,10 @@ var CmsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCategory",
 			Handler:    _CmsService_DeleteCategory_Handler,
 		},
+		{
+			MethodName: "GetCategoryTree",
+			Handler:    _CmsService_GetCategoryTree_Handler,
+		},
 		{
 			MethodName: "GetCategory",
 			Handler:    _CmsService_GetCategory_Handler,
@@ -730,6 +2644,10 @@ var CmsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCategories",
 			Handler:    _CmsService_ListCategories_Handler,
 		},
+		{
+			MethodName: "BatchGetCategories",
+			Handler:    _CmsService_BatchGetCategories_Handler,
+		},
 		{
 			MethodName: "CreateEpisode",
 			Handler:    _CmsService_CreateEpisode_Handler,
@@ -750,6 +2668,214 @@ var CmsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEpisodes",
 			Handler:    _CmsService_ListEpisodes_Handler,
 		},
+		{
+			MethodName: "BatchGetEpisodes",
+			Handler:    _CmsService_BatchGetEpisodes_Handler,
+		},
+		{
+			MethodName: "RescheduleEpisode",
+			Handler:    _CmsService_RescheduleEpisode_Handler,
+		},
+		{
+			MethodName: "CancelEpisodeSchedule",
+			Handler:    _CmsService_CancelEpisodeSchedule_Handler,
+		},
+		{
+			MethodName: "SubmitForReview",
+			Handler:    _CmsService_SubmitForReview_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _CmsService_Approve_Handler,
+		},
+		{
+			MethodName: "Reject",
+			Handler:    _CmsService_Reject_Handler,
+		},
+		{
+			MethodName: "ListStatusTransitions",
+			Handler:    _CmsService_ListStatusTransitions_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _CmsService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _CmsService_GetRevision_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _CmsService_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _CmsService_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _CmsService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreFromTrash",
+			Handler:    _CmsService_RestoreFromTrash_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _CmsService_PurgeTrash_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _CmsService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _CmsService_MoveCategory_Handler,
+		},
+		{
+			MethodName: "SetProgramCategories",
+			Handler:    _CmsService_SetProgramCategories_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _CmsService_ListTags_Handler,
+		},
+		{
+			MethodName: "AutocompleteTags",
+			Handler:    _CmsService_AutocompleteTags_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _CmsService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _CmsService_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _CmsService_MergeTags_Handler,
+		},
+		{
+			MethodName: "ListTranslations",
+			Handler:    _CmsService_ListTranslations_Handler,
+		},
+		{
+			MethodName: "SetTranslation",
+			Handler:    _CmsService_SetTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteTranslation",
+			Handler:    _CmsService_DeleteTranslation_Handler,
+		},
+		{
+			MethodName: "CreateSeason",
+			Handler:    _CmsService_CreateSeason_Handler,
+		},
+		{
+			MethodName: "ListSeasons",
+			Handler:    _CmsService_ListSeasons_Handler,
+		},
+		{
+			MethodName: "GetSeason",
+			Handler:    _CmsService_GetSeason_Handler,
+		},
+		{
+			MethodName: "UpdateSeason",
+			Handler:    _CmsService_UpdateSeason_Handler,
+		},
+		{
+			MethodName: "DeleteSeason",
+			Handler:    _CmsService_DeleteSeason_Handler,
+		},
+		{
+			MethodName: "ReorderEpisodes",
+			Handler:    _CmsService_ReorderEpisodes_Handler,
+		},
+		{
+			MethodName: "ListSeasonEpisodes",
+			Handler:    _CmsService_ListSeasonEpisodes_Handler,
+		},
+		{
+			MethodName: "ListChapters",
+			Handler:    _CmsService_ListChapters_Handler,
+		},
+		{
+			MethodName: "CreateChapter",
+			Handler:    _CmsService_CreateChapter_Handler,
+		},
+		{
+			MethodName: "UpdateChapter",
+			Handler:    _CmsService_UpdateChapter_Handler,
+		},
+		{
+			MethodName: "DeleteChapter",
+			Handler:    _CmsService_DeleteChapter_Handler,
+		},
+		{
+			MethodName: "ReplaceChapters",
+			Handler:    _CmsService_ReplaceChapters_Handler,
+		},
+		{
+			MethodName: "ImportChapters",
+			Handler:    _CmsService_ImportChapters_Handler,
+		},
+		{
+			MethodName: "UploadTranscript",
+			Handler:    _CmsService_UploadTranscript_Handler,
+		},
+		{
+			MethodName: "ListTranscripts",
+			Handler:    _CmsService_ListTranscripts_Handler,
+		},
+		{
+			MethodName: "DeleteTranscript",
+			Handler:    _CmsService_DeleteTranscript_Handler,
+		},
+		{
+			MethodName: "CreatePerson",
+			Handler:    _CmsService_CreatePerson_Handler,
+		},
+		{
+			MethodName: "GetPerson",
+			Handler:    _CmsService_GetPerson_Handler,
+		},
+		{
+			MethodName: "ListPeople",
+			Handler:    _CmsService_ListPeople_Handler,
+		},
+		{
+			MethodName: "UpdatePerson",
+			Handler:    _CmsService_UpdatePerson_Handler,
+		},
+		{
+			MethodName: "DeletePerson",
+			Handler:    _CmsService_DeletePerson_Handler,
+		},
+		{
+			MethodName: "CreateCredit",
+			Handler:    _CmsService_CreateCredit_Handler,
+		},
+		{
+			MethodName: "ListCredits",
+			Handler:    _CmsService_ListCredits_Handler,
+		},
+		{
+			MethodName: "UpdateCredit",
+			Handler:    _CmsService_UpdateCredit_Handler,
+		},
+		{
+			MethodName: "DeleteCredit",
+			Handler:    _CmsService_DeleteCredit_Handler,
+		},
+		{
+			MethodName: "ListCreditsByPerson",
+			Handler:    _CmsService_ListCreditsByPerson_Handler,
+		},
+		{
+			MethodName: "CheckAvailability",
+			Handler:    _CmsService_CheckAvailability_Handler,
+		},
 		{
 			MethodName: "ImportData",
 			Handler:    _CmsService_ImportData_Handler,
@@ -763,6 +2889,12 @@ var CmsService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CmsService_BulkDeletePrograms_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchImport",
+			Handler:       _CmsService_WatchImport_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "v1/cms.proto",
 }
@@ -20,44 +20,154 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationCmsServiceApprove = "/thmanyah.v1.CmsService/Approve"
+const OperationCmsServiceAutocompleteTags = "/thmanyah.v1.CmsService/AutocompleteTags"
+const OperationCmsServiceBatchGetCategories = "/thmanyah.v1.CmsService/BatchGetCategories"
+const OperationCmsServiceBatchGetEpisodes = "/thmanyah.v1.CmsService/BatchGetEpisodes"
+const OperationCmsServiceBatchGetPrograms = "/thmanyah.v1.CmsService/BatchGetPrograms"
 const OperationCmsServiceBulkDeletePrograms = "/thmanyah.v1.CmsService/BulkDeletePrograms"
 const OperationCmsServiceBulkUpdatePrograms = "/thmanyah.v1.CmsService/BulkUpdatePrograms"
+const OperationCmsServiceCancelEpisodeSchedule = "/thmanyah.v1.CmsService/CancelEpisodeSchedule"
+const OperationCmsServiceCheckAvailability = "/thmanyah.v1.CmsService/CheckAvailability"
 const OperationCmsServiceCreateCategory = "/thmanyah.v1.CmsService/CreateCategory"
+const OperationCmsServiceCreateChapter = "/thmanyah.v1.CmsService/CreateChapter"
+const OperationCmsServiceCreateCredit = "/thmanyah.v1.CmsService/CreateCredit"
 const OperationCmsServiceCreateEpisode = "/thmanyah.v1.CmsService/CreateEpisode"
+const OperationCmsServiceCreatePerson = "/thmanyah.v1.CmsService/CreatePerson"
 const OperationCmsServiceCreateProgram = "/thmanyah.v1.CmsService/CreateProgram"
+const OperationCmsServiceCreateSeason = "/thmanyah.v1.CmsService/CreateSeason"
 const OperationCmsServiceDeleteCategory = "/thmanyah.v1.CmsService/DeleteCategory"
+const OperationCmsServiceDeleteChapter = "/thmanyah.v1.CmsService/DeleteChapter"
+const OperationCmsServiceDeleteCredit = "/thmanyah.v1.CmsService/DeleteCredit"
 const OperationCmsServiceDeleteEpisode = "/thmanyah.v1.CmsService/DeleteEpisode"
+const OperationCmsServiceDeletePerson = "/thmanyah.v1.CmsService/DeletePerson"
 const OperationCmsServiceDeleteProgram = "/thmanyah.v1.CmsService/DeleteProgram"
+const OperationCmsServiceDeleteSeason = "/thmanyah.v1.CmsService/DeleteSeason"
+const OperationCmsServiceDeleteTranscript = "/thmanyah.v1.CmsService/DeleteTranscript"
+const OperationCmsServiceDeleteTranslation = "/thmanyah.v1.CmsService/DeleteTranslation"
+const OperationCmsServiceDiffRevisions = "/thmanyah.v1.CmsService/DiffRevisions"
 const OperationCmsServiceGetCategory = "/thmanyah.v1.CmsService/GetCategory"
+const OperationCmsServiceGetCategoryTree = "/thmanyah.v1.CmsService/GetCategoryTree"
 const OperationCmsServiceGetEpisode = "/thmanyah.v1.CmsService/GetEpisode"
+const OperationCmsServiceGetPerson = "/thmanyah.v1.CmsService/GetPerson"
 const OperationCmsServiceGetProgram = "/thmanyah.v1.CmsService/GetProgram"
+const OperationCmsServiceGetRevision = "/thmanyah.v1.CmsService/GetRevision"
+const OperationCmsServiceGetSeason = "/thmanyah.v1.CmsService/GetSeason"
+const OperationCmsServiceImportChapters = "/thmanyah.v1.CmsService/ImportChapters"
 const OperationCmsServiceImportData = "/thmanyah.v1.CmsService/ImportData"
+const OperationCmsServiceListAuditEvents = "/thmanyah.v1.CmsService/ListAuditEvents"
 const OperationCmsServiceListCategories = "/thmanyah.v1.CmsService/ListCategories"
+const OperationCmsServiceListChapters = "/thmanyah.v1.CmsService/ListChapters"
+const OperationCmsServiceListCredits = "/thmanyah.v1.CmsService/ListCredits"
+const OperationCmsServiceListCreditsByPerson = "/thmanyah.v1.CmsService/ListCreditsByPerson"
 const OperationCmsServiceListEpisodes = "/thmanyah.v1.CmsService/ListEpisodes"
+const OperationCmsServiceListPeople = "/thmanyah.v1.CmsService/ListPeople"
 const OperationCmsServiceListPrograms = "/thmanyah.v1.CmsService/ListPrograms"
+const OperationCmsServiceListRevisions = "/thmanyah.v1.CmsService/ListRevisions"
+const OperationCmsServiceListSeasonEpisodes = "/thmanyah.v1.CmsService/ListSeasonEpisodes"
+const OperationCmsServiceListSeasons = "/thmanyah.v1.CmsService/ListSeasons"
+const OperationCmsServiceListStatusTransitions = "/thmanyah.v1.CmsService/ListStatusTransitions"
+const OperationCmsServiceListTags = "/thmanyah.v1.CmsService/ListTags"
+const OperationCmsServiceListTranscripts = "/thmanyah.v1.CmsService/ListTranscripts"
+const OperationCmsServiceListTranslations = "/thmanyah.v1.CmsService/ListTranslations"
+const OperationCmsServiceListTrash = "/thmanyah.v1.CmsService/ListTrash"
+const OperationCmsServiceMergeTags = "/thmanyah.v1.CmsService/MergeTags"
+const OperationCmsServiceMoveCategory = "/thmanyah.v1.CmsService/MoveCategory"
+const OperationCmsServicePurgeTrash = "/thmanyah.v1.CmsService/PurgeTrash"
+const OperationCmsServiceReject = "/thmanyah.v1.CmsService/Reject"
+const OperationCmsServiceRenameTag = "/thmanyah.v1.CmsService/RenameTag"
+const OperationCmsServiceReorderEpisodes = "/thmanyah.v1.CmsService/ReorderEpisodes"
+const OperationCmsServiceReplaceChapters = "/thmanyah.v1.CmsService/ReplaceChapters"
+const OperationCmsServiceRescheduleEpisode = "/thmanyah.v1.CmsService/RescheduleEpisode"
+const OperationCmsServiceRestoreFromTrash = "/thmanyah.v1.CmsService/RestoreFromTrash"
+const OperationCmsServiceRestoreRevision = "/thmanyah.v1.CmsService/RestoreRevision"
+const OperationCmsServiceSetProgramCategories = "/thmanyah.v1.CmsService/SetProgramCategories"
+const OperationCmsServiceSetTranslation = "/thmanyah.v1.CmsService/SetTranslation"
+const OperationCmsServiceSubmitForReview = "/thmanyah.v1.CmsService/SubmitForReview"
 const OperationCmsServiceUpdateCategory = "/thmanyah.v1.CmsService/UpdateCategory"
+const OperationCmsServiceUpdateChapter = "/thmanyah.v1.CmsService/UpdateChapter"
+const OperationCmsServiceUpdateCredit = "/thmanyah.v1.CmsService/UpdateCredit"
 const OperationCmsServiceUpdateEpisode = "/thmanyah.v1.CmsService/UpdateEpisode"
+const OperationCmsServiceUpdatePerson = "/thmanyah.v1.CmsService/UpdatePerson"
 const OperationCmsServiceUpdateProgram = "/thmanyah.v1.CmsService/UpdateProgram"
+const OperationCmsServiceUpdateSeason = "/thmanyah.v1.CmsService/UpdateSeason"
+const OperationCmsServiceUpdateTag = "/thmanyah.v1.CmsService/UpdateTag"
+const OperationCmsServiceUploadTranscript = "/thmanyah.v1.CmsService/UploadTranscript"
 
 type CmsServiceHTTPServer interface {
+	Approve(context.Context, *ApproveRequest) (*ReviewResponse, error)
+	AutocompleteTags(context.Context, *AutocompleteTagsRequest) (*AutocompleteTagsResponse, error)
+	BatchGetCategories(context.Context, *BatchGetCategoriesRequest) (*BatchGetCategoriesResponse, error)
+	BatchGetEpisodes(context.Context, *BatchGetEpisodesRequest) (*BatchGetEpisodesResponse, error)
+	BatchGetPrograms(context.Context, *BatchGetProgramsRequest) (*BatchGetProgramsResponse, error)
 	BulkDeletePrograms(context.Context, *BulkDeleteProgramsRequest) (*emptypb.Empty, error)
 	BulkUpdatePrograms(context.Context, *BulkUpdateProgramsRequest) (*BulkUpdateProgramsResponse, error)
+	CancelEpisodeSchedule(context.Context, *CancelEpisodeScheduleRequest) (*CancelEpisodeScheduleResponse, error)
+	CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error)
 	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	CreateChapter(context.Context, *CreateChapterRequest) (*CreateChapterResponse, error)
+	CreateCredit(context.Context, *CreateCreditRequest) (*CreateCreditResponse, error)
 	CreateEpisode(context.Context, *CreateEpisodeRequest) (*CreateEpisodeResponse, error)
+	CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponse, error)
 	CreateProgram(context.Context, *CreateProgramRequest) (*CreateProgramResponse, error)
+	CreateSeason(context.Context, *CreateSeasonRequest) (*CreateSeasonResponse, error)
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
+	DeleteChapter(context.Context, *DeleteChapterRequest) (*emptypb.Empty, error)
+	DeleteCredit(context.Context, *DeleteCreditRequest) (*emptypb.Empty, error)
 	DeleteEpisode(context.Context, *DeleteEpisodeRequest) (*emptypb.Empty, error)
+	DeletePerson(context.Context, *DeletePersonRequest) (*emptypb.Empty, error)
 	DeleteProgram(context.Context, *DeleteProgramRequest) (*emptypb.Empty, error)
+	DeleteSeason(context.Context, *DeleteSeasonRequest) (*emptypb.Empty, error)
+	DeleteTranscript(context.Context, *DeleteTranscriptRequest) (*emptypb.Empty, error)
+	DeleteTranslation(context.Context, *DeleteTranslationRequest) (*emptypb.Empty, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error)
 	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
+	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
 	GetEpisode(context.Context, *GetEpisodeRequest) (*GetEpisodeResponse, error)
+	GetPerson(context.Context, *GetPersonRequest) (*GetPersonResponse, error)
 	GetProgram(context.Context, *GetProgramRequest) (*GetProgramResponse, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error)
+	GetSeason(context.Context, *GetSeasonRequest) (*GetSeasonResponse, error)
+	ImportChapters(context.Context, *ImportChaptersRequest) (*ImportChaptersResponse, error)
 	ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	ListChapters(context.Context, *ListChaptersRequest) (*ListChaptersResponse, error)
+	ListCredits(context.Context, *ListCreditsRequest) (*ListCreditsResponse, error)
+	ListCreditsByPerson(context.Context, *ListCreditsByPersonRequest) (*ListCreditsByPersonResponse, error)
 	ListEpisodes(context.Context, *ListEpisodesRequest) (*ListEpisodesResponse, error)
+	ListPeople(context.Context, *ListPeopleRequest) (*ListPeopleResponse, error)
 	ListPrograms(context.Context, *ListProgramsRequest) (*ListProgramsResponse, error)
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	ListSeasonEpisodes(context.Context, *ListSeasonEpisodesRequest) (*ListSeasonEpisodesResponse, error)
+	ListSeasons(context.Context, *ListSeasonsRequest) (*ListSeasonsResponse, error)
+	ListStatusTransitions(context.Context, *ListStatusTransitionsRequest) (*ListStatusTransitionsResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	ListTranscripts(context.Context, *ListTranscriptsRequest) (*ListTranscriptsResponse, error)
+	ListTranslations(context.Context, *ListTranslationsRequest) (*ListTranslationsResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
+	MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error)
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	Reject(context.Context, *RejectRequest) (*ReviewResponse, error)
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	ReorderEpisodes(context.Context, *ReorderEpisodesRequest) (*ReorderEpisodesResponse, error)
+	ReplaceChapters(context.Context, *ReplaceChaptersRequest) (*ReplaceChaptersResponse, error)
+	RescheduleEpisode(context.Context, *RescheduleEpisodeRequest) (*RescheduleEpisodeResponse, error)
+	RestoreFromTrash(context.Context, *RestoreFromTrashRequest) (*RestoreFromTrashResponse, error)
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error)
+	SetProgramCategories(context.Context, *SetProgramCategoriesRequest) (*SetProgramCategoriesResponse, error)
+	SetTranslation(context.Context, *SetTranslationRequest) (*SetTranslationResponse, error)
+	SubmitForReview(context.Context, *SubmitForReviewRequest) (*ReviewResponse, error)
 	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	UpdateChapter(context.Context, *UpdateChapterRequest) (*UpdateChapterResponse, error)
+	UpdateCredit(context.Context, *UpdateCreditRequest) (*UpdateCreditResponse, error)
 	UpdateEpisode(context.Context, *UpdateEpisodeRequest) (*UpdateEpisodeResponse, error)
+	UpdatePerson(context.Context, *UpdatePersonRequest) (*UpdatePersonResponse, error)
 	UpdateProgram(context.Context, *UpdateProgramRequest) (*UpdateProgramResponse, error)
+	UpdateSeason(context.Context, *UpdateSeasonRequest) (*UpdateSeasonResponse, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
+	UploadTranscript(context.Context, *UploadTranscriptRequest) (*UploadTranscriptResponse, error)
 }
 
 func RegisterCmsServiceHTTPServer(s *http.Server, srv CmsServiceHTTPServer) {
@@ -67,16 +177,71 @@ func RegisterCmsServiceHTTPServer(s *http.Server, srv CmsServiceHTTPServer) {
 	r.DELETE("/api/v1/cms/programs/{program_id}", _CmsService_DeleteProgram0_HTTP_Handler(srv))
 	r.GET("/api/v1/cms/programs/{program_id}", _CmsService_GetProgram0_HTTP_Handler(srv))
 	r.GET("/api/v1/cms/programs", _CmsService_ListPrograms0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/programs/batch-get", _CmsService_BatchGetPrograms0_HTTP_Handler(srv))
 	r.POST("/api/v1/cms/categories", _CmsService_CreateCategory0_HTTP_Handler(srv))
 	r.PUT("/api/v1/cms/categories/{category_id}", _CmsService_UpdateCategory0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/cms/categories/{category_id}", _CmsService_DeleteCategory0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/categories/tree", _CmsService_GetCategoryTree0_HTTP_Handler(srv))
 	r.GET("/api/v1/cms/categories/{category_id}", _CmsService_GetCategory0_HTTP_Handler(srv))
 	r.GET("/api/v1/cms/categories", _CmsService_ListCategories0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/categories/batch-get", _CmsService_BatchGetCategories0_HTTP_Handler(srv))
 	r.POST("/api/v1/cms/episodes", _CmsService_CreateEpisode0_HTTP_Handler(srv))
 	r.PUT("/api/v1/cms/episodes/{episode_id}", _CmsService_UpdateEpisode0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/cms/episodes/{episode_id}", _CmsService_DeleteEpisode0_HTTP_Handler(srv))
 	r.GET("/api/v1/cms/episodes/{episode_id}", _CmsService_GetEpisode0_HTTP_Handler(srv))
 	r.GET("/api/v1/cms/programs/{program_id}/episodes", _CmsService_ListEpisodes0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/episodes/batch-get", _CmsService_BatchGetEpisodes0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/episodes/{episode_id}/reschedule", _CmsService_RescheduleEpisode0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/episodes/{episode_id}/cancel-schedule", _CmsService_CancelEpisodeSchedule0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/reviews/submit", _CmsService_SubmitForReview0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/reviews/approve", _CmsService_Approve0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/reviews/reject", _CmsService_Reject0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/reviews/transitions", _CmsService_ListStatusTransitions0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/revisions", _CmsService_ListRevisions0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/revisions/{revision_id}", _CmsService_GetRevision0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/revisions/{from_revision_id}/diff/{to_revision_id}", _CmsService_DiffRevisions0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/revisions/{revision_id}/restore", _CmsService_RestoreRevision0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/trash", _CmsService_ListTrash0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/trash/restore", _CmsService_RestoreFromTrash0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/trash/purge", _CmsService_PurgeTrash0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/audit/events", _CmsService_ListAuditEvents0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/categories/{category_id}/move", _CmsService_MoveCategory0_HTTP_Handler(srv))
+	r.PUT("/api/v1/cms/programs/{program_id}/categories", _CmsService_SetProgramCategories0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/tags", _CmsService_ListTags0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/tags/autocomplete", _CmsService_AutocompleteTags0_HTTP_Handler(srv))
+	r.PUT("/api/v1/cms/tags/{tag_id}", _CmsService_UpdateTag0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/tags/{tag_id}/rename", _CmsService_RenameTag0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/tags/{tag_id}/merge", _CmsService_MergeTags0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/translations", _CmsService_ListTranslations0_HTTP_Handler(srv))
+	r.PUT("/api/v1/cms/translations/{locale}", _CmsService_SetTranslation0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/cms/translations/{locale}", _CmsService_DeleteTranslation0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/programs/{program_id}/seasons", _CmsService_CreateSeason0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/programs/{program_id}/seasons", _CmsService_ListSeasons0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/seasons/{season_id}", _CmsService_GetSeason0_HTTP_Handler(srv))
+	r.PUT("/api/v1/cms/seasons/{season_id}", _CmsService_UpdateSeason0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/cms/seasons/{season_id}", _CmsService_DeleteSeason0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/seasons/{season_id}/episodes/reorder", _CmsService_ReorderEpisodes0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/seasons/{season_id}/episodes", _CmsService_ListSeasonEpisodes0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/episodes/{episode_id}/chapters", _CmsService_ListChapters0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/episodes/{episode_id}/chapters", _CmsService_CreateChapter0_HTTP_Handler(srv))
+	r.PUT("/api/v1/cms/chapters/{chapter_id}", _CmsService_UpdateChapter0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/cms/chapters/{chapter_id}", _CmsService_DeleteChapter0_HTTP_Handler(srv))
+	r.PUT("/api/v1/cms/episodes/{episode_id}/chapters", _CmsService_ReplaceChapters0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/episodes/{episode_id}/chapters/import", _CmsService_ImportChapters0_HTTP_Handler(srv))
+	r.PUT("/api/v1/cms/episodes/{episode_id}/transcripts/{locale}", _CmsService_UploadTranscript0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/episodes/{episode_id}/transcripts", _CmsService_ListTranscripts0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/cms/episodes/{episode_id}/transcripts/{locale}", _CmsService_DeleteTranscript0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/people", _CmsService_CreatePerson0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/people/{person_id}", _CmsService_GetPerson0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/people", _CmsService_ListPeople0_HTTP_Handler(srv))
+	r.PUT("/api/v1/cms/people/{person_id}", _CmsService_UpdatePerson0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/cms/people/{person_id}", _CmsService_DeletePerson0_HTTP_Handler(srv))
+	r.POST("/api/v1/cms/credits", _CmsService_CreateCredit0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/credits", _CmsService_ListCredits0_HTTP_Handler(srv))
+	r.PUT("/api/v1/cms/credits/{credit_id}", _CmsService_UpdateCredit0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/cms/credits/{credit_id}", _CmsService_DeleteCredit0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/people/{person_id}/credits", _CmsService_ListCreditsByPerson0_HTTP_Handler(srv))
+	r.GET("/api/v1/cms/availability", _CmsService_CheckAvailability0_HTTP_Handler(srv))
 	r.POST("/api/v1/cms/import", _CmsService_ImportData0_HTTP_Handler(srv))
 	r.POST("/api/v1/cms/programs/bulk-update", _CmsService_BulkUpdatePrograms0_HTTP_Handler(srv))
 	r.POST("/api/v1/cms/programs/bulk-delete", _CmsService_BulkDeletePrograms0_HTTP_Handler(srv))
@@ -192,6 +357,28 @@ func _CmsService_ListPrograms0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx h
 	}
 }
 
+func _CmsService_BatchGetPrograms0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGetProgramsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceBatchGetPrograms)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetPrograms(ctx, req.(*BatchGetProgramsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchGetProgramsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _CmsService_CreateCategory0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateCategoryRequest
@@ -261,6 +448,25 @@ func _CmsService_DeleteCategory0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx
 	}
 }
 
+func _CmsService_GetCategoryTree0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCategoryTreeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceGetCategoryTree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCategoryTree(ctx, req.(*GetCategoryTreeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCategoryTreeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _CmsService_GetCategory0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetCategoryRequest
@@ -302,6 +508,28 @@ func _CmsService_ListCategories0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx
 	}
 }
 
+func _CmsService_BatchGetCategories0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGetCategoriesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceBatchGetCategories)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetCategories(ctx, req.(*BatchGetCategoriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchGetCategoriesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _CmsService_CreateEpisode0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateEpisodeRequest
@@ -415,210 +643,1865 @@ func _CmsService_ListEpisodes0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx h
 	}
 }
 
-func _CmsService_ImportData0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+func _CmsService_BatchGetEpisodes0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ImportDataRequest
+		var in BatchGetEpisodesRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationCmsServiceImportData)
+		http.SetOperation(ctx, OperationCmsServiceBatchGetEpisodes)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ImportData(ctx, req.(*ImportDataRequest))
+			return srv.BatchGetEpisodes(ctx, req.(*BatchGetEpisodesRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ImportDataResponse)
+		reply := out.(*BatchGetEpisodesResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _CmsService_BulkUpdatePrograms0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+func _CmsService_RescheduleEpisode0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in BulkUpdateProgramsRequest
+		var in RescheduleEpisodeRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationCmsServiceBulkUpdatePrograms)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceRescheduleEpisode)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.BulkUpdatePrograms(ctx, req.(*BulkUpdateProgramsRequest))
+			return srv.RescheduleEpisode(ctx, req.(*RescheduleEpisodeRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*BulkUpdateProgramsResponse)
+		reply := out.(*RescheduleEpisodeResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _CmsService_BulkDeletePrograms0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+func _CmsService_CancelEpisodeSchedule0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in BulkDeleteProgramsRequest
+		var in CancelEpisodeScheduleRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationCmsServiceBulkDeletePrograms)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceCancelEpisodeSchedule)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.BulkDeletePrograms(ctx, req.(*BulkDeleteProgramsRequest))
+			return srv.CancelEpisodeSchedule(ctx, req.(*CancelEpisodeScheduleRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*emptypb.Empty)
+		reply := out.(*CancelEpisodeScheduleResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-type CmsServiceHTTPClient interface {
-	BulkDeletePrograms(ctx context.Context, req *BulkDeleteProgramsRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	BulkUpdatePrograms(ctx context.Context, req *BulkUpdateProgramsRequest, opts ...http.CallOption) (rsp *BulkUpdateProgramsResponse, err error)
-	CreateCategory(ctx context.Context, req *CreateCategoryRequest, opts ...http.CallOption) (rsp *CreateCategoryResponse, err error)
-	CreateEpisode(ctx context.Context, req *CreateEpisodeRequest, opts ...http.CallOption) (rsp *CreateEpisodeResponse, err error)
-	CreateProgram(ctx context.Context, req *CreateProgramRequest, opts ...http.CallOption) (rsp *CreateProgramResponse, err error)
-	DeleteCategory(ctx context.Context, req *DeleteCategoryRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	DeleteEpisode(ctx context.Context, req *DeleteEpisodeRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	DeleteProgram(ctx context.Context, req *DeleteProgramRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	GetCategory(ctx context.Context, req *GetCategoryRequest, opts ...http.CallOption) (rsp *GetCategoryResponse, err error)
-	GetEpisode(ctx context.Context, req *GetEpisodeRequest, opts ...http.CallOption) (rsp *GetEpisodeResponse, err error)
-	GetProgram(ctx context.Context, req *GetProgramRequest, opts ...http.CallOption) (rsp *GetProgramResponse, err error)
-	ImportData(ctx context.Context, req *ImportDataRequest, opts ...http.CallOption) (rsp *ImportDataResponse, err error)
-	ListCategories(ctx context.Context, req *ListCategoriesRequest, opts ...http.CallOption) (rsp *ListCategoriesResponse, err error)
-	ListEpisodes(ctx context.Context, req *ListEpisodesRequest, opts ...http.CallOption) (rsp *ListEpisodesResponse, err error)
-	ListPrograms(ctx context.Context, req *ListProgramsRequest, opts ...http.CallOption) (rsp *ListProgramsResponse, err error)
-	UpdateCategory(ctx context.Context, req *UpdateCategoryRequest, opts ...http.CallOption) (rsp *UpdateCategoryResponse, err error)
-	UpdateEpisode(ctx context.Context, req *UpdateEpisodeRequest, opts ...http.CallOption) (rsp *UpdateEpisodeResponse, err error)
-	UpdateProgram(ctx context.Context, req *UpdateProgramRequest, opts ...http.CallOption) (rsp *UpdateProgramResponse, err error)
+func _CmsService_SubmitForReview0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubmitForReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceSubmitForReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitForReview(ctx, req.(*SubmitForReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReviewResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-type CmsServiceHTTPClientImpl struct {
-	cc *http.Client
+func _CmsService_Approve0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApproveRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceApprove)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Approve(ctx, req.(*ApproveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReviewResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewCmsServiceHTTPClient(client *http.Client) CmsServiceHTTPClient {
-	return &CmsServiceHTTPClientImpl{client}
+func _CmsService_Reject0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RejectRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceReject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Reject(ctx, req.(*RejectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReviewResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-func (c *CmsServiceHTTPClientImpl) BulkDeletePrograms(ctx context.Context, in *BulkDeleteProgramsRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/api/v1/cms/programs/bulk-delete"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationCmsServiceBulkDeletePrograms))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _CmsService_ListStatusTransitions0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListStatusTransitionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListStatusTransitions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListStatusTransitions(ctx, req.(*ListStatusTransitionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListStatusTransitionsResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) BulkUpdatePrograms(ctx context.Context, in *BulkUpdateProgramsRequest, opts ...http.CallOption) (*BulkUpdateProgramsResponse, error) {
-	var out BulkUpdateProgramsResponse
-	pattern := "/api/v1/cms/programs/bulk-update"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationCmsServiceBulkUpdatePrograms))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _CmsService_ListRevisions0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRevisions(ctx, req.(*ListRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRevisionsResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...http.CallOption) (*CreateCategoryResponse, error) {
-	var out CreateCategoryResponse
-	pattern := "/api/v1/cms/categories"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationCmsServiceCreateCategory))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _CmsService_GetRevision0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRevisionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceGetRevision)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRevision(ctx, req.(*GetRevisionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRevisionResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) CreateEpisode(ctx context.Context, in *CreateEpisodeRequest, opts ...http.CallOption) (*CreateEpisodeResponse, error) {
-	var out CreateEpisodeResponse
-	pattern := "/api/v1/cms/episodes"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationCmsServiceCreateEpisode))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _CmsService_DiffRevisions0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DiffRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceDiffRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DiffRevisionsResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) CreateProgram(ctx context.Context, in *CreateProgramRequest, opts ...http.CallOption) (*CreateProgramResponse, error) {
-	var out CreateProgramResponse
-	pattern := "/api/v1/cms/programs"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationCmsServiceCreateProgram))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _CmsService_RestoreRevision0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreRevisionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceRestoreRevision)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreRevisionResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
+func _CmsService_ListTrash0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTrashRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListTrash)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTrash(ctx, req.(*ListTrashRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTrashResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_RestoreFromTrash0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreFromTrashRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceRestoreFromTrash)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreFromTrash(ctx, req.(*RestoreFromTrashRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreFromTrashResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_PurgeTrash0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeTrashRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServicePurgeTrash)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeTrash(ctx, req.(*PurgeTrashRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurgeTrashResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ListAuditEvents0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAuditEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListAuditEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAuditEventsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_MoveCategory0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MoveCategoryRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceMoveCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MoveCategory(ctx, req.(*MoveCategoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MoveCategoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_SetProgramCategories0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetProgramCategoriesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceSetProgramCategories)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetProgramCategories(ctx, req.(*SetProgramCategoriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetProgramCategoriesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ListTags0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTags(ctx, req.(*ListTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTagsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_AutocompleteTags0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AutocompleteTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceAutocompleteTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AutocompleteTags(ctx, req.(*AutocompleteTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AutocompleteTagsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_UpdateTag0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceUpdateTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTag(ctx, req.(*UpdateTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_RenameTag0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenameTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceRenameTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenameTag(ctx, req.(*RenameTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenameTagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_MergeTags0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MergeTagsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceMergeTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MergeTags(ctx, req.(*MergeTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MergeTagsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ListTranslations0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTranslationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListTranslations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTranslations(ctx, req.(*ListTranslationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTranslationsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_SetTranslation0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetTranslationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceSetTranslation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetTranslation(ctx, req.(*SetTranslationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetTranslationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_DeleteTranslation0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTranslationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceDeleteTranslation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTranslation(ctx, req.(*DeleteTranslationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_CreateSeason0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateSeasonRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceCreateSeason)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSeason(ctx, req.(*CreateSeasonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateSeasonResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ListSeasons0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSeasonsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListSeasons)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSeasons(ctx, req.(*ListSeasonsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSeasonsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_GetSeason0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSeasonRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceGetSeason)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSeason(ctx, req.(*GetSeasonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSeasonResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_UpdateSeason0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateSeasonRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceUpdateSeason)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSeason(ctx, req.(*UpdateSeasonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateSeasonResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_DeleteSeason0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSeasonRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceDeleteSeason)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSeason(ctx, req.(*DeleteSeasonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ReorderEpisodes0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReorderEpisodesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceReorderEpisodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReorderEpisodes(ctx, req.(*ReorderEpisodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReorderEpisodesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ListSeasonEpisodes0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSeasonEpisodesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListSeasonEpisodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSeasonEpisodes(ctx, req.(*ListSeasonEpisodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSeasonEpisodesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ListChapters0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListChaptersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListChapters)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListChapters(ctx, req.(*ListChaptersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListChaptersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_CreateChapter0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateChapterRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceCreateChapter)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateChapter(ctx, req.(*CreateChapterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateChapterResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_UpdateChapter0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateChapterRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceUpdateChapter)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateChapter(ctx, req.(*UpdateChapterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateChapterResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_DeleteChapter0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteChapterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceDeleteChapter)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteChapter(ctx, req.(*DeleteChapterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ReplaceChapters0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReplaceChaptersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceReplaceChapters)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReplaceChapters(ctx, req.(*ReplaceChaptersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReplaceChaptersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ImportChapters0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportChaptersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceImportChapters)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportChapters(ctx, req.(*ImportChaptersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportChaptersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_UploadTranscript0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UploadTranscriptRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceUploadTranscript)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UploadTranscript(ctx, req.(*UploadTranscriptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UploadTranscriptResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ListTranscripts0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTranscriptsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListTranscripts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTranscripts(ctx, req.(*ListTranscriptsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTranscriptsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_DeleteTranscript0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTranscriptRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceDeleteTranscript)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTranscript(ctx, req.(*DeleteTranscriptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_CreatePerson0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreatePersonRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceCreatePerson)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreatePerson(ctx, req.(*CreatePersonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreatePersonResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_GetPerson0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPersonRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceGetPerson)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPerson(ctx, req.(*GetPersonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPersonResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ListPeople0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPeopleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListPeople)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPeople(ctx, req.(*ListPeopleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPeopleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_UpdatePerson0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePersonRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceUpdatePerson)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePerson(ctx, req.(*UpdatePersonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdatePersonResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_DeletePerson0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeletePersonRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceDeletePerson)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeletePerson(ctx, req.(*DeletePersonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_CreateCredit0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCreditRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceCreateCredit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCredit(ctx, req.(*CreateCreditRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateCreditResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ListCredits0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCreditsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListCredits)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCredits(ctx, req.(*ListCreditsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCreditsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_UpdateCredit0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCreditRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceUpdateCredit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCredit(ctx, req.(*UpdateCreditRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateCreditResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_DeleteCredit0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCreditRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceDeleteCredit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCredit(ctx, req.(*DeleteCreditRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ListCreditsByPerson0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCreditsByPersonRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceListCreditsByPerson)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCreditsByPerson(ctx, req.(*ListCreditsByPersonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCreditsByPersonResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_CheckAvailability0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckAvailabilityRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceCheckAvailability)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckAvailability(ctx, req.(*CheckAvailabilityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckAvailabilityResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_ImportData0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportDataRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceImportData)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportData(ctx, req.(*ImportDataRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportDataResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_BulkUpdatePrograms0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BulkUpdateProgramsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceBulkUpdatePrograms)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BulkUpdatePrograms(ctx, req.(*BulkUpdateProgramsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkUpdateProgramsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CmsService_BulkDeletePrograms0_HTTP_Handler(srv CmsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BulkDeleteProgramsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCmsServiceBulkDeletePrograms)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BulkDeletePrograms(ctx, req.(*BulkDeleteProgramsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+type CmsServiceHTTPClient interface {
+	Approve(ctx context.Context, req *ApproveRequest, opts ...http.CallOption) (rsp *ReviewResponse, err error)
+	AutocompleteTags(ctx context.Context, req *AutocompleteTagsRequest, opts ...http.CallOption) (rsp *AutocompleteTagsResponse, err error)
+	BatchGetCategories(ctx context.Context, req *BatchGetCategoriesRequest, opts ...http.CallOption) (rsp *BatchGetCategoriesResponse, err error)
+	BatchGetEpisodes(ctx context.Context, req *BatchGetEpisodesRequest, opts ...http.CallOption) (rsp *BatchGetEpisodesResponse, err error)
+	BatchGetPrograms(ctx context.Context, req *BatchGetProgramsRequest, opts ...http.CallOption) (rsp *BatchGetProgramsResponse, err error)
+	BulkDeletePrograms(ctx context.Context, req *BulkDeleteProgramsRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	BulkUpdatePrograms(ctx context.Context, req *BulkUpdateProgramsRequest, opts ...http.CallOption) (rsp *BulkUpdateProgramsResponse, err error)
+	CancelEpisodeSchedule(ctx context.Context, req *CancelEpisodeScheduleRequest, opts ...http.CallOption) (rsp *CancelEpisodeScheduleResponse, err error)
+	CheckAvailability(ctx context.Context, req *CheckAvailabilityRequest, opts ...http.CallOption) (rsp *CheckAvailabilityResponse, err error)
+	CreateCategory(ctx context.Context, req *CreateCategoryRequest, opts ...http.CallOption) (rsp *CreateCategoryResponse, err error)
+	CreateChapter(ctx context.Context, req *CreateChapterRequest, opts ...http.CallOption) (rsp *CreateChapterResponse, err error)
+	CreateCredit(ctx context.Context, req *CreateCreditRequest, opts ...http.CallOption) (rsp *CreateCreditResponse, err error)
+	CreateEpisode(ctx context.Context, req *CreateEpisodeRequest, opts ...http.CallOption) (rsp *CreateEpisodeResponse, err error)
+	CreatePerson(ctx context.Context, req *CreatePersonRequest, opts ...http.CallOption) (rsp *CreatePersonResponse, err error)
+	CreateProgram(ctx context.Context, req *CreateProgramRequest, opts ...http.CallOption) (rsp *CreateProgramResponse, err error)
+	CreateSeason(ctx context.Context, req *CreateSeasonRequest, opts ...http.CallOption) (rsp *CreateSeasonResponse, err error)
+	DeleteCategory(ctx context.Context, req *DeleteCategoryRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteChapter(ctx context.Context, req *DeleteChapterRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteCredit(ctx context.Context, req *DeleteCreditRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteEpisode(ctx context.Context, req *DeleteEpisodeRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeletePerson(ctx context.Context, req *DeletePersonRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteProgram(ctx context.Context, req *DeleteProgramRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteSeason(ctx context.Context, req *DeleteSeasonRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteTranscript(ctx context.Context, req *DeleteTranscriptRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteTranslation(ctx context.Context, req *DeleteTranslationRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DiffRevisions(ctx context.Context, req *DiffRevisionsRequest, opts ...http.CallOption) (rsp *DiffRevisionsResponse, err error)
+	GetCategory(ctx context.Context, req *GetCategoryRequest, opts ...http.CallOption) (rsp *GetCategoryResponse, err error)
+	GetCategoryTree(ctx context.Context, req *GetCategoryTreeRequest, opts ...http.CallOption) (rsp *GetCategoryTreeResponse, err error)
+	GetEpisode(ctx context.Context, req *GetEpisodeRequest, opts ...http.CallOption) (rsp *GetEpisodeResponse, err error)
+	GetPerson(ctx context.Context, req *GetPersonRequest, opts ...http.CallOption) (rsp *GetPersonResponse, err error)
+	GetProgram(ctx context.Context, req *GetProgramRequest, opts ...http.CallOption) (rsp *GetProgramResponse, err error)
+	GetRevision(ctx context.Context, req *GetRevisionRequest, opts ...http.CallOption) (rsp *GetRevisionResponse, err error)
+	GetSeason(ctx context.Context, req *GetSeasonRequest, opts ...http.CallOption) (rsp *GetSeasonResponse, err error)
+	ImportChapters(ctx context.Context, req *ImportChaptersRequest, opts ...http.CallOption) (rsp *ImportChaptersResponse, err error)
+	ImportData(ctx context.Context, req *ImportDataRequest, opts ...http.CallOption) (rsp *ImportDataResponse, err error)
+	ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest, opts ...http.CallOption) (rsp *ListAuditEventsResponse, err error)
+	ListCategories(ctx context.Context, req *ListCategoriesRequest, opts ...http.CallOption) (rsp *ListCategoriesResponse, err error)
+	ListChapters(ctx context.Context, req *ListChaptersRequest, opts ...http.CallOption) (rsp *ListChaptersResponse, err error)
+	ListCredits(ctx context.Context, req *ListCreditsRequest, opts ...http.CallOption) (rsp *ListCreditsResponse, err error)
+	ListCreditsByPerson(ctx context.Context, req *ListCreditsByPersonRequest, opts ...http.CallOption) (rsp *ListCreditsByPersonResponse, err error)
+	ListEpisodes(ctx context.Context, req *ListEpisodesRequest, opts ...http.CallOption) (rsp *ListEpisodesResponse, err error)
+	ListPeople(ctx context.Context, req *ListPeopleRequest, opts ...http.CallOption) (rsp *ListPeopleResponse, err error)
+	ListPrograms(ctx context.Context, req *ListProgramsRequest, opts ...http.CallOption) (rsp *ListProgramsResponse, err error)
+	ListRevisions(ctx context.Context, req *ListRevisionsRequest, opts ...http.CallOption) (rsp *ListRevisionsResponse, err error)
+	ListSeasonEpisodes(ctx context.Context, req *ListSeasonEpisodesRequest, opts ...http.CallOption) (rsp *ListSeasonEpisodesResponse, err error)
+	ListSeasons(ctx context.Context, req *ListSeasonsRequest, opts ...http.CallOption) (rsp *ListSeasonsResponse, err error)
+	ListStatusTransitions(ctx context.Context, req *ListStatusTransitionsRequest, opts ...http.CallOption) (rsp *ListStatusTransitionsResponse, err error)
+	ListTags(ctx context.Context, req *ListTagsRequest, opts ...http.CallOption) (rsp *ListTagsResponse, err error)
+	ListTranscripts(ctx context.Context, req *ListTranscriptsRequest, opts ...http.CallOption) (rsp *ListTranscriptsResponse, err error)
+	ListTranslations(ctx context.Context, req *ListTranslationsRequest, opts ...http.CallOption) (rsp *ListTranslationsResponse, err error)
+	ListTrash(ctx context.Context, req *ListTrashRequest, opts ...http.CallOption) (rsp *ListTrashResponse, err error)
+	MergeTags(ctx context.Context, req *MergeTagsRequest, opts ...http.CallOption) (rsp *MergeTagsResponse, err error)
+	MoveCategory(ctx context.Context, req *MoveCategoryRequest, opts ...http.CallOption) (rsp *MoveCategoryResponse, err error)
+	PurgeTrash(ctx context.Context, req *PurgeTrashRequest, opts ...http.CallOption) (rsp *PurgeTrashResponse, err error)
+	Reject(ctx context.Context, req *RejectRequest, opts ...http.CallOption) (rsp *ReviewResponse, err error)
+	RenameTag(ctx context.Context, req *RenameTagRequest, opts ...http.CallOption) (rsp *RenameTagResponse, err error)
+	ReorderEpisodes(ctx context.Context, req *ReorderEpisodesRequest, opts ...http.CallOption) (rsp *ReorderEpisodesResponse, err error)
+	ReplaceChapters(ctx context.Context, req *ReplaceChaptersRequest, opts ...http.CallOption) (rsp *ReplaceChaptersResponse, err error)
+	RescheduleEpisode(ctx context.Context, req *RescheduleEpisodeRequest, opts ...http.CallOption) (rsp *RescheduleEpisodeResponse, err error)
+	RestoreFromTrash(ctx context.Context, req *RestoreFromTrashRequest, opts ...http.CallOption) (rsp *RestoreFromTrashResponse, err error)
+	RestoreRevision(ctx context.Context, req *RestoreRevisionRequest, opts ...http.CallOption) (rsp *RestoreRevisionResponse, err error)
+	SetProgramCategories(ctx context.Context, req *SetProgramCategoriesRequest, opts ...http.CallOption) (rsp *SetProgramCategoriesResponse, err error)
+	SetTranslation(ctx context.Context, req *SetTranslationRequest, opts ...http.CallOption) (rsp *SetTranslationResponse, err error)
+	SubmitForReview(ctx context.Context, req *SubmitForReviewRequest, opts ...http.CallOption) (rsp *ReviewResponse, err error)
+	UpdateCategory(ctx context.Context, req *UpdateCategoryRequest, opts ...http.CallOption) (rsp *UpdateCategoryResponse, err error)
+	UpdateChapter(ctx context.Context, req *UpdateChapterRequest, opts ...http.CallOption) (rsp *UpdateChapterResponse, err error)
+	UpdateCredit(ctx context.Context, req *UpdateCreditRequest, opts ...http.CallOption) (rsp *UpdateCreditResponse, err error)
+	UpdateEpisode(ctx context.Context, req *UpdateEpisodeRequest, opts ...http.CallOption) (rsp *UpdateEpisodeResponse, err error)
+	UpdatePerson(ctx context.Context, req *UpdatePersonRequest, opts ...http.CallOption) (rsp *UpdatePersonResponse, err error)
+	UpdateProgram(ctx context.Context, req *UpdateProgramRequest, opts ...http.CallOption) (rsp *UpdateProgramResponse, err error)
+	UpdateSeason(ctx context.Context, req *UpdateSeasonRequest, opts ...http.CallOption) (rsp *UpdateSeasonResponse, err error)
+	UpdateTag(ctx context.Context, req *UpdateTagRequest, opts ...http.CallOption) (rsp *UpdateTagResponse, err error)
+	UploadTranscript(ctx context.Context, req *UploadTranscriptRequest, opts ...http.CallOption) (rsp *UploadTranscriptResponse, err error)
+}
+
+type CmsServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewCmsServiceHTTPClient(client *http.Client) CmsServiceHTTPClient {
+	return &CmsServiceHTTPClientImpl{client}
+}
+
+func (c *CmsServiceHTTPClientImpl) Approve(ctx context.Context, in *ApproveRequest, opts ...http.CallOption) (*ReviewResponse, error) {
+	var out ReviewResponse
+	pattern := "/api/v1/cms/reviews/approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceApprove))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...http.CallOption) (*AutocompleteTagsResponse, error) {
+	var out AutocompleteTagsResponse
+	pattern := "/api/v1/cms/tags/autocomplete"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceAutocompleteTags))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) BatchGetCategories(ctx context.Context, in *BatchGetCategoriesRequest, opts ...http.CallOption) (*BatchGetCategoriesResponse, error) {
+	var out BatchGetCategoriesResponse
+	pattern := "/api/v1/cms/categories/batch-get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceBatchGetCategories))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) BatchGetEpisodes(ctx context.Context, in *BatchGetEpisodesRequest, opts ...http.CallOption) (*BatchGetEpisodesResponse, error) {
+	var out BatchGetEpisodesResponse
+	pattern := "/api/v1/cms/episodes/batch-get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceBatchGetEpisodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) BatchGetPrograms(ctx context.Context, in *BatchGetProgramsRequest, opts ...http.CallOption) (*BatchGetProgramsResponse, error) {
+	var out BatchGetProgramsResponse
+	pattern := "/api/v1/cms/programs/batch-get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceBatchGetPrograms))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) BulkDeletePrograms(ctx context.Context, in *BulkDeleteProgramsRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/cms/programs/bulk-delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceBulkDeletePrograms))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) BulkUpdatePrograms(ctx context.Context, in *BulkUpdateProgramsRequest, opts ...http.CallOption) (*BulkUpdateProgramsResponse, error) {
+	var out BulkUpdateProgramsResponse
+	pattern := "/api/v1/cms/programs/bulk-update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceBulkUpdatePrograms))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) CancelEpisodeSchedule(ctx context.Context, in *CancelEpisodeScheduleRequest, opts ...http.CallOption) (*CancelEpisodeScheduleResponse, error) {
+	var out CancelEpisodeScheduleResponse
+	pattern := "/api/v1/cms/episodes/{episode_id}/cancel-schedule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceCancelEpisodeSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...http.CallOption) (*CheckAvailabilityResponse, error) {
+	var out CheckAvailabilityResponse
+	pattern := "/api/v1/cms/availability"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceCheckAvailability))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...http.CallOption) (*CreateCategoryResponse, error) {
+	var out CreateCategoryResponse
+	pattern := "/api/v1/cms/categories"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceCreateCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) CreateChapter(ctx context.Context, in *CreateChapterRequest, opts ...http.CallOption) (*CreateChapterResponse, error) {
+	var out CreateChapterResponse
+	pattern := "/api/v1/cms/episodes/{episode_id}/chapters"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceCreateChapter))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) CreateCredit(ctx context.Context, in *CreateCreditRequest, opts ...http.CallOption) (*CreateCreditResponse, error) {
+	var out CreateCreditResponse
+	pattern := "/api/v1/cms/credits"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceCreateCredit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) CreateEpisode(ctx context.Context, in *CreateEpisodeRequest, opts ...http.CallOption) (*CreateEpisodeResponse, error) {
+	var out CreateEpisodeResponse
+	pattern := "/api/v1/cms/episodes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceCreateEpisode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...http.CallOption) (*CreatePersonResponse, error) {
+	var out CreatePersonResponse
+	pattern := "/api/v1/cms/people"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceCreatePerson))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) CreateProgram(ctx context.Context, in *CreateProgramRequest, opts ...http.CallOption) (*CreateProgramResponse, error) {
+	var out CreateProgramResponse
+	pattern := "/api/v1/cms/programs"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceCreateProgram))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...http.CallOption) (*CreateSeasonResponse, error) {
+	var out CreateSeasonResponse
+	pattern := "/api/v1/cms/programs/{program_id}/seasons"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceCreateSeason))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/cms/categories/{category_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceDeleteCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) DeleteChapter(ctx context.Context, in *DeleteChapterRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/cms/chapters/{chapter_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceDeleteChapter))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) DeleteCredit(ctx context.Context, in *DeleteCreditRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/cms/credits/{credit_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceDeleteCredit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) DeleteEpisode(ctx context.Context, in *DeleteEpisodeRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/cms/episodes/{episode_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceDeleteEpisode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/cms/people/{person_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceDeletePerson))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) DeleteProgram(ctx context.Context, in *DeleteProgramRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/cms/programs/{program_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceDeleteProgram))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) DeleteSeason(ctx context.Context, in *DeleteSeasonRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/cms/seasons/{season_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceDeleteSeason))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) DeleteTranscript(ctx context.Context, in *DeleteTranscriptRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/cms/episodes/{episode_id}/transcripts/{locale}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceDeleteTranscript))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) DeleteTranslation(ctx context.Context, in *DeleteTranslationRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/cms/translations/{locale}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceDeleteTranslation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...http.CallOption) (*DiffRevisionsResponse, error) {
+	var out DiffRevisionsResponse
+	pattern := "/api/v1/cms/revisions/{from_revision_id}/diff/{to_revision_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceDiffRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...http.CallOption) (*GetCategoryResponse, error) {
+	var out GetCategoryResponse
 	pattern := "/api/v1/cms/categories/{category_id}"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCmsServiceDeleteCategory))
+	opts = append(opts, http.Operation(OperationCmsServiceGetCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...http.CallOption) (*GetCategoryTreeResponse, error) {
+	var out GetCategoryTreeResponse
+	pattern := "/api/v1/cms/categories/tree"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceGetCategoryTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) GetEpisode(ctx context.Context, in *GetEpisodeRequest, opts ...http.CallOption) (*GetEpisodeResponse, error) {
+	var out GetEpisodeResponse
+	pattern := "/api/v1/cms/episodes/{episode_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceGetEpisode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) GetPerson(ctx context.Context, in *GetPersonRequest, opts ...http.CallOption) (*GetPersonResponse, error) {
+	var out GetPersonResponse
+	pattern := "/api/v1/cms/people/{person_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceGetPerson))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) GetProgram(ctx context.Context, in *GetProgramRequest, opts ...http.CallOption) (*GetProgramResponse, error) {
+	var out GetProgramResponse
+	pattern := "/api/v1/cms/programs/{program_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceGetProgram))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...http.CallOption) (*GetRevisionResponse, error) {
+	var out GetRevisionResponse
+	pattern := "/api/v1/cms/revisions/{revision_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceGetRevision))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) GetSeason(ctx context.Context, in *GetSeasonRequest, opts ...http.CallOption) (*GetSeasonResponse, error) {
+	var out GetSeasonResponse
+	pattern := "/api/v1/cms/seasons/{season_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceGetSeason))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ImportChapters(ctx context.Context, in *ImportChaptersRequest, opts ...http.CallOption) (*ImportChaptersResponse, error) {
+	var out ImportChaptersResponse
+	pattern := "/api/v1/cms/episodes/{episode_id}/chapters/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceImportChapters))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ImportData(ctx context.Context, in *ImportDataRequest, opts ...http.CallOption) (*ImportDataResponse, error) {
+	var out ImportDataResponse
+	pattern := "/api/v1/cms/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceImportData))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...http.CallOption) (*ListAuditEventsResponse, error) {
+	var out ListAuditEventsResponse
+	pattern := "/api/v1/cms/audit/events"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceListAuditEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...http.CallOption) (*ListCategoriesResponse, error) {
+	var out ListCategoriesResponse
+	pattern := "/api/v1/cms/categories"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceListCategories))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ListChapters(ctx context.Context, in *ListChaptersRequest, opts ...http.CallOption) (*ListChaptersResponse, error) {
+	var out ListChaptersResponse
+	pattern := "/api/v1/cms/episodes/{episode_id}/chapters"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceListChapters))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ListCredits(ctx context.Context, in *ListCreditsRequest, opts ...http.CallOption) (*ListCreditsResponse, error) {
+	var out ListCreditsResponse
+	pattern := "/api/v1/cms/credits"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceListCredits))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) DeleteEpisode(ctx context.Context, in *DeleteEpisodeRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/api/v1/cms/episodes/{episode_id}"
+func (c *CmsServiceHTTPClientImpl) ListCreditsByPerson(ctx context.Context, in *ListCreditsByPersonRequest, opts ...http.CallOption) (*ListCreditsByPersonResponse, error) {
+	var out ListCreditsByPersonResponse
+	pattern := "/api/v1/cms/people/{person_id}/credits"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCmsServiceDeleteEpisode))
+	opts = append(opts, http.Operation(OperationCmsServiceListCreditsByPerson))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) DeleteProgram(ctx context.Context, in *DeleteProgramRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/api/v1/cms/programs/{program_id}"
+func (c *CmsServiceHTTPClientImpl) ListEpisodes(ctx context.Context, in *ListEpisodesRequest, opts ...http.CallOption) (*ListEpisodesResponse, error) {
+	var out ListEpisodesResponse
+	pattern := "/api/v1/cms/programs/{program_id}/episodes"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCmsServiceDeleteProgram))
+	opts = append(opts, http.Operation(OperationCmsServiceListEpisodes))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...http.CallOption) (*GetCategoryResponse, error) {
-	var out GetCategoryResponse
-	pattern := "/api/v1/cms/categories/{category_id}"
+func (c *CmsServiceHTTPClientImpl) ListPeople(ctx context.Context, in *ListPeopleRequest, opts ...http.CallOption) (*ListPeopleResponse, error) {
+	var out ListPeopleResponse
+	pattern := "/api/v1/cms/people"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCmsServiceGetCategory))
+	opts = append(opts, http.Operation(OperationCmsServiceListPeople))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -627,11 +2510,11 @@ func (c *CmsServiceHTTPClientImpl) GetCategory(ctx context.Context, in *GetCateg
 	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) GetEpisode(ctx context.Context, in *GetEpisodeRequest, opts ...http.CallOption) (*GetEpisodeResponse, error) {
-	var out GetEpisodeResponse
-	pattern := "/api/v1/cms/episodes/{episode_id}"
+func (c *CmsServiceHTTPClientImpl) ListPrograms(ctx context.Context, in *ListProgramsRequest, opts ...http.CallOption) (*ListProgramsResponse, error) {
+	var out ListProgramsResponse
+	pattern := "/api/v1/cms/programs"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCmsServiceGetEpisode))
+	opts = append(opts, http.Operation(OperationCmsServiceListPrograms))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -640,11 +2523,11 @@ func (c *CmsServiceHTTPClientImpl) GetEpisode(ctx context.Context, in *GetEpisod
 	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) GetProgram(ctx context.Context, in *GetProgramRequest, opts ...http.CallOption) (*GetProgramResponse, error) {
-	var out GetProgramResponse
-	pattern := "/api/v1/cms/programs/{program_id}"
+func (c *CmsServiceHTTPClientImpl) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...http.CallOption) (*ListRevisionsResponse, error) {
+	var out ListRevisionsResponse
+	pattern := "/api/v1/cms/revisions"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCmsServiceGetProgram))
+	opts = append(opts, http.Operation(OperationCmsServiceListRevisions))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -653,24 +2536,24 @@ func (c *CmsServiceHTTPClientImpl) GetProgram(ctx context.Context, in *GetProgra
 	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) ImportData(ctx context.Context, in *ImportDataRequest, opts ...http.CallOption) (*ImportDataResponse, error) {
-	var out ImportDataResponse
-	pattern := "/api/v1/cms/import"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationCmsServiceImportData))
+func (c *CmsServiceHTTPClientImpl) ListSeasonEpisodes(ctx context.Context, in *ListSeasonEpisodesRequest, opts ...http.CallOption) (*ListSeasonEpisodesResponse, error) {
+	var out ListSeasonEpisodesResponse
+	pattern := "/api/v1/cms/seasons/{season_id}/episodes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceListSeasonEpisodes))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...http.CallOption) (*ListCategoriesResponse, error) {
-	var out ListCategoriesResponse
-	pattern := "/api/v1/cms/categories"
+func (c *CmsServiceHTTPClientImpl) ListSeasons(ctx context.Context, in *ListSeasonsRequest, opts ...http.CallOption) (*ListSeasonsResponse, error) {
+	var out ListSeasonsResponse
+	pattern := "/api/v1/cms/programs/{program_id}/seasons"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCmsServiceListCategories))
+	opts = append(opts, http.Operation(OperationCmsServiceListSeasons))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -679,11 +2562,11 @@ func (c *CmsServiceHTTPClientImpl) ListCategories(ctx context.Context, in *ListC
 	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) ListEpisodes(ctx context.Context, in *ListEpisodesRequest, opts ...http.CallOption) (*ListEpisodesResponse, error) {
-	var out ListEpisodesResponse
-	pattern := "/api/v1/cms/programs/{program_id}/episodes"
+func (c *CmsServiceHTTPClientImpl) ListStatusTransitions(ctx context.Context, in *ListStatusTransitionsRequest, opts ...http.CallOption) (*ListStatusTransitionsResponse, error) {
+	var out ListStatusTransitionsResponse
+	pattern := "/api/v1/cms/reviews/transitions"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCmsServiceListEpisodes))
+	opts = append(opts, http.Operation(OperationCmsServiceListStatusTransitions))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -692,11 +2575,50 @@ func (c *CmsServiceHTTPClientImpl) ListEpisodes(ctx context.Context, in *ListEpi
 	return &out, nil
 }
 
-func (c *CmsServiceHTTPClientImpl) ListPrograms(ctx context.Context, in *ListProgramsRequest, opts ...http.CallOption) (*ListProgramsResponse, error) {
-	var out ListProgramsResponse
-	pattern := "/api/v1/cms/programs"
+func (c *CmsServiceHTTPClientImpl) ListTags(ctx context.Context, in *ListTagsRequest, opts ...http.CallOption) (*ListTagsResponse, error) {
+	var out ListTagsResponse
+	pattern := "/api/v1/cms/tags"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCmsServiceListPrograms))
+	opts = append(opts, http.Operation(OperationCmsServiceListTags))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ListTranscripts(ctx context.Context, in *ListTranscriptsRequest, opts ...http.CallOption) (*ListTranscriptsResponse, error) {
+	var out ListTranscriptsResponse
+	pattern := "/api/v1/cms/episodes/{episode_id}/transcripts"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceListTranscripts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ListTranslations(ctx context.Context, in *ListTranslationsRequest, opts ...http.CallOption) (*ListTranslationsResponse, error) {
+	var out ListTranslationsResponse
+	pattern := "/api/v1/cms/translations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceListTranslations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...http.CallOption) (*ListTrashResponse, error) {
+	var out ListTrashResponse
+	pattern := "/api/v1/cms/trash"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCmsServiceListTrash))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -705,6 +2627,175 @@ func (c *CmsServiceHTTPClientImpl) ListPrograms(ctx context.Context, in *ListPro
 	return &out, nil
 }
 
+func (c *CmsServiceHTTPClientImpl) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...http.CallOption) (*MergeTagsResponse, error) {
+	var out MergeTagsResponse
+	pattern := "/api/v1/cms/tags/{tag_id}/merge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceMergeTags))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...http.CallOption) (*MoveCategoryResponse, error) {
+	var out MoveCategoryResponse
+	pattern := "/api/v1/cms/categories/{category_id}/move"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceMoveCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...http.CallOption) (*PurgeTrashResponse, error) {
+	var out PurgeTrashResponse
+	pattern := "/api/v1/cms/trash/purge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServicePurgeTrash))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) Reject(ctx context.Context, in *RejectRequest, opts ...http.CallOption) (*ReviewResponse, error) {
+	var out ReviewResponse
+	pattern := "/api/v1/cms/reviews/reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceReject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...http.CallOption) (*RenameTagResponse, error) {
+	var out RenameTagResponse
+	pattern := "/api/v1/cms/tags/{tag_id}/rename"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceRenameTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ReorderEpisodes(ctx context.Context, in *ReorderEpisodesRequest, opts ...http.CallOption) (*ReorderEpisodesResponse, error) {
+	var out ReorderEpisodesResponse
+	pattern := "/api/v1/cms/seasons/{season_id}/episodes/reorder"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceReorderEpisodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) ReplaceChapters(ctx context.Context, in *ReplaceChaptersRequest, opts ...http.CallOption) (*ReplaceChaptersResponse, error) {
+	var out ReplaceChaptersResponse
+	pattern := "/api/v1/cms/episodes/{episode_id}/chapters"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceReplaceChapters))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) RescheduleEpisode(ctx context.Context, in *RescheduleEpisodeRequest, opts ...http.CallOption) (*RescheduleEpisodeResponse, error) {
+	var out RescheduleEpisodeResponse
+	pattern := "/api/v1/cms/episodes/{episode_id}/reschedule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceRescheduleEpisode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) RestoreFromTrash(ctx context.Context, in *RestoreFromTrashRequest, opts ...http.CallOption) (*RestoreFromTrashResponse, error) {
+	var out RestoreFromTrashResponse
+	pattern := "/api/v1/cms/trash/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceRestoreFromTrash))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...http.CallOption) (*RestoreRevisionResponse, error) {
+	var out RestoreRevisionResponse
+	pattern := "/api/v1/cms/revisions/{revision_id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceRestoreRevision))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) SetProgramCategories(ctx context.Context, in *SetProgramCategoriesRequest, opts ...http.CallOption) (*SetProgramCategoriesResponse, error) {
+	var out SetProgramCategoriesResponse
+	pattern := "/api/v1/cms/programs/{program_id}/categories"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceSetProgramCategories))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) SetTranslation(ctx context.Context, in *SetTranslationRequest, opts ...http.CallOption) (*SetTranslationResponse, error) {
+	var out SetTranslationResponse
+	pattern := "/api/v1/cms/translations/{locale}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceSetTranslation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...http.CallOption) (*ReviewResponse, error) {
+	var out ReviewResponse
+	pattern := "/api/v1/cms/reviews/submit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceSubmitForReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CmsServiceHTTPClientImpl) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...http.CallOption) (*UpdateCategoryResponse, error) {
 	var out UpdateCategoryResponse
 	pattern := "/api/v1/cms/categories/{category_id}"
@@ -718,6 +2809,32 @@ func (c *CmsServiceHTTPClientImpl) UpdateCategory(ctx context.Context, in *Updat
 	return &out, nil
 }
 
+func (c *CmsServiceHTTPClientImpl) UpdateChapter(ctx context.Context, in *UpdateChapterRequest, opts ...http.CallOption) (*UpdateChapterResponse, error) {
+	var out UpdateChapterResponse
+	pattern := "/api/v1/cms/chapters/{chapter_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceUpdateChapter))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) UpdateCredit(ctx context.Context, in *UpdateCreditRequest, opts ...http.CallOption) (*UpdateCreditResponse, error) {
+	var out UpdateCreditResponse
+	pattern := "/api/v1/cms/credits/{credit_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceUpdateCredit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CmsServiceHTTPClientImpl) UpdateEpisode(ctx context.Context, in *UpdateEpisodeRequest, opts ...http.CallOption) (*UpdateEpisodeResponse, error) {
 	var out UpdateEpisodeResponse
 	pattern := "/api/v1/cms/episodes/{episode_id}"
@@ -731,6 +2848,19 @@ func (c *CmsServiceHTTPClientImpl) UpdateEpisode(ctx context.Context, in *Update
 	return &out, nil
 }
 
+func (c *CmsServiceHTTPClientImpl) UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...http.CallOption) (*UpdatePersonResponse, error) {
+	var out UpdatePersonResponse
+	pattern := "/api/v1/cms/people/{person_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceUpdatePerson))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CmsServiceHTTPClientImpl) UpdateProgram(ctx context.Context, in *UpdateProgramRequest, opts ...http.CallOption) (*UpdateProgramResponse, error) {
 	var out UpdateProgramResponse
 	pattern := "/api/v1/cms/programs/{program_id}"
@@ -743,3 +2873,42 @@ func (c *CmsServiceHTTPClientImpl) UpdateProgram(ctx context.Context, in *Update
 	}
 	return &out, nil
 }
+
+func (c *CmsServiceHTTPClientImpl) UpdateSeason(ctx context.Context, in *UpdateSeasonRequest, opts ...http.CallOption) (*UpdateSeasonResponse, error) {
+	var out UpdateSeasonResponse
+	pattern := "/api/v1/cms/seasons/{season_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceUpdateSeason))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...http.CallOption) (*UpdateTagResponse, error) {
+	var out UpdateTagResponse
+	pattern := "/api/v1/cms/tags/{tag_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceUpdateTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CmsServiceHTTPClientImpl) UploadTranscript(ctx context.Context, in *UploadTranscriptRequest, opts ...http.CallOption) (*UploadTranscriptResponse, error) {
+	var out UploadTranscriptResponse
+	pattern := "/api/v1/cms/episodes/{episode_id}/transcripts/{locale}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCmsServiceUploadTranscript))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/google/gnostic/openapiv3"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -28,6 +29,8 @@ type SearchRequest struct {
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,4,opt,name=category_id,proto3" json:"category_id,omitempty"` // Only content in this category or below it
+	Locale        string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`           // Overrides Accept-Language
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,17 +86,32 @@ func (x *SearchRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *SearchRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type SearchResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	Programs      []*Program             `protobuf:"bytes,2,rep,name=programs,proto3" json:"programs,omitempty"`
-	Episodes      []*Episode             `protobuf:"bytes,3,rep,name=episodes,proto3" json:"episodes,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,7,opt,name=total_pages,proto3" json:"total_pages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Categories        []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Programs          []*Program             `protobuf:"bytes,2,rep,name=programs,proto3" json:"programs,omitempty"`
+	Episodes          []*Episode             `protobuf:"bytes,3,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	TotalCount        int32                  `protobuf:"varint,4,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	Page              int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize          int32                  `protobuf:"varint,6,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	TotalPages        int32                  `protobuf:"varint,7,opt,name=total_pages,proto3" json:"total_pages,omitempty"`
+	TranscriptMatches []*TranscriptMatch     `protobuf:"bytes,8,rep,name=transcript_matches,proto3" json:"transcript_matches,omitempty"` // For the episodes found in a transcript
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SearchResponse) Reset() {
@@ -175,15 +193,101 @@ func (x *SearchResponse) GetTotalPages() int32 {
 	return 0
 }
 
+func (x *SearchResponse) GetTranscriptMatches() []*TranscriptMatch {
+	if x != nil {
+		return x.TranscriptMatches
+	}
+	return nil
+}
+
+// The first cue of an episode transcript that matched the query
+type TranscriptMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EpisodeId     string                 `protobuf:"bytes,1,opt,name=episode_id,proto3" json:"episode_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	StartSeconds  *float64               `protobuf:"fixed64,3,opt,name=start_seconds,proto3,oneof" json:"start_seconds,omitempty"` // Unset for plain text transcripts
+	EndSeconds    *float64               `protobuf:"fixed64,4,opt,name=end_seconds,proto3,oneof" json:"end_seconds,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranscriptMatch) Reset() {
+	*x = TranscriptMatch{}
+	mi := &file_v1_discover_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranscriptMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptMatch) ProtoMessage() {}
+
+func (x *TranscriptMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_discover_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptMatch.ProtoReflect.Descriptor instead.
+func (*TranscriptMatch) Descriptor() ([]byte, []int) {
+	return file_v1_discover_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TranscriptMatch) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *TranscriptMatch) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *TranscriptMatch) GetStartSeconds() float64 {
+	if x != nil && x.StartSeconds != nil {
+		return *x.StartSeconds
+	}
+	return 0
+}
+
+func (x *TranscriptMatch) GetEndSeconds() float64 {
+	if x != nil && x.EndSeconds != nil {
+		return *x.EndSeconds
+	}
+	return 0
+}
+
+func (x *TranscriptMatch) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
 type FeaturedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,proto3" json:"category_id,omitempty"` // Only programs in this category or below it
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`           // Overrides Accept-Language
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FeaturedRequest) Reset() {
 	*x = FeaturedRequest{}
-	mi := &file_v1_discover_proto_msgTypes[2]
+	mi := &file_v1_discover_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -195,7 +299,7 @@ func (x *FeaturedRequest) String() string {
 func (*FeaturedRequest) ProtoMessage() {}
 
 func (x *FeaturedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_discover_proto_msgTypes[2]
+	mi := &file_v1_discover_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +312,21 @@ func (x *FeaturedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeaturedRequest.ProtoReflect.Descriptor instead.
 func (*FeaturedRequest) Descriptor() ([]byte, []int) {
-	return file_v1_discover_proto_rawDescGZIP(), []int{2}
+	return file_v1_discover_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeaturedRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *FeaturedRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
 }
 
 type FeaturedResponse struct {
@@ -220,7 +338,7 @@ type FeaturedResponse struct {
 
 func (x *FeaturedResponse) Reset() {
 	*x = FeaturedResponse{}
-	mi := &file_v1_discover_proto_msgTypes[3]
+	mi := &file_v1_discover_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -232,7 +350,7 @@ func (x *FeaturedResponse) String() string {
 func (*FeaturedResponse) ProtoMessage() {}
 
 func (x *FeaturedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_discover_proto_msgTypes[3]
+	mi := &file_v1_discover_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +363,7 @@ func (x *FeaturedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeaturedResponse.ProtoReflect.Descriptor instead.
 func (*FeaturedResponse) Descriptor() ([]byte, []int) {
-	return file_v1_discover_proto_rawDescGZIP(), []int{3}
+	return file_v1_discover_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *FeaturedResponse) GetPrograms() []*Program {
@@ -255,15 +373,325 @@ func (x *FeaturedResponse) GetPrograms() []*Program {
 	return nil
 }
 
+type ListProgramSeasonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     string                 `protobuf:"bytes,1,opt,name=program_id,proto3" json:"program_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"` // Overrides Accept-Language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProgramSeasonsRequest) Reset() {
+	*x = ListProgramSeasonsRequest{}
+	mi := &file_v1_discover_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProgramSeasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProgramSeasonsRequest) ProtoMessage() {}
+
+func (x *ListProgramSeasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_discover_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProgramSeasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListProgramSeasonsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_discover_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListProgramSeasonsRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *ListProgramSeasonsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type SeasonEpisodes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        *Season                `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	Episodes      []*Episode             `protobuf:"bytes,2,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonEpisodes) Reset() {
+	*x = SeasonEpisodes{}
+	mi := &file_v1_discover_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonEpisodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonEpisodes) ProtoMessage() {}
+
+func (x *SeasonEpisodes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_discover_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonEpisodes.ProtoReflect.Descriptor instead.
+func (*SeasonEpisodes) Descriptor() ([]byte, []int) {
+	return file_v1_discover_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SeasonEpisodes) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+func (x *SeasonEpisodes) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+type ListProgramSeasonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seasons       []*SeasonEpisodes      `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProgramSeasonsResponse) Reset() {
+	*x = ListProgramSeasonsResponse{}
+	mi := &file_v1_discover_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProgramSeasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProgramSeasonsResponse) ProtoMessage() {}
+
+func (x *ListProgramSeasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_discover_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProgramSeasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListProgramSeasonsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_discover_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListProgramSeasonsResponse) GetSeasons() []*SeasonEpisodes {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+type GetPersonPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PersonId      string                 `protobuf:"bytes,1,opt,name=person_id,proto3" json:"person_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"` // Overrides Accept-Language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonPageRequest) Reset() {
+	*x = GetPersonPageRequest{}
+	mi := &file_v1_discover_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonPageRequest) ProtoMessage() {}
+
+func (x *GetPersonPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_discover_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonPageRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonPageRequest) Descriptor() ([]byte, []int) {
+	return file_v1_discover_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPersonPageRequest) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+func (x *GetPersonPageRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPersonPageRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPersonPageRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetPersonPageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	Credits       []*Credit              `protobuf:"bytes,2,rep,name=credits,proto3" json:"credits,omitempty"`
+	Programs      []*Program             `protobuf:"bytes,3,rep,name=programs,proto3" json:"programs,omitempty"` // The programs credits are on
+	Episodes      []*Episode             `protobuf:"bytes,4,rep,name=episodes,proto3" json:"episodes,omitempty"` // The episodes credits are on
+	TotalCount    int32                  `protobuf:"varint,5,opt,name=total_count,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonPageResponse) Reset() {
+	*x = GetPersonPageResponse{}
+	mi := &file_v1_discover_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonPageResponse) ProtoMessage() {}
+
+func (x *GetPersonPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_discover_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonPageResponse.ProtoReflect.Descriptor instead.
+func (*GetPersonPageResponse) Descriptor() ([]byte, []int) {
+	return file_v1_discover_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPersonPageResponse) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *GetPersonPageResponse) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *GetPersonPageResponse) GetPrograms() []*Program {
+	if x != nil {
+		return x.Programs
+	}
+	return nil
+}
+
+func (x *GetPersonPageResponse) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+func (x *GetPersonPageResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetPersonPageResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPersonPageResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 var File_v1_discover_proto protoreflect.FileDescriptor
 
 const file_v1_discover_proto_rawDesc = "" +
 	"\n" +
-	"\x11v1/discover.proto\x12\vthmanyah.v1\x1a\x1cgoogle/api/annotations.proto\x1a\fv1/cms.proto\x1a\x1copenapi/v3/annotations.proto\"W\n" +
+	"\x11v1/discover.proto\x12\vthmanyah.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\fv1/cms.proto\x1a\x1copenapi/v3/annotations.proto\"\xcf\x01\n" +
 	"\rSearchRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1c\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\tpage_size\"\xa1\x02\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\tpage_size\x12-\n" +
+	"\vcategory_id\x18\x04 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\vcategory_id\x12G\n" +
+	"\x06locale\x18\x05 \x01(\tB/\xfaB,r*\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\x06locale\"\xef\x02\n" +
 	"\x0eSearchResponse\x125\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x15.thmanyah.v1.CategoryR\n" +
@@ -273,13 +701,54 @@ const file_v1_discover_proto_rawDesc = "" +
 	"\vtotal_count\x18\x04 \x01(\x05R\vtotal_count\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1c\n" +
 	"\tpage_size\x18\x06 \x01(\x05R\tpage_size\x12 \n" +
-	"\vtotal_pages\x18\a \x01(\x05R\vtotal_pages\"\x11\n" +
-	"\x0fFeaturedRequest\"D\n" +
+	"\vtotal_pages\x18\a \x01(\x05R\vtotal_pages\x12L\n" +
+	"\x12transcript_matches\x18\b \x03(\v2\x1c.thmanyah.v1.TranscriptMatchR\x12transcript_matches\"\xd1\x01\n" +
+	"\x0fTranscriptMatch\x12\x1e\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\n" +
+	"episode_id\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12)\n" +
+	"\rstart_seconds\x18\x03 \x01(\x01H\x00R\rstart_seconds\x88\x01\x01\x12%\n" +
+	"\vend_seconds\x18\x04 \x01(\x01H\x01R\vend_seconds\x88\x01\x01\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04textB\x10\n" +
+	"\x0e_start_secondsB\x0e\n" +
+	"\f_end_seconds\"\x89\x01\n" +
+	"\x0fFeaturedRequest\x12-\n" +
+	"\vcategory_id\x18\x01 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\vcategory_id\x12G\n" +
+	"\x06locale\x18\x02 \x01(\tB/\xfaB,r*\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\x06locale\"D\n" +
 	"\x10FeaturedResponse\x120\n" +
-	"\bprograms\x18\x01 \x03(\v2\x14.thmanyah.v1.ProgramR\bprograms2\xa0\x02\n" +
+	"\bprograms\x18\x01 \x03(\v2\x14.thmanyah.v1.ProgramR\bprograms\"\x8e\x01\n" +
+	"\x19ListProgramSeasonsRequest\x12(\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"program_id\x12G\n" +
+	"\x06locale\x18\x02 \x01(\tB/\xfaB,r*\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\x06locale\"o\n" +
+	"\x0eSeasonEpisodes\x12+\n" +
+	"\x06season\x18\x01 \x01(\v2\x13.thmanyah.v1.SeasonR\x06season\x120\n" +
+	"\bepisodes\x18\x02 \x03(\v2\x14.thmanyah.v1.EpisodeR\bepisodes\"S\n" +
+	"\x1aListProgramSeasonsResponse\x125\n" +
+	"\aseasons\x18\x01 \x03(\v2\x1b.thmanyah.v1.SeasonEpisodesR\aseasons\"\xc2\x01\n" +
+	"\x14GetPersonPageRequest\x12&\n" +
+	"\tperson_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tperson_id\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12%\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02\x18dR\tpage_size\x12G\n" +
+	"\x06locale\x18\x04 \x01(\tB/\xfaB,r*\x18#2#^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\xd0\x01\x01R\x06locale\"\xab\x02\n" +
+	"\x15GetPersonPageResponse\x12+\n" +
+	"\x06person\x18\x01 \x01(\v2\x13.thmanyah.v1.PersonR\x06person\x12-\n" +
+	"\acredits\x18\x02 \x03(\v2\x13.thmanyah.v1.CreditR\acredits\x120\n" +
+	"\bprograms\x18\x03 \x03(\v2\x14.thmanyah.v1.ProgramR\bprograms\x120\n" +
+	"\bepisodes\x18\x04 \x03(\v2\x14.thmanyah.v1.EpisodeR\bepisodes\x12 \n" +
+	"\vtotal_count\x18\x05 \x01(\x05R\vtotal_count\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1c\n" +
+	"\tpage_size\x18\a \x01(\x05R\tpage_size2\xbe\r\n" +
 	"\x0fDiscoverService\x12\xa5\x01\n" +
 	"\bFeatured\x12\x1c.thmanyah.v1.FeaturedRequest\x1a\x1d.thmanyah.v1.FeaturedResponse\"\\\xbaG8\x1a6Returns a list of featured programs that are published\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/discover/featured\x12e\n" +
-	"\x06Search\x12\x1a.thmanyah.v1.SearchRequest\x1a\x1b.thmanyah.v1.SearchResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/discover/searchB\x14Z\x12thmanyah/api/v1;v1b\x06proto3"
+	"\x06Search\x12\x1a.thmanyah.v1.SearchRequest\x1a\x1b.thmanyah.v1.SearchResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/discover/search\x12\x9a\x02\n" +
+	"\x10BatchGetPrograms\x12$.thmanyah.v1.BatchGetProgramsRequest\x1a%.thmanyah.v1.BatchGetProgramsResponse\"\xb8\x01\xbaG\x86\x01\x1a\x83\x01Returns up to 100 published programs by ID in request order. IDs that do not exist or are not published are listed in not_found_ids\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/discover/programs/batch-get\x12\x9a\x02\n" +
+	"\x10BatchGetEpisodes\x12$.thmanyah.v1.BatchGetEpisodesRequest\x1a%.thmanyah.v1.BatchGetEpisodesResponse\"\xb8\x01\xbaG\x86\x01\x1a\x83\x01Returns up to 100 published episodes by ID in request order. IDs that do not exist or are not published are listed in not_found_ids\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/discover/episodes/batch-get\x12\x83\x02\n" +
+	"\x12BatchGetCategories\x12&.thmanyah.v1.BatchGetCategoriesRequest\x1a'.thmanyah.v1.BatchGetCategoriesResponse\"\x9b\x01\xbaGh\x1afReturns up to 100 categories by ID in request order. IDs that do not exist are listed in not_found_ids\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/discover/categories/batch-get\x12\xcb\x02\n" +
+	"\x12ListProgramSeasons\x12&.thmanyah.v1.ListProgramSeasonsRequest\x1a'.thmanyah.v1.ListProgramSeasonsResponse\"\xe3\x01\xbaG\xa9\x01\x1a\xa6\x01Returns the published episodes of a published program grouped by season, seasons by sort order and episodes by number. Seasons without published episodes are left out\x82\xd3\xe4\x93\x020\x12./api/v1/discover/programs/{program_id}/seasons\x12\x8d\x02\n" +
+	"\rGetPersonPage\x12!.thmanyah.v1.GetPersonPageRequest\x1a\".thmanyah.v1.GetPersonPageResponse\"\xb4\x01\xbaG\x85\x01\x1a\x82\x01Returns a person with their credits on published programs and episodes, most recently added first, and those programs and episodes\x82\xd3\xe4\x93\x02%\x12#/api/v1/discover/people/{person_id}B\x14Z\x12thmanyah/api/v1;v1b\x06proto3"
 
 var (
 	file_v1_discover_proto_rawDescOnce sync.Once
@@ -293,30 +762,63 @@ func file_v1_discover_proto_rawDescGZIP() []byte {
 	return file_v1_discover_proto_rawDescData
 }
 
-var file_v1_discover_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v1_discover_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_v1_discover_proto_goTypes = []any{
-	(*SearchRequest)(nil),    // 0: thmanyah.v1.SearchRequest
-	(*SearchResponse)(nil),   // 1: thmanyah.v1.SearchResponse
-	(*FeaturedRequest)(nil),  // 2: thmanyah.v1.FeaturedRequest
-	(*FeaturedResponse)(nil), // 3: thmanyah.v1.FeaturedResponse
-	(*Category)(nil),         // 4: thmanyah.v1.Category
-	(*Program)(nil),          // 5: thmanyah.v1.Program
-	(*Episode)(nil),          // 6: thmanyah.v1.Episode
+	(*SearchRequest)(nil),              // 0: thmanyah.v1.SearchRequest
+	(*SearchResponse)(nil),             // 1: thmanyah.v1.SearchResponse
+	(*TranscriptMatch)(nil),            // 2: thmanyah.v1.TranscriptMatch
+	(*FeaturedRequest)(nil),            // 3: thmanyah.v1.FeaturedRequest
+	(*FeaturedResponse)(nil),           // 4: thmanyah.v1.FeaturedResponse
+	(*ListProgramSeasonsRequest)(nil),  // 5: thmanyah.v1.ListProgramSeasonsRequest
+	(*SeasonEpisodes)(nil),             // 6: thmanyah.v1.SeasonEpisodes
+	(*ListProgramSeasonsResponse)(nil), // 7: thmanyah.v1.ListProgramSeasonsResponse
+	(*GetPersonPageRequest)(nil),       // 8: thmanyah.v1.GetPersonPageRequest
+	(*GetPersonPageResponse)(nil),      // 9: thmanyah.v1.GetPersonPageResponse
+	(*Category)(nil),                   // 10: thmanyah.v1.Category
+	(*Program)(nil),                    // 11: thmanyah.v1.Program
+	(*Episode)(nil),                    // 12: thmanyah.v1.Episode
+	(*Season)(nil),                     // 13: thmanyah.v1.Season
+	(*Person)(nil),                     // 14: thmanyah.v1.Person
+	(*Credit)(nil),                     // 15: thmanyah.v1.Credit
+	(*BatchGetProgramsRequest)(nil),    // 16: thmanyah.v1.BatchGetProgramsRequest
+	(*BatchGetEpisodesRequest)(nil),    // 17: thmanyah.v1.BatchGetEpisodesRequest
+	(*BatchGetCategoriesRequest)(nil),  // 18: thmanyah.v1.BatchGetCategoriesRequest
+	(*BatchGetProgramsResponse)(nil),   // 19: thmanyah.v1.BatchGetProgramsResponse
+	(*BatchGetEpisodesResponse)(nil),   // 20: thmanyah.v1.BatchGetEpisodesResponse
+	(*BatchGetCategoriesResponse)(nil), // 21: thmanyah.v1.BatchGetCategoriesResponse
 }
 var file_v1_discover_proto_depIdxs = []int32{
-	4, // 0: thmanyah.v1.SearchResponse.categories:type_name -> thmanyah.v1.Category
-	5, // 1: thmanyah.v1.SearchResponse.programs:type_name -> thmanyah.v1.Program
-	6, // 2: thmanyah.v1.SearchResponse.episodes:type_name -> thmanyah.v1.Episode
-	5, // 3: thmanyah.v1.FeaturedResponse.programs:type_name -> thmanyah.v1.Program
-	2, // 4: thmanyah.v1.DiscoverService.Featured:input_type -> thmanyah.v1.FeaturedRequest
-	0, // 5: thmanyah.v1.DiscoverService.Search:input_type -> thmanyah.v1.SearchRequest
-	3, // 6: thmanyah.v1.DiscoverService.Featured:output_type -> thmanyah.v1.FeaturedResponse
-	1, // 7: thmanyah.v1.DiscoverService.Search:output_type -> thmanyah.v1.SearchResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	10, // 0: thmanyah.v1.SearchResponse.categories:type_name -> thmanyah.v1.Category
+	11, // 1: thmanyah.v1.SearchResponse.programs:type_name -> thmanyah.v1.Program
+	12, // 2: thmanyah.v1.SearchResponse.episodes:type_name -> thmanyah.v1.Episode
+	2,  // 3: thmanyah.v1.SearchResponse.transcript_matches:type_name -> thmanyah.v1.TranscriptMatch
+	11, // 4: thmanyah.v1.FeaturedResponse.programs:type_name -> thmanyah.v1.Program
+	13, // 5: thmanyah.v1.SeasonEpisodes.season:type_name -> thmanyah.v1.Season
+	12, // 6: thmanyah.v1.SeasonEpisodes.episodes:type_name -> thmanyah.v1.Episode
+	6,  // 7: thmanyah.v1.ListProgramSeasonsResponse.seasons:type_name -> thmanyah.v1.SeasonEpisodes
+	14, // 8: thmanyah.v1.GetPersonPageResponse.person:type_name -> thmanyah.v1.Person
+	15, // 9: thmanyah.v1.GetPersonPageResponse.credits:type_name -> thmanyah.v1.Credit
+	11, // 10: thmanyah.v1.GetPersonPageResponse.programs:type_name -> thmanyah.v1.Program
+	12, // 11: thmanyah.v1.GetPersonPageResponse.episodes:type_name -> thmanyah.v1.Episode
+	3,  // 12: thmanyah.v1.DiscoverService.Featured:input_type -> thmanyah.v1.FeaturedRequest
+	0,  // 13: thmanyah.v1.DiscoverService.Search:input_type -> thmanyah.v1.SearchRequest
+	16, // 14: thmanyah.v1.DiscoverService.BatchGetPrograms:input_type -> thmanyah.v1.BatchGetProgramsRequest
+	17, // 15: thmanyah.v1.DiscoverService.BatchGetEpisodes:input_type -> thmanyah.v1.BatchGetEpisodesRequest
+	18, // 16: thmanyah.v1.DiscoverService.BatchGetCategories:input_type -> thmanyah.v1.BatchGetCategoriesRequest
+	5,  // 17: thmanyah.v1.DiscoverService.ListProgramSeasons:input_type -> thmanyah.v1.ListProgramSeasonsRequest
+	8,  // 18: thmanyah.v1.DiscoverService.GetPersonPage:input_type -> thmanyah.v1.GetPersonPageRequest
+	4,  // 19: thmanyah.v1.DiscoverService.Featured:output_type -> thmanyah.v1.FeaturedResponse
+	1,  // 20: thmanyah.v1.DiscoverService.Search:output_type -> thmanyah.v1.SearchResponse
+	19, // 21: thmanyah.v1.DiscoverService.BatchGetPrograms:output_type -> thmanyah.v1.BatchGetProgramsResponse
+	20, // 22: thmanyah.v1.DiscoverService.BatchGetEpisodes:output_type -> thmanyah.v1.BatchGetEpisodesResponse
+	21, // 23: thmanyah.v1.DiscoverService.BatchGetCategories:output_type -> thmanyah.v1.BatchGetCategoriesResponse
+	7,  // 24: thmanyah.v1.DiscoverService.ListProgramSeasons:output_type -> thmanyah.v1.ListProgramSeasonsResponse
+	9,  // 25: thmanyah.v1.DiscoverService.GetPersonPage:output_type -> thmanyah.v1.GetPersonPageResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_v1_discover_proto_init() }
@@ -325,13 +827,14 @@ func file_v1_discover_proto_init() {
 		return
 	}
 	file_v1_cms_proto_init()
+	file_v1_discover_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_discover_proto_rawDesc), len(file_v1_discover_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
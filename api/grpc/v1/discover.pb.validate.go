@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _discover_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on SearchRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -63,6 +66,48 @@ func (m *SearchRequest) validate(all bool) error {
 
 	// no validation rules for PageSize
 
+	if m.GetCategoryId() != "" {
+
+		if err := m._validateUuid(m.GetCategoryId()); err != nil {
+			err = SearchRequestValidationError{
+				field:  "CategoryId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetLocale() != "" {
+
+		if utf8.RuneCountInString(m.GetLocale()) > 35 {
+			err := SearchRequestValidationError{
+				field:  "Locale",
+				reason: "value length must be at most 35 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_SearchRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+			err := SearchRequestValidationError{
+				field:  "Locale",
+				reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SearchRequestMultiError(errors)
 	}
@@ -70,6 +115,14 @@ func (m *SearchRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *SearchRequest) _validateUuid(uuid string) error {
+	if matched := _discover_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // SearchRequestMultiError is an error wrapping multiple validation errors
 // returned by SearchRequest.ValidateAll() if the designated constraints
 // aren't met.
@@ -141,6 +194,8 @@ var _ interface {
 	ErrorName() string
 } = SearchRequestValidationError{}
 
+var _SearchRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
 // Validate checks the field values on SearchResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -273,6 +328,40 @@ func (m *SearchResponse) validate(all bool) error {
 
 	// no validation rules for TotalPages
 
+	for idx, item := range m.GetTranscriptMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchResponseValidationError{
+						field:  fmt.Sprintf("TranscriptMatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchResponseValidationError{
+						field:  fmt.Sprintf("TranscriptMatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchResponseValidationError{
+					field:  fmt.Sprintf("TranscriptMatches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SearchResponseMultiError(errors)
 	}
@@ -351,6 +440,120 @@ var _ interface {
 	ErrorName() string
 } = SearchResponseValidationError{}
 
+// Validate checks the field values on TranscriptMatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TranscriptMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TranscriptMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TranscriptMatchMultiError, or nil if none found.
+func (m *TranscriptMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TranscriptMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EpisodeId
+
+	// no validation rules for Locale
+
+	// no validation rules for Text
+
+	if m.StartSeconds != nil {
+		// no validation rules for StartSeconds
+	}
+
+	if m.EndSeconds != nil {
+		// no validation rules for EndSeconds
+	}
+
+	if len(errors) > 0 {
+		return TranscriptMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// TranscriptMatchMultiError is an error wrapping multiple validation errors
+// returned by TranscriptMatch.ValidateAll() if the designated constraints
+// aren't met.
+type TranscriptMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TranscriptMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TranscriptMatchMultiError) AllErrors() []error { return m }
+
+// TranscriptMatchValidationError is the validation error returned by
+// TranscriptMatch.Validate if the designated constraints aren't met.
+type TranscriptMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TranscriptMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TranscriptMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TranscriptMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TranscriptMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TranscriptMatchValidationError) ErrorName() string { return "TranscriptMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TranscriptMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTranscriptMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TranscriptMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TranscriptMatchValidationError{}
+
 // Validate checks the field values on FeaturedRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -373,6 +576,48 @@ func (m *FeaturedRequest) validate(all bool) error {
 
 	var errors []error
 
+	if m.GetCategoryId() != "" {
+
+		if err := m._validateUuid(m.GetCategoryId()); err != nil {
+			err = FeaturedRequestValidationError{
+				field:  "CategoryId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetLocale() != "" {
+
+		if utf8.RuneCountInString(m.GetLocale()) > 35 {
+			err := FeaturedRequestValidationError{
+				field:  "Locale",
+				reason: "value length must be at most 35 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_FeaturedRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+			err := FeaturedRequestValidationError{
+				field:  "Locale",
+				reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return FeaturedRequestMultiError(errors)
 	}
@@ -380,6 +625,14 @@ func (m *FeaturedRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *FeaturedRequest) _validateUuid(uuid string) error {
+	if matched := _discover_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // FeaturedRequestMultiError is an error wrapping multiple validation errors
 // returned by FeaturedRequest.ValidateAll() if the designated constraints
 // aren't met.
@@ -451,6 +704,8 @@ var _ interface {
 	ErrorName() string
 } = FeaturedRequestValidationError{}
 
+var _FeaturedRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
 // Validate checks the field values on FeaturedResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -584,3 +839,854 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = FeaturedResponseValidationError{}
+
+// Validate checks the field values on ListProgramSeasonsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProgramSeasonsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProgramSeasonsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProgramSeasonsRequestMultiError, or nil if none found.
+func (m *ListProgramSeasonsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProgramSeasonsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProgramId()); err != nil {
+		err = ListProgramSeasonsRequestValidationError{
+			field:  "ProgramId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLocale() != "" {
+
+		if utf8.RuneCountInString(m.GetLocale()) > 35 {
+			err := ListProgramSeasonsRequestValidationError{
+				field:  "Locale",
+				reason: "value length must be at most 35 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_ListProgramSeasonsRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+			err := ListProgramSeasonsRequestValidationError{
+				field:  "Locale",
+				reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListProgramSeasonsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListProgramSeasonsRequest) _validateUuid(uuid string) error {
+	if matched := _discover_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListProgramSeasonsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProgramSeasonsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListProgramSeasonsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProgramSeasonsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProgramSeasonsRequestMultiError) AllErrors() []error { return m }
+
+// ListProgramSeasonsRequestValidationError is the validation error returned by
+// ListProgramSeasonsRequest.Validate if the designated constraints aren't met.
+type ListProgramSeasonsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProgramSeasonsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProgramSeasonsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProgramSeasonsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProgramSeasonsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProgramSeasonsRequestValidationError) ErrorName() string {
+	return "ListProgramSeasonsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProgramSeasonsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProgramSeasonsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProgramSeasonsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProgramSeasonsRequestValidationError{}
+
+var _ListProgramSeasonsRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on SeasonEpisodes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SeasonEpisodes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SeasonEpisodes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SeasonEpisodesMultiError,
+// or nil if none found.
+func (m *SeasonEpisodes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SeasonEpisodes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSeason()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeasonEpisodesValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeasonEpisodesValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeason()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeasonEpisodesValidationError{
+				field:  "Season",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEpisodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SeasonEpisodesValidationError{
+						field:  fmt.Sprintf("Episodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SeasonEpisodesValidationError{
+						field:  fmt.Sprintf("Episodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SeasonEpisodesValidationError{
+					field:  fmt.Sprintf("Episodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SeasonEpisodesMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeasonEpisodesMultiError is an error wrapping multiple validation errors
+// returned by SeasonEpisodes.ValidateAll() if the designated constraints
+// aren't met.
+type SeasonEpisodesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeasonEpisodesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeasonEpisodesMultiError) AllErrors() []error { return m }
+
+// SeasonEpisodesValidationError is the validation error returned by
+// SeasonEpisodes.Validate if the designated constraints aren't met.
+type SeasonEpisodesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeasonEpisodesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeasonEpisodesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeasonEpisodesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeasonEpisodesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeasonEpisodesValidationError) ErrorName() string { return "SeasonEpisodesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeasonEpisodesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeasonEpisodes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeasonEpisodesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeasonEpisodesValidationError{}
+
+// Validate checks the field values on ListProgramSeasonsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProgramSeasonsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProgramSeasonsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProgramSeasonsResponseMultiError, or nil if none found.
+func (m *ListProgramSeasonsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProgramSeasonsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSeasons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProgramSeasonsResponseValidationError{
+						field:  fmt.Sprintf("Seasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProgramSeasonsResponseValidationError{
+						field:  fmt.Sprintf("Seasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProgramSeasonsResponseValidationError{
+					field:  fmt.Sprintf("Seasons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListProgramSeasonsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProgramSeasonsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProgramSeasonsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListProgramSeasonsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProgramSeasonsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProgramSeasonsResponseMultiError) AllErrors() []error { return m }
+
+// ListProgramSeasonsResponseValidationError is the validation error returned
+// by ListProgramSeasonsResponse.Validate if the designated constraints aren't met.
+type ListProgramSeasonsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProgramSeasonsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProgramSeasonsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProgramSeasonsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProgramSeasonsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProgramSeasonsResponseValidationError) ErrorName() string {
+	return "ListProgramSeasonsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProgramSeasonsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProgramSeasonsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProgramSeasonsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProgramSeasonsResponseValidationError{}
+
+// Validate checks the field values on GetPersonPageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPersonPageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPersonPageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPersonPageRequestMultiError, or nil if none found.
+func (m *GetPersonPageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPersonPageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPersonId()); err != nil {
+		err = GetPersonPageRequestValidationError{
+			field:  "PersonId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	if m.GetPageSize() > 100 {
+		err := GetPersonPageRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLocale() != "" {
+
+		if utf8.RuneCountInString(m.GetLocale()) > 35 {
+			err := GetPersonPageRequestValidationError{
+				field:  "Locale",
+				reason: "value length must be at most 35 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_GetPersonPageRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+			err := GetPersonPageRequestValidationError{
+				field:  "Locale",
+				reason: "value does not match regex pattern \"^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPersonPageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPersonPageRequest) _validateUuid(uuid string) error {
+	if matched := _discover_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPersonPageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPersonPageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPersonPageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPersonPageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPersonPageRequestMultiError) AllErrors() []error { return m }
+
+// GetPersonPageRequestValidationError is the validation error returned by
+// GetPersonPageRequest.Validate if the designated constraints aren't met.
+type GetPersonPageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPersonPageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPersonPageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPersonPageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPersonPageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPersonPageRequestValidationError) ErrorName() string {
+	return "GetPersonPageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPersonPageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPersonPageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPersonPageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPersonPageRequestValidationError{}
+
+var _GetPersonPageRequest_Locale_Pattern = regexp.MustCompile("^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$")
+
+// Validate checks the field values on GetPersonPageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPersonPageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPersonPageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPersonPageResponseMultiError, or nil if none found.
+func (m *GetPersonPageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPersonPageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPerson()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPersonPageResponseValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPersonPageResponseValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerson()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPersonPageResponseValidationError{
+				field:  "Person",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPersonPageResponseValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPersonPageResponseValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPersonPageResponseValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPrograms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPersonPageResponseValidationError{
+						field:  fmt.Sprintf("Programs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPersonPageResponseValidationError{
+						field:  fmt.Sprintf("Programs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPersonPageResponseValidationError{
+					field:  fmt.Sprintf("Programs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEpisodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPersonPageResponseValidationError{
+						field:  fmt.Sprintf("Episodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPersonPageResponseValidationError{
+						field:  fmt.Sprintf("Episodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPersonPageResponseValidationError{
+					field:  fmt.Sprintf("Episodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetPersonPageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPersonPageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPersonPageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPersonPageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPersonPageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPersonPageResponseMultiError) AllErrors() []error { return m }
+
+// GetPersonPageResponseValidationError is the validation error returned by
+// GetPersonPageResponse.Validate if the designated constraints aren't met.
+type GetPersonPageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPersonPageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPersonPageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPersonPageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPersonPageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPersonPageResponseValidationError) ErrorName() string {
+	return "GetPersonPageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPersonPageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPersonPageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPersonPageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPersonPageResponseValidationError{}
@@ -19,8 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DiscoverService_Featured_FullMethodName = "/thmanyah.v1.DiscoverService/Featured"
-	DiscoverService_Search_FullMethodName   = "/thmanyah.v1.DiscoverService/Search"
+	DiscoverService_Featured_FullMethodName           = "/thmanyah.v1.DiscoverService/Featured"
+	DiscoverService_Search_FullMethodName             = "/thmanyah.v1.DiscoverService/Search"
+	DiscoverService_BatchGetPrograms_FullMethodName   = "/thmanyah.v1.DiscoverService/BatchGetPrograms"
+	DiscoverService_BatchGetEpisodes_FullMethodName   = "/thmanyah.v1.DiscoverService/BatchGetEpisodes"
+	DiscoverService_BatchGetCategories_FullMethodName = "/thmanyah.v1.DiscoverService/BatchGetCategories"
+	DiscoverService_ListProgramSeasons_FullMethodName = "/thmanyah.v1.DiscoverService/ListProgramSeasons"
+	DiscoverService_GetPersonPage_FullMethodName      = "/thmanyah.v1.DiscoverService/GetPersonPage"
 )
 
 // DiscoverServiceClient is the client API for DiscoverService service.
@@ -29,6 +34,11 @@ const (
 type DiscoverServiceClient interface {
 	Featured(ctx context.Context, in *FeaturedRequest, opts ...grpc.CallOption) (*FeaturedResponse, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	BatchGetPrograms(ctx context.Context, in *BatchGetProgramsRequest, opts ...grpc.CallOption) (*BatchGetProgramsResponse, error)
+	BatchGetEpisodes(ctx context.Context, in *BatchGetEpisodesRequest, opts ...grpc.CallOption) (*BatchGetEpisodesResponse, error)
+	BatchGetCategories(ctx context.Context, in *BatchGetCategoriesRequest, opts ...grpc.CallOption) (*BatchGetCategoriesResponse, error)
+	ListProgramSeasons(ctx context.Context, in *ListProgramSeasonsRequest, opts ...grpc.CallOption) (*ListProgramSeasonsResponse, error)
+	GetPersonPage(ctx context.Context, in *GetPersonPageRequest, opts ...grpc.CallOption) (*GetPersonPageResponse, error)
 }
 
 type discoverServiceClient struct {
@@ -59,12 +69,67 @@ func (c *discoverServiceClient) Search(ctx context.Context, in *SearchRequest, o
 	return out, nil
 }
 
+func (c *discoverServiceClient) BatchGetPrograms(ctx context.Context, in *BatchGetProgramsRequest, opts ...grpc.CallOption) (*BatchGetProgramsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetProgramsResponse)
+	err := c.cc.Invoke(ctx, DiscoverService_BatchGetPrograms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoverServiceClient) BatchGetEpisodes(ctx context.Context, in *BatchGetEpisodesRequest, opts ...grpc.CallOption) (*BatchGetEpisodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetEpisodesResponse)
+	err := c.cc.Invoke(ctx, DiscoverService_BatchGetEpisodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoverServiceClient) BatchGetCategories(ctx context.Context, in *BatchGetCategoriesRequest, opts ...grpc.CallOption) (*BatchGetCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetCategoriesResponse)
+	err := c.cc.Invoke(ctx, DiscoverService_BatchGetCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoverServiceClient) ListProgramSeasons(ctx context.Context, in *ListProgramSeasonsRequest, opts ...grpc.CallOption) (*ListProgramSeasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProgramSeasonsResponse)
+	err := c.cc.Invoke(ctx, DiscoverService_ListProgramSeasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoverServiceClient) GetPersonPage(ctx context.Context, in *GetPersonPageRequest, opts ...grpc.CallOption) (*GetPersonPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPersonPageResponse)
+	err := c.cc.Invoke(ctx, DiscoverService_GetPersonPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscoverServiceServer is the server API for DiscoverService service.
 // All implementations must embed UnimplementedDiscoverServiceServer
 // for forward compatibility.
 type DiscoverServiceServer interface {
 	Featured(context.Context, *FeaturedRequest) (*FeaturedResponse, error)
 	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	BatchGetPrograms(context.Context, *BatchGetProgramsRequest) (*BatchGetProgramsResponse, error)
+	BatchGetEpisodes(context.Context, *BatchGetEpisodesRequest) (*BatchGetEpisodesResponse, error)
+	BatchGetCategories(context.Context, *BatchGetCategoriesRequest) (*BatchGetCategoriesResponse, error)
+	ListProgramSeasons(context.Context, *ListProgramSeasonsRequest) (*ListProgramSeasonsResponse, error)
+	GetPersonPage(context.Context, *GetPersonPageRequest) (*GetPersonPageResponse, error)
 	mustEmbedUnimplementedDiscoverServiceServer()
 }
 
@@ -81,6 +146,21 @@ func (UnimplementedDiscoverServiceServer) Featured(context.Context, *FeaturedReq
 func (UnimplementedDiscoverServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedDiscoverServiceServer) BatchGetPrograms(context.Context, *BatchGetProgramsRequest) (*BatchGetProgramsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetPrograms not implemented")
+}
+func (UnimplementedDiscoverServiceServer) BatchGetEpisodes(context.Context, *BatchGetEpisodesRequest) (*BatchGetEpisodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetEpisodes not implemented")
+}
+func (UnimplementedDiscoverServiceServer) BatchGetCategories(context.Context, *BatchGetCategoriesRequest) (*BatchGetCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetCategories not implemented")
+}
+func (UnimplementedDiscoverServiceServer) ListProgramSeasons(context.Context, *ListProgramSeasonsRequest) (*ListProgramSeasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProgramSeasons not implemented")
+}
+func (UnimplementedDiscoverServiceServer) GetPersonPage(context.Context, *GetPersonPageRequest) (*GetPersonPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonPage not implemented")
+}
 func (UnimplementedDiscoverServiceServer) mustEmbedUnimplementedDiscoverServiceServer() {}
 func (UnimplementedDiscoverServiceServer) testEmbeddedByValue()                         {}
 
@@ -138,6 +218,96 @@ func _DiscoverService_Search_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscoverService_BatchGetPrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetProgramsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoverServiceServer).BatchGetPrograms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscoverService_BatchGetPrograms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoverServiceServer).BatchGetPrograms(ctx, req.(*BatchGetProgramsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoverService_BatchGetEpisodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetEpisodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoverServiceServer).BatchGetEpisodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscoverService_BatchGetEpisodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoverServiceServer).BatchGetEpisodes(ctx, req.(*BatchGetEpisodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoverService_BatchGetCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoverServiceServer).BatchGetCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscoverService_BatchGetCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoverServiceServer).BatchGetCategories(ctx, req.(*BatchGetCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoverService_ListProgramSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProgramSeasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoverServiceServer).ListProgramSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscoverService_ListProgramSeasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoverServiceServer).ListProgramSeasons(ctx, req.(*ListProgramSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoverService_GetPersonPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoverServiceServer).GetPersonPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscoverService_GetPersonPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoverServiceServer).GetPersonPage(ctx, req.(*GetPersonPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DiscoverService_ServiceDesc is the grpc.ServiceDesc for DiscoverService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +323,26 @@ var DiscoverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _DiscoverService_Search_Handler,
 		},
+		{
+			MethodName: "BatchGetPrograms",
+			Handler:    _DiscoverService_BatchGetPrograms_Handler,
+		},
+		{
+			MethodName: "BatchGetEpisodes",
+			Handler:    _DiscoverService_BatchGetEpisodes_Handler,
+		},
+		{
+			MethodName: "BatchGetCategories",
+			Handler:    _DiscoverService_BatchGetCategories_Handler,
+		},
+		{
+			MethodName: "ListProgramSeasons",
+			Handler:    _DiscoverService_ListProgramSeasons_Handler,
+		},
+		{
+			MethodName: "GetPersonPage",
+			Handler:    _DiscoverService_GetPersonPage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/discover.proto",
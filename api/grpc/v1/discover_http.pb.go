@@ -19,11 +19,21 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationDiscoverServiceBatchGetCategories = "/thmanyah.v1.DiscoverService/BatchGetCategories"
+const OperationDiscoverServiceBatchGetEpisodes = "/thmanyah.v1.DiscoverService/BatchGetEpisodes"
+const OperationDiscoverServiceBatchGetPrograms = "/thmanyah.v1.DiscoverService/BatchGetPrograms"
 const OperationDiscoverServiceFeatured = "/thmanyah.v1.DiscoverService/Featured"
+const OperationDiscoverServiceGetPersonPage = "/thmanyah.v1.DiscoverService/GetPersonPage"
+const OperationDiscoverServiceListProgramSeasons = "/thmanyah.v1.DiscoverService/ListProgramSeasons"
 const OperationDiscoverServiceSearch = "/thmanyah.v1.DiscoverService/Search"
 
 type DiscoverServiceHTTPServer interface {
+	BatchGetCategories(context.Context, *BatchGetCategoriesRequest) (*BatchGetCategoriesResponse, error)
+	BatchGetEpisodes(context.Context, *BatchGetEpisodesRequest) (*BatchGetEpisodesResponse, error)
+	BatchGetPrograms(context.Context, *BatchGetProgramsRequest) (*BatchGetProgramsResponse, error)
 	Featured(context.Context, *FeaturedRequest) (*FeaturedResponse, error)
+	GetPersonPage(context.Context, *GetPersonPageRequest) (*GetPersonPageResponse, error)
+	ListProgramSeasons(context.Context, *ListProgramSeasonsRequest) (*ListProgramSeasonsResponse, error)
 	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 }
 
@@ -31,6 +41,11 @@ func RegisterDiscoverServiceHTTPServer(s *http.Server, srv DiscoverServiceHTTPSe
 	r := s.Route("/")
 	r.GET("/api/v1/discover/featured", _DiscoverService_Featured0_HTTP_Handler(srv))
 	r.POST("/api/v1/discover/search", _DiscoverService_Search0_HTTP_Handler(srv))
+	r.POST("/api/v1/discover/programs/batch-get", _DiscoverService_BatchGetPrograms1_HTTP_Handler(srv))
+	r.POST("/api/v1/discover/episodes/batch-get", _DiscoverService_BatchGetEpisodes1_HTTP_Handler(srv))
+	r.POST("/api/v1/discover/categories/batch-get", _DiscoverService_BatchGetCategories1_HTTP_Handler(srv))
+	r.GET("/api/v1/discover/programs/{program_id}/seasons", _DiscoverService_ListProgramSeasons0_HTTP_Handler(srv))
+	r.GET("/api/v1/discover/people/{person_id}", _DiscoverService_GetPersonPage0_HTTP_Handler(srv))
 }
 
 func _DiscoverService_Featured0_HTTP_Handler(srv DiscoverServiceHTTPServer) func(ctx http.Context) error {
@@ -74,8 +89,123 @@ func _DiscoverService_Search0_HTTP_Handler(srv DiscoverServiceHTTPServer) func(c
 	}
 }
 
+func _DiscoverService_BatchGetPrograms1_HTTP_Handler(srv DiscoverServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGetProgramsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDiscoverServiceBatchGetPrograms)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetPrograms(ctx, req.(*BatchGetProgramsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchGetProgramsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DiscoverService_BatchGetEpisodes1_HTTP_Handler(srv DiscoverServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGetEpisodesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDiscoverServiceBatchGetEpisodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetEpisodes(ctx, req.(*BatchGetEpisodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchGetEpisodesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DiscoverService_BatchGetCategories1_HTTP_Handler(srv DiscoverServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGetCategoriesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDiscoverServiceBatchGetCategories)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetCategories(ctx, req.(*BatchGetCategoriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchGetCategoriesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DiscoverService_ListProgramSeasons0_HTTP_Handler(srv DiscoverServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListProgramSeasonsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDiscoverServiceListProgramSeasons)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListProgramSeasons(ctx, req.(*ListProgramSeasonsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListProgramSeasonsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DiscoverService_GetPersonPage0_HTTP_Handler(srv DiscoverServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPersonPageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDiscoverServiceGetPersonPage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPersonPage(ctx, req.(*GetPersonPageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPersonPageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type DiscoverServiceHTTPClient interface {
+	BatchGetCategories(ctx context.Context, req *BatchGetCategoriesRequest, opts ...http.CallOption) (rsp *BatchGetCategoriesResponse, err error)
+	BatchGetEpisodes(ctx context.Context, req *BatchGetEpisodesRequest, opts ...http.CallOption) (rsp *BatchGetEpisodesResponse, err error)
+	BatchGetPrograms(ctx context.Context, req *BatchGetProgramsRequest, opts ...http.CallOption) (rsp *BatchGetProgramsResponse, err error)
 	Featured(ctx context.Context, req *FeaturedRequest, opts ...http.CallOption) (rsp *FeaturedResponse, err error)
+	GetPersonPage(ctx context.Context, req *GetPersonPageRequest, opts ...http.CallOption) (rsp *GetPersonPageResponse, err error)
+	ListProgramSeasons(ctx context.Context, req *ListProgramSeasonsRequest, opts ...http.CallOption) (rsp *ListProgramSeasonsResponse, err error)
 	Search(ctx context.Context, req *SearchRequest, opts ...http.CallOption) (rsp *SearchResponse, err error)
 }
 
@@ -87,6 +217,45 @@ func NewDiscoverServiceHTTPClient(client *http.Client) DiscoverServiceHTTPClient
 	return &DiscoverServiceHTTPClientImpl{client}
 }
 
+func (c *DiscoverServiceHTTPClientImpl) BatchGetCategories(ctx context.Context, in *BatchGetCategoriesRequest, opts ...http.CallOption) (*BatchGetCategoriesResponse, error) {
+	var out BatchGetCategoriesResponse
+	pattern := "/api/v1/discover/categories/batch-get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDiscoverServiceBatchGetCategories))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DiscoverServiceHTTPClientImpl) BatchGetEpisodes(ctx context.Context, in *BatchGetEpisodesRequest, opts ...http.CallOption) (*BatchGetEpisodesResponse, error) {
+	var out BatchGetEpisodesResponse
+	pattern := "/api/v1/discover/episodes/batch-get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDiscoverServiceBatchGetEpisodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DiscoverServiceHTTPClientImpl) BatchGetPrograms(ctx context.Context, in *BatchGetProgramsRequest, opts ...http.CallOption) (*BatchGetProgramsResponse, error) {
+	var out BatchGetProgramsResponse
+	pattern := "/api/v1/discover/programs/batch-get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDiscoverServiceBatchGetPrograms))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *DiscoverServiceHTTPClientImpl) Featured(ctx context.Context, in *FeaturedRequest, opts ...http.CallOption) (*FeaturedResponse, error) {
 	var out FeaturedResponse
 	pattern := "/api/v1/discover/featured"
@@ -100,6 +269,32 @@ func (c *DiscoverServiceHTTPClientImpl) Featured(ctx context.Context, in *Featur
 	return &out, nil
 }
 
+func (c *DiscoverServiceHTTPClientImpl) GetPersonPage(ctx context.Context, in *GetPersonPageRequest, opts ...http.CallOption) (*GetPersonPageResponse, error) {
+	var out GetPersonPageResponse
+	pattern := "/api/v1/discover/people/{person_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDiscoverServiceGetPersonPage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DiscoverServiceHTTPClientImpl) ListProgramSeasons(ctx context.Context, in *ListProgramSeasonsRequest, opts ...http.CallOption) (*ListProgramSeasonsResponse, error) {
+	var out ListProgramSeasonsResponse
+	pattern := "/api/v1/discover/programs/{program_id}/seasons"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDiscoverServiceListProgramSeasons))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *DiscoverServiceHTTPClientImpl) Search(ctx context.Context, in *SearchRequest, opts ...http.CallOption) (*SearchResponse, error) {
 	var out SearchResponse
 	pattern := "/api/v1/discover/search"
@@ -9,6 +9,10 @@ import (
 
 	"github.com/go-kratos/kratos/v2/config/env"
 	"thmanyah/internal/conf"
+	"thmanyah/internal/modules/cms/data/pgnotify"
+	"thmanyah/internal/modules/cms/data/webhook"
+	"thmanyah/internal/modules/cms/service"
+	"thmanyah/internal/observability"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -31,7 +35,7 @@ var (
 	id, _ = os.Hostname()
 )
 
-func newApp(ctx context.Context, logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(ctx context.Context, logger log.Logger, gs *grpc.Server, hs *http.Server, wd *webhook.Dispatcher, il *pgnotify.ImportListener, es *service.EpisodeScheduler, tp *service.TrashPurger) *kratos.App {
 	return kratos.New(
 		kratos.Context(ctx),
 		kratos.ID(id),
@@ -42,6 +46,10 @@ func newApp(ctx context.Context, logger log.Logger, gs *grpc.Server, hs *http.Se
 		kratos.Server(
 			gs,
 			hs,
+			wd,
+			il,
+			es,
+			tp,
 		),
 	)
 }
@@ -51,15 +59,6 @@ func main() {
 	defer cancel()
 
 	flag.Parse()
-	logger := log.With(
-		log.NewStdLogger(os.Stdout),
-		"ts", log.DefaultTimestamp,
-		"caller", log.DefaultCaller,
-		"service.id", id,
-		"service.version", Version,
-		"trace.id", tracing.TraceID(),
-		"span.id", tracing.SpanID(),
-	)
 	c := config.New(
 		config.WithSource(
 			file.NewSource("../../configs"),
@@ -77,10 +76,22 @@ func main() {
 		panic(err)
 	}
 
-	app, err := wireApp(ctx, logger, bc.Server, bc.Data)
+	logger := log.With(
+		observability.NewLogger(bc.Observability.GetLogging(), os.Stdout),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+		"service.id", id,
+		"service.version", Version,
+		"trace.id", tracing.TraceID(),
+		"span.id", tracing.SpanID(),
+		"request.id", observability.RequestIDValuer(),
+	)
+
+	app, cleanup, err := wireApp(ctx, logger, bc.Server, bc.Data, bc.Observability, bc.Jobs, bc.Workflow, bc.Revisions, bc.Audit)
 	if err != nil {
 		log.Fatalf("setup application: %v", err)
 	}
+	defer cleanup()
 
 	errChan := make(chan error)
 
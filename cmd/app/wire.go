@@ -9,8 +9,12 @@ import (
 	"context"
 
 	"thmanyah/internal/conf"
+	"thmanyah/internal/geo"
+	"thmanyah/internal/gql"
+	"thmanyah/internal/i18n"
 	"thmanyah/internal/modules/cms"
 	"thmanyah/internal/modules/discover"
+	"thmanyah/internal/observability"
 	"thmanyah/internal/postgres"
 	"thmanyah/internal/server"
 	"thmanyah/keys"
@@ -20,14 +24,18 @@ import (
 	"github.com/google/wire"
 )
 
-func wireApp(context.Context, log.Logger, *conf.Server, *conf.Data) (*kratos.App, error) {
+func wireApp(context.Context, log.Logger, *conf.Server, *conf.Data, *conf.Observability, *conf.Jobs, *conf.Workflow, *conf.Revisions, *conf.Audit) (*kratos.App, func(), error) {
 	panic(
 		wire.Build(
+			observability.ProviderSet,
 			postgres.NewPgPool,
 			keys.Provider,
 			server.ProviderSet,
 			cms.ProviderSet,
 			discover.ProviderSet,
+			gql.ProviderSet,
+			i18n.ProviderSet,
+			geo.ProviderSet,
 			newApp,
 		),
 	)
@@ -11,14 +11,22 @@ import (
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"thmanyah/internal/conf"
+	"thmanyah/internal/geo"
+	"thmanyah/internal/gql"
+	"thmanyah/internal/i18n"
 	"thmanyah/internal/modules/cms/biz"
+	"thmanyah/internal/modules/cms/data/audit"
+	"thmanyah/internal/modules/cms/data/pgnotify"
 	"thmanyah/internal/modules/cms/data/repo"
+	"thmanyah/internal/modules/cms/data/review"
 	"thmanyah/internal/modules/cms/data/s3"
+	"thmanyah/internal/modules/cms/data/webhook"
 	"thmanyah/internal/modules/cms/service"
 	biz2 "thmanyah/internal/modules/discover/biz"
 	repo2 "thmanyah/internal/modules/discover/data"
 	"thmanyah/internal/modules/discover/data/cache"
 	service2 "thmanyah/internal/modules/discover/service"
+	"thmanyah/internal/observability"
 	"thmanyah/internal/postgres"
 	"thmanyah/internal/server"
 	"thmanyah/keys"
@@ -30,39 +38,130 @@ import (
 
 // Injectors from wire.go:
 
-func wireApp(contextContext context.Context, logger log.Logger, confServer *conf.Server, data *conf.Data) (*kratos.App, error) {
-	pool, err := postgres.NewPgPool(contextContext, data)
+func wireApp(contextContext context.Context, logger log.Logger, confServer *conf.Server, data *conf.Data, confObservability *conf.Observability, jobs *conf.Jobs, workflow *conf.Workflow, revisions *conf.Revisions, confAudit *conf.Audit) (*kratos.App, func(), error) {
+	store := keys.NewKeyStore()
+	metrics, cleanup, err := observability.NewMetrics(confObservability)
+	if err != nil {
+		return nil, nil, err
+	}
+	meter := observability.ProvideMeter(metrics)
+	tracing, cleanup2, err := observability.NewTracing(confObservability)
 	if err != nil {
-		return nil, err
+		cleanup()
+		return nil, nil, err
+	}
+	tracerProvider := observability.ProvideTracerProvider(tracing)
+	pool, err := postgres.NewPgPool(contextContext, data, meter, tracerProvider)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
 	}
 	usersRepository, err := repo.NewUsersRepo(pool, logger)
 	if err != nil {
-		return nil, err
+		cleanup2()
+		cleanup()
+		return nil, nil, err
 	}
 	categoryRepository := repo.NewCategoryRepository(pool)
 	programRepository := repo.NewProgramRepository(pool)
 	episodeRepository := repo.NewEpisodeRepository(pool)
 	importRepository := repo.NewImportRepository(pool)
-	store := keys.NewKeyStore()
-	s3Client, err := s3.NewS3Client(contextContext, data)
+	webhookRepository := repo.NewWebhookRepository(pool)
+	s3Client, err := s3.NewS3Client(contextContext, data, meter, tracerProvider)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	importListener := pgnotify.NewImportListener(pool, logger)
+	workflowRepository := repo.NewWorkflowRepository(pool)
+	reviewPolicy, err := review.NewPolicy(workflow)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	revisionRepository := repo.NewRevisionRepository(pool, revisions)
+	trashRepository := repo.NewTrashRepository(pool)
+	transactor := repo.NewTransactor(pool)
+	auditRepository := repo.NewAuditRepository(pool)
+	auditPolicy, err := audit.NewPolicy(confAudit)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tagRepository := repo.NewTagRepository(pool)
+	translationRepository := repo.NewTranslationRepository(pool)
+	seasonRepository := repo.NewSeasonRepository(pool)
+	chapterRepository := repo.NewChapterRepository(pool)
+	transcriptRepository := repo.NewTranscriptRepository(pool)
+	personRepository := repo.NewPersonRepository(pool)
+	creditRepository := repo.NewCreditRepository(pool)
+	useCase, err := biz.NewUseCase(usersRepository, categoryRepository, programRepository, episodeRepository, importRepository, webhookRepository, store, s3Client, importListener, workflowRepository, reviewPolicy, revisionRepository, trashRepository, transactor, auditRepository, auditPolicy, tagRepository, translationRepository, seasonRepository, chapterRepository, transcriptRepository, personRepository, creditRepository, meter, logger)
 	if err != nil {
-		return nil, err
+		cleanup2()
+		cleanup()
+		return nil, nil, err
 	}
-	useCase := biz.NewUseCase(usersRepository, categoryRepository, programRepository, episodeRepository, importRepository, store, s3Client, logger)
 	authService := service.NewAuthService(useCase)
 	cmsService := service.NewCmsService(useCase)
+	webhookService := service.NewWebhookService(useCase)
 	discoverRepository, err := repo2.NewDiscoverRepo(pool, logger)
 	if err != nil {
-		return nil, err
+		cleanup2()
+		cleanup()
+		return nil, nil, err
 	}
-	memoryCache, err := cache.NewMemoryCache()
+	memoryCache, err := cache.NewMemoryCache(meter, tracerProvider)
 	if err != nil {
-		return nil, err
+		cleanup2()
+		cleanup()
+		return nil, nil, err
 	}
-	discoverUsecase := biz2.NewDiscoverUsecase(discoverRepository, memoryCache, logger)
+	discoverUsecase := biz2.NewDiscoverUsecase(discoverRepository, programRepository, episodeRepository, categoryRepository, seasonRepository, translationRepository, chapterRepository, personRepository, creditRepository, memoryCache, logger)
 	discoverService := service2.NewDiscoverService(discoverUsecase, logger)
-	grpcServer := server.NewGRPCServer(confServer, authService, cmsService, discoverService, logger)
-	httpServer := server.NewHTTPServer(confServer, store, authService, cmsService, discoverService, logger)
-	app := newApp(contextContext, logger, grpcServer, httpServer)
-	return app, nil
+	auditor := service.NewAuditor(useCase)
+	translator, err := i18n.NewTranslator(confServer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	trustedProxies, err := server.NewTrustedProxies(confServer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	resolver, err := geo.NewResolver(confServer, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	locator := geo.NewLocator(confServer, trustedProxies, resolver)
+	accessLog := observability.NewAccessLog(confObservability, trustedProxies, logger)
+	grpcServer := server.NewGRPCServer(confServer, store, authService, cmsService, webhookService, discoverService, auditor, translator, locator, metrics, tracing, accessLog, logger)
+	handler, err := gql.NewHandler(confServer, useCase)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	httpServer := server.NewHTTPServer(confServer, store, authService, cmsService, webhookService, discoverService, auditor, handler, translator, locator, metrics, tracing, accessLog, logger)
+	dispatcher, err := webhook.NewDispatcher(jobs, webhookRepository, meter, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	episodeScheduler := service.NewEpisodeScheduler(jobs, useCase, logger)
+	trashPurger := service.NewTrashPurger(jobs, useCase, logger)
+	app := newApp(contextContext, logger, grpcServer, httpServer, dispatcher, importListener, episodeScheduler, trashPurger)
+	return app, func() {
+		cleanup2()
+		cleanup()
+	}, nil
 }
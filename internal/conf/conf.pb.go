@@ -26,6 +26,11 @@ type Bootstrap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Observability *Observability         `protobuf:"bytes,3,opt,name=observability,proto3" json:"observability,omitempty"`
+	Jobs          *Jobs                  `protobuf:"bytes,4,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	Workflow      *Workflow              `protobuf:"bytes,5,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Revisions     *Revisions             `protobuf:"bytes,6,opt,name=revisions,proto3" json:"revisions,omitempty"`
+	Audit         *Audit                 `protobuf:"bytes,7,opt,name=audit,proto3" json:"audit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,12 +79,55 @@ func (x *Bootstrap) GetData() *Data {
 	return nil
 }
 
+func (x *Bootstrap) GetObservability() *Observability {
+	if x != nil {
+		return x.Observability
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetJobs() *Jobs {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetWorkflow() *Workflow {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRevisions() *Revisions {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetAudit() *Audit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
 type Server struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Http         *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc         *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Graphql      *Server_GraphQL        `protobuf:"bytes,3,opt,name=graphql,proto3" json:"graphql,omitempty"`
+	Localization *Server_Localization   `protobuf:"bytes,4,opt,name=localization,proto3" json:"localization,omitempty"`
+	Geolocation  *Server_Geolocation    `protobuf:"bytes,5,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	// Address ranges of the load balancers and proxies in front of the server, e.g.
+	// 10.0.0.0/8. Client addresses in X-Forwarded-For, X-Real-IP and the geolocation
+	// client_ip_header are only believed when added by one of them, so the access log,
+	// the audit log and geolocation see the real client. Empty trusts no proxy.
+	TrustedProxies []string `protobuf:"bytes,6,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Server) Reset() {
@@ -126,6 +174,34 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetGraphql() *Server_GraphQL {
+	if x != nil {
+		return x.Graphql
+	}
+	return nil
+}
+
+func (x *Server) GetLocalization() *Server_Localization {
+	if x != nil {
+		return x.Localization
+	}
+	return nil
+}
+
+func (x *Server) GetGeolocation() *Server_Geolocation {
+	if x != nil {
+		return x.Geolocation
+	}
+	return nil
+}
+
+func (x *Server) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
 type Database struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
@@ -338,29 +414,29 @@ func (x *Data) GetS3() *S3 {
 	return nil
 }
 
-type Server_HTTP struct {
+type Observability struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Metrics       *Observability_Metrics `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Tracing       *Observability_Tracing `protobuf:"bytes,2,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	Logging       *Observability_Logging `protobuf:"bytes,3,opt,name=logging,proto3" json:"logging,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server_HTTP) Reset() {
-	*x = Server_HTTP{}
+func (x *Observability) Reset() {
+	*x = Observability{}
 	mi := &file_conf_conf_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_HTTP) String() string {
+func (x *Observability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_HTTP) ProtoMessage() {}
+func (*Observability) ProtoMessage() {}
 
-func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+func (x *Observability) ProtoReflect() protoreflect.Message {
 	mi := &file_conf_conf_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -372,55 +448,55 @@ func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
-func (*Server_HTTP) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use Observability.ProtoReflect.Descriptor instead.
+func (*Observability) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Server_HTTP) GetNetwork() string {
+func (x *Observability) GetMetrics() *Observability_Metrics {
 	if x != nil {
-		return x.Network
+		return x.Metrics
 	}
-	return ""
+	return nil
 }
 
-func (x *Server_HTTP) GetAddr() string {
+func (x *Observability) GetTracing() *Observability_Tracing {
 	if x != nil {
-		return x.Addr
+		return x.Tracing
 	}
-	return ""
+	return nil
 }
 
-func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+func (x *Observability) GetLogging() *Observability_Logging {
 	if x != nil {
-		return x.Timeout
+		return x.Logging
 	}
 	return nil
 }
 
-type Server_GRPC struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Jobs struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks         *Jobs_Webhooks         `protobuf:"bytes,1,opt,name=webhooks,proto3" json:"webhooks,omitempty"`
+	EpisodeScheduler *Jobs_EpisodeScheduler `protobuf:"bytes,2,opt,name=episode_scheduler,json=episodeScheduler,proto3" json:"episode_scheduler,omitempty"`
+	TrashPurger      *Jobs_TrashPurger      `protobuf:"bytes,3,opt,name=trash_purger,json=trashPurger,proto3" json:"trash_purger,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Server_GRPC) Reset() {
-	*x = Server_GRPC{}
+func (x *Jobs) Reset() {
+	*x = Jobs{}
 	mi := &file_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_GRPC) String() string {
+func (x *Jobs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_GRPC) ProtoMessage() {}
+func (*Jobs) ProtoMessage() {}
 
-func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
+func (x *Jobs) ProtoReflect() protoreflect.Message {
 	mi := &file_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -432,55 +508,1384 @@ func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
-func (*Server_GRPC) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use Jobs.ProtoReflect.Descriptor instead.
+func (*Jobs) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Server_GRPC) GetNetwork() string {
+func (x *Jobs) GetWebhooks() *Jobs_Webhooks {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *Jobs) GetEpisodeScheduler() *Jobs_EpisodeScheduler {
+	if x != nil {
+		return x.EpisodeScheduler
+	}
+	return nil
+}
+
+func (x *Jobs) GetTrashPurger() *Jobs_TrashPurger {
+	if x != nil {
+		return x.TrashPurger
+	}
+	return nil
+}
+
+type Workflow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When set, content must be submitted for review and approved before it is
+	// published or scheduled. Status changes are recorded either way.
+	ReviewRequired bool `protobuf:"varint,1,opt,name=review_required,json=reviewRequired,proto3" json:"review_required,omitempty"`
+	// IDs of the users who may approve or reject content under review. At least one is
+	// needed when review is required.
+	ReviewerIds []string `protobuf:"bytes,2,rep,name=reviewer_ids,json=reviewerIds,proto3" json:"reviewer_ids,omitempty"`
+	// Lets reviewers approve content they created themselves.
+	AllowSelfApproval bool `protobuf:"varint,3,opt,name=allow_self_approval,json=allowSelfApproval,proto3" json:"allow_self_approval,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Workflow) Reset() {
+	*x = Workflow{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workflow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workflow) ProtoMessage() {}
+
+func (x *Workflow) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
+func (*Workflow) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Workflow) GetReviewRequired() bool {
+	if x != nil {
+		return x.ReviewRequired
+	}
+	return false
+}
+
+func (x *Workflow) GetReviewerIds() []string {
+	if x != nil {
+		return x.ReviewerIds
+	}
+	return nil
+}
+
+func (x *Workflow) GetAllowSelfApproval() bool {
+	if x != nil {
+		return x.AllowSelfApproval
+	}
+	return false
+}
+
+type Revisions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How many revisions are kept per program and per episode; older ones are pruned
+	// when a new one is stored. Zero keeps every revision.
+	ProgramRetention int32 `protobuf:"varint,1,opt,name=program_retention,json=programRetention,proto3" json:"program_retention,omitempty"`
+	EpisodeRetention int32 `protobuf:"varint,2,opt,name=episode_retention,json=episodeRetention,proto3" json:"episode_retention,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Revisions) Reset() {
+	*x = Revisions{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Revisions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revisions) ProtoMessage() {}
+
+func (x *Revisions) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revisions.ProtoReflect.Descriptor instead.
+func (*Revisions) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Revisions) GetProgramRetention() int32 {
+	if x != nil {
+		return x.ProgramRetention
+	}
+	return 0
+}
+
+func (x *Revisions) GetEpisodeRetention() int32 {
+	if x != nil {
+		return x.EpisodeRetention
+	}
+	return 0
+}
+
+type Audit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs of the users who may read and export the audit log.
+	AdminIds      []string `protobuf:"bytes,1,rep,name=admin_ids,json=adminIds,proto3" json:"admin_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Audit) Reset() {
+	*x = Audit{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Audit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Audit) ProtoMessage() {}
+
+func (x *Audit) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Audit.ProtoReflect.Descriptor instead.
+func (*Audit) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Audit) GetAdminIds() []string {
+	if x != nil {
+		return x.AdminIds
+	}
+	return nil
+}
+
+type Server_HTTP struct {
+	state           protoimpl.MessageState       `protogen:"open.v1"`
+	Network         string                       `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr            string                       `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout         *durationpb.Duration         `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Cors            *Server_HTTP_CORS            `protobuf:"bytes,4,opt,name=cors,proto3" json:"cors,omitempty"`
+	SecurityHeaders *Server_HTTP_SecurityHeaders `protobuf:"bytes,5,opt,name=security_headers,json=securityHeaders,proto3" json:"security_headers,omitempty"`
+	Csrf            *Server_HTTP_CSRF            `protobuf:"bytes,6,opt,name=csrf,proto3" json:"csrf,omitempty"`
+	CacheRules      []*Server_HTTP_CacheRule     `protobuf:"bytes,7,rep,name=cache_rules,json=cacheRules,proto3" json:"cache_rules,omitempty"`
+	Compression     *Server_HTTP_Compression     `protobuf:"bytes,8,opt,name=compression,proto3" json:"compression,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Server_HTTP) Reset() {
+	*x = Server_HTTP{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP) ProtoMessage() {}
+
+func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
+func (*Server_HTTP) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Server_HTTP) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
 	return ""
 }
 
-func (x *Server_GRPC) GetAddr() string {
+func (x *Server_HTTP) GetAddr() string {
 	if x != nil {
 		return x.Addr
 	}
 	return ""
 }
 
-func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
 	if x != nil {
 		return x.Timeout
 	}
 	return nil
 }
 
-var File_conf_conf_proto protoreflect.FileDescriptor
+func (x *Server_HTTP) GetCors() *Server_HTTP_CORS {
+	if x != nil {
+		return x.Cors
+	}
+	return nil
+}
 
-const file_conf_conf_proto_rawDesc = "" +
-	"\n" +
-	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"]\n" +
-	"\tBootstrap\x12*\n" +
-	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xb8\x02\n" +
-	"\x06Server\x12+\n" +
-	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
-	"\x04HTTP\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
-	"\x04GRPC\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"z\n" +
-	"\bDatabase\x12\x12\n" +
-	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
-	"\x04user\x18\x02 \x01(\tR\x04user\x12\x1a\n" +
+func (x *Server_HTTP) GetSecurityHeaders() *Server_HTTP_SecurityHeaders {
+	if x != nil {
+		return x.SecurityHeaders
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetCsrf() *Server_HTTP_CSRF {
+	if x != nil {
+		return x.Csrf
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetCacheRules() []*Server_HTTP_CacheRule {
+	if x != nil {
+		return x.CacheRules
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetCompression() *Server_HTTP_Compression {
+	if x != nil {
+		return x.Compression
+	}
+	return nil
+}
+
+type Server_GRPC struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_GRPC) Reset() {
+	*x = Server_GRPC{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_GRPC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_GRPC) ProtoMessage() {}
+
+func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
+func (*Server_GRPC) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Server_GRPC) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Server_GraphQL struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Path    string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Deepest field nesting a query may select.
+	MaxDepth int32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// Upper bound on the estimated number of resolved fields, with list fields
+	// weighted by their page size.
+	MaxComplexity int32 `protobuf:"varint,4,opt,name=max_complexity,json=maxComplexity,proto3" json:"max_complexity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_GraphQL) Reset() {
+	*x = Server_GraphQL{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_GraphQL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_GraphQL) ProtoMessage() {}
+
+func (x *Server_GraphQL) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_GraphQL.ProtoReflect.Descriptor instead.
+func (*Server_GraphQL) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Server_GraphQL) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Server_GraphQL) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Server_GraphQL) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *Server_GraphQL) GetMaxComplexity() int32 {
+	if x != nil {
+		return x.MaxComplexity
+	}
+	return 0
+}
+
+type Server_Localization struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Locale used when Accept-Language names no supported locale: en or ar.
+	DefaultLocale string `protobuf:"bytes,1,opt,name=default_locale,json=defaultLocale,proto3" json:"default_locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Localization) Reset() {
+	*x = Server_Localization{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Localization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Localization) ProtoMessage() {}
+
+func (x *Server_Localization) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Localization.ProtoReflect.Descriptor instead.
+func (*Server_Localization) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *Server_Localization) GetDefaultLocale() string {
+	if x != nil {
+		return x.DefaultLocale
+	}
+	return ""
+}
+
+type Server_Geolocation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Header a trusted proxy or CDN sets to the country of the client, e.g. CF-IPCountry.
+	// Only set it when every request comes through that proxy, since clients could
+	// send it themselves otherwise.
+	CountryHeader string `protobuf:"bytes,1,opt,name=country_header,json=countryHeader,proto3" json:"country_header,omitempty"`
+	// CSV file of IP ranges and their country, one "first,last,country" range per line
+	// as in the DB-IP and IP2Location LITE country databases. Used when the header is
+	// not set or missing from a request.
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	// Header a trusted proxy sets to the address of the client, e.g. X-Real-IP, to look
+	// up in the database instead of the address of the connection. It is only read on
+	// connections from trusted_proxies; for a list such as X-Forwarded-For, the
+	// right-most address that is not a trusted proxy is used.
+	ClientIpHeader string `protobuf:"bytes,3,opt,name=client_ip_header,json=clientIpHeader,proto3" json:"client_ip_header,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Server_Geolocation) Reset() {
+	*x = Server_Geolocation{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Geolocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Geolocation) ProtoMessage() {}
+
+func (x *Server_Geolocation) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Geolocation.ProtoReflect.Descriptor instead.
+func (*Server_Geolocation) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *Server_Geolocation) GetCountryHeader() string {
+	if x != nil {
+		return x.CountryHeader
+	}
+	return ""
+}
+
+func (x *Server_Geolocation) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *Server_Geolocation) GetClientIpHeader() string {
+	if x != nil {
+		return x.ClientIpHeader
+	}
+	return ""
+}
+
+type Server_HTTP_CORS struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AllowedOrigins   []string               `protobuf:"bytes,1,rep,name=allowed_origins,json=allowedOrigins,proto3" json:"allowed_origins,omitempty"`
+	AllowedMethods   []string               `protobuf:"bytes,2,rep,name=allowed_methods,json=allowedMethods,proto3" json:"allowed_methods,omitempty"`
+	AllowedHeaders   []string               `protobuf:"bytes,3,rep,name=allowed_headers,json=allowedHeaders,proto3" json:"allowed_headers,omitempty"`
+	ExposedHeaders   []string               `protobuf:"bytes,4,rep,name=exposed_headers,json=exposedHeaders,proto3" json:"exposed_headers,omitempty"`
+	AllowCredentials bool                   `protobuf:"varint,5,opt,name=allow_credentials,json=allowCredentials,proto3" json:"allow_credentials,omitempty"`
+	MaxAge           *durationpb.Duration   `protobuf:"bytes,6,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Server_HTTP_CORS) Reset() {
+	*x = Server_HTTP_CORS{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP_CORS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP_CORS) ProtoMessage() {}
+
+func (x *Server_HTTP_CORS) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP_CORS.ProtoReflect.Descriptor instead.
+func (*Server_HTTP_CORS) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+func (x *Server_HTTP_CORS) GetAllowedOrigins() []string {
+	if x != nil {
+		return x.AllowedOrigins
+	}
+	return nil
+}
+
+func (x *Server_HTTP_CORS) GetAllowedMethods() []string {
+	if x != nil {
+		return x.AllowedMethods
+	}
+	return nil
+}
+
+func (x *Server_HTTP_CORS) GetAllowedHeaders() []string {
+	if x != nil {
+		return x.AllowedHeaders
+	}
+	return nil
+}
+
+func (x *Server_HTTP_CORS) GetExposedHeaders() []string {
+	if x != nil {
+		return x.ExposedHeaders
+	}
+	return nil
+}
+
+func (x *Server_HTTP_CORS) GetAllowCredentials() bool {
+	if x != nil {
+		return x.AllowCredentials
+	}
+	return false
+}
+
+func (x *Server_HTTP_CORS) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+type Server_HTTP_SecurityHeaders struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ContentSecurityPolicy string                 `protobuf:"bytes,1,opt,name=content_security_policy,json=contentSecurityPolicy,proto3" json:"content_security_policy,omitempty"`
+	HstsMaxAge            *durationpb.Duration   `protobuf:"bytes,2,opt,name=hsts_max_age,json=hstsMaxAge,proto3" json:"hsts_max_age,omitempty"`
+	FrameOptions          string                 `protobuf:"bytes,3,opt,name=frame_options,json=frameOptions,proto3" json:"frame_options,omitempty"`
+	ReferrerPolicy        string                 `protobuf:"bytes,4,opt,name=referrer_policy,json=referrerPolicy,proto3" json:"referrer_policy,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Server_HTTP_SecurityHeaders) Reset() {
+	*x = Server_HTTP_SecurityHeaders{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP_SecurityHeaders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP_SecurityHeaders) ProtoMessage() {}
+
+func (x *Server_HTTP_SecurityHeaders) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP_SecurityHeaders.ProtoReflect.Descriptor instead.
+func (*Server_HTTP_SecurityHeaders) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0, 1}
+}
+
+func (x *Server_HTTP_SecurityHeaders) GetContentSecurityPolicy() string {
+	if x != nil {
+		return x.ContentSecurityPolicy
+	}
+	return ""
+}
+
+func (x *Server_HTTP_SecurityHeaders) GetHstsMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.HstsMaxAge
+	}
+	return nil
+}
+
+func (x *Server_HTTP_SecurityHeaders) GetFrameOptions() string {
+	if x != nil {
+		return x.FrameOptions
+	}
+	return ""
+}
+
+func (x *Server_HTTP_SecurityHeaders) GetReferrerPolicy() string {
+	if x != nil {
+		return x.ReferrerPolicy
+	}
+	return ""
+}
+
+type Server_HTTP_CSRF struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CookieName    string                 `protobuf:"bytes,2,opt,name=cookie_name,json=cookieName,proto3" json:"cookie_name,omitempty"`
+	HeaderName    string                 `protobuf:"bytes,3,opt,name=header_name,json=headerName,proto3" json:"header_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP_CSRF) Reset() {
+	*x = Server_HTTP_CSRF{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP_CSRF) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP_CSRF) ProtoMessage() {}
+
+func (x *Server_HTTP_CSRF) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP_CSRF.ProtoReflect.Descriptor instead.
+func (*Server_HTTP_CSRF) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0, 2}
+}
+
+func (x *Server_HTTP_CSRF) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Server_HTTP_CSRF) GetCookieName() string {
+	if x != nil {
+		return x.CookieName
+	}
+	return ""
+}
+
+func (x *Server_HTTP_CSRF) GetHeaderName() string {
+	if x != nil {
+		return x.HeaderName
+	}
+	return ""
+}
+
+type Server_HTTP_CacheRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Operation the rule applies to, e.g. /thmanyah.v1.DiscoverService/Featured.
+	Operation            string               `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	MaxAge               *durationpb.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	SMaxage              *durationpb.Duration `protobuf:"bytes,3,opt,name=s_maxage,json=sMaxage,proto3" json:"s_maxage,omitempty"`
+	StaleWhileRevalidate *durationpb.Duration `protobuf:"bytes,4,opt,name=stale_while_revalidate,json=staleWhileRevalidate,proto3" json:"stale_while_revalidate,omitempty"`
+	Private              bool                 `protobuf:"varint,5,opt,name=private,proto3" json:"private,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Server_HTTP_CacheRule) Reset() {
+	*x = Server_HTTP_CacheRule{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP_CacheRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP_CacheRule) ProtoMessage() {}
+
+func (x *Server_HTTP_CacheRule) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP_CacheRule.ProtoReflect.Descriptor instead.
+func (*Server_HTTP_CacheRule) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0, 3}
+}
+
+func (x *Server_HTTP_CacheRule) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *Server_HTTP_CacheRule) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *Server_HTTP_CacheRule) GetSMaxage() *durationpb.Duration {
+	if x != nil {
+		return x.SMaxage
+	}
+	return nil
+}
+
+func (x *Server_HTTP_CacheRule) GetStaleWhileRevalidate() *durationpb.Duration {
+	if x != nil {
+		return x.StaleWhileRevalidate
+	}
+	return nil
+}
+
+func (x *Server_HTTP_CacheRule) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type Server_HTTP_Compression struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Responses smaller than this many bytes are sent uncompressed.
+	MinSize       int64 `protobuf:"varint,2,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP_Compression) Reset() {
+	*x = Server_HTTP_Compression{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP_Compression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP_Compression) ProtoMessage() {}
+
+func (x *Server_HTTP_Compression) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP_Compression.ProtoReflect.Descriptor instead.
+func (*Server_HTTP_Compression) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0, 4}
+}
+
+func (x *Server_HTTP_Compression) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Server_HTTP_Compression) GetMinSize() int64 {
+	if x != nil {
+		return x.MinSize
+	}
+	return 0
+}
+
+type Observability_Metrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Observability_Metrics) Reset() {
+	*x = Observability_Metrics{}
+	mi := &file_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Observability_Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observability_Metrics) ProtoMessage() {}
+
+func (x *Observability_Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observability_Metrics.ProtoReflect.Descriptor instead.
+func (*Observability_Metrics) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Observability_Metrics) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Observability_Metrics) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type Observability_Tracing struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// OTLP/gRPC collector address, e.g. localhost:4317.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Insecure bool   `protobuf:"varint,3,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	// Fraction of root traces to sample; unset samples every trace.
+	SamplingRatio *float64 `protobuf:"fixed64,4,opt,name=sampling_ratio,json=samplingRatio,proto3,oneof" json:"sampling_ratio,omitempty"`
+	ServiceName   string   `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Observability_Tracing) Reset() {
+	*x = Observability_Tracing{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Observability_Tracing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observability_Tracing) ProtoMessage() {}
+
+func (x *Observability_Tracing) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observability_Tracing.ProtoReflect.Descriptor instead.
+func (*Observability_Tracing) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *Observability_Tracing) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Observability_Tracing) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Observability_Tracing) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *Observability_Tracing) GetSamplingRatio() float64 {
+	if x != nil && x.SamplingRatio != nil {
+		return *x.SamplingRatio
+	}
+	return 0
+}
+
+func (x *Observability_Tracing) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type Observability_Logging struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// debug, info, warn or error.
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// json or text.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Include the redacted request payload in access logs.
+	RequestPayloads bool `protobuf:"varint,3,opt,name=request_payloads,json=requestPayloads,proto3" json:"request_payloads,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Observability_Logging) Reset() {
+	*x = Observability_Logging{}
+	mi := &file_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Observability_Logging) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observability_Logging) ProtoMessage() {}
+
+func (x *Observability_Logging) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observability_Logging.ProtoReflect.Descriptor instead.
+func (*Observability_Logging) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *Observability_Logging) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *Observability_Logging) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Observability_Logging) GetRequestPayloads() bool {
+	if x != nil {
+		return x.RequestPayloads
+	}
+	return false
+}
+
+type Jobs_Webhooks struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// How often the dispatcher looks for due deliveries.
+	PollInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// Deliveries claimed per poll.
+	BatchSize int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Attempts before a delivery is dead-lettered.
+	MaxAttempts int32 `protobuf:"varint,4,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Delay before the first retry; doubled on every further failure up to max_backoff.
+	InitialBackoff *durationpb.Duration `protobuf:"bytes,5,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	MaxBackoff     *durationpb.Duration `protobuf:"bytes,6,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	RequestTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Jobs_Webhooks) Reset() {
+	*x = Jobs_Webhooks{}
+	mi := &file_conf_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Jobs_Webhooks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Jobs_Webhooks) ProtoMessage() {}
+
+func (x *Jobs_Webhooks) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Jobs_Webhooks.ProtoReflect.Descriptor instead.
+func (*Jobs_Webhooks) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *Jobs_Webhooks) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Jobs_Webhooks) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Jobs_Webhooks) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Jobs_Webhooks) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Jobs_Webhooks) GetInitialBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.InitialBackoff
+	}
+	return nil
+}
+
+func (x *Jobs_Webhooks) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+func (x *Jobs_Webhooks) GetRequestTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+type Jobs_EpisodeScheduler struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// How often the scheduler looks for episodes whose scheduled_at has passed.
+	PollInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// Episodes published per poll; the scheduler polls again at once while a batch is full.
+	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Jobs_EpisodeScheduler) Reset() {
+	*x = Jobs_EpisodeScheduler{}
+	mi := &file_conf_conf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Jobs_EpisodeScheduler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Jobs_EpisodeScheduler) ProtoMessage() {}
+
+func (x *Jobs_EpisodeScheduler) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Jobs_EpisodeScheduler.ProtoReflect.Descriptor instead.
+func (*Jobs_EpisodeScheduler) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *Jobs_EpisodeScheduler) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Jobs_EpisodeScheduler) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Jobs_EpisodeScheduler) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type Jobs_TrashPurger struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// How often the purger looks for content that has been in the trash too long.
+	PollInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// Rows of each kind purged per poll; the purger polls again at once while a batch is full.
+	BatchSize int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Days deleted content stays in the trash before it is purged for good.
+	RetentionDays int32 `protobuf:"varint,4,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Jobs_TrashPurger) Reset() {
+	*x = Jobs_TrashPurger{}
+	mi := &file_conf_conf_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Jobs_TrashPurger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Jobs_TrashPurger) ProtoMessage() {}
+
+func (x *Jobs_TrashPurger) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Jobs_TrashPurger.ProtoReflect.Descriptor instead.
+func (*Jobs_TrashPurger) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *Jobs_TrashPurger) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Jobs_TrashPurger) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Jobs_TrashPurger) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Jobs_TrashPurger) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+var File_conf_conf_proto protoreflect.FileDescriptor
+
+const file_conf_conf_proto_rawDesc = "" +
+	"\n" +
+	"\x0fconf/conf.proto\x12\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xd4\x02\n" +
+	"\tBootstrap\x12*\n" +
+	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12?\n" +
+	"\robservability\x18\x03 \x01(\v2\x19.kratos.api.ObservabilityR\robservability\x12$\n" +
+	"\x04jobs\x18\x04 \x01(\v2\x10.kratos.api.JobsR\x04jobs\x120\n" +
+	"\bworkflow\x18\x05 \x01(\v2\x14.kratos.api.WorkflowR\bworkflow\x123\n" +
+	"\trevisions\x18\x06 \x01(\v2\x15.kratos.api.RevisionsR\trevisions\x12'\n" +
+	"\x05audit\x18\a \x01(\v2\x11.kratos.api.AuditR\x05audit\"\xa0\x10\n" +
+	"\x06Server\x12+\n" +
+	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x124\n" +
+	"\agraphql\x18\x03 \x01(\v2\x1a.kratos.api.Server.GraphQLR\agraphql\x12C\n" +
+	"\flocalization\x18\x04 \x01(\v2\x1f.kratos.api.Server.LocalizationR\flocalization\x12@\n" +
+	"\vgeolocation\x18\x05 \x01(\v2\x1e.kratos.api.Server.GeolocationR\vgeolocation\x12'\n" +
+	"\x0ftrusted_proxies\x18\x06 \x03(\tR\x0etrustedProxies\x1a\xba\n" +
+	"\n" +
+	"\x04HTTP\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x120\n" +
+	"\x04cors\x18\x04 \x01(\v2\x1c.kratos.api.Server.HTTP.CORSR\x04cors\x12R\n" +
+	"\x10security_headers\x18\x05 \x01(\v2'.kratos.api.Server.HTTP.SecurityHeadersR\x0fsecurityHeaders\x120\n" +
+	"\x04csrf\x18\x06 \x01(\v2\x1c.kratos.api.Server.HTTP.CSRFR\x04csrf\x12B\n" +
+	"\vcache_rules\x18\a \x03(\v2!.kratos.api.Server.HTTP.CacheRuleR\n" +
+	"cacheRules\x12E\n" +
+	"\vcompression\x18\b \x01(\v2#.kratos.api.Server.HTTP.CompressionR\vcompression\x1a\x8b\x02\n" +
+	"\x04CORS\x12'\n" +
+	"\x0fallowed_origins\x18\x01 \x03(\tR\x0eallowedOrigins\x12'\n" +
+	"\x0fallowed_methods\x18\x02 \x03(\tR\x0eallowedMethods\x12'\n" +
+	"\x0fallowed_headers\x18\x03 \x03(\tR\x0eallowedHeaders\x12'\n" +
+	"\x0fexposed_headers\x18\x04 \x03(\tR\x0eexposedHeaders\x12+\n" +
+	"\x11allow_credentials\x18\x05 \x01(\bR\x10allowCredentials\x122\n" +
+	"\amax_age\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x1a\xd4\x01\n" +
+	"\x0fSecurityHeaders\x126\n" +
+	"\x17content_security_policy\x18\x01 \x01(\tR\x15contentSecurityPolicy\x12;\n" +
+	"\fhsts_max_age\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"hstsMaxAge\x12#\n" +
+	"\rframe_options\x18\x03 \x01(\tR\fframeOptions\x12'\n" +
+	"\x0freferrer_policy\x18\x04 \x01(\tR\x0ereferrerPolicy\x1ab\n" +
+	"\x04CSRF\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vcookie_name\x18\x02 \x01(\tR\n" +
+	"cookieName\x12\x1f\n" +
+	"\vheader_name\x18\x03 \x01(\tR\n" +
+	"headerName\x1a\xfe\x01\n" +
+	"\tCacheRule\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x122\n" +
+	"\amax_age\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x124\n" +
+	"\bs_maxage\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\asMaxage\x12O\n" +
+	"\x16stale_while_revalidate\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x14staleWhileRevalidate\x12\x18\n" +
+	"\aprivate\x18\x05 \x01(\bR\aprivate\x1aB\n" +
+	"\vCompression\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x19\n" +
+	"\bmin_size\x18\x02 \x01(\x03R\aminSize\x1ai\n" +
+	"\x04GRPC\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a{\n" +
+	"\aGraphQL\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1b\n" +
+	"\tmax_depth\x18\x03 \x01(\x05R\bmaxDepth\x12%\n" +
+	"\x0emax_complexity\x18\x04 \x01(\x05R\rmaxComplexity\x1a5\n" +
+	"\fLocalization\x12%\n" +
+	"\x0edefault_locale\x18\x01 \x01(\tR\rdefaultLocale\x1az\n" +
+	"\vGeolocation\x12%\n" +
+	"\x0ecountry_header\x18\x01 \x01(\tR\rcountryHeader\x12\x1a\n" +
+	"\bdatabase\x18\x02 \x01(\tR\bdatabase\x12(\n" +
+	"\x10client_ip_header\x18\x03 \x01(\tR\x0eclientIpHeader\"z\n" +
+	"\bDatabase\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x16\n" +
 	"\x06dbname\x18\x04 \x01(\tR\x06dbname\x12\x12\n" +
 	"\x04port\x18\x05 \x01(\x03R\x04port\"\xb6\x01\n" +
@@ -496,7 +1901,59 @@ const file_conf_conf_proto_rawDesc = "" +
 	"files_host\x18\x06 \x01(\tR\tfilesHost\"X\n" +
 	"\x04Data\x120\n" +
 	"\bpostgres\x18\x01 \x01(\v2\x14.kratos.api.DatabaseR\bpostgres\x12\x1e\n" +
-	"\x02s3\x18\x03 \x01(\v2\x0e.kratos.api.S3R\x02s3B\x1fZ\x1dgeeksquest/internal/conf;confb\x06proto3"
+	"\x02s3\x18\x03 \x01(\v2\x0e.kratos.api.S3R\x02s3\"\xa3\x04\n" +
+	"\rObservability\x12;\n" +
+	"\ametrics\x18\x01 \x01(\v2!.kratos.api.Observability.MetricsR\ametrics\x12;\n" +
+	"\atracing\x18\x02 \x01(\v2!.kratos.api.Observability.TracingR\atracing\x12;\n" +
+	"\alogging\x18\x03 \x01(\v2!.kratos.api.Observability.LoggingR\alogging\x1a7\n" +
+	"\aMetrics\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x1a\xbd\x01\n" +
+	"\aTracing\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\binsecure\x18\x03 \x01(\bR\binsecure\x12*\n" +
+	"\x0esampling_ratio\x18\x04 \x01(\x01H\x00R\rsamplingRatio\x88\x01\x01\x12!\n" +
+	"\fservice_name\x18\x05 \x01(\tR\vserviceNameB\x11\n" +
+	"\x0f_sampling_ratio\x1ab\n" +
+	"\aLogging\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12)\n" +
+	"\x10request_payloads\x18\x03 \x01(\bR\x0frequestPayloads\"\xf9\x06\n" +
+	"\x04Jobs\x125\n" +
+	"\bwebhooks\x18\x01 \x01(\v2\x19.kratos.api.Jobs.WebhooksR\bwebhooks\x12N\n" +
+	"\x11episode_scheduler\x18\x02 \x01(\v2!.kratos.api.Jobs.EpisodeSchedulerR\x10episodeScheduler\x12?\n" +
+	"\ftrash_purger\x18\x03 \x01(\v2\x1c.kratos.api.Jobs.TrashPurgerR\vtrashPurger\x1a\xea\x02\n" +
+	"\bWebhooks\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12>\n" +
+	"\rpoll_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x12!\n" +
+	"\fmax_attempts\x18\x04 \x01(\x05R\vmaxAttempts\x12B\n" +
+	"\x0finitial_backoff\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0einitialBackoff\x12:\n" +
+	"\vmax_backoff\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x12B\n" +
+	"\x0frequest_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0erequestTimeout\x1a\x8b\x01\n" +
+	"\x10EpisodeScheduler\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12>\n" +
+	"\rpoll_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x1a\xad\x01\n" +
+	"\vTrashPurger\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12>\n" +
+	"\rpoll_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x12%\n" +
+	"\x0eretention_days\x18\x04 \x01(\x05R\rretentionDays\"\x86\x01\n" +
+	"\bWorkflow\x12'\n" +
+	"\x0freview_required\x18\x01 \x01(\bR\x0ereviewRequired\x12!\n" +
+	"\freviewer_ids\x18\x02 \x03(\tR\vreviewerIds\x12.\n" +
+	"\x13allow_self_approval\x18\x03 \x01(\bR\x11allowSelfApproval\"e\n" +
+	"\tRevisions\x12+\n" +
+	"\x11program_retention\x18\x01 \x01(\x05R\x10programRetention\x12+\n" +
+	"\x11episode_retention\x18\x02 \x01(\x05R\x10episodeRetention\"$\n" +
+	"\x05Audit\x12\x1b\n" +
+	"\tadmin_ids\x18\x01 \x03(\tR\badminIdsB\x1fZ\x1dgeeksquest/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -510,31 +1967,80 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Database)(nil),            // 2: kratos.api.Database
-	(*S3)(nil),                  // 3: kratos.api.S3
-	(*Data)(nil),                // 4: kratos.api.Data
-	(*Server_HTTP)(nil),         // 5: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 6: kratos.api.Server.GRPC
-	(*durationpb.Duration)(nil), // 7: google.protobuf.Duration
+	(*Bootstrap)(nil),                   // 0: kratos.api.Bootstrap
+	(*Server)(nil),                      // 1: kratos.api.Server
+	(*Database)(nil),                    // 2: kratos.api.Database
+	(*S3)(nil),                          // 3: kratos.api.S3
+	(*Data)(nil),                        // 4: kratos.api.Data
+	(*Observability)(nil),               // 5: kratos.api.Observability
+	(*Jobs)(nil),                        // 6: kratos.api.Jobs
+	(*Workflow)(nil),                    // 7: kratos.api.Workflow
+	(*Revisions)(nil),                   // 8: kratos.api.Revisions
+	(*Audit)(nil),                       // 9: kratos.api.Audit
+	(*Server_HTTP)(nil),                 // 10: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),                 // 11: kratos.api.Server.GRPC
+	(*Server_GraphQL)(nil),              // 12: kratos.api.Server.GraphQL
+	(*Server_Localization)(nil),         // 13: kratos.api.Server.Localization
+	(*Server_Geolocation)(nil),          // 14: kratos.api.Server.Geolocation
+	(*Server_HTTP_CORS)(nil),            // 15: kratos.api.Server.HTTP.CORS
+	(*Server_HTTP_SecurityHeaders)(nil), // 16: kratos.api.Server.HTTP.SecurityHeaders
+	(*Server_HTTP_CSRF)(nil),            // 17: kratos.api.Server.HTTP.CSRF
+	(*Server_HTTP_CacheRule)(nil),       // 18: kratos.api.Server.HTTP.CacheRule
+	(*Server_HTTP_Compression)(nil),     // 19: kratos.api.Server.HTTP.Compression
+	(*Observability_Metrics)(nil),       // 20: kratos.api.Observability.Metrics
+	(*Observability_Tracing)(nil),       // 21: kratos.api.Observability.Tracing
+	(*Observability_Logging)(nil),       // 22: kratos.api.Observability.Logging
+	(*Jobs_Webhooks)(nil),               // 23: kratos.api.Jobs.Webhooks
+	(*Jobs_EpisodeScheduler)(nil),       // 24: kratos.api.Jobs.EpisodeScheduler
+	(*Jobs_TrashPurger)(nil),            // 25: kratos.api.Jobs.TrashPurger
+	(*durationpb.Duration)(nil),         // 26: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
-	1, // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	4, // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	5, // 2: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	6, // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	2, // 4: kratos.api.Data.postgres:type_name -> kratos.api.Database
-	3, // 5: kratos.api.Data.s3:type_name -> kratos.api.S3
-	7, // 6: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	7, // 7: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	4,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
+	5,  // 2: kratos.api.Bootstrap.observability:type_name -> kratos.api.Observability
+	6,  // 3: kratos.api.Bootstrap.jobs:type_name -> kratos.api.Jobs
+	7,  // 4: kratos.api.Bootstrap.workflow:type_name -> kratos.api.Workflow
+	8,  // 5: kratos.api.Bootstrap.revisions:type_name -> kratos.api.Revisions
+	9,  // 6: kratos.api.Bootstrap.audit:type_name -> kratos.api.Audit
+	10, // 7: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	11, // 8: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	12, // 9: kratos.api.Server.graphql:type_name -> kratos.api.Server.GraphQL
+	13, // 10: kratos.api.Server.localization:type_name -> kratos.api.Server.Localization
+	14, // 11: kratos.api.Server.geolocation:type_name -> kratos.api.Server.Geolocation
+	2,  // 12: kratos.api.Data.postgres:type_name -> kratos.api.Database
+	3,  // 13: kratos.api.Data.s3:type_name -> kratos.api.S3
+	20, // 14: kratos.api.Observability.metrics:type_name -> kratos.api.Observability.Metrics
+	21, // 15: kratos.api.Observability.tracing:type_name -> kratos.api.Observability.Tracing
+	22, // 16: kratos.api.Observability.logging:type_name -> kratos.api.Observability.Logging
+	23, // 17: kratos.api.Jobs.webhooks:type_name -> kratos.api.Jobs.Webhooks
+	24, // 18: kratos.api.Jobs.episode_scheduler:type_name -> kratos.api.Jobs.EpisodeScheduler
+	25, // 19: kratos.api.Jobs.trash_purger:type_name -> kratos.api.Jobs.TrashPurger
+	26, // 20: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	15, // 21: kratos.api.Server.HTTP.cors:type_name -> kratos.api.Server.HTTP.CORS
+	16, // 22: kratos.api.Server.HTTP.security_headers:type_name -> kratos.api.Server.HTTP.SecurityHeaders
+	17, // 23: kratos.api.Server.HTTP.csrf:type_name -> kratos.api.Server.HTTP.CSRF
+	18, // 24: kratos.api.Server.HTTP.cache_rules:type_name -> kratos.api.Server.HTTP.CacheRule
+	19, // 25: kratos.api.Server.HTTP.compression:type_name -> kratos.api.Server.HTTP.Compression
+	26, // 26: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	26, // 27: kratos.api.Server.HTTP.CORS.max_age:type_name -> google.protobuf.Duration
+	26, // 28: kratos.api.Server.HTTP.SecurityHeaders.hsts_max_age:type_name -> google.protobuf.Duration
+	26, // 29: kratos.api.Server.HTTP.CacheRule.max_age:type_name -> google.protobuf.Duration
+	26, // 30: kratos.api.Server.HTTP.CacheRule.s_maxage:type_name -> google.protobuf.Duration
+	26, // 31: kratos.api.Server.HTTP.CacheRule.stale_while_revalidate:type_name -> google.protobuf.Duration
+	26, // 32: kratos.api.Jobs.Webhooks.poll_interval:type_name -> google.protobuf.Duration
+	26, // 33: kratos.api.Jobs.Webhooks.initial_backoff:type_name -> google.protobuf.Duration
+	26, // 34: kratos.api.Jobs.Webhooks.max_backoff:type_name -> google.protobuf.Duration
+	26, // 35: kratos.api.Jobs.Webhooks.request_timeout:type_name -> google.protobuf.Duration
+	26, // 36: kratos.api.Jobs.EpisodeScheduler.poll_interval:type_name -> google.protobuf.Duration
+	26, // 37: kratos.api.Jobs.TrashPurger.poll_interval:type_name -> google.protobuf.Duration
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -542,13 +2048,14 @@ func file_conf_conf_proto_init() {
 	if File_conf_conf_proto != nil {
 		return
 	}
+	file_conf_conf_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,108 @@
+package geo
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"slices"
+
+	"thmanyah/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Database is a Resolver over IP ranges loaded in memory from a local file.
+type Database struct {
+	ranges []ipRange
+}
+
+type ipRange struct {
+	first, last netip.Addr
+	country     string
+}
+
+// NewResolver opens the database of the configuration. Without one, it returns a nil
+// Resolver and countries only come from the country header.
+func NewResolver(c *conf.Server, logger log.Logger) (Resolver, error) {
+	path := c.GetGeolocation().GetDatabase()
+	if path == "" {
+		return nil, nil
+	}
+
+	db, err := OpenDatabase(path)
+	if err != nil {
+		return nil, err
+	}
+	log.NewHelper(logger).Infof("loaded %d IP ranges from %s", len(db.ranges), path)
+	return db, nil
+}
+
+// OpenDatabase loads a CSV file with one "first,last,country" IP range per line, IPv4
+// or IPv6, as in the DB-IP and IP2Location LITE country databases. Further columns, and
+// a header line, are ignored.
+func OpenDatabase(path string) (*Database, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open geolocation database: %w", err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+
+	db := &Database{}
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read geolocation database: %w", err)
+		}
+		if len(record) < 3 {
+			return nil, fmt.Errorf("geolocation database line %d: want first,last,country", line)
+		}
+
+		first, errFirst := netip.ParseAddr(record[0])
+		last, errLast := netip.ParseAddr(record[1])
+		if errFirst != nil || errLast != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("geolocation database line %d: invalid IP range", line)
+		}
+		country, ok := normalizeCountry(record[2])
+		if !ok {
+			// Ranges without a country, such as reserved ones, are left unknown
+			continue
+		}
+
+		db.ranges = append(db.ranges, ipRange{first: first.Unmap(), last: last.Unmap(), country: country})
+	}
+
+	slices.SortFunc(db.ranges, func(a, b ipRange) int { return a.first.Compare(b.first) })
+	return db, nil
+}
+
+// Country finds the range addr is in. Ranges are assumed not to overlap.
+func (db *Database) Country(addr netip.Addr) (string, bool) {
+	addr = addr.Unmap()
+	i, found := slices.BinarySearchFunc(db.ranges, addr, func(r ipRange, addr netip.Addr) int { return r.first.Compare(addr) })
+	if !found {
+		// The range starting before addr is the only one it can be in
+		if i == 0 {
+			return "", false
+		}
+		i--
+	}
+
+	r := db.ranges[i]
+	if addr.Compare(r.last) > 0 || addr.BitLen() != r.first.BitLen() {
+		return "", false
+	}
+	return r.country, true
+}
@@ -0,0 +1,134 @@
+// Package geo finds the country callers are in, for content that is only licensed in
+// some countries.
+package geo
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/utils"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	varyHeader         = "Vary"
+	cacheControlHeader = "Cache-Control"
+)
+
+// PrivateCacheControl is set on replies that depend on the country of the caller when
+// it was found from their address.
+const PrivateCacheControl = "private"
+
+// Resolver finds the country of an IP address, as an upper-case ISO 3166-1 alpha-2 code.
+type Resolver interface {
+	Country(addr netip.Addr) (string, bool)
+}
+
+// Locator finds the country of callers from a header set by a trusted proxy, or else by
+// looking their address up with a Resolver.
+type Locator struct {
+	countryHeader  string
+	clientIPHeader string
+	proxies        utils.TrustedProxies
+	resolver       Resolver
+}
+
+// NewLocator reads where to find countries from c. The client IP header is only read on
+// connections from proxies. resolver may be nil, in which case only the country header
+// is used.
+func NewLocator(c *conf.Server, proxies utils.TrustedProxies, resolver Resolver) *Locator {
+	return &Locator{
+		countryHeader:  c.GetGeolocation().GetCountryHeader(),
+		clientIPHeader: c.GetGeolocation().GetClientIpHeader(),
+		proxies:        proxies,
+		resolver:       resolver,
+	}
+}
+
+type locatorKey struct{}
+
+// Server makes the country of callers available to Country. It is only looked up when
+// asked for.
+func (l *Locator) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			return handler(context.WithValue(ctx, locatorKey{}, l), req)
+		}
+	}
+}
+
+// Country returns the country the caller is in, as an upper-case ISO 3166-1 alpha-2
+// code, or "" when it is not known.
+func Country(ctx context.Context) string {
+	l, ok := ctx.Value(locatorKey{}).(*Locator)
+	if !ok {
+		return ""
+	}
+
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if l.countryHeader != "" {
+		// The reply depends on the header, so shared caches must keep one copy per value
+		tr.ReplyHeader().Add(varyHeader, l.countryHeader)
+		if country, ok := normalizeCountry(tr.RequestHeader().Get(l.countryHeader)); ok {
+			return country
+		}
+	}
+
+	if l.resolver == nil {
+		return ""
+	}
+	// No Vary header can describe a reply that depends on the address of the caller, so
+	// shared caches must not keep it at all; see server.NewResponseEncoder
+	tr.ReplyHeader().Set(cacheControlHeader, PrivateCacheControl)
+	addr, ok := l.clientAddr(ctx, tr)
+	if !ok {
+		return ""
+	}
+	country, ok := l.resolver.Country(addr)
+	if !ok {
+		return ""
+	}
+	return country
+}
+
+// clientAddr is the address of the caller: the address of the connection, or the one
+// trusted proxies reported in the client IP header.
+func (l *Locator) clientAddr(ctx context.Context, tr transport.Transporter) (netip.Addr, bool) {
+	var remote string
+	if r, ok := http.RequestFromServerContext(ctx); ok {
+		remote = r.RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remote = p.Addr.String()
+	}
+
+	var forwarded []string
+	if l.clientIPHeader != "" {
+		forwarded = tr.RequestHeader().Values(l.clientIPHeader)
+	}
+	return l.proxies.ClientAddr(remote, forwarded)
+}
+
+// normalizeCountry upper-cases an ISO 3166-1 alpha-2 code. XX, which CDNs send for
+// unknown addresses, is not a country.
+func normalizeCountry(country string) (string, bool) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if len(country) != 2 || country == "XX" {
+		return "", false
+	}
+	for _, c := range country {
+		if c < 'A' || c > 'Z' {
+			return "", false
+		}
+	}
+	return country, true
+}
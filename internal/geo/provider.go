@@ -0,0 +1,10 @@
+package geo
+
+import (
+	"github.com/google/wire"
+)
+
+var ProviderSet = wire.NewSet(
+	NewResolver,
+	NewLocator,
+)
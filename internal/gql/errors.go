@@ -0,0 +1,35 @@
+package gql
+
+import (
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// resolverError exposes the kratos reason and status of a use case error in the
+// GraphQL error's extensions, mirroring what REST clients see in the error body.
+type resolverError struct {
+	err *errors.Error
+}
+
+func (e *resolverError) Error() string {
+	return e.err.Message
+}
+
+func (e *resolverError) Extensions() map[string]any {
+	return map[string]any{
+		"reason": e.err.Reason,
+		"code":   e.err.Code,
+	}
+}
+
+func toGraphQLError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var e *errors.Error
+	if errors.As(err, &e) {
+		return &resolverError{err: e}
+	}
+
+	return err
+}
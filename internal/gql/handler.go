@@ -0,0 +1,106 @@
+package gql
+
+import (
+	"context"
+	"fmt"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+const (
+	defaultPath          = "/graphql"
+	defaultMaxDepth      = 10
+	defaultMaxComplexity = 1000
+)
+
+// Request is the body of a GraphQL POST.
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Handler executes GraphQL queries against the catalog schema.
+type Handler struct {
+	schema        graphql.Schema
+	uc            *biz.UseCase
+	enabled       bool
+	path          string
+	maxDepth      int
+	maxComplexity int
+}
+
+func NewHandler(c *conf.Server, uc *biz.UseCase) (*Handler, error) {
+	schema, err := NewSchema(uc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build graphql schema: %w", err)
+	}
+
+	g := c.GetGraphql()
+	h := &Handler{
+		schema:        schema,
+		uc:            uc,
+		enabled:       g.GetEnabled(),
+		path:          g.GetPath(),
+		maxDepth:      int(g.GetMaxDepth()),
+		maxComplexity: int(g.GetMaxComplexity()),
+	}
+	if h.path == "" {
+		h.path = defaultPath
+	}
+	if h.maxDepth <= 0 {
+		h.maxDepth = defaultMaxDepth
+	}
+	if h.maxComplexity <= 0 {
+		h.maxComplexity = defaultMaxComplexity
+	}
+
+	return h, nil
+}
+
+func (h *Handler) Enabled() bool {
+	return h.enabled
+}
+
+func (h *Handler) Path() string {
+	return h.path
+}
+
+// Execute runs req with a fresh set of loaders. Queries over the depth or complexity
+// limits are rejected before any resolver runs.
+func (h *Handler) Execute(ctx context.Context, req *Request) *graphql.Result {
+	// Syntax errors are left for graphql.Do to report in its usual shape.
+	if doc, err := parser.Parse(parser.ParseParams{Source: req.Query}); err == nil {
+		depth, complexity, err := measure(doc, req.OperationName, req.Variables)
+		if err != nil {
+			return rejected("INVALID_QUERY", err.Error())
+		}
+		if depth > h.maxDepth {
+			return rejected("QUERY_TOO_DEEP",
+				fmt.Sprintf("query depth %d exceeds the limit of %d", depth, h.maxDepth))
+		}
+		if complexity > h.maxComplexity {
+			return rejected("QUERY_TOO_COMPLEX",
+				fmt.Sprintf("query complexity %d exceeds the limit of %d", complexity, h.maxComplexity))
+		}
+	}
+
+	return graphql.Do(graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		OperationName:  req.OperationName,
+		VariableValues: req.Variables,
+		Context:        withLoaders(ctx, newLoaders(h.uc)),
+	})
+}
+
+func rejected(reason, message string) *graphql.Result {
+	err := gqlerrors.NewFormattedError(message)
+	err.Extensions = map[string]any{"reason": reason}
+	return &graphql.Result{Errors: []gqlerrors.FormattedError{err}}
+}
@@ -0,0 +1,171 @@
+package gql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// paginatedFields are list fields whose children are resolved once per item on the page.
+var paginatedFields = map[string]bool{
+	"programs":   true,
+	"categories": true,
+	"episodes":   true,
+}
+
+// maxCost bounds complexity so that it cannot overflow; any query reaching it is far
+// over every configured limit.
+const maxCost = 1 << 31
+
+// queryCost measures the operation about to run before any resolver is called.
+// Depth counts nested fields; complexity counts fields, multiplying the subtree of a
+// paginated field by its page size. Introspection fields are free.
+type queryCost struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+	// measured holds the cost of each fragment once walked, so that spreading a
+	// fragment many times, or fragments spreading each other, costs a lookup.
+	measured map[string]fragmentCost
+	// cycle names a fragment that spreads itself. It has to be caught here: the
+	// library's own validation recurses without bound on such documents.
+	cycle string
+}
+
+func measure(doc *ast.Document, operationName string, variables map[string]any) (depth, complexity int, err error) {
+	qc := &queryCost{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		measured:  make(map[string]fragmentCost),
+	}
+
+	var operation *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.FragmentDefinition:
+			qc.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operation == nil && (operationName == "" || (def.Name != nil && def.Name.Value == operationName)) {
+				operation = def
+			}
+		}
+	}
+	if operation == nil {
+		return 0, 0, nil
+	}
+	qc.variables = withDefaults(operation, variables)
+
+	depth, complexity = qc.selectionSet(operation.SelectionSet, map[string]bool{})
+	if qc.cycle != "" {
+		return 0, 0, fmt.Errorf("fragment %q spreads itself", qc.cycle)
+	}
+
+	return depth, complexity, nil
+}
+
+func (qc *queryCost) selectionSet(set *ast.SelectionSet, visiting map[string]bool) (depth, complexity int) {
+	if set == nil {
+		return 0, 0
+	}
+
+	for _, selection := range set.Selections {
+		var d, c int
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(selection.Name.Value, "__") {
+				continue
+			}
+			childDepth, childComplexity := qc.selectionSet(selection.SelectionSet, visiting)
+			d = childDepth + 1
+			c = min(1+qc.multiplier(selection)*childComplexity, maxCost)
+		case *ast.InlineFragment:
+			d, c = qc.selectionSet(selection.SelectionSet, visiting)
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			fragment, ok := qc.fragments[name]
+			if !ok {
+				continue
+			}
+			if visiting[name] {
+				qc.cycle = name
+				continue
+			}
+			if cost, ok := qc.measured[name]; ok {
+				d, c = cost.depth, cost.complexity
+				break
+			}
+			visiting[name] = true
+			d, c = qc.selectionSet(fragment.SelectionSet, visiting)
+			delete(visiting, name)
+			qc.measured[name] = fragmentCost{depth: d, complexity: c}
+		}
+
+		depth = max(depth, d)
+		complexity = min(complexity+c, maxCost)
+	}
+
+	return depth, complexity
+}
+
+type fragmentCost struct {
+	depth, complexity int
+}
+
+// withDefaults adds the default values the operation declares for the variables the
+// request leaves out, since those are what the resolvers will get.
+func withDefaults(operation *ast.OperationDefinition, variables map[string]any) map[string]any {
+	values := make(map[string]any, len(variables)+len(operation.VariableDefinitions))
+	for name, value := range variables {
+		values[name] = value
+	}
+
+	for _, def := range operation.VariableDefinitions {
+		name := def.Variable.Name.Value
+		if _, ok := values[name]; ok {
+			continue
+		}
+		if value, ok := def.DefaultValue.(*ast.IntValue); ok {
+			if n, err := strconv.Atoi(value.Value); err == nil {
+				values[name] = n
+			}
+		}
+	}
+
+	return values
+}
+
+func (qc *queryCost) multiplier(f *ast.Field) int {
+	if !paginatedFields[f.Name.Value] {
+		return 1
+	}
+
+	for _, arg := range f.Arguments {
+		if arg.Name.Value != "pageSize" {
+			continue
+		}
+
+		switch value := arg.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(value.Value); err == nil {
+				return clampPageSize(n)
+			}
+		case *ast.Variable:
+			switch n := qc.variables[value.Name.Value].(type) {
+			case float64:
+				return clampPageSize(int(n))
+			case int:
+				return clampPageSize(n)
+			}
+		}
+	}
+
+	return defaultPageSize
+}
+
+func clampPageSize(n int) int {
+	if n < 1 {
+		return defaultPageSize
+	}
+	return min(n, maxPageSize)
+}
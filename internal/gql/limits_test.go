@@ -0,0 +1,121 @@
+package gql
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parse(t *testing.T, query string) *ast.Document {
+	t.Helper()
+
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	require.NoError(t, err)
+	return doc
+}
+
+func TestMeasure(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		variables  map[string]any
+		depth      int
+		complexity int
+	}{
+		{
+			name:       "Flat",
+			query:      `{ program(id: "x") { id title } }`,
+			depth:      2,
+			complexity: 3,
+		},
+		{
+			name:       "DefaultPageSize",
+			query:      `{ programs { items { id } } }`,
+			depth:      3,
+			complexity: 1 + defaultPageSize*2,
+		},
+		{
+			name:       "LiteralPageSize",
+			query:      `{ programs(pageSize: 50) { items { id } } }`,
+			depth:      3,
+			complexity: 1 + 50*2,
+		},
+		{
+			name:       "PageSizeClamped",
+			query:      `{ programs(pageSize: 1000) { items { id } } }`,
+			depth:      3,
+			complexity: 1 + maxPageSize*2,
+		},
+		{
+			name:       "VariablePageSize",
+			query:      `query($n: Int) { programs(pageSize: $n) { items { id } } }`,
+			variables:  map[string]any{"n": float64(40)},
+			depth:      3,
+			complexity: 1 + 40*2,
+		},
+		{
+			name:       "VariableDefault",
+			query:      `query($n: Int = 100) { programs(pageSize: $n) { items { id } } }`,
+			depth:      3,
+			complexity: 1 + 100*2,
+		},
+		{
+			name:       "VariableOverridesDefault",
+			query:      `query($n: Int = 100) { programs(pageSize: $n) { items { id } } }`,
+			variables:  map[string]any{"n": float64(10)},
+			depth:      3,
+			complexity: 1 + 10*2,
+		},
+		{
+			name:       "Fragments",
+			query:      `{ program(id: "x") { ...A ...A } } fragment A on Program { id ...B } fragment B on Program { title }`,
+			depth:      2,
+			complexity: 5,
+		},
+		{
+			name:       "Introspection",
+			query:      `{ __schema { types { name } } }`,
+			depth:      0,
+			complexity: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			depth, complexity, err := measure(parse(t, tt.query), "", tt.variables)
+			require.NoError(t, err)
+			assert.Equal(t, tt.depth, depth)
+			assert.Equal(t, tt.complexity, complexity)
+		})
+	}
+}
+
+func TestMeasure_FragmentCycle(t *testing.T) {
+	_, _, err := measure(parse(t, `{ program(id: "x") { ...A } } fragment A on Program { ...B } fragment B on Program { ...A }`), "", nil)
+	assert.Error(t, err)
+}
+
+// Each fragment spreads the next one twice, which doubles the cost at every level;
+// walking it naively takes 2^n steps.
+func TestMeasure_FragmentFanOut(t *testing.T) {
+	const n = 64
+
+	var b strings.Builder
+	b.WriteString(`{ program(id: "x") { ...F0 } }`)
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, " fragment F%d on Program { ...F%d ...F%d }", i, i+1, i+1)
+	}
+	fmt.Fprintf(&b, " fragment F%d on Program { id }", n)
+
+	start := time.Now()
+	_, complexity, err := measure(parse(t, b.String()), "", nil)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, maxCost, complexity)
+}
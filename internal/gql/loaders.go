@@ -0,0 +1,135 @@
+package gql
+
+import (
+	"context"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+// episodesKey identifies one program's page of episodes; programs requested with the
+// same page are fetched together.
+type episodesKey struct {
+	programID uuid.UUID
+	page      int32
+	pageSize  int32
+}
+
+// loaders batch the nested lookups of a single request so that resolving a list of N
+// programs costs one query per relation instead of N.
+type loaders struct {
+	programs   *dataloader.Loader[uuid.UUID, *biz.Program]
+	categories *dataloader.Loader[uuid.UUID, *biz.Category]
+	episodes   *dataloader.Loader[episodesKey, *biz.EpisodePage]
+}
+
+type loadersKey struct{}
+
+func newLoaders(uc *biz.UseCase) *loaders {
+	return &loaders{
+		programs:   dataloader.NewBatchedLoader(batchPrograms(uc)),
+		categories: dataloader.NewBatchedLoader(batchCategories(uc)),
+		episodes:   dataloader.NewBatchedLoader(batchEpisodes(uc)),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func batchPrograms(uc *biz.UseCase) dataloader.BatchFunc[uuid.UUID, *biz.Program] {
+	return func(ctx context.Context, ids []uuid.UUID) []*dataloader.Result[*biz.Program] {
+		programs, err := uc.GetProgramsByIDs(ctx, ids)
+		if err != nil {
+			return failAll[*biz.Program](len(ids), err)
+		}
+
+		byID := make(map[uuid.UUID]*biz.Program, len(programs))
+		for _, program := range programs {
+			byID[program.ID] = program
+		}
+
+		results := make([]*dataloader.Result[*biz.Program], len(ids))
+		for i, id := range ids {
+			if program, ok := byID[id]; ok {
+				results[i] = &dataloader.Result[*biz.Program]{Data: program}
+			} else {
+				results[i] = &dataloader.Result[*biz.Program]{Error: biz.ErrProgramNotFound}
+			}
+		}
+		return results
+	}
+}
+
+func batchCategories(uc *biz.UseCase) dataloader.BatchFunc[uuid.UUID, *biz.Category] {
+	return func(ctx context.Context, ids []uuid.UUID) []*dataloader.Result[*biz.Category] {
+		categories, err := uc.GetCategoriesByIDs(ctx, ids)
+		if err != nil {
+			return failAll[*biz.Category](len(ids), err)
+		}
+
+		byID := make(map[uuid.UUID]*biz.Category, len(categories))
+		for _, category := range categories {
+			byID[category.ID] = category
+		}
+
+		results := make([]*dataloader.Result[*biz.Category], len(ids))
+		for i, id := range ids {
+			if category, ok := byID[id]; ok {
+				results[i] = &dataloader.Result[*biz.Category]{Data: category}
+			} else {
+				results[i] = &dataloader.Result[*biz.Category]{Error: biz.ErrCategoryNotFound}
+			}
+		}
+		return results
+	}
+}
+
+func batchEpisodes(uc *biz.UseCase) dataloader.BatchFunc[episodesKey, *biz.EpisodePage] {
+	return func(ctx context.Context, keys []episodesKey) []*dataloader.Result[*biz.EpisodePage] {
+		type window struct{ page, pageSize int32 }
+
+		groups := make(map[window][]uuid.UUID)
+		for _, key := range keys {
+			w := window{key.page, key.pageSize}
+			groups[w] = append(groups[w], key.programID)
+		}
+
+		pages := make(map[episodesKey]*biz.EpisodePage, len(keys))
+		for w, programIDs := range groups {
+			byProgram, err := uc.ListEpisodesByPrograms(ctx, programIDs,
+				biz.PaginationRequest{Page: w.page, PageSize: w.pageSize}, biz.SortRequest{})
+			if err != nil {
+				return failAll[*biz.EpisodePage](len(keys), err)
+			}
+
+			for programID, page := range byProgram {
+				pages[episodesKey{programID, w.page, w.pageSize}] = page
+			}
+		}
+
+		results := make([]*dataloader.Result[*biz.EpisodePage], len(keys))
+		for i, key := range keys {
+			if page, ok := pages[key]; ok {
+				results[i] = &dataloader.Result[*biz.EpisodePage]{Data: page}
+			} else {
+				results[i] = &dataloader.Result[*biz.EpisodePage]{Error: biz.ErrUnauthorized}
+			}
+		}
+		return results
+	}
+}
+
+func failAll[V any](n int, err error) []*dataloader.Result[V] {
+	results := make([]*dataloader.Result[V], n)
+	for i := range results {
+		results[i] = &dataloader.Result[V]{Error: err}
+	}
+	return results
+}
@@ -0,0 +1,9 @@
+package gql
+
+import (
+	"github.com/google/wire"
+)
+
+var ProviderSet = wire.NewSet(
+	NewHandler,
+)
@@ -0,0 +1,374 @@
+package gql
+
+import (
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+	"thmanyah/internal/validation"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var categoryTypeEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "CategoryType",
+	Values: graphql.EnumValueConfigMap{
+		"PODCAST":       {Value: biz.CategoryTypePodcast},
+		"DOCUMENTARY":   {Value: biz.CategoryTypeDocumentary},
+		"SPORTS_EVENT":  {Value: biz.CategoryTypeSportsEvent},
+		"EDUCATIONAL":   {Value: biz.CategoryTypeEducational},
+		"NEWS":          {Value: biz.CategoryTypeNews},
+		"ENTERTAINMENT": {Value: biz.CategoryTypeEntertainment},
+	},
+})
+
+var programStatusEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "ProgramStatus",
+	Values: graphql.EnumValueConfigMap{
+		"DRAFT":     {Value: biz.ProgramStatusDraft},
+		"PUBLISHED": {Value: biz.ProgramStatusPublished},
+		"ARCHIVED":  {Value: biz.ProgramStatusArchived},
+		"IN_REVIEW": {Value: biz.ProgramStatusInReview},
+		"APPROVED":  {Value: biz.ProgramStatusApproved},
+	},
+})
+
+var episodeStatusEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "EpisodeStatus",
+	Values: graphql.EnumValueConfigMap{
+		"DRAFT":     {Value: biz.EpisodeStatusDraft},
+		"PUBLISHED": {Value: biz.EpisodeStatusPublished},
+		"SCHEDULED": {Value: biz.EpisodeStatusScheduled},
+		"ARCHIVED":  {Value: biz.EpisodeStatusArchived},
+		"IN_REVIEW": {Value: biz.EpisodeStatusInReview},
+		"APPROVED":  {Value: biz.EpisodeStatusApproved},
+	},
+})
+
+var pageInfoType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: graphql.Fields{
+		"page":       field(graphql.NewNonNull(graphql.Int), func(p *biz.PaginationResponse) any { return p.Page }),
+		"pageSize":   field(graphql.NewNonNull(graphql.Int), func(p *biz.PaginationResponse) any { return p.PageSize }),
+		"totalCount": field(graphql.NewNonNull(graphql.Int), func(p *biz.PaginationResponse) any { return p.TotalCount }),
+		"totalPages": field(graphql.NewNonNull(graphql.Int), func(p *biz.PaginationResponse) any { return p.TotalPages }),
+	},
+})
+
+// connection is the resolved value of the *Connection types.
+type connection struct {
+	items    any
+	pageInfo *biz.PaginationResponse
+}
+
+func connectionType(name string, item graphql.Output) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name,
+		Fields: graphql.Fields{
+			"items":    field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(item))), func(c *connection) any { return c.items }),
+			"pageInfo": field(graphql.NewNonNull(pageInfoType), func(c *connection) any { return c.pageInfo }),
+		},
+	})
+}
+
+var paginationArgs = graphql.FieldConfigArgument{
+	"page":     {Type: graphql.Int, DefaultValue: 1},
+	"pageSize": {Type: graphql.Int, DefaultValue: defaultPageSize},
+}
+
+// NewSchema builds the read-only catalog schema on top of the CMS use cases.
+func NewSchema(uc *biz.UseCase) (graphql.Schema, error) {
+	categoryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Category",
+		Fields: graphql.Fields{
+			"id":          field(graphql.NewNonNull(graphql.ID), func(c *biz.Category) any { return c.ID.String() }),
+			"name":        field(graphql.NewNonNull(graphql.String), func(c *biz.Category) any { return c.Name }),
+			"description": field(graphql.NewNonNull(graphql.String), func(c *biz.Category) any { return c.Description }),
+			"type":        field(graphql.NewNonNull(categoryTypeEnum), func(c *biz.Category) any { return c.Type }),
+			"parentId":    field(graphql.ID, func(c *biz.Category) any { return optionalID(c.ParentID) }),
+			"createdAt":   field(graphql.NewNonNull(graphql.DateTime), func(c *biz.Category) any { return c.CreatedAt }),
+			"updatedAt":   field(graphql.NewNonNull(graphql.DateTime), func(c *biz.Category) any { return c.UpdatedAt }),
+		},
+	})
+
+	programType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Program",
+		Fields: graphql.Fields{
+			"id":            field(graphql.NewNonNull(graphql.ID), func(p *biz.Program) any { return p.ID.String() }),
+			"title":         field(graphql.NewNonNull(graphql.String), func(p *biz.Program) any { return p.Title }),
+			"description":   field(graphql.NewNonNull(graphql.String), func(p *biz.Program) any { return p.Description }),
+			"status":        field(graphql.NewNonNull(programStatusEnum), func(p *biz.Program) any { return p.Status }),
+			"thumbnailUrl":  field(graphql.NewNonNull(graphql.String), func(p *biz.Program) any { return p.ThumbnailURL }),
+			"sourceUrl":     field(graphql.String, func(p *biz.Program) any { return optionalString(p.SourceURL) }),
+			"tags":          field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))), func(p *biz.Program) any { return nonNilStrings(p.Tags) }),
+			"episodesCount": field(graphql.NewNonNull(graphql.Int), func(p *biz.Program) any { return p.EpisodesCount }),
+			"isFeatured":    field(graphql.NewNonNull(graphql.Boolean), func(p *biz.Program) any { return p.IsFeatured }),
+			"viewCount":     field(graphql.NewNonNull(graphql.Int), func(p *biz.Program) any { return p.ViewCount }),
+			"rating":        field(graphql.NewNonNull(graphql.Float), func(p *biz.Program) any { return p.Rating }),
+			"createdAt":     field(graphql.NewNonNull(graphql.DateTime), func(p *biz.Program) any { return p.CreatedAt }),
+			"updatedAt":     field(graphql.NewNonNull(graphql.DateTime), func(p *biz.Program) any { return p.UpdatedAt }),
+			"publishedAt":   field(graphql.DateTime, func(p *biz.Program) any { return optionalTime(p.PublishedAt) }),
+			"category": {
+				Type: categoryType,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					program := p.Source.(*biz.Program)
+					thunk := loadersFrom(p.Context).categories.Load(p.Context, program.CategoryID)
+					return func() (any, error) {
+						return resolved(thunk())
+					}, nil
+				},
+			},
+		},
+	})
+
+	episodeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Episode",
+		Fields: graphql.Fields{
+			"id":              field(graphql.NewNonNull(graphql.ID), func(e *biz.Episode) any { return e.ID.String() }),
+			"title":           field(graphql.NewNonNull(graphql.String), func(e *biz.Episode) any { return e.Title }),
+			"description":     field(graphql.NewNonNull(graphql.String), func(e *biz.Episode) any { return e.Description }),
+			"durationSeconds": field(graphql.NewNonNull(graphql.Int), func(e *biz.Episode) any { return e.DurationSecs }),
+			"episodeNumber":   field(graphql.NewNonNull(graphql.Int), func(e *biz.Episode) any { return e.EpisodeNumber }),
+			"seasonNumber":    field(graphql.NewNonNull(graphql.Int), func(e *biz.Episode) any { return e.SeasonNumber }),
+			"seasonId":        field(graphql.NewNonNull(graphql.ID), func(e *biz.Episode) any { return e.SeasonID.String() }),
+			"status":          field(graphql.NewNonNull(episodeStatusEnum), func(e *biz.Episode) any { return e.Status }),
+			"mediaUrl":        field(graphql.NewNonNull(graphql.String), func(e *biz.Episode) any { return e.MediaURL }),
+			"thumbnailUrl":    field(graphql.NewNonNull(graphql.String), func(e *biz.Episode) any { return e.ThumbnailURL }),
+			"tags":            field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))), func(e *biz.Episode) any { return nonNilStrings(e.Tags) }),
+			"viewCount":       field(graphql.NewNonNull(graphql.Int), func(e *biz.Episode) any { return e.ViewCount }),
+			"rating":          field(graphql.NewNonNull(graphql.Float), func(e *biz.Episode) any { return e.Rating }),
+			"createdAt":       field(graphql.NewNonNull(graphql.DateTime), func(e *biz.Episode) any { return e.CreatedAt }),
+			"updatedAt":       field(graphql.NewNonNull(graphql.DateTime), func(e *biz.Episode) any { return e.UpdatedAt }),
+			"publishedAt":     field(graphql.DateTime, func(e *biz.Episode) any { return optionalTime(e.PublishedAt) }),
+			"scheduledAt":     field(graphql.DateTime, func(e *biz.Episode) any { return optionalTime(e.ScheduledAt) }),
+			"program": {
+				Type: programType,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					episode := p.Source.(*biz.Episode)
+					thunk := loadersFrom(p.Context).programs.Load(p.Context, episode.ProgramID)
+					return func() (any, error) {
+						return resolved(thunk())
+					}, nil
+				},
+			},
+		},
+	})
+
+	episodeConnectionType := connectionType("EpisodeConnection", episodeType)
+
+	// Added after the fact because Program and Episode reference each other.
+	programType.AddFieldConfig("episodes", &graphql.Field{
+		Type: graphql.NewNonNull(episodeConnectionType),
+		Args: paginationArgs,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			program := p.Source.(*biz.Program)
+			page, pageSize := pageArgs(p.Args)
+			thunk := loadersFrom(p.Context).episodes.Load(p.Context, episodesKey{program.ID, page, pageSize})
+			return func() (any, error) {
+				result, err := thunk()
+				if err != nil {
+					return nil, toGraphQLError(err)
+				}
+				return &connection{items: result.Episodes, pageInfo: result.Pagination}, nil
+			}, nil
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"program": {
+				Type: programType,
+				Args: graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, err := idArg(p.Args, "id")
+					if err != nil {
+						return nil, err
+					}
+					return resolved(uc.GetProgram(p.Context, id))
+				},
+			},
+			"programs": {
+				Type: graphql.NewNonNull(connectionType("ProgramConnection", programType)),
+				Args: graphql.FieldConfigArgument{
+					"page":         paginationArgs["page"],
+					"pageSize":     paginationArgs["pageSize"],
+					"categoryId":   {Type: graphql.ID},
+					"status":       {Type: programStatusEnum},
+					"search":       {Type: graphql.String},
+					"featuredOnly": {Type: graphql.Boolean},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					var filter biz.ProgramFilter
+					if _, ok := p.Args["categoryId"]; ok {
+						id, err := idArg(p.Args, "categoryId")
+						if err != nil {
+							return nil, err
+						}
+						filter.CategoryID = &id
+					}
+					if status, ok := p.Args["status"].(biz.ProgramStatus); ok {
+						filter.Status = &status
+					}
+					if search, ok := p.Args["search"].(string); ok {
+						filter.SearchQuery = &search
+					}
+					if featured, ok := p.Args["featuredOnly"].(bool); ok {
+						filter.FeaturedOnly = &featured
+					}
+
+					page, pageSize := pageArgs(p.Args)
+					programs, pagination, err := uc.ListPrograms(p.Context, filter,
+						biz.PaginationRequest{Page: page, PageSize: pageSize}, biz.SortRequest{})
+					if err != nil {
+						return nil, toGraphQLError(err)
+					}
+					primePrograms(p, programs)
+
+					return &connection{items: programs, pageInfo: pagination}, nil
+				},
+			},
+			"episode": {
+				Type: episodeType,
+				Args: graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, err := idArg(p.Args, "id")
+					if err != nil {
+						return nil, err
+					}
+					return resolved(uc.GetEpisode(p.Context, id))
+				},
+			},
+			"category": {
+				Type: categoryType,
+				Args: graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, err := idArg(p.Args, "id")
+					if err != nil {
+						return nil, err
+					}
+					return resolved(uc.GetCategory(p.Context, id))
+				},
+			},
+			"categories": {
+				Type: graphql.NewNonNull(connectionType("CategoryConnection", categoryType)),
+				Args: graphql.FieldConfigArgument{
+					"page":     paginationArgs["page"],
+					"pageSize": paginationArgs["pageSize"],
+					"type":     {Type: categoryTypeEnum},
+					"search":   {Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					var filter biz.CategoryFilter
+					if categoryType, ok := p.Args["type"].(biz.CategoryType); ok {
+						filter.Type = &categoryType
+					}
+					if search, ok := p.Args["search"].(string); ok {
+						filter.SearchQuery = &search
+					}
+
+					page, pageSize := pageArgs(p.Args)
+					categories, pagination, err := uc.ListCategories(p.Context, filter,
+						biz.PaginationRequest{Page: page, PageSize: pageSize}, biz.SortRequest{})
+					if err != nil {
+						return nil, toGraphQLError(err)
+					}
+
+					return &connection{items: categories, pageInfo: pagination}, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// field resolves a scalar from the typed source object.
+func field[T any](typ graphql.Output, get func(T) any) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return get(p.Source.(T)), nil
+		},
+	}
+}
+
+// primePrograms seeds the program loader with a page that was already fetched,
+// so episode → program lookups further down the query hit the cache.
+func primePrograms(p graphql.ResolveParams, programs []*biz.Program) {
+	l := loadersFrom(p.Context)
+	for _, program := range programs {
+		l.programs.Prime(p.Context, program.ID, program)
+	}
+}
+
+// resolved converts a typed (value, error) pair into a resolver result, keeping
+// a nil pointer from turning into a non-nil interface.
+func resolved[T any](value *T, err error) (any, error) {
+	if err != nil {
+		return nil, toGraphQLError(err)
+	}
+	if value == nil {
+		return nil, nil
+	}
+	return value, nil
+}
+
+func idArg(args map[string]any, name string) (uuid.UUID, error) {
+	raw, _ := args[name].(string)
+	id, err := validation.ParseUUID(name, raw)
+	if err != nil {
+		return uuid.Nil, toGraphQLError(err)
+	}
+	return id, nil
+}
+
+func pageArgs(args map[string]any) (int32, int32) {
+	page, _ := args["page"].(int)
+	pageSize, _ := args["pageSize"].(int)
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	return int32(page), int32(pageSize)
+}
+
+func optionalTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
+
+func optionalID(id *uuid.UUID) any {
+	if id == nil {
+		return nil
+	}
+	return id.String()
+}
+
+func optionalString(s *string) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,59 @@
+package i18n
+
+import (
+	"context"
+	"slices"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"golang.org/x/text/language"
+)
+
+const varyHeader = "Vary"
+
+// maxContentLocales caps how many locales content is looked up in, however long the
+// Accept-Language header is.
+const maxContentLocales = 6
+
+// ContentLocales lists the locales to show content in, most preferred first: locale when
+// the request names one, else the Accept-Language preferences of the caller. Each locale
+// is followed by its base language, so content translated to "ar" is shown for "ar-SA".
+// Content keeps its original text if none of them has a translation.
+func ContentLocales(ctx context.Context, locale string) []string {
+	if locale != "" {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			return nil
+		}
+		return withBaseLanguages([]language.Tag{tag})
+	}
+
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return nil
+	}
+	// The reply depends on the header, so shared caches must keep one copy per value
+	tr.ReplyHeader().Add(varyHeader, acceptLanguageHeader)
+
+	tags, _, err := language.ParseAcceptLanguage(tr.RequestHeader().Get(acceptLanguageHeader))
+	if err != nil {
+		return nil
+	}
+	return withBaseLanguages(tags)
+}
+
+func withBaseLanguages(tags []language.Tag) []string {
+	locales := make([]string, 0, 2*len(tags))
+	add := func(locale string) {
+		if locale != "und" && !slices.Contains(locales, locale) && len(locales) < maxContentLocales {
+			locales = append(locales, locale)
+		}
+	}
+
+	for _, tag := range tags {
+		add(tag.String())
+		base, _ := tag.Base()
+		add(base.String())
+	}
+
+	return locales
+}
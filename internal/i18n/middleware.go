@@ -0,0 +1,42 @@
+package i18n
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	acceptLanguageHeader  = "Accept-Language"
+	contentLanguageHeader = "Content-Language"
+)
+
+// Server localizes errors returned by the rest of the chain. The locale comes from the
+// Accept-Language header, or the accept-language metadata key over gRPC.
+func (t *Translator) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			res, err := handler(ctx, req)
+			if err == nil {
+				return res, nil
+			}
+
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return res, t.Localize(err, t.defaultLocale)
+			}
+
+			locale := t.Locale(tr.RequestHeader().Get(acceptLanguageHeader))
+			tr.ReplyHeader().Set(contentLanguageHeader, locale)
+			return res, t.Localize(err, locale)
+		}
+	}
+}
+
+// HTTPError localizes errors that are encoded without passing through the middleware
+// chain, such as request body decoding failures.
+func (t *Translator) HTTPError(r *http.Request, err error) error {
+	return t.Localize(err, t.Locale(r.Header.Get(acceptLanguageHeader)))
+}
@@ -0,0 +1,9 @@
+package i18n
+
+import (
+	"github.com/google/wire"
+)
+
+var ProviderSet = wire.NewSet(
+	NewTranslator,
+)
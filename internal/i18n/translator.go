@@ -0,0 +1,150 @@
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/validation"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"golang.org/x/text/language"
+)
+
+//go:embed catalogs/*.json
+var catalogFiles embed.FS
+
+const defaultLocale = "en"
+
+// FieldMetadataKey is the error metadata key holding the path of the first field that failed validation.
+const FieldMetadataKey = "field"
+
+type catalog struct {
+	Errors        map[string]string `json:"errors"`
+	FieldMessage  string            `json:"field_message"`
+	FieldRules    map[string]string `json:"field_rules"`
+	FieldFallback string            `json:"field_fallback"`
+}
+
+// Translator replaces error messages with the catalog entry for their reason in the
+// locale the caller asked for. Reasons without an entry keep their message.
+type Translator struct {
+	catalogs      map[string]*catalog
+	defaultLocale string
+	matcher       language.Matcher
+	locales       []string
+}
+
+func NewTranslator(c *conf.Server) (*Translator, error) {
+	entries, err := catalogFiles.ReadDir("catalogs")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Translator{catalogs: make(map[string]*catalog, len(entries))}
+	for _, entry := range entries {
+		locale := strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))
+		cat, err := loadCatalog("catalogs/" + entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("load %s message catalog: %w", locale, err)
+		}
+		t.catalogs[locale] = cat
+	}
+
+	t.defaultLocale = c.GetLocalization().GetDefaultLocale()
+	if t.defaultLocale == "" {
+		t.defaultLocale = defaultLocale
+	}
+	if _, ok := t.catalogs[t.defaultLocale]; !ok {
+		return nil, fmt.Errorf("default locale %q has no message catalog", t.defaultLocale)
+	}
+
+	// The default locale goes first so the matcher falls back to it
+	tags := []language.Tag{language.Make(t.defaultLocale)}
+	t.locales = []string{t.defaultLocale}
+	for locale := range t.catalogs {
+		if locale != t.defaultLocale {
+			tags = append(tags, language.Make(locale))
+			t.locales = append(t.locales, locale)
+		}
+	}
+	t.matcher = language.NewMatcher(tags)
+
+	return t, nil
+}
+
+func loadCatalog(name string) (*catalog, error) {
+	data, err := catalogFiles.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var cat catalog
+	if err := json.Unmarshal(data, &cat); err != nil {
+		return nil, err
+	}
+
+	return &cat, nil
+}
+
+// Locale picks the supported locale that best matches an Accept-Language value.
+func (t *Translator) Locale(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return t.defaultLocale
+	}
+
+	_, index, confidence := t.matcher.Match(tags...)
+	if confidence == language.No {
+		return t.defaultLocale
+	}
+	return t.locales[index]
+}
+
+// Localize returns err with its message in locale. Field violations get localized
+// descriptions, and the message describes the first of them with its path in metadata.
+func (t *Translator) Localize(err error, locale string) error {
+	if err == nil {
+		return nil
+	}
+
+	cat, ok := t.catalogs[locale]
+	if !ok {
+		cat = t.catalogs[t.defaultLocale]
+	}
+
+	e := errors.Clone(errors.FromError(err))
+	if violations, ok := validation.FromError(e); ok {
+		localized := make(validation.Violations, len(violations))
+		for i, v := range violations {
+			v.Description = cat.fieldDescription(v)
+			localized[i] = v
+		}
+
+		// Clone copied the metadata, so it is safe to extend
+		e.Metadata[FieldMetadataKey] = localized[0].Field
+		e.Message = strings.NewReplacer("{field}", localized[0].Field, "{reason}", localized[0].Description).Replace(cat.FieldMessage)
+		return e.WithCause(localized)
+	}
+
+	if message, ok := cat.Errors[e.Reason]; ok {
+		e.Message = message
+	}
+	return e
+}
+
+func (c *catalog) fieldDescription(v validation.Violation) string {
+	message, ok := c.FieldRules[v.Rule]
+	if !ok {
+		return c.FieldFallback
+	}
+
+	for i, param := range v.Params {
+		message = strings.ReplaceAll(message, "{"+strconv.Itoa(i)+"}", param)
+	}
+	return message
+}
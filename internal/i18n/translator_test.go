@@ -0,0 +1,190 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/validation"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTranslator(t *testing.T) *Translator {
+	t.Helper()
+
+	translator, err := NewTranslator(&conf.Server{})
+	require.NoError(t, err)
+	return translator
+}
+
+func TestNewTranslator_UnknownDefaultLocale(t *testing.T) {
+	_, err := NewTranslator(&conf.Server{Localization: &conf.Server_Localization{DefaultLocale: "fr"}})
+	assert.Error(t, err)
+}
+
+func TestCatalogs_SameKeys(t *testing.T) {
+	translator := newTestTranslator(t)
+	en := translator.catalogs["en"]
+
+	for locale, cat := range translator.catalogs {
+		for reason := range en.Errors {
+			assert.Contains(t, cat.Errors, reason, "%s catalog misses error %s", locale, reason)
+		}
+		for reason := range cat.Errors {
+			assert.Contains(t, en.Errors, reason, "%s catalog has extra error %s", locale, reason)
+		}
+		for rule := range en.FieldRules {
+			assert.Contains(t, cat.FieldRules, rule, "%s catalog misses field rule %s", locale, rule)
+		}
+		assert.NotEmpty(t, cat.FieldMessage, locale)
+		assert.NotEmpty(t, cat.FieldFallback, locale)
+	}
+}
+
+func TestTranslator_Locale(t *testing.T) {
+	translator := newTestTranslator(t)
+
+	tests := []struct {
+		acceptLanguage string
+		want           string
+	}{
+		{"", "en"},
+		{"ar", "ar"},
+		{"ar-SA,ar;q=0.9", "ar"},
+		{"fr-FR,ar;q=0.5", "ar"},
+		{"en-GB", "en"},
+		{"fr", "en"},
+		{";;;", "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.acceptLanguage, func(t *testing.T) {
+			assert.Equal(t, tt.want, translator.Locale(tt.acceptLanguage))
+		})
+	}
+}
+
+func TestTranslator_Localize(t *testing.T) {
+	translator := newTestTranslator(t)
+	notFound := errors.NotFound("PROGRAM_NOT_FOUND", "program not found").WithMetadata(map[string]string{"id": "1"})
+
+	t.Run("Nil", func(t *testing.T) {
+		assert.NoError(t, translator.Localize(nil, "ar"))
+	})
+
+	t.Run("Catalog", func(t *testing.T) {
+		e := errors.FromError(translator.Localize(notFound, "ar"))
+		assert.Equal(t, translator.catalogs["ar"].Errors["PROGRAM_NOT_FOUND"], e.Message)
+		assert.Equal(t, "PROGRAM_NOT_FOUND", e.Reason)
+		assert.Equal(t, "1", e.Metadata["id"])
+		// The original error is left alone
+		assert.Equal(t, "program not found", notFound.Message)
+	})
+
+	t.Run("UnknownLocaleFallsBack", func(t *testing.T) {
+		e := errors.FromError(translator.Localize(notFound, "fr"))
+		assert.Equal(t, translator.catalogs["en"].Errors["PROGRAM_NOT_FOUND"], e.Message)
+	})
+
+	t.Run("UnknownReasonKeepsMessage", func(t *testing.T) {
+		e := errors.FromError(translator.Localize(errors.BadRequest("SOMETHING_NEW", "something new"), "ar"))
+		assert.Equal(t, "something new", e.Message)
+	})
+
+	t.Run("PlainError", func(t *testing.T) {
+		e := errors.FromError(translator.Localize(assert.AnError, "ar"))
+		assert.Equal(t, translator.catalogs["ar"].Errors["UNKNOWN"], e.Message)
+	})
+
+	t.Run("FieldViolations", func(t *testing.T) {
+		err := validation.NewError(validation.ReasonValidator, validation.Violations{
+			{Field: "title", Rule: validation.RuleMaxLen, Params: []string{"10"}, Description: "value length must be at most 10 runes"},
+			{Field: "tags[0]", Rule: "unknown.rule", Description: "whatever"},
+		})
+
+		localized := translator.Localize(err, "en")
+		e := errors.FromError(localized)
+		assert.Equal(t, "title: must be at most 10 characters long", e.Message)
+		assert.Equal(t, "title", e.Metadata[FieldMetadataKey])
+
+		violations, ok := validation.FromError(localized)
+		require.True(t, ok)
+		require.Len(t, violations, 2)
+		assert.Equal(t, "must be at most 10 characters long", violations[0].Description)
+		assert.Equal(t, translator.catalogs["en"].FieldFallback, violations[1].Description)
+
+		// The violations of the original error are left alone
+		original, _ := validation.FromError(err)
+		assert.Equal(t, "value length must be at most 10 runes", original[0].Description)
+	})
+}
+
+type headerCarrier map[string][]string
+
+func (h headerCarrier) Get(key string) string {
+	if v := h[key]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (h headerCarrier) Set(key, value string)      { h[key] = []string{value} }
+func (h headerCarrier) Add(key, value string)      { h[key] = append(h[key], value) }
+func (h headerCarrier) Values(key string) []string { return h[key] }
+
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type testTransport struct {
+	request, reply headerCarrier
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return "" }
+func (t *testTransport) RequestHeader() transport.Header { return t.request }
+func (t *testTransport) ReplyHeader() transport.Header   { return t.reply }
+
+func TestContentLocales(t *testing.T) {
+	tests := []struct {
+		name           string
+		locale         string
+		acceptLanguage string
+		want           []string
+		vary           bool
+	}{
+		{name: "Explicit", locale: "ar-SA", want: []string{"ar-SA", "ar"}},
+		{name: "ExplicitWinsOverHeader", locale: "en", acceptLanguage: "ar", want: []string{"en"}},
+		{name: "InvalidExplicit", locale: "not a locale!", want: nil},
+		{name: "Header", acceptLanguage: "ar-SA,en;q=0.8", want: []string{"ar-SA", "ar", "en"}, vary: true},
+		{name: "HeaderDeduplicated", acceptLanguage: "ar,ar-EG;q=0.9", want: []string{"ar", "ar-EG"}, vary: true},
+		{name: "HeaderCapped", acceptLanguage: "ar-SA,en-GB,fr-FR,de-DE", want: []string{"ar-SA", "ar", "en-GB", "en", "fr-FR", "fr"}, vary: true},
+		{name: "NoHeader", want: []string{}, vary: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &testTransport{request: headerCarrier{}, reply: headerCarrier{}}
+			if tt.acceptLanguage != "" {
+				tr.request.Set(acceptLanguageHeader, tt.acceptLanguage)
+			}
+			ctx := transport.NewServerContext(context.Background(), tr)
+
+			assert.Equal(t, tt.want, ContentLocales(ctx, tt.locale))
+			if tt.vary {
+				assert.Equal(t, []string{acceptLanguageHeader}, tr.reply.Values(varyHeader))
+			} else {
+				assert.Empty(t, tr.reply.Values(varyHeader))
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PrincipalType tells what kind of caller made a change.
+type PrincipalType string
+
+const (
+	// PrincipalAnonymous is a caller without a token, such as someone logging in.
+	PrincipalAnonymous PrincipalType = "anonymous"
+	PrincipalUser      PrincipalType = "user"
+	// PrincipalSystem is a background job, such as the episode scheduler.
+	PrincipalSystem PrincipalType = "system"
+)
+
+// OperationEpisodeScheduler is the operation of the audit events of episodes the
+// scheduler publishes.
+const OperationEpisodeScheduler = "jobs.episode_scheduler"
+
+// EntityType names what an audited operation changed.
+type EntityType string
+
+const (
+	EntityTypeUser       EntityType = "user"
+	EntityTypeCategory   EntityType = "category"
+	EntityTypeProgram    EntityType = "program"
+	EntityTypeEpisode    EntityType = "episode"
+	EntityTypeSeason     EntityType = "season"
+	EntityTypeChapter    EntityType = "chapter"
+	EntityTypeTranscript EntityType = "transcript"
+	EntityTypePerson     EntityType = "person"
+	EntityTypeCredit     EntityType = "credit"
+	EntityTypeImport     EntityType = "import"
+	EntityTypeTag        EntityType = "tag"
+	EntityTypeWebhook    EntityType = "webhook"
+	// EntityTypeWebhookDelivery is one delivery of an event to a webhook.
+	EntityTypeWebhookDelivery EntityType = "webhook_delivery"
+)
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+// AuditEvent records one call of a mutating operation, whether it succeeded or not.
+// Before and After summarize the change: the fields that differ for updates and the
+// whole entity when it is created or deleted. EntityID is unset for bulk operations,
+// which list the ids they touched in the summary instead.
+type AuditEvent struct {
+	ID            uuid.UUID     `json:"id"`
+	ActorID       *uuid.UUID    `json:"actor_id,omitempty"`
+	PrincipalType PrincipalType `json:"principal_type"`
+	Operation     string        `json:"operation"`
+	EntityType    EntityType    `json:"entity_type,omitempty"`
+	EntityID      *uuid.UUID    `json:"entity_id,omitempty"`
+	Before        Snapshot      `json:"before,omitempty"`
+	After         Snapshot      `json:"after,omitempty"`
+	IPAddress     string        `json:"ip_address"`
+	UserAgent     string        `json:"user_agent"`
+	RequestID     string        `json:"request_id"`
+	Outcome       AuditOutcome  `json:"outcome"`
+	ErrorReason   string        `json:"error_reason,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+type AuditEventFilter struct {
+	ActorID       *uuid.UUID
+	PrincipalType *PrincipalType
+	Operation     *string
+	EntityType    *EntityType
+	EntityID      *uuid.UUID
+	Outcome       *AuditOutcome
+	RequestID     *string
+	From          *time.Time
+	To            *time.Time
+}
+
+// AuditPolicy tells who may read the audit log.
+type AuditPolicy interface {
+	IsAdmin(userID uuid.UUID) bool
+}
+
+type auditEventKey struct{}
+
+// WithAuditEvent makes event the audit record of the operation ctx runs, so the use
+// case can note what the operation changed.
+func WithAuditEvent(ctx context.Context, event *AuditEvent) context.Context {
+	return context.WithValue(ctx, auditEventKey{}, event)
+}
+
+func auditEventFrom(ctx context.Context) *AuditEvent {
+	event, _ := ctx.Value(auditEventKey{}).(*AuditEvent)
+	return event
+}
+
+// auditSummary reduces the before and after state of an entity to what an audit event
+// keeps. Either side may be nil.
+func auditSummary(before, after any) (Snapshot, Snapshot, error) {
+	var from, to Snapshot
+	var err error
+	if before != nil {
+		if from, err = snapshotOf(before); err != nil {
+			return nil, nil, err
+		}
+	}
+	if after != nil {
+		if to, err = snapshotOf(after); err != nil {
+			return nil, nil, err
+		}
+	}
+	if from == nil || to == nil {
+		return from, to, nil
+	}
+
+	changes := DiffSnapshots(from, to)
+	from = make(Snapshot, len(changes))
+	to = make(Snapshot, len(changes))
+	for _, change := range changes {
+		from[change.Field] = change.From
+		to[change.Field] = change.To
+	}
+	return from, to, nil
+}
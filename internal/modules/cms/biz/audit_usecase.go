@@ -0,0 +1,175 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// Audited runs a mutating operation together with its audit event in one transaction,
+// so the event is stored exactly when the change is. A failed operation is rolled back
+// and its event stored on its own, with the error reason.
+func (uc *UseCase) Audited(ctx context.Context, event *AuditEvent, mutate func(ctx context.Context) error) error {
+	var committed []func()
+	ctx = context.WithValue(ctx, commitHooksKey{}, &committed)
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := mutate(WithAuditEvent(ctx, event)); err != nil {
+			return err
+		}
+		event.Outcome = AuditOutcomeSuccess
+		return uc.auditRepo.Create(ctx, event)
+	})
+	if err != nil {
+		uc.auditFailure(ctx, event, err)
+		return err
+	}
+
+	for _, fn := range committed {
+		fn()
+	}
+	return nil
+}
+
+// AuditedOutsideTx is Audited for operations that read or write object storage, which
+// must not hold a transaction open meanwhile. The operation writes its changes with
+// auditedTx, which stores the event in the same transaction.
+func (uc *UseCase) AuditedOutsideTx(ctx context.Context, event *AuditEvent, mutate func(ctx context.Context) error) error {
+	var stored bool
+	ctx = context.WithValue(WithAuditEvent(ctx, event), auditStoredKey{}, &stored)
+
+	err := mutate(ctx)
+	if err == nil && !stored {
+		// The operation changed nothing in the database
+		event.Outcome = AuditOutcomeSuccess
+		err = uc.auditRepo.Create(ctx, event)
+	}
+	if err != nil && !stored {
+		uc.auditFailure(ctx, event, err)
+	}
+
+	return err
+}
+
+type auditStoredKey struct{}
+
+type commitHooksKey struct{}
+
+// afterCommit runs fn once the transaction of the audited operation ctx runs is
+// committed, or right away outside of one. Metrics count changes with it, so that
+// changes rolled back with their operation are not counted.
+func (uc *UseCase) afterCommit(ctx context.Context, fn func()) {
+	if committed, ok := ctx.Value(commitHooksKey{}).(*[]func()); ok {
+		*committed = append(*committed, fn)
+		return
+	}
+	fn()
+}
+
+// auditedTx runs fn in a transaction. In an operation run by AuditedOutsideTx, the audit
+// event is stored in that transaction, so the operation calls it once, for all of its
+// changes. In one run by Audited, fn simply joins the transaction of the operation.
+func (uc *UseCase) auditedTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	stored, _ := ctx.Value(auditStoredKey{}).(*bool)
+	if stored == nil {
+		return uc.tx.InTx(ctx, fn)
+	}
+
+	var committed []func()
+	err := uc.tx.InTx(context.WithValue(ctx, commitHooksKey{}, &committed), func(ctx context.Context) error {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+
+		event := auditEventFrom(ctx)
+		event.Outcome = AuditOutcomeSuccess
+		if err := uc.auditRepo.Create(ctx, event); err != nil {
+			return err
+		}
+		*stored = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, fn := range committed {
+		fn()
+	}
+	return nil
+}
+
+// auditFailure stores the event of an operation that failed with err.
+func (uc *UseCase) auditFailure(ctx context.Context, event *AuditEvent, err error) {
+	event.Outcome = AuditOutcomeFailure
+	event.ErrorReason = errors.FromError(err).Reason
+	if event.ErrorReason == "" {
+		event.ErrorReason = errors.UnknownReason
+	}
+	// The request may have been cancelled, which is worth recording too
+	if err := uc.auditRepo.Create(context.WithoutCancel(ctx), event); err != nil {
+		uc.logger.WithContext(ctx).Errorf("Failed to store audit event for %s: %v", event.Operation, err)
+	}
+}
+
+// auditChange notes on the audit event of ctx which entity the operation changed and
+// how. Outside an audited operation it does nothing.
+func (uc *UseCase) auditChange(ctx context.Context, entityType EntityType, id uuid.UUID, before, after any) {
+	event := auditEventFrom(ctx)
+	if event == nil {
+		return
+	}
+
+	event.EntityType = entityType
+	if id != uuid.Nil {
+		event.EntityID = &id
+	}
+
+	from, to, err := auditSummary(before, after)
+	if err != nil {
+		uc.logger.WithContext(ctx).Errorf("Failed to summarize %s %s for the audit log: %v", entityType, id, err)
+		return
+	}
+	event.Before, event.After = from, to
+}
+
+// auditActor names the user an anonymous caller turned out to be, such as someone
+// logging in.
+func (uc *UseCase) auditActor(ctx context.Context, userID uuid.UUID) {
+	if event := auditEventFrom(ctx); event != nil && event.ActorID == nil {
+		event.ActorID = &userID
+	}
+}
+
+// ListAuditEvents returns audit events newest first. Only admins may read the audit log.
+func (uc *UseCase) ListAuditEvents(ctx context.Context, filter AuditEventFilter, pagination PaginationRequest) ([]*AuditEvent, *PaginationResponse, error) {
+	if err := uc.checkAuditAccess(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	pagination.SetDefaults()
+
+	return uc.auditRepo.List(ctx, filter, pagination)
+}
+
+// ExportAuditEvents calls fn with every audit event filter matches, newest first.
+func (uc *UseCase) ExportAuditEvents(ctx context.Context, filter AuditEventFilter, fn func(*AuditEvent) error) error {
+	if err := uc.checkAuditAccess(ctx); err != nil {
+		return err
+	}
+
+	return uc.auditRepo.Export(ctx, filter, fn)
+}
+
+func (uc *UseCase) checkAuditAccess(ctx context.Context) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+	if !uc.auditPolicy.IsAdmin(userID) {
+		return ErrAdminRequired
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAuditedUseCase() (*UseCase, *fakeTransactor, *fakeAuditRepo) {
+	tx := &fakeTransactor{}
+	audit := &fakeAuditRepo{}
+	return &UseCase{
+		logger:    log.NewHelper(log.DefaultLogger),
+		tx:        tx,
+		auditRepo: audit,
+	}, tx, audit
+}
+
+func TestAudited(t *testing.T) {
+	errMutate := errors.Conflict("MUTATE_FAILED", "mutation failed")
+	errStore := errors.InternalServer("AUDIT_UNAVAILABLE", "audit log unavailable")
+	programID := uuid.New()
+
+	tests := []struct {
+		name        string
+		mutate      error
+		failSuccess error
+		wantErr     error
+		wantOutcome AuditOutcome
+		wantReason  string
+		wantInTx    bool
+	}{
+		{
+			name:        "Success",
+			wantOutcome: AuditOutcomeSuccess,
+			wantInTx:    true,
+		},
+		{
+			name:        "FailedOperation",
+			mutate:      errMutate,
+			wantErr:     errMutate,
+			wantOutcome: AuditOutcomeFailure,
+			wantReason:  "MUTATE_FAILED",
+		},
+		{
+			name:        "FailedToStoreEvent",
+			failSuccess: errStore,
+			wantErr:     errStore,
+			wantOutcome: AuditOutcomeFailure,
+			wantReason:  "AUDIT_UNAVAILABLE",
+		},
+		{
+			name:        "UnknownError",
+			mutate:      context.Canceled,
+			wantErr:     context.Canceled,
+			wantOutcome: AuditOutcomeFailure,
+			wantReason:  errors.UnknownReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, tx, audit := newAuditedUseCase()
+			audit.failSuccess = tt.failSuccess
+
+			var inTx bool
+			err := uc.Audited(context.Background(), &AuditEvent{Operation: "UpdateProgram"}, func(ctx context.Context) error {
+				inTx = inFakeTx(ctx)
+				uc.auditChange(ctx, EntityTypeProgram, programID, &Program{Title: "Before"}, &Program{Title: "After"})
+				return tt.mutate
+			})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.True(t, inTx, "the operation runs in the transaction")
+
+			if tt.wantErr == nil {
+				assert.Equal(t, 1, tx.commits)
+			} else {
+				assert.Equal(t, 1, tx.rollbacks)
+			}
+
+			// A failure is stored after the rollback, so that it is kept
+			require.Len(t, audit.events, 1)
+			event := audit.events[0]
+			assert.Equal(t, tt.wantOutcome, event.Outcome)
+			assert.Equal(t, tt.wantReason, event.ErrorReason)
+			assert.Equal(t, tt.wantInTx, event.inTx)
+			assert.Equal(t, EntityTypeProgram, event.EntityType)
+			assert.Equal(t, &programID, event.EntityID)
+		})
+	}
+}
+
+func TestAuditedOutsideTx(t *testing.T) {
+	errMutate := errors.InternalServer("UPLOAD_FAILED", "upload failed")
+	errStore := errors.InternalServer("AUDIT_UNAVAILABLE", "audit log unavailable")
+
+	tests := []struct {
+		name string
+		// mutate runs the operation, which writes its changes with write
+		mutate      func(ctx context.Context, write func(ctx context.Context) error) error
+		failSuccess error
+		wantErr     error
+		wantEvents  []AuditOutcome
+		wantInTx    bool
+		wantCommits int
+	}{
+		{
+			name: "Success",
+			mutate: func(ctx context.Context, write func(ctx context.Context) error) error {
+				return write(ctx)
+			},
+			wantEvents:  []AuditOutcome{AuditOutcomeSuccess},
+			wantInTx:    true,
+			wantCommits: 1,
+		},
+		{
+			name: "NoDatabaseChanges",
+			mutate: func(ctx context.Context, write func(ctx context.Context) error) error {
+				return nil
+			},
+			wantEvents: []AuditOutcome{AuditOutcomeSuccess},
+		},
+		{
+			name: "FailedBeforeChanges",
+			mutate: func(ctx context.Context, write func(ctx context.Context) error) error {
+				return errMutate
+			},
+			wantErr:    errMutate,
+			wantEvents: []AuditOutcome{AuditOutcomeFailure},
+		},
+		{
+			// The changes are committed along with the event, which is not stored again
+			name: "FailedAfterChanges",
+			mutate: func(ctx context.Context, write func(ctx context.Context) error) error {
+				if err := write(ctx); err != nil {
+					return err
+				}
+				return errMutate
+			},
+			wantErr:     errMutate,
+			wantEvents:  []AuditOutcome{AuditOutcomeSuccess},
+			wantInTx:    true,
+			wantCommits: 1,
+		},
+		{
+			name: "FailedToStoreEvent",
+			mutate: func(ctx context.Context, write func(ctx context.Context) error) error {
+				return write(ctx)
+			},
+			failSuccess: errStore,
+			wantErr:     errStore,
+			wantEvents:  []AuditOutcome{AuditOutcomeFailure},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, tx, audit := newAuditedUseCase()
+			audit.failSuccess = tt.failSuccess
+
+			write := func(ctx context.Context) error {
+				return uc.auditedTx(ctx, func(ctx context.Context) error {
+					assert.True(t, inFakeTx(ctx))
+					return nil
+				})
+			}
+			err := uc.AuditedOutsideTx(context.Background(), &AuditEvent{Operation: "UploadTranscript"}, func(ctx context.Context) error {
+				assert.False(t, inFakeTx(ctx), "the operation runs outside a transaction")
+				return tt.mutate(ctx, write)
+			})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantCommits, tx.commits)
+
+			outcomes := make([]AuditOutcome, 0, len(audit.events))
+			for _, event := range audit.events {
+				outcomes = append(outcomes, event.Outcome)
+			}
+			assert.Equal(t, tt.wantEvents, outcomes)
+			if len(audit.events) > 0 {
+				assert.Equal(t, tt.wantInTx, audit.events[0].inTx)
+			}
+		})
+	}
+}
+
+func TestAuditedTx_JoinsAudited(t *testing.T) {
+	uc, tx, audit := newAuditedUseCase()
+
+	err := uc.Audited(context.Background(), &AuditEvent{Operation: "RestoreProgram"}, func(ctx context.Context) error {
+		return uc.auditedTx(ctx, func(ctx context.Context) error {
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	// The event is stored once, by Audited, in its transaction
+	assert.Equal(t, 1, tx.commits)
+	require.Len(t, audit.events, 1)
+	assert.Equal(t, AuditOutcomeSuccess, audit.events[0].Outcome)
+	assert.True(t, audit.events[0].inTx)
+
+	// Outside an audited operation, nothing is stored
+	require.NoError(t, uc.auditedTx(context.Background(), func(ctx context.Context) error {
+		return nil
+	}))
+	assert.Equal(t, 2, tx.commits)
+	assert.Len(t, audit.events, 1)
+}
+
+func TestAuditEvents_AdminOnly(t *testing.T) {
+	admin, editor := uuid.New(), uuid.New()
+	uc, _, audit := newAuditedUseCase()
+	uc.auditPolicy = fakeAuditPolicy{admins: []uuid.UUID{admin}}
+	audit.events = []storedAuditEvent{
+		{AuditEvent: AuditEvent{Operation: "DeleteProgram", Outcome: AuditOutcomeSuccess}},
+	}
+
+	list := func(ctx context.Context) error {
+		_, _, err := uc.ListAuditEvents(ctx, AuditEventFilter{}, PaginationRequest{})
+		return err
+	}
+	export := func(ctx context.Context) error {
+		return uc.ExportAuditEvents(ctx, AuditEventFilter{}, func(*AuditEvent) error { return nil })
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{name: "Anonymous", ctx: context.Background(), wantErr: ErrUnauthorized},
+		{name: "Editor", ctx: asUser(context.Background(), editor), wantErr: ErrAdminRequired},
+		{name: "Admin", ctx: asUser(context.Background(), admin)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, read := range map[string]func(context.Context) error{"List": list, "Export": export} {
+				err := read(tt.ctx)
+				if tt.wantErr == nil {
+					assert.NoError(t, err, name)
+				} else {
+					assert.True(t, errors.Is(err, tt.wantErr), "%s: got %v", name, err)
+				}
+			}
+		})
+	}
+
+	events, _, err := uc.ListAuditEvents(asUser(context.Background(), admin), AuditEventFilter{}, PaginationRequest{})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "DeleteProgram", events[0].Operation)
+
+	var exported []string
+	err = uc.ExportAuditEvents(asUser(context.Background(), admin), AuditEventFilter{}, func(event *AuditEvent) error {
+		exported = append(exported, event.Operation)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DeleteProgram"}, exported)
+}
@@ -34,6 +34,9 @@ func (uc *UseCase) Login(ctx context.Context, request *LoginRequest) (*LoginResp
 		return nil, ErrInvalidCredentials
 	}
 
+	uc.auditActor(ctx, user.ID)
+	uc.auditChange(ctx, EntityTypeUser, user.ID, nil, nil)
+
 	claimsMap := utils.NewClaimsBuilder().
 		WithUserID(user.ID.String()).
 		WithExpiry(time.Now().Add(time.Hour * 72).Unix()).
@@ -58,7 +61,7 @@ func (uc *UseCase) Register(ctx context.Context, req *RegisterRequest) error {
 	hasher.Write([]byte(req.Password))
 	hashedPassword := hex.EncodeToString(hasher.Sum(nil))
 
-	_, err := uc.usersRepo.CreateUser(ctx, &User{
+	user, err := uc.usersRepo.CreateUser(ctx, &User{
 		Email:    req.Email,
 		Name:     req.Name,
 		Password: hashedPassword,
@@ -67,6 +70,9 @@ func (uc *UseCase) Register(ctx context.Context, req *RegisterRequest) error {
 		return err
 	}
 
+	uc.auditActor(ctx, user.ID)
+	uc.auditChange(ctx, EntityTypeUser, user.ID, nil, user)
+
 	return nil
 }
 
@@ -85,10 +91,13 @@ func (uc *UseCase) UpdateUserProfile(ctx context.Context, userId uuid.UUID, req
 		return nil, err
 	}
 
+	before := user
 	user, err = uc.usersRepo.UpdateUser(ctx, user.ID, req)
 	if err != nil {
 		return nil, err
 	}
 
+	uc.auditChange(ctx, EntityTypeUser, user.ID, before, user)
+
 	return user, nil
 }
@@ -0,0 +1,183 @@
+package biz
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Availability limits when and where licensed content can be shown to listeners. Content
+// is available from StartAt until EndAt, either of them open when unset. With
+// AllowedCountries, only listeners in those countries see it; listeners in
+// BlockedCountries never do. Countries are ISO 3166-1 alpha-2 codes such as SA. The zero
+// value puts no limits on content. An episode is only available while its program is too.
+type Availability struct {
+	StartAt          *time.Time `json:"start_at,omitempty"`
+	EndAt            *time.Time `json:"end_at,omitempty"`
+	AllowedCountries []string   `json:"allowed_countries,omitempty"`
+	BlockedCountries []string   `json:"blocked_countries,omitempty"`
+}
+
+func (a Availability) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
+
+func (a *Availability) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*a = Availability{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Availability", value)
+	}
+
+	*a = Availability{}
+	return json.Unmarshal(data, a)
+}
+
+// UnavailableReason says why content is not shown to a listener.
+type UnavailableReason string
+
+const (
+	UnavailableNotPublished      UnavailableReason = "not_published"
+	UnavailableNotStarted        UnavailableReason = "not_started"
+	UnavailableEnded             UnavailableReason = "ended"
+	UnavailableCountryNotAllowed UnavailableReason = "country_not_allowed"
+	UnavailableCountryBlocked    UnavailableReason = "country_blocked"
+)
+
+// ScheduleReasons tells why content is unavailable to everyone at the time at.
+func (a Availability) ScheduleReasons(at time.Time) []UnavailableReason {
+	var reasons []UnavailableReason
+	if a.StartAt != nil && at.Before(*a.StartAt) {
+		reasons = append(reasons, UnavailableNotStarted)
+	}
+	if a.EndAt != nil && !at.Before(*a.EndAt) {
+		reasons = append(reasons, UnavailableEnded)
+	}
+	return reasons
+}
+
+// CountryReasons tells why content is unavailable to listeners in country, empty when
+// it is unknown.
+func (a Availability) CountryReasons(country string) []UnavailableReason {
+	var reasons []UnavailableReason
+	if len(a.AllowedCountries) > 0 && !slices.Contains(a.AllowedCountries, country) {
+		reasons = append(reasons, UnavailableCountryNotAllowed)
+	}
+	if country != "" && slices.Contains(a.BlockedCountries, country) {
+		reasons = append(reasons, UnavailableCountryBlocked)
+	}
+	return reasons
+}
+
+// Check tells why content is unavailable to listeners in country at the time at, and
+// returns nothing when it is available. It matches content_available in the database.
+func (a Availability) Check(at time.Time, country string) []UnavailableReason {
+	return append(a.ScheduleReasons(at), a.CountryReasons(country)...)
+}
+
+func (a Availability) Available(at time.Time, country string) bool {
+	return len(a.Check(at, country)) == 0
+}
+
+// Listener is someone reading content through the public endpoints, in Country, empty
+// when it is not known. Reads that take a Listener only return published content that
+// is available to them; editors pass nil and see everything.
+type Listener struct {
+	Country string
+}
+
+// UnavailableReasons tells why listeners in country cannot see the program at the time
+// at. An empty country leaves out the reasons that depend on where listeners are.
+func (p *Program) UnavailableReasons(at time.Time, country string) []UnavailableReason {
+	return unavailableReasons(p.Status == ProgramStatusPublished, p.Availability, at, country)
+}
+
+// UnavailableReasons is like Program.UnavailableReasons, without the reasons of the
+// program of the episode.
+func (e *Episode) UnavailableReasons(at time.Time, country string) []UnavailableReason {
+	return unavailableReasons(e.Status == EpisodeStatusPublished, e.Availability, at, country)
+}
+
+func unavailableReasons(published bool, availability Availability, at time.Time, country string) []UnavailableReason {
+	var reasons []UnavailableReason
+	if !published {
+		reasons = append(reasons, UnavailableNotPublished)
+	}
+	reasons = append(reasons, availability.ScheduleReasons(at)...)
+	if country != "" {
+		reasons = append(reasons, availability.CountryReasons(country)...)
+	}
+	return reasons
+}
+
+// normalize upper-cases and dedupes the countries of a, and fails when they are not
+// two-letter codes, when a country is both allowed and blocked, or when the window ends
+// before it starts.
+func (a *Availability) normalize() error {
+	if a.StartAt != nil && a.EndAt != nil && a.EndAt.Before(*a.StartAt) {
+		return ErrInvalidAvailability
+	}
+
+	var err error
+	if a.AllowedCountries, err = normalizeCountries(a.AllowedCountries); err != nil {
+		return err
+	}
+	if a.BlockedCountries, err = normalizeCountries(a.BlockedCountries); err != nil {
+		return err
+	}
+	for _, country := range a.AllowedCountries {
+		if slices.Contains(a.BlockedCountries, country) {
+			return ErrInvalidAvailability.WithMetadata(map[string]string{"country": country})
+		}
+	}
+	return nil
+}
+
+func normalizeCountries(countries []string) ([]string, error) {
+	normalized := make([]string, 0, len(countries))
+	for _, country := range countries {
+		country = strings.ToUpper(strings.TrimSpace(country))
+		if !validCountry(country) {
+			return nil, ErrInvalidCountry.WithMetadata(map[string]string{"country": country})
+		}
+		if !slices.Contains(normalized, country) {
+			normalized = append(normalized, country)
+		}
+	}
+	if len(normalized) == 0 {
+		return nil, nil
+	}
+	return normalized, nil
+}
+
+func validCountry(country string) bool {
+	return len(country) == 2 && country[0] >= 'A' && country[0] <= 'Z' && country[1] >= 'A' && country[1] <= 'Z'
+}
+
+// AvailabilityCheck is why a program or episode is unavailable to listeners in Country
+// at At. ProgramReasons are those of the program of an episode, which hide it as well.
+type AvailabilityCheck struct {
+	ContentType    ContentType         `json:"content_type"`
+	ContentID      uuid.UUID           `json:"content_id"`
+	Country        string              `json:"country"`
+	At             time.Time           `json:"at"`
+	Reasons        []UnavailableReason `json:"reasons"`
+	ProgramReasons []UnavailableReason `json:"program_reasons"`
+}
+
+// Available tells whether neither the content nor its program keep listeners out.
+func (c *AvailabilityCheck) Available() bool {
+	return len(c.Reasons) == 0 && len(c.ProgramReasons) == 0
+}
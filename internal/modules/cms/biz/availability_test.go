@@ -0,0 +1,99 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvailability_Check(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+
+	tests := []struct {
+		name         string
+		availability Availability
+		country      string
+		want         []UnavailableReason
+	}{
+		{name: "Unlimited", country: "SA"},
+		{name: "UnlimitedUnknownCountry"},
+		{name: "Started", availability: Availability{StartAt: &before}},
+		{name: "StartsNow", availability: Availability{StartAt: &now}},
+		{name: "NotStarted", availability: Availability{StartAt: &after}, want: []UnavailableReason{UnavailableNotStarted}},
+		{name: "NotEnded", availability: Availability{EndAt: &after}},
+		{name: "EndsNow", availability: Availability{EndAt: &now}, want: []UnavailableReason{UnavailableEnded}},
+		{name: "Ended", availability: Availability{StartAt: &before, EndAt: &before}, want: []UnavailableReason{UnavailableEnded}},
+		{name: "Allowed", availability: Availability{AllowedCountries: []string{"SA", "AE"}}, country: "AE"},
+		{
+			name:         "NotAllowed",
+			availability: Availability{AllowedCountries: []string{"SA"}},
+			country:      "EG",
+			want:         []UnavailableReason{UnavailableCountryNotAllowed},
+		},
+		{
+			// Without a country, content limited to some countries cannot be shown
+			name:         "AllowedUnknownCountry",
+			availability: Availability{AllowedCountries: []string{"SA"}},
+			want:         []UnavailableReason{UnavailableCountryNotAllowed},
+		},
+		{
+			name:         "Blocked",
+			availability: Availability{BlockedCountries: []string{"EG"}},
+			country:      "EG",
+			want:         []UnavailableReason{UnavailableCountryBlocked},
+		},
+		{name: "NotBlocked", availability: Availability{BlockedCountries: []string{"EG"}}, country: "SA"},
+		{name: "BlockedUnknownCountry", availability: Availability{BlockedCountries: []string{"EG"}}},
+		{
+			name:         "Everything",
+			availability: Availability{StartAt: &after, EndAt: &before, AllowedCountries: []string{"SA"}, BlockedCountries: []string{"EG"}},
+			country:      "EG",
+			want:         []UnavailableReason{UnavailableNotStarted, UnavailableEnded, UnavailableCountryNotAllowed, UnavailableCountryBlocked},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reasons := tt.availability.Check(now, tt.country)
+			assert.Equal(t, tt.want, reasons)
+			assert.Equal(t, len(tt.want) == 0, tt.availability.Available(now, tt.country))
+		})
+	}
+}
+
+func TestAvailability_Normalize(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	a := Availability{StartAt: &start, EndAt: &end, AllowedCountries: []string{" sa", "SA", "ae"}, BlockedCountries: []string{}}
+	require.NoError(t, a.normalize())
+	assert.Equal(t, []string{"SA", "AE"}, a.AllowedCountries)
+	assert.Nil(t, a.BlockedCountries)
+
+	for name, a := range map[string]Availability{
+		"EndsBeforeStart":   {StartAt: &end, EndAt: &start},
+		"NotACountry":       {AllowedCountries: []string{"SAU"}},
+		"AllowedAndBlocked": {AllowedCountries: []string{"SA"}, BlockedCountries: []string{"sa"}},
+		"EmptyCountry":      {BlockedCountries: []string{""}},
+		"NonLetterCountry":  {BlockedCountries: []string{"S1"}},
+	} {
+		assert.Error(t, a.normalize(), name)
+	}
+}
+
+func TestUnavailableReasons(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	after := now.Add(time.Hour)
+
+	program := &Program{Status: ProgramStatusDraft, Availability: Availability{StartAt: &after, AllowedCountries: []string{"SA"}}}
+	assert.Equal(t, []UnavailableReason{UnavailableNotPublished, UnavailableNotStarted, UnavailableCountryNotAllowed}, program.UnavailableReasons(now, "EG"))
+	// Reasons that depend on where listeners are need a country
+	assert.Equal(t, []UnavailableReason{UnavailableNotPublished, UnavailableNotStarted}, program.UnavailableReasons(now, ""))
+
+	episode := &Episode{Status: EpisodeStatusPublished}
+	assert.Empty(t, episode.UnavailableReasons(now, "EG"))
+}
@@ -0,0 +1,51 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CheckAvailability tells editors why listeners in country cannot see a program or
+// episode now. Without a country, only the reasons that apply everywhere are given.
+func (uc *UseCase) CheckAvailability(ctx context.Context, contentType ContentType, contentID uuid.UUID, country string) (*AvailabilityCheck, error) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if country != "" && !validCountry(country) {
+		return nil, ErrInvalidCountry.WithMetadata(map[string]string{"country": country})
+	}
+
+	check := &AvailabilityCheck{
+		ContentType: contentType,
+		ContentID:   contentID,
+		Country:     country,
+		At:          time.Now(),
+	}
+
+	switch contentType {
+	case ContentTypeProgram:
+		program, err := uc.programRepo.GetByID(ctx, contentID)
+		if err != nil {
+			return nil, err
+		}
+		check.Reasons = program.UnavailableReasons(check.At, country)
+
+	case ContentTypeEpisode:
+		episode, err := uc.episodeRepo.GetByID(ctx, contentID)
+		if err != nil {
+			return nil, err
+		}
+		program, err := uc.programRepo.GetByID(ctx, episode.ProgramID)
+		if err != nil {
+			return nil, err
+		}
+		check.Reasons = episode.UnavailableReasons(check.At, country)
+		check.ProgramReasons = program.UnavailableReasons(check.At, country)
+
+	default:
+		return nil, ErrInvalidContentType
+	}
+
+	return check, nil
+}
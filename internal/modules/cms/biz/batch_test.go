@@ -0,0 +1,110 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBatch(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	missing := uuid.New()
+	idOf := func(id uuid.UUID) uuid.UUID { return id }
+
+	tests := []struct {
+		name     string
+		ids      []uuid.UUID
+		items    []uuid.UUID
+		found    []uuid.UUID
+		notFound []uuid.UUID
+	}{
+		{"request order", []uuid.UUID{b, c, a}, []uuid.UUID{a, b, c}, []uuid.UUID{b, c, a}, nil},
+		{"unknown ids", []uuid.UUID{a, missing, b}, []uuid.UUID{b, a}, []uuid.UUID{a, b}, []uuid.UUID{missing}},
+		{"duplicate ids at their first position", []uuid.UUID{b, a, b, missing, missing}, []uuid.UUID{a, b}, []uuid.UUID{b, a}, []uuid.UUID{missing}},
+		{"nothing found", []uuid.UUID{missing}, nil, []uuid.UUID{}, []uuid.UUID{missing}},
+		{"no ids", nil, nil, []uuid.UUID{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batch := NewBatch(tt.ids, tt.items, idOf)
+			assert.Equal(t, tt.found, batch.Found)
+			assert.Equal(t, tt.notFound, batch.NotFound)
+		})
+	}
+}
+
+func TestUseCase_BatchGet(t *testing.T) {
+	programs := map[uuid.UUID]*Program{}
+	categories := map[uuid.UUID]*Category{}
+	episodes := map[uuid.UUID]*Episode{}
+	var programIDs, categoryIDs, episodeIDs []uuid.UUID
+	for range 3 {
+		program := &Program{ID: uuid.New()}
+		programs[program.ID] = program
+		programIDs = append(programIDs, program.ID)
+
+		category := &Category{ID: uuid.New()}
+		categories[category.ID] = category
+		categoryIDs = append(categoryIDs, category.ID)
+
+		episode := &Episode{ID: uuid.New()}
+		episodes[episode.ID] = episode
+		episodeIDs = append(episodeIDs, episode.ID)
+	}
+
+	uc := &UseCase{
+		programRepo:  &fakeProgramRepo{programs: programs},
+		categoryRepo: &fakeCategoryRepo{categories: categories},
+		episodeRepo:  &fakeEpisodeRepo{episodes: episodes},
+	}
+	ctx := context.Background()
+	missing := uuid.New()
+
+	// The fakes return rows in reverse, so the batch has to put them back in order
+	request := func(ids []uuid.UUID) []uuid.UUID {
+		return []uuid.UUID{ids[0], ids[2], missing, ids[0], ids[1]}
+	}
+	want := func(ids []uuid.UUID) []uuid.UUID {
+		return []uuid.UUID{ids[0], ids[2], ids[1]}
+	}
+
+	t.Run("Programs", func(t *testing.T) {
+		batch, err := uc.BatchGetPrograms(ctx, request(programIDs))
+		require.NoError(t, err)
+
+		var got []uuid.UUID
+		for _, program := range batch.Found {
+			got = append(got, program.ID)
+		}
+		assert.Equal(t, want(programIDs), got)
+		assert.Equal(t, []uuid.UUID{missing}, batch.NotFound)
+	})
+
+	t.Run("Categories", func(t *testing.T) {
+		batch, err := uc.BatchGetCategories(ctx, request(categoryIDs))
+		require.NoError(t, err)
+
+		var got []uuid.UUID
+		for _, category := range batch.Found {
+			got = append(got, category.ID)
+		}
+		assert.Equal(t, want(categoryIDs), got)
+		assert.Equal(t, []uuid.UUID{missing}, batch.NotFound)
+	})
+
+	t.Run("Episodes", func(t *testing.T) {
+		batch, err := uc.BatchGetEpisodes(ctx, request(episodeIDs))
+		require.NoError(t, err)
+
+		var got []uuid.UUID
+		for _, episode := range batch.Found {
+			got = append(got, episode.ID)
+		}
+		assert.Equal(t, want(episodeIDs), got)
+		assert.Equal(t, []uuid.UUID{missing}, batch.NotFound)
+	})
+}
@@ -0,0 +1,159 @@
+package biz
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PodcastChaptersVersion is the version of the Podcasting 2.0 JSON chapters format
+// chapters are exported in.
+const PodcastChaptersVersion = "1.2.0"
+
+// Chapter is a navigable part of an episode, starting StartSeconds into it. Without
+// EndSeconds, it lasts until the next chapter starts, or the episode ends.
+type Chapter struct {
+	ID           uuid.UUID `json:"id"`
+	EpisodeID    uuid.UUID `json:"episode_id"`
+	StartSeconds float64   `json:"start_seconds"`
+	EndSeconds   *float64  `json:"end_seconds,omitempty"`
+	Title        string    `json:"title"`
+	ImageURL     string    `json:"image_url"`
+	URL          string    `json:"url"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// UpdateChapterRequest contains the fields of a chapter that can change. An EndSeconds
+// of 0 removes the end of the chapter.
+type UpdateChapterRequest struct {
+	StartSeconds *float64 `json:"start_seconds,omitempty"`
+	EndSeconds   *float64 `json:"end_seconds,omitempty"`
+	Title        *string  `json:"title,omitempty"`
+	ImageURL     *string  `json:"image_url,omitempty"`
+	URL          *string  `json:"url,omitempty"`
+}
+
+// apply returns a copy of chapter with the updates.
+func (r *UpdateChapterRequest) apply(chapter *Chapter) *Chapter {
+	updated := *chapter
+	if r.StartSeconds != nil {
+		updated.StartSeconds = *r.StartSeconds
+	}
+	if r.EndSeconds != nil {
+		updated.EndSeconds = r.EndSeconds
+		if *r.EndSeconds == 0 {
+			updated.EndSeconds = nil
+		}
+	}
+	if r.Title != nil {
+		updated.Title = *r.Title
+	}
+	if r.ImageURL != nil {
+		updated.ImageURL = *r.ImageURL
+	}
+	if r.URL != nil {
+		updated.URL = *r.URL
+	}
+	return &updated
+}
+
+// checkChapters fails when chapters do not fit in an episode of durationSecs, or when
+// two of them start at the same time or overlap. A duration of 0 is not known yet, so
+// chapters can then go on for as long as they need. chapters are sorted by start.
+func checkChapters(chapters []*Chapter, durationSecs int32) error {
+	slices.SortStableFunc(chapters, func(a, b *Chapter) int {
+		switch {
+		case a.StartSeconds < b.StartSeconds:
+			return -1
+		case a.StartSeconds > b.StartSeconds:
+			return 1
+		}
+		return 0
+	})
+
+	duration := float64(durationSecs)
+	for i, chapter := range chapters {
+		start := chapter.StartSeconds
+		if math.IsNaN(start) || math.IsInf(start, 0) || start < 0 || (duration > 0 && start >= duration) {
+			return ErrChapterOutOfRange
+		}
+		if end := chapter.EndSeconds; end != nil {
+			if math.IsNaN(*end) || math.IsInf(*end, 0) || *end <= start || (duration > 0 && *end > duration) {
+				return ErrChapterOutOfRange
+			}
+		}
+
+		if i == 0 {
+			continue
+		}
+		previous := chapters[i-1]
+		if previous.StartSeconds == start || (previous.EndSeconds != nil && *previous.EndSeconds > start) {
+			return ErrChapterOverlap
+		}
+	}
+
+	return nil
+}
+
+// podcastChapters is the Podcasting 2.0 JSON chapters document,
+// https://github.com/Podcastindex-org/podcast-namespace/blob/main/docs/examples/chapters/jsonChapters.md.
+type podcastChapters struct {
+	Version  string           `json:"version"`
+	Chapters []podcastChapter `json:"chapters"`
+}
+
+type podcastChapter struct {
+	StartTime *float64 `json:"startTime"`
+	EndTime   *float64 `json:"endTime,omitempty"`
+	Title     string   `json:"title,omitempty"`
+	Img       string   `json:"img,omitempty"`
+	URL       string   `json:"url,omitempty"`
+}
+
+// ParsePodcastChapters reads the chapters of a Podcasting 2.0 JSON chapters document.
+// Fields the chapters model has no place for, such as locations, are left out.
+func ParsePodcastChapters(data []byte) ([]*Chapter, error) {
+	var doc podcastChapters
+	if err := json.Unmarshal(data, &doc); err != nil || doc.Version == "" || doc.Chapters == nil {
+		return nil, ErrInvalidChaptersJSON
+	}
+
+	chapters := make([]*Chapter, 0, len(doc.Chapters))
+	for _, c := range doc.Chapters {
+		if c.StartTime == nil {
+			return nil, ErrInvalidChaptersJSON
+		}
+		chapters = append(chapters, &Chapter{
+			StartSeconds: *c.StartTime,
+			EndSeconds:   c.EndTime,
+			Title:        c.Title,
+			ImageURL:     c.Img,
+			URL:          c.URL,
+		})
+	}
+
+	return chapters, nil
+}
+
+// MarshalPodcastChapters writes chapters as a Podcasting 2.0 JSON chapters document.
+func MarshalPodcastChapters(chapters []*Chapter) ([]byte, error) {
+	doc := podcastChapters{
+		Version:  PodcastChaptersVersion,
+		Chapters: make([]podcastChapter, 0, len(chapters)),
+	}
+	for _, c := range chapters {
+		doc.Chapters = append(doc.Chapters, podcastChapter{
+			StartTime: &c.StartSeconds,
+			EndTime:   c.EndSeconds,
+			Title:     c.Title,
+			Img:       c.ImageURL,
+			URL:       c.URL,
+		})
+	}
+
+	return json.Marshal(doc)
+}
@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seconds(s float64) *float64 {
+	return &s
+}
+
+func TestCheckChapters(t *testing.T) {
+	tests := []struct {
+		name     string
+		chapters []*Chapter
+		duration int32
+		want     error
+	}{
+		{name: "None", duration: 60},
+		{
+			name:     "OpenEnded",
+			chapters: []*Chapter{{StartSeconds: 0}, {StartSeconds: 30}},
+			duration: 60,
+		},
+		{
+			name:     "Unsorted",
+			chapters: []*Chapter{{StartSeconds: 30}, {StartSeconds: 0, EndSeconds: seconds(30)}},
+			duration: 60,
+		},
+		{
+			name:     "EndsWithEpisode",
+			chapters: []*Chapter{{StartSeconds: 10, EndSeconds: seconds(60)}},
+			duration: 60,
+		},
+		{
+			name:     "Gap",
+			chapters: []*Chapter{{StartSeconds: 0, EndSeconds: seconds(10)}, {StartSeconds: 20}},
+			duration: 60,
+		},
+		{
+			// Without a duration, chapters can go on for as long as they need
+			name:     "UnknownDuration",
+			chapters: []*Chapter{{StartSeconds: 3600, EndSeconds: seconds(7200)}},
+		},
+		{name: "Negative", chapters: []*Chapter{{StartSeconds: -1}}, duration: 60, want: ErrChapterOutOfRange},
+		{name: "StartsAtEnd", chapters: []*Chapter{{StartSeconds: 60}}, duration: 60, want: ErrChapterOutOfRange},
+		{name: "NaN", chapters: []*Chapter{{StartSeconds: math.NaN()}}, want: ErrChapterOutOfRange},
+		{name: "Infinite", chapters: []*Chapter{{StartSeconds: 0, EndSeconds: seconds(math.Inf(1))}}, want: ErrChapterOutOfRange},
+		{
+			name:     "EndsAfterEpisode",
+			chapters: []*Chapter{{StartSeconds: 10, EndSeconds: seconds(61)}},
+			duration: 60,
+			want:     ErrChapterOutOfRange,
+		},
+		{
+			name:     "EndsAtStart",
+			chapters: []*Chapter{{StartSeconds: 10, EndSeconds: seconds(10)}},
+			duration: 60,
+			want:     ErrChapterOutOfRange,
+		},
+		{
+			name:     "SameStart",
+			chapters: []*Chapter{{StartSeconds: 10}, {StartSeconds: 10}},
+			duration: 60,
+			want:     ErrChapterOverlap,
+		},
+		{
+			name:     "Overlap",
+			chapters: []*Chapter{{StartSeconds: 20}, {StartSeconds: 0, EndSeconds: seconds(25)}},
+			duration: 60,
+			want:     ErrChapterOverlap,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkChapters(tt.chapters, tt.duration)
+			if tt.want == nil {
+				require.NoError(t, err)
+				for i := 1; i < len(tt.chapters); i++ {
+					assert.Less(t, tt.chapters[i-1].StartSeconds, tt.chapters[i].StartSeconds)
+				}
+				return
+			}
+			assert.True(t, errors.Is(err, tt.want), "got %v", err)
+		})
+	}
+}
+
+func TestParsePodcastChapters(t *testing.T) {
+	data := `{
+		"version": "1.2.0",
+		"author": "Thmanyah",
+		"chapters": [
+			{"startTime": 0, "title": "Intro", "img": "https://example.com/intro.jpg"},
+			{"startTime": 62.5, "endTime": 120, "title": "Interview", "url": "https://example.com", "location": {"name": "Riyadh"}},
+			{"startTime": 130, "toc": false}
+		]
+	}`
+
+	chapters, err := ParsePodcastChapters([]byte(data))
+	require.NoError(t, err)
+	assert.Equal(t, []*Chapter{
+		{StartSeconds: 0, Title: "Intro", ImageURL: "https://example.com/intro.jpg"},
+		{StartSeconds: 62.5, EndSeconds: seconds(120), Title: "Interview", URL: "https://example.com"},
+		{StartSeconds: 130},
+	}, chapters)
+
+	exported, err := MarshalPodcastChapters(chapters)
+	require.NoError(t, err)
+	reparsed, err := ParsePodcastChapters(exported)
+	require.NoError(t, err)
+	assert.Equal(t, chapters, reparsed)
+}
+
+func TestParsePodcastChapters_Invalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"NotJSON":          `chapters`,
+		"MissingVersion":   `{"chapters": []}`,
+		"MissingChapters":  `{"version": "1.2.0"}`,
+		"MissingStartTime": `{"version": "1.2.0", "chapters": [{"title": "Intro"}]}`,
+		"StringStartTime":  `{"version": "1.2.0", "chapters": [{"startTime": "0"}]}`,
+	} {
+		_, err := ParsePodcastChapters([]byte(data))
+		assert.True(t, errors.Is(err, ErrInvalidChaptersJSON), "%s: got %v", name, err)
+	}
+
+	chapters, err := ParsePodcastChapters([]byte(`{"version": "1.2.0", "chapters": []}`))
+	require.NoError(t, err)
+	assert.Empty(t, chapters)
+}
@@ -0,0 +1,172 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// ListChapters returns the chapters of an episode, by start.
+func (uc *UseCase) ListChapters(ctx context.Context, episodeID uuid.UUID) ([]*Chapter, error) {
+	if _, err := uc.episodeRepo.GetByID(ctx, episodeID); err != nil {
+		return nil, err
+	}
+
+	return uc.chapterRepo.List(ctx, episodeID)
+}
+
+// CreateChapter adds a chapter to an episode of the caller.
+func (uc *UseCase) CreateChapter(ctx context.Context, chapter *Chapter) error {
+	return uc.changeChapters(ctx, chapter.EpisodeID, func(ctx context.Context, episode *Episode, chapters []*Chapter) error {
+		if chapter.ID == uuid.Nil {
+			chapter.ID = uuid.Must(uuid.NewV7())
+		}
+		chapter.CreatedAt = time.Now()
+		chapter.UpdatedAt = chapter.CreatedAt
+
+		if err := checkChapters(append(chapters, chapter), episode.DurationSecs); err != nil {
+			return err
+		}
+		if err := uc.chapterRepo.Create(ctx, chapter); err != nil {
+			return err
+		}
+
+		uc.auditChange(ctx, EntityTypeChapter, chapter.ID, nil, chapter)
+		return nil
+	})
+}
+
+func (uc *UseCase) UpdateChapter(ctx context.Context, id uuid.UUID, updates *UpdateChapterRequest) (*Chapter, error) {
+	before, err := uc.chapterRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var chapter *Chapter
+	err = uc.changeChapters(ctx, before.EpisodeID, func(ctx context.Context, episode *Episode, chapters []*Chapter) error {
+		i := slices.IndexFunc(chapters, func(c *Chapter) bool { return c.ID == id })
+		if i < 0 {
+			return ErrChapterNotFound
+		}
+		chapter = updates.apply(chapters[i])
+		chapters[i] = chapter
+
+		if err := checkChapters(chapters, episode.DurationSecs); err != nil {
+			return err
+		}
+		return uc.chapterRepo.Update(ctx, chapter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeChapter, id, before, chapter)
+
+	return chapter, nil
+}
+
+func (uc *UseCase) DeleteChapter(ctx context.Context, id uuid.UUID) error {
+	chapter, err := uc.chapterRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return uc.changeChapters(ctx, chapter.EpisodeID, func(ctx context.Context, _ *Episode, _ []*Chapter) error {
+		if err := uc.chapterRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		uc.auditChange(ctx, EntityTypeChapter, id, chapter, nil)
+		return nil
+	})
+}
+
+// ReplaceChapters swaps all chapters of an episode of the caller for chapters. No
+// chapters removes them all.
+func (uc *UseCase) ReplaceChapters(ctx context.Context, episodeID uuid.UUID, chapters []*Chapter) ([]*Chapter, error) {
+	err := uc.changeChapters(ctx, episodeID, func(ctx context.Context, episode *Episode, before []*Chapter) error {
+		now := time.Now()
+		for _, chapter := range chapters {
+			chapter.ID = uuid.Must(uuid.NewV7())
+			chapter.EpisodeID = episodeID
+			chapter.CreatedAt = now
+			chapter.UpdatedAt = now
+		}
+
+		if err := checkChapters(chapters, episode.DurationSecs); err != nil {
+			return err
+		}
+		if err := uc.chapterRepo.Replace(ctx, episodeID, chapters); err != nil {
+			return err
+		}
+
+		uc.auditChange(ctx, EntityTypeEpisode, episodeID, map[string]any{"chapters": before}, map[string]any{"chapters": chapters})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chapters, nil
+}
+
+// ImportChapters replaces the chapters of an episode of the caller by the ones of a
+// Podcasting 2.0 JSON chapters document.
+func (uc *UseCase) ImportChapters(ctx context.Context, episodeID uuid.UUID, data []byte) ([]*Chapter, error) {
+	chapters, err := ParsePodcastChapters(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.ReplaceChapters(ctx, episodeID, chapters)
+}
+
+// ExportChapters returns the chapters of an episode as a Podcasting 2.0 JSON chapters
+// document.
+func (uc *UseCase) ExportChapters(ctx context.Context, episodeID uuid.UUID) ([]byte, error) {
+	chapters, err := uc.ListChapters(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshalPodcastChapters(chapters)
+}
+
+// changeChapters runs fn with the episode and its current chapters, once the caller is
+// known to own the episode. No other change to the chapters of the episode can happen
+// until fn returns.
+func (uc *UseCase) changeChapters(ctx context.Context, episodeID uuid.UUID, fn func(ctx context.Context, episode *Episode, chapters []*Chapter) error) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	episode, err := uc.episodeRepo.GetByID(ctx, episodeID)
+	if err != nil {
+		return err
+	}
+	if episode.CreatedBy != userID {
+		return ErrForbidden
+	}
+
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		chapters, err := uc.chapterRepo.ListForUpdate(ctx, episodeID)
+		if err != nil {
+			return err
+		}
+		return fn(ctx, episode, chapters)
+	})
+}
+
+// checkEpisodeChapters fails when the chapters of an episode would no longer fit in it
+// at durationSecs.
+func (uc *UseCase) checkEpisodeChapters(ctx context.Context, episodeID uuid.UUID, durationSecs int32) error {
+	chapters, err := uc.chapterRepo.List(ctx, episodeID)
+	if err != nil {
+		return err
+	}
+	return checkChapters(chapters, durationSecs)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/metric"
 	"thmanyah/internal/utils"
 	"thmanyah/keys"
 )
@@ -15,13 +16,34 @@ import (
 type UseCase struct {
 	logger    *log.Helper
 	keysStore *keys.Store
+	metrics   *useCaseMetrics
 
 	usersRepo    UsersRepository
 	categoryRepo CategoryRepository
 	programRepo  ProgramRepository
 	episodeRepo  EpisodeRepository
 	importRepo   ImportRepository
+	webhookRepo  WebhookRepository
 	s3           S3Client
+
+	importNotifier ImportNotifier
+
+	workflowRepo WorkflowRepository
+	workflow     workflow
+	revisionRepo RevisionRepository
+	trashRepo    TrashRepository
+
+	tx          Transactor
+	auditRepo   AuditRepository
+	auditPolicy AuditPolicy
+
+	tagRepo         TagRepository
+	translationRepo TranslationRepository
+	seasonRepo      SeasonRepository
+	chapterRepo     ChapterRepository
+	transcriptRepo  TranscriptRepository
+	personRepo      PersonRepository
+	creditRepo      CreditRepository
 }
 
 func NewUseCase(
@@ -30,56 +52,232 @@ func NewUseCase(
 	programRepo ProgramRepository,
 	episodeRepo EpisodeRepository,
 	importRepo ImportRepository,
+	webhookRepo WebhookRepository,
 	keysStore *keys.Store,
 	s3 S3Client,
+	importNotifier ImportNotifier,
+	workflowRepo WorkflowRepository,
+	reviewPolicy ReviewPolicy,
+	revisionRepo RevisionRepository,
+	trashRepo TrashRepository,
+	tx Transactor,
+	auditRepo AuditRepository,
+	auditPolicy AuditPolicy,
+	tagRepo TagRepository,
+	translationRepo TranslationRepository,
+	seasonRepo SeasonRepository,
+	chapterRepo ChapterRepository,
+	transcriptRepo TranscriptRepository,
+	personRepo PersonRepository,
+	creditRepo CreditRepository,
+	meter metric.Meter,
 	logger log.Logger,
-) *UseCase {
+) (*UseCase, error) {
+	metrics, err := newUseCaseMetrics(meter)
+	if err != nil {
+		return nil, err
+	}
+
 	return &UseCase{
 		logger:       log.NewHelper(logger),
+		metrics:      metrics,
 		usersRepo:    userRepo,
 		categoryRepo: categoryRepo,
 		programRepo:  programRepo,
 		episodeRepo:  episodeRepo,
 		importRepo:   importRepo,
+		webhookRepo:  webhookRepo,
 		keysStore:    keysStore,
 		s3:           s3,
-	}
+
+		importNotifier: importNotifier,
+
+		workflowRepo: workflowRepo,
+		workflow:     workflow{policy: reviewPolicy},
+		revisionRepo: revisionRepo,
+		trashRepo:    trashRepo,
+
+		tx:          tx,
+		auditRepo:   auditRepo,
+		auditPolicy: auditPolicy,
+
+		tagRepo:         tagRepo,
+		translationRepo: translationRepo,
+		seasonRepo:      seasonRepo,
+		chapterRepo:     chapterRepo,
+		transcriptRepo:  transcriptRepo,
+		personRepo:      personRepo,
+		creditRepo:      creditRepo,
+	}, nil
 }
 
 // Program operations
 
+// CreateProgram stores a new program in its primary category and in CategoryIDs, which
+// may repeat the primary one.
 func (uc *UseCase) CreateProgram(ctx context.Context, program *Program) error {
-	return uc.programRepo.Create(ctx, program)
+	if err := program.Availability.normalize(); err != nil {
+		return err
+	}
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		tags, err := uc.normalizeTags(ctx, program.Tags)
+		if err != nil {
+			return err
+		}
+		program.Tags = tags
+
+		if err := uc.programRepo.Create(ctx, program); err != nil {
+			return err
+		}
+		if len(program.CategoryIDs) == 0 {
+			program.CategoryIDs = []uuid.UUID{program.CategoryID}
+			return nil
+		}
+
+		created, err := uc.programRepo.SetCategories(ctx, program.CreatedBy, program.ID, program.CategoryID, program.CategoryIDs)
+		if err != nil {
+			return err
+		}
+		*program = *created
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.afterCommit(ctx, func() { uc.metrics.programsCreated.Add(ctx, 1) })
+	uc.auditChange(ctx, EntityTypeProgram, program.ID, nil, program)
+	uc.recordProgramRevision(ctx, program, program.CreatedBy, nil)
+	uc.publishEvent(ctx, WebhookEventProgramCreated, program.CreatedBy, program)
+
+	return nil
 }
 
 func (uc *UseCase) UpdateProgram(ctx context.Context, id uuid.UUID, updates *UpdateProgramRequest) (*Program, error) {
 	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil && updates.Status != nil {
+		return nil, ErrUnauthorized
+	}
+
+	var action WorkflowAction
+	var before *Program
 	if userID != uuid.Nil {
 		program, err := uc.programRepo.GetByID(ctx, id)
 		if err != nil {
 			return nil, err
 		}
 		if program.CreatedBy != userID {
-			return nil, fmt.Errorf("unauthorized: you can only update your own programs")
+			return nil, ErrForbidden
+		}
+		if updates.Status != nil && *updates.Status != program.Status {
+			if action, err = uc.programAction(program, *updates.Status, userID); err != nil {
+				return nil, err
+			}
+		}
+		before = program
+	}
+	if updates.Availability != nil {
+		if err := updates.Availability.normalize(); err != nil {
+			return nil, err
 		}
 	}
 
-	return uc.programRepo.Update(ctx, userID, id, updates)
+	// The status only changes through the workflow, after the other fields are saved
+	fields := *updates
+	fields.Status = nil
+	if fields.Tags != nil {
+		tags, err := uc.normalizeTags(ctx, *fields.Tags)
+		if err != nil {
+			return nil, err
+		}
+		fields.Tags = &tags
+	}
+	program, err := uc.programRepo.Update(ctx, userID, id, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if action != "" {
+		if program, _, err = uc.transitionProgram(ctx, program, action, userID, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	uc.auditChange(ctx, EntityTypeProgram, id, before, program)
+	uc.recordProgramRevision(ctx, program, userID, nil)
+	uc.publishProgramUpdated(ctx, program, updates.Status)
+
+	return program, nil
+}
+
+// SetProgramCategories changes the categories a program of the current user appears in.
+// primaryID becomes its category_id; categoryIDs are the others and may repeat it.
+func (uc *UseCase) SetProgramCategories(ctx context.Context, id, primaryID uuid.UUID, categoryIDs []uuid.UUID) (*Program, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	before, err := uc.programRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if before.CreatedBy != userID {
+		return nil, ErrForbidden
+	}
+
+	program, err := uc.programRepo.SetCategories(ctx, userID, id, primaryID, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeProgram, id, before, program)
+	uc.recordProgramRevision(ctx, program, userID, nil)
+	uc.publishProgramUpdated(ctx, program, nil)
+
+	return program, nil
 }
 
 func (uc *UseCase) DeleteProgram(ctx context.Context, id uuid.UUID) error {
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return fmt.Errorf("unauthorized: user ID not found in context")
+		return ErrUnauthorized
+	}
+
+	program, err := uc.programRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.programRepo.Delete(ctx, userID, id); err != nil {
+		return err
 	}
 
-	return uc.programRepo.Delete(ctx, userID, id)
+	uc.auditChange(ctx, EntityTypeProgram, id, program, nil)
+	uc.publishEvent(ctx, WebhookEventProgramDeleted, program.CreatedBy, program)
+
+	return nil
 }
 
 func (uc *UseCase) GetProgram(ctx context.Context, id uuid.UUID) (*Program, error) {
 	return uc.programRepo.GetByID(ctx, id)
 }
 
+// GetProgramsByIDs loads several programs at once; ids that do not exist are left out.
+func (uc *UseCase) GetProgramsByIDs(ctx context.Context, ids []uuid.UUID) ([]*Program, error) {
+	return uc.programRepo.GetByIDs(ctx, ids)
+}
+
+// BatchGetPrograms loads up to MaxBatchGetIDs programs with one query, in request order.
+func (uc *UseCase) BatchGetPrograms(ctx context.Context, ids []uuid.UUID) (*Batch[*Program], error) {
+	programs, err := uc.programRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return NewBatch(ids, programs, func(p *Program) uuid.UUID { return p.ID }), nil
+}
+
 func (uc *UseCase) ListPrograms(ctx context.Context, filter ProgramFilter, pagination PaginationRequest, sort SortRequest) ([]*Program, *PaginationResponse, error) {
 	pagination.SetDefaults()
 
@@ -89,19 +287,88 @@ func (uc *UseCase) ListPrograms(ctx context.Context, filter ProgramFilter, pagin
 func (uc *UseCase) BulkUpdatePrograms(ctx context.Context, ids []uuid.UUID, updates *BulkUpdateProgramsRequest) (int32, error) {
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return 0, fmt.Errorf("unauthorized: user ID not found in context")
+		return 0, ErrUnauthorized
+	}
+
+	programs, err := uc.programRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	// Every status change is checked before anything is written, so an illegal
+	// transition leaves the whole batch untouched
+	actions := make(map[uuid.UUID]WorkflowAction, len(programs))
+	if updates.Status != nil {
+		for _, program := range programs {
+			if program.CreatedBy != userID || program.Status == *updates.Status {
+				continue
+			}
+			action, err := uc.programAction(program, *updates.Status, userID)
+			if err != nil {
+				return 0, withMetadata(err, "program_id", program.ID.String())
+			}
+			actions[program.ID] = action
+		}
 	}
 
-	return uc.programRepo.BulkUpdate(ctx, userID, ids, updates)
+	fields := *updates
+	fields.Status = nil
+	if fields.Tags != nil {
+		tags, err := uc.normalizeTags(ctx, *fields.Tags)
+		if err != nil {
+			return 0, err
+		}
+		fields.Tags = &tags
+	}
+	updated, err := uc.programRepo.BulkUpdate(ctx, userID, ids, &fields)
+	if err != nil {
+		return 0, err
+	}
+	uc.auditChange(ctx, EntityTypeProgram, uuid.Nil, nil, map[string]any{"program_ids": ids, "updates": updates})
+
+	for _, program := range programs {
+		if program.CreatedBy != userID {
+			continue
+		}
+		id := program.ID
+		if action, ok := actions[id]; ok {
+			if program, _, err = uc.transitionProgram(ctx, program, action, userID, ""); err != nil {
+				return updated, withMetadata(err, "program_id", id.String())
+			}
+		} else if program, err = uc.programRepo.GetByID(ctx, id); err != nil {
+			uc.logger.WithContext(ctx).Errorf("Failed to load bulk updated program for webhooks: %v", err)
+			continue
+		}
+		uc.recordProgramRevision(ctx, program, userID, nil)
+		uc.publishProgramUpdated(ctx, program, updates.Status)
+	}
+
+	return updated, nil
 }
 
 func (uc *UseCase) BulkDeletePrograms(ctx context.Context, ids []uuid.UUID) error {
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return fmt.Errorf("unauthorized: user ID not found in context")
+		return ErrUnauthorized
 	}
 
-	return uc.programRepo.BulkDelete(ctx, userID, ids)
+	programs, err := uc.programRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.programRepo.BulkDelete(ctx, userID, ids); err != nil {
+		return err
+	}
+	uc.auditChange(ctx, EntityTypeProgram, uuid.Nil, map[string]any{"program_ids": ids}, nil)
+
+	for _, program := range programs {
+		if program.CreatedBy == userID {
+			uc.publishEvent(ctx, WebhookEventProgramDeleted, program.CreatedBy, program)
+		}
+	}
+
+	return nil
 }
 
 // Category operations
@@ -120,33 +387,146 @@ func (uc *UseCase) CreateCategory(ctx context.Context, category *Category) error
 		category.CreatedBy = userID
 	}
 
-	return uc.categoryRepo.Create(ctx, category)
+	if category.ParentID != nil {
+		if _, err := uc.categoryRepo.GetByID(ctx, *category.ParentID); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.categoryRepo.Create(ctx, category); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypeCategory, category.ID, nil, category)
+
+	return nil
 }
 
 func (uc *UseCase) UpdateCategory(ctx context.Context, id uuid.UUID, updates *UpdateCategoryRequest) (*Category, error) {
 	// Get user ID from context if available
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return nil, fmt.Errorf("unauthorized: user ID not found in context")
+		return nil, ErrUnauthorized
 	}
 
-	return uc.categoryRepo.Update(ctx, userID, id, updates)
+	before, err := uc.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := uc.categoryRepo.Update(ctx, userID, id, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeCategory, id, before, category)
+
+	return category, nil
 }
 
 func (uc *UseCase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
 	// Get user ID from context if available
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return fmt.Errorf("unauthorized: user ID not found in context")
+		return ErrUnauthorized
+	}
+
+	category, err := uc.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.categoryRepo.Delete(ctx, userID, id); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypeCategory, id, category, nil)
+
+	return nil
+}
+
+// MoveCategory puts a category of the current user under another category, or at the
+// top level when parentID is nil. Its subcategories move along with it.
+func (uc *UseCase) MoveCategory(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (*Category, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	before, err := uc.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if before.CreatedBy != userID {
+		return nil, ErrForbidden
+	}
+
+	category, err := uc.categoryRepo.Move(ctx, userID, id, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeCategory, id, before, category)
+
+	return category, nil
+}
+
+// GetCategoryTree returns the category tree below rootID, or the whole tree when rootID
+// is nil. Siblings are ordered by name.
+func (uc *UseCase) GetCategoryTree(ctx context.Context, rootID *uuid.UUID) ([]*CategoryNode, error) {
+	categories, err := uc.categoryRepo.Tree(ctx, rootID)
+	if err != nil {
+		return nil, err
+	}
+	if rootID != nil && len(categories) == 0 {
+		return nil, ErrCategoryNotFound
 	}
 
-	return uc.categoryRepo.Delete(ctx, userID, id)
+	return buildCategoryTree(categories, rootID), nil
+}
+
+// buildCategoryTree nests categories, which list parents before their children. With
+// a rootID the root is the only top-level node.
+func buildCategoryTree(categories []*Category, rootID *uuid.UUID) []*CategoryNode {
+	nodes := make(map[uuid.UUID]*CategoryNode, len(categories))
+	var roots []*CategoryNode
+	for _, category := range categories {
+		node := &CategoryNode{Category: category}
+		nodes[category.ID] = node
+
+		isRoot := category.ParentID == nil
+		if rootID != nil {
+			isRoot = category.ID == *rootID
+		}
+		if isRoot {
+			roots = append(roots, node)
+			continue
+		}
+		if parent, ok := nodes[*category.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+	return roots
 }
 
 func (uc *UseCase) GetCategory(ctx context.Context, id uuid.UUID) (*Category, error) {
 	return uc.categoryRepo.GetByID(ctx, id)
 }
 
+// GetCategoriesByIDs loads several categories at once; ids that do not exist are left out.
+func (uc *UseCase) GetCategoriesByIDs(ctx context.Context, ids []uuid.UUID) ([]*Category, error) {
+	return uc.categoryRepo.GetByIDs(ctx, ids)
+}
+
+// BatchGetCategories loads up to MaxBatchGetIDs categories with one query, in request order.
+func (uc *UseCase) BatchGetCategories(ctx context.Context, ids []uuid.UUID) (*Batch[*Category], error) {
+	categories, err := uc.categoryRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return NewBatch(ids, categories, func(c *Category) uuid.UUID { return c.ID }), nil
+}
+
 func (uc *UseCase) ListCategories(ctx context.Context, filter CategoryFilter, pagination PaginationRequest, sort SortRequest) ([]*Category, *PaginationResponse, error) {
 	pagination.SetDefaults()
 
@@ -177,11 +557,25 @@ func (uc *UseCase) CreateEpisode(ctx context.Context, episode *Episode) error {
 		episode.UpdatedBy = userID
 	}
 
-	err := uc.episodeRepo.Create(ctx, episode)
+	if err := episode.Availability.normalize(); err != nil {
+		return err
+	}
+	tags, err := uc.normalizeTags(ctx, episode.Tags)
+	if err != nil {
+		return err
+	}
+	episode.Tags = tags
+
+	err = uc.episodeRepo.Create(ctx, episode)
 	if err != nil {
 		return err
 	}
 
+	uc.afterCommit(ctx, func() { uc.metrics.episodesCreated.Add(ctx, 1) })
+	uc.auditChange(ctx, EntityTypeEpisode, episode.ID, nil, episode)
+	uc.recordEpisodeRevision(ctx, episode, episode.CreatedBy, nil)
+	uc.publishEvent(ctx, WebhookEventEpisodeCreated, episode.CreatedBy, episode)
+
 	// Update episodes count for the program
 	return uc.programRepo.UpdateEpisodesCount(ctx, episode.ProgramID)
 }
@@ -190,26 +584,255 @@ func (uc *UseCase) UpdateEpisode(ctx context.Context, id uuid.UUID, updates *Upd
 	// Get user ID from context if available
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return nil, fmt.Errorf("unauthorized: user ID not found in context")
+		return nil, ErrUnauthorized
+	}
+
+	current, err := uc.episodeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current.CreatedBy != userID {
+		return nil, ErrForbidden
+	}
+
+	if err := checkSchedule(current, updates); err != nil {
+		return nil, err
+	}
+	if updates.Availability != nil {
+		if err := updates.Availability.normalize(); err != nil {
+			return nil, err
+		}
+	}
+	if updates.DurationSecs != nil && *updates.DurationSecs != current.DurationSecs {
+		if err := uc.checkEpisodeChapters(ctx, id, *updates.DurationSecs); err != nil {
+			return nil, err
+		}
 	}
 
-	return uc.episodeRepo.Update(ctx, userID, id, updates)
+	var action WorkflowAction
+	if updates.Status != nil && *updates.Status != current.Status {
+		if action, err = uc.episodeAction(current, *updates.Status, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	// The status only changes through the workflow, after the other fields are saved
+	fields := *updates
+	fields.Status = nil
+	if fields.Tags != nil {
+		tags, err := uc.normalizeTags(ctx, *fields.Tags)
+		if err != nil {
+			return nil, err
+		}
+		fields.Tags = &tags
+	}
+	episode, err := uc.episodeRepo.Update(ctx, userID, id, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if action != "" {
+		if episode, _, err = uc.transitionEpisode(ctx, episode, action, userID, "", episode.ScheduledAt); err != nil {
+			return nil, err
+		}
+	}
+
+	uc.auditChange(ctx, EntityTypeEpisode, id, current, episode)
+	uc.recordEpisodeRevision(ctx, episode, userID, nil)
+	uc.publishEpisodeUpdated(ctx, episode, updates.Status)
+
+	return episode, nil
+}
+
+// checkSchedule rejects updates that would leave an episode SCHEDULED without a
+// scheduled_at in the future, since the scheduler would publish it right away.
+func checkSchedule(episode *Episode, updates *UpdateEpisodeRequest) error {
+	if updates.Status == nil && updates.ScheduledAt == nil {
+		return nil
+	}
+
+	status, scheduledAt := episode.Status, episode.ScheduledAt
+	if updates.Status != nil {
+		status = *updates.Status
+	}
+	if updates.ScheduledAt != nil {
+		scheduledAt = updates.ScheduledAt
+	}
+
+	if status != EpisodeStatusScheduled {
+		return nil
+	}
+	if scheduledAt == nil || !scheduledAt.After(time.Now()) {
+		return ErrInvalidSchedule
+	}
+	return nil
+}
+
+// RescheduleEpisode schedules an approved episode, or moves an already scheduled one, to at.
+// Without review, drafts can be scheduled too.
+func (uc *UseCase) RescheduleEpisode(ctx context.Context, id uuid.UUID, at time.Time) (*Episode, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	if !at.After(time.Now()) {
+		return nil, ErrInvalidSchedule
+	}
+
+	before, err := uc.episodeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	episode, _, err := uc.transitionEpisode(ctx, before, WorkflowActionSchedule, userID, "", &at)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeEpisode, id, before, episode)
+
+	uc.recordEpisodeRevision(ctx, episode, userID, nil)
+	uc.publishEvent(ctx, WebhookEventEpisodeUpdated, episode.CreatedBy, episode)
+
+	return episode, nil
+}
+
+// CancelEpisodeSchedule takes a scheduled episode off the schedule. It goes back to
+// approved, or to draft when review is not required.
+func (uc *UseCase) CancelEpisodeSchedule(ctx context.Context, id uuid.UUID) (*Episode, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	before, err := uc.episodeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if before.CreatedBy != userID {
+		return nil, ErrForbidden
+	}
+	if before.Status != EpisodeStatusScheduled {
+		return nil, ErrEpisodeNotScheduled
+	}
+
+	episode, _, err := uc.transitionEpisode(ctx, before, WorkflowActionUnschedule, userID, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeEpisode, id, before, episode)
+
+	uc.recordEpisodeRevision(ctx, episode, userID, nil)
+	uc.publishEvent(ctx, WebhookEventEpisodeUpdated, episode.CreatedBy, episode)
+
+	return episode, nil
+}
+
+// PublishDueEpisodes publishes up to limit scheduled episodes whose time has come and
+// returns how many it published. Episodes of programs that are not published, or are in
+// the trash, wait for their program. The episodes are published together with their
+// transitions, revisions, audit events and webhook deliveries, so a failure leaves them
+// scheduled for the next run. It is safe to call from every replica at once.
+func (uc *UseCase) PublishDueEpisodes(ctx context.Context, limit int32) (int, error) {
+	var episodes []*Episode
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if episodes, err = uc.episodeRepo.PublishDue(ctx, limit); err != nil {
+			return err
+		}
+
+		programIDs := make(map[uuid.UUID]struct{}, len(episodes))
+		for _, episode := range episodes {
+			programIDs[episode.ProgramID] = struct{}{}
+
+			uc.recordEpisodeRevision(ctx, episode, uuid.Nil, nil)
+			if err := uc.auditRepo.Create(ctx, scheduledPublication(episode)); err != nil {
+				return fmt.Errorf("failed to store audit event: %w", err)
+			}
+			// Unlike publishEvent, a failure undoes the publication so the events are not lost
+			for _, eventType := range []WebhookEventType{WebhookEventEpisodeUpdated, WebhookEventEpisodePublished} {
+				if err := uc.queueEvent(ctx, eventType, episode.CreatedBy, episode); err != nil {
+					return fmt.Errorf("failed to queue webhook event %s: %w", eventType, err)
+				}
+			}
+		}
+
+		for programID := range programIDs {
+			if err := uc.programRepo.UpdateEpisodesCount(ctx, programID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	uc.metrics.episodesPublished.Add(ctx, int64(len(episodes)))
+
+	return len(episodes), nil
+}
+
+// scheduledPublication is the audit event of the scheduler publishing episode.
+func scheduledPublication(episode *Episode) *AuditEvent {
+	id := episode.ID
+	after := Snapshot{"status": episode.Status}
+	if episode.PublishedAt != nil {
+		after["published_at"] = episode.PublishedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return &AuditEvent{
+		PrincipalType: PrincipalSystem,
+		Operation:     OperationEpisodeScheduler,
+		EntityType:    EntityTypeEpisode,
+		EntityID:      &id,
+		Before:        Snapshot{"status": EpisodeStatusScheduled},
+		After:         after,
+		Outcome:       AuditOutcomeSuccess,
+	}
 }
 
 func (uc *UseCase) DeleteEpisode(ctx context.Context, id uuid.UUID) error {
 	// Get user ID from context if available
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return fmt.Errorf("unauthorized: user ID not found in context")
+		return ErrUnauthorized
 	}
 
-	return uc.episodeRepo.Delete(ctx, userID, id)
+	episode, err := uc.episodeRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if episode.CreatedBy != userID {
+		return ErrForbidden
+	}
+
+	if err := uc.episodeRepo.Delete(ctx, userID, id); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypeEpisode, id, episode, nil)
+
+	uc.publishEvent(ctx, WebhookEventEpisodeDeleted, episode.CreatedBy, episode)
+
+	return uc.programRepo.UpdateEpisodesCount(ctx, episode.ProgramID)
 }
 
 func (uc *UseCase) GetEpisode(ctx context.Context, id uuid.UUID) (*Episode, error) {
 	return uc.episodeRepo.GetByID(ctx, id)
 }
 
+// BatchGetEpisodes loads up to MaxBatchGetIDs episodes with one query, in request order.
+func (uc *UseCase) BatchGetEpisodes(ctx context.Context, ids []uuid.UUID) (*Batch[*Episode], error) {
+	episodes, err := uc.episodeRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return NewBatch(ids, episodes, func(e *Episode) uuid.UUID { return e.ID }), nil
+}
+
 func (uc *UseCase) ListEpisodes(ctx context.Context, filter EpisodeFilter, pagination PaginationRequest, sort SortRequest) ([]*Episode, *PaginationResponse, error) {
 	pagination.SetDefaults()
 
@@ -235,13 +858,37 @@ func (uc *UseCase) ListEpisodesByProgram(ctx context.Context, programID uuid.UUI
 			return nil, nil, err
 		}
 		if program.CreatedBy != userID {
-			return nil, nil, fmt.Errorf("unauthorized: you can only view episodes of your own programs")
+			return nil, nil, ErrForbidden
 		}
 	}
 
 	return uc.episodeRepo.ListByProgram(ctx, programID, pagination, sort)
 }
 
+// ListEpisodesByPrograms is the batched form of ListEpisodesByProgram. Programs the
+// caller does not own are left out of the result rather than failing the whole batch.
+func (uc *UseCase) ListEpisodesByPrograms(ctx context.Context, programIDs []uuid.UUID, pagination PaginationRequest, sort SortRequest) (map[uuid.UUID]*EpisodePage, error) {
+	pagination.SetDefaults()
+
+	userID, _ := utils.GetUserID(ctx)
+	if userID != uuid.Nil {
+		programs, err := uc.programRepo.GetByIDs(ctx, programIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		owned := make([]uuid.UUID, 0, len(programs))
+		for _, program := range programs {
+			if program.CreatedBy == userID {
+				owned = append(owned, program.ID)
+			}
+		}
+		programIDs = owned
+	}
+
+	return uc.episodeRepo.ListByPrograms(ctx, programIDs, pagination, sort)
+}
+
 func (uc *UseCase) IncrementEpisodeViewCount(ctx context.Context, id uuid.UUID) error {
 	return uc.episodeRepo.IncrementViewCount(ctx, id)
 }
@@ -272,6 +919,9 @@ func (uc *UseCase) ImportData(ctx context.Context, importData *ImportData) (*Imp
 		return nil, err
 	}
 
+	uc.afterCommit(ctx, func() { uc.metrics.importsCreated.Add(ctx, 1) })
+	uc.auditChange(ctx, EntityTypeImport, importData.ID, nil, importData)
+
 	return importData, nil
 }
 
@@ -279,10 +929,20 @@ func (uc *UseCase) UpdateImport(ctx context.Context, id uuid.UUID, updates *Upda
 	// Get user ID from context if available
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return nil, fmt.Errorf("unauthorized: user ID not found in context")
+		return nil, ErrUnauthorized
+	}
+
+	importData, err := uc.importRepo.Update(ctx, userID, id, updates)
+	if err != nil {
+		return nil, err
 	}
 
-	return uc.importRepo.Update(ctx, userID, id, updates)
+	if updates.Status != nil {
+		status := *updates.Status
+		uc.afterCommit(ctx, func() { uc.metrics.importProcessed(ctx, status) })
+	}
+
+	return importData, nil
 }
 
 func (uc *UseCase) GetImport(ctx context.Context, id uuid.UUID) (*ImportData, error) {
@@ -310,7 +970,7 @@ func (uc *UseCase) UpdateImportProgress(ctx context.Context, id uuid.UUID, updat
 	// Get user ID from context if available
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return fmt.Errorf("unauthorized: user ID not found in context")
+		return ErrUnauthorized
 	}
 
 	return uc.importRepo.UpdateProgress(ctx, userID, id, updates)
@@ -320,7 +980,7 @@ func (uc *UseCase) AddImportError(ctx context.Context, id uuid.UUID, errorMsg st
 	// Get user ID from context if available
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return fmt.Errorf("unauthorized: user ID not found in context")
+		return ErrUnauthorized
 	}
 
 	return uc.importRepo.AddError(ctx, userID, id, errorMsg)
@@ -330,19 +990,106 @@ func (uc *UseCase) AddImportWarning(ctx context.Context, id uuid.UUID, warningMs
 	// Get user ID from context if available
 	userID, _ := utils.GetUserID(ctx)
 	if userID == uuid.Nil {
-		return fmt.Errorf("unauthorized: user ID not found in context")
+		return ErrUnauthorized
 	}
 
 	return uc.importRepo.AddWarning(ctx, userID, id, warningMsg)
 }
 
+// importResyncInterval bounds how long a watcher can miss a change when a notification is lost,
+// for example while the listener reconnects.
+const importResyncInterval = 30 * time.Second
+
+// WatchImport sends the current state of an import, then every change to it, until the import
+// completes or fails or ctx is done.
+func (uc *UseCase) WatchImport(ctx context.Context, id uuid.UUID, send func(*ImportEvent) error) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	// Subscribe before the first read so no change falls between the two
+	changes, unsubscribe := uc.importNotifier.Subscribe(id)
+	defer unsubscribe()
+
+	current, err := uc.importRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current.CreatedBy != userID {
+		return ErrImportNotFound
+	}
+
+	if err := send(&ImportEvent{Type: ImportEventProgress, Import: current}); err != nil {
+		return err
+	}
+
+	resync := time.NewTicker(importResyncInterval)
+	defer resync.Stop()
+
+	for !importFinished(current.Status) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changes:
+		case <-resync.C:
+		}
+
+		next, err := uc.importRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range importEvents(current, next) {
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+		current = next
+	}
+
+	return nil
+}
+
+func importFinished(status ImportStatus) bool {
+	return status == ImportStatusCompleted || status == ImportStatusFailed
+}
+
+// importEvents describes the change from prev to next: new warnings and errors first, then
+// a progress event if the counters or status moved, so the final status is always sent last.
+func importEvents(prev, next *ImportData) []*ImportEvent {
+	var events []*ImportEvent
+	if len(next.Warnings) > len(prev.Warnings) {
+		for _, warning := range next.Warnings[len(prev.Warnings):] {
+			events = append(events, &ImportEvent{Type: ImportEventWarning, Import: next, Message: warning})
+		}
+	}
+	if len(next.Errors) > len(prev.Errors) {
+		for _, message := range next.Errors[len(prev.Errors):] {
+			events = append(events, &ImportEvent{Type: ImportEventError, Import: next, Message: message})
+		}
+	}
+
+	if next.Status != prev.Status ||
+		next.TotalItems != prev.TotalItems ||
+		next.ProcessedItems != prev.ProcessedItems ||
+		next.SuccessCount != prev.SuccessCount ||
+		next.ErrorCount != prev.ErrorCount {
+		events = append(events, &ImportEvent{Type: ImportEventProgress, Import: next})
+	}
+
+	return events
+}
+
+// UpdateEpisodeFile uploads the thumbnail or media file of an episode. The upload runs
+// before the transaction that points the episode at it, so it holds no connection.
 func (uc *UseCase) UpdateEpisodeFile(ctx context.Context, userId, episodeId uuid.UUID, request *UpdateEpisodeFileRequest) (string, error) {
-	episode, err := uc.episodeRepo.GetByID(ctx, episodeId)
+	before, err := uc.episodeRepo.GetByID(ctx, episodeId)
 	if err != nil {
 		return "", err
 	}
 
-	key := fmt.Sprintf("episodes/%s/%s%s", episode.ID.String(), request.Target, filepath.Ext(request.Header.Filename))
+	key := fmt.Sprintf("episodes/%s/%s%s", before.ID.String(), request.Target, filepath.Ext(request.Header.Filename))
 	err = uc.s3.PutObject(ctx, "thmanyah", key, request.File)
 	if err != nil {
 		return "", err
@@ -357,7 +1104,17 @@ func (uc *UseCase) UpdateEpisodeFile(ctx context.Context, userId, episodeId uuid
 		updateEpisodeRequest.MediaURL = &fileUrl
 	}
 
-	_, err = uc.episodeRepo.Update(ctx, userId, episodeId, updateEpisodeRequest)
+	err = uc.auditedTx(ctx, func(ctx context.Context) error {
+		episode, err := uc.episodeRepo.Update(ctx, userId, episodeId, updateEpisodeRequest)
+		if err != nil {
+			return err
+		}
+
+		uc.auditChange(ctx, EntityTypeEpisode, episodeId, before, episode)
+		uc.recordEpisodeRevision(ctx, episode, userId, nil)
+		uc.publishEpisodeUpdated(ctx, episode, nil)
+		return nil
+	})
 	if err != nil {
 		return "", err
 	}
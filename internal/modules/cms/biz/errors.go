@@ -7,9 +7,58 @@ import (
 var ErrUserNotFound = errors.NotFound("USER_NOT_FOUND", "user not found")
 var ErrInvalidCredentials = errors.Unauthorized("INVALID_CREDENTIALS", "invalid credentials")
 var ErrUnauthorized = errors.Unauthorized("UNAUTHORIZED", "unauthorized")
+var ErrForbidden = errors.Forbidden("FORBIDDEN", "you can only access your own content")
 var ErrUserAlreadyExists = errors.BadRequest("USER_ALREADY_EXISTS", "user already exists")
 var ErrCategoryAlreadyExists = errors.BadRequest("CATEGORY_ALREADY_EXISTS", "category name already exists")
 var ErrCategoryNotFound = errors.NotFound("CATEGORY_NOT_FOUND", "category not found")
 var ErrProgramNotFound = errors.NotFound("PROGRAM_NOT_FOUND", "program not found")
 var ErrEpisodeNotFound = errors.NotFound("EPISODE_NOT_FOUND", "episode not found")
 var ErrEpisodeAlreadyExists = errors.BadRequest("EPISODE_ALREADY_EXISTS", "episode with this number already exists for this program and season")
+var ErrInvalidSchedule = errors.BadRequest("INVALID_SCHEDULE", "scheduled episodes need a scheduled_at in the future")
+var ErrEpisodeNotScheduled = errors.Conflict("EPISODE_NOT_SCHEDULED", "episode is not scheduled")
+var ErrIllegalTransition = errors.Conflict("ILLEGAL_STATUS_TRANSITION", "this status change is not allowed by the editorial workflow")
+var ErrStatusChanged = errors.Conflict("STATUS_CHANGED", "the status changed in the meantime, reload and try again")
+var ErrReviewerRequired = errors.Forbidden("REVIEWER_REQUIRED", "only reviewers can approve or reject content")
+var ErrSelfReview = errors.Forbidden("SELF_REVIEW_NOT_ALLOWED", "you cannot review your own content")
+var ErrInvalidContentType = errors.BadRequest("INVALID_CONTENT_TYPE", "content type must be program or episode")
+var ErrReviewCommentRequired = errors.BadRequest("REVIEW_COMMENT_REQUIRED", "a comment is required to reject content")
+var ErrRevisionNotFound = errors.NotFound("REVISION_NOT_FOUND", "revision not found")
+var ErrRevisionMismatch = errors.BadRequest("REVISION_MISMATCH", "revisions belong to different content")
+var ErrTrashItemNotFound = errors.NotFound("TRASH_ITEM_NOT_FOUND", "nothing like this is in your trash")
+var ErrParentInTrash = errors.Conflict("PARENT_IN_TRASH", "restore the category or program this belongs to first")
+var ErrCategoryInUse = errors.Conflict("CATEGORY_IN_USE", "category is still used by programs")
+var ErrCategoryHasChildren = errors.Conflict("CATEGORY_HAS_CHILDREN", "category still has subcategories")
+var ErrCategoryCycle = errors.BadRequest("CATEGORY_CYCLE", "a category cannot be moved under itself or one of its subcategories")
+var ErrAdminRequired = errors.Forbidden("ADMIN_REQUIRED", "only admins can read the audit log")
+var ErrTagNotFound = errors.NotFound("TAG_NOT_FOUND", "tag not found")
+var ErrTagAlreadyExists = errors.Conflict("TAG_ALREADY_EXISTS", "another tag already has this name or alias")
+var ErrInvalidTag = errors.BadRequest("INVALID_TAG", "a tag needs at least one letter or digit")
+var ErrTagMergeIntoSelf = errors.BadRequest("TAG_MERGE_INTO_SELF", "a tag cannot be merged into itself")
+var ErrTaxonomyReviewerRequired = errors.Forbidden("TAXONOMY_REVIEWER_REQUIRED", "only reviewers can change the tag taxonomy")
+var ErrSeasonNotFound = errors.NotFound("SEASON_NOT_FOUND", "season not found")
+var ErrSeasonAlreadyExists = errors.Conflict("SEASON_ALREADY_EXISTS", "the program already has a season with this number")
+var ErrSeasonHasEpisodes = errors.Conflict("SEASON_HAS_EPISODES", "season still has episodes, including the ones in the trash")
+var ErrEpisodeOrderIncomplete = errors.BadRequest("EPISODE_ORDER_INCOMPLETE", "the new order must list every episode of the season exactly once")
+var ErrEpisodeNotInProgram = errors.BadRequest("EPISODE_NOT_IN_PROGRAM", "episodes can only move between seasons of the same program")
+var ErrInvalidReleaseWindow = errors.BadRequest("INVALID_RELEASE_WINDOW", "the release window cannot end before it starts")
+var ErrTranslationNotFound = errors.NotFound("TRANSLATION_NOT_FOUND", "translation not found")
+var ErrInvalidLocale = errors.BadRequest("INVALID_LOCALE", "locale must be a BCP 47 language tag such as ar or en-US")
+var ErrChapterNotFound = errors.NotFound("CHAPTER_NOT_FOUND", "chapter not found")
+var ErrChapterOutOfRange = errors.BadRequest("CHAPTER_OUT_OF_RANGE", "chapters must start and end within the duration of the episode")
+var ErrChapterOverlap = errors.BadRequest("CHAPTER_OVERLAP", "chapters cannot start at the same time or overlap")
+var ErrInvalidChaptersJSON = errors.BadRequest("INVALID_CHAPTERS_JSON", "chapters must be a Podcasting 2.0 JSON chapters document")
+var ErrTranscriptNotFound = errors.NotFound("TRANSCRIPT_NOT_FOUND", "transcript not found")
+var ErrInvalidTranscript = errors.BadRequest("INVALID_TRANSCRIPT", "the transcript is not valid in its format")
+var ErrInvalidTranscriptFormat = errors.BadRequest("INVALID_TRANSCRIPT_FORMAT", "transcripts are WebVTT, SRT, plain text or Podcasting 2.0 JSON")
+var ErrTranscriptNotTimed = errors.BadRequest("TRANSCRIPT_NOT_TIMED", "plain text transcripts have no timing, so they can only be served as plain text")
+var ErrPersonNotFound = errors.NotFound("PERSON_NOT_FOUND", "person not found")
+var ErrCreditNotFound = errors.NotFound("CREDIT_NOT_FOUND", "credit not found")
+var ErrCreditAlreadyExists = errors.Conflict("CREDIT_ALREADY_EXISTS", "the person is already credited on this content in this role")
+var ErrInvalidCreditRole = errors.BadRequest("INVALID_CREDIT_ROLE", "credit role must be host, guest or producer")
+var ErrInvalidAvailability = errors.BadRequest("INVALID_AVAILABILITY", "availability cannot end before it starts, nor allow and block the same country")
+var ErrInvalidCountry = errors.BadRequest("INVALID_COUNTRY", "countries must be ISO 3166-1 alpha-2 codes such as SA")
+var ErrImportNotFound = errors.NotFound("IMPORT_NOT_FOUND", "import not found")
+var ErrWebhookNotFound = errors.NotFound("WEBHOOK_NOT_FOUND", "webhook not found")
+var ErrWebhookDeliveryNotFound = errors.NotFound("WEBHOOK_DELIVERY_NOT_FOUND", "webhook delivery not found")
+var ErrInvalidWebhookURL = errors.BadRequest("INVALID_WEBHOOK_URL", "webhook url must be an absolute http or https url on a public host")
+var ErrInvalidWebhookEventType = errors.BadRequest("INVALID_WEBHOOK_EVENT_TYPE", "unknown webhook event type")
@@ -0,0 +1,216 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"slices"
+
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// The fakes implement the parts of the repositories the tests need; calling anything
+// else panics on the nil embedded interface.
+
+type fakeProgramRepo struct {
+	ProgramRepository
+	programs map[uuid.UUID]*Program
+}
+
+func (r *fakeProgramRepo) GetByID(ctx context.Context, id uuid.UUID) (*Program, error) {
+	program, ok := r.programs[id]
+	if !ok {
+		return nil, ErrProgramNotFound
+	}
+	copied := *program
+	return &copied, nil
+}
+
+func (r *fakeProgramRepo) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*Program, error) {
+	return byIDs(r.programs, ids), nil
+}
+
+type fakeEpisodeRepo struct {
+	EpisodeRepository
+	episodes map[uuid.UUID]*Episode
+}
+
+func (r *fakeEpisodeRepo) GetByID(ctx context.Context, id uuid.UUID) (*Episode, error) {
+	episode, ok := r.episodes[id]
+	if !ok {
+		return nil, ErrEpisodeNotFound
+	}
+	copied := *episode
+	return &copied, nil
+}
+
+func (r *fakeEpisodeRepo) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*Episode, error) {
+	return byIDs(r.episodes, ids), nil
+}
+
+type fakeCategoryRepo struct {
+	CategoryRepository
+	categories map[uuid.UUID]*Category
+}
+
+func (r *fakeCategoryRepo) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*Category, error) {
+	return byIDs(r.categories, ids), nil
+}
+
+// byIDs answers a GetByIDs the way the database does: every known ID once, not in
+// request order. It returns them reversed so that tests notice relying on the order.
+func byIDs[T any](items map[uuid.UUID]*T, ids []uuid.UUID) []*T {
+	var found []*T
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range slices.Backward(ids) {
+		if item, ok := items[id]; ok && !seen[id] {
+			seen[id] = true
+			found = append(found, item)
+		}
+	}
+	return found
+}
+
+// fakeTranscriptRepo holds one transcript of every episode.
+type fakeTranscriptRepo struct {
+	TranscriptRepository
+	transcript *Transcript
+	cues       []*TranscriptCue
+}
+
+func (r *fakeTranscriptRepo) Get(ctx context.Context, episodeID uuid.UUID, locale string) (*Transcript, error) {
+	transcript := *r.transcript
+	transcript.EpisodeID = episodeID
+	return &transcript, nil
+}
+
+func (r *fakeTranscriptRepo) Cues(ctx context.Context, transcriptID uuid.UUID) ([]*TranscriptCue, error) {
+	return r.cues, nil
+}
+
+// fakeS3 serves objects from memory and counts the readers left open.
+type fakeS3 struct {
+	S3Client
+	objects map[string][]byte
+	open    int
+}
+
+func (c *fakeS3) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	c.open++
+	return &fakeObject{Reader: bytes.NewReader(c.objects[bucket+"/"+key]), s3: c}, nil
+}
+
+type fakeObject struct {
+	io.Reader
+	s3 *fakeS3
+}
+
+func (o *fakeObject) Close() error {
+	o.s3.open--
+	return nil
+}
+
+type fakeTxKey struct{}
+
+// fakeTransactor counts the transactions it commits and rolls back. Nested calls join the
+// outer transaction, like savepoints.
+type fakeTransactor struct {
+	commits   int
+	rollbacks int
+}
+
+func (t *fakeTransactor) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if inFakeTx(ctx) {
+		return fn(ctx)
+	}
+	if err := fn(context.WithValue(ctx, fakeTxKey{}, true)); err != nil {
+		t.rollbacks++
+		return err
+	}
+	t.commits++
+	return nil
+}
+
+func inFakeTx(ctx context.Context) bool {
+	inTx, _ := ctx.Value(fakeTxKey{}).(bool)
+	return inTx
+}
+
+// storedAuditEvent is an audit event as it was stored, and whether that was inside a
+// transaction.
+type storedAuditEvent struct {
+	AuditEvent
+	inTx bool
+}
+
+// fakeAuditRepo stores audit events in memory. failSuccess makes storing the event of a
+// successful operation fail.
+type fakeAuditRepo struct {
+	AuditRepository
+	events      []storedAuditEvent
+	failSuccess error
+}
+
+func (r *fakeAuditRepo) Create(ctx context.Context, event *AuditEvent) error {
+	if event.Outcome == AuditOutcomeSuccess && r.failSuccess != nil {
+		return r.failSuccess
+	}
+	r.events = append(r.events, storedAuditEvent{AuditEvent: *event, inTx: inFakeTx(ctx)})
+	return nil
+}
+
+func (r *fakeAuditRepo) List(ctx context.Context, filter AuditEventFilter, pagination PaginationRequest) ([]*AuditEvent, *PaginationResponse, error) {
+	events := make([]*AuditEvent, 0, len(r.events))
+	for i := range r.events {
+		events = append(events, &r.events[i].AuditEvent)
+	}
+	return events, &PaginationResponse{}, nil
+}
+
+func (r *fakeAuditRepo) Export(ctx context.Context, filter AuditEventFilter, fn func(*AuditEvent) error) error {
+	for i := range r.events {
+		if err := fn(&r.events[i].AuditEvent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakeAuditPolicy struct {
+	admins []uuid.UUID
+}
+
+func (p fakeAuditPolicy) IsAdmin(userID uuid.UUID) bool {
+	return slices.Contains(p.admins, userID)
+}
+
+// fakeImportRepo keeps created imports in memory.
+type fakeImportRepo struct {
+	ImportRepository
+	imports map[uuid.UUID]*ImportData
+}
+
+func (r *fakeImportRepo) Create(ctx context.Context, importData *ImportData) error {
+	copied := *importData
+	r.imports[importData.ID] = &copied
+	return nil
+}
+
+func (r *fakeImportRepo) Update(ctx context.Context, userID, id uuid.UUID, updates *UpdateImportRequest) (*ImportData, error) {
+	importData, ok := r.imports[id]
+	if !ok {
+		return nil, ErrImportNotFound
+	}
+	if updates.Status != nil {
+		importData.Status = *updates.Status
+	}
+	copied := *importData
+	return &copied, nil
+}
+
+// asUser returns a context authenticated as userID.
+func asUser(ctx context.Context, userID uuid.UUID) context.Context {
+	return jwt.NewContext(ctx, utils.NewClaimsBuilder().WithUserID(userID.String()).Build())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"mime/multipart"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -20,7 +21,14 @@ type CategoryRepository interface {
 	Update(ctx context.Context, userID, id uuid.UUID, updates *UpdateCategoryRequest) (*Category, error)
 	Delete(ctx context.Context, userID, id uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*Category, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*Category, error)
 	List(ctx context.Context, filter CategoryFilter, pagination PaginationRequest, sort SortRequest) ([]*Category, *PaginationResponse, error)
+	// Tree returns the categories under rootID, or every category without one, parents
+	// before their children.
+	Tree(ctx context.Context, rootID *uuid.UUID) ([]*Category, error)
+	// Move puts the category under parentID, or at the top level without one. It fails
+	// with ErrCategoryCycle when parentID is the category itself or one below it.
+	Move(ctx context.Context, userID, id uuid.UUID, parentID *uuid.UUID) (*Category, error)
 }
 
 type ProgramRepository interface {
@@ -28,11 +36,18 @@ type ProgramRepository interface {
 	Update(ctx context.Context, userID, id uuid.UUID, updates *UpdateProgramRequest) (*Program, error)
 	Delete(ctx context.Context, userID, id uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*Program, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*Program, error)
 	List(ctx context.Context, filter ProgramFilter, pagination PaginationRequest, sort SortRequest) ([]*Program, *PaginationResponse, error)
 	BulkUpdate(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, updates *BulkUpdateProgramsRequest) (int32, error)
 	BulkDelete(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error
 	IncrementViewCount(ctx context.Context, id uuid.UUID) error
 	UpdateEpisodesCount(ctx context.Context, programID uuid.UUID) error
+	// SetCategories makes primaryID the primary category of the program and categoryIDs
+	// the other categories it appears in, replacing the ones it had.
+	SetCategories(ctx context.Context, userID, id, primaryID uuid.UUID, categoryIDs []uuid.UUID) (*Program, error)
+	// Transition changes the status and records the transition together. It fails with
+	// ErrStatusChanged when the program is no longer in the transition's from status.
+	Transition(ctx context.Context, transition *StatusTransition) (*Program, error)
 }
 
 type EpisodeRepository interface {
@@ -40,9 +55,91 @@ type EpisodeRepository interface {
 	Update(ctx context.Context, userID, id uuid.UUID, updates *UpdateEpisodeRequest) (*Episode, error)
 	Delete(ctx context.Context, userID, id uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*Episode, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*Episode, error)
 	List(ctx context.Context, filter EpisodeFilter, pagination PaginationRequest, sort SortRequest) ([]*Episode, *PaginationResponse, error)
 	ListByProgram(ctx context.Context, programID uuid.UUID, pagination PaginationRequest, sort SortRequest) ([]*Episode, *PaginationResponse, error)
+	ListByPrograms(ctx context.Context, programIDs []uuid.UUID, pagination PaginationRequest, sort SortRequest) (map[uuid.UUID]*EpisodePage, error)
 	IncrementViewCount(ctx context.Context, id uuid.UUID) error
+	// Transition changes the status and records the transition together. It fails with
+	// ErrStatusChanged when the episode is no longer in the transition's from status.
+	// scheduledAt is stored when the episode becomes scheduled.
+	Transition(ctx context.Context, transition *StatusTransition, scheduledAt *time.Time) (*Episode, error)
+	// PublishDue publishes up to limit scheduled episodes whose time has come. Each one is
+	// returned to exactly one caller, however many replicas call it at once.
+	PublishDue(ctx context.Context, limit int32) ([]*Episode, error)
+	// Reorder numbers the episodes ids from firstNumber on in the season, in that order,
+	// moving the ones from other seasons of the program into it. Every episode of the
+	// season has to be in ids, or it fails with ErrEpisodeOrderIncomplete. The episodes
+	// are returned by their new number.
+	Reorder(ctx context.Context, userID, seasonID uuid.UUID, ids []uuid.UUID, firstNumber int32) ([]*Episode, error)
+}
+
+type SeasonRepository interface {
+	Create(ctx context.Context, season *Season) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Season, error)
+	// ListByProgram returns every season of a program, by sort order and number.
+	ListByProgram(ctx context.Context, programID uuid.UUID) ([]*Season, error)
+	Update(ctx context.Context, id uuid.UUID, updates *UpdateSeasonRequest) (*Season, error)
+	// Delete removes a season for good. It fails with ErrSeasonHasEpisodes while any
+	// episode, trashed or not, is in the season.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type ChapterRepository interface {
+	Create(ctx context.Context, chapter *Chapter) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Chapter, error)
+	// List returns the chapters of an episode, by start.
+	List(ctx context.Context, episodeID uuid.UUID) ([]*Chapter, error)
+	// ListForUpdate is List that also keeps other transactions from changing the
+	// chapters of the episode until the one ctx runs in ends.
+	ListForUpdate(ctx context.Context, episodeID uuid.UUID) ([]*Chapter, error)
+	// ListByEpisodes returns the chapters of each of episodeIDs, by start.
+	ListByEpisodes(ctx context.Context, episodeIDs []uuid.UUID) (map[uuid.UUID][]*Chapter, error)
+	// Update stores the fields of chapter and fills in UpdatedAt.
+	Update(ctx context.Context, chapter *Chapter) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Replace swaps all chapters of an episode for chapters, in one go.
+	Replace(ctx context.Context, episodeID uuid.UUID, chapters []*Chapter) error
+}
+
+type TranscriptRepository interface {
+	// Upsert stores transcript with its Cues, replacing the transcript of the episode in
+	// the same locale, and fills in ID, CuesCount, CreatedAt and UpdatedAt.
+	Upsert(ctx context.Context, transcript *Transcript) error
+	Get(ctx context.Context, episodeID uuid.UUID, locale string) (*Transcript, error)
+	// Cues returns the cues of a transcript, in order.
+	Cues(ctx context.Context, transcriptID uuid.UUID) ([]*TranscriptCue, error)
+	// List returns the transcripts of an episode, by locale.
+	List(ctx context.Context, episodeID uuid.UUID) ([]*Transcript, error)
+	// ListByEpisodes returns the transcripts of each of episodeIDs, by locale.
+	ListByEpisodes(ctx context.Context, episodeIDs []uuid.UUID) (map[uuid.UUID][]*Transcript, error)
+	Delete(ctx context.Context, episodeID uuid.UUID, locale string) error
+}
+
+type PersonRepository interface {
+	Create(ctx context.Context, person *Person) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Person, error)
+	// List returns people by name. A search query matches anywhere in their name.
+	List(ctx context.Context, filter PersonFilter, pagination PaginationRequest) ([]*Person, *PaginationResponse, error)
+	Update(ctx context.Context, id uuid.UUID, updates *UpdatePersonRequest) (*Person, error)
+	// Delete removes a person for good, along with their credits and translations.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type CreditRepository interface {
+	// Create fails with ErrCreditAlreadyExists when the person already has the role on
+	// the content, and with ErrPersonNotFound when there is no such person.
+	Create(ctx context.Context, credit *Credit) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Credit, error)
+	// ListByContent returns the credits of a program or episode with their Person, by
+	// sort order.
+	ListByContent(ctx context.Context, contentType ContentType, contentID uuid.UUID) ([]*Credit, error)
+	// ListByPerson returns the credits of a person, most recently added first. For a
+	// listener, only credits on published programs and episodes outside the trash that
+	// are available to them, on such a program for episodes, are returned.
+	ListByPerson(ctx context.Context, personID uuid.UUID, listener *Listener, pagination PaginationRequest) ([]*Credit, *PaginationResponse, error)
+	Update(ctx context.Context, id uuid.UUID, updates *UpdateCreditRequest) (*Credit, error)
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 type ImportRepository interface {
@@ -55,8 +152,104 @@ type ImportRepository interface {
 	AddWarning(ctx context.Context, userID, id uuid.UUID, warningMsg string) error
 }
 
+type WorkflowRepository interface {
+	ListTransitions(ctx context.Context, contentType ContentType, contentID uuid.UUID) ([]*StatusTransition, error)
+}
+
+type RevisionRepository interface {
+	// Create stores revision as the next version of its content and fills in ID, Version,
+	// ChangedFields and CreatedAt. When nothing changed since the latest revision it stores
+	// nothing and leaves ID unset.
+	Create(ctx context.Context, revision *Revision) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Revision, error)
+	// List returns the revisions of one program or episode, newest first.
+	List(ctx context.Context, contentType ContentType, contentID uuid.UUID, pagination PaginationRequest) ([]*Revision, *PaginationResponse, error)
+}
+
+type TrashRepository interface {
+	// List returns the trash of userID, most recently deleted first.
+	List(ctx context.Context, userID uuid.UUID, contentType *ContentType, pagination PaginationRequest) ([]*TrashItem, *PaginationResponse, error)
+	// Restore takes content of userID out of the trash. Restoring a program restores the
+	// episodes deleted with it; content whose parent is still in the trash stays there.
+	Restore(ctx context.Context, userID uuid.UUID, contentType ContentType, id uuid.UUID) error
+	// Purge deletes trashed content for good, along with the episodes of purged programs
+	// and the history of everything purged. Categories still used by a program are kept.
+	Purge(ctx context.Context, filter PurgeFilter) (*PurgeResult, error)
+}
+
+type AuditRepository interface {
+	Create(ctx context.Context, event *AuditEvent) error
+	// List returns audit events newest first.
+	List(ctx context.Context, filter AuditEventFilter, pagination PaginationRequest) ([]*AuditEvent, *PaginationResponse, error)
+	// Export calls fn with every event filter matches, newest first, until fn fails.
+	Export(ctx context.Context, filter AuditEventFilter, fn func(*AuditEvent) error) error
+}
+
+type TagRepository interface {
+	// Resolve returns the tag each name stands for, in the order of names, matching their
+	// slugs against tag slugs and aliases. Names no tag stands for get a new tag.
+	Resolve(ctx context.Context, names []string) ([]*Tag, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Tag, error)
+	List(ctx context.Context, filter TagFilter, pagination PaginationRequest, sort SortRequest) ([]*Tag, *PaginationResponse, error)
+	// Autocomplete returns up to limit tags whose name, alias or translation starts with
+	// prefix, the most used first.
+	Autocomplete(ctx context.Context, prefix string, limit int32) ([]*Tag, error)
+	Update(ctx context.Context, id uuid.UUID, updates *UpdateTagRequest) (*Tag, error)
+	// Rename gives a tag a new name, keeping the old one as an alias, and renames it in
+	// the tags of every program and episode.
+	Rename(ctx context.Context, id uuid.UUID, name string) (*Tag, error)
+	// Merge folds the sources into target: their names and aliases become aliases of
+	// target, content tagged with them is tagged with target instead, and they are deleted.
+	Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*Tag, error)
+}
+
+type TranslationRepository interface {
+	// Upsert stores translation, replacing the one in the same locale, and fills in
+	// CreatedAt and UpdatedAt.
+	Upsert(ctx context.Context, translation *Translation) error
+	Delete(ctx context.Context, contentType ContentType, contentID uuid.UUID, locale string) error
+	// List returns the translations of one content, by locale.
+	List(ctx context.Context, contentType ContentType, contentID uuid.UUID) ([]*Translation, error)
+	// ListForContent returns the translations of contentIDs in locales.
+	ListForContent(ctx context.Context, contentType ContentType, contentIDs []uuid.UUID, locales []string) ([]*Translation, error)
+}
+
+// Transactor runs fn in a database transaction that the repositories called with its
+// context take part in. Inside another transaction, fn runs in a savepoint.
+type Transactor interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// ImportNotifier signals subscribers whenever an import changes, whichever replica changed it.
+// Signals are coalesced, so subscribers reload the import rather than count them.
+type ImportNotifier interface {
+	Subscribe(id uuid.UUID) (<-chan struct{}, func())
+}
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription *WebhookSubscription) error
+	UpdateSubscription(ctx context.Context, userID, id uuid.UUID, updates *UpdateWebhookSubscriptionRequest) (*WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, userID, id uuid.UUID) error
+	GetSubscription(ctx context.Context, id uuid.UUID) (*WebhookSubscription, error)
+	GetSubscriptionsByIDs(ctx context.Context, ids []uuid.UUID) ([]*WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, filter WebhookSubscriptionFilter, pagination PaginationRequest) ([]*WebhookSubscription, *PaginationResponse, error)
+	// FindSubscribers returns the active subscriptions of owner that asked for eventType.
+	FindSubscribers(ctx context.Context, owner uuid.UUID, eventType WebhookEventType) ([]*WebhookSubscription, error)
+
+	CreateDeliveries(ctx context.Context, deliveries []*WebhookDelivery) error
+	GetDelivery(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, filter WebhookDeliveryFilter, pagination PaginationRequest) ([]*WebhookDelivery, *PaginationResponse, error)
+	// ClaimDueDeliveries counts an attempt against up to limit pending deliveries that are
+	// due and hides them from other dispatchers for lease.
+	ClaimDueDeliveries(ctx context.Context, limit int32, lease time.Duration) ([]*WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, id uuid.UUID, attempt *WebhookDeliveryAttempt) error
+	// ResetDelivery queues a delivery again with a fresh attempt budget.
+	ResetDelivery(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error)
+}
+
 type S3Client interface {
-	GetObject(ctx context.Context, bucket, key string) (io.Reader, error)
+	// GetObject streams an object, which the caller has to close.
+	GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error)
 	PutObject(ctx context.Context, bucket, key string, file multipart.File) error
 	DeleteObject(ctx context.Context, bucket, key string) error
 	GetObjectSignedURL(ctx context.Context, bucket, key string) (string, error)
@@ -0,0 +1,62 @@
+package biz
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// useCaseMetrics holds the business counters recorded by the CMS use cases.
+type useCaseMetrics struct {
+	programsCreated   metric.Int64Counter
+	programsPublished metric.Int64Counter
+	episodesCreated   metric.Int64Counter
+	episodesPublished metric.Int64Counter
+	importsCreated    metric.Int64Counter
+	importsProcessed  metric.Int64Counter
+}
+
+func newUseCaseMetrics(meter metric.Meter) (*useCaseMetrics, error) {
+	programsCreated, err := meter.Int64Counter("cms_programs_created", metric.WithDescription("Programs created"))
+	if err != nil {
+		return nil, err
+	}
+	programsPublished, err := meter.Int64Counter("cms_programs_published", metric.WithDescription("Programs moved to the published status"))
+	if err != nil {
+		return nil, err
+	}
+	episodesCreated, err := meter.Int64Counter("cms_episodes_created", metric.WithDescription("Episodes created"))
+	if err != nil {
+		return nil, err
+	}
+	episodesPublished, err := meter.Int64Counter("cms_episodes_published", metric.WithDescription("Scheduled episodes published by the scheduler"))
+	if err != nil {
+		return nil, err
+	}
+	importsCreated, err := meter.Int64Counter("cms_imports_created", metric.WithDescription("Imports submitted"))
+	if err != nil {
+		return nil, err
+	}
+	importsProcessed, err := meter.Int64Counter("cms_imports_processed", metric.WithDescription("Imports that reached a final status"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &useCaseMetrics{
+		programsCreated:   programsCreated,
+		programsPublished: programsPublished,
+		episodesCreated:   episodesCreated,
+		episodesPublished: episodesPublished,
+		importsCreated:    importsCreated,
+		importsProcessed:  importsProcessed,
+	}, nil
+}
+
+func (m *useCaseMetrics) importProcessed(ctx context.Context, status ImportStatus) {
+	if status != ImportStatusCompleted && status != ImportStatusFailed {
+		return
+	}
+
+	m.importsProcessed.Add(ctx, 1, metric.WithAttributes(attribute.String("status", string(status))))
+}
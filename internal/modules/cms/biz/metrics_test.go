@@ -0,0 +1,105 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// newMeteredUseCase returns a use case whose metrics reader collects.
+func newMeteredUseCase(t *testing.T) (*UseCase, *sdkmetric.ManualReader) {
+	reader := sdkmetric.NewManualReader()
+	metrics, err := newUseCaseMetrics(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test"))
+	require.NoError(t, err)
+
+	return &UseCase{
+		logger:     log.NewHelper(log.DefaultLogger),
+		metrics:    metrics,
+		importRepo: &fakeImportRepo{imports: map[uuid.UUID]*ImportData{}},
+		tx:         &fakeTransactor{},
+		auditRepo:  &fakeAuditRepo{},
+	}, reader
+}
+
+// counted returns the total of the counter name, by the value of its status attribute.
+func counted(t *testing.T, reader *sdkmetric.ManualReader, name string) map[string]int64 {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	totals := map[string]int64{}
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != name {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				status, _ := point.Attributes.Value("status")
+				totals[status.AsString()] += point.Value
+			}
+		}
+	}
+	return totals
+}
+
+func TestMetrics_CountCommittedChangesOnly(t *testing.T) {
+	uc, reader := newMeteredUseCase(t)
+	ctx := asUser(context.Background(), uuid.New())
+	importData := func(ctx context.Context) error {
+		_, err := uc.ImportData(ctx, &ImportData{SourceType: "rss"})
+		return err
+	}
+
+	// Outside an audited operation, the change is committed by the repository
+	require.NoError(t, importData(ctx))
+	assert.Equal(t, map[string]int64{"": 1}, counted(t, reader, "cms_imports_created"))
+
+	require.NoError(t, uc.Audited(ctx, &AuditEvent{Operation: "ImportData"}, importData))
+	assert.Equal(t, map[string]int64{"": 2}, counted(t, reader, "cms_imports_created"))
+
+	// Failing to store the audit event rolls the import back
+	uc.auditRepo.(*fakeAuditRepo).failSuccess = errors.InternalServer("AUDIT", "audit log unavailable")
+	require.Error(t, uc.Audited(ctx, &AuditEvent{Operation: "ImportData"}, importData))
+	assert.Equal(t, map[string]int64{"": 2}, counted(t, reader, "cms_imports_created"))
+
+	// Operations outside a transaction make their changes in auditedTx
+	outsideTx := func(ctx context.Context) error {
+		return uc.auditedTx(ctx, importData)
+	}
+	require.Error(t, uc.AuditedOutsideTx(ctx, &AuditEvent{Operation: "ImportData"}, outsideTx))
+	assert.Equal(t, map[string]int64{"": 2}, counted(t, reader, "cms_imports_created"))
+
+	uc.auditRepo.(*fakeAuditRepo).failSuccess = nil
+	require.NoError(t, uc.AuditedOutsideTx(ctx, &AuditEvent{Operation: "ImportData"}, outsideTx))
+	assert.Equal(t, map[string]int64{"": 3}, counted(t, reader, "cms_imports_created"))
+}
+
+func TestMetrics_ImportProcessed(t *testing.T) {
+	uc, reader := newMeteredUseCase(t)
+	ctx := asUser(context.Background(), uuid.New())
+	created, err := uc.ImportData(ctx, &ImportData{SourceType: "rss"})
+	require.NoError(t, err)
+
+	update := func(status ImportStatus) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			_, err := uc.UpdateImport(ctx, created.ID, &UpdateImportRequest{Status: &status})
+			return err
+		}
+	}
+
+	// Only final statuses count
+	require.NoError(t, uc.Audited(ctx, &AuditEvent{Operation: "UpdateImport"}, update(ImportStatusProcessing)))
+	require.NoError(t, uc.Audited(ctx, &AuditEvent{Operation: "UpdateImport"}, update(ImportStatusCompleted)))
+	require.NoError(t, update(ImportStatusFailed)(ctx))
+	assert.Equal(t, map[string]int64{string(ImportStatusCompleted): 1, string(ImportStatusFailed): 1}, counted(t, reader, "cms_imports_processed"))
+
+	uc.auditRepo.(*fakeAuditRepo).failSuccess = errors.InternalServer("AUDIT", "audit log unavailable")
+	require.Error(t, uc.Audited(ctx, &AuditEvent{Operation: "UpdateImport"}, update(ImportStatusCompleted)))
+	assert.Equal(t, map[string]int64{string(ImportStatusCompleted): 1, string(ImportStatusFailed): 1}, counted(t, reader, "cms_imports_processed"))
+}
@@ -0,0 +1,107 @@
+package biz
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Person is an entry of the people directory: someone who hosts, guests on or produces
+// programs and episodes. People are shared by all programs; their name and bio can be
+// translated like content, the name as the title.
+type Person struct {
+	ID        uuid.UUID   `json:"id"`
+	Name      string      `json:"name"`
+	Bio       string      `json:"bio"`
+	PhotoURL  string      `json:"photo_url"`
+	Links     PersonLinks `json:"links"`
+	CreatedBy uuid.UUID   `json:"created_by"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// PersonLink is a page about a person elsewhere, such as their website or a profile.
+type PersonLink struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// PersonLinks is stored as a JSON array.
+type PersonLinks []PersonLink
+
+func (l PersonLinks) Value() (driver.Value, error) {
+	if l == nil {
+		l = PersonLinks{}
+	}
+	return json.Marshal(l)
+}
+
+func (l *PersonLinks) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*l = PersonLinks{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into PersonLinks", value)
+	}
+
+	return json.Unmarshal(data, l)
+}
+
+// UpdatePersonRequest contains the fields of a person that can change.
+type UpdatePersonRequest struct {
+	Name     *string      `json:"name,omitempty"`
+	Bio      *string      `json:"bio,omitempty"`
+	PhotoURL *string      `json:"photo_url,omitempty"`
+	Links    *PersonLinks `json:"links,omitempty"`
+}
+
+type PersonFilter struct {
+	SearchQuery *string `json:"search_query"`
+}
+
+// CreditRole is what a person did on a program or episode.
+type CreditRole string
+
+const (
+	CreditRoleHost     CreditRole = "host"
+	CreditRoleGuest    CreditRole = "guest"
+	CreditRoleProducer CreditRole = "producer"
+)
+
+func (r CreditRole) Valid() bool {
+	switch r {
+	case CreditRoleHost, CreditRoleGuest, CreditRoleProducer:
+		return true
+	}
+	return false
+}
+
+// Credit says a person took part in a program or episode in a role. Credits of the same
+// content are listed by SortOrder, then by when they were added. Person is only loaded
+// when listing the credits of content.
+type Credit struct {
+	ID          uuid.UUID   `json:"id"`
+	PersonID    uuid.UUID   `json:"person_id"`
+	ContentType ContentType `json:"content_type"`
+	ContentID   uuid.UUID   `json:"content_id"`
+	Role        CreditRole  `json:"role"`
+	SortOrder   int32       `json:"sort_order"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	Person      *Person     `json:"person,omitempty"`
+}
+
+// UpdateCreditRequest contains the fields of a credit that can change.
+type UpdateCreditRequest struct {
+	Role      *CreditRole `json:"role,omitempty"`
+	SortOrder *int32      `json:"sort_order,omitempty"`
+}
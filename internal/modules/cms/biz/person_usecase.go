@@ -0,0 +1,218 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// CreatePerson adds someone to the people directory.
+func (uc *UseCase) CreatePerson(ctx context.Context, person *Person) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	person.Name = strings.TrimSpace(person.Name)
+	if person.ID == uuid.Nil {
+		person.ID = uuid.Must(uuid.NewV7())
+	}
+	if person.Links == nil {
+		person.Links = PersonLinks{}
+	}
+	person.CreatedBy = userID
+	person.CreatedAt = time.Now()
+	person.UpdatedAt = person.CreatedAt
+
+	if err := uc.personRepo.Create(ctx, person); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypePerson, person.ID, nil, person)
+
+	return nil
+}
+
+func (uc *UseCase) GetPerson(ctx context.Context, id uuid.UUID) (*Person, error) {
+	return uc.personRepo.GetByID(ctx, id)
+}
+
+// ListPeople returns the people directory by name.
+func (uc *UseCase) ListPeople(ctx context.Context, filter PersonFilter, pagination PaginationRequest) ([]*Person, *PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	return uc.personRepo.List(ctx, filter, pagination)
+}
+
+func (uc *UseCase) UpdatePerson(ctx context.Context, id uuid.UUID, updates *UpdatePersonRequest) (*Person, error) {
+	before, err := uc.checkPersonAccess(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if updates.Name != nil {
+		name := strings.TrimSpace(*updates.Name)
+		updates.Name = &name
+	}
+
+	// A new name is carried over to the search vectors of their credits by a trigger
+	person, err := uc.personRepo.Update(ctx, id, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypePerson, id, before, person)
+
+	return person, nil
+}
+
+// DeletePerson removes a person from the directory for good, with their credits.
+func (uc *UseCase) DeletePerson(ctx context.Context, id uuid.UUID) error {
+	person, err := uc.checkPersonAccess(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.personRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypePerson, id, person, nil)
+
+	return nil
+}
+
+// checkPersonAccess lets the one who added a person, and reviewers, change them. The
+// directory is shared, so owning content credited to a person is not enough.
+func (uc *UseCase) checkPersonAccess(ctx context.Context, id uuid.UUID) (*Person, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	person, err := uc.personRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if person.CreatedBy != userID && !uc.workflow.policy.IsReviewer(userID) {
+		return nil, ErrForbidden
+	}
+	return person, nil
+}
+
+// Credit operations
+
+// CreateCredit credits a person on a program or episode of the caller.
+func (uc *UseCase) CreateCredit(ctx context.Context, credit *Credit) error {
+	if !credit.Role.Valid() {
+		return ErrInvalidCreditRole
+	}
+	if err := uc.checkCreditAccess(ctx, credit.ContentType, credit.ContentID); err != nil {
+		return err
+	}
+
+	if credit.ID == uuid.Nil {
+		credit.ID = uuid.Must(uuid.NewV7())
+	}
+	credit.CreatedAt = time.Now()
+	credit.UpdatedAt = credit.CreatedAt
+
+	if err := uc.creditRepo.Create(ctx, credit); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypeCredit, credit.ID, nil, credit)
+
+	return nil
+}
+
+// ListCredits returns the credits of a program or episode with the people credited, by
+// sort order.
+func (uc *UseCase) ListCredits(ctx context.Context, contentType ContentType, contentID uuid.UUID) ([]*Credit, error) {
+	if _, err := uc.creditedContentOwner(ctx, contentType, contentID); err != nil {
+		return nil, err
+	}
+
+	return uc.creditRepo.ListByContent(ctx, contentType, contentID)
+}
+
+// ListCreditsByPerson returns what a person is credited on, most recently added first.
+func (uc *UseCase) ListCreditsByPerson(ctx context.Context, personID uuid.UUID, pagination PaginationRequest) ([]*Credit, *PaginationResponse, error) {
+	if _, err := uc.personRepo.GetByID(ctx, personID); err != nil {
+		return nil, nil, err
+	}
+	pagination.SetDefaults()
+
+	return uc.creditRepo.ListByPerson(ctx, personID, nil, pagination)
+}
+
+func (uc *UseCase) UpdateCredit(ctx context.Context, id uuid.UUID, updates *UpdateCreditRequest) (*Credit, error) {
+	if updates.Role != nil && !updates.Role.Valid() {
+		return nil, ErrInvalidCreditRole
+	}
+
+	before, err := uc.creditRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkCreditAccess(ctx, before.ContentType, before.ContentID); err != nil {
+		return nil, err
+	}
+
+	credit, err := uc.creditRepo.Update(ctx, id, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeCredit, id, before, credit)
+
+	return credit, nil
+}
+
+func (uc *UseCase) DeleteCredit(ctx context.Context, id uuid.UUID) error {
+	credit, err := uc.creditRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.checkCreditAccess(ctx, credit.ContentType, credit.ContentID); err != nil {
+		return err
+	}
+
+	if err := uc.creditRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypeCredit, id, credit, nil)
+
+	return nil
+}
+
+// checkCreditAccess lets only the owner of a program or episode change its credits, like
+// they are the only one who can update it.
+func (uc *UseCase) checkCreditAccess(ctx context.Context, contentType ContentType, contentID uuid.UUID) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	owner, err := uc.creditedContentOwner(ctx, contentType, contentID)
+	if err != nil {
+		return err
+	}
+	if owner != userID {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// creditedContentOwner is contentOwner for the content credits are on: programs and
+// episodes only.
+func (uc *UseCase) creditedContentOwner(ctx context.Context, contentType ContentType, contentID uuid.UUID) (uuid.UUID, error) {
+	if contentType != ContentTypeProgram && contentType != ContentTypeEpisode {
+		return uuid.Nil, ErrInvalidContentType
+	}
+	return uc.contentOwner(ctx, contentType, contentID)
+}
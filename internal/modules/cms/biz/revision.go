@@ -0,0 +1,119 @@
+package biz
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Revision is an immutable snapshot of a program or episode, stored after every change.
+// Versions count up from 1 per content. ChangedFields lists the fields that differ from
+// the previous revision; it is empty for the first one.
+type Revision struct {
+	ID            uuid.UUID   `json:"id"`
+	ContentType   ContentType `json:"content_type"`
+	ContentID     uuid.UUID   `json:"content_id"`
+	Version       int32       `json:"version"`
+	Snapshot      Snapshot    `json:"snapshot"`
+	ChangedFields []string    `json:"changed_fields"`
+	AuthorID      *uuid.UUID  `json:"author_id,omitempty"`
+	RestoredFrom  *int32      `json:"restored_from,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+// FieldChange is one field that differs between two revisions. From or To is nil when
+// the field is missing on that side.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// Snapshot is the JSON form of a program or episode.
+type Snapshot map[string]any
+
+func (s Snapshot) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return json.Marshal(s)
+}
+
+func (s *Snapshot) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case map[string]any:
+		*s = v
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into Snapshot", value)
+	}
+
+	return json.Unmarshal(data, s)
+}
+
+// volatileFields change without anyone editing the content, so they are left out of
+// snapshots and never make a revision on their own.
+var volatileFields = []string{"updated_at", "updated_by", "view_count", "episodes_count"}
+
+func snapshotOf(content any) (Snapshot, error) {
+	data, err := json.Marshal(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	for _, field := range volatileFields {
+		delete(snapshot, field)
+	}
+
+	return snapshot, nil
+}
+
+// DiffSnapshots compares two snapshots field by field, in field name order.
+func DiffSnapshots(from, to Snapshot) []FieldChange {
+	fields := make([]string, 0, len(from)+len(to))
+	for field := range from {
+		fields = append(fields, field)
+	}
+	for field := range to {
+		if _, ok := from[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+
+	var changes []FieldChange
+	for _, field := range fields {
+		if !reflect.DeepEqual(from[field], to[field]) {
+			changes = append(changes, FieldChange{Field: field, From: from[field], To: to[field]})
+		}
+	}
+
+	return changes
+}
+
+// ChangedFields names the fields DiffSnapshots would report.
+func ChangedFields(from, to Snapshot) []string {
+	changes := DiffSnapshots(from, to)
+	fields := make([]string, 0, len(changes))
+	for _, change := range changes {
+		fields = append(fields, change.Field)
+	}
+	return fields
+}
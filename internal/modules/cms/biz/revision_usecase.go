@@ -0,0 +1,220 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// RevisionRestore is the content a restore changed and the revision it stored.
+// Exactly one of Program and Episode is set.
+type RevisionRestore struct {
+	Program  *Program
+	Episode  *Episode
+	Revision *Revision
+}
+
+// ListRevisions returns the revisions of a program or episode, newest first. Only the
+// owner and reviewers may see them.
+func (uc *UseCase) ListRevisions(ctx context.Context, contentType ContentType, id uuid.UUID, pagination PaginationRequest) ([]*Revision, *PaginationResponse, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, nil, ErrUnauthorized
+	}
+
+	if err := uc.checkHistoryAccess(ctx, contentType, id, userID); err != nil {
+		return nil, nil, err
+	}
+
+	pagination.SetDefaults()
+
+	return uc.revisionRepo.List(ctx, contentType, id, pagination)
+}
+
+func (uc *UseCase) GetRevision(ctx context.Context, id uuid.UUID) (*Revision, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	revision, err := uc.revisionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkHistoryAccess(ctx, revision.ContentType, revision.ContentID, userID); err != nil {
+		return nil, err
+	}
+
+	return revision, nil
+}
+
+// DiffRevisions compares two revisions of the same content field by field. The order
+// is up to the caller, so diffing a newer revision against an older one shows the
+// changes undone.
+func (uc *UseCase) DiffRevisions(ctx context.Context, fromID, toID uuid.UUID) (*Revision, *Revision, []FieldChange, error) {
+	from, err := uc.GetRevision(ctx, fromID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	to, err := uc.revisionRepo.GetByID(ctx, toID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if to.ContentType != from.ContentType || to.ContentID != from.ContentID {
+		return nil, nil, nil, ErrRevisionMismatch
+	}
+
+	return from, to, DiffSnapshots(from.Snapshot, to.Snapshot), nil
+}
+
+// RestoreRevision puts the editable fields of a revision back, storing the result as a
+// new revision. The status is left alone, since it only changes through the workflow.
+func (uc *UseCase) RestoreRevision(ctx context.Context, id uuid.UUID) (*RevisionRestore, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	revision, err := uc.revisionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(revision.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode revision snapshot: %w", err)
+	}
+
+	switch revision.ContentType {
+	case ContentTypeProgram:
+		current, err := uc.programRepo.GetByID(ctx, revision.ContentID)
+		if err != nil {
+			return nil, err
+		}
+		if current.CreatedBy != userID {
+			return nil, ErrForbidden
+		}
+
+		var old Program
+		if err := json.Unmarshal(data, &old); err != nil {
+			return nil, fmt.Errorf("failed to decode program revision: %w", err)
+		}
+		// Tags may have been renamed or merged since
+		if old.Tags, err = uc.normalizeTags(ctx, old.Tags); err != nil {
+			return nil, err
+		}
+		program, err := uc.programRepo.Update(ctx, userID, revision.ContentID, &UpdateProgramRequest{
+			Title:        &old.Title,
+			Description:  &old.Description,
+			CategoryID:   &old.CategoryID,
+			ThumbnailURL: &old.ThumbnailURL,
+			Tags:         &old.Tags,
+			Metadata:     &old.Metadata,
+			SourceURL:    old.SourceURL,
+			IsFeatured:   &old.IsFeatured,
+			Rating:       &old.Rating,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Revisions from before programs could be in several categories have none
+		if len(old.CategoryIDs) > 0 {
+			if program, err = uc.programRepo.SetCategories(ctx, userID, revision.ContentID, old.CategoryID, old.CategoryIDs); err != nil {
+				return nil, err
+			}
+		}
+
+		uc.auditChange(ctx, EntityTypeProgram, program.ID, current, program)
+		restored := uc.recordProgramRevision(ctx, program, userID, &revision.Version)
+		uc.publishProgramUpdated(ctx, program, nil)
+		return &RevisionRestore{Program: program, Revision: restored}, nil
+
+	case ContentTypeEpisode:
+		current, err := uc.episodeRepo.GetByID(ctx, revision.ContentID)
+		if err != nil {
+			return nil, err
+		}
+		if current.CreatedBy != userID {
+			return nil, ErrForbidden
+		}
+
+		var old Episode
+		if err := json.Unmarshal(data, &old); err != nil {
+			return nil, fmt.Errorf("failed to decode episode revision: %w", err)
+		}
+		if old.Tags, err = uc.normalizeTags(ctx, old.Tags); err != nil {
+			return nil, err
+		}
+		if old.DurationSecs != current.DurationSecs {
+			if err := uc.checkEpisodeChapters(ctx, current.ID, old.DurationSecs); err != nil {
+				return nil, err
+			}
+		}
+		episode, err := uc.episodeRepo.Update(ctx, userID, revision.ContentID, &UpdateEpisodeRequest{
+			Title:         &old.Title,
+			Description:   &old.Description,
+			DurationSecs:  &old.DurationSecs,
+			EpisodeNumber: &old.EpisodeNumber,
+			SeasonNumber:  &old.SeasonNumber,
+			MediaURL:      &old.MediaURL,
+			ThumbnailURL:  &old.ThumbnailURL,
+			Tags:          &old.Tags,
+			Metadata:      &old.Metadata,
+			Rating:        &old.Rating,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		uc.auditChange(ctx, EntityTypeEpisode, episode.ID, current, episode)
+		restored := uc.recordEpisodeRevision(ctx, episode, userID, &revision.Version)
+		uc.publishEpisodeUpdated(ctx, episode, nil)
+		return &RevisionRestore{Episode: episode, Revision: restored}, nil
+
+	default:
+		return nil, ErrInvalidContentType
+	}
+}
+
+// recordProgramRevision stores program as its next revision. The change is already
+// saved by then, so a failure is only logged. author is uuid.Nil for system changes.
+func (uc *UseCase) recordProgramRevision(ctx context.Context, program *Program, author uuid.UUID, restoredFrom *int32) *Revision {
+	return uc.recordRevision(ctx, ContentTypeProgram, program.ID, program, author, restoredFrom)
+}
+
+func (uc *UseCase) recordEpisodeRevision(ctx context.Context, episode *Episode, author uuid.UUID, restoredFrom *int32) *Revision {
+	return uc.recordRevision(ctx, ContentTypeEpisode, episode.ID, episode, author, restoredFrom)
+}
+
+func (uc *UseCase) recordRevision(ctx context.Context, contentType ContentType, id uuid.UUID, content any, author uuid.UUID, restoredFrom *int32) *Revision {
+	snapshot, err := snapshotOf(content)
+	if err != nil {
+		uc.logger.WithContext(ctx).Errorf("Failed to snapshot %s %s: %v", contentType, id, err)
+		return nil
+	}
+
+	revision := &Revision{
+		ContentType:  contentType,
+		ContentID:    id,
+		Snapshot:     snapshot,
+		RestoredFrom: restoredFrom,
+	}
+	if author != uuid.Nil {
+		revision.AuthorID = &author
+	}
+
+	if err := uc.revisionRepo.Create(ctx, revision); err != nil {
+		uc.logger.WithContext(ctx).Errorf("Failed to store revision of %s %s: %v", contentType, id, err)
+		return nil
+	}
+	if revision.ID == uuid.Nil {
+		return nil
+	}
+
+	return revision
+}
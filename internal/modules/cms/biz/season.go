@@ -0,0 +1,58 @@
+package biz
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Season groups the episodes of a program that share a season number. Seasons are listed
+// by SortOrder, then by number. A season is added whenever an episode is stored with a
+// season number its program has no season for yet. EpisodesCount leaves out the trash.
+type Season struct {
+	ID             uuid.UUID  `json:"id"`
+	ProgramID      uuid.UUID  `json:"program_id"`
+	SeasonNumber   int32      `json:"season_number"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description"`
+	ThumbnailURL   string     `json:"thumbnail_url"`
+	ReleaseStartAt *time.Time `json:"release_start_at,omitempty"`
+	ReleaseEndAt   *time.Time `json:"release_end_at,omitempty"`
+	SortOrder      int32      `json:"sort_order"`
+	EpisodesCount  int32      `json:"episodes_count"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// UpdateSeasonRequest contains the fields of a season that can change. A new
+// SeasonNumber is carried over to the episodes of the season.
+type UpdateSeasonRequest struct {
+	SeasonNumber   *int32     `json:"season_number,omitempty"`
+	Title          *string    `json:"title,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	ThumbnailURL   *string    `json:"thumbnail_url,omitempty"`
+	ReleaseStartAt *time.Time `json:"release_start_at,omitempty"`
+	ReleaseEndAt   *time.Time `json:"release_end_at,omitempty"`
+	SortOrder      *int32     `json:"sort_order,omitempty"`
+}
+
+// SeasonEpisodes is a season together with its episodes, by episode number.
+type SeasonEpisodes struct {
+	Season   *Season
+	Episodes []*Episode
+}
+
+// EpisodePosition is where an episode sits in its program.
+type EpisodePosition struct {
+	EpisodeID     uuid.UUID `json:"episode_id"`
+	SeasonNumber  int32     `json:"season_number"`
+	EpisodeNumber int32     `json:"episode_number"`
+}
+
+// checkReleaseWindow fails when a season would stop being released before it starts.
+func checkReleaseWindow(start, end *time.Time) error {
+	if start != nil && end != nil && end.Before(*start) {
+		return ErrInvalidReleaseWindow
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// CreateSeason adds a season to a program of the caller.
+func (uc *UseCase) CreateSeason(ctx context.Context, season *Season) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	if err := uc.checkSeasonAccess(ctx, season.ProgramID, userID); err != nil {
+		return err
+	}
+	if err := checkReleaseWindow(season.ReleaseStartAt, season.ReleaseEndAt); err != nil {
+		return err
+	}
+
+	if season.ID == uuid.Nil {
+		season.ID = uuid.Must(uuid.NewV7())
+	}
+	season.CreatedAt = time.Now()
+	season.UpdatedAt = season.CreatedAt
+
+	if err := uc.seasonRepo.Create(ctx, season); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypeSeason, season.ID, nil, season)
+
+	return nil
+}
+
+func (uc *UseCase) GetSeason(ctx context.Context, id uuid.UUID) (*Season, error) {
+	return uc.seasonRepo.GetByID(ctx, id)
+}
+
+// ListSeasons returns the seasons of a program, by sort order and number.
+func (uc *UseCase) ListSeasons(ctx context.Context, programID uuid.UUID) ([]*Season, error) {
+	if _, err := uc.programRepo.GetByID(ctx, programID); err != nil {
+		return nil, err
+	}
+
+	return uc.seasonRepo.ListByProgram(ctx, programID)
+}
+
+func (uc *UseCase) UpdateSeason(ctx context.Context, id uuid.UUID, updates *UpdateSeasonRequest) (*Season, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	before, err := uc.seasonRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkSeasonAccess(ctx, before.ProgramID, userID); err != nil {
+		return nil, err
+	}
+
+	start, end := before.ReleaseStartAt, before.ReleaseEndAt
+	if updates.ReleaseStartAt != nil {
+		start = updates.ReleaseStartAt
+	}
+	if updates.ReleaseEndAt != nil {
+		end = updates.ReleaseEndAt
+	}
+	if err := checkReleaseWindow(start, end); err != nil {
+		return nil, err
+	}
+
+	season, err := uc.seasonRepo.Update(ctx, id, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeSeason, id, before, season)
+
+	return season, nil
+}
+
+// DeleteSeason removes an empty season for good.
+func (uc *UseCase) DeleteSeason(ctx context.Context, id uuid.UUID) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	season, err := uc.seasonRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.checkSeasonAccess(ctx, season.ProgramID, userID); err != nil {
+		return err
+	}
+
+	if err := uc.seasonRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypeSeason, id, season, nil)
+
+	return nil
+}
+
+// ListSeasonEpisodes lists the episodes of a season, by episode number unless sort says
+// otherwise.
+func (uc *UseCase) ListSeasonEpisodes(ctx context.Context, id uuid.UUID, filter EpisodeFilter, pagination PaginationRequest, sort SortRequest) ([]*Episode, *PaginationResponse, error) {
+	if _, err := uc.seasonRepo.GetByID(ctx, id); err != nil {
+		return nil, nil, err
+	}
+
+	filter.SeasonID = &id
+	if sort.SortBy == "" {
+		sort.SortBy = "episode_number"
+	}
+
+	return uc.ListEpisodes(ctx, filter, pagination, sort)
+}
+
+// checkSeasonAccess lets only the owner of a program change its seasons.
+func (uc *UseCase) checkSeasonAccess(ctx context.Context, programID, userID uuid.UUID) error {
+	program, err := uc.programRepo.GetByID(ctx, programID)
+	if err != nil {
+		return err
+	}
+	if program.CreatedBy != userID {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// ReorderEpisodes numbers the episodes ids from firstNumber on in a season, in the given
+// order, in one go. ids must list every episode of the season; episodes of other seasons
+// of the program in it move to the season. The episodes are returned by their number.
+func (uc *UseCase) ReorderEpisodes(ctx context.Context, seasonID uuid.UUID, ids []uuid.UUID, firstNumber int32) ([]*Episode, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+	if firstNumber <= 0 {
+		firstNumber = 1
+	}
+
+	season, err := uc.seasonRepo.GetByID(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkSeasonAccess(ctx, season.ProgramID, userID); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return nil, ErrEpisodeOrderIncomplete
+		}
+		seen[id] = true
+	}
+
+	current, err := uc.episodeRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(current) != len(ids) {
+		return nil, ErrEpisodeNotFound
+	}
+	before := make(map[uuid.UUID]EpisodePosition, len(current))
+	for _, episode := range current {
+		if episode.ProgramID != season.ProgramID {
+			return nil, ErrEpisodeNotInProgram
+		}
+		if episode.CreatedBy != userID {
+			return nil, ErrForbidden
+		}
+		before[episode.ID] = positionOf(episode)
+	}
+
+	episodes, err := uc.episodeRepo.Reorder(ctx, userID, seasonID, ids, firstNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	from := make([]EpisodePosition, 0, len(episodes))
+	to := make([]EpisodePosition, 0, len(episodes))
+	for _, episode := range episodes {
+		old := before[episode.ID]
+		if old.SeasonNumber == episode.SeasonNumber && old.EpisodeNumber == episode.EpisodeNumber {
+			continue
+		}
+		from = append(from, old)
+		to = append(to, positionOf(episode))
+
+		uc.recordEpisodeRevision(ctx, episode, userID, nil)
+		uc.publishEpisodeUpdated(ctx, episode, nil)
+	}
+
+	uc.auditChange(ctx, EntityTypeSeason, seasonID, map[string]any{"episodes": from}, map[string]any{"episodes": to})
+
+	return episodes, nil
+}
+
+func positionOf(episode *Episode) EpisodePosition {
+	return EpisodePosition{
+		EpisodeID:     episode.ID,
+		SeasonNumber:  episode.SeasonNumber,
+		EpisodeNumber: episode.EpisodeNumber,
+	}
+}
@@ -0,0 +1,60 @@
+package biz
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// MaxTagAutocomplete caps how many suggestions tag autocomplete returns.
+const MaxTagAutocomplete = 20
+
+// Tag is an entry of the tag taxonomy. Programs and episodes are tagged with its Name;
+// any tag whose slug equals Slug or one of Aliases stands for it. The counts only
+// include content that is not in the trash.
+type Tag struct {
+	ID           uuid.UUID         `json:"id"`
+	Name         string            `json:"name"`
+	Slug         string            `json:"slug"`
+	Translations map[string]string `json:"translations"`
+	Aliases      []string          `json:"aliases"`
+	ProgramCount int32             `json:"program_count"`
+	EpisodeCount int32             `json:"episode_count"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// UpdateTagRequest changes the translations and aliases of a tag. Aliases are stored as
+// slugs, and the slugs of the translations are added to them.
+type UpdateTagRequest struct {
+	Translations *map[string]string `json:"translations,omitempty"`
+	Aliases      *[]string          `json:"aliases,omitempty"`
+}
+
+type TagFilter struct {
+	SearchQuery *string `json:"search_query"`
+}
+
+// TagSlug is the lookup key of a tag: its letters and digits in lower case, with every
+// run of other characters turned into a single dash. Combining marks such as Arabic
+// diacritics are dropped, so spellings with and without them match.
+func TagSlug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			dash = false
+			b.WriteRune(r)
+		default:
+			dash = true
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,195 @@
+package biz
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// ListTags returns the tag taxonomy with usage counts, by name or, sorted by "usage",
+// the most used first.
+func (uc *UseCase) ListTags(ctx context.Context, filter TagFilter, pagination PaginationRequest, sort SortRequest) ([]*Tag, *PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	return uc.tagRepo.List(ctx, filter, pagination, sort)
+}
+
+// AutocompleteTags suggests up to limit tags for what an editor has typed so far.
+func (uc *UseCase) AutocompleteTags(ctx context.Context, prefix string, limit int32) ([]*Tag, error) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return []*Tag{}, nil
+	}
+	if limit <= 0 || limit > MaxTagAutocomplete {
+		limit = MaxTagAutocomplete
+	}
+
+	return uc.tagRepo.Autocomplete(ctx, prefix, limit)
+}
+
+// UpdateTag changes the translations and aliases of a tag.
+func (uc *UseCase) UpdateTag(ctx context.Context, id uuid.UUID, updates *UpdateTagRequest) (*Tag, error) {
+	if err := uc.checkTaxonomyAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	before, err := uc.tagRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	translations := before.Translations
+	if updates.Translations != nil {
+		translations = *updates.Translations
+	}
+	aliases := before.Aliases
+	if updates.Aliases != nil {
+		aliases = *updates.Aliases
+	}
+	if aliases, err = tagAliases(before.Slug, aliases, translations); err != nil {
+		return nil, err
+	}
+
+	tag, err := uc.tagRepo.Update(ctx, id, &UpdateTagRequest{
+		Translations: &translations,
+		Aliases:      &aliases,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeTag, id, before, tag)
+
+	return tag, nil
+}
+
+// RenameTag gives a tag a new canonical name, everywhere it is used.
+func (uc *UseCase) RenameTag(ctx context.Context, id uuid.UUID, name string) (*Tag, error) {
+	if err := uc.checkTaxonomyAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if TagSlug(name) == "" {
+		return nil, ErrInvalidTag
+	}
+
+	before, err := uc.tagRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := uc.tagRepo.Rename(ctx, id, name)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeTag, id, before, tag)
+
+	return tag, nil
+}
+
+// MergeTags folds duplicate tags into target, retagging all content that uses them.
+func (uc *UseCase) MergeTags(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*Tag, error) {
+	if err := uc.checkTaxonomyAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	sources := make([]uuid.UUID, 0, len(sourceIDs))
+	seen := make(map[uuid.UUID]bool, len(sourceIDs))
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, ErrTagMergeIntoSelf
+		}
+		if !seen[id] {
+			seen[id] = true
+			sources = append(sources, id)
+		}
+	}
+
+	tag, err := uc.tagRepo.Merge(ctx, targetID, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeTag, targetID, map[string]any{"tag_ids": sources}, tag)
+
+	return tag, nil
+}
+
+// checkTaxonomyAccess lets reviewers change the taxonomy, since renames and merges
+// retag the content of every user.
+func (uc *UseCase) checkTaxonomyAccess(ctx context.Context) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+	if !uc.workflow.policy.IsReviewer(userID) {
+		return ErrTaxonomyReviewerRequired
+	}
+	return nil
+}
+
+// normalizeTags replaces tags by the names of the taxonomy tags they stand for, adding
+// tags the taxonomy does not know yet. Tags standing for the same one are kept once.
+func (uc *UseCase) normalizeTags(ctx context.Context, tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return tags, nil
+	}
+
+	for _, tag := range tags {
+		if TagSlug(tag) == "" {
+			return nil, ErrInvalidTag
+		}
+	}
+
+	resolved, err := uc.tagRepo.Resolve(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(resolved))
+	seen := make(map[uuid.UUID]bool, len(resolved))
+	for _, tag := range resolved {
+		if !seen[tag.ID] {
+			seen[tag.ID] = true
+			names = append(names, tag.Name)
+		}
+	}
+
+	return names, nil
+}
+
+// tagAliases turns aliases and translations into the lookup keys of the tag with slug.
+func tagAliases(slug string, aliases []string, translations map[string]string) ([]string, error) {
+	keys := make([]string, 0, len(aliases)+len(translations))
+	seen := map[string]bool{slug: true}
+	add := func(name string) error {
+		key := TagSlug(name)
+		if key == "" {
+			return ErrInvalidTag
+		}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+		return nil
+	}
+
+	for _, alias := range aliases {
+		if err := add(alias); err != nil {
+			return nil, err
+		}
+	}
+	for _, locale := range slices.Sorted(maps.Keys(translations)) {
+		if err := add(translations[locale]); err != nil {
+			return nil, err
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,99 @@
+package biz
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TranscriptFormat is a file format transcripts are uploaded and served in.
+type TranscriptFormat string
+
+const (
+	TranscriptFormatWebVTT TranscriptFormat = "vtt"
+	TranscriptFormatSRT    TranscriptFormat = "srt"
+	TranscriptFormatText   TranscriptFormat = "txt"
+	// TranscriptFormatJSON is the Podcasting 2.0 JSON transcript format
+	TranscriptFormatJSON TranscriptFormat = "json"
+)
+
+// ContentType is the media type a transcript in the format is served with.
+func (f TranscriptFormat) ContentType() string {
+	switch f {
+	case TranscriptFormatWebVTT:
+		return "text/vtt; charset=utf-8"
+	case TranscriptFormatSRT:
+		return "application/x-subrip; charset=utf-8"
+	case TranscriptFormatJSON:
+		return "application/json"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// Timed reports whether transcripts in the format say when each cue is spoken. Only
+// timed transcripts can be turned into captions.
+func (f TranscriptFormat) Timed() bool {
+	return f != TranscriptFormatText
+}
+
+// Transcript is what is said in an episode, in one locale. The uploaded file is kept as
+// is at FileURL; its cues are stored too, to convert it to other formats and to search
+// it.
+type Transcript struct {
+	ID        uuid.UUID        `json:"id"`
+	EpisodeID uuid.UUID        `json:"episode_id"`
+	Locale    string           `json:"locale"`
+	Format    TranscriptFormat `json:"format"`
+	FileURL   string           `json:"file_url"`
+	CuesCount int32            `json:"cues_count"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+	// Cues are only loaded to store or convert the transcript
+	Cues []*TranscriptCue `json:"-"`
+}
+
+// TranscriptCue is a part of a transcript. Plain text transcripts have no timing, so
+// their cues are paragraphs starting and ending at 0.
+type TranscriptCue struct {
+	StartSeconds float64 `json:"start_seconds"`
+	EndSeconds   float64 `json:"end_seconds"`
+	Speaker      string  `json:"speaker,omitempty"`
+	Text         string  `json:"text"`
+}
+
+// TranscriptMatch is the first cue of an episode transcript that matched a search.
+// StartSeconds and EndSeconds are nil for plain text transcripts.
+type TranscriptMatch struct {
+	EpisodeID    uuid.UUID `json:"episode_id"`
+	Locale       string    `json:"locale"`
+	StartSeconds *float64  `json:"start_seconds,omitempty"`
+	EndSeconds   *float64  `json:"end_seconds,omitempty"`
+	Text         string    `json:"text"`
+}
+
+// DetectTranscriptFormat guesses the format of a transcript from its first lines.
+// Anything that is not WebVTT, SRT or JSON is taken for plain text.
+func DetectTranscriptFormat(data []byte) TranscriptFormat {
+	data = bytes.TrimLeft(bytes.TrimPrefix(data, []byte("\ufeff")), " \t\r\n")
+
+	switch {
+	case bytes.HasPrefix(data, []byte("WEBVTT")):
+		return TranscriptFormatWebVTT
+	case bytes.HasPrefix(data, []byte("{")):
+		return TranscriptFormatJSON
+	}
+
+	lines := strings.SplitN(normalizeNewlines(string(data)), "\n", 3)
+	if len(lines) >= 2 && isCueIndex(lines[0]) && strings.Contains(lines[1], "-->") {
+		return TranscriptFormatSRT
+	}
+	return TranscriptFormatText
+}
+
+// transcriptKey is where the file of a transcript is stored.
+func transcriptKey(episodeID uuid.UUID, locale string, format TranscriptFormat) string {
+	return "episodes/" + episodeID.String() + "/transcripts/" + locale + "." + string(format)
+}
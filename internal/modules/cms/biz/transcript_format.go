@@ -0,0 +1,404 @@
+package biz
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// PodcastTranscriptVersion is the version of the Podcasting 2.0 JSON transcript format
+// transcripts are written in.
+const PodcastTranscriptVersion = "1.0.0"
+
+var (
+	// cueTag matches the markup of a cue, such as <i>, <c.yellow> or <00:00:01.000>
+	cueTag = regexp.MustCompile(`<[^>]*>`)
+	// voiceTag matches a WebVTT voice span opening a cue, capturing the speaker
+	voiceTag = regexp.MustCompile(`^<v(?:\.[^\s>]*)?\s+([^>]*)>`)
+)
+
+// ParseTranscript reads the cues of a transcript in format. Malformed transcripts fail
+// with ErrInvalidTranscript, naming the line at fault where there is one.
+func ParseTranscript(data []byte, format TranscriptFormat) ([]*TranscriptCue, error) {
+	if !utf8.Valid(data) {
+		return nil, ErrInvalidTranscript
+	}
+	text := strings.TrimPrefix(normalizeNewlines(string(data)), "\ufeff")
+
+	var cues []*TranscriptCue
+	var err error
+	switch format {
+	case TranscriptFormatWebVTT:
+		cues, err = parseWebVTT(text)
+	case TranscriptFormatSRT:
+		cues, err = parseSRT(text)
+	case TranscriptFormatText:
+		cues = parsePlainText(text)
+	case TranscriptFormatJSON:
+		cues, err = parsePodcastTranscript([]byte(text))
+	default:
+		return nil, ErrInvalidTranscriptFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(cues) == 0 {
+		return nil, ErrInvalidTranscript
+	}
+
+	return cues, nil
+}
+
+// RenderTranscript writes cues in format. Cues of a plain text transcript, which have no
+// timing, can only be written as plain text.
+func RenderTranscript(cues []*TranscriptCue, timed bool, format TranscriptFormat) ([]byte, error) {
+	if format.Timed() && !timed {
+		return nil, ErrTranscriptNotTimed
+	}
+
+	switch format {
+	case TranscriptFormatWebVTT:
+		return renderWebVTT(cues), nil
+	case TranscriptFormatSRT:
+		return renderSRT(cues), nil
+	case TranscriptFormatText:
+		return renderPlainText(cues), nil
+	case TranscriptFormatJSON:
+		return renderPodcastTranscript(cues)
+	default:
+		return nil, ErrInvalidTranscriptFormat
+	}
+}
+
+// cueBlock is a run of non-blank lines, starting at line (counting from 1).
+type cueBlock struct {
+	line  int
+	lines []string
+}
+
+func cueBlocks(text string) []cueBlock {
+	var blocks []cueBlock
+	var current *cueBlock
+	for i, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			current = nil
+			continue
+		}
+		if current == nil {
+			blocks = append(blocks, cueBlock{line: i + 1})
+			current = &blocks[len(blocks)-1]
+		}
+		current.lines = append(current.lines, line)
+	}
+	return blocks
+}
+
+func parseWebVTT(text string) ([]*TranscriptCue, error) {
+	blocks := cueBlocks(text)
+	if len(blocks) == 0 {
+		return nil, invalidTranscriptAt(1)
+	}
+	if header := blocks[0].lines[0]; header != "WEBVTT" && !strings.HasPrefix(header, "WEBVTT ") && !strings.HasPrefix(header, "WEBVTT\t") {
+		return nil, invalidTranscriptAt(blocks[0].line)
+	}
+
+	var cues []*TranscriptCue
+	for _, block := range blocks[1:] {
+		first := block.lines[0]
+		if first == "NOTE" || strings.HasPrefix(first, "NOTE ") || first == "STYLE" || first == "REGION" {
+			continue
+		}
+
+		// The timing line may follow a cue identifier
+		timing, line := 0, block.line
+		if !strings.Contains(first, "-->") {
+			timing, line = 1, block.line+1
+		}
+		if timing >= len(block.lines) {
+			return nil, invalidTranscriptAt(line)
+		}
+
+		start, end, err := parseCueTiming(block.lines[timing], '.')
+		if err != nil {
+			return nil, invalidTranscriptAt(line)
+		}
+		cue := newCue(start, end, block.lines[timing+1:])
+		if cue == nil {
+			continue
+		}
+		if err := checkCue(cue, cues); err != nil {
+			return nil, invalidTranscriptAt(line)
+		}
+		cues = append(cues, cue)
+	}
+
+	return cues, nil
+}
+
+func parseSRT(text string) ([]*TranscriptCue, error) {
+	var cues []*TranscriptCue
+	for _, block := range cueBlocks(text) {
+		if len(block.lines) < 2 || !isCueIndex(block.lines[0]) {
+			return nil, invalidTranscriptAt(block.line)
+		}
+
+		// Some SRT files use the WebVTT decimal point
+		start, end, err := parseCueTiming(block.lines[1], ',')
+		if err != nil {
+			start, end, err = parseCueTiming(block.lines[1], '.')
+		}
+		if err != nil {
+			return nil, invalidTranscriptAt(block.line + 1)
+		}
+		cue := newCue(start, end, block.lines[2:])
+		if cue == nil {
+			continue
+		}
+		if err := checkCue(cue, cues); err != nil {
+			return nil, invalidTranscriptAt(block.line + 1)
+		}
+		cues = append(cues, cue)
+	}
+
+	return cues, nil
+}
+
+// parsePlainText turns each paragraph of text into a cue.
+func parsePlainText(text string) []*TranscriptCue {
+	var cues []*TranscriptCue
+	for _, block := range cueBlocks(text) {
+		lines := make([]string, len(block.lines))
+		for i, line := range block.lines {
+			lines[i] = strings.TrimSpace(line)
+		}
+		cues = append(cues, &TranscriptCue{Text: strings.Join(lines, "\n")})
+	}
+	return cues
+}
+
+// podcastTranscript is the Podcasting 2.0 JSON transcript document,
+// https://github.com/Podcastindex-org/podcast-namespace/blob/main/docs/examples/transcripts/transcripts.md.
+type podcastTranscript struct {
+	Version  string           `json:"version"`
+	Segments []podcastSegment `json:"segments"`
+}
+
+type podcastSegment struct {
+	Speaker   string   `json:"speaker,omitempty"`
+	StartTime *float64 `json:"startTime"`
+	EndTime   *float64 `json:"endTime"`
+	Body      *string  `json:"body"`
+}
+
+func parsePodcastTranscript(data []byte) ([]*TranscriptCue, error) {
+	var doc podcastTranscript
+	if err := json.Unmarshal(data, &doc); err != nil || doc.Version == "" || doc.Segments == nil {
+		return nil, ErrInvalidTranscript
+	}
+
+	cues := make([]*TranscriptCue, 0, len(doc.Segments))
+	for i, segment := range doc.Segments {
+		if segment.StartTime == nil || segment.EndTime == nil || segment.Body == nil {
+			return nil, ErrInvalidTranscript.WithMetadata(map[string]string{"segment": strconv.Itoa(i)})
+		}
+		cue := &TranscriptCue{
+			StartSeconds: *segment.StartTime,
+			EndSeconds:   *segment.EndTime,
+			Speaker:      strings.TrimSpace(segment.Speaker),
+			Text:         strings.TrimSpace(*segment.Body),
+		}
+		if err := checkCue(cue, cues); err != nil {
+			return nil, ErrInvalidTranscript.WithMetadata(map[string]string{"segment": strconv.Itoa(i)})
+		}
+		cues = append(cues, cue)
+	}
+
+	return cues, nil
+}
+
+// newCue makes a cue of the text lines of a caption, taking the speaker from a WebVTT
+// voice span and dropping the other markup. Captions without text make no cue.
+func newCue(start, end float64, lines []string) *TranscriptCue {
+	text := strings.TrimSpace(strings.Join(lines, "\n"))
+	cue := &TranscriptCue{StartSeconds: start, EndSeconds: end}
+	if m := voiceTag.FindStringSubmatch(text); m != nil {
+		cue.Speaker = strings.TrimSpace(html.UnescapeString(m[1]))
+	}
+	cue.Text = strings.TrimSpace(html.UnescapeString(cueTag.ReplaceAllString(text, "")))
+	if cue.Text == "" {
+		return nil
+	}
+	return cue
+}
+
+// checkCue fails when a cue ends before it starts, or starts before the cues before it.
+func checkCue(cue *TranscriptCue, before []*TranscriptCue) error {
+	if math.IsNaN(cue.StartSeconds) || math.IsInf(cue.StartSeconds, 0) || math.IsNaN(cue.EndSeconds) || math.IsInf(cue.EndSeconds, 0) {
+		return ErrInvalidTranscript
+	}
+	if cue.StartSeconds < 0 || cue.EndSeconds < cue.StartSeconds {
+		return ErrInvalidTranscript
+	}
+	if len(before) > 0 && cue.StartSeconds < before[len(before)-1].StartSeconds {
+		return ErrInvalidTranscript
+	}
+	return nil
+}
+
+// parseCueTiming reads a "start --> end" line, ignoring WebVTT cue settings after end.
+func parseCueTiming(line string, decimal byte) (float64, float64, error) {
+	from, to, ok := strings.Cut(line, "-->")
+	if !ok {
+		return 0, 0, fmt.Errorf("missing -->")
+	}
+	fields := strings.Fields(to)
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("missing end")
+	}
+
+	start, err := parseCueTimestamp(strings.TrimSpace(from), decimal)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseCueTimestamp(fields[0], decimal)
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// parseCueTimestamp reads "[hh:]mm:ss<decimal>ttt" into seconds.
+func parseCueTimestamp(s string, decimal byte) (float64, error) {
+	clock, millis, ok := strings.Cut(s, string(decimal))
+	if !ok || len(millis) != 3 {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+	ms, err := strconv.Atoi(millis)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+	seconds := 0
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || (i > 0 && (len(part) != 2 || n > 59)) {
+			return 0, fmt.Errorf("invalid timestamp %q", s)
+		}
+		seconds = seconds*60 + n
+	}
+
+	return float64(seconds) + float64(ms)/1000, nil
+}
+
+func formatCueTimestamp(seconds float64, decimal byte) string {
+	ms := int64(math.Round(seconds * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d", ms/3600000, ms/60000%60, ms/1000%60, decimal, ms%1000)
+}
+
+// cueLines are the lines of the text of a cue, leaving out blank ones, which would end
+// the cue in WebVTT and SRT.
+func cueLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func renderWebVTT(cues []*TranscriptCue) []byte {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+	for _, cue := range cues {
+		fmt.Fprintf(&b, "\n%s --> %s\n", formatCueTimestamp(cue.StartSeconds, '.'), formatCueTimestamp(cue.EndSeconds, '.'))
+		if cue.Speaker != "" {
+			fmt.Fprintf(&b, "<v %s>", escapeCueText(cue.Speaker))
+		}
+		b.WriteString(escapeCueText(strings.Join(cueLines(cue.Text), "\n")))
+		b.WriteString("\n")
+	}
+	return []byte(b.String())
+}
+
+func renderSRT(cues []*TranscriptCue) []byte {
+	var b strings.Builder
+	for i, cue := range cues {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%d\n%s --> %s\n", i+1, formatCueTimestamp(cue.StartSeconds, ','), formatCueTimestamp(cue.EndSeconds, ','))
+		b.WriteString(speakerPrefix(cue))
+		b.WriteString(strings.Join(cueLines(cue.Text), "\n"))
+		b.WriteString("\n")
+	}
+	return []byte(b.String())
+}
+
+// renderPlainText writes each cue as a paragraph.
+func renderPlainText(cues []*TranscriptCue) []byte {
+	paragraphs := make([]string, 0, len(cues))
+	for _, cue := range cues {
+		paragraphs = append(paragraphs, speakerPrefix(cue)+strings.Join(cueLines(cue.Text), "\n"))
+	}
+	return []byte(strings.Join(paragraphs, "\n\n") + "\n")
+}
+
+func renderPodcastTranscript(cues []*TranscriptCue) ([]byte, error) {
+	doc := podcastTranscript{
+		Version:  PodcastTranscriptVersion,
+		Segments: make([]podcastSegment, 0, len(cues)),
+	}
+	for _, cue := range cues {
+		doc.Segments = append(doc.Segments, podcastSegment{
+			Speaker:   cue.Speaker,
+			StartTime: &cue.StartSeconds,
+			EndTime:   &cue.EndSeconds,
+			Body:      &cue.Text,
+		})
+	}
+
+	return json.Marshal(doc)
+}
+
+// speakerPrefix names the speaker of a cue in formats without a place for it.
+func speakerPrefix(cue *TranscriptCue) string {
+	if cue.Speaker == "" {
+		return ""
+	}
+	return cue.Speaker + ": "
+}
+
+func escapeCueText(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+func invalidTranscriptAt(line int) error {
+	return ErrInvalidTranscript.WithMetadata(map[string]string{"line": strconv.Itoa(line)})
+}
+
+func isCueIndex(line string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return false
+	}
+	for _, r := range line {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeNewlines(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\r", "\n")
+}
@@ -0,0 +1,289 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTranscript(t *testing.T) {
+	tests := []struct {
+		name   string
+		format TranscriptFormat
+		data   string
+		want   []*TranscriptCue
+	}{
+		{
+			name:   "WebVTT",
+			format: TranscriptFormatWebVTT,
+			data:   "WEBVTT\n\n00:00:01.000 --> 00:00:02.500\nHello\n\n00:01:02.000 --> 01:00:03.250 align:start\n<i>there</i> &amp; back\n",
+			want: []*TranscriptCue{
+				{StartSeconds: 1, EndSeconds: 2.5, Text: "Hello"},
+				{StartSeconds: 62, EndSeconds: 3603.25, Text: "there & back"},
+			},
+		},
+		{
+			name:   "WebVTTHeaderText",
+			format: TranscriptFormatWebVTT,
+			data:   "\ufeffWEBVTT - Episode 1\r\nKind: captions\r\n\r\n00:01.000 --> 00:02.000\r\nHello\r\n",
+			want:   []*TranscriptCue{{StartSeconds: 1, EndSeconds: 2, Text: "Hello"}},
+		},
+		{
+			name:   "WebVTTNoteStyleAndRegion",
+			format: TranscriptFormatWebVTT,
+			data:   "WEBVTT\n\nNOTE written by hand\n--> not a cue\n\nSTYLE\n::cue { color: yellow }\n\nREGION\nid:left\n\n00:00:01.000 --> 00:00:02.000\nHello\n",
+			want:   []*TranscriptCue{{StartSeconds: 1, EndSeconds: 2, Text: "Hello"}},
+		},
+		{
+			name:   "WebVTTCueIdentifiers",
+			format: TranscriptFormatWebVTT,
+			data:   "WEBVTT\n\nintro\n00:00:01.000 --> 00:00:02.000\nHello\n\n2\n00:00:03.000 --> 00:00:04.000\nBye\n",
+			want: []*TranscriptCue{
+				{StartSeconds: 1, EndSeconds: 2, Text: "Hello"},
+				{StartSeconds: 3, EndSeconds: 4, Text: "Bye"},
+			},
+		},
+		{
+			name:   "WebVTTVoiceSpans",
+			format: TranscriptFormatWebVTT,
+			data:   "WEBVTT\n\n00:00:01.000 --> 00:00:02.000\n<v Fahad Al-Harbi>Welcome</v>\n\n00:00:03.000 --> 00:00:04.000\n<v.loud Sara &amp; Co>Thanks\n",
+			want: []*TranscriptCue{
+				{StartSeconds: 1, EndSeconds: 2, Speaker: "Fahad Al-Harbi", Text: "Welcome"},
+				{StartSeconds: 3, EndSeconds: 4, Speaker: "Sara & Co", Text: "Thanks"},
+			},
+		},
+		{
+			name:   "WebVTTEmptyCuesDropped",
+			format: TranscriptFormatWebVTT,
+			data:   "WEBVTT\n\n00:00:01.000 --> 00:00:02.000\n<i></i>\n\n00:00:03.000 --> 00:00:04.000\nHello\n",
+			want:   []*TranscriptCue{{StartSeconds: 3, EndSeconds: 4, Text: "Hello"}},
+		},
+		{
+			name:   "SRT",
+			format: TranscriptFormatSRT,
+			data:   "1\n00:00:01,000 --> 00:00:02,500\nHello\nworld\n\n2\n00:00:03,000 --> 00:00:04,000\nBye\n",
+			want: []*TranscriptCue{
+				{StartSeconds: 1, EndSeconds: 2.5, Text: "Hello\nworld"},
+				{StartSeconds: 3, EndSeconds: 4, Text: "Bye"},
+			},
+		},
+		{
+			name:   "SRTDecimalPoint",
+			format: TranscriptFormatSRT,
+			data:   "1\r\n00:00:01.000 --> 00:00:02.000\r\nHello\r\n",
+			want:   []*TranscriptCue{{StartSeconds: 1, EndSeconds: 2, Text: "Hello"}},
+		},
+		{
+			name:   "PlainText",
+			format: TranscriptFormatText,
+			data:   "  First paragraph\nstill first\n\n\nSecond  \n",
+			want:   []*TranscriptCue{{Text: "First paragraph\nstill first"}, {Text: "Second"}},
+		},
+		{
+			name:   "JSON",
+			format: TranscriptFormatJSON,
+			data:   `{"version":"1.0.0","segments":[{"speaker":" Host ","startTime":0.5,"endTime":1.25,"body":" Hi "},{"startTime":1.25,"endTime":2,"body":"Bye"}]}`,
+			want: []*TranscriptCue{
+				{StartSeconds: 0.5, EndSeconds: 1.25, Speaker: "Host", Text: "Hi"},
+				{StartSeconds: 1.25, EndSeconds: 2, Text: "Bye"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cues, err := ParseTranscript([]byte(tt.data), tt.format)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, cues)
+		})
+	}
+}
+
+func TestParseTranscript_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   TranscriptFormat
+		data     string
+		metadata map[string]string
+	}{
+		{
+			name:     "WebVTTMissingHeader",
+			format:   TranscriptFormatWebVTT,
+			data:     "\n\n00:00:01.000 --> 00:00:02.000\nHello\n",
+			metadata: map[string]string{"line": "3"},
+		},
+		{
+			name:     "WebVTTHeaderPrefix",
+			format:   TranscriptFormatWebVTT,
+			data:     "WEBVTTX\n\n00:00:01.000 --> 00:00:02.000\nHello\n",
+			metadata: map[string]string{"line": "1"},
+		},
+		{
+			name:     "WebVTTCommaDecimal",
+			format:   TranscriptFormatWebVTT,
+			data:     "WEBVTT\n\n00:00:01,000 --> 00:00:02,000\nHello\n",
+			metadata: map[string]string{"line": "3"},
+		},
+		{
+			name:     "WebVTTIdentifierWithoutTiming",
+			format:   TranscriptFormatWebVTT,
+			data:     "WEBVTT\n\nintro\n",
+			metadata: map[string]string{"line": "4"},
+		},
+		{
+			name:     "WebVTTBadTimingAfterIdentifier",
+			format:   TranscriptFormatWebVTT,
+			data:     "WEBVTT\n\n00:00:01.000 --> 00:00:02.000\nHello\n\nintro\n00:00:61.000 --> 00:01:02.000\nBye\n",
+			metadata: map[string]string{"line": "7"},
+		},
+		{
+			name:     "WebVTTOutOfOrder",
+			format:   TranscriptFormatWebVTT,
+			data:     "WEBVTT\n\n00:00:05.000 --> 00:00:06.000\nLater\n\n00:00:01.000 --> 00:00:02.000\nEarlier\n",
+			metadata: map[string]string{"line": "6"},
+		},
+		{
+			name:     "WebVTTEndsBeforeStart",
+			format:   TranscriptFormatWebVTT,
+			data:     "WEBVTT\n\n00:00:02.000 --> 00:00:01.000\nBackwards\n",
+			metadata: map[string]string{"line": "3"},
+		},
+		{
+			name:     "WebVTTOnlyHeader",
+			format:   TranscriptFormatWebVTT,
+			data:     "WEBVTT\n",
+			metadata: map[string]string{},
+		},
+		{
+			name:     "SRTMissingIndex",
+			format:   TranscriptFormatSRT,
+			data:     "1\n00:00:01,000 --> 00:00:02,000\nHello\n\n00:00:03,000 --> 00:00:04,000\nBye\n",
+			metadata: map[string]string{"line": "5"},
+		},
+		{
+			name:     "SRTShortMillis",
+			format:   TranscriptFormatSRT,
+			data:     "1\n00:00:01,00 --> 00:00:02,000\nHello\n",
+			metadata: map[string]string{"line": "2"},
+		},
+		{
+			name:     "SRTOutOfOrder",
+			format:   TranscriptFormatSRT,
+			data:     "1\n00:00:05,000 --> 00:00:06,000\nLater\n\n2\n00:00:01,000 --> 00:00:02,000\nEarlier\n",
+			metadata: map[string]string{"line": "6"},
+		},
+		{
+			name:     "JSONOutOfOrder",
+			format:   TranscriptFormatJSON,
+			data:     `{"version":"1.0.0","segments":[{"startTime":5,"endTime":6,"body":"Later"},{"startTime":1,"endTime":2,"body":"Earlier"}]}`,
+			metadata: map[string]string{"segment": "1"},
+		},
+		{
+			name:     "JSONMissingTime",
+			format:   TranscriptFormatJSON,
+			data:     `{"version":"1.0.0","segments":[{"endTime":2,"body":"Hello"}]}`,
+			metadata: map[string]string{"segment": "0"},
+		},
+		{
+			name:     "JSONNaN",
+			format:   TranscriptFormatJSON,
+			data:     `{"version":"1.0.0","segments":[{"startTime":NaN,"endTime":2,"body":"Hello"}]}`,
+			metadata: map[string]string{},
+		},
+		{
+			name:     "JSONInfinity",
+			format:   TranscriptFormatJSON,
+			data:     `{"version":"1.0.0","segments":[{"startTime":0,"endTime":1e400,"body":"Hello"}]}`,
+			metadata: map[string]string{},
+		},
+		{
+			name:     "JSONMissingVersion",
+			format:   TranscriptFormatJSON,
+			data:     `{"segments":[]}`,
+			metadata: map[string]string{},
+		},
+		{
+			name:     "InvalidUTF8",
+			format:   TranscriptFormatText,
+			data:     "caf\xe9",
+			metadata: map[string]string{},
+		},
+		{
+			name:     "Empty",
+			format:   TranscriptFormatText,
+			data:     "\n\n",
+			metadata: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseTranscript([]byte(tt.data), tt.format)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTranscript), "got %v", err)
+
+			metadata := errors.FromError(err).Metadata
+			if metadata == nil {
+				metadata = map[string]string{}
+			}
+			assert.Equal(t, tt.metadata, metadata)
+		})
+	}
+}
+
+func TestParseTranscript_UnknownFormat(t *testing.T) {
+	_, err := ParseTranscript([]byte("Hello"), TranscriptFormat("docx"))
+	assert.True(t, errors.Is(err, ErrInvalidTranscriptFormat))
+}
+
+func TestRenderTranscript_RoundTrip(t *testing.T) {
+	cues := []*TranscriptCue{
+		{StartSeconds: 0, EndSeconds: 1.5, Speaker: "Host", Text: "Is 1 < 2 & 3?"},
+		{StartSeconds: 1.5, EndSeconds: 3725.042, Text: "Two\nlines"},
+	}
+
+	tests := []struct {
+		format TranscriptFormat
+		want   []*TranscriptCue
+	}{
+		{format: TranscriptFormatWebVTT, want: cues},
+		{format: TranscriptFormatJSON, want: cues},
+		{
+			// SRT has no place for the speaker, which is kept in the text
+			format: TranscriptFormatSRT,
+			want: []*TranscriptCue{
+				{StartSeconds: 0, EndSeconds: 1.5, Text: "Host: Is 1 < 2 & 3?"},
+				{StartSeconds: 1.5, EndSeconds: 3725.042, Text: "Two\nlines"},
+			},
+		},
+		{
+			format: TranscriptFormatText,
+			want:   []*TranscriptCue{{Text: "Host: Is 1 < 2 & 3?"}, {Text: "Two\nlines"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			data, err := RenderTranscript(cues, true, tt.format)
+			require.NoError(t, err)
+			assert.Equal(t, tt.format, DetectTranscriptFormat(data))
+
+			parsed, err := ParseTranscript(data, tt.format)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, parsed)
+		})
+	}
+}
+
+func TestRenderTranscript_Untimed(t *testing.T) {
+	cues := []*TranscriptCue{{Text: "Hello"}}
+
+	_, err := RenderTranscript(cues, false, TranscriptFormatWebVTT)
+	assert.True(t, errors.Is(err, ErrTranscriptNotTimed))
+
+	data, err := RenderTranscript(cues, false, TranscriptFormatText)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello\n", string(data))
+}
@@ -0,0 +1,223 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// bytesFile lets content held in memory be stored like an uploaded file.
+type bytesFile struct {
+	*bytes.Reader
+}
+
+func (bytesFile) Close() error { return nil }
+
+// UploadTranscript stores the transcript of an episode of the caller in locale,
+// replacing the one already there. Without a format, it is detected from the content.
+// The file is uploaded before the transaction that stores the transcript, and the file
+// it replaces is deleted after it.
+func (uc *UseCase) UploadTranscript(ctx context.Context, episodeID uuid.UUID, locale string, format TranscriptFormat, content []byte) (*Transcript, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	locale, err := CanonicalLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	episode, err := uc.episodeRepo.GetByID(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	if episode.CreatedBy != userID {
+		return nil, ErrForbidden
+	}
+
+	if format == "" {
+		format = DetectTranscriptFormat(content)
+	}
+	cues, err := ParseTranscript(content, format)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := uc.transcriptRepo.Get(ctx, episodeID, locale)
+	if err != nil && !errors.Is(err, ErrTranscriptNotFound) {
+		return nil, err
+	}
+
+	key := transcriptKey(episodeID, locale, format)
+	if err := uc.s3.PutObject(ctx, "thmanyah", key, bytesFile{bytes.NewReader(content)}); err != nil {
+		return nil, err
+	}
+
+	transcript := &Transcript{
+		ID:        uuid.Must(uuid.NewV7()),
+		EpisodeID: episodeID,
+		Locale:    locale,
+		Format:    format,
+		FileURL:   "/thmanyah/" + key,
+		Cues:      cues,
+	}
+	replaced := before != nil && before.FileURL == transcript.FileURL
+	err = uc.auditedTx(ctx, func(ctx context.Context) error {
+		if err := uc.transcriptRepo.Upsert(ctx, transcript); err != nil {
+			return err
+		}
+
+		uc.auditChange(ctx, EntityTypeTranscript, transcript.ID, before, transcript)
+		return nil
+	})
+	if err != nil {
+		// Nothing points at a new file
+		if !replaced {
+			uc.deleteObjects(ctx, []string{transcript.FileURL})
+		}
+		return nil, err
+	}
+
+	// A transcript in another format leaves its file behind under another name
+	if before != nil && !replaced {
+		uc.deleteObjects(ctx, []string{before.FileURL})
+	}
+
+	return transcript, nil
+}
+
+// ListTranscripts returns the transcripts of an episode, by locale.
+func (uc *UseCase) ListTranscripts(ctx context.Context, episodeID uuid.UUID) ([]*Transcript, error) {
+	if _, err := uc.episodeRepo.GetByID(ctx, episodeID); err != nil {
+		return nil, err
+	}
+
+	return uc.transcriptRepo.List(ctx, episodeID)
+}
+
+func (uc *UseCase) DeleteTranscript(ctx context.Context, episodeID uuid.UUID, locale string) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	locale, err := CanonicalLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	episode, err := uc.episodeRepo.GetByID(ctx, episodeID)
+	if err != nil {
+		return err
+	}
+	if episode.CreatedBy != userID {
+		return ErrForbidden
+	}
+
+	transcript, err := uc.transcriptRepo.Get(ctx, episodeID, locale)
+	if err != nil {
+		return err
+	}
+	err = uc.auditedTx(ctx, func(ctx context.Context) error {
+		if err := uc.transcriptRepo.Delete(ctx, episodeID, locale); err != nil {
+			return err
+		}
+
+		uc.auditChange(ctx, EntityTypeTranscript, transcript.ID, transcript, nil)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.deleteObjects(ctx, []string{transcript.FileURL})
+
+	return nil
+}
+
+// RenderedTranscript is a transcript file, ready to be served.
+type RenderedTranscript struct {
+	Format  TranscriptFormat
+	Content []byte
+}
+
+// RenderTranscript returns the transcript of an episode in locale as format: the
+// uploaded file when it is in that format already, or else one converted from its cues.
+// Without a format, the uploaded file is returned. For a listener, transcripts of
+// episodes that are not published, or that their availability keeps from them, are not
+// found.
+func (uc *UseCase) RenderTranscript(ctx context.Context, episodeID uuid.UUID, locale string, format TranscriptFormat, listener *Listener) (*RenderedTranscript, error) {
+	locale, err := CanonicalLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	episode, err := uc.episodeRepo.GetByID(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	if listener != nil {
+		if err := uc.checkListenerCanSee(ctx, episode, listener); err != nil {
+			return nil, err
+		}
+	}
+
+	transcript, err := uc.transcriptRepo.Get(ctx, episodeID, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "" || format == transcript.Format {
+		key, _ := strings.CutPrefix(transcript.FileURL, "/thmanyah/")
+		file, err := uc.s3.GetObject(ctx, "thmanyah", key)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		if err != nil {
+			return nil, err
+		}
+		return &RenderedTranscript{Format: transcript.Format, Content: content}, nil
+	}
+
+	cues, err := uc.transcriptRepo.Cues(ctx, transcript.ID)
+	if err != nil {
+		return nil, err
+	}
+	content, err := RenderTranscript(cues, transcript.Format.Timed(), format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RenderedTranscript{Format: format, Content: content}, nil
+}
+
+// checkListenerCanSee reports an episode as not found unless it is published and
+// available to listener, and its program is published and available to them too.
+func (uc *UseCase) checkListenerCanSee(ctx context.Context, episode *Episode, listener *Listener) error {
+	now := time.Now()
+	if episode.Status != EpisodeStatusPublished || !episode.Availability.Available(now, listener.Country) {
+		return ErrEpisodeNotFound
+	}
+
+	program, err := uc.programRepo.GetByID(ctx, episode.ProgramID)
+	if errors.Is(err, ErrProgramNotFound) {
+		return ErrEpisodeNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if program.Status != ProgramStatusPublished || !program.Availability.Available(now, listener.Country) {
+		return ErrEpisodeNotFound
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTranscript_Listener(t *testing.T) {
+	programs := map[uuid.UUID]*Program{}
+	episodes := map[uuid.UUID]*Episode{}
+	addEpisode := func(programStatus ProgramStatus, availability Availability) uuid.UUID {
+		program := &Program{ID: uuid.New(), Status: programStatus, Availability: availability}
+		programs[program.ID] = program
+		episode := &Episode{ID: uuid.New(), ProgramID: program.ID, Status: EpisodeStatusPublished}
+		episodes[episode.ID] = episode
+		return episode.ID
+	}
+
+	published := addEpisode(ProgramStatusPublished, Availability{})
+	draft := addEpisode(ProgramStatusDraft, Availability{})
+	archived := addEpisode(ProgramStatusArchived, Availability{})
+	blocked := addEpisode(ProgramStatusPublished, Availability{BlockedCountries: []string{"SA"}})
+	unpublished := uuid.New()
+	episodes[unpublished] = &Episode{ID: unpublished, ProgramID: uuid.New(), Status: EpisodeStatusDraft}
+	orphan := uuid.New()
+	episodes[orphan] = &Episode{ID: orphan, ProgramID: uuid.New(), Status: EpisodeStatusPublished}
+
+	uc := &UseCase{
+		programRepo: &fakeProgramRepo{programs: programs},
+		episodeRepo: &fakeEpisodeRepo{episodes: episodes},
+		transcriptRepo: &fakeTranscriptRepo{
+			transcript: &Transcript{ID: uuid.New(), Locale: "en", Format: TranscriptFormatWebVTT},
+			cues:       []*TranscriptCue{{StartSeconds: 0, EndSeconds: 1, Text: "Hello"}},
+		},
+	}
+	listener := &Listener{Country: "SA"}
+	ctx := context.Background()
+
+	rendered, err := uc.RenderTranscript(ctx, published, "en", TranscriptFormatText, listener)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello\n", string(rendered.Content))
+
+	for name, id := range map[string]uuid.UUID{
+		"DraftProgram":    draft,
+		"ArchivedProgram": archived,
+		"BlockedProgram":  blocked,
+		"DraftEpisode":    unpublished,
+		"MissingProgram":  orphan,
+	} {
+		_, err := uc.RenderTranscript(ctx, id, "en", TranscriptFormatText, listener)
+		assert.True(t, errors.Is(err, ErrEpisodeNotFound), "%s: got %v", name, err)
+	}
+
+	// Editors see transcripts whatever the status
+	_, err = uc.RenderTranscript(ctx, draft, "en", TranscriptFormatText, nil)
+	assert.NoError(t, err)
+}
+
+func TestRenderTranscript_UploadedFile(t *testing.T) {
+	episode := &Episode{ID: uuid.New(), ProgramID: uuid.New(), Status: EpisodeStatusPublished}
+	file := "WEBVTT\n\n00:00:00.000 --> 00:00:01.000\nHello\n"
+	s3 := &fakeS3{objects: map[string][]byte{"thmanyah/transcripts/en.vtt": []byte(file)}}
+
+	uc := &UseCase{
+		programRepo: &fakeProgramRepo{programs: map[uuid.UUID]*Program{
+			episode.ProgramID: {ID: episode.ProgramID, Status: ProgramStatusPublished},
+		}},
+		episodeRepo: &fakeEpisodeRepo{episodes: map[uuid.UUID]*Episode{episode.ID: episode}},
+		transcriptRepo: &fakeTranscriptRepo{transcript: &Transcript{
+			ID:      uuid.New(),
+			Locale:  "en",
+			Format:  TranscriptFormatWebVTT,
+			FileURL: "/thmanyah/transcripts/en.vtt",
+		}},
+		s3: s3,
+	}
+
+	rendered, err := uc.RenderTranscript(context.Background(), episode.ID, "en", "", &Listener{})
+	require.NoError(t, err)
+	assert.Equal(t, TranscriptFormatWebVTT, rendered.Format)
+	assert.Equal(t, file, string(rendered.Content))
+	assert.Zero(t, s3.open, "the object must be closed")
+}
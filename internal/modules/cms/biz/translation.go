@@ -0,0 +1,70 @@
+package biz
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/language"
+)
+
+// Translation is the title and description of a category, program, episode or person in
+// another locale. For categories and people, Title is the name; for people, Description
+// is the bio.
+type Translation struct {
+	ContentType ContentType `json:"content_type"`
+	ContentID   uuid.UUID   `json:"content_id"`
+	Locale      string      `json:"locale"`
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// CanonicalLocale turns a BCP 47 tag such as "AR-sa" into the form translations are
+// stored under, "ar-SA".
+func CanonicalLocale(locale string) (string, error) {
+	tag, err := language.Parse(strings.TrimSpace(locale))
+	if err != nil || tag == language.Und {
+		return "", ErrInvalidLocale
+	}
+	return tag.String(), nil
+}
+
+// PickTranslations chooses, for each content, the translation in the first of locales it
+// has one in. Content without a translation in any of them is left out, so it keeps its
+// original title and description.
+func PickTranslations(translations []*Translation, locales []string) map[uuid.UUID]*Translation {
+	rank := make(map[string]int, len(locales))
+	for i, locale := range locales {
+		if _, ok := rank[locale]; !ok {
+			rank[locale] = i
+		}
+	}
+
+	picked := make(map[uuid.UUID]*Translation, len(translations))
+	for _, t := range translations {
+		r, ok := rank[t.Locale]
+		if !ok {
+			continue
+		}
+		if current, ok := picked[t.ContentID]; ok && rank[current.Locale] <= r {
+			continue
+		}
+		picked[t.ContentID] = t
+	}
+
+	return picked
+}
+
+// Localize returns title and description in translation t. An empty translated
+// description falls back to the original one.
+func (t *Translation) Localize(title, description string) (string, string) {
+	if t == nil {
+		return title, description
+	}
+	if t.Description != "" {
+		description = t.Description
+	}
+	return t.Title, description
+}
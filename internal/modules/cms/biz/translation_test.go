@@ -0,0 +1,91 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickTranslations(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	translation := func(id uuid.UUID, locale string) *Translation {
+		return &Translation{ContentID: id, Locale: locale, Title: locale}
+	}
+	translations := []*Translation{
+		translation(first, "en"),
+		translation(first, "ar-SA"),
+		translation(first, "ar"),
+		translation(second, "fr"),
+		translation(second, "ar"),
+	}
+
+	tests := []struct {
+		name    string
+		locales []string
+		want    map[uuid.UUID]string
+	}{
+		{name: "NoLocales", want: map[uuid.UUID]string{}},
+		{name: "FirstLocale", locales: []string{"ar", "en"}, want: map[uuid.UUID]string{first: "ar", second: "ar"}},
+		{name: "RegionFirst", locales: []string{"ar-SA", "ar"}, want: map[uuid.UUID]string{first: "ar-SA", second: "ar"}},
+		{name: "FallsBack", locales: []string{"en", "fr"}, want: map[uuid.UUID]string{first: "en", second: "fr"}},
+		{name: "FallsBackLater", locales: []string{"de", "fr", "ar"}, want: map[uuid.UUID]string{first: "ar", second: "fr"}},
+		{name: "NotTranslated", locales: []string{"fr"}, want: map[uuid.UUID]string{second: "fr"}},
+		{name: "NoneTranslated", locales: []string{"de"}, want: map[uuid.UUID]string{}},
+		// A locale asked for twice keeps its first place
+		{name: "RepeatedLocale", locales: []string{"en", "fr", "en"}, want: map[uuid.UUID]string{first: "en", second: "fr"}},
+		// Locales match exactly; a region is not a fallback for its language
+		{name: "ExactMatch", locales: []string{"ar-EG"}, want: map[uuid.UUID]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			picked := PickTranslations(translations, tt.locales)
+			got := make(map[uuid.UUID]string, len(picked))
+			for id, translation := range picked {
+				assert.Equal(t, id, translation.ContentID)
+				got[id] = translation.Locale
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTranslation_Localize(t *testing.T) {
+	var missing *Translation
+	title, description := missing.Localize("Title", "Description")
+	assert.Equal(t, "Title", title)
+	assert.Equal(t, "Description", description)
+
+	title, description = (&Translation{Title: "العنوان"}).Localize("Title", "Description")
+	assert.Equal(t, "العنوان", title)
+	assert.Equal(t, "Description", description, "an empty translated description keeps the original")
+
+	title, description = (&Translation{Title: "العنوان", Description: "الوصف"}).Localize("Title", "Description")
+	assert.Equal(t, "العنوان", title)
+	assert.Equal(t, "الوصف", description)
+}
+
+func TestCanonicalLocale(t *testing.T) {
+	tests := []struct {
+		locale  string
+		want    string
+		wantErr bool
+	}{
+		{locale: "ar", want: "ar"},
+		{locale: "AR-sa", want: "ar-SA"},
+		{locale: " en-us ", want: "en-US"},
+		{locale: "", wantErr: true},
+		{locale: "not a locale", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			got, err := CanonicalLocale(tt.locale)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidLocale)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
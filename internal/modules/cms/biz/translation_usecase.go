@@ -0,0 +1,141 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// ListTranslations returns the translations of a category, program, episode or person.
+func (uc *UseCase) ListTranslations(ctx context.Context, contentType ContentType, id uuid.UUID) ([]*Translation, error) {
+	if _, err := uc.contentOwner(ctx, contentType, id); err != nil {
+		return nil, err
+	}
+
+	return uc.translationRepo.List(ctx, contentType, id)
+}
+
+// SetTranslation adds or replaces the translation of content in translation.Locale. Only
+// the owner of the content can translate it.
+func (uc *UseCase) SetTranslation(ctx context.Context, translation *Translation) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	locale, err := CanonicalLocale(translation.Locale)
+	if err != nil {
+		return err
+	}
+	translation.Locale = locale
+
+	if err := uc.checkTranslationAccess(ctx, translation.ContentType, translation.ContentID, userID); err != nil {
+		return err
+	}
+
+	before, err := uc.findTranslation(ctx, translation.ContentType, translation.ContentID, locale)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.translationRepo.Upsert(ctx, translation); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityType(translation.ContentType), translation.ContentID, before, translation)
+
+	return nil
+}
+
+// DeleteTranslation removes the translation of content in locale.
+func (uc *UseCase) DeleteTranslation(ctx context.Context, contentType ContentType, id uuid.UUID, locale string) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	locale, err := CanonicalLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.checkTranslationAccess(ctx, contentType, id, userID); err != nil {
+		return err
+	}
+
+	before, err := uc.findTranslation(ctx, contentType, id, locale)
+	if err != nil {
+		return err
+	}
+	if before == nil {
+		return ErrTranslationNotFound
+	}
+
+	if err := uc.translationRepo.Delete(ctx, contentType, id, locale); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityType(contentType), id, before, nil)
+
+	return nil
+}
+
+// checkTranslationAccess lets only the owner of content translate it, like they are the
+// only one who can update it.
+func (uc *UseCase) checkTranslationAccess(ctx context.Context, contentType ContentType, id, userID uuid.UUID) error {
+	owner, err := uc.contentOwner(ctx, contentType, id)
+	if err != nil {
+		return err
+	}
+	if owner != userID {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// contentOwner returns who created a category, program, episode or person.
+func (uc *UseCase) contentOwner(ctx context.Context, contentType ContentType, id uuid.UUID) (uuid.UUID, error) {
+	switch contentType {
+	case ContentTypeCategory:
+		category, err := uc.categoryRepo.GetByID(ctx, id)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return category.CreatedBy, nil
+	case ContentTypeProgram:
+		program, err := uc.programRepo.GetByID(ctx, id)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return program.CreatedBy, nil
+	case ContentTypeEpisode:
+		episode, err := uc.episodeRepo.GetByID(ctx, id)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return episode.CreatedBy, nil
+	case ContentTypePerson:
+		person, err := uc.personRepo.GetByID(ctx, id)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return person.CreatedBy, nil
+	default:
+		return uuid.Nil, ErrInvalidContentType
+	}
+}
+
+// findTranslation returns the translation of content in locale, or nil if it has none.
+func (uc *UseCase) findTranslation(ctx context.Context, contentType ContentType, id uuid.UUID, locale string) (*Translation, error) {
+	translations, err := uc.translationRepo.List(ctx, contentType, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range translations {
+		if t.Locale == locale {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
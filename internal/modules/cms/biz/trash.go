@@ -0,0 +1,49 @@
+package biz
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashItem is a deleted category, program or episode waiting in its owner's trash.
+// Title is the name of a category. Episodes deleted together with their program are
+// not listed on their own, since restoring or purging the program takes them along.
+type TrashItem struct {
+	ContentType ContentType `json:"content_type"`
+	ID          uuid.UUID   `json:"id"`
+	Title       string      `json:"title"`
+	DeletedAt   time.Time   `json:"deleted_at"`
+}
+
+// TrashRestore is the content a restore brought back. Exactly one of Category, Program
+// and Episode is set.
+type TrashRestore struct {
+	Category *Category
+	Program  *Program
+	Episode  *Episode
+}
+
+// PurgeFilter selects trashed content to delete for good. Unset fields match
+// everything, and Limit caps each kind of content separately, 0 meaning no cap.
+type PurgeFilter struct {
+	UserID        *uuid.UUID
+	ContentType   *ContentType
+	ID            *uuid.UUID
+	DeletedBefore *time.Time
+	Limit         int32
+}
+
+// PurgeResult counts what a purge deleted. ObjectURLs are the storage objects the
+// purged episodes pointed at, which are no longer referenced.
+type PurgeResult struct {
+	Categories int32    `json:"categories"`
+	Programs   int32    `json:"programs"`
+	Episodes   int32    `json:"episodes"`
+	ObjectURLs []string `json:"object_urls,omitempty"`
+}
+
+// Total is the number of rows the purge deleted.
+func (r *PurgeResult) Total() int32 {
+	return r.Categories + r.Programs + r.Episodes
+}
@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// ListTrash returns what the current user deleted, most recently deleted first.
+func (uc *UseCase) ListTrash(ctx context.Context, contentType *ContentType, pagination PaginationRequest) ([]*TrashItem, *PaginationResponse, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, nil, ErrUnauthorized
+	}
+
+	pagination.SetDefaults()
+
+	return uc.trashRepo.List(ctx, userID, contentType, pagination)
+}
+
+// RestoreFromTrash takes a category, program or episode of the current user out of the
+// trash. A program comes back with the episodes that were deleted along with it.
+func (uc *UseCase) RestoreFromTrash(ctx context.Context, contentType ContentType, id uuid.UUID) (*TrashRestore, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	if err := uc.trashRepo.Restore(ctx, userID, contentType, id); err != nil {
+		return nil, err
+	}
+
+	switch contentType {
+	case ContentTypeCategory:
+		category, err := uc.categoryRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		uc.auditChange(ctx, EntityTypeCategory, id, nil, category)
+		return &TrashRestore{Category: category}, nil
+
+	case ContentTypeProgram:
+		if err := uc.programRepo.UpdateEpisodesCount(ctx, id); err != nil {
+			return nil, err
+		}
+		program, err := uc.programRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		uc.auditChange(ctx, EntityTypeProgram, id, nil, program)
+		uc.publishProgramUpdated(ctx, program, nil)
+		return &TrashRestore{Program: program}, nil
+
+	case ContentTypeEpisode:
+		episode, err := uc.episodeRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.programRepo.UpdateEpisodesCount(ctx, episode.ProgramID); err != nil {
+			return nil, err
+		}
+		uc.auditChange(ctx, EntityTypeEpisode, id, nil, episode)
+		uc.publishEpisodeUpdated(ctx, episode, nil)
+		return &TrashRestore{Episode: episode}, nil
+
+	default:
+		return nil, ErrInvalidContentType
+	}
+}
+
+// PurgeTrash deletes trashed content of the current user for good. Without an id it
+// empties the trash, or the part of it holding contentType.
+func (uc *UseCase) PurgeTrash(ctx context.Context, contentType *ContentType, id *uuid.UUID) (*PurgeResult, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	var result *PurgeResult
+	err := uc.auditedTx(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = uc.trashRepo.Purge(ctx, PurgeFilter{
+			UserID:      &userID,
+			ContentType: contentType,
+			ID:          id,
+		})
+		if err != nil {
+			return err
+		}
+		if id != nil && result.Total() == 0 {
+			return ErrTrashItemNotFound
+		}
+
+		entityID := uuid.Nil
+		if id != nil {
+			entityID = *id
+		}
+		entityType := EntityType("")
+		if contentType != nil {
+			entityType = EntityType(*contentType)
+		}
+		uc.auditChange(ctx, entityType, entityID, result, nil)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Files are deleted once the rows are, so a rollback cannot leave rows without them
+	uc.deleteObjects(ctx, result.ObjectURLs)
+
+	return result, nil
+}
+
+// PurgeExpiredTrash deletes up to limit of each kind of content that went to the trash
+// before the given time, whoever deleted it.
+func (uc *UseCase) PurgeExpiredTrash(ctx context.Context, before time.Time, limit int32) (*PurgeResult, error) {
+	result, err := uc.trashRepo.Purge(ctx, PurgeFilter{
+		DeletedBefore: &before,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.deleteObjects(ctx, result.ObjectURLs)
+
+	return result, nil
+}
+
+// deleteObjects removes uploaded files of purged episodes from storage. URLs that do not
+// point into our bucket are left alone. The rows are gone by now, so failures are only
+// logged.
+func (uc *UseCase) deleteObjects(ctx context.Context, urls []string) {
+	for _, url := range urls {
+		key, ok := strings.CutPrefix(url, "/thmanyah/")
+		if !ok {
+			continue
+		}
+		if err := uc.s3.DeleteObject(ctx, "thmanyah", key); err != nil {
+			uc.logger.WithContext(ctx).Errorf("Failed to delete object %s: %v", key, err)
+		}
+	}
+}
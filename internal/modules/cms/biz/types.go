@@ -77,6 +77,9 @@ type CategoryType string
 type ProgramStatus string
 type EpisodeStatus string
 type ImportStatus string
+type ImportEventType string
+type WebhookEventType string
+type WebhookDeliveryStatus string
 
 const (
 	CategoryTypePodcast       CategoryType = "CATEGORY_TYPE_PODCAST"
@@ -91,6 +94,8 @@ const (
 	ProgramStatusDraft     ProgramStatus = "PROGRAM_STATUS_DRAFT"
 	ProgramStatusPublished ProgramStatus = "PROGRAM_STATUS_PUBLISHED"
 	ProgramStatusArchived  ProgramStatus = "PROGRAM_STATUS_ARCHIVED"
+	ProgramStatusInReview  ProgramStatus = "PROGRAM_STATUS_IN_REVIEW"
+	ProgramStatusApproved  ProgramStatus = "PROGRAM_STATUS_APPROVED"
 )
 
 const (
@@ -98,6 +103,8 @@ const (
 	EpisodeStatusPublished EpisodeStatus = "EPISODE_STATUS_PUBLISHED"
 	EpisodeStatusScheduled EpisodeStatus = "EPISODE_STATUS_SCHEDULED"
 	EpisodeStatusArchived  EpisodeStatus = "EPISODE_STATUS_ARCHIVED"
+	EpisodeStatusInReview  EpisodeStatus = "EPISODE_STATUS_IN_REVIEW"
+	EpisodeStatusApproved  EpisodeStatus = "EPISODE_STATUS_APPROVED"
 )
 
 const (
@@ -107,17 +114,59 @@ const (
 	ImportStatusFailed     ImportStatus = "IMPORT_STATUS_FAILED"
 )
 
+const (
+	ImportEventProgress ImportEventType = "IMPORT_EVENT_TYPE_PROGRESS"
+	ImportEventWarning  ImportEventType = "IMPORT_EVENT_TYPE_WARNING"
+	ImportEventError    ImportEventType = "IMPORT_EVENT_TYPE_ERROR"
+)
+
+const (
+	WebhookEventProgramCreated   WebhookEventType = "program.created"
+	WebhookEventProgramUpdated   WebhookEventType = "program.updated"
+	WebhookEventProgramPublished WebhookEventType = "program.published"
+	WebhookEventProgramDeleted   WebhookEventType = "program.deleted"
+	WebhookEventEpisodeCreated   WebhookEventType = "episode.created"
+	WebhookEventEpisodeUpdated   WebhookEventType = "episode.updated"
+	WebhookEventEpisodePublished WebhookEventType = "episode.published"
+	WebhookEventEpisodeDeleted   WebhookEventType = "episode.deleted"
+)
+
+// WebhookEventTypes lists the events a subscription can ask for.
+var WebhookEventTypes = []WebhookEventType{
+	WebhookEventProgramCreated,
+	WebhookEventProgramUpdated,
+	WebhookEventProgramPublished,
+	WebhookEventProgramDeleted,
+	WebhookEventEpisodeCreated,
+	WebhookEventEpisodeUpdated,
+	WebhookEventEpisodePublished,
+	WebhookEventEpisodeDeleted,
+}
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "WEBHOOK_DELIVERY_STATUS_PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "WEBHOOK_DELIVERY_STATUS_SUCCEEDED"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "WEBHOOK_DELIVERY_STATUS_DEAD"
+)
+
 type Category struct {
 	ID          uuid.UUID    `db:"id"`
 	Name        string       `db:"name"`
 	Description string       `db:"description"`
 	Type        CategoryType `db:"type"`
+	ParentID    *uuid.UUID   `db:"parent_id"`
 	CreatedAt   time.Time    `db:"created_at"`
 	UpdatedAt   time.Time    `db:"updated_at"`
 	CreatedBy   uuid.UUID    `db:"created_by"`
 	Metadata    Metadata     `db:"metadata"`
 }
 
+// CategoryNode is a category together with its subcategories.
+type CategoryNode struct {
+	Category *Category
+	Children []*CategoryNode
+}
+
 type UpdateCategoryRequest struct {
 	Name        *string       `json:"name,omitempty"`
 	Description *string       `json:"description,omitempty"`
@@ -126,24 +175,26 @@ type UpdateCategoryRequest struct {
 }
 
 type Program struct {
-	ID            uuid.UUID     `db:"id"`
-	Title         string        `db:"title"`
-	Description   string        `db:"description"`
-	CategoryID    uuid.UUID     `db:"category_id"`
-	Status        ProgramStatus `db:"status"`
-	CreatedAt     time.Time     `db:"created_at"`
-	UpdatedAt     time.Time     `db:"updated_at"`
-	PublishedAt   *time.Time    `db:"published_at"`
-	CreatedBy     uuid.UUID     `db:"created_by"`
-	UpdatedBy     uuid.UUID     `db:"updated_by"`
-	ThumbnailURL  string        `db:"thumbnail_url"`
-	Tags          []string      `db:"tags"`
-	Metadata      Metadata      `db:"metadata"`
-	SourceURL     *string       `db:"source_url"`
-	EpisodesCount int32         `db:"episodes_count"`
-	IsFeatured    bool          `db:"is_featured"`
-	ViewCount     int32         `db:"view_count"`
-	Rating        float64       `db:"rating"`
+	ID            uuid.UUID     `db:"id" json:"id"`
+	Title         string        `db:"title" json:"title"`
+	Description   string        `db:"description" json:"description"`
+	CategoryID    uuid.UUID     `db:"category_id" json:"category_id"`
+	CategoryIDs   []uuid.UUID   `db:"category_ids" json:"category_ids"`
+	Status        ProgramStatus `db:"status" json:"status"`
+	CreatedAt     time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at" json:"updated_at"`
+	PublishedAt   *time.Time    `db:"published_at" json:"published_at"`
+	CreatedBy     uuid.UUID     `db:"created_by" json:"created_by"`
+	UpdatedBy     uuid.UUID     `db:"updated_by" json:"updated_by"`
+	ThumbnailURL  string        `db:"thumbnail_url" json:"thumbnail_url"`
+	Tags          []string      `db:"tags" json:"tags"`
+	Metadata      Metadata      `db:"metadata" json:"metadata"`
+	SourceURL     *string       `db:"source_url" json:"source_url"`
+	EpisodesCount int32         `db:"episodes_count" json:"episodes_count"`
+	IsFeatured    bool          `db:"is_featured" json:"is_featured"`
+	ViewCount     int32         `db:"view_count" json:"view_count"`
+	Rating        float64       `db:"rating" json:"rating"`
+	Availability  Availability  `db:"availability" json:"availability"`
 }
 
 type UpdateProgramRequest struct {
@@ -160,29 +211,34 @@ type UpdateProgramRequest struct {
 	IsFeatured    *bool          `json:"is_featured,omitempty"`
 	ViewCount     *int32         `json:"view_count,omitempty"`
 	Rating        *float64       `json:"rating,omitempty"`
+	Availability  *Availability  `json:"availability,omitempty"`
 }
 
 type Episode struct {
-	ID            uuid.UUID     `db:"id"`
-	ProgramID     uuid.UUID     `db:"program_id"`
-	Title         string        `db:"title"`
-	Description   string        `db:"description"`
-	DurationSecs  int32         `db:"duration_seconds"`
-	EpisodeNumber int32         `db:"episode_number"`
-	SeasonNumber  int32         `db:"season_number"`
-	Status        EpisodeStatus `db:"status"`
-	CreatedAt     time.Time     `db:"created_at"`
-	UpdatedAt     time.Time     `db:"updated_at"`
-	PublishedAt   *time.Time    `db:"published_at"`
-	ScheduledAt   *time.Time    `db:"scheduled_at"`
-	CreatedBy     uuid.UUID     `db:"created_by"`
-	UpdatedBy     uuid.UUID     `db:"updated_by"`
-	MediaURL      string        `db:"media_url"`
-	ThumbnailURL  string        `db:"thumbnail_url"`
-	Tags          []string      `db:"tags"`
-	Metadata      Metadata      `db:"metadata"`
-	ViewCount     int32         `db:"view_count"`
-	Rating        float64       `db:"rating"`
+	ID            uuid.UUID     `db:"id" json:"id"`
+	ProgramID     uuid.UUID     `db:"program_id" json:"program_id"`
+	Title         string        `db:"title" json:"title"`
+	Description   string        `db:"description" json:"description"`
+	DurationSecs  int32         `db:"duration_seconds" json:"duration_seconds"`
+	EpisodeNumber int32         `db:"episode_number" json:"episode_number"`
+	SeasonNumber  int32         `db:"season_number" json:"season_number"`
+	SeasonID      uuid.UUID     `db:"season_id" json:"season_id"`
+	Status        EpisodeStatus `db:"status" json:"status"`
+	CreatedAt     time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at" json:"updated_at"`
+	PublishedAt   *time.Time    `db:"published_at" json:"published_at"`
+	ScheduledAt   *time.Time    `db:"scheduled_at" json:"scheduled_at"`
+	CreatedBy     uuid.UUID     `db:"created_by" json:"created_by"`
+	UpdatedBy     uuid.UUID     `db:"updated_by" json:"updated_by"`
+	MediaURL      string        `db:"media_url" json:"media_url"`
+	ThumbnailURL  string        `db:"thumbnail_url" json:"thumbnail_url"`
+	Tags          []string      `db:"tags" json:"tags"`
+	Metadata      Metadata      `db:"metadata" json:"metadata"`
+	ViewCount     int32         `db:"view_count" json:"view_count"`
+	Rating        float64       `db:"rating" json:"rating"`
+	Availability  Availability  `db:"availability" json:"availability"`
+	// Chapters are only loaded where an episode is shown to listeners
+	Chapters []*Chapter `db:"-" json:"chapters,omitempty"`
 }
 
 // UpdateEpisodeRequest contains only the fields that are safe to update
@@ -201,6 +257,7 @@ type UpdateEpisodeRequest struct {
 	Metadata      *Metadata      `json:"metadata,omitempty"`
 	ViewCount     *int32         `json:"view_count,omitempty"`
 	Rating        *float64       `json:"rating,omitempty"`
+	Availability  *Availability  `json:"availability,omitempty"`
 }
 
 // BulkUpdateProgramsRequest contains only the fields that are safe to update in bulk operations
@@ -238,6 +295,49 @@ type PaginationResponse struct {
 	TotalPages int32 `json:"total_pages"`
 }
 
+// EpisodePage is one program's page of episodes in a batched lookup.
+type EpisodePage struct {
+	Episodes   []*Episode
+	Pagination *PaginationResponse
+}
+
+// MaxBatchGetIDs is the most IDs a single batch get may ask for.
+const MaxBatchGetIDs = 100
+
+// Batch is the result of a batch get: what was found, in request order, and the
+// requested IDs that were not.
+type Batch[T any] struct {
+	Found    []T
+	NotFound []uuid.UUID
+}
+
+// NewBatch orders items, loaded in any order, by the ids they were requested with.
+// An ID requested more than once is answered once, at its first position.
+func NewBatch[T any](ids []uuid.UUID, items []T, idOf func(T) uuid.UUID) *Batch[T] {
+	byID := make(map[uuid.UUID]T, len(items))
+	for _, item := range items {
+		byID[idOf(item)] = item
+	}
+
+	batch := &Batch[T]{Found: make([]T, 0, len(items))}
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if item, ok := byID[id]; ok {
+			batch.Found = append(batch.Found, item)
+		} else {
+			batch.NotFound = append(batch.NotFound, id)
+		}
+	}
+	return batch
+}
+
+// ProgramFilter narrows a program listing. CategoryID matches programs in that category
+// or any of its subcategories, whether it is their primary category or not.
 type ProgramFilter struct {
 	CategoryID   *uuid.UUID     `json:"category_id"`
 	Status       *ProgramStatus `json:"status"`
@@ -254,10 +354,12 @@ type CategoryFilter struct {
 }
 
 type EpisodeFilter struct {
-	ProgramID   *uuid.UUID     `json:"program_id"`
-	Status      *EpisodeStatus `json:"status"`
-	SearchQuery *string        `json:"search_query"`
-	CreatedBy   *uuid.UUID     `json:"created_by"`
+	ProgramID    *uuid.UUID     `json:"program_id"`
+	SeasonID     *uuid.UUID     `json:"season_id"`
+	SeasonNumber *int32         `json:"season_number"`
+	Status       *EpisodeStatus `json:"status"`
+	SearchQuery  *string        `json:"search_query"`
+	CreatedBy    *uuid.UUID     `json:"created_by"`
 }
 
 type ImportFilter struct {
@@ -307,8 +409,79 @@ type UpdateImportProgressRequest struct {
 	ErrorCount     *int32 `json:"error_count,omitempty"`
 }
 
+// ImportEvent is one update pushed to import watchers. Import is the state of the
+// import after the change; Message holds the new warning or error text.
+type ImportEvent struct {
+	Type    ImportEventType
+	Import  *ImportData
+	Message string
+}
+
 type UpdateEpisodeFileRequest struct {
 	Target string
 	File   multipart.File
 	Header *multipart.FileHeader
 }
+
+type WebhookSubscription struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	URL        string    `db:"url" json:"url"`
+	EventTypes []string  `db:"event_types" json:"event_types"`
+	Secret     string    `db:"secret" json:"-"`
+	Active     bool      `db:"active" json:"active"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+	CreatedBy  uuid.UUID `db:"created_by" json:"created_by"`
+}
+
+// UpdateWebhookSubscriptionRequest contains only the fields that are safe to update
+type UpdateWebhookSubscriptionRequest struct {
+	URL        *string   `json:"url,omitempty"`
+	EventTypes *[]string `json:"event_types,omitempty"`
+	Secret     *string   `json:"secret,omitempty"`
+	Active     *bool     `json:"active,omitempty"`
+}
+
+type WebhookSubscriptionFilter struct {
+	CreatedBy *uuid.UUID `json:"created_by"`
+}
+
+// WebhookDelivery is one event queued for one subscription, along with the
+// outcome of its latest attempt.
+type WebhookDelivery struct {
+	ID             uuid.UUID             `db:"id" json:"id"`
+	SubscriptionID uuid.UUID             `db:"subscription_id" json:"subscription_id"`
+	EventID        uuid.UUID             `db:"event_id" json:"event_id"`
+	EventType      WebhookEventType      `db:"event_type" json:"event_type"`
+	Payload        []byte                `db:"payload" json:"-"`
+	Status         WebhookDeliveryStatus `db:"status" json:"status"`
+	Attempts       int32                 `db:"attempts" json:"attempts"`
+	NextAttemptAt  *time.Time            `db:"next_attempt_at" json:"next_attempt_at"`
+	LastAttemptAt  *time.Time            `db:"last_attempt_at" json:"last_attempt_at"`
+	ResponseStatus *int32                `db:"response_status" json:"response_status"`
+	LastError      *string               `db:"last_error" json:"last_error"`
+	CreatedAt      time.Time             `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time             `db:"updated_at" json:"updated_at"`
+}
+
+type WebhookDeliveryFilter struct {
+	SubscriptionID *uuid.UUID             `json:"subscription_id"`
+	Status         *WebhookDeliveryStatus `json:"status"`
+}
+
+// WebhookDeliveryAttempt is the outcome of sending a delivery once.
+type WebhookDeliveryAttempt struct {
+	Status         WebhookDeliveryStatus
+	NextAttemptAt  *time.Time
+	ResponseStatus *int32
+	Error          *string
+}
+
+// WebhookEvent is the JSON body posted to subscribers. Data holds the program or
+// episode as it was after the change, or just before it for deletions.
+type WebhookEvent struct {
+	ID         uuid.UUID        `json:"id"`
+	Type       WebhookEventType `json:"type"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Data       any              `json:"data"`
+}
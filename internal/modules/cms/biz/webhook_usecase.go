@@ -0,0 +1,285 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// Webhook subscription operations
+
+func (uc *UseCase) CreateWebhookSubscription(ctx context.Context, subscription *WebhookSubscription) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	if err := validateWebhookURL(ctx, subscription.URL); err != nil {
+		return err
+	}
+	eventTypes, err := normalizeWebhookEventTypes(subscription.EventTypes)
+	if err != nil {
+		return err
+	}
+
+	if subscription.Secret == "" {
+		secret, err := newWebhookSecret()
+		if err != nil {
+			return err
+		}
+		subscription.Secret = secret
+	}
+
+	subscription.ID = uuid.Must(uuid.NewV7())
+	subscription.EventTypes = eventTypes
+	subscription.Active = true
+	subscription.CreatedBy = userID
+
+	if err := uc.webhookRepo.CreateSubscription(ctx, subscription); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypeWebhook, subscription.ID, nil, subscription)
+
+	return nil
+}
+
+func (uc *UseCase) UpdateWebhookSubscription(ctx context.Context, id uuid.UUID, updates *UpdateWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	if updates.URL != nil {
+		if err := validateWebhookURL(ctx, *updates.URL); err != nil {
+			return nil, err
+		}
+	}
+	if updates.EventTypes != nil {
+		eventTypes, err := normalizeWebhookEventTypes(*updates.EventTypes)
+		if err != nil {
+			return nil, err
+		}
+		updates.EventTypes = &eventTypes
+	}
+
+	before, err := uc.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	subscription, err := uc.webhookRepo.UpdateSubscription(ctx, userID, id, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeWebhook, id, before, subscription)
+
+	return subscription, nil
+}
+
+func (uc *UseCase) DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+
+	before, err := uc.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.webhookRepo.DeleteSubscription(ctx, userID, id); err != nil {
+		return err
+	}
+
+	uc.auditChange(ctx, EntityTypeWebhook, id, before, nil)
+
+	return nil
+}
+
+func (uc *UseCase) GetWebhookSubscription(ctx context.Context, id uuid.UUID) (*WebhookSubscription, error) {
+	subscription, err := uc.webhookRepo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Subscriptions carry a signing secret, so other users get the same answer as for a missing one
+	userID, _ := utils.GetUserID(ctx)
+	if subscription.CreatedBy != userID {
+		return nil, ErrWebhookNotFound
+	}
+
+	return subscription, nil
+}
+
+func (uc *UseCase) ListWebhookSubscriptions(ctx context.Context, pagination PaginationRequest) ([]*WebhookSubscription, *PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, nil, ErrUnauthorized
+	}
+
+	return uc.webhookRepo.ListSubscriptions(ctx, WebhookSubscriptionFilter{CreatedBy: &userID}, pagination)
+}
+
+func (uc *UseCase) ListWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID, status *WebhookDeliveryStatus, pagination PaginationRequest) ([]*WebhookDelivery, *PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	if _, err := uc.GetWebhookSubscription(ctx, subscriptionID); err != nil {
+		return nil, nil, err
+	}
+
+	filter := WebhookDeliveryFilter{
+		SubscriptionID: &subscriptionID,
+		Status:         status,
+	}
+	return uc.webhookRepo.ListDeliveries(ctx, filter, pagination)
+}
+
+// RedeliverWebhook queues a delivery again, whatever its current status.
+func (uc *UseCase) RedeliverWebhook(ctx context.Context, deliveryID uuid.UUID) (*WebhookDelivery, error) {
+	delivery, err := uc.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.GetWebhookSubscription(ctx, delivery.SubscriptionID); err != nil {
+		if errors.Is(err, ErrWebhookNotFound) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	redelivered, err := uc.webhookRepo.ResetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditChange(ctx, EntityTypeWebhookDelivery, deliveryID, delivery, redelivered)
+
+	return redelivered, nil
+}
+
+// publishEvent queues eventType for every active subscription of owner that asked for it.
+// Called inside the transaction of the change it reports, the deliveries are committed
+// with it. They are queued in a savepoint, so failures are logged rather than returned
+// and do not undo the change.
+func (uc *UseCase) publishEvent(ctx context.Context, eventType WebhookEventType, owner uuid.UUID, data any) {
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		return uc.queueEvent(ctx, eventType, owner, data)
+	})
+	if err != nil {
+		uc.logger.WithContext(ctx).Errorf("Failed to queue webhook event %s: %v", eventType, err)
+	}
+}
+
+func (uc *UseCase) queueEvent(ctx context.Context, eventType WebhookEventType, owner uuid.UUID, data any) error {
+	subscribers, err := uc.webhookRepo.FindSubscribers(ctx, owner, eventType)
+	if err != nil {
+		return fmt.Errorf("failed to find subscribers: %w", err)
+	}
+	if len(subscribers) == 0 {
+		return nil
+	}
+
+	event := WebhookEvent{
+		ID:         uuid.Must(uuid.NewV7()),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	deliveries := make([]*WebhookDelivery, 0, len(subscribers))
+	for _, subscriber := range subscribers {
+		deliveries = append(deliveries, &WebhookDelivery{
+			ID:             uuid.Must(uuid.NewV7()),
+			SubscriptionID: subscriber.ID,
+			EventID:        event.ID,
+			EventType:      eventType,
+			Payload:        payload,
+			Status:         WebhookDeliveryStatusPending,
+		})
+	}
+
+	return uc.webhookRepo.CreateDeliveries(ctx, deliveries)
+}
+
+func (uc *UseCase) publishProgramUpdated(ctx context.Context, program *Program, status *ProgramStatus) {
+	uc.publishEvent(ctx, WebhookEventProgramUpdated, program.CreatedBy, program)
+	if status != nil && *status == ProgramStatusPublished {
+		uc.publishEvent(ctx, WebhookEventProgramPublished, program.CreatedBy, program)
+	}
+}
+
+func (uc *UseCase) publishEpisodeUpdated(ctx context.Context, episode *Episode, status *EpisodeStatus) {
+	uc.publishEvent(ctx, WebhookEventEpisodeUpdated, episode.CreatedBy, episode)
+	if status != nil && *status == EpisodeStatusPublished {
+		uc.publishEvent(ctx, WebhookEventEpisodePublished, episode.CreatedBy, episode)
+	}
+}
+
+// validateWebhookURL accepts absolute http and https URLs whose host is, or only resolves
+// to, public addresses, so that subscriptions cannot reach internal services. The
+// dispatcher checks the address again when it connects.
+func validateWebhookURL(ctx context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidWebhookURL
+	}
+
+	host := u.Hostname()
+	invalid := ErrInvalidWebhookURL.WithMetadata(map[string]string{"host": host})
+	var addrs []netip.Addr
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addrs = []netip.Addr{addr}
+	} else if addrs, err = net.DefaultResolver.LookupNetIP(ctx, "ip", host); err != nil {
+		return invalid
+	}
+	for _, addr := range addrs {
+		if !utils.IsPublicAddr(addr) {
+			return invalid
+		}
+	}
+	return nil
+}
+
+func normalizeWebhookEventTypes(eventTypes []string) ([]string, error) {
+	if len(eventTypes) == 0 {
+		return nil, ErrInvalidWebhookEventType
+	}
+
+	normalized := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !slices.Contains(WebhookEventTypes, WebhookEventType(eventType)) {
+			return nil, ErrInvalidWebhookEventType.WithMetadata(map[string]string{"event_type": eventType})
+		}
+		if !slices.Contains(normalized, eventType) {
+			normalized = append(normalized, eventType)
+		}
+	}
+
+	return normalized, nil
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
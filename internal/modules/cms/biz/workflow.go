@@ -0,0 +1,199 @@
+package biz
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ContentType names the kinds of content that go through the editorial workflow.
+// Categories only appear in the trash and in translations, and people only in
+// translations.
+type ContentType string
+
+const (
+	ContentTypeProgram  ContentType = "program"
+	ContentTypeEpisode  ContentType = "episode"
+	ContentTypeCategory ContentType = "category"
+	ContentTypePerson   ContentType = "person"
+)
+
+// WorkflowAction is what caused a status transition.
+type WorkflowAction string
+
+const (
+	WorkflowActionSubmit     WorkflowAction = "submit"
+	WorkflowActionWithdraw   WorkflowAction = "withdraw"
+	WorkflowActionApprove    WorkflowAction = "approve"
+	WorkflowActionReject     WorkflowAction = "reject"
+	WorkflowActionPublish    WorkflowAction = "publish"
+	WorkflowActionUnpublish  WorkflowAction = "unpublish"
+	WorkflowActionSchedule   WorkflowAction = "schedule"
+	WorkflowActionUnschedule WorkflowAction = "unschedule"
+	WorkflowActionArchive    WorkflowAction = "archive"
+	WorkflowActionRestore    WorkflowAction = "restore"
+)
+
+// StatusTransition is one recorded status change of a program or episode. ActorID is
+// nil for changes made by the system, such as scheduled publishing.
+type StatusTransition struct {
+	ID          uuid.UUID      `json:"id"`
+	ContentType ContentType    `json:"content_type"`
+	ContentID   uuid.UUID      `json:"content_id"`
+	Action      WorkflowAction `json:"action"`
+	FromStatus  string         `json:"from_status"`
+	ToStatus    string         `json:"to_status"`
+	ActorID     *uuid.UUID     `json:"actor_id,omitempty"`
+	Comment     string         `json:"comment,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+// ReviewPolicy tells the workflow whether review is required and who may review.
+type ReviewPolicy interface {
+	ReviewRequired() bool
+	IsReviewer(userID uuid.UUID) bool
+	AllowSelfApproval() bool
+}
+
+// stage is the place of a status in the workflow. Programs and episodes share the
+// stages, except that only episodes can be scheduled.
+type stage string
+
+const (
+	stageDraft     stage = "draft"
+	stageInReview  stage = "in_review"
+	stageApproved  stage = "approved"
+	stageScheduled stage = "scheduled"
+	stagePublished stage = "published"
+	stageArchived  stage = "archived"
+)
+
+var programStages = map[ProgramStatus]stage{
+	ProgramStatusDraft:     stageDraft,
+	ProgramStatusInReview:  stageInReview,
+	ProgramStatusApproved:  stageApproved,
+	ProgramStatusPublished: stagePublished,
+	ProgramStatusArchived:  stageArchived,
+}
+
+var episodeStages = map[EpisodeStatus]stage{
+	EpisodeStatusDraft:     stageDraft,
+	EpisodeStatusInReview:  stageInReview,
+	EpisodeStatusApproved:  stageApproved,
+	EpisodeStatusScheduled: stageScheduled,
+	EpisodeStatusPublished: stagePublished,
+	EpisodeStatusArchived:  stageArchived,
+}
+
+type role int
+
+const (
+	roleOwner role = iota
+	roleReviewer
+)
+
+type workflowRule struct {
+	from []stage
+	to   stage
+	by   role
+}
+
+// reviewRules is the editorial workflow: draft → in_review → approved → (scheduled →) published.
+var reviewRules = map[WorkflowAction]workflowRule{
+	WorkflowActionSubmit:     {from: []stage{stageDraft}, to: stageInReview, by: roleOwner},
+	WorkflowActionWithdraw:   {from: []stage{stageInReview}, to: stageDraft, by: roleOwner},
+	WorkflowActionApprove:    {from: []stage{stageInReview}, to: stageApproved, by: roleReviewer},
+	WorkflowActionReject:     {from: []stage{stageInReview, stageApproved}, to: stageDraft, by: roleReviewer},
+	WorkflowActionPublish:    {from: []stage{stageApproved, stageScheduled}, to: stagePublished, by: roleOwner},
+	WorkflowActionSchedule:   {from: []stage{stageApproved, stageScheduled}, to: stageScheduled, by: roleOwner},
+	WorkflowActionUnschedule: {from: []stage{stageScheduled}, to: stageApproved, by: roleOwner},
+	WorkflowActionUnpublish:  {from: []stage{stagePublished}, to: stageDraft, by: roleOwner},
+	WorkflowActionArchive:    {from: []stage{stageDraft, stageInReview, stageApproved, stageScheduled, stagePublished}, to: stageArchived, by: roleOwner},
+	WorkflowActionRestore:    {from: []stage{stageArchived}, to: stageDraft, by: roleOwner},
+}
+
+// directRules replace review rules when review is not required, so drafts can be
+// published and scheduled straight away.
+var directRules = map[WorkflowAction]workflowRule{
+	WorkflowActionPublish:    {from: []stage{stageDraft, stageApproved, stageScheduled}, to: stagePublished, by: roleOwner},
+	WorkflowActionSchedule:   {from: []stage{stageDraft, stageApproved, stageScheduled}, to: stageScheduled, by: roleOwner},
+	WorkflowActionUnschedule: {from: []stage{stageScheduled}, to: stageDraft, by: roleOwner},
+}
+
+// workflow is the state machine every program and episode status change goes through.
+type workflow struct {
+	policy ReviewPolicy
+}
+
+func (w workflow) rule(action WorkflowAction) (workflowRule, bool) {
+	if !w.policy.ReviewRequired() {
+		if rule, ok := directRules[action]; ok {
+			return rule, true
+		}
+	}
+	rule, ok := reviewRules[action]
+	return rule, ok
+}
+
+// check returns the stage action leads to from, or why actor may not take it on
+// content created by owner.
+func (w workflow) check(action WorkflowAction, from stage, actor, owner uuid.UUID) (stage, error) {
+	rule, ok := w.rule(action)
+	if !ok || !slices.Contains(rule.from, from) {
+		return "", illegalTransition(action, from)
+	}
+
+	switch rule.by {
+	case roleReviewer:
+		if !w.policy.IsReviewer(actor) {
+			return "", ErrReviewerRequired
+		}
+		if actor == owner && !w.policy.AllowSelfApproval() {
+			return "", ErrSelfReview
+		}
+	case roleOwner:
+		if actor != owner {
+			return "", ErrForbidden
+		}
+	}
+
+	return rule.to, nil
+}
+
+// actionFor finds the owner action that moves content from one stage to another, for
+// status changes made through a plain update.
+func (w workflow) actionFor(from, to stage) (WorkflowAction, error) {
+	for _, action := range []WorkflowAction{
+		WorkflowActionSubmit, WorkflowActionWithdraw, WorkflowActionPublish, WorkflowActionSchedule,
+		WorkflowActionUnschedule, WorkflowActionUnpublish, WorkflowActionArchive, WorkflowActionRestore,
+	} {
+		rule, ok := w.rule(action)
+		if ok && rule.to == to && slices.Contains(rule.from, from) {
+			return action, nil
+		}
+	}
+	return "", ErrIllegalTransition.WithMetadata(map[string]string{"from": string(from), "to": string(to)})
+}
+
+func illegalTransition(action WorkflowAction, from stage) error {
+	return ErrIllegalTransition.WithMetadata(map[string]string{"action": string(action), "from": string(from)})
+}
+
+func programStatusOf(s stage) (ProgramStatus, bool) {
+	for status, st := range programStages {
+		if st == s {
+			return status, true
+		}
+	}
+	return "", false
+}
+
+func episodeStatusOf(s stage) (EpisodeStatus, bool) {
+	for status, st := range episodeStages {
+		if st == s {
+			return status, true
+		}
+	}
+	return "", false
+}
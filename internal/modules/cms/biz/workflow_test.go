@@ -0,0 +1,133 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeReviewPolicy struct {
+	required          bool
+	reviewers         []uuid.UUID
+	allowSelfApproval bool
+}
+
+func (p fakeReviewPolicy) ReviewRequired() bool { return p.required }
+
+func (p fakeReviewPolicy) IsReviewer(userID uuid.UUID) bool {
+	for _, reviewer := range p.reviewers {
+		if reviewer == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (p fakeReviewPolicy) AllowSelfApproval() bool { return p.allowSelfApproval }
+
+func TestWorkflow_Check(t *testing.T) {
+	owner := uuid.New()
+	reviewer := uuid.New()
+	reviewingOwner := uuid.New()
+	stranger := uuid.New()
+
+	review := workflow{policy: fakeReviewPolicy{required: true, reviewers: []uuid.UUID{reviewer, reviewingOwner}}}
+	selfApproval := workflow{policy: fakeReviewPolicy{required: true, reviewers: []uuid.UUID{reviewingOwner}, allowSelfApproval: true}}
+	direct := workflow{policy: fakeReviewPolicy{reviewers: []uuid.UUID{reviewer}}}
+
+	tests := []struct {
+		name     string
+		workflow workflow
+		action   WorkflowAction
+		from     stage
+		actor    uuid.UUID
+		owner    uuid.UUID
+		want     stage
+		err      *errors.Error
+	}{
+		{name: "Submit", workflow: review, action: WorkflowActionSubmit, from: stageDraft, actor: owner, want: stageInReview},
+		{name: "SubmitByStranger", workflow: review, action: WorkflowActionSubmit, from: stageDraft, actor: stranger, err: ErrForbidden},
+		{name: "SubmitTwice", workflow: review, action: WorkflowActionSubmit, from: stageInReview, actor: owner, err: ErrIllegalTransition},
+		{name: "Withdraw", workflow: review, action: WorkflowActionWithdraw, from: stageInReview, actor: owner, want: stageDraft},
+		{name: "Approve", workflow: review, action: WorkflowActionApprove, from: stageInReview, actor: reviewer, want: stageApproved},
+		{name: "ApproveByOwner", workflow: review, action: WorkflowActionApprove, from: stageInReview, actor: owner, err: ErrReviewerRequired},
+		{name: "ApproveDraft", workflow: review, action: WorkflowActionApprove, from: stageDraft, actor: reviewer, err: ErrIllegalTransition},
+		{name: "SelfApproval", workflow: review, action: WorkflowActionApprove, from: stageInReview, actor: reviewingOwner, owner: reviewingOwner, err: ErrSelfReview},
+		{name: "SelfApprovalAllowed", workflow: selfApproval, action: WorkflowActionApprove, from: stageInReview, actor: reviewingOwner, owner: reviewingOwner, want: stageApproved},
+		{name: "RejectInReview", workflow: review, action: WorkflowActionReject, from: stageInReview, actor: reviewer, want: stageDraft},
+		{name: "RejectApproved", workflow: review, action: WorkflowActionReject, from: stageApproved, actor: reviewer, want: stageDraft},
+		{name: "RejectPublished", workflow: review, action: WorkflowActionReject, from: stagePublished, actor: reviewer, err: ErrIllegalTransition},
+		{name: "SelfReject", workflow: review, action: WorkflowActionReject, from: stageInReview, actor: reviewingOwner, owner: reviewingOwner, err: ErrSelfReview},
+		{name: "PublishApproved", workflow: review, action: WorkflowActionPublish, from: stageApproved, actor: owner, want: stagePublished},
+		{name: "PublishDraft", workflow: review, action: WorkflowActionPublish, from: stageDraft, actor: owner, err: ErrIllegalTransition},
+		{name: "PublishInReview", workflow: review, action: WorkflowActionPublish, from: stageInReview, actor: owner, err: ErrIllegalTransition},
+		{name: "PublishByReviewer", workflow: review, action: WorkflowActionPublish, from: stageApproved, actor: reviewer, err: ErrForbidden},
+		{name: "Schedule", workflow: review, action: WorkflowActionSchedule, from: stageApproved, actor: owner, want: stageScheduled},
+		{name: "Reschedule", workflow: review, action: WorkflowActionSchedule, from: stageScheduled, actor: owner, want: stageScheduled},
+		{name: "Unschedule", workflow: review, action: WorkflowActionUnschedule, from: stageScheduled, actor: owner, want: stageApproved},
+		{name: "PublishScheduled", workflow: review, action: WorkflowActionPublish, from: stageScheduled, actor: owner, want: stagePublished},
+		{name: "Unpublish", workflow: review, action: WorkflowActionUnpublish, from: stagePublished, actor: owner, want: stageDraft},
+		{name: "Archive", workflow: review, action: WorkflowActionArchive, from: stagePublished, actor: owner, want: stageArchived},
+		{name: "ArchiveTwice", workflow: review, action: WorkflowActionArchive, from: stageArchived, actor: owner, err: ErrIllegalTransition},
+		{name: "Restore", workflow: review, action: WorkflowActionRestore, from: stageArchived, actor: owner, want: stageDraft},
+		{name: "DirectPublish", workflow: direct, action: WorkflowActionPublish, from: stageDraft, actor: owner, want: stagePublished},
+		{name: "DirectSchedule", workflow: direct, action: WorkflowActionSchedule, from: stageDraft, actor: owner, want: stageScheduled},
+		{name: "DirectUnschedule", workflow: direct, action: WorkflowActionUnschedule, from: stageScheduled, actor: owner, want: stageDraft},
+		{name: "DirectSubmit", workflow: direct, action: WorkflowActionSubmit, from: stageDraft, actor: owner, want: stageInReview},
+		{name: "DirectApprove", workflow: direct, action: WorkflowActionApprove, from: stageInReview, actor: reviewer, want: stageApproved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentOwner := tt.owner
+			if contentOwner == uuid.Nil {
+				contentOwner = owner
+			}
+			to, err := tt.workflow.check(tt.action, tt.from, tt.actor, contentOwner)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, to)
+		})
+	}
+}
+
+func TestWorkflow_ActionFor(t *testing.T) {
+	review := workflow{policy: fakeReviewPolicy{required: true}}
+	direct := workflow{policy: fakeReviewPolicy{}}
+
+	tests := []struct {
+		name     string
+		workflow workflow
+		from, to stage
+		want     WorkflowAction
+	}{
+		{name: "Submit", workflow: review, from: stageDraft, to: stageInReview, want: WorkflowActionSubmit},
+		{name: "Withdraw", workflow: review, from: stageInReview, to: stageDraft, want: WorkflowActionWithdraw},
+		{name: "Publish", workflow: review, from: stageApproved, to: stagePublished, want: WorkflowActionPublish},
+		{name: "Unpublish", workflow: review, from: stagePublished, to: stageDraft, want: WorkflowActionUnpublish},
+		{name: "Archive", workflow: review, from: stageDraft, to: stageArchived, want: WorkflowActionArchive},
+		{name: "Restore", workflow: review, from: stageArchived, to: stageDraft, want: WorkflowActionRestore},
+		{name: "DirectPublish", workflow: direct, from: stageDraft, to: stagePublished, want: WorkflowActionPublish},
+		{name: "DirectUnschedule", workflow: direct, from: stageScheduled, to: stageDraft, want: WorkflowActionUnschedule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, err := tt.workflow.actionFor(tt.from, tt.to)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, action)
+		})
+	}
+
+	// Approving and rejecting are left to reviewers, and drafts need a review first
+	for _, tc := range [][2]stage{{stageInReview, stageApproved}, {stageDraft, stagePublished}, {stageArchived, stagePublished}} {
+		_, err := review.actionFor(tc[0], tc[1])
+		assert.True(t, errors.Is(err, ErrIllegalTransition), "%s to %s: got %v", tc[0], tc[1], err)
+	}
+}
@@ -0,0 +1,256 @@
+package biz
+
+import (
+	"context"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"thmanyah/internal/utils"
+)
+
+// WorkflowResult is the content a review action moved and the transition it recorded.
+// Exactly one of Program and Episode is set.
+type WorkflowResult struct {
+	Program    *Program
+	Episode    *Episode
+	Transition *StatusTransition
+}
+
+// SubmitForReview sends a draft program or episode to the reviewers.
+func (uc *UseCase) SubmitForReview(ctx context.Context, contentType ContentType, id uuid.UUID, comment string) (*WorkflowResult, error) {
+	return uc.review(ctx, contentType, id, WorkflowActionSubmit, comment)
+}
+
+// ApproveContent approves content in review so its owner can publish or schedule it.
+func (uc *UseCase) ApproveContent(ctx context.Context, contentType ContentType, id uuid.UUID, comment string) (*WorkflowResult, error) {
+	return uc.review(ctx, contentType, id, WorkflowActionApprove, comment)
+}
+
+// RejectContent sends content in review, or approved but not yet published, back to
+// draft. The comment tells the owner what to change, so it is required.
+func (uc *UseCase) RejectContent(ctx context.Context, contentType ContentType, id uuid.UUID, comment string) (*WorkflowResult, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, ErrReviewCommentRequired
+	}
+	return uc.review(ctx, contentType, id, WorkflowActionReject, comment)
+}
+
+func (uc *UseCase) review(ctx context.Context, contentType ContentType, id uuid.UUID, action WorkflowAction, comment string) (*WorkflowResult, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	switch contentType {
+	case ContentTypeProgram:
+		program, err := uc.programRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		before := program
+		program, transition, err := uc.transitionProgram(ctx, program, action, userID, comment)
+		if err != nil {
+			return nil, err
+		}
+		uc.auditChange(ctx, EntityTypeProgram, id, before, program)
+		uc.recordProgramRevision(ctx, program, userID, nil)
+		uc.publishProgramUpdated(ctx, program, nil)
+		return &WorkflowResult{Program: program, Transition: transition}, nil
+
+	case ContentTypeEpisode:
+		episode, err := uc.episodeRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		before := episode
+		episode, transition, err := uc.transitionEpisode(ctx, episode, action, userID, comment, nil)
+		if err != nil {
+			return nil, err
+		}
+		uc.auditChange(ctx, EntityTypeEpisode, id, before, episode)
+		uc.recordEpisodeRevision(ctx, episode, userID, nil)
+		uc.publishEpisodeUpdated(ctx, episode, nil)
+		return &WorkflowResult{Episode: episode, Transition: transition}, nil
+
+	default:
+		return nil, ErrInvalidContentType
+	}
+}
+
+// ListStatusTransitions returns the status history of a program or episode, oldest
+// first. Only the owner and reviewers may see it.
+func (uc *UseCase) ListStatusTransitions(ctx context.Context, contentType ContentType, id uuid.UUID) ([]*StatusTransition, error) {
+	userID, _ := utils.GetUserID(ctx)
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+
+	if err := uc.checkHistoryAccess(ctx, contentType, id, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.workflowRepo.ListTransitions(ctx, contentType, id)
+}
+
+// checkHistoryAccess lets the owner of a program or episode and reviewers see its history.
+func (uc *UseCase) checkHistoryAccess(ctx context.Context, contentType ContentType, id, userID uuid.UUID) error {
+	var owner uuid.UUID
+	switch contentType {
+	case ContentTypeProgram:
+		program, err := uc.programRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		owner = program.CreatedBy
+	case ContentTypeEpisode:
+		episode, err := uc.episodeRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		owner = episode.CreatedBy
+	default:
+		return ErrInvalidContentType
+	}
+
+	if owner != userID && !uc.workflow.policy.IsReviewer(userID) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// programAction finds the workflow action behind setting status through an update and
+// checks that actor may take it.
+func (uc *UseCase) programAction(program *Program, status ProgramStatus, actor uuid.UUID) (WorkflowAction, error) {
+	from, ok := programStages[program.Status]
+	if !ok {
+		return "", ErrIllegalTransition
+	}
+	to, ok := programStages[status]
+	if !ok {
+		return "", ErrIllegalTransition
+	}
+
+	action, err := uc.workflow.actionFor(from, to)
+	if err != nil {
+		return "", err
+	}
+	if _, err := uc.workflow.check(action, from, actor, program.CreatedBy); err != nil {
+		return "", err
+	}
+
+	return action, nil
+}
+
+func (uc *UseCase) episodeAction(episode *Episode, status EpisodeStatus, actor uuid.UUID) (WorkflowAction, error) {
+	from, ok := episodeStages[episode.Status]
+	if !ok {
+		return "", ErrIllegalTransition
+	}
+	to, ok := episodeStages[status]
+	if !ok {
+		return "", ErrIllegalTransition
+	}
+
+	action, err := uc.workflow.actionFor(from, to)
+	if err != nil {
+		return "", err
+	}
+	if _, err := uc.workflow.check(action, from, actor, episode.CreatedBy); err != nil {
+		return "", err
+	}
+
+	return action, nil
+}
+
+// transitionProgram takes action on program for actor and records the transition.
+// Callers publish the webhook events, since they know what else changed.
+func (uc *UseCase) transitionProgram(ctx context.Context, program *Program, action WorkflowAction, actor uuid.UUID, comment string) (*Program, *StatusTransition, error) {
+	from, ok := programStages[program.Status]
+	if !ok {
+		return nil, nil, ErrIllegalTransition
+	}
+	to, err := uc.workflow.check(action, from, actor, program.CreatedBy)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Programs cannot be scheduled, so not every stage has a program status
+	status, ok := programStatusOf(to)
+	if !ok {
+		return nil, nil, illegalTransition(action, from)
+	}
+
+	transition := &StatusTransition{
+		ContentType: ContentTypeProgram,
+		ContentID:   program.ID,
+		Action:      action,
+		FromStatus:  string(program.Status),
+		ToStatus:    string(status),
+		ActorID:     &actor,
+		Comment:     comment,
+	}
+	program, err = uc.programRepo.Transition(ctx, transition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if status == ProgramStatusPublished {
+		uc.afterCommit(ctx, func() { uc.metrics.programsPublished.Add(ctx, 1) })
+	}
+
+	return program, transition, nil
+}
+
+// transitionEpisode takes action on episode for actor and records the transition.
+// scheduledAt is required when the action schedules the episode.
+func (uc *UseCase) transitionEpisode(ctx context.Context, episode *Episode, action WorkflowAction, actor uuid.UUID, comment string, scheduledAt *time.Time) (*Episode, *StatusTransition, error) {
+	from, ok := episodeStages[episode.Status]
+	if !ok {
+		return nil, nil, ErrIllegalTransition
+	}
+	to, err := uc.workflow.check(action, from, actor, episode.CreatedBy)
+	if err != nil {
+		return nil, nil, err
+	}
+	status, ok := episodeStatusOf(to)
+	if !ok {
+		return nil, nil, illegalTransition(action, from)
+	}
+	if status == EpisodeStatusScheduled && (scheduledAt == nil || !scheduledAt.After(time.Now())) {
+		return nil, nil, ErrInvalidSchedule
+	}
+
+	transition := &StatusTransition{
+		ContentType: ContentTypeEpisode,
+		ContentID:   episode.ID,
+		Action:      action,
+		FromStatus:  string(episode.Status),
+		ToStatus:    string(status),
+		ActorID:     &actor,
+		Comment:     comment,
+	}
+	episode, err = uc.episodeRepo.Transition(ctx, transition, scheduledAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return episode, transition, nil
+}
+
+// withMetadata adds one metadata entry to a kratos error, keeping the ones it has.
+func withMetadata(err error, key, value string) error {
+	var e *errors.Error
+	if !errors.As(err, &e) {
+		return err
+	}
+
+	metadata := maps.Clone(e.Metadata)
+	if metadata == nil {
+		metadata = make(map[string]string, 1)
+	}
+	metadata[key] = value
+
+	return e.WithMetadata(metadata)
+}
@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"fmt"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+// policy is the audit policy from the audit config. Admins are listed by user id; with
+// none listed, nobody can read the audit log through the API.
+type policy struct {
+	admins map[uuid.UUID]struct{}
+}
+
+func NewPolicy(c *conf.Audit) (biz.AuditPolicy, error) {
+	p := &policy{
+		admins: make(map[uuid.UUID]struct{}, len(c.GetAdminIds())),
+	}
+
+	for _, raw := range c.GetAdminIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid audit admin id %q: %w", raw, err)
+		}
+		p.admins[id] = struct{}{}
+	}
+
+	return p, nil
+}
+
+func (p *policy) IsAdmin(userID uuid.UUID) bool {
+	_, ok := p.admins[userID]
+	return ok
+}
@@ -0,0 +1,161 @@
+package pgnotify
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ImportProgressChannel is the channel the imports trigger notifies with the changed import ID.
+const ImportProgressChannel = "import_progress"
+
+const reconnectDelay = 2 * time.Second
+
+// ImportListener holds a dedicated connection that LISTENs for import changes and fans
+// each notification out to the watchers of that import on this replica.
+type ImportListener struct {
+	db     *pgxpool.Pool
+	logger *log.Helper
+
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan struct{}]struct{}
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+func NewImportListener(db *pgxpool.Pool, logger log.Logger) *ImportListener {
+	return &ImportListener{
+		db:          db,
+		logger:      log.NewHelper(logger),
+		subscribers: make(map[uuid.UUID]map[chan struct{}]struct{}),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+var _ biz.ImportNotifier = (*ImportListener)(nil)
+
+func (l *ImportListener) Subscribe(id uuid.UUID) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	if l.subscribers[id] == nil {
+		l.subscribers[id] = make(map[chan struct{}]struct{})
+	}
+	l.subscribers[id][ch] = struct{}{}
+	l.mu.Unlock()
+
+	return ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.subscribers[id], ch)
+		if len(l.subscribers[id]) == 0 {
+			delete(l.subscribers, id)
+		}
+	}
+}
+
+func (l *ImportListener) Start(ctx context.Context) error {
+	defer close(l.done)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-l.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		l.logger.Errorf("Import listener disconnected, reconnecting: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (l *ImportListener) Stop(ctx context.Context) error {
+	l.stopOnce.Do(func() { close(l.stop) })
+
+	select {
+	case <-l.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *ImportListener) listen(ctx context.Context) error {
+	pooled, err := l.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// A listening connection must not go back to the pool
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+ImportProgressChannel); err != nil {
+		return err
+	}
+
+	// Notifications sent while disconnected are lost, so every watcher reloads once
+	l.broadcast()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		id, err := uuid.Parse(notification.Payload)
+		if err != nil {
+			continue
+		}
+		l.notify(id)
+	}
+}
+
+func (l *ImportListener) notify(id uuid.UUID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for ch := range l.subscribers[id] {
+		signal(ch)
+	}
+}
+
+func (l *ImportListener) broadcast() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, subscribers := range l.subscribers {
+		for ch := range subscribers {
+			signal(ch)
+		}
+	}
+}
+
+// signal never blocks: a pending signal already tells the watcher to reload.
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,222 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// auditExportBatchSize is how many events an export reads per query.
+const auditExportBatchSize = 500
+
+var auditEventColumns = []any{
+	"id",
+	"actor_id",
+	"principal_type",
+	"operation",
+	"entity_type",
+	"entity_id",
+	"before",
+	"after",
+	"ip_address",
+	"user_agent",
+	"request_id",
+	"outcome",
+	"error_reason",
+	"created_at",
+}
+
+type auditRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewAuditRepository(db *pgxpool.Pool) biz.AuditRepository {
+	return &auditRepo{
+		db: db,
+	}
+}
+
+func (r *auditRepo) Create(ctx context.Context, event *biz.AuditEvent) error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.Must(uuid.NewV7())
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	query, args, err := goqu.Insert("audit_events").Rows(goqu.Record{
+		"id":             event.ID,
+		"actor_id":       event.ActorID,
+		"principal_type": event.PrincipalType,
+		"operation":      event.Operation,
+		"entity_type":    event.EntityType,
+		"entity_id":      event.EntityID,
+		"before":         event.Before,
+		"after":          event.After,
+		"ip_address":     event.IPAddress,
+		"user_agent":     event.UserAgent,
+		"request_id":     event.RequestID,
+		"outcome":        event.Outcome,
+		"error_reason":   event.ErrorReason,
+		"created_at":     event.CreatedAt,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to create audit event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *auditRepo) List(ctx context.Context, filter biz.AuditEventFilter, pagination biz.PaginationRequest) ([]*biz.AuditEvent, *biz.PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	conditions := auditConditions(filter)
+
+	query, args, err := goqu.Select(goqu.COUNT("*")).
+		From("audit_events").
+		Where(conditions...).
+		ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var totalCount int32
+	if err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count audit events: %w", err)
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	events, err := r.query(ctx, goqu.Select(auditEventColumns...).
+		From("audit_events").
+		Where(conditions...).
+		Order(goqu.C("created_at").Desc(), goqu.C("id").Desc()).
+		Limit(uint(pagination.PageSize)).
+		Offset(uint(offset)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, paginationResponse(pagination, totalCount), nil
+}
+
+// Export pages through the matching events by their position rather than an offset,
+// so a long export neither slows down nor skips events written while it runs.
+func (r *auditRepo) Export(ctx context.Context, filter biz.AuditEventFilter, fn func(*biz.AuditEvent) error) error {
+	conditions := auditConditions(filter)
+
+	var last *biz.AuditEvent
+	for {
+		where := conditions
+		if last != nil {
+			where = append(where[:len(where):len(where)], goqu.L("(created_at, id) < (?, ?)", last.CreatedAt, last.ID))
+		}
+
+		events, err := r.query(ctx, goqu.Select(auditEventColumns...).
+			From("audit_events").
+			Where(where...).
+			Order(goqu.C("created_at").Desc(), goqu.C("id").Desc()).
+			Limit(auditExportBatchSize))
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		if len(events) < auditExportBatchSize {
+			return nil
+		}
+		last = events[len(events)-1]
+	}
+}
+
+func auditConditions(filter biz.AuditEventFilter) []exp.Expression {
+	var conditions []exp.Expression
+	if filter.ActorID != nil {
+		conditions = append(conditions, goqu.C("actor_id").Eq(*filter.ActorID))
+	}
+	if filter.PrincipalType != nil {
+		conditions = append(conditions, goqu.C("principal_type").Eq(*filter.PrincipalType))
+	}
+	if filter.Operation != nil {
+		conditions = append(conditions, goqu.C("operation").Eq(*filter.Operation))
+	}
+	if filter.EntityType != nil {
+		conditions = append(conditions, goqu.C("entity_type").Eq(*filter.EntityType))
+	}
+	if filter.EntityID != nil {
+		conditions = append(conditions, goqu.C("entity_id").Eq(*filter.EntityID))
+	}
+	if filter.Outcome != nil {
+		conditions = append(conditions, goqu.C("outcome").Eq(*filter.Outcome))
+	}
+	if filter.RequestID != nil {
+		conditions = append(conditions, goqu.C("request_id").Eq(*filter.RequestID))
+	}
+	if filter.From != nil {
+		conditions = append(conditions, goqu.C("created_at").Gte(*filter.From))
+	}
+	if filter.To != nil {
+		conditions = append(conditions, goqu.C("created_at").Lt(*filter.To))
+	}
+	return conditions
+}
+
+func (r *auditRepo) query(ctx context.Context, ds *goqu.SelectDataset) ([]*biz.AuditEvent, error) {
+	query, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit events: %w", err)
+	}
+
+	events, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.AuditEvent, error) {
+		return scanAuditEvent(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan audit event: %w", err)
+	}
+
+	return events, nil
+}
+
+func scanAuditEvent(row pgx.Row) (*biz.AuditEvent, error) {
+	var event biz.AuditEvent
+	err := row.Scan(
+		&event.ID,
+		&event.ActorID,
+		&event.PrincipalType,
+		&event.Operation,
+		&event.EntityType,
+		&event.EntityID,
+		&event.Before,
+		&event.After,
+		&event.IPAddress,
+		&event.UserAgent,
+		&event.RequestID,
+		&event.Outcome,
+		&event.ErrorReason,
+		&event.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
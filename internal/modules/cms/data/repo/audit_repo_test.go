@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+func TestAuditRepo_Export(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewAuditRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+	programID := uuid.New()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// More events than one export query reads, a minute apart, the odd ones by the test user
+	var ids []uuid.UUID
+	for i := range auditExportBatchSize + 10 {
+		event := &biz.AuditEvent{
+			PrincipalType: biz.PrincipalSystem,
+			Operation:     "UpdateProgram",
+			EntityType:    biz.EntityTypeProgram,
+			EntityID:      &programID,
+			Outcome:       biz.AuditOutcomeSuccess,
+			CreatedAt:     start.Add(time.Duration(i) * time.Minute),
+		}
+		if i%2 == 1 {
+			event.ActorID = &userID
+			event.PrincipalType = biz.PrincipalUser
+		}
+		if i%5 == 0 {
+			event.Outcome = biz.AuditOutcomeFailure
+			event.ErrorReason = "PROGRAM_NOT_FOUND"
+		}
+		AssertNoError(t, repo.Create(ctx, event), "creating audit event")
+		ids = append(ids, event.ID)
+	}
+	// Events written at the same time are ordered by id
+	tied := []*biz.AuditEvent{
+		{ID: uuid.Must(uuid.NewV7()), PrincipalType: biz.PrincipalSystem, Operation: "DeleteProgram", Outcome: biz.AuditOutcomeSuccess, CreatedAt: start.Add(-time.Hour)},
+		{ID: uuid.Must(uuid.NewV7()), PrincipalType: biz.PrincipalSystem, Operation: "DeleteProgram", Outcome: biz.AuditOutcomeSuccess, CreatedAt: start.Add(-time.Hour)},
+	}
+	for _, event := range tied {
+		AssertNoError(t, repo.Create(ctx, event), "creating audit event")
+	}
+	ids = append([]uuid.UUID{tied[0].ID, tied[1].ID}, ids...)
+	slices.Reverse(ids)
+
+	export := func(t *testing.T, filter biz.AuditEventFilter) []*biz.AuditEvent {
+		t.Helper()
+		var events []*biz.AuditEvent
+		AssertNoError(t, repo.Export(ctx, filter, func(event *biz.AuditEvent) error {
+			events = append(events, event)
+			return nil
+		}), "exporting audit events")
+		return events
+	}
+	idsOf := func(events []*biz.AuditEvent) []uuid.UUID {
+		out := make([]uuid.UUID, 0, len(events))
+		for _, event := range events {
+			out = append(out, event.ID)
+		}
+		return out
+	}
+
+	t.Run("NewestFirstAcrossBatches", func(t *testing.T) {
+		got := idsOf(export(t, biz.AuditEventFilter{}))
+		if !slices.Equal(got, ids) {
+			t.Errorf("Expected %d events newest first, got %d in another order", len(ids), len(got))
+		}
+	})
+
+	t.Run("Filtered", func(t *testing.T) {
+		failure := biz.AuditOutcomeFailure
+		from := start.Add(100 * time.Minute)
+		to := start.Add(200 * time.Minute)
+		events := export(t, biz.AuditEventFilter{ActorID: &userID, Outcome: &failure, From: &from, To: &to})
+
+		// Minutes 100 to 199 that are odd and a multiple of five: 105, 115, ... 195
+		if len(events) != 10 {
+			t.Fatalf("Expected 10 events, got %d", len(events))
+		}
+		for i, event := range events {
+			want := start.Add(time.Duration(195-10*i) * time.Minute)
+			if !event.CreatedAt.Equal(want) {
+				t.Errorf("Expected event %d at %v, got %v", i, want, event.CreatedAt)
+			}
+			if event.ActorID == nil || *event.ActorID != userID || event.Outcome != failure || event.ErrorReason != "PROGRAM_NOT_FOUND" {
+				t.Errorf("Expected a failure by the test user, got %+v", event)
+			}
+		}
+	})
+
+	t.Run("ByOperation", func(t *testing.T) {
+		operation := "DeleteProgram"
+		got := idsOf(export(t, biz.AuditEventFilter{Operation: &operation}))
+		if !slices.Equal(got, []uuid.UUID{tied[1].ID, tied[0].ID}) {
+			t.Errorf("Expected the two deletions by descending id, got %v", got)
+		}
+	})
+
+	t.Run("StopsOnError", func(t *testing.T) {
+		var seen int
+		err := repo.Export(ctx, biz.AuditEventFilter{}, func(*biz.AuditEvent) error {
+			seen++
+			if seen == 3 {
+				return biz.ErrAdminRequired
+			}
+			return nil
+		})
+		if !errors.Is(err, biz.ErrAdminRequired) || seen != 3 {
+			t.Errorf("Expected the export to stop at the third event with its error, got %v after %d", err, seen)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		events, pagination, err := repo.List(ctx, biz.AuditEventFilter{EntityID: &programID}, biz.PaginationRequest{Page: 2, PageSize: 100})
+		AssertNoError(t, err, "listing audit events")
+		if pagination.TotalCount != int32(auditExportBatchSize+10) {
+			t.Errorf("Expected %d events, got %d", auditExportBatchSize+10, pagination.TotalCount)
+		}
+		if !slices.Equal(idsOf(events), ids[100:200]) {
+			t.Errorf("Expected the second page of events newest first")
+		}
+	})
+}
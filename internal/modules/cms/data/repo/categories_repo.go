@@ -16,6 +16,18 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+var categoryColumns = []any{
+	"id",
+	"name",
+	"description",
+	"type",
+	"parent_id",
+	"created_at",
+	"updated_at",
+	"created_by",
+	"metadata",
+}
+
 type categoryRepo struct {
 	db    *pgxpool.Pool
 	table string
@@ -41,6 +53,7 @@ func (r *categoryRepo) Create(ctx context.Context, category *biz.Category) error
 		"name":        category.Name,
 		"description": category.Description,
 		"type":        category.Type,
+		"parent_id":   category.ParentID,
 		"created_at":  category.CreatedAt,
 		"updated_at":  category.UpdatedAt,
 		"created_by":  category.CreatedBy,
@@ -50,13 +63,16 @@ func (r *categoryRepo) Create(ctx context.Context, category *biz.Category) error
 		return fmt.Errorf("failed to build insert query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			if pgErr.Code == "23505" && pgErr.ConstraintName == "categories_name_key" {
 				return biz.ErrCategoryAlreadyExists
 			}
+			if pgErr.Code == "23503" && pgErr.ConstraintName == "categories_parent_id_fkey" {
+				return biz.ErrCategoryNotFound
+			}
 		}
 		return fmt.Errorf("failed to insert category: %w", err)
 	}
@@ -86,12 +102,13 @@ func (r *categoryRepo) Update(ctx context.Context, userID, id uuid.UUID, updates
 		Set(updateRecord).
 		Where(goqu.C("id").Eq(id)).
 		Where(goqu.C("created_by").Eq(userID)).
+		Where(notDeleted()).
 		ToSQL()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build update query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -105,55 +122,70 @@ func (r *categoryRepo) Update(ctx context.Context, userID, id uuid.UUID, updates
 	return r.GetByID(ctx, id)
 }
 
+// Delete moves the category to the trash; PurgeTrash removes it for good. Categories
+// still used by programs or holding subcategories outside the trash cannot be deleted.
 func (r *categoryRepo) Delete(ctx context.Context, userID, id uuid.UUID) error {
-	query, args, err := goqu.Delete("categories").
+	inUse := goqu.From("program_categories").
+		Select(goqu.L("1")).
+		Join(goqu.T("programs"), goqu.On(goqu.I("programs.id").Eq(goqu.I("program_categories.program_id")))).
+		Where(
+			goqu.I("program_categories.category_id").Eq(goqu.I("categories.id")),
+			goqu.I("programs.deleted_at").IsNull(),
+		)
+	hasChildren := goqu.From(goqu.T("categories").As("child")).
+		Select(goqu.L("1")).
+		Where(
+			goqu.I("child.parent_id").Eq(goqu.I("categories.id")),
+			goqu.I("child.deleted_at").IsNull(),
+		)
+
+	query, args, err := goqu.Update("categories").
+		Set(goqu.Record{"deleted_at": time.Now()}).
 		Where(goqu.C("id").Eq(id)).
 		Where(goqu.C("created_by").Eq(userID)).
+		Where(notDeleted()).
+		Returning(goqu.L("EXISTS ?", inUse), goqu.L("EXISTS ?", hasChildren)).
 		ToSQL()
 	if err != nil {
 		return fmt.Errorf("failed to build delete query: %w", err)
 	}
 
-	result, err := r.db.Exec(ctx, query, args...)
+	tx, err := conn(ctx, r.db).Begin(ctx)
 	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var used, parent bool
+	if err := tx.QueryRow(ctx, query, args...).Scan(&used, &parent); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("category not found")
+		}
 		return fmt.Errorf("failed to delete category: %w", err)
 	}
+	if used {
+		return biz.ErrCategoryInUse
+	}
+	if parent {
+		return biz.ErrCategoryHasChildren
+	}
 
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("category not found")
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
 	}
 
 	return nil
 }
 
 func (r *categoryRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Category, error) {
-	query, args, err := goqu.Select(
-		"id",
-		"name",
-		"description",
-		"type",
-		"created_at",
-		"updated_at",
-		"created_by",
-		"metadata",
-	).From("categories").
-		Where(goqu.C("id").Eq(id)).
+	query, args, err := goqu.Select(categoryColumns...).From("categories").
+		Where(goqu.C("id").Eq(id), notDeleted()).
 		ToSQL()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	var category biz.Category
-	err = r.db.QueryRow(ctx, query, args...).Scan(
-		&category.ID,
-		&category.Name,
-		&category.Description,
-		&category.Type,
-		&category.CreatedAt,
-		&category.UpdatedAt,
-		&category.CreatedBy,
-		&category.Metadata,
-	)
+	category, err := scanCategory(conn(ctx, r.db).QueryRow(ctx, query, args...))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, biz.ErrCategoryNotFound
@@ -161,13 +193,44 @@ func (r *categoryRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Category
 		return nil, fmt.Errorf("failed to scan category: %w", err)
 	}
 
-	return &category, nil
+	return category, nil
+}
+
+// GetByIDs returns the categories matching ids in no particular order; unknown ids are skipped.
+func (r *categoryRepo) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*biz.Category, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := goqu.Select(categoryColumns...).From("categories").
+		Where(goqu.C("id").In(ids), notDeleted()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*biz.Category
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, rows.Err()
 }
 
 func (r *categoryRepo) List(ctx context.Context, filter biz.CategoryFilter, pagination biz.PaginationRequest, sort biz.SortRequest) ([]*biz.Category, *biz.PaginationResponse, error) {
 	pagination.SetDefaults()
 
-	var conditions []exp.Expression
+	conditions := []exp.Expression{notDeleted()}
 
 	if filter.Type != nil {
 		conditions = append(conditions, goqu.C("type").Eq(*filter.Type))
@@ -195,7 +258,7 @@ func (r *categoryRepo) List(ctx context.Context, filter biz.CategoryFilter, pagi
 	}
 
 	var totalCount int32
-	err = r.db.QueryRow(ctx, countQuery, countArgs...).Scan(&totalCount)
+	err = conn(ctx, r.db).QueryRow(ctx, countQuery, countArgs...).Scan(&totalCount)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to count categories: %w", err)
 	}
@@ -217,16 +280,7 @@ func (r *categoryRepo) List(ctx context.Context, filter biz.CategoryFilter, pagi
 	limit := pagination.PageSize
 
 	// Build final query
-	selectQuery, selectArgs, err := goqu.Select(
-		"id",
-		"name",
-		"description",
-		"type",
-		"created_at",
-		"updated_at",
-		"created_by",
-		"metadata",
-	).From("categories").
+	selectQuery, selectArgs, err := goqu.Select(categoryColumns...).From("categories").
 		Where(conditions...).
 		Order(orderBy).
 		Limit(uint(limit)).
@@ -236,7 +290,7 @@ func (r *categoryRepo) List(ctx context.Context, filter biz.CategoryFilter, pagi
 		return nil, nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	rows, err := r.db.Query(ctx, selectQuery, selectArgs...)
+	rows, err := conn(ctx, r.db).Query(ctx, selectQuery, selectArgs...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to query categories: %w", err)
 	}
@@ -244,21 +298,11 @@ func (r *categoryRepo) List(ctx context.Context, filter biz.CategoryFilter, pagi
 
 	var categories []*biz.Category
 	for rows.Next() {
-		var category biz.Category
-		err := rows.Scan(
-			&category.ID,
-			&category.Name,
-			&category.Description,
-			&category.Type,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-			&category.CreatedBy,
-			&category.Metadata,
-		)
+		category, err := scanCategory(rows)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to scan category: %w", err)
 		}
-		categories = append(categories, &category)
+		categories = append(categories, category)
 	}
 
 	// Calculate pagination response
@@ -272,3 +316,122 @@ func (r *categoryRepo) List(ctx context.Context, filter biz.CategoryFilter, pagi
 
 	return categories, paginationResponse, nil
 }
+
+func (r *categoryRepo) Tree(ctx context.Context, rootID *uuid.UUID) ([]*biz.Category, error) {
+	start := "parent_id IS NULL"
+	var args []any
+	if rootID != nil {
+		start = "id = $1"
+		args = append(args, *rootID)
+	}
+
+	query := fmt.Sprintf(`
+		WITH RECURSIVE tree AS (
+			SELECT id, name, description, type, parent_id, created_at, updated_at, created_by, metadata, 0 AS depth
+			FROM categories
+			WHERE %s AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, c.name, c.description, c.type, c.parent_id, c.created_at, c.updated_at, c.created_by, c.metadata, tree.depth + 1
+			FROM categories c
+			JOIN tree ON c.parent_id = tree.id
+			WHERE c.deleted_at IS NULL
+		)
+		SELECT id, name, description, type, parent_id, created_at, updated_at, created_by, metadata
+		FROM tree
+		ORDER BY depth, name
+	`, start)
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query category tree: %w", err)
+	}
+
+	categories, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.Category, error) {
+		return scanCategory(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan category: %w", err)
+	}
+
+	return categories, nil
+}
+
+// Move serializes with other moves through an advisory lock, so two moves cannot each
+// pass the cycle check and together close a loop.
+func (r *categoryRepo) Move(ctx context.Context, userID, id uuid.UUID, parentID *uuid.UUID) (*biz.Category, error) {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('category_tree'))"); err != nil {
+		return nil, fmt.Errorf("failed to lock category tree: %w", err)
+	}
+
+	if parentID != nil {
+		var exists, cycle bool
+		err := tx.QueryRow(ctx, `
+			WITH RECURSIVE ancestors AS (
+				SELECT id, parent_id FROM categories WHERE id = $1 AND deleted_at IS NULL
+				UNION ALL
+				SELECT c.id, c.parent_id FROM categories c JOIN ancestors a ON c.id = a.parent_id
+			)
+			SELECT EXISTS (SELECT 1 FROM ancestors), EXISTS (SELECT 1 FROM ancestors WHERE id = $2)
+		`, *parentID, id).Scan(&exists, &cycle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check category ancestors: %w", err)
+		}
+		if !exists {
+			return nil, biz.ErrCategoryNotFound
+		}
+		if cycle {
+			return nil, biz.ErrCategoryCycle
+		}
+	}
+
+	query, args, err := goqu.Update("categories").
+		Set(goqu.Record{"parent_id": parentID, "updated_at": time.Now()}).
+		Where(
+			goqu.C("id").Eq(id),
+			goqu.C("created_by").Eq(userID),
+			notDeleted(),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build move query: %w", err)
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move category: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, biz.ErrCategoryNotFound
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit category move: %w", err)
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func scanCategory(row pgx.Row) (*biz.Category, error) {
+	var category biz.Category
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.Description,
+		&category.Type,
+		&category.ParentID,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+		&category.CreatedBy,
+		&category.Metadata,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"thmanyah/internal/modules/cms/biz"
@@ -235,3 +236,146 @@ func TestCategoryRepo_CRUDJourney(t *testing.T) {
 		}
 	})
 }
+
+func TestCategoryRepo_Move(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewCategoryRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	create := func(name string) *biz.Category {
+		category := &biz.Category{Name: name, Type: biz.CategoryTypePodcast, CreatedBy: userID}
+		AssertNoError(t, repo.Create(ctx, category), "creating category "+name)
+		return category
+	}
+	root := create("Move Root")
+	child := create("Move Child")
+	grandchild := create("Move Grandchild")
+
+	t.Run("UnderParent", func(t *testing.T) {
+		moved, err := repo.Move(ctx, userID, child.ID, &root.ID)
+		AssertNoError(t, err, "moving child under root")
+		if moved.ParentID == nil || *moved.ParentID != root.ID {
+			t.Errorf("Expected parent %s, got %v", root.ID, moved.ParentID)
+		}
+
+		_, err = repo.Move(ctx, userID, grandchild.ID, &child.ID)
+		AssertNoError(t, err, "moving grandchild under child")
+	})
+
+	t.Run("UnderItself", func(t *testing.T) {
+		_, err := repo.Move(ctx, userID, root.ID, &root.ID)
+		if !errors.Is(err, biz.ErrCategoryCycle) {
+			t.Errorf("Expected ErrCategoryCycle, got %v", err)
+		}
+	})
+
+	t.Run("UnderDescendant", func(t *testing.T) {
+		_, err := repo.Move(ctx, userID, root.ID, &grandchild.ID)
+		if !errors.Is(err, biz.ErrCategoryCycle) {
+			t.Errorf("Expected ErrCategoryCycle, got %v", err)
+		}
+
+		// The tree is left as it was
+		unchanged, err := repo.GetByID(ctx, root.ID)
+		AssertNoError(t, err, "getting root")
+		if unchanged.ParentID != nil {
+			t.Errorf("Expected root to stay at the top, got parent %v", unchanged.ParentID)
+		}
+	})
+
+	t.Run("UnderSibling", func(t *testing.T) {
+		sibling := create("Move Sibling")
+		_, err := repo.Move(ctx, userID, sibling.ID, &grandchild.ID)
+		AssertNoError(t, err, "moving sibling under grandchild")
+	})
+
+	t.Run("ToTop", func(t *testing.T) {
+		moved, err := repo.Move(ctx, userID, grandchild.ID, nil)
+		AssertNoError(t, err, "moving grandchild to the top")
+		if moved.ParentID != nil {
+			t.Errorf("Expected no parent, got %v", moved.ParentID)
+		}
+	})
+
+	t.Run("ParentNotFound", func(t *testing.T) {
+		missing := uuid.New()
+		_, err := repo.Move(ctx, userID, child.ID, &missing)
+		if !errors.Is(err, biz.ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+
+	t.Run("ParentDeleted", func(t *testing.T) {
+		deleted := create("Move Deleted Parent")
+		AssertNoError(t, repo.Delete(ctx, userID, deleted.ID), "deleting category")
+
+		_, err := repo.Move(ctx, userID, child.ID, &deleted.ID)
+		if !errors.Is(err, biz.ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+
+	t.Run("CategoryNotFound", func(t *testing.T) {
+		_, err := repo.Move(ctx, userID, uuid.New(), &root.ID)
+		if !errors.Is(err, biz.ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		_, err := repo.Move(ctx, uuid.New(), child.ID, nil)
+		if !errors.Is(err, biz.ErrCategoryNotFound) {
+			t.Errorf("Expected ErrCategoryNotFound, got %v", err)
+		}
+	})
+}
+
+func TestCategoryRepo_GetByIDs(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewCategoryRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	var categories []*biz.Category
+	for _, name := range []string{"Batch Get One", "Batch Get Two", "Batch Get Trashed"} {
+		category := &biz.Category{Name: name, Type: biz.CategoryTypePodcast, CreatedBy: userID}
+		AssertNoError(t, repo.Create(ctx, category), "creating category "+name)
+		categories = append(categories, category)
+	}
+	trashed := categories[2]
+	AssertNoError(t, repo.Delete(ctx, userID, trashed.ID), "trashing category")
+
+	t.Run("SkipsUnknownAndTrashed", func(t *testing.T) {
+		found, err := repo.GetByIDs(ctx, []uuid.UUID{categories[1].ID, uuid.New(), trashed.ID, categories[0].ID})
+		AssertNoError(t, err, "getting categories by IDs")
+
+		got := make(map[uuid.UUID]bool, len(found))
+		for _, category := range found {
+			got[category.ID] = true
+		}
+		if len(found) != 2 || !got[categories[0].ID] || !got[categories[1].ID] {
+			t.Errorf("Expected categories %s and %s, got %d categories", categories[0].ID, categories[1].ID, len(found))
+		}
+	})
+
+	t.Run("DuplicateIDs", func(t *testing.T) {
+		found, err := repo.GetByIDs(ctx, []uuid.UUID{categories[0].ID, categories[0].ID})
+		AssertNoError(t, err, "getting duplicate category IDs")
+		if len(found) != 1 || found[0].ID != categories[0].ID {
+			t.Errorf("Expected category %s once, got %d categories", categories[0].ID, len(found))
+		}
+	})
+
+	t.Run("NoIDs", func(t *testing.T) {
+		found, err := repo.GetByIDs(ctx, nil)
+		AssertNoError(t, err, "getting no categories")
+		if len(found) != 0 {
+			t.Errorf("Expected no categories, got %d", len(found))
+		}
+	})
+}
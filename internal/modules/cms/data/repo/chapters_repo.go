@@ -0,0 +1,255 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var chapterColumns = []any{
+	"id",
+	"episode_id",
+	"start_seconds",
+	"end_seconds",
+	"title",
+	"image_url",
+	"url",
+	"created_at",
+	"updated_at",
+}
+
+type chapterRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewChapterRepository(db *pgxpool.Pool) biz.ChapterRepository {
+	return &chapterRepo{
+		db: db,
+	}
+}
+
+func chapterRecord(chapter *biz.Chapter) goqu.Record {
+	return goqu.Record{
+		"id":            chapter.ID,
+		"episode_id":    chapter.EpisodeID,
+		"start_seconds": chapter.StartSeconds,
+		"end_seconds":   chapter.EndSeconds,
+		"title":         chapter.Title,
+		"image_url":     chapter.ImageURL,
+		"url":           chapter.URL,
+		"created_at":    chapter.CreatedAt,
+		"updated_at":    chapter.UpdatedAt,
+	}
+}
+
+func (r *chapterRepo) Create(ctx context.Context, chapter *biz.Chapter) error {
+	query, args, err := goqu.Insert("chapters").Rows(chapterRecord(chapter)).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" && pgErr.ConstraintName == "chapters_episode_id_fkey" {
+			return biz.ErrEpisodeNotFound
+		}
+		return fmt.Errorf("failed to insert chapter: %w", err)
+	}
+
+	return nil
+}
+
+func (r *chapterRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Chapter, error) {
+	query, args, err := goqu.Select(chapterColumns...).
+		From("chapters").
+		Where(goqu.C("id").Eq(id)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	chapter, err := scanChapter(conn(ctx, r.db).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, biz.ErrChapterNotFound
+		}
+		return nil, fmt.Errorf("failed to scan chapter: %w", err)
+	}
+
+	return chapter, nil
+}
+
+func (r *chapterRepo) List(ctx context.Context, episodeID uuid.UUID) ([]*biz.Chapter, error) {
+	chapters, err := r.ListByEpisodes(ctx, []uuid.UUID{episodeID})
+	if err != nil {
+		return nil, err
+	}
+
+	if chapters[episodeID] == nil {
+		return []*biz.Chapter{}, nil
+	}
+	return chapters[episodeID], nil
+}
+
+func (r *chapterRepo) ListForUpdate(ctx context.Context, episodeID uuid.UUID) ([]*biz.Chapter, error) {
+	// Locking the episode rather than its chapters also holds back the first chapter
+	var one int
+	err := conn(ctx, r.db).QueryRow(ctx, "SELECT 1 FROM episodes WHERE id = $1 FOR UPDATE", episodeID).Scan(&one)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, biz.ErrEpisodeNotFound
+		}
+		return nil, fmt.Errorf("failed to lock episode: %w", err)
+	}
+
+	return r.List(ctx, episodeID)
+}
+
+func (r *chapterRepo) ListByEpisodes(ctx context.Context, episodeIDs []uuid.UUID) (map[uuid.UUID][]*biz.Chapter, error) {
+	if len(episodeIDs) == 0 {
+		return map[uuid.UUID][]*biz.Chapter{}, nil
+	}
+
+	query, args, err := goqu.Select(chapterColumns...).
+		From("chapters").
+		Where(goqu.C("episode_id").In(episodeIDs)).
+		Order(goqu.C("episode_id").Asc(), goqu.C("start_seconds").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query chapters: %w", err)
+	}
+
+	chapters, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.Chapter, error) {
+		return scanChapter(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan chapters: %w", err)
+	}
+
+	byEpisode := make(map[uuid.UUID][]*biz.Chapter, len(episodeIDs))
+	for _, chapter := range chapters {
+		byEpisode[chapter.EpisodeID] = append(byEpisode[chapter.EpisodeID], chapter)
+	}
+
+	return byEpisode, nil
+}
+
+func (r *chapterRepo) Update(ctx context.Context, chapter *biz.Chapter) error {
+	chapter.UpdatedAt = time.Now()
+
+	query, args, err := goqu.Update("chapters").
+		Set(goqu.Record{
+			"start_seconds": chapter.StartSeconds,
+			"end_seconds":   chapter.EndSeconds,
+			"title":         chapter.Title,
+			"image_url":     chapter.ImageURL,
+			"url":           chapter.URL,
+			"updated_at":    chapter.UpdatedAt,
+		}).
+		Where(goqu.C("id").Eq(chapter.ID)).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update chapter: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return biz.ErrChapterNotFound
+	}
+
+	return nil
+}
+
+func (r *chapterRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	query, args, err := goqu.Delete("chapters").Where(goqu.C("id").Eq(id)).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete chapter: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return biz.ErrChapterNotFound
+	}
+
+	return nil
+}
+
+func (r *chapterRepo) Replace(ctx context.Context, episodeID uuid.UUID, chapters []*biz.Chapter) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := goqu.Delete("chapters").Where(goqu.C("episode_id").Eq(episodeID)).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete chapters: %w", err)
+	}
+
+	if len(chapters) > 0 {
+		rows := make([]any, 0, len(chapters))
+		for _, chapter := range chapters {
+			rows = append(rows, chapterRecord(chapter))
+		}
+
+		query, args, err = goqu.Insert("chapters").Rows(rows...).ToSQL()
+		if err != nil {
+			return fmt.Errorf("failed to build insert query: %w", err)
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" && pgErr.ConstraintName == "chapters_episode_id_fkey" {
+				return biz.ErrEpisodeNotFound
+			}
+			return fmt.Errorf("failed to insert chapters: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func scanChapter(row pgx.Row) (*biz.Chapter, error) {
+	var chapter biz.Chapter
+	err := row.Scan(
+		&chapter.ID,
+		&chapter.EpisodeID,
+		&chapter.StartSeconds,
+		&chapter.EndSeconds,
+		&chapter.Title,
+		&chapter.ImageURL,
+		&chapter.URL,
+		&chapter.CreatedAt,
+		&chapter.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &chapter, nil
+}
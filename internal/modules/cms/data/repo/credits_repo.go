@@ -0,0 +1,294 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var creditColumns = []any{
+	"id",
+	"person_id",
+	"content_type",
+	"content_id",
+	"role",
+	"sort_order",
+	"created_at",
+	"updated_at",
+}
+
+type creditRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewCreditRepository(db *pgxpool.Pool) biz.CreditRepository {
+	return &creditRepo{
+		db: db,
+	}
+}
+
+func (r *creditRepo) Create(ctx context.Context, credit *biz.Credit) error {
+	query, args, err := goqu.Insert("credits").Rows(goqu.Record{
+		"id":           credit.ID,
+		"person_id":    credit.PersonID,
+		"content_type": credit.ContentType,
+		"content_id":   credit.ContentID,
+		"role":         credit.Role,
+		"sort_order":   credit.SortOrder,
+		"created_at":   credit.CreatedAt,
+		"updated_at":   credit.UpdatedAt,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, args...); err != nil {
+		return creditError(err)
+	}
+
+	return nil
+}
+
+func (r *creditRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Credit, error) {
+	query, args, err := goqu.From("credits").Select(creditColumns...).Where(goqu.C("id").Eq(id)).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	credit, err := scanCredit(conn(ctx, r.db).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, biz.ErrCreditNotFound
+		}
+		return nil, fmt.Errorf("failed to scan credit: %w", err)
+	}
+
+	return credit, nil
+}
+
+func (r *creditRepo) ListByContent(ctx context.Context, contentType biz.ContentType, contentID uuid.UUID) ([]*biz.Credit, error) {
+	columns := make([]any, 0, len(creditColumns)+len(personColumns))
+	for _, column := range creditColumns {
+		columns = append(columns, goqu.T("c").Col(column))
+	}
+	for _, column := range personColumns {
+		columns = append(columns, goqu.T("p").Col(column))
+	}
+
+	query, args, err := goqu.From(goqu.T("credits").As("c")).
+		Join(goqu.T("people").As("p"), goqu.On(goqu.T("p").Col("id").Eq(goqu.T("c").Col("person_id")))).
+		Select(columns...).
+		Where(
+			goqu.T("c").Col("content_type").Eq(contentType),
+			goqu.T("c").Col("content_id").Eq(contentID),
+		).
+		Order(goqu.T("c").Col("sort_order").Asc(), goqu.T("c").Col("created_at").Asc(), goqu.T("c").Col("id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query credits: %w", err)
+	}
+
+	credits, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.Credit, error) {
+		var credit biz.Credit
+		var person biz.Person
+		err := row.Scan(
+			&credit.ID,
+			&credit.PersonID,
+			&credit.ContentType,
+			&credit.ContentID,
+			&credit.Role,
+			&credit.SortOrder,
+			&credit.CreatedAt,
+			&credit.UpdatedAt,
+			&person.ID,
+			&person.Name,
+			&person.Bio,
+			&person.PhotoURL,
+			&person.Links,
+			&person.CreatedBy,
+			&person.CreatedAt,
+			&person.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		credit.Person = &person
+		return &credit, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan credits: %w", err)
+	}
+
+	return credits, nil
+}
+
+func (r *creditRepo) ListByPerson(ctx context.Context, personID uuid.UUID, listener *biz.Listener, pagination biz.PaginationRequest) ([]*biz.Credit, *biz.PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	conditions := []exp.Expression{goqu.C("person_id").Eq(personID)}
+	if listener != nil {
+		available := availableTo(listener)
+		conditions = append(conditions, goqu.Or(
+			goqu.And(
+				goqu.C("content_type").Eq(biz.ContentTypeProgram),
+				goqu.C("content_id").In(goqu.From("programs").Select("id").Where(
+					goqu.C("status").Eq(biz.ProgramStatusPublished),
+					goqu.C("deleted_at").IsNull(),
+					available,
+				)),
+			),
+			goqu.And(
+				goqu.C("content_type").Eq(biz.ContentTypeEpisode),
+				goqu.C("content_id").In(goqu.From("episodes").Select("id").Where(
+					goqu.C("status").Eq(biz.EpisodeStatusPublished),
+					goqu.C("deleted_at").IsNull(),
+					available,
+					goqu.C("program_id").In(goqu.From("programs").Select("id").Where(
+						goqu.C("status").Eq(biz.ProgramStatusPublished),
+						goqu.C("deleted_at").IsNull(),
+						available,
+					)),
+				)),
+			),
+		))
+	}
+
+	query, args, err := goqu.Select(goqu.COUNT("*")).
+		From("credits").
+		Where(conditions...).
+		ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var totalCount int32
+	if err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count credits: %w", err)
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	query, args, err = goqu.From("credits").
+		Select(creditColumns...).
+		Where(conditions...).
+		Order(goqu.C("created_at").Desc(), goqu.C("id").Desc()).
+		Limit(uint(pagination.PageSize)).
+		Offset(uint(offset)).
+		ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query credits: %w", err)
+	}
+
+	credits, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.Credit, error) {
+		return scanCredit(row)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to scan credits: %w", err)
+	}
+
+	return credits, paginationResponse(pagination, totalCount), nil
+}
+
+func (r *creditRepo) Update(ctx context.Context, id uuid.UUID, updates *biz.UpdateCreditRequest) (*biz.Credit, error) {
+	record := goqu.Record{
+		"updated_at": time.Now(),
+	}
+	if updates.Role != nil {
+		record["role"] = *updates.Role
+	}
+	if updates.SortOrder != nil {
+		record["sort_order"] = *updates.SortOrder
+	}
+
+	query, args, err := goqu.Update("credits").
+		Set(record).
+		Where(goqu.C("id").Eq(id)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return nil, creditError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, biz.ErrCreditNotFound
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *creditRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	query, args, err := goqu.Delete("credits").Where(goqu.C("id").Eq(id)).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete credit: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return biz.ErrCreditNotFound
+	}
+
+	return nil
+}
+
+// availableTo is a condition on the availability column of the table in scope, see
+// content_available.
+func availableTo(listener *biz.Listener) exp.Expression {
+	return goqu.L("content_available(availability, ?)", listener.Country)
+}
+
+// creditError maps constraint violations of credits to their biz errors.
+func creditError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == "23505" && pgErr.ConstraintName == "credits_person_id_content_type_content_id_role_key" {
+			return biz.ErrCreditAlreadyExists
+		}
+		if pgErr.Code == "23503" && pgErr.ConstraintName == "credits_person_id_fkey" {
+			return biz.ErrPersonNotFound
+		}
+	}
+	return fmt.Errorf("failed to store credit: %w", err)
+}
+
+func scanCredit(row pgx.Row) (*biz.Credit, error) {
+	var credit biz.Credit
+	err := row.Scan(
+		&credit.ID,
+		&credit.PersonID,
+		&credit.ContentType,
+		&credit.ContentID,
+		&credit.Role,
+		&credit.SortOrder,
+		&credit.CreatedAt,
+		&credit.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &credit, nil
+}
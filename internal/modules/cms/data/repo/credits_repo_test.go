@@ -0,0 +1,305 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+// createPerson adds a person named name to the people directory.
+func createPerson(t *testing.T, repo biz.PersonRepository, name string) *biz.Person {
+	t.Helper()
+	now := time.Now()
+	person := &biz.Person{
+		ID:        uuid.Must(uuid.NewV7()),
+		Name:      name,
+		CreatedBy: uuid.MustParse(GetTestUserID()),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	AssertNoError(t, repo.Create(context.Background(), person), "creating person")
+	return person
+}
+
+// createCredit credits person with role on content, added at createdAt.
+func createCredit(t *testing.T, repo biz.CreditRepository, person uuid.UUID, contentType biz.ContentType, contentID uuid.UUID, role biz.CreditRole, createdAt time.Time) *biz.Credit {
+	t.Helper()
+	credit := &biz.Credit{
+		ID:          uuid.Must(uuid.NewV7()),
+		PersonID:    person,
+		ContentType: contentType,
+		ContentID:   contentID,
+		Role:        role,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+	AssertNoError(t, repo.Create(context.Background(), credit), "creating credit")
+	return credit
+}
+
+func TestCreditRepo_CRUD(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewCreditRepository(helper.Pool)
+	people := NewPersonRepository(helper.Pool)
+	programs := NewProgramRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+	now := time.Now()
+
+	program := &biz.Program{
+		Title:      "Credited Program",
+		CategoryID: uuid.MustParse(GetTestCategoryID()),
+		Status:     biz.ProgramStatusDraft,
+		CreatedBy:  userID,
+		UpdatedBy:  userID,
+	}
+	AssertNoError(t, programs.Create(ctx, program), "creating program")
+	host := createPerson(t, people, "Host")
+	guest := createPerson(t, people, "Guest")
+
+	hosting := createCredit(t, repo, host.ID, biz.ContentTypeProgram, program.ID, biz.CreditRoleHost, now)
+	producing := createCredit(t, repo, host.ID, biz.ContentTypeProgram, program.ID, biz.CreditRoleProducer, now)
+	guesting := createCredit(t, repo, guest.ID, biz.ContentTypeProgram, program.ID, biz.CreditRoleGuest, now)
+
+	t.Run("Create_Duplicate", func(t *testing.T) {
+		err := repo.Create(ctx, &biz.Credit{
+			ID:          uuid.Must(uuid.NewV7()),
+			PersonID:    host.ID,
+			ContentType: biz.ContentTypeProgram,
+			ContentID:   program.ID,
+			Role:        biz.CreditRoleHost,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+		if !errors.Is(err, biz.ErrCreditAlreadyExists) {
+			t.Errorf("Expected ErrCreditAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("Create_UnknownPerson", func(t *testing.T) {
+		err := repo.Create(ctx, &biz.Credit{
+			ID:          uuid.Must(uuid.NewV7()),
+			PersonID:    uuid.New(),
+			ContentType: biz.ContentTypeProgram,
+			ContentID:   program.ID,
+			Role:        biz.CreditRoleHost,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+		if !errors.Is(err, biz.ErrPersonNotFound) {
+			t.Errorf("Expected ErrPersonNotFound, got %v", err)
+		}
+	})
+
+	t.Run("GetByID", func(t *testing.T) {
+		credit, err := repo.GetByID(ctx, producing.ID)
+		AssertNoError(t, err, "getting credit")
+		if credit.PersonID != host.ID || credit.ContentID != program.ID || credit.Role != biz.CreditRoleProducer {
+			t.Errorf("Expected the producer credit of the host, got %+v", credit)
+		}
+
+		_, err = repo.GetByID(ctx, uuid.New())
+		if !errors.Is(err, biz.ErrCreditNotFound) {
+			t.Errorf("Expected ErrCreditNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		sortOrder := int32(-1)
+		updated, err := repo.Update(ctx, guesting.ID, &biz.UpdateCreditRequest{SortOrder: &sortOrder})
+		AssertNoError(t, err, "updating credit")
+		if updated.SortOrder != -1 || updated.Role != biz.CreditRoleGuest {
+			t.Errorf("Expected the guest credit first, got %+v", updated)
+		}
+
+		// The content is listed by sort order, with its people
+		credits, err := repo.ListByContent(ctx, biz.ContentTypeProgram, program.ID)
+		AssertNoError(t, err, "listing credits")
+		var ids []uuid.UUID
+		for _, credit := range credits {
+			ids = append(ids, credit.ID)
+			if credit.Person == nil || credit.Person.ID != credit.PersonID {
+				t.Errorf("Expected credit %s with its person, got %+v", credit.ID, credit.Person)
+			}
+		}
+		if want := []uuid.UUID{guesting.ID, hosting.ID, producing.ID}; !slices.Equal(ids, want) {
+			t.Errorf("Expected credits %v, got %v", want, ids)
+		}
+
+		role := biz.CreditRoleHost
+		_, err = repo.Update(ctx, producing.ID, &biz.UpdateCreditRequest{Role: &role})
+		if !errors.Is(err, biz.ErrCreditAlreadyExists) {
+			t.Errorf("Expected ErrCreditAlreadyExists, got %v", err)
+		}
+
+		_, err = repo.Update(ctx, uuid.New(), &biz.UpdateCreditRequest{Role: &role})
+		if !errors.Is(err, biz.ErrCreditNotFound) {
+			t.Errorf("Expected ErrCreditNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		AssertNoError(t, repo.Delete(ctx, producing.ID), "deleting credit")
+		if err := repo.Delete(ctx, producing.ID); !errors.Is(err, biz.ErrCreditNotFound) {
+			t.Errorf("Expected ErrCreditNotFound, got %v", err)
+		}
+	})
+
+	t.Run("DeletePerson", func(t *testing.T) {
+		// Credits go with their person
+		AssertNoError(t, people.Delete(ctx, guest.ID), "deleting person")
+		if _, err := repo.GetByID(ctx, guesting.ID); !errors.Is(err, biz.ErrCreditNotFound) {
+			t.Errorf("Expected the credit deleted with the person, got %v", err)
+		}
+		if _, err := people.GetByID(ctx, guest.ID); !errors.Is(err, biz.ErrPersonNotFound) {
+			t.Errorf("Expected ErrPersonNotFound, got %v", err)
+		}
+
+		count, err := helper.CountRows(ctx, "credits", "person_id = $1", host.ID)
+		AssertNoError(t, err, "counting credits")
+		if count != 1 {
+			t.Errorf("Expected the host to keep 1 credit, got %d", count)
+		}
+	})
+}
+
+func TestCreditRepo_ListByPerson(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewCreditRepository(helper.Pool)
+	programs := NewProgramRepository(helper.Pool)
+	episodes := NewEpisodeRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+	person := createPerson(t, NewPersonRepository(helper.Pool), "Host")
+	blocked := biz.Availability{BlockedCountries: []string{"SA"}}
+
+	createProgram := func(status biz.ProgramStatus, availability biz.Availability) uuid.UUID {
+		program := &biz.Program{
+			Title:        "Program",
+			CategoryID:   uuid.MustParse(GetTestCategoryID()),
+			Status:       status,
+			CreatedBy:    userID,
+			UpdatedBy:    userID,
+			Availability: availability,
+		}
+		AssertNoError(t, programs.Create(ctx, program), "creating program")
+		return program.ID
+	}
+	number := int32(0)
+	createEpisode := func(programID uuid.UUID, status biz.EpisodeStatus, availability biz.Availability) uuid.UUID {
+		number++
+		episode := &biz.Episode{
+			ProgramID:     programID,
+			Title:         "Episode",
+			EpisodeNumber: number,
+			SeasonNumber:  1,
+			Status:        status,
+			CreatedBy:     userID,
+			UpdatedBy:     userID,
+			Availability:  availability,
+		}
+		AssertNoError(t, episodes.Create(ctx, episode), "creating episode")
+		return episode.ID
+	}
+	trash := func(table string, id uuid.UUID) {
+		_, err := helper.Pool.Exec(ctx, "UPDATE "+table+" SET deleted_at = NOW() WHERE id = $1", id)
+		AssertNoError(t, err, "trashing "+table)
+	}
+
+	published := createProgram(biz.ProgramStatusPublished, biz.Availability{})
+	draft := createProgram(biz.ProgramStatusDraft, biz.Availability{})
+	trashed := createProgram(biz.ProgramStatusPublished, biz.Availability{})
+	blockedProgram := createProgram(biz.ProgramStatusPublished, blocked)
+
+	visibleEpisode := createEpisode(published, biz.EpisodeStatusPublished, biz.Availability{})
+	draftEpisode := createEpisode(published, biz.EpisodeStatusDraft, biz.Availability{})
+	blockedEpisode := createEpisode(published, biz.EpisodeStatusPublished, blocked)
+	trashedEpisode := createEpisode(published, biz.EpisodeStatusPublished, biz.Availability{})
+	onDraft := createEpisode(draft, biz.EpisodeStatusPublished, biz.Availability{})
+	onTrashed := createEpisode(trashed, biz.EpisodeStatusPublished, biz.Availability{})
+	onBlocked := createEpisode(blockedProgram, biz.EpisodeStatusPublished, biz.Availability{})
+	trash("programs", trashed)
+	trash("episodes", trashedEpisode)
+
+	// Credits added a minute apart, the visible ones first
+	start := time.Now().Add(-time.Hour)
+	var all []uuid.UUID
+	credit := func(contentType biz.ContentType, contentID uuid.UUID) uuid.UUID {
+		id := createCredit(t, repo, person.ID, contentType, contentID, biz.CreditRoleHost, start.Add(time.Duration(len(all))*time.Minute)).ID
+		all = append([]uuid.UUID{id}, all...)
+		return id
+	}
+	visible := []uuid.UUID{
+		credit(biz.ContentTypeProgram, published),
+		credit(biz.ContentTypeEpisode, visibleEpisode),
+	}
+	slices.Reverse(visible)
+	for _, id := range []uuid.UUID{draft, trashed, blockedProgram} {
+		credit(biz.ContentTypeProgram, id)
+	}
+	for _, id := range []uuid.UUID{draftEpisode, blockedEpisode, trashedEpisode, onDraft, onTrashed, onBlocked} {
+		credit(biz.ContentTypeEpisode, id)
+	}
+	// Credits of someone else are not listed
+	other := createPerson(t, NewPersonRepository(helper.Pool), "Guest")
+	createCredit(t, repo, other.ID, biz.ContentTypeProgram, published, biz.CreditRoleGuest, start)
+
+	list := func(t *testing.T, listener *biz.Listener, pagination biz.PaginationRequest) ([]uuid.UUID, *biz.PaginationResponse) {
+		t.Helper()
+		credits, paginationResp, err := repo.ListByPerson(ctx, person.ID, listener, pagination)
+		AssertNoError(t, err, "listing credits of person")
+		ids := make([]uuid.UUID, 0, len(credits))
+		for _, credit := range credits {
+			ids = append(ids, credit.ID)
+		}
+		return ids, paginationResp
+	}
+
+	t.Run("Editor", func(t *testing.T) {
+		ids, paginationResp := list(t, nil, biz.PaginationRequest{})
+		if !slices.Equal(ids, all) {
+			t.Errorf("Expected all %d credits newest first, got %d", len(all), len(ids))
+		}
+		if paginationResp.TotalCount != int32(len(all)) {
+			t.Errorf("Expected a total of %d, got %d", len(all), paginationResp.TotalCount)
+		}
+	})
+
+	t.Run("Listener", func(t *testing.T) {
+		ids, paginationResp := list(t, &biz.Listener{Country: "SA"}, biz.PaginationRequest{})
+		if !slices.Equal(ids, visible) {
+			t.Errorf("Expected only the credits on visible content %v, got %v", visible, ids)
+		}
+		if paginationResp.TotalCount != 2 {
+			t.Errorf("Expected a total of 2, got %d", paginationResp.TotalCount)
+		}
+	})
+
+	t.Run("ListenerElsewhere", func(t *testing.T) {
+		// The blocked program, its episode and the blocked episode are available
+		ids, _ := list(t, &biz.Listener{Country: "AE"}, biz.PaginationRequest{})
+		if len(ids) != 5 {
+			t.Errorf("Expected 5 credits, got %d", len(ids))
+		}
+	})
+
+	t.Run("Paginated", func(t *testing.T) {
+		ids, paginationResp := list(t, nil, biz.PaginationRequest{Page: 2, PageSize: 4})
+		if !slices.Equal(ids, all[4:8]) {
+			t.Errorf("Expected the second page of credits %v, got %v", all[4:8], ids)
+		}
+		if paginationResp.TotalPages != 3 {
+			t.Errorf("Expected 3 pages, got %d", paginationResp.TotalPages)
+		}
+	})
+}
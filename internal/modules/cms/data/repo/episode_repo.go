@@ -17,6 +17,31 @@ import (
 	"github.com/lib/pq"
 )
 
+var episodeColumns = []any{
+	"id",
+	"program_id",
+	"title",
+	"description",
+	"duration_seconds",
+	"episode_number",
+	"season_number",
+	"status",
+	"created_at",
+	"updated_at",
+	"published_at",
+	"scheduled_at",
+	"created_by",
+	"updated_by",
+	"media_url",
+	"thumbnail_url",
+	"tags",
+	"metadata",
+	"view_count",
+	"rating",
+	"season_id",
+	"availability",
+}
+
 type episodeRepo struct {
 	db    *pgxpool.Pool
 	table string
@@ -56,6 +81,7 @@ func (r *episodeRepo) Create(ctx context.Context, episode *biz.Episode) error {
 		"metadata":         episode.Metadata,
 		"view_count":       episode.ViewCount,
 		"rating":           episode.Rating,
+		"availability":     episode.Availability,
 	}
 
 	if len(episode.Tags) > 0 {
@@ -65,12 +91,13 @@ func (r *episodeRepo) Create(ctx context.Context, episode *biz.Episode) error {
 		record["tags"] = pq.Array([]string{})
 	}
 
-	query, args, err := goqu.Insert("episodes").Rows(record).ToSQL()
+	// The season comes from season_number, and is added by a trigger when it is new
+	query, args, err := goqu.Insert("episodes").Rows(record).Returning("season_id").ToSQL()
 	if err != nil {
 		return fmt.Errorf("failed to build insert query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	err = conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&episode.SeasonID)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -142,31 +169,51 @@ func (r *episodeRepo) Update(ctx context.Context, userID, id uuid.UUID, updates
 	if updates.Rating != nil {
 		updateRecord["rating"] = *updates.Rating
 	}
+	if updates.Availability != nil {
+		updateRecord["availability"] = *updates.Availability
+	}
 
 	query, args, err := goqu.Update("episodes").
 		Set(updateRecord).
 		Where(goqu.C("id").Eq(id)).
 		Where(goqu.C("created_by").Eq(userID)).
+		Where(notDeleted()).
 		ToSQL()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build update query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "episodes_program_id_season_number_episode_number_key" {
+			return nil, biz.ErrEpisodeAlreadyExists
+		}
 		return nil, fmt.Errorf("failed to update episode: %w", err)
 	}
 
 	return r.GetByID(ctx, id)
 }
 
+// Delete moves the episode to the trash; PurgeTrash removes it for good.
 func (r *episodeRepo) Delete(ctx context.Context, userID, id uuid.UUID) error {
-	status := biz.EpisodeStatusArchived
-	_, err := r.Update(ctx, userID, id, &biz.UpdateEpisodeRequest{
-		Status: &status,
-	})
+	query, args, err := goqu.Update("episodes").
+		Set(goqu.Record{"deleted_at": time.Now()}).
+		Where(goqu.C("id").Eq(id)).
+		Where(goqu.C("created_by").Eq(userID)).
+		Where(notDeleted()).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to soft delete episode: %w", err)
+		return fmt.Errorf("failed to delete episode: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("episode not found")
 	}
 
 	return nil
@@ -198,8 +245,10 @@ func (r *episodeRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Episode,
 		"metadata",
 		"view_count",
 		"rating",
+		"season_id",
+		"availability",
 	).From("episodes").
-		Where(goqu.C("id").Eq(id)).
+		Where(goqu.C("id").Eq(id), notDeleted()).
 		ToSQL()
 
 	if err != nil {
@@ -208,7 +257,7 @@ func (r *episodeRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Episode,
 
 	var episode biz.Episode
 
-	err = r.db.QueryRow(ctx, query, args...).Scan(
+	err = conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(
 		&episode.ID,
 		&episode.ProgramID,
 		&episode.Title,
@@ -229,6 +278,8 @@ func (r *episodeRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Episode,
 		&episode.Metadata,
 		&episode.ViewCount,
 		&episode.Rating,
+		&episode.SeasonID,
+		&episode.Availability,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -240,17 +291,42 @@ func (r *episodeRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Episode,
 	return &episode, nil
 }
 
+// GetByIDs returns the episodes matching ids in no particular order; unknown ids are skipped.
+func (r *episodeRepo) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*biz.Episode, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := goqu.Select(episodeColumns...).
+		From("episodes").
+		Where(goqu.C("id").In(ids), notDeleted()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	return r.queryEpisodes(ctx, query, args)
+}
+
 func (r *episodeRepo) List(ctx context.Context, filter biz.EpisodeFilter, pagination biz.PaginationRequest, sort biz.SortRequest) ([]*biz.Episode, *biz.PaginationResponse, error) {
 	pagination.SetDefaults()
 
 	// Build WHERE conditions
-	var conditions []exp.Expression
+	conditions := []exp.Expression{notDeleted()}
 
 	// Filter by program_id if provided
 	if filter.ProgramID != nil {
 		conditions = append(conditions, goqu.C("program_id").Eq(*filter.ProgramID))
 	}
 
+	if filter.SeasonID != nil {
+		conditions = append(conditions, goqu.C("season_id").Eq(*filter.SeasonID))
+	}
+
+	if filter.SeasonNumber != nil {
+		conditions = append(conditions, goqu.C("season_number").Eq(*filter.SeasonNumber))
+	}
+
 	if filter.Status != nil {
 		conditions = append(conditions, goqu.C("status").Eq(*filter.Status))
 	}
@@ -277,7 +353,7 @@ func (r *episodeRepo) List(ctx context.Context, filter biz.EpisodeFilter, pagina
 	}
 
 	var totalCount int32
-	err = r.db.QueryRow(ctx, countQuery, countArgs...).Scan(&totalCount)
+	err = conn(ctx, r.db).QueryRow(ctx, countQuery, countArgs...).Scan(&totalCount)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to count episodes: %w", err)
 	}
@@ -321,6 +397,8 @@ func (r *episodeRepo) List(ctx context.Context, filter biz.EpisodeFilter, pagina
 		"metadata",
 		"view_count",
 		"rating",
+		"season_id",
+		"availability",
 	).From("episodes").
 		Where(conditions...).
 		Order(orderBy).
@@ -331,7 +409,7 @@ func (r *episodeRepo) List(ctx context.Context, filter biz.EpisodeFilter, pagina
 		return nil, nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	rows, err := r.db.Query(ctx, selectQuery, selectArgs...)
+	rows, err := conn(ctx, r.db).Query(ctx, selectQuery, selectArgs...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to query episodes: %w", err)
 	}
@@ -361,6 +439,8 @@ func (r *episodeRepo) List(ctx context.Context, filter biz.EpisodeFilter, pagina
 			&episode.Metadata,
 			&episode.ViewCount,
 			&episode.Rating,
+			&episode.SeasonID,
+			&episode.Availability,
 		)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to scan episode: %w", err)
@@ -389,18 +469,151 @@ func (r *episodeRepo) ListByProgram(ctx context.Context, programID uuid.UUID, pa
 	return r.List(ctx, filter, pagination, sort)
 }
 
+// ListByPrograms pages through the episodes of several programs in a single query,
+// applying the same page to each program independently.
+func (r *episodeRepo) ListByPrograms(ctx context.Context, programIDs []uuid.UUID, pagination biz.PaginationRequest, sort biz.SortRequest) (map[uuid.UUID]*biz.EpisodePage, error) {
+	pagination.SetDefaults()
+
+	pages := make(map[uuid.UUID]*biz.EpisodePage, len(programIDs))
+	if len(programIDs) == 0 {
+		return pages, nil
+	}
+
+	var orderBy exp.OrderedExpression
+	if sort.SortBy != "" {
+		if sort.SortOrder == "desc" {
+			orderBy = goqu.C(sort.SortBy).Desc()
+		} else {
+			orderBy = goqu.C(sort.SortBy).Asc()
+		}
+	} else {
+		orderBy = goqu.C("created_at").Desc()
+	}
+
+	columns := []interface{}{
+		"id",
+		"program_id",
+		"title",
+		"description",
+		"duration_seconds",
+		"episode_number",
+		"season_number",
+		"status",
+		"created_at",
+		"updated_at",
+		"published_at",
+		"scheduled_at",
+		"created_by",
+		"updated_by",
+		"media_url",
+		"thumbnail_url",
+		"tags",
+		"metadata",
+		"view_count",
+		"rating",
+		"season_id",
+		"availability",
+	}
+
+	ranked := goqu.From("episodes").
+		Select(append(columns,
+			goqu.ROW_NUMBER().Over(goqu.W().PartitionBy("program_id").OrderBy(orderBy)).As("position"),
+			goqu.COUNT("*").Over(goqu.W().PartitionBy("program_id")).As("total_count"),
+		)...).
+		Where(goqu.C("program_id").In(programIDs), notDeleted())
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	query, args, err := goqu.From(ranked.As("ranked")).
+		Select(append(columns, "total_count")...).
+		Where(
+			goqu.C("position").Gt(offset),
+			goqu.C("position").Lte(offset+pagination.PageSize),
+		).
+		Order(goqu.C("program_id").Asc(), goqu.C("position").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query episodes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var episode biz.Episode
+		var totalCount int32
+		err := rows.Scan(
+			&episode.ID,
+			&episode.ProgramID,
+			&episode.Title,
+			&episode.Description,
+			&episode.DurationSecs,
+			&episode.EpisodeNumber,
+			&episode.SeasonNumber,
+			&episode.Status,
+			&episode.CreatedAt,
+			&episode.UpdatedAt,
+			&episode.PublishedAt,
+			&episode.ScheduledAt,
+			&episode.CreatedBy,
+			&episode.UpdatedBy,
+			&episode.MediaURL,
+			&episode.ThumbnailURL,
+			&episode.Tags,
+			&episode.Metadata,
+			&episode.ViewCount,
+			&episode.Rating,
+			&episode.SeasonID,
+			&episode.Availability,
+			&totalCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan episode: %w", err)
+		}
+
+		page, ok := pages[episode.ProgramID]
+		if !ok {
+			page = &biz.EpisodePage{Pagination: &biz.PaginationResponse{
+				Page:       pagination.Page,
+				PageSize:   pagination.PageSize,
+				TotalCount: totalCount,
+				TotalPages: (totalCount + pagination.PageSize - 1) / pagination.PageSize,
+			}}
+			pages[episode.ProgramID] = page
+		}
+		page.Episodes = append(page.Episodes, &episode)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read episodes: %w", err)
+	}
+
+	// Programs past their last page, or without episodes, still get an empty page.
+	for _, id := range programIDs {
+		if _, ok := pages[id]; !ok {
+			pages[id] = &biz.EpisodePage{Pagination: &biz.PaginationResponse{
+				Page:     pagination.Page,
+				PageSize: pagination.PageSize,
+			}}
+		}
+	}
+
+	return pages, nil
+}
+
 func (r *episodeRepo) IncrementViewCount(ctx context.Context, id uuid.UUID) error {
 	query, args, err := goqu.Update("episodes").
 		Set(goqu.Record{
 			"view_count": goqu.L("view_count + 1"),
 		}).
-		Where(goqu.C("id").Eq(id)).
+		Where(goqu.C("id").Eq(id), notDeleted()).
 		ToSQL()
 	if err != nil {
 		return fmt.Errorf("failed to build increment view count query: %w", err)
 	}
 
-	result, err := r.db.Exec(ctx, query, args...)
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to increment view count: %w", err)
 	}
@@ -411,3 +624,282 @@ func (r *episodeRepo) IncrementViewCount(ctx context.Context, id uuid.UUID) erro
 
 	return nil
 }
+
+func (r *episodeRepo) Transition(ctx context.Context, transition *biz.StatusTransition, scheduledAt *time.Time) (*biz.Episode, error) {
+	now := time.Now()
+	record := goqu.Record{
+		"status":     transition.ToStatus,
+		"updated_at": now,
+	}
+	if transition.ActorID != nil {
+		record["updated_by"] = *transition.ActorID
+	}
+	switch biz.EpisodeStatus(transition.ToStatus) {
+	case biz.EpisodeStatusScheduled:
+		record["scheduled_at"] = scheduledAt
+	case biz.EpisodeStatusPublished:
+		record["published_at"] = now
+	default:
+		if biz.EpisodeStatus(transition.FromStatus) == biz.EpisodeStatusScheduled {
+			record["scheduled_at"] = nil
+		}
+	}
+
+	// Matching the old status makes concurrent transitions fail instead of overwriting each other
+	query, args, err := goqu.Update("episodes").
+		Set(record).
+		Where(
+			goqu.C("id").Eq(transition.ContentID),
+			goqu.C("status").Eq(transition.FromStatus),
+			notDeleted(),
+		).
+		Returning(episodeColumns...).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transition query: %w", err)
+	}
+
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	episode, err := scanEpisode(tx.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, biz.ErrStatusChanged
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update episode status: %w", err)
+	}
+
+	if err := insertTransitions(ctx, tx, transition); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit episode transition: %w", err)
+	}
+
+	return episode, nil
+}
+
+func (r *episodeRepo) PublishDue(ctx context.Context, limit int32) ([]*biz.Episode, error) {
+	now := time.Now()
+
+	// SKIP LOCKED lets every replica run the scheduler without publishing an episode twice
+	due := goqu.From("episodes").
+		Select("id").
+		Where(
+			goqu.C("status").Eq(biz.EpisodeStatusScheduled),
+			goqu.C("scheduled_at").Lte(now),
+			notDeleted(),
+			// Episodes of an unpublished or trashed program wait for it
+			goqu.C("program_id").In(goqu.From("programs").
+				Select("id").
+				Where(
+					goqu.I("programs.status").Eq(biz.ProgramStatusPublished),
+					goqu.I("programs.deleted_at").IsNull(),
+				)),
+		).
+		Order(goqu.C("scheduled_at").Asc()).
+		Limit(uint(limit)).
+		ForUpdate(exp.SkipLocked)
+
+	query, args, err := goqu.Update("episodes").
+		Set(goqu.Record{
+			"status":       biz.EpisodeStatusPublished,
+			"published_at": now,
+			"updated_at":   now,
+		}).
+		Where(
+			goqu.C("id").In(due),
+			// A schedule cancelled while the claim waited must not be published
+			goqu.C("status").Eq(biz.EpisodeStatusScheduled),
+		).
+		Returning(episodeColumns...).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build publish query: %w", err)
+	}
+
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	episodes, err := collectEpisodes(tx.Query(ctx, query, args...))
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := make([]*biz.StatusTransition, 0, len(episodes))
+	for _, episode := range episodes {
+		transitions = append(transitions, &biz.StatusTransition{
+			ContentType: biz.ContentTypeEpisode,
+			ContentID:   episode.ID,
+			Action:      biz.WorkflowActionPublish,
+			FromStatus:  string(biz.EpisodeStatusScheduled),
+			ToStatus:    string(biz.EpisodeStatusPublished),
+		})
+	}
+	if err := insertTransitions(ctx, tx, transitions...); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit published episodes: %w", err)
+	}
+
+	return episodes, nil
+}
+
+// Reorder cannot lean on deferred constraints, since the uniqueness of episode numbers is
+// a partial index and indexes are checked row by row. The episodes first take negative
+// numbers no other episode has, then their final ones.
+func (r *episodeRepo) Reorder(ctx context.Context, userID, seasonID uuid.UUID, ids []uuid.UUID, firstNumber int32) ([]*biz.Episode, error) {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = id.String()
+	}
+
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the season makes concurrent reorders of it take turns
+	var programID uuid.UUID
+	var seasonNumber int32
+	err = tx.QueryRow(ctx, "SELECT program_id, season_number FROM seasons WHERE id = $1 FOR UPDATE", seasonID).
+		Scan(&programID, &seasonNumber)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, biz.ErrSeasonNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock season: %w", err)
+	}
+
+	var locked int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM (
+			SELECT id FROM episodes
+			WHERE id = ANY($1::uuid[]) AND program_id = $2 AND deleted_at IS NULL
+			FOR UPDATE
+		) AS locked`, keys, programID).Scan(&locked)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock episodes: %w", err)
+	}
+	if locked != len(ids) {
+		return nil, biz.ErrEpisodeNotFound
+	}
+
+	var incomplete bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM episodes
+			WHERE season_id = $1 AND deleted_at IS NULL AND id <> ALL($2::uuid[])
+		)`, seasonID, keys).Scan(&incomplete)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check episode order: %w", err)
+	}
+	if incomplete {
+		return nil, biz.ErrEpisodeOrderIncomplete
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE episodes SET episode_number = -o.position
+		FROM unnest($1::uuid[]) WITH ORDINALITY AS o(id, position)
+		WHERE episodes.id = o.id`, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to renumber episodes: %w", err)
+	}
+
+	// Setting season_number moves episodes of other seasons over, see assign_episode_season
+	_, err = tx.Exec(ctx, `
+		UPDATE episodes
+		SET episode_number = $2 + o.position - 1, season_number = $3, updated_by = $4, updated_at = $5
+		FROM unnest($1::uuid[]) WITH ORDINALITY AS o(id, position)
+		WHERE episodes.id = o.id`, keys, firstNumber, seasonNumber, userID, time.Now())
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "episodes_program_id_season_number_episode_number_key" {
+			return nil, biz.ErrEpisodeAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to renumber episodes: %w", err)
+	}
+
+	query, args, err := goqu.Select(episodeColumns...).
+		From("episodes").
+		Where(goqu.C("id").In(ids)).
+		Order(goqu.C("episode_number").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	episodes, err := collectEpisodes(tx.Query(ctx, query, args...))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit reorder: %w", err)
+	}
+
+	return episodes, nil
+}
+
+func (r *episodeRepo) queryEpisodes(ctx context.Context, query string, args []any) ([]*biz.Episode, error) {
+	return collectEpisodes(conn(ctx, r.db).Query(ctx, query, args...))
+}
+
+func collectEpisodes(rows pgx.Rows, err error) ([]*biz.Episode, error) {
+	if err != nil {
+		return nil, fmt.Errorf("failed to query episodes: %w", err)
+	}
+	defer rows.Close()
+
+	var episodes []*biz.Episode
+	for rows.Next() {
+		episode, err := scanEpisode(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan episode: %w", err)
+		}
+		episodes = append(episodes, episode)
+	}
+
+	return episodes, rows.Err()
+}
+
+func scanEpisode(row pgx.Row) (*biz.Episode, error) {
+	var episode biz.Episode
+	err := row.Scan(
+		&episode.ID,
+		&episode.ProgramID,
+		&episode.Title,
+		&episode.Description,
+		&episode.DurationSecs,
+		&episode.EpisodeNumber,
+		&episode.SeasonNumber,
+		&episode.Status,
+		&episode.CreatedAt,
+		&episode.UpdatedAt,
+		&episode.PublishedAt,
+		&episode.ScheduledAt,
+		&episode.CreatedBy,
+		&episode.UpdatedBy,
+		&episode.MediaURL,
+		&episode.ThumbnailURL,
+		&episode.Tags,
+		&episode.Metadata,
+		&episode.ViewCount,
+		&episode.Rating,
+		&episode.SeasonID,
+		&episode.Availability,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &episode, nil
+}
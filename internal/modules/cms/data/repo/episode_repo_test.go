@@ -2,7 +2,9 @@ package repo
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"thmanyah/internal/modules/cms/biz"
 
@@ -108,6 +110,13 @@ func TestEpisodeRepo_CRUDJourney(t *testing.T) {
 		if len(retrieved.Tags) != len(testEpisode.Tags) {
 			t.Errorf("Expected %d tags, got %d", len(testEpisode.Tags), len(retrieved.Tags))
 		}
+
+		// Unknown ids are skipped
+		episodes, err := repo.GetByIDs(ctx, []uuid.UUID{testEpisode.ID, uuid.New()})
+		AssertNoError(t, err, "getting episodes by IDs")
+		if len(episodes) != 1 || episodes[0].ID != testEpisode.ID {
+			t.Errorf("Expected only episode %s, got %d episodes", testEpisode.ID, len(episodes))
+		}
 	})
 
 	// Test 4: Update Episode
@@ -222,7 +231,7 @@ func TestEpisodeRepo_CRUDJourney(t *testing.T) {
 		}
 	})
 
-	// Test 7: Soft and Hard Delete
+	// Test 7: Delete to trash and restore
 	t.Run("DeleteOperations", func(t *testing.T) {
 		// Create episode for soft delete
 		softDeleteEpisode := &biz.Episode{
@@ -242,13 +251,24 @@ func TestEpisodeRepo_CRUDJourney(t *testing.T) {
 		err = repo.Delete(ctx, userID, softDeleteEpisode.ID)
 		AssertNoError(t, err, "soft deleting episode")
 
-		// Episode should still exist but be archived
-		archivedEpisode, err := repo.GetByID(ctx, softDeleteEpisode.ID)
-		AssertNoError(t, err, "getting archived episode")
+		// Episode should be hidden from reads but listed in the trash
+		_, err = repo.GetByID(ctx, softDeleteEpisode.ID)
+		AssertError(t, err, "getting trashed episode")
 
-		if archivedEpisode.Status != biz.EpisodeStatusArchived {
-			t.Errorf("Expected status %s, got %s", biz.EpisodeStatusArchived, archivedEpisode.Status)
+		trashRepo := NewTrashRepository(helper.Pool)
+		episodeType := biz.ContentTypeEpisode
+		items, _, err := trashRepo.List(ctx, userID, &episodeType, biz.PaginationRequest{Page: 1, PageSize: 10})
+		AssertNoError(t, err, "listing trash")
+		if len(items) != 1 || items[0].ID != softDeleteEpisode.ID {
+			t.Fatalf("Expected the deleted episode in the trash, got %v", items)
 		}
+
+		// Restoring brings it back
+		err = trashRepo.Restore(ctx, userID, biz.ContentTypeEpisode, softDeleteEpisode.ID)
+		AssertNoError(t, err, "restoring episode")
+
+		_, err = repo.GetByID(ctx, softDeleteEpisode.ID)
+		AssertNoError(t, err, "getting restored episode")
 	})
 
 	// Test 8: View Count Operations
@@ -336,4 +356,248 @@ func TestEpisodeRepo_CRUDJourney(t *testing.T) {
 			t.Error("Should not find episodes from program 2 in program 1 results")
 		}
 	})
+
+	// Test 10: Scheduling
+	t.Run("Schedule", func(t *testing.T) {
+		episode := &biz.Episode{
+			ProgramID:     program.ID,
+			Title:         "Scheduled Episode",
+			EpisodeNumber: 90,
+			SeasonNumber:  1,
+			Status:        biz.EpisodeStatusDraft,
+			CreatedBy:     userID,
+			UpdatedBy:     userID,
+		}
+		err := repo.Create(ctx, episode)
+		AssertNoError(t, err, "creating episode to schedule")
+
+		// The repository leaves checking the time to the use case, so a past time makes it due
+		at := time.Now().Add(-time.Minute)
+		schedule := &biz.StatusTransition{
+			ContentType: biz.ContentTypeEpisode,
+			ContentID:   episode.ID,
+			Action:      biz.WorkflowActionSchedule,
+			FromStatus:  string(biz.EpisodeStatusDraft),
+			ToStatus:    string(biz.EpisodeStatusScheduled),
+			ActorID:     &userID,
+		}
+		scheduled, err := repo.Transition(ctx, schedule, &at)
+		AssertNoError(t, err, "scheduling episode")
+		if scheduled.Status != biz.EpisodeStatusScheduled || scheduled.ScheduledAt == nil {
+			t.Errorf("Expected scheduled episode with scheduled_at, got status %s", scheduled.Status)
+		}
+
+		published, err := repo.PublishDue(ctx, 100)
+		AssertNoError(t, err, "publishing due episodes")
+		var found *biz.Episode
+		for _, ep := range published {
+			if ep.ID == episode.ID {
+				found = ep
+			}
+		}
+		if found == nil || found.Status != biz.EpisodeStatusPublished || found.PublishedAt == nil {
+			t.Fatalf("Expected episode %s to be published", episode.ID)
+		}
+
+		// A transition from a status the episode has left must not apply
+		_, err = repo.Transition(ctx, &biz.StatusTransition{
+			ContentType: biz.ContentTypeEpisode,
+			ContentID:   episode.ID,
+			Action:      biz.WorkflowActionUnschedule,
+			FromStatus:  string(biz.EpisodeStatusScheduled),
+			ToStatus:    string(biz.EpisodeStatusDraft),
+			ActorID:     &userID,
+		}, nil)
+		if !errors.Is(err, biz.ErrStatusChanged) {
+			t.Errorf("Expected ErrStatusChanged, got %v", err)
+		}
+
+		// Both the user's and the scheduler's transitions are recorded, in order
+		transitions, err := NewWorkflowRepository(helper.Pool).ListTransitions(ctx, biz.ContentTypeEpisode, episode.ID)
+		AssertNoError(t, err, "listing status transitions")
+		if len(transitions) != 2 {
+			t.Fatalf("Expected 2 transitions, got %d", len(transitions))
+		}
+		if transitions[0].Action != biz.WorkflowActionSchedule || transitions[0].ActorID == nil || *transitions[0].ActorID != userID {
+			t.Errorf("Expected schedule transition by %s, got %+v", userID, transitions[0])
+		}
+		if transitions[1].Action != biz.WorkflowActionPublish || transitions[1].ActorID != nil {
+			t.Errorf("Expected publish transition without actor, got %+v", transitions[1])
+		}
+	})
+}
+
+func TestEpisodeRepo_Reorder(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewEpisodeRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	program := &biz.Program{
+		Title:      "Reorder Program",
+		CategoryID: uuid.MustParse(GetTestCategoryID()),
+		Status:     biz.ProgramStatusDraft,
+		CreatedBy:  userID,
+		UpdatedBy:  userID,
+	}
+	AssertNoError(t, NewProgramRepository(helper.Pool).Create(ctx, program), "creating program")
+
+	create := func(season, number int32) uuid.UUID {
+		episode := &biz.Episode{
+			ProgramID:     program.ID,
+			Title:         "Reorder Episode",
+			EpisodeNumber: number,
+			SeasonNumber:  season,
+			Status:        biz.EpisodeStatusDraft,
+			CreatedBy:     userID,
+			UpdatedBy:     userID,
+		}
+		AssertNoError(t, repo.Create(ctx, episode), "creating episode")
+		return episode.ID
+	}
+	first, second, third := create(1, 1), create(1, 2), create(1, 3)
+	other := create(2, 1)
+
+	seasons, err := NewSeasonRepository(helper.Pool).ListByProgram(ctx, program.ID)
+	AssertNoError(t, err, "listing seasons")
+	if len(seasons) != 2 {
+		t.Fatalf("Expected 2 seasons, got %d", len(seasons))
+	}
+	seasonOne, seasonTwo := seasons[0], seasons[1]
+	var added uuid.UUID
+
+	// assertOrder checks that the episodes are returned, and stored, in season one with
+	// numbers from firstNumber on
+	assertOrder := func(t *testing.T, episodes []*biz.Episode, firstNumber int32, want ...uuid.UUID) {
+		t.Helper()
+		if len(episodes) != len(want) {
+			t.Fatalf("Expected %d episodes, got %d", len(want), len(episodes))
+		}
+		for i, id := range want {
+			number := firstNumber + int32(i)
+			if episodes[i].ID != id || episodes[i].EpisodeNumber != number {
+				t.Errorf("Expected episode %s as number %d, got %s as %d", id, number, episodes[i].ID, episodes[i].EpisodeNumber)
+			}
+
+			stored, err := repo.GetByID(ctx, id)
+			AssertNoError(t, err, "getting episode")
+			if stored.EpisodeNumber != number || stored.SeasonNumber != 1 || stored.SeasonID != seasonOne.ID {
+				t.Errorf("Expected episode %s stored as 1x%d, got %dx%d in season %s",
+					id, number, stored.SeasonNumber, stored.EpisodeNumber, stored.SeasonID)
+			}
+		}
+	}
+
+	t.Run("Swap", func(t *testing.T) {
+		episodes, err := repo.Reorder(ctx, userID, seasonOne.ID, []uuid.UUID{second, first, third}, 1)
+		AssertNoError(t, err, "swapping episodes")
+		assertOrder(t, episodes, 1, second, first, third)
+	})
+
+	t.Run("InsertMiddle", func(t *testing.T) {
+		// A new episode added at the end of the season goes between the first two
+		added = create(1, 4)
+		episodes, err := repo.Reorder(ctx, userID, seasonOne.ID, []uuid.UUID{second, added, first, third}, 1)
+		AssertNoError(t, err, "inserting episode")
+		assertOrder(t, episodes, 1, second, added, first, third)
+	})
+
+	t.Run("Incomplete", func(t *testing.T) {
+		_, err := repo.Reorder(ctx, userID, seasonOne.ID, []uuid.UUID{first, second}, 1)
+		if !errors.Is(err, biz.ErrEpisodeOrderIncomplete) {
+			t.Errorf("Expected ErrEpisodeOrderIncomplete, got %v", err)
+		}
+	})
+
+	t.Run("UnknownEpisode", func(t *testing.T) {
+		_, err := repo.Reorder(ctx, userID, seasonOne.ID, []uuid.UUID{uuid.New()}, 1)
+		if !errors.Is(err, biz.ErrEpisodeNotFound) {
+			t.Errorf("Expected ErrEpisodeNotFound, got %v", err)
+		}
+	})
+
+	t.Run("UnknownSeason", func(t *testing.T) {
+		_, err := repo.Reorder(ctx, userID, uuid.New(), []uuid.UUID{first}, 1)
+		if !errors.Is(err, biz.ErrSeasonNotFound) {
+			t.Errorf("Expected ErrSeasonNotFound, got %v", err)
+		}
+	})
+
+	t.Run("CrossSeason", func(t *testing.T) {
+		episodes, err := repo.Reorder(ctx, userID, seasonOne.ID, []uuid.UUID{first, other, second, added, third}, 10)
+		AssertNoError(t, err, "moving episode from season two")
+		assertOrder(t, episodes, 10, first, other, second, added, third)
+
+		count, err := helper.CountRows(ctx, "episodes", "season_id = $1 AND deleted_at IS NULL", seasonTwo.ID)
+		AssertNoError(t, err, "counting episodes of season two")
+		if count != 0 {
+			t.Errorf("Expected season two to be empty, got %d episodes", count)
+		}
+	})
+}
+
+func TestEpisodeRepo_GetByIDs(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewEpisodeRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	program := &biz.Program{
+		Title:      "Batch Get Episodes Program",
+		CategoryID: uuid.MustParse(GetTestCategoryID()),
+		Status:     biz.ProgramStatusDraft,
+		CreatedBy:  userID,
+		UpdatedBy:  userID,
+	}
+	AssertNoError(t, NewProgramRepository(helper.Pool).Create(ctx, program), "creating program")
+
+	var episodes []*biz.Episode
+	for i := int32(1); i <= 3; i++ {
+		episode := &biz.Episode{
+			ProgramID:     program.ID,
+			Title:         "Batch Get Episode",
+			EpisodeNumber: i,
+			SeasonNumber:  1,
+			Status:        biz.EpisodeStatusDraft,
+			CreatedBy:     userID,
+			UpdatedBy:     userID,
+		}
+		AssertNoError(t, repo.Create(ctx, episode), "creating episode")
+		episodes = append(episodes, episode)
+	}
+	trashed := episodes[2]
+	AssertNoError(t, repo.Delete(ctx, userID, trashed.ID), "trashing episode")
+
+	t.Run("SkipsUnknownAndTrashed", func(t *testing.T) {
+		found, err := repo.GetByIDs(ctx, []uuid.UUID{episodes[1].ID, uuid.New(), trashed.ID, episodes[0].ID})
+		AssertNoError(t, err, "getting episodes by IDs")
+
+		got := make(map[uuid.UUID]bool, len(found))
+		for _, episode := range found {
+			got[episode.ID] = true
+		}
+		if len(found) != 2 || !got[episodes[0].ID] || !got[episodes[1].ID] {
+			t.Errorf("Expected episodes %s and %s, got %d episodes", episodes[0].ID, episodes[1].ID, len(found))
+		}
+	})
+
+	t.Run("DuplicateIDs", func(t *testing.T) {
+		found, err := repo.GetByIDs(ctx, []uuid.UUID{episodes[0].ID, episodes[0].ID})
+		AssertNoError(t, err, "getting duplicate episode IDs")
+		if len(found) != 1 || found[0].ID != episodes[0].ID {
+			t.Errorf("Expected episode %s once, got %d episodes", episodes[0].ID, len(found))
+		}
+	})
+
+	t.Run("NoIDs", func(t *testing.T) {
+		found, err := repo.GetByIDs(ctx, nil)
+		AssertNoError(t, err, "getting no episodes")
+		if len(found) != 0 {
+			t.Errorf("Expected no episodes, got %d", len(found))
+		}
+	})
 }
@@ -56,7 +56,7 @@ func (r *importRepo) Create(ctx context.Context, importData *biz.ImportData) err
 		return fmt.Errorf("failed to build insert query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to insert import data: %w", err)
 	}
@@ -115,7 +115,7 @@ func (r *importRepo) Update(ctx context.Context, userID, id uuid.UUID, updates *
 		return nil, fmt.Errorf("failed to build update query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update import data: %w", err)
 	}
@@ -149,7 +149,7 @@ func (r *importRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.ImportData
 	}
 
 	var importData biz.ImportData
-	err = r.db.QueryRow(ctx, query, args...).Scan(
+	err = conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(
 		&importData.ID,
 		&importData.SourceType,
 		&importData.SourceURL,
@@ -208,7 +208,7 @@ func (r *importRepo) List(ctx context.Context, filter biz.ImportFilter, paginati
 	}
 
 	var totalCount int32
-	err = r.db.QueryRow(ctx, countQuery, countArgs...).Scan(&totalCount)
+	err = conn(ctx, r.db).QueryRow(ctx, countQuery, countArgs...).Scan(&totalCount)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to count imports: %w", err)
 	}
@@ -254,7 +254,7 @@ func (r *importRepo) List(ctx context.Context, filter biz.ImportFilter, paginati
 		return nil, nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	rows, err := r.db.Query(ctx, selectQuery, selectArgs...)
+	rows, err := conn(ctx, r.db).Query(ctx, selectQuery, selectArgs...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to query imports: %w", err)
 	}
@@ -323,7 +323,7 @@ func (r *importRepo) UpdateProgress(ctx context.Context, userID, id uuid.UUID, u
 		return fmt.Errorf("failed to build update progress query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update import progress: %w", err)
 	}
@@ -360,7 +360,7 @@ func (r *importRepo) AddError(ctx context.Context, userID, id uuid.UUID, errorMs
 		return fmt.Errorf("failed to build add error query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to add error to import: %w", err)
 	}
@@ -395,7 +395,7 @@ func (r *importRepo) AddWarning(ctx context.Context, userID, id uuid.UUID, warni
 		return fmt.Errorf("failed to build add warning query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to add warning to import: %w", err)
 	}
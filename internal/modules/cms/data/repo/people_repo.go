@@ -0,0 +1,218 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var personColumns = []any{
+	"id",
+	"name",
+	"bio",
+	"photo_url",
+	"links",
+	"created_by",
+	"created_at",
+	"updated_at",
+}
+
+type personRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPersonRepository(db *pgxpool.Pool) biz.PersonRepository {
+	return &personRepo{
+		db: db,
+	}
+}
+
+func (r *personRepo) Create(ctx context.Context, person *biz.Person) error {
+	query, args, err := goqu.Insert("people").Rows(goqu.Record{
+		"id":         person.ID,
+		"name":       person.Name,
+		"bio":        person.Bio,
+		"photo_url":  person.PhotoURL,
+		"links":      person.Links,
+		"created_by": person.CreatedBy,
+		"created_at": person.CreatedAt,
+		"updated_at": person.UpdatedAt,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert person: %w", err)
+	}
+
+	return nil
+}
+
+func (r *personRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Person, error) {
+	query, args, err := goqu.From("people").Select(personColumns...).Where(goqu.C("id").Eq(id)).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	person, err := scanPerson(conn(ctx, r.db).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, biz.ErrPersonNotFound
+		}
+		return nil, fmt.Errorf("failed to scan person: %w", err)
+	}
+
+	return person, nil
+}
+
+func (r *personRepo) List(ctx context.Context, filter biz.PersonFilter, pagination biz.PaginationRequest) ([]*biz.Person, *biz.PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	var conditions []exp.Expression
+	if filter.SearchQuery != nil && *filter.SearchQuery != "" {
+		conditions = append(conditions, goqu.C("name").ILike("%"+escapeLike(*filter.SearchQuery)+"%"))
+	}
+
+	query, args, err := goqu.Select(goqu.COUNT("*")).
+		From("people").
+		Where(conditions...).
+		ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var totalCount int32
+	if err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count people: %w", err)
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	query, args, err = goqu.From("people").
+		Select(personColumns...).
+		Where(conditions...).
+		Order(goqu.L("lower(name)").Asc(), goqu.C("id").Asc()).
+		Limit(uint(pagination.PageSize)).
+		Offset(uint(offset)).
+		ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query people: %w", err)
+	}
+
+	people, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.Person, error) {
+		return scanPerson(row)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to scan people: %w", err)
+	}
+
+	return people, paginationResponse(pagination, totalCount), nil
+}
+
+func (r *personRepo) Update(ctx context.Context, id uuid.UUID, updates *biz.UpdatePersonRequest) (*biz.Person, error) {
+	record := goqu.Record{
+		"updated_at": time.Now(),
+	}
+	if updates.Name != nil {
+		record["name"] = *updates.Name
+	}
+	if updates.Bio != nil {
+		record["bio"] = *updates.Bio
+	}
+	if updates.PhotoURL != nil {
+		record["photo_url"] = *updates.PhotoURL
+	}
+	if updates.Links != nil {
+		record["links"] = *updates.Links
+	}
+
+	query, args, err := goqu.Update("people").
+		Set(record).
+		Where(goqu.C("id").Eq(id)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update person: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, biz.ErrPersonNotFound
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *personRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Translations have no foreign key, and credits go with the person
+	query, args, err := goqu.Delete("translations").
+		Where(
+			goqu.C("content_type").Eq(biz.ContentTypePerson),
+			goqu.C("content_id").Eq(id),
+		).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete translations query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete person translations: %w", err)
+	}
+
+	query, args, err = goqu.Delete("people").Where(goqu.C("id").Eq(id)).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete person: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return biz.ErrPersonNotFound
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func scanPerson(row pgx.Row) (*biz.Person, error) {
+	var person biz.Person
+	err := row.Scan(
+		&person.ID,
+		&person.Name,
+		&person.Bio,
+		&person.PhotoURL,
+		&person.Links,
+		&person.CreatedBy,
+		&person.CreatedAt,
+		&person.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &person, nil
+}
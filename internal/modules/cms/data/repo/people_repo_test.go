@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+func TestPersonRepo_SearchVectors(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewPersonRepository(helper.Pool)
+	credits := NewCreditRepository(helper.Pool)
+	translations := NewTranslationRepository(helper.Pool)
+	programs := NewProgramRepository(helper.Pool)
+	episodes := NewEpisodeRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	program := &biz.Program{
+		Title:      "Morning Show",
+		CategoryID: uuid.MustParse(GetTestCategoryID()),
+		Status:     biz.ProgramStatusPublished,
+		CreatedBy:  userID,
+		UpdatedBy:  userID,
+	}
+	AssertNoError(t, programs.Create(ctx, program), "creating program")
+	episode := &biz.Episode{
+		ProgramID:     program.ID,
+		Title:         "First Morning",
+		EpisodeNumber: 1,
+		SeasonNumber:  1,
+		Status:        biz.EpisodeStatusPublished,
+		CreatedBy:     userID,
+		UpdatedBy:     userID,
+	}
+	AssertNoError(t, episodes.Create(ctx, episode), "creating episode")
+	person := createPerson(t, repo, "Zainab Haddad")
+
+	searchable := func(t *testing.T, table string, id uuid.UUID, query string) bool {
+		t.Helper()
+		found, err := helper.Searchable(ctx, table, id, query)
+		AssertNoError(t, err, "searching "+table)
+		return found
+	}
+	assertSearchable := func(t *testing.T, query string, want bool) {
+		t.Helper()
+		if got := searchable(t, "programs", program.ID, query); got != want {
+			t.Errorf("Expected the program searchable by %q to be %v", query, want)
+		}
+		if got := searchable(t, "episodes", episode.ID, query); got != want {
+			t.Errorf("Expected the episode searchable by %q to be %v", query, want)
+		}
+	}
+
+	assertSearchable(t, "Haddad", false)
+
+	now := time.Now()
+	hosting := createCredit(t, credits, person.ID, biz.ContentTypeProgram, program.ID, biz.CreditRoleHost, now)
+	guesting := createCredit(t, credits, person.ID, biz.ContentTypeEpisode, episode.ID, biz.CreditRoleGuest, now)
+
+	t.Run("Credited", func(t *testing.T) {
+		assertSearchable(t, "Haddad", true)
+	})
+
+	t.Run("Renamed", func(t *testing.T) {
+		name := "Zainab Karim"
+		_, err := repo.Update(ctx, person.ID, &biz.UpdatePersonRequest{Name: &name})
+		AssertNoError(t, err, "renaming person")
+		assertSearchable(t, "Karim", true)
+		assertSearchable(t, "Haddad", false)
+	})
+
+	t.Run("Translated", func(t *testing.T) {
+		err := translations.Upsert(ctx, &biz.Translation{
+			ContentType: biz.ContentTypePerson,
+			ContentID:   person.ID,
+			Locale:      "ar",
+			Title:       "زينب كريم",
+		})
+		AssertNoError(t, err, "translating person")
+		assertSearchable(t, "زينب", true)
+
+		AssertNoError(t, translations.Delete(ctx, biz.ContentTypePerson, person.ID, "ar"), "deleting translation")
+		assertSearchable(t, "زينب", false)
+	})
+
+	t.Run("Uncredited", func(t *testing.T) {
+		AssertNoError(t, credits.Delete(ctx, hosting.ID), "deleting credit")
+		AssertNoError(t, credits.Delete(ctx, guesting.ID), "deleting credit")
+		assertSearchable(t, "Karim", false)
+		// The content's own text is still searchable
+		if !searchable(t, "programs", program.ID, "Morning") {
+			t.Errorf("Expected the program searchable by its title")
+		}
+	})
+}
@@ -17,6 +17,13 @@ import (
 	"github.com/lib/pq"
 )
 
+// programCategoryIDs selects every category of a program, the primary one first.
+var programCategoryIDs = goqu.L(`ARRAY(
+	SELECT category_id FROM program_categories
+	WHERE program_categories.program_id = programs.id
+	ORDER BY is_primary DESC, category_id
+)`).As("category_ids")
+
 type programRepo struct {
 	db    *pgxpool.Pool
 	table string
@@ -54,6 +61,7 @@ func (r *programRepo) Create(ctx context.Context, program *biz.Program) error {
 		"is_featured":    program.IsFeatured,
 		"view_count":     program.ViewCount,
 		"rating":         program.Rating,
+		"availability":   program.Availability,
 	}
 
 	if len(program.Tags) > 0 {
@@ -67,7 +75,7 @@ func (r *programRepo) Create(ctx context.Context, program *biz.Program) error {
 		return fmt.Errorf("failed to build insert query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -132,17 +140,21 @@ func (r *programRepo) Update(ctx context.Context, userId, id uuid.UUID, updates
 	if updates.Rating != nil {
 		updateRecord["rating"] = *updates.Rating
 	}
+	if updates.Availability != nil {
+		updateRecord["availability"] = *updates.Availability
+	}
 
 	query, args, err := goqu.Update("programs").
 		Set(updateRecord).
 		Where(goqu.C("id").Eq(id)).
 		Where(goqu.C("created_by").Eq(userId)).
+		Where(notDeleted()).
 		ToSQL()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build update query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update program: %w", err)
 	}
@@ -150,25 +162,121 @@ func (r *programRepo) Update(ctx context.Context, userId, id uuid.UUID, updates
 	return r.GetByID(ctx, id)
 }
 
+// Delete moves the program and its episodes to the trash; PurgeTrash removes them for good.
 func (r *programRepo) Delete(ctx context.Context, userId, id uuid.UUID) error {
-	query, args, err := goqu.Delete("programs").
-		Where(goqu.C("id").Eq(id)).
-		Where(goqu.C("created_by").Eq(userId)).
+	trashed, err := r.trash(ctx, userId, []uuid.UUID{id})
+	if err != nil {
+		return fmt.Errorf("failed to delete program: %w", err)
+	}
+
+	if trashed == 0 {
+		return fmt.Errorf("program not found")
+	}
+
+	return nil
+}
+
+// trash soft deletes the programs together with their episodes. The episodes are marked
+// as deleted with the program, so restoring the program brings back exactly those.
+func (r *programRepo) trash(ctx context.Context, userId uuid.UUID, ids []uuid.UUID) (int, error) {
+	now := time.Now()
+	query, args, err := goqu.Update("programs").
+		Set(goqu.Record{"deleted_at": now}).
+		Where(
+			goqu.C("id").In(ids),
+			goqu.C("created_by").Eq(userId),
+			notDeleted(),
+		).
+		Returning("id").
 		ToSQL()
 	if err != nil {
-		return fmt.Errorf("failed to build delete query: %w", err)
+		return 0, fmt.Errorf("failed to build delete query: %w", err)
 	}
 
-	result, err := r.db.Exec(ctx, query, args...)
+	tx, err := conn(ctx, r.db).Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete program: %w", err)
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	trashed, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return 0, err
+	}
+	if len(trashed) == 0 {
+		return 0, nil
+	}
+
+	query, args, err = goqu.Update("episodes").
+		Set(goqu.Record{"deleted_at": now, "deleted_with_program": true}).
+		Where(goqu.C("program_id").In(trashed), notDeleted()).
+		ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build delete episodes query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return 0, fmt.Errorf("failed to delete episodes: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return len(trashed), nil
+}
+
+func (r *programRepo) Transition(ctx context.Context, transition *biz.StatusTransition) (*biz.Program, error) {
+	now := time.Now()
+	record := goqu.Record{
+		"status":     transition.ToStatus,
+		"updated_at": now,
+	}
+	if transition.ActorID != nil {
+		record["updated_by"] = *transition.ActorID
+	}
+	if biz.ProgramStatus(transition.ToStatus) == biz.ProgramStatusPublished {
+		record["published_at"] = now
+	}
+
+	// Matching the old status makes concurrent transitions fail instead of overwriting each other
+	query, args, err := goqu.Update("programs").
+		Set(record).
+		Where(
+			goqu.C("id").Eq(transition.ContentID),
+			goqu.C("status").Eq(transition.FromStatus),
+			notDeleted(),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transition query: %w", err)
+	}
+
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update program status: %w", err)
+	}
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("program not found")
+		return nil, biz.ErrStatusChanged
 	}
 
-	return nil
+	if err := insertTransitions(ctx, tx, transition); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit program transition: %w", err)
+	}
+
+	return r.GetByID(ctx, transition.ContentID)
 }
 
 func (r *programRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Program, error) {
@@ -181,6 +289,7 @@ func (r *programRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Program,
 		"title",
 		"description",
 		"category_id",
+		programCategoryIDs,
 		"status",
 		"created_at",
 		"updated_at",
@@ -195,8 +304,9 @@ func (r *programRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Program,
 		"is_featured",
 		"view_count",
 		"rating",
+		"availability",
 	).From("programs").
-		Where(goqu.C("id").Eq(id)).
+		Where(goqu.C("id").Eq(id), notDeleted()).
 		ToSQL()
 
 	if err != nil {
@@ -205,11 +315,12 @@ func (r *programRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Program,
 
 	var program biz.Program
 
-	err = r.db.QueryRow(ctx, query, args...).Scan(
+	err = conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(
 		&program.ID,
 		&program.Title,
 		&program.Description,
 		&program.CategoryID,
+		&program.CategoryIDs,
 		&program.Status,
 		&program.CreatedAt,
 		&program.UpdatedAt,
@@ -224,6 +335,7 @@ func (r *programRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Program,
 		&program.IsFeatured,
 		&program.ViewCount,
 		&program.Rating,
+		&program.Availability,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -235,14 +347,88 @@ func (r *programRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Program,
 	return &program, nil
 }
 
+// GetByIDs returns the programs matching ids in no particular order; unknown ids are skipped.
+func (r *programRepo) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*biz.Program, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := goqu.Select(
+		"id",
+		"title",
+		"description",
+		"category_id",
+		programCategoryIDs,
+		"status",
+		"created_at",
+		"updated_at",
+		"published_at",
+		"created_by",
+		"updated_by",
+		"thumbnail_url",
+		"tags",
+		"metadata",
+		"source_url",
+		"episodes_count",
+		"is_featured",
+		"view_count",
+		"rating",
+		"availability",
+	).From("programs").
+		Where(goqu.C("id").In(ids), notDeleted()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query programs: %w", err)
+	}
+	defer rows.Close()
+
+	var programs []*biz.Program
+	for rows.Next() {
+		var program biz.Program
+		err := rows.Scan(
+			&program.ID,
+			&program.Title,
+			&program.Description,
+			&program.CategoryID,
+			&program.CategoryIDs,
+			&program.Status,
+			&program.CreatedAt,
+			&program.UpdatedAt,
+			&program.PublishedAt,
+			&program.CreatedBy,
+			&program.UpdatedBy,
+			&program.ThumbnailURL,
+			&program.Tags,
+			&program.Metadata,
+			&program.SourceURL,
+			&program.EpisodesCount,
+			&program.IsFeatured,
+			&program.ViewCount,
+			&program.Rating,
+			&program.Availability,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan program: %w", err)
+		}
+		programs = append(programs, &program)
+	}
+
+	return programs, rows.Err()
+}
+
 func (r *programRepo) List(ctx context.Context, filter biz.ProgramFilter, pagination biz.PaginationRequest, sort biz.SortRequest) ([]*biz.Program, *biz.PaginationResponse, error) {
 	pagination.SetDefaults()
 
 	// Build WHERE conditions
-	var conditions []exp.Expression
+	conditions := []exp.Expression{notDeleted()}
 
 	if filter.CategoryID != nil {
-		conditions = append(conditions, goqu.C("category_id").Eq(*filter.CategoryID))
+		conditions = append(conditions, inCategoryTree(*filter.CategoryID))
 	}
 
 	if filter.Status != nil {
@@ -283,7 +469,7 @@ func (r *programRepo) List(ctx context.Context, filter biz.ProgramFilter, pagina
 	}
 
 	var totalCount int32
-	err = r.db.QueryRow(ctx, countQuery, countArgs...).Scan(&totalCount)
+	err = conn(ctx, r.db).QueryRow(ctx, countQuery, countArgs...).Scan(&totalCount)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to count programs: %w", err)
 	}
@@ -310,6 +496,7 @@ func (r *programRepo) List(ctx context.Context, filter biz.ProgramFilter, pagina
 		"title",
 		"description",
 		"category_id",
+		programCategoryIDs,
 		"status",
 		"created_at",
 		"updated_at",
@@ -324,6 +511,7 @@ func (r *programRepo) List(ctx context.Context, filter biz.ProgramFilter, pagina
 		"is_featured",
 		"view_count",
 		"rating",
+		"availability",
 	).From("programs").
 		Where(conditions...).
 		Order(orderBy).
@@ -334,7 +522,7 @@ func (r *programRepo) List(ctx context.Context, filter biz.ProgramFilter, pagina
 		return nil, nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	rows, err := r.db.Query(ctx, selectQuery, selectArgs...)
+	rows, err := conn(ctx, r.db).Query(ctx, selectQuery, selectArgs...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to query programs: %w", err)
 	}
@@ -348,6 +536,7 @@ func (r *programRepo) List(ctx context.Context, filter biz.ProgramFilter, pagina
 			&program.Title,
 			&program.Description,
 			&program.CategoryID,
+			&program.CategoryIDs,
 			&program.Status,
 			&program.CreatedAt,
 			&program.UpdatedAt,
@@ -362,6 +551,7 @@ func (r *programRepo) List(ctx context.Context, filter biz.ProgramFilter, pagina
 			&program.IsFeatured,
 			&program.ViewCount,
 			&program.Rating,
+			&program.Availability,
 		)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to scan program: %w", err)
@@ -416,12 +606,13 @@ func (r *programRepo) BulkUpdate(ctx context.Context, userId uuid.UUID, ids []uu
 		Set(updateRecord).
 		Where(goqu.C("id").In(ids)).
 		Where(goqu.C("created_by").Eq(userId)).
+		Where(notDeleted()).
 		ToSQL()
 	if err != nil {
 		return 0, fmt.Errorf("failed to build bulk update query: %w", err)
 	}
 
-	result, err := r.db.Exec(ctx, query, args...)
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		return 0, fmt.Errorf("failed to bulk update programs: %w", err)
 	}
@@ -434,16 +625,7 @@ func (r *programRepo) BulkDelete(ctx context.Context, userId uuid.UUID, ids []uu
 		return nil
 	}
 
-	query, args, err := goqu.Delete("programs").
-		Where(goqu.C("id").In(ids)).
-		Where(goqu.C("created_by").Eq(userId)).
-		ToSQL()
-	if err != nil {
-		return fmt.Errorf("failed to build bulk delete query: %w", err)
-	}
-
-	_, err = r.db.Exec(ctx, query, args...)
-	if err != nil {
+	if _, err := r.trash(ctx, userId, ids); err != nil {
 		return fmt.Errorf("failed to bulk delete programs: %w", err)
 	}
 
@@ -455,13 +637,13 @@ func (r *programRepo) IncrementViewCount(ctx context.Context, id uuid.UUID) erro
 		Set(goqu.Record{
 			"view_count": goqu.L("view_count + 1"),
 		}).
-		Where(goqu.C("id").Eq(id)).
+		Where(goqu.C("id").Eq(id), notDeleted()).
 		ToSQL()
 	if err != nil {
 		return fmt.Errorf("failed to build increment view count query: %w", err)
 	}
 
-	result, err := r.db.Exec(ctx, query, args...)
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to increment view count: %w", err)
 	}
@@ -481,6 +663,7 @@ func (r *programRepo) UpdateEpisodesCount(ctx context.Context, programID uuid.UU
 				Where(goqu.And(
 					goqu.C("program_id").Eq(programID),
 					goqu.C("status").Neq(biz.EpisodeStatusArchived),
+					notDeleted(),
 				)),
 		}).
 		Where(goqu.C("id").Eq(programID)).
@@ -489,10 +672,112 @@ func (r *programRepo) UpdateEpisodesCount(ctx context.Context, programID uuid.UU
 		return fmt.Errorf("failed to build update episodes count query: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query, args...)
+	_, err = conn(ctx, r.db).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update episodes count: %w", err)
 	}
 
 	return nil
 }
+
+func (r *programRepo) SetCategories(ctx context.Context, userID, id, primaryID uuid.UUID, categoryIDs []uuid.UUID) (*biz.Program, error) {
+	others := make([]uuid.UUID, 0, len(categoryIDs))
+	seen := map[uuid.UUID]bool{primaryID: true}
+	for _, categoryID := range categoryIDs {
+		if !seen[categoryID] {
+			seen[categoryID] = true
+			others = append(others, categoryID)
+		}
+	}
+
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Trashed categories cannot take new programs
+	var live int
+	query, args, err := goqu.Select(goqu.COUNT("*")).
+		From("categories").
+		Where(goqu.C("id").In(append(others, primaryID)), notDeleted()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build category check query: %w", err)
+	}
+	if err := tx.QueryRow(ctx, query, args...).Scan(&live); err != nil {
+		return nil, fmt.Errorf("failed to check categories: %w", err)
+	}
+	if live != len(others)+1 {
+		return nil, biz.ErrCategoryNotFound
+	}
+
+	// The trigger on programs moves the primary row along with category_id
+	query, args, err = goqu.Update("programs").
+		Set(goqu.Record{"category_id": primaryID, "updated_by": userID, "updated_at": time.Now()}).
+		Where(
+			goqu.C("id").Eq(id),
+			goqu.C("created_by").Eq(userID),
+			notDeleted(),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update program category: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, fmt.Errorf("program not found")
+	}
+
+	query, args, err = goqu.Delete("program_categories").
+		Where(
+			goqu.C("program_id").Eq(id),
+			goqu.C("is_primary").IsFalse(),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build delete query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to clear program categories: %w", err)
+	}
+
+	if len(others) > 0 {
+		rows := make([]any, 0, len(others))
+		for _, categoryID := range others {
+			rows = append(rows, goqu.Record{"program_id": id, "category_id": categoryID, "is_primary": false})
+		}
+		query, args, err = goqu.Insert("program_categories").Rows(rows...).ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build insert query: %w", err)
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return nil, fmt.Errorf("failed to insert program categories: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit program categories: %w", err)
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// inCategoryTree matches programs in the category or one of its subcategories, as
+// their primary category or another one.
+func inCategoryTree(categoryID uuid.UUID) exp.Expression {
+	return goqu.L(`id IN (
+		SELECT program_id FROM program_categories
+		WHERE category_id IN (
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM categories WHERE id = ?
+				UNION ALL
+				SELECT c.id FROM categories c JOIN subtree ON c.parent_id = subtree.id WHERE c.deleted_at IS NULL
+			)
+			SELECT id FROM subtree
+		)
+	)`, categoryID)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"thmanyah/internal/conf"
 	"thmanyah/internal/modules/cms/biz"
 
 	"github.com/google/uuid"
@@ -310,4 +311,92 @@ func TestProgramRepo_CRUDJourney(t *testing.T) {
 			t.Errorf("Expected episodes count 0, got %d", updatedProgram.EpisodesCount)
 		}
 	})
+
+	// Test 10: Revisions
+	t.Run("Revisions", func(t *testing.T) {
+		revisions := NewRevisionRepository(helper.Pool, &conf.Revisions{ProgramRetention: 2})
+		contentID := uuid.New()
+
+		for _, title := range []string{"First", "First", "Second", "Third"} {
+			err := revisions.Create(ctx, &biz.Revision{
+				ContentType: biz.ContentTypeProgram,
+				ContentID:   contentID,
+				Snapshot:    biz.Snapshot{"title": title},
+				AuthorID:    &userID,
+			})
+			AssertNoError(t, err, "creating revision")
+		}
+
+		// The repeated title stores nothing, and retention keeps the two newest
+		list, pagination, err := revisions.List(ctx, biz.ContentTypeProgram, contentID, biz.PaginationRequest{Page: 1, PageSize: 10})
+		AssertNoError(t, err, "listing revisions")
+		if pagination.TotalCount != 2 || len(list) != 2 {
+			t.Fatalf("Expected 2 revisions, got %d", pagination.TotalCount)
+		}
+		if list[0].Version != 3 || list[1].Version != 2 {
+			t.Errorf("Expected versions 3 and 2, got %d and %d", list[0].Version, list[1].Version)
+		}
+		if len(list[0].ChangedFields) != 1 || list[0].ChangedFields[0] != "title" {
+			t.Errorf("Expected title to be changed, got %v", list[0].ChangedFields)
+		}
+
+		revision, err := revisions.GetByID(ctx, list[1].ID)
+		AssertNoError(t, err, "getting revision")
+		if revision.Snapshot["title"] != "Second" {
+			t.Errorf("Expected snapshot title Second, got %v", revision.Snapshot["title"])
+		}
+	})
+}
+
+func TestProgramRepo_GetByIDs(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewProgramRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	var programs []*biz.Program
+	for _, title := range []string{"Batch Get One", "Batch Get Two", "Batch Get Trashed"} {
+		program := &biz.Program{
+			Title:      title,
+			CategoryID: uuid.MustParse(GetTestCategoryID()),
+			Status:     biz.ProgramStatusDraft,
+			CreatedBy:  userID,
+			UpdatedBy:  userID,
+		}
+		AssertNoError(t, repo.Create(ctx, program), "creating program "+title)
+		programs = append(programs, program)
+	}
+	trashed := programs[2]
+	AssertNoError(t, repo.Delete(ctx, userID, trashed.ID), "trashing program")
+
+	t.Run("SkipsUnknownAndTrashed", func(t *testing.T) {
+		found, err := repo.GetByIDs(ctx, []uuid.UUID{programs[1].ID, uuid.New(), trashed.ID, programs[0].ID})
+		AssertNoError(t, err, "getting programs by IDs")
+
+		got := make(map[uuid.UUID]bool, len(found))
+		for _, program := range found {
+			got[program.ID] = true
+		}
+		if len(found) != 2 || !got[programs[0].ID] || !got[programs[1].ID] {
+			t.Errorf("Expected programs %s and %s, got %d programs", programs[0].ID, programs[1].ID, len(found))
+		}
+	})
+
+	t.Run("DuplicateIDs", func(t *testing.T) {
+		found, err := repo.GetByIDs(ctx, []uuid.UUID{programs[0].ID, programs[0].ID})
+		AssertNoError(t, err, "getting duplicate program IDs")
+		if len(found) != 1 || found[0].ID != programs[0].ID {
+			t.Errorf("Expected program %s once, got %d programs", programs[0].ID, len(found))
+		}
+	})
+
+	t.Run("NoIDs", func(t *testing.T) {
+		found, err := repo.GetByIDs(ctx, nil)
+		AssertNoError(t, err, "getting no programs")
+		if len(found) != 0 {
+			t.Errorf("Expected no programs, got %d", len(found))
+		}
+	})
 }
@@ -0,0 +1,228 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lib/pq"
+)
+
+var revisionColumns = []any{
+	"id",
+	"content_type",
+	"content_id",
+	"version",
+	"snapshot",
+	"changed_fields",
+	"author_id",
+	"restored_from",
+	"created_at",
+}
+
+type revisionRepo struct {
+	db        *pgxpool.Pool
+	retention map[biz.ContentType]int32
+}
+
+func NewRevisionRepository(db *pgxpool.Pool, c *conf.Revisions) biz.RevisionRepository {
+	return &revisionRepo{
+		db: db,
+		retention: map[biz.ContentType]int32{
+			biz.ContentTypeProgram: c.GetProgramRetention(),
+			biz.ContentTypeEpisode: c.GetEpisodeRetention(),
+		},
+	}
+}
+
+func (r *revisionRepo) Create(ctx context.Context, revision *biz.Revision) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Concurrent changes to the same content take turns, so versions stay gapless and
+	// each revision is compared with the one stored right before it
+	_, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", string(revision.ContentType)+":"+revision.ContentID.String())
+	if err != nil {
+		return fmt.Errorf("failed to lock revisions: %w", err)
+	}
+
+	query, args, err := goqu.Select(revisionColumns...).
+		From("revisions").
+		Where(
+			goqu.C("content_type").Eq(revision.ContentType),
+			goqu.C("content_id").Eq(revision.ContentID),
+		).
+		Order(goqu.C("version").Desc()).
+		Limit(1).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	latest, err := scanRevision(tx.QueryRow(ctx, query, args...))
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		revision.Version = 1
+		revision.ChangedFields = []string{}
+	case err != nil:
+		return fmt.Errorf("failed to load latest revision: %w", err)
+	default:
+		revision.ChangedFields = biz.ChangedFields(latest.Snapshot, revision.Snapshot)
+		if len(revision.ChangedFields) == 0 {
+			return nil
+		}
+		revision.Version = latest.Version + 1
+	}
+
+	id := uuid.Must(uuid.NewV7())
+	now := time.Now()
+	query, args, err = goqu.Insert("revisions").Rows(goqu.Record{
+		"id":             id,
+		"content_type":   revision.ContentType,
+		"content_id":     revision.ContentID,
+		"version":        revision.Version,
+		"snapshot":       revision.Snapshot,
+		"changed_fields": pq.Array(revision.ChangedFields),
+		"author_id":      revision.AuthorID,
+		"restored_from":  revision.RestoredFrom,
+		"created_at":     now,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert revision: %w", err)
+	}
+
+	if keep := r.retention[revision.ContentType]; keep > 0 {
+		query, args, err = goqu.Delete("revisions").
+			Where(
+				goqu.C("content_type").Eq(revision.ContentType),
+				goqu.C("content_id").Eq(revision.ContentID),
+				goqu.C("version").Lte(revision.Version-keep),
+			).
+			ToSQL()
+		if err != nil {
+			return fmt.Errorf("failed to build delete query: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to prune revisions: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit revision: %w", err)
+	}
+
+	revision.ID = id
+	revision.CreatedAt = now
+
+	return nil
+}
+
+func (r *revisionRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Revision, error) {
+	query, args, err := goqu.Select(revisionColumns...).
+		From("revisions").
+		Where(goqu.C("id").Eq(id)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	revision, err := scanRevision(conn(ctx, r.db).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, biz.ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+func (r *revisionRepo) List(ctx context.Context, contentType biz.ContentType, contentID uuid.UUID, pagination biz.PaginationRequest) ([]*biz.Revision, *biz.PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	conditions := []exp.Expression{
+		goqu.C("content_type").Eq(contentType),
+		goqu.C("content_id").Eq(contentID),
+	}
+
+	query, args, err := goqu.Select(goqu.COUNT("*")).
+		From("revisions").
+		Where(conditions...).
+		ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var totalCount int32
+	if err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count revisions: %w", err)
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	query, args, err = goqu.Select(revisionColumns...).
+		From("revisions").
+		Where(conditions...).
+		Order(goqu.C("version").Desc()).
+		Limit(uint(pagination.PageSize)).
+		Offset(uint(offset)).
+		ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*biz.Revision
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan revision: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return revisions, paginationResponse(pagination, totalCount), nil
+}
+
+func scanRevision(row pgx.Row) (*biz.Revision, error) {
+	var revision biz.Revision
+	err := row.Scan(
+		&revision.ID,
+		&revision.ContentType,
+		&revision.ContentID,
+		&revision.Version,
+		&revision.Snapshot,
+		&revision.ChangedFields,
+		&revision.AuthorID,
+		&revision.RestoredFrom,
+		&revision.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
@@ -0,0 +1,186 @@
+package repo
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+var (
+	baselineProgramA = uuid.MustParse("770e8400-e29b-41d4-a716-446655440000")
+	baselineProgramB = uuid.MustParse("770e8400-e29b-41d4-a716-446655440001")
+)
+
+// baselineSeed is content stored before seasons, soft deletes, program categories and the
+// tag taxonomy existed
+const baselineSeed = `
+INSERT INTO users (id, name, email, password) VALUES
+('550e8400-e29b-41d4-a716-446655440000', 'Test User 1', 'test1@example.com', 'hashed_password_1');
+
+INSERT INTO categories (id, name, description, type, created_by) VALUES
+('660e8400-e29b-41d4-a716-446655440000', 'Test Category 1', '', 'CATEGORY_TYPE_PODCAST', '550e8400-e29b-41d4-a716-446655440000');
+
+INSERT INTO programs (id, title, category_id, status, created_by, updated_by, tags) VALUES
+('770e8400-e29b-41d4-a716-446655440000', 'Program A', '660e8400-e29b-41d4-a716-446655440000', 'PROGRAM_STATUS_PUBLISHED',
+ '550e8400-e29b-41d4-a716-446655440000', '550e8400-e29b-41d4-a716-446655440000', '{"Tech", "tech ", "Machine Learning"}'),
+('770e8400-e29b-41d4-a716-446655440001', 'Program B', '660e8400-e29b-41d4-a716-446655440000', 'PROGRAM_STATUS_DRAFT',
+ '550e8400-e29b-41d4-a716-446655440000', '550e8400-e29b-41d4-a716-446655440000', '{}');
+
+INSERT INTO episodes (id, program_id, title, episode_number, season_number, created_by, updated_by, tags) VALUES
+('880e8400-e29b-41d4-a716-446655440000', '770e8400-e29b-41d4-a716-446655440000', 'A 1x1', 1, 1,
+ '550e8400-e29b-41d4-a716-446655440000', '550e8400-e29b-41d4-a716-446655440000', '{"machine-learning", "News"}'),
+('880e8400-e29b-41d4-a716-446655440001', '770e8400-e29b-41d4-a716-446655440000', 'A 1x2', 2, 1,
+ '550e8400-e29b-41d4-a716-446655440000', '550e8400-e29b-41d4-a716-446655440000', '{}'),
+('880e8400-e29b-41d4-a716-446655440002', '770e8400-e29b-41d4-a716-446655440000', 'A 2x1', 1, 2,
+ '550e8400-e29b-41d4-a716-446655440000', '550e8400-e29b-41d4-a716-446655440000', '{}'),
+('880e8400-e29b-41d4-a716-446655440003', '770e8400-e29b-41d4-a716-446655440001', 'B 1x1', 1, 1,
+ '550e8400-e29b-41d4-a716-446655440000', '550e8400-e29b-41d4-a716-446655440000', '{}');
+`
+
+// TestInitSchema_UpgradesBaseline runs init.sql against a database created by its first
+// version, as on an existing deployment, and then once more.
+func TestInitSchema_UpgradesBaseline(t *testing.T) {
+	helper := SetupEmptyTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	initSQL := filepath.Join("..", "..", "..", "..", "..", "platform", "sql", "init.sql")
+
+	AssertNoError(t, helper.ExecFile(ctx, filepath.Join("testdata", "baseline_init.sql")), "creating the baseline schema")
+	_, err := helper.Pool.Exec(ctx, baselineSeed)
+	AssertNoError(t, err, "seeding the baseline schema")
+	AssertNoError(t, helper.ExecFile(ctx, initSQL), "upgrading the schema")
+	AssertNoError(t, helper.ExecFile(ctx, initSQL), "running the upgrade again")
+
+	t.Run("Seasons", func(t *testing.T) {
+		seasons, err := NewSeasonRepository(helper.Pool).ListByProgram(ctx, baselineProgramA)
+		AssertNoError(t, err, "listing seasons")
+		if len(seasons) != 2 {
+			t.Fatalf("Expected 2 seasons, got %d", len(seasons))
+		}
+
+		episodes := NewEpisodeRepository(helper.Pool)
+		for _, season := range seasons {
+			count, err := helper.CountRows(ctx, "episodes", "season_id = $1 AND season_number = $2", season.ID, season.SeasonNumber)
+			AssertNoError(t, err, "counting episodes of season")
+			want := map[int32]int{1: 2, 2: 1}[season.SeasonNumber]
+			if count != want {
+				t.Errorf("Expected %d episodes in season %d, got %d", want, season.SeasonNumber, count)
+			}
+		}
+
+		count, err := helper.CountRows(ctx, "seasons", "program_id = $1", baselineProgramB)
+		AssertNoError(t, err, "counting seasons of program B")
+		if count != 1 {
+			t.Errorf("Expected 1 season of program B, got %d", count)
+		}
+
+		// New episodes join the seasons that were carried over
+		episode := &biz.Episode{
+			ProgramID:     baselineProgramA,
+			Title:         "A 2x2",
+			EpisodeNumber: 2,
+			SeasonNumber:  2,
+			Status:        biz.EpisodeStatusDraft,
+			CreatedBy:     uuid.MustParse(GetTestUserID()),
+			UpdatedBy:     uuid.MustParse(GetTestUserID()),
+		}
+		AssertNoError(t, episodes.Create(ctx, episode), "creating episode")
+		created, err := episodes.GetByID(ctx, episode.ID)
+		AssertNoError(t, err, "getting episode")
+		if created.SeasonID != seasons[1].ID {
+			t.Errorf("Expected episode in season %s, got %s", seasons[1].ID, created.SeasonID)
+		}
+	})
+
+	t.Run("SoftDelete", func(t *testing.T) {
+		programs := NewProgramRepository(helper.Pool)
+		AssertNoError(t, programs.Delete(ctx, uuid.MustParse(GetTestUserID()), baselineProgramB), "trashing program")
+
+		_, err := programs.GetByID(ctx, baselineProgramB)
+		AssertError(t, err, "getting trashed program")
+	})
+
+	t.Run("ProgramCategories", func(t *testing.T) {
+		count, err := helper.CountRows(ctx, "program_categories", "category_id = $1 AND is_primary", GetTestCategoryID())
+		AssertNoError(t, err, "counting program categories")
+		if count != 2 {
+			t.Errorf("Expected 2 primary program categories, got %d", count)
+		}
+	})
+
+	t.Run("Tags", func(t *testing.T) {
+		tags, _, err := NewTagRepository(helper.Pool).List(ctx, biz.TagFilter{}, biz.PaginationRequest{Page: 1, PageSize: 10}, biz.SortRequest{})
+		AssertNoError(t, err, "listing tags")
+
+		slugs := make([]string, 0, len(tags))
+		names := make(map[string]string, len(tags))
+		for _, tag := range tags {
+			slugs = append(slugs, tag.Slug)
+			names[tag.Slug] = tag.Name
+		}
+		slices.Sort(slugs)
+		if !slices.Equal(slugs, []string{"machine-learning", "news", "tech"}) {
+			t.Fatalf("Expected tags machine-learning, news and tech, got %v", slugs)
+		}
+
+		var programTags, episodeTags []string
+		err = helper.Pool.QueryRow(ctx, "SELECT tags FROM programs WHERE id = $1", baselineProgramA).Scan(&programTags)
+		AssertNoError(t, err, "getting program tags")
+		err = helper.Pool.QueryRow(ctx, "SELECT tags FROM episodes WHERE id = '880e8400-e29b-41d4-a716-446655440000'").Scan(&episodeTags)
+		AssertNoError(t, err, "getting episode tags")
+
+		if want := []string{names["tech"], names["machine-learning"]}; !slices.Equal(programTags, want) {
+			t.Errorf("Expected program tags %v, got %v", want, programTags)
+		}
+		if want := []string{names["machine-learning"], names["news"]}; !slices.Equal(episodeTags, want) {
+			t.Errorf("Expected episode tags %v, got %v", want, episodeTags)
+		}
+	})
+}
+
+// TestContentAvailable_MatchesCheck makes sure the database hides exactly the content
+// Availability.Check reports as unavailable.
+func TestContentAvailable_MatchesCheck(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	before := time.Now().Add(-time.Hour)
+	after := time.Now().Add(time.Hour)
+
+	availabilities := map[string]biz.Availability{
+		"Unlimited":  {},
+		"Started":    {StartAt: &before},
+		"NotStarted": {StartAt: &after},
+		"NotEnded":   {EndAt: &after},
+		"Ended":      {StartAt: &before, EndAt: &before},
+		"Window":     {StartAt: &before, EndAt: &after},
+		"Allowed":    {AllowedCountries: []string{"SA", "AE"}},
+		"Blocked":    {BlockedCountries: []string{"EG"}},
+		"Everything": {StartAt: &before, EndAt: &after, AllowedCountries: []string{"SA"}, BlockedCountries: []string{"EG"}},
+	}
+
+	for name, availability := range availabilities {
+		for _, country := range []string{"", "SA", "AE", "EG"} {
+			rules, err := availability.Value()
+			AssertNoError(t, err, "encoding availability")
+
+			var now time.Time
+			var available bool
+			err = helper.Pool.QueryRow(ctx, "SELECT NOW(), content_available($1::jsonb, $2)", string(rules.([]byte)), country).
+				Scan(&now, &available)
+			AssertNoError(t, err, "checking availability")
+
+			if want := availability.Available(now, country); available != want {
+				t.Errorf("%s in %q: expected content_available to be %v, got %v", name, country, want, available)
+			}
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var seasonColumns = []any{
+	"id",
+	"program_id",
+	"season_number",
+	"title",
+	"description",
+	"thumbnail_url",
+	"release_start_at",
+	"release_end_at",
+	"sort_order",
+	"created_at",
+	"updated_at",
+}
+
+type seasonRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewSeasonRepository(db *pgxpool.Pool) biz.SeasonRepository {
+	return &seasonRepo{
+		db: db,
+	}
+}
+
+// seasonsWithCounts is the seasons table along with how many episodes outside the trash
+// each season has.
+func seasonsWithCounts() *goqu.SelectDataset {
+	return goqu.From("seasons").Select(append(seasonColumns,
+		goqu.L("(SELECT COUNT(*) FROM episodes WHERE episodes.season_id = seasons.id AND episodes.deleted_at IS NULL)").As("episodes_count"),
+	)...)
+}
+
+func (r *seasonRepo) Create(ctx context.Context, season *biz.Season) error {
+	query, args, err := goqu.Insert("seasons").Rows(goqu.Record{
+		"id":               season.ID,
+		"program_id":       season.ProgramID,
+		"season_number":    season.SeasonNumber,
+		"title":            season.Title,
+		"description":      season.Description,
+		"thumbnail_url":    season.ThumbnailURL,
+		"release_start_at": season.ReleaseStartAt,
+		"release_end_at":   season.ReleaseEndAt,
+		"sort_order":       season.SortOrder,
+		"created_at":       season.CreatedAt,
+		"updated_at":       season.UpdatedAt,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "seasons_program_id_season_number_key" {
+				return biz.ErrSeasonAlreadyExists
+			}
+			if pgErr.Code == "23503" && pgErr.ConstraintName == "seasons_program_id_fkey" {
+				return biz.ErrProgramNotFound
+			}
+		}
+		return fmt.Errorf("failed to insert season: %w", err)
+	}
+
+	return nil
+}
+
+func (r *seasonRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Season, error) {
+	query, args, err := seasonsWithCounts().Where(goqu.C("id").Eq(id)).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	season, err := scanSeason(conn(ctx, r.db).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, biz.ErrSeasonNotFound
+		}
+		return nil, fmt.Errorf("failed to scan season: %w", err)
+	}
+
+	return season, nil
+}
+
+func (r *seasonRepo) ListByProgram(ctx context.Context, programID uuid.UUID) ([]*biz.Season, error) {
+	query, args, err := seasonsWithCounts().
+		Where(goqu.C("program_id").Eq(programID)).
+		Order(goqu.C("sort_order").Asc(), goqu.C("season_number").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query seasons: %w", err)
+	}
+
+	seasons, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.Season, error) {
+		return scanSeason(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan seasons: %w", err)
+	}
+
+	return seasons, nil
+}
+
+func (r *seasonRepo) Update(ctx context.Context, id uuid.UUID, updates *biz.UpdateSeasonRequest) (*biz.Season, error) {
+	record := goqu.Record{
+		"updated_at": time.Now(),
+	}
+	if updates.SeasonNumber != nil {
+		record["season_number"] = *updates.SeasonNumber
+	}
+	if updates.Title != nil {
+		record["title"] = *updates.Title
+	}
+	if updates.Description != nil {
+		record["description"] = *updates.Description
+	}
+	if updates.ThumbnailURL != nil {
+		record["thumbnail_url"] = *updates.ThumbnailURL
+	}
+	if updates.ReleaseStartAt != nil {
+		record["release_start_at"] = *updates.ReleaseStartAt
+	}
+	if updates.ReleaseEndAt != nil {
+		record["release_end_at"] = *updates.ReleaseEndAt
+	}
+	if updates.SortOrder != nil {
+		record["sort_order"] = *updates.SortOrder
+	}
+
+	query, args, err := goqu.Update("seasons").
+		Set(record).
+		Where(goqu.C("id").Eq(id)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	// A new season number is carried over to the episodes by a trigger
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "seasons_program_id_season_number_key" {
+			return nil, biz.ErrSeasonAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to update season: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, biz.ErrSeasonNotFound
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *seasonRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	query, args, err := goqu.Delete("seasons").Where(goqu.C("id").Eq(id)).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" && pgErr.ConstraintName == "episodes_season_id_fkey" {
+			return biz.ErrSeasonHasEpisodes
+		}
+		return fmt.Errorf("failed to delete season: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return biz.ErrSeasonNotFound
+	}
+
+	return nil
+}
+
+func scanSeason(row pgx.Row) (*biz.Season, error) {
+	var season biz.Season
+	err := row.Scan(
+		&season.ID,
+		&season.ProgramID,
+		&season.SeasonNumber,
+		&season.Title,
+		&season.Description,
+		&season.ThumbnailURL,
+		&season.ReleaseStartAt,
+		&season.ReleaseEndAt,
+		&season.SortOrder,
+		&season.CreatedAt,
+		&season.UpdatedAt,
+		&season.EpisodesCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &season, nil
+}
@@ -0,0 +1,199 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+func TestSeasonRepo(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewSeasonRepository(helper.Pool)
+	programs := NewProgramRepository(helper.Pool)
+	episodes := NewEpisodeRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	program := &biz.Program{
+		Title:      "Seasonal Program",
+		CategoryID: uuid.MustParse(GetTestCategoryID()),
+		Status:     biz.ProgramStatusDraft,
+		CreatedBy:  userID,
+		UpdatedBy:  userID,
+	}
+	AssertNoError(t, programs.Create(ctx, program), "creating program")
+
+	newSeason := func(number int32) *biz.Season {
+		now := time.Now()
+		return &biz.Season{
+			ID:           uuid.Must(uuid.NewV7()),
+			ProgramID:    program.ID,
+			SeasonNumber: number,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}
+	}
+	createEpisode := func(t *testing.T, season, number int32) (*biz.Episode, error) {
+		t.Helper()
+		episode := &biz.Episode{
+			ProgramID:     program.ID,
+			Title:         "Episode",
+			EpisodeNumber: number,
+			SeasonNumber:  season,
+			Status:        biz.EpisodeStatusDraft,
+			CreatedBy:     userID,
+			UpdatedBy:     userID,
+		}
+		return episode, episodes.Create(ctx, episode)
+	}
+
+	second := newSeason(2)
+	second.Title = "Second Season"
+	second.SortOrder = 1
+	AssertNoError(t, repo.Create(ctx, second), "creating season")
+
+	t.Run("Create_Duplicate", func(t *testing.T) {
+		err := repo.Create(ctx, newSeason(2))
+		if !errors.Is(err, biz.ErrSeasonAlreadyExists) {
+			t.Errorf("Expected ErrSeasonAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("Create_UnknownProgram", func(t *testing.T) {
+		season := newSeason(1)
+		season.ProgramID = uuid.New()
+		if err := repo.Create(ctx, season); !errors.Is(err, biz.ErrProgramNotFound) {
+			t.Errorf("Expected ErrProgramNotFound, got %v", err)
+		}
+	})
+
+	var first, inSecond *biz.Episode
+	t.Run("EpisodesJoinSeasons", func(t *testing.T) {
+		// An episode of a new season number adds the season
+		var err error
+		first, err = createEpisode(t, 1, 1)
+		AssertNoError(t, err, "creating episode")
+		inSecond, err = createEpisode(t, 2, 1)
+		AssertNoError(t, err, "creating episode")
+		if inSecond.SeasonID != second.ID {
+			t.Errorf("Expected the episode in season %s, got %s", second.ID, inSecond.SeasonID)
+		}
+
+		seasons, err := repo.ListByProgram(ctx, program.ID)
+		AssertNoError(t, err, "listing seasons")
+		if len(seasons) != 2 {
+			t.Fatalf("Expected 2 seasons, got %d", len(seasons))
+		}
+		// By sort order, then number
+		if seasons[0].SeasonNumber != 1 || seasons[0].ID != first.SeasonID || seasons[1].ID != second.ID {
+			t.Errorf("Expected seasons 1 and 2, got %d and %d", seasons[0].SeasonNumber, seasons[1].SeasonNumber)
+		}
+		if seasons[0].EpisodesCount != 1 || seasons[1].EpisodesCount != 1 {
+			t.Errorf("Expected 1 episode in each season, got %d and %d", seasons[0].EpisodesCount, seasons[1].EpisodesCount)
+		}
+	})
+
+	t.Run("EpisodeNumberConflicts", func(t *testing.T) {
+		if _, err := createEpisode(t, 1, 1); !errors.Is(err, biz.ErrEpisodeAlreadyExists) {
+			t.Errorf("Expected ErrEpisodeAlreadyExists, got %v", err)
+		}
+
+		// The same number in another season, or another number, is fine
+		other, err := createEpisode(t, 1, 2)
+		AssertNoError(t, err, "creating episode")
+
+		number := int32(1)
+		_, err = episodes.Update(ctx, userID, other.ID, &biz.UpdateEpisodeRequest{EpisodeNumber: &number})
+		if !errors.Is(err, biz.ErrEpisodeAlreadyExists) {
+			t.Errorf("Expected ErrEpisodeAlreadyExists, got %v", err)
+		}
+		season := int32(2)
+		_, err = episodes.Update(ctx, userID, first.ID, &biz.UpdateEpisodeRequest{SeasonNumber: &season})
+		if !errors.Is(err, biz.ErrEpisodeAlreadyExists) {
+			t.Errorf("Expected ErrEpisodeAlreadyExists moving into a taken number, got %v", err)
+		}
+
+		// A trashed episode gives up its number
+		AssertNoError(t, episodes.Delete(ctx, userID, other.ID), "trashing episode")
+		replacement, err := createEpisode(t, 1, 2)
+		AssertNoError(t, err, "creating replacement episode")
+
+		// Moving an episode to another season moves it to that season's row
+		season = int32(4)
+		moved, err := episodes.Update(ctx, userID, replacement.ID, &biz.UpdateEpisodeRequest{SeasonNumber: &season})
+		AssertNoError(t, err, "moving episode")
+		fourth, err := repo.GetByID(ctx, moved.SeasonID)
+		AssertNoError(t, err, "getting season")
+		if fourth.SeasonNumber != 4 || fourth.EpisodesCount != 1 {
+			t.Errorf("Expected season 4 with 1 episode, got %d with %d", fourth.SeasonNumber, fourth.EpisodesCount)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		title := "Season Two"
+		sortOrder := int32(-1)
+		updated, err := repo.Update(ctx, second.ID, &biz.UpdateSeasonRequest{Title: &title, SortOrder: &sortOrder})
+		AssertNoError(t, err, "updating season")
+		if updated.Title != title || updated.SortOrder != -1 || updated.SeasonNumber != 2 {
+			t.Errorf("Expected the season renamed and moved first, got %+v", updated)
+		}
+
+		// A new number is carried over to the episodes of the season
+		number := int32(3)
+		updated, err = repo.Update(ctx, second.ID, &biz.UpdateSeasonRequest{SeasonNumber: &number})
+		AssertNoError(t, err, "renumbering season")
+		if updated.SeasonNumber != 3 {
+			t.Errorf("Expected season 3, got %d", updated.SeasonNumber)
+		}
+		episode, err := episodes.GetByID(ctx, inSecond.ID)
+		AssertNoError(t, err, "getting episode")
+		if episode.SeasonNumber != 3 || episode.SeasonID != second.ID {
+			t.Errorf("Expected the episode in season 3, got season %d", episode.SeasonNumber)
+		}
+
+		number = 1
+		_, err = repo.Update(ctx, second.ID, &biz.UpdateSeasonRequest{SeasonNumber: &number})
+		if !errors.Is(err, biz.ErrSeasonAlreadyExists) {
+			t.Errorf("Expected ErrSeasonAlreadyExists, got %v", err)
+		}
+
+		_, err = repo.Update(ctx, uuid.New(), &biz.UpdateSeasonRequest{Title: &title})
+		if !errors.Is(err, biz.ErrSeasonNotFound) {
+			t.Errorf("Expected ErrSeasonNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		if err := repo.Delete(ctx, second.ID); !errors.Is(err, biz.ErrSeasonHasEpisodes) {
+			t.Errorf("Expected ErrSeasonHasEpisodes, got %v", err)
+		}
+
+		// Episodes in the trash still keep their season
+		AssertNoError(t, episodes.Delete(ctx, userID, inSecond.ID), "trashing episode")
+		season, err := repo.GetByID(ctx, second.ID)
+		AssertNoError(t, err, "getting season")
+		if season.EpisodesCount != 0 {
+			t.Errorf("Expected trashed episodes left out of the count, got %d", season.EpisodesCount)
+		}
+		if err := repo.Delete(ctx, second.ID); !errors.Is(err, biz.ErrSeasonHasEpisodes) {
+			t.Errorf("Expected ErrSeasonHasEpisodes with a trashed episode, got %v", err)
+		}
+
+		empty := newSeason(9)
+		AssertNoError(t, repo.Create(ctx, empty), "creating season")
+		AssertNoError(t, repo.Delete(ctx, empty.ID), "deleting season")
+		if _, err := repo.GetByID(ctx, empty.ID); !errors.Is(err, biz.ErrSeasonNotFound) {
+			t.Errorf("Expected ErrSeasonNotFound, got %v", err)
+		}
+		if err := repo.Delete(ctx, empty.ID); !errors.Is(err, biz.ErrSeasonNotFound) {
+			t.Errorf("Expected ErrSeasonNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,472 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lib/pq"
+)
+
+var tagColumns = []any{
+	"id",
+	"name",
+	"slug",
+	"translations",
+	"aliases",
+	"program_count",
+	"episode_count",
+	"created_at",
+	"updated_at",
+}
+
+// retagQuery replaces the tags matching $1, compared in lower case, by $2 in the tags of
+// every program or episode, trashed or not. Each tag keeps its first position.
+const retagQuery = `
+	UPDATE %s SET tags = ARRAY(
+		SELECT tag FROM (
+			SELECT DISTINCT ON (tag) tag, ord FROM (
+				SELECT CASE WHEN lower(t) = ANY($1) THEN $2 ELSE t END AS tag, ord
+				FROM unnest(tags) WITH ORDINALITY AS u(t, ord)
+			) replaced
+			ORDER BY tag, ord
+		) deduped
+		ORDER BY ord
+	), updated_at = NOW()
+	WHERE EXISTS (SELECT 1 FROM unnest(tags) AS t WHERE lower(t) = ANY($1))
+`
+
+type tagRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewTagRepository(db *pgxpool.Pool) biz.TagRepository {
+	return &tagRepo{
+		db: db,
+	}
+}
+
+// tagsWithUsage is the tags table along with how many programs and episodes outside the
+// trash use each tag.
+func tagsWithUsage() *goqu.SelectDataset {
+	usage := goqu.From(goqu.T("tags").As("t")).Select(
+		goqu.L("t.*"),
+		goqu.L("(SELECT COUNT(*)::int FROM programs p WHERE p.tags @> ARRAY[t.name] AND p.deleted_at IS NULL)").As("program_count"),
+		goqu.L("(SELECT COUNT(*)::int FROM episodes e WHERE e.tags @> ARRAY[t.name] AND e.deleted_at IS NULL)").As("episode_count"),
+	)
+	return goqu.From(usage.As("tags")).Select(tagColumns...)
+}
+
+func (r *tagRepo) Resolve(ctx context.Context, names []string) ([]*biz.Tag, error) {
+	slugs := make([]string, len(names))
+	for i, name := range names {
+		slugs[i] = biz.TagSlug(name)
+	}
+
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	bySlug, err := r.lookup(ctx, tx, slugs)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := false
+	for _, slug := range slugs {
+		missing = missing || bySlug[slug] == nil
+	}
+
+	if missing {
+		// Tags are only added under the taxonomy lock, so that no other tag takes the
+		// slug as an alias in the meantime
+		if err := lockTags(ctx, tx); err != nil {
+			return nil, err
+		}
+		if bySlug, err = r.lookup(ctx, tx, slugs); err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		for i, slug := range slugs {
+			if bySlug[slug] != nil {
+				continue
+			}
+			tag := &biz.Tag{
+				ID:           uuid.Must(uuid.NewV7()),
+				Name:         strings.TrimSpace(names[i]),
+				Slug:         slug,
+				Translations: map[string]string{},
+				Aliases:      []string{},
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			}
+			_, err := tx.Exec(ctx, `
+				INSERT INTO tags (id, name, slug, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)
+			`, tag.ID, tag.Name, tag.Slug, tag.CreatedAt, tag.UpdatedAt)
+			if err != nil {
+				return nil, fmt.Errorf("failed to insert tag: %w", err)
+			}
+			bySlug[slug] = tag
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tags: %w", err)
+	}
+
+	tags := make([]*biz.Tag, len(slugs))
+	for i, slug := range slugs {
+		tags[i] = bySlug[slug]
+	}
+	return tags, nil
+}
+
+// lookup finds the tags standing for slugs, keyed by the slugs they were found under.
+func (r *tagRepo) lookup(ctx context.Context, q querier, slugs []string) (map[string]*biz.Tag, error) {
+	tags, err := r.query(ctx, q, tagsWithUsage().
+		Where(goqu.L("slug = ANY(?::text[]) OR aliases && ?::text[]", pq.Array(slugs), pq.Array(slugs))))
+	if err != nil {
+		return nil, err
+	}
+
+	bySlug := make(map[string]*biz.Tag, len(slugs))
+	for _, tag := range tags {
+		for _, alias := range tag.Aliases {
+			bySlug[alias] = tag
+		}
+	}
+	// A slug wins over an alias of another tag
+	for _, tag := range tags {
+		bySlug[tag.Slug] = tag
+	}
+
+	found := make(map[string]*biz.Tag, len(slugs))
+	for _, slug := range slugs {
+		if tag, ok := bySlug[slug]; ok {
+			found[slug] = tag
+		}
+	}
+	return found, nil
+}
+
+func (r *tagRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.Tag, error) {
+	return r.get(ctx, conn(ctx, r.db), id)
+}
+
+func (r *tagRepo) get(ctx context.Context, q querier, id uuid.UUID) (*biz.Tag, error) {
+	tags, err := r.query(ctx, q, tagsWithUsage().Where(goqu.C("id").Eq(id)))
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, biz.ErrTagNotFound
+	}
+	return tags[0], nil
+}
+
+func (r *tagRepo) List(ctx context.Context, filter biz.TagFilter, pagination biz.PaginationRequest, sort biz.SortRequest) ([]*biz.Tag, *biz.PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	var conditions []exp.Expression
+	if filter.SearchQuery != nil && *filter.SearchQuery != "" {
+		searchPattern := "%" + *filter.SearchQuery + "%"
+		conditions = append(conditions, goqu.Or(
+			goqu.C("name").ILike(searchPattern),
+			goqu.L("? = ANY(aliases)", biz.TagSlug(*filter.SearchQuery)),
+		))
+	}
+
+	query, args, err := goqu.Select(goqu.COUNT("*")).
+		From("tags").
+		Where(conditions...).
+		ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var totalCount int32
+	if err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count tags: %w", err)
+	}
+
+	var orderBy exp.Orderable = goqu.C("name")
+	if sort.SortBy == "usage" {
+		orderBy = goqu.L("program_count + episode_count")
+	}
+	order := orderBy.Asc()
+	if sort.SortOrder == "desc" {
+		order = orderBy.Desc()
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	tags, err := r.query(ctx, conn(ctx, r.db), tagsWithUsage().
+		Where(conditions...).
+		Order(order, goqu.C("name").Asc()).
+		Limit(uint(pagination.PageSize)).
+		Offset(uint(offset)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tags, paginationResponse(pagination, totalCount), nil
+}
+
+func (r *tagRepo) Autocomplete(ctx context.Context, prefix string, limit int32) ([]*biz.Tag, error) {
+	pattern := escapeLike(strings.ToLower(prefix)) + "%"
+	conditions := []exp.Expression{
+		goqu.L("lower(name) LIKE ?", pattern),
+		goqu.L("EXISTS (SELECT 1 FROM jsonb_each_text(translations) AS tr WHERE lower(tr.value) LIKE ?)", pattern),
+	}
+	if slug := biz.TagSlug(prefix); slug != "" {
+		slugPattern := escapeLike(slug) + "%"
+		conditions = append(conditions,
+			goqu.C("slug").Like(slugPattern),
+			goqu.L("EXISTS (SELECT 1 FROM unnest(aliases) AS alias WHERE alias LIKE ?)", slugPattern),
+		)
+	}
+
+	return r.query(ctx, conn(ctx, r.db), tagsWithUsage().
+		Where(goqu.Or(conditions...)).
+		Order(goqu.L("program_count + episode_count").Desc(), goqu.C("name").Asc()).
+		Limit(uint(limit)))
+}
+
+func (r *tagRepo) Update(ctx context.Context, id uuid.UUID, updates *biz.UpdateTagRequest) (*biz.Tag, error) {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockTags(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	record := goqu.Record{"updated_at": time.Now()}
+	if updates.Translations != nil {
+		translations := biz.Metadata(*updates.Translations)
+		if translations == nil {
+			translations = biz.Metadata{}
+		}
+		record["translations"] = translations
+	}
+	if updates.Aliases != nil {
+		if err := checkTagKeys(ctx, tx, id, *updates.Aliases); err != nil {
+			return nil, err
+		}
+		record["aliases"] = pq.Array(*updates.Aliases)
+	}
+
+	query, args, err := goqu.Update("tags").Set(record).Where(goqu.C("id").Eq(id)).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, biz.ErrTagNotFound
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tag: %w", err)
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *tagRepo) Rename(ctx context.Context, id uuid.UUID, name string) (*biz.Tag, error) {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockTags(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	tag, err := r.get(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	slug := biz.TagSlug(name)
+	aliases := tag.Aliases
+	if slug != tag.Slug {
+		if err := checkTagKeys(ctx, tx, id, []string{slug}); err != nil {
+			return nil, err
+		}
+		aliases = make([]string, 0, len(tag.Aliases)+1)
+		for _, alias := range tag.Aliases {
+			if alias != slug {
+				aliases = append(aliases, alias)
+			}
+		}
+		aliases = append(aliases, tag.Slug)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE tags SET name = $2, slug = $3, aliases = $4, updated_at = NOW() WHERE id = $1
+	`, id, name, slug, aliases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	if err := retag(ctx, tx, []string{tag.Name}, name); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tag rename: %w", err)
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *tagRepo) Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*biz.Tag, error) {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockTags(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	target, err := r.get(ctx, tx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := r.query(ctx, tx, tagsWithUsage().Where(goqu.C("id").In(sourceIDs)))
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) != len(sourceIDs) {
+		return nil, biz.ErrTagNotFound
+	}
+
+	aliases := make([]string, 0, len(target.Aliases)+len(sources))
+	seen := map[string]bool{target.Slug: true}
+	names := make([]string, 0, len(sources))
+	for _, source := range sources {
+		names = append(names, source.Name)
+		for _, alias := range append(append(target.Aliases, source.Slug), source.Aliases...) {
+			if !seen[alias] {
+				seen[alias] = true
+				aliases = append(aliases, alias)
+			}
+		}
+	}
+
+	if err := retag(ctx, tx, names, target.Name); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, "DELETE FROM tags WHERE id = ANY($1)", sourceIDs); err != nil {
+		return nil, fmt.Errorf("failed to delete merged tags: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE tags SET aliases = $2, updated_at = NOW() WHERE id = $1
+	`, targetID, aliases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update merged tag: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tag merge: %w", err)
+	}
+
+	return r.GetByID(ctx, targetID)
+}
+
+// lockTags serializes changes to tag slugs and aliases, which have to stay unique
+// across the taxonomy.
+func lockTags(ctx context.Context, tx pgx.Tx) error {
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('tags'))"); err != nil {
+		return fmt.Errorf("failed to lock tags: %w", err)
+	}
+	return nil
+}
+
+// checkTagKeys fails when another tag than id has one of keys as its slug or alias.
+func checkTagKeys(ctx context.Context, tx pgx.Tx, id uuid.UUID, keys []string) error {
+	var taken bool
+	err := tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM tags WHERE id <> $1 AND (slug = ANY($2) OR aliases && $2))
+	`, id, keys).Scan(&taken)
+	if err != nil {
+		return fmt.Errorf("failed to check tag aliases: %w", err)
+	}
+	if taken {
+		return biz.ErrTagAlreadyExists
+	}
+	return nil
+}
+
+// retag replaces the tags named from by to in the tags of all programs and episodes.
+func retag(ctx context.Context, tx pgx.Tx, from []string, to string) error {
+	lower := make([]string, len(from))
+	for i, name := range from {
+		lower[i] = strings.ToLower(name)
+	}
+
+	for _, table := range []string{"programs", "episodes"} {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(retagQuery, table), lower, to); err != nil {
+			return fmt.Errorf("failed to retag %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (r *tagRepo) query(ctx context.Context, q querier, ds *goqu.SelectDataset) ([]*biz.Tag, error) {
+	query, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tags: %w", err)
+	}
+
+	tags, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.Tag, error) {
+		var tag biz.Tag
+		err := row.Scan(
+			&tag.ID,
+			&tag.Name,
+			&tag.Slug,
+			&tag.Translations,
+			&tag.Aliases,
+			&tag.ProgramCount,
+			&tag.EpisodeCount,
+			&tag.CreatedAt,
+			&tag.UpdatedAt,
+		)
+		return &tag, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tag: %w", err)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,133 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+func TestTagRepo_RenameAndMerge(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewTagRepository(helper.Pool)
+	programs := NewProgramRepository(helper.Pool)
+	episodes := NewEpisodeRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+	categoryID := uuid.MustParse(GetTestCategoryID())
+
+	tags, err := repo.Resolve(ctx, []string{"Tech", "AI", "Machine Learning", "News"})
+	AssertNoError(t, err, "resolving tags")
+	tech, ai, ml, news := tags[0], tags[1], tags[2], tags[3]
+
+	createProgram := func(title string, tags []string) uuid.UUID {
+		program := &biz.Program{
+			Title:      title,
+			CategoryID: categoryID,
+			Status:     biz.ProgramStatusDraft,
+			CreatedBy:  userID,
+			UpdatedBy:  userID,
+			Tags:       tags,
+		}
+		AssertNoError(t, programs.Create(ctx, program), "creating program")
+		return program.ID
+	}
+	first := createProgram("Tagged Program 1", []string{"Tech", "AI", "News"})
+	second := createProgram("Tagged Program 2", []string{"Machine Learning", "AI"})
+
+	episode := &biz.Episode{
+		ProgramID:     first,
+		Title:         "Tagged Episode",
+		EpisodeNumber: 1,
+		SeasonNumber:  1,
+		Status:        biz.EpisodeStatusDraft,
+		CreatedBy:     userID,
+		UpdatedBy:     userID,
+		Tags:          []string{"ai"},
+	}
+	AssertNoError(t, episodes.Create(ctx, episode), "creating episode")
+
+	assertProgramTags := func(t *testing.T, id uuid.UUID, want ...string) {
+		t.Helper()
+		program, err := programs.GetByID(ctx, id)
+		AssertNoError(t, err, "getting program")
+		if !slices.Equal(program.Tags, want) {
+			t.Errorf("Expected program tags %v, got %v", want, program.Tags)
+		}
+	}
+
+	t.Run("Rename", func(t *testing.T) {
+		renamed, err := repo.Rename(ctx, ai.ID, "Artificial Intelligence")
+		AssertNoError(t, err, "renaming tag")
+		if renamed.Slug != "artificial-intelligence" || !slices.Contains(renamed.Aliases, "ai") {
+			t.Errorf("Expected slug artificial-intelligence with alias ai, got %s with %v", renamed.Slug, renamed.Aliases)
+		}
+
+		// Content is retagged in place, whatever case it was tagged in
+		assertProgramTags(t, first, "Tech", "Artificial Intelligence", "News")
+		assertProgramTags(t, second, "Machine Learning", "Artificial Intelligence")
+		retagged, err := episodes.GetByID(ctx, episode.ID)
+		AssertNoError(t, err, "getting episode")
+		if !slices.Equal(retagged.Tags, []string{"Artificial Intelligence"}) {
+			t.Errorf("Expected episode tags [Artificial Intelligence], got %v", retagged.Tags)
+		}
+		if renamed.ProgramCount != 2 || renamed.EpisodeCount != 1 {
+			t.Errorf("Expected the tag on 2 programs and 1 episode, got %d and %d", renamed.ProgramCount, renamed.EpisodeCount)
+		}
+
+		// The old name still finds the tag
+		resolved, err := repo.Resolve(ctx, []string{"AI"})
+		AssertNoError(t, err, "resolving old name")
+		if resolved[0].ID != ai.ID {
+			t.Errorf("Expected AI to resolve to tag %s, got %s", ai.ID, resolved[0].ID)
+		}
+	})
+
+	t.Run("RenameTaken", func(t *testing.T) {
+		_, err := repo.Rename(ctx, tech.ID, "news")
+		if !errors.Is(err, biz.ErrTagAlreadyExists) {
+			t.Errorf("Expected ErrTagAlreadyExists, got %v", err)
+		}
+		assertProgramTags(t, first, "Tech", "Artificial Intelligence", "News")
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		merged, err := repo.Merge(ctx, ai.ID, []uuid.UUID{ml.ID})
+		AssertNoError(t, err, "merging tags")
+		for _, alias := range []string{"ai", "machine-learning"} {
+			if !slices.Contains(merged.Aliases, alias) {
+				t.Errorf("Expected alias %s, got %v", alias, merged.Aliases)
+			}
+		}
+
+		// A program tagged with both keeps the target once, where the first of them was
+		assertProgramTags(t, second, "Artificial Intelligence")
+		assertProgramTags(t, first, "Tech", "Artificial Intelligence", "News")
+
+		_, err = repo.GetByID(ctx, ml.ID)
+		if !errors.Is(err, biz.ErrTagNotFound) {
+			t.Errorf("Expected merged tag to be deleted, got %v", err)
+		}
+
+		resolved, err := repo.Resolve(ctx, []string{"machine learning"})
+		AssertNoError(t, err, "resolving merged name")
+		if resolved[0].ID != ai.ID {
+			t.Errorf("Expected machine learning to resolve to tag %s, got %s", ai.ID, resolved[0].ID)
+		}
+	})
+
+	t.Run("MergeUnknown", func(t *testing.T) {
+		_, err := repo.Merge(ctx, news.ID, []uuid.UUID{tech.ID, uuid.New()})
+		if !errors.Is(err, biz.ErrTagNotFound) {
+			t.Errorf("Expected ErrTagNotFound, got %v", err)
+		}
+		// Nothing changes when one of the sources is missing
+		assertProgramTags(t, first, "Tech", "Artificial Intelligence", "News")
+	})
+}
@@ -29,6 +29,18 @@ type TestHelper struct {
 
 // SetupTestDB creates a PostgreSQL testcontainer and returns a connection pool
 func SetupTestDB(t *testing.T) *TestHelper {
+	helper := SetupEmptyTestDB(t)
+
+	// Initialize schema
+	if err := helper.InitSchema(context.Background(), t); err != nil {
+		t.Fatalf("Failed to initialize schema: %v", err)
+	}
+
+	return helper
+}
+
+// SetupEmptyTestDB creates a PostgreSQL testcontainer without a schema
+func SetupEmptyTestDB(t *testing.T) *TestHelper {
 	ctx := context.Background()
 
 	// Create PostgreSQL container
@@ -63,33 +75,20 @@ func SetupTestDB(t *testing.T) *TestHelper {
 		t.Fatalf("Failed to ping database: %v", err)
 	}
 
-	helper := &TestHelper{
+	return &TestHelper{
 		Container: container,
 		Pool:      pool,
 		DSN:       dsn,
 	}
-
-	// Initialize schema
-	if err := helper.InitSchema(ctx, t); err != nil {
-		t.Fatalf("Failed to initialize schema: %v", err)
-	}
-
-	return helper
 }
 
 // InitSchema loads the test schema and initial data
 func (h *TestHelper) InitSchema(ctx context.Context, t *testing.T) error {
 	t.Helper()
 
-	initSQLPath := filepath.Join("platform", "sql", "init.sql")
-	sqlBytes, err := os.ReadFile("../../../../../" + initSQLPath)
-	if err != nil {
-		return fmt.Errorf("failed to read platform/sql/init.sql: %w", err)
-	}
-
 	// Execute the main schema SQL
-	if _, err := h.Pool.Exec(ctx, string(sqlBytes)); err != nil {
-		return fmt.Errorf("failed to execute platform/sql/init.sql: %w", err)
+	if err := h.ExecFile(ctx, filepath.Join("..", "..", "..", "..", "..", "platform", "sql", "init.sql")); err != nil {
+		return err
 	}
 
 	// Add test seed data
@@ -113,6 +112,20 @@ INSERT INTO categories (id, name, description, type, created_at, updated_at, cre
 	return nil
 }
 
+// ExecFile executes the SQL statements in the file at path
+func (h *TestHelper) ExecFile(ctx context.Context, path string) error {
+	sqlBytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	if _, err := h.Pool.Exec(ctx, string(sqlBytes)); err != nil {
+		return fmt.Errorf("failed to execute %s: %w", path, err)
+	}
+
+	return nil
+}
+
 // Cleanup terminates the test container and closes connections
 func (h *TestHelper) Cleanup(ctx context.Context, t *testing.T) {
 	if h.Pool != nil {
@@ -212,3 +225,8 @@ func (h *TestHelper) RowExists(ctx context.Context, table, whereClause string, a
 	count, err := h.CountRows(ctx, table, whereClause, args...)
 	return count > 0, err
 }
+
+// Searchable checks if the search vector of the row with id in table matches query
+func (h *TestHelper) Searchable(ctx context.Context, table string, id interface{}, query string) (bool, error) {
+	return h.RowExists(ctx, table, "id = $1 AND search_vector @@ plainto_tsquery('simple', unaccent($2))", id, query)
+}
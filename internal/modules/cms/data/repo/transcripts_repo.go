@@ -0,0 +1,265 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// cueBatchSize is how many cues go in one insert, well below the parameter limit of
+// Postgres.
+const cueBatchSize = 1000
+
+var transcriptColumns = []any{
+	"id",
+	"episode_id",
+	"locale",
+	"format",
+	"file_url",
+	"created_at",
+	"updated_at",
+}
+
+type transcriptRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewTranscriptRepository(db *pgxpool.Pool) biz.TranscriptRepository {
+	return &transcriptRepo{
+		db: db,
+	}
+}
+
+// transcriptsWithCounts is the transcripts table along with how many cues each has.
+func transcriptsWithCounts() *goqu.SelectDataset {
+	return goqu.From("transcripts").Select(append(transcriptColumns,
+		goqu.L("(SELECT COUNT(*) FROM transcript_cues WHERE transcript_cues.transcript_id = transcripts.id)").As("cues_count"),
+	)...)
+}
+
+func (r *transcriptRepo) Upsert(ctx context.Context, transcript *biz.Transcript) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := goqu.Insert("transcripts").
+		Rows(goqu.Record{
+			"id":         transcript.ID,
+			"episode_id": transcript.EpisodeID,
+			"locale":     transcript.Locale,
+			"format":     transcript.Format,
+			"file_url":   transcript.FileURL,
+		}).
+		OnConflict(goqu.DoUpdate("episode_id, locale", goqu.Record{
+			"format":     goqu.I("excluded.format"),
+			"file_url":   goqu.I("excluded.file_url"),
+			"updated_at": goqu.L("NOW()"),
+		})).
+		Returning("id", "created_at", "updated_at").
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build upsert query: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, query, args...).Scan(&transcript.ID, &transcript.CreatedAt, &transcript.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" && pgErr.ConstraintName == "transcripts_episode_id_fkey" {
+			return biz.ErrEpisodeNotFound
+		}
+		return fmt.Errorf("failed to upsert transcript: %w", err)
+	}
+
+	query, args, err = goqu.Delete("transcript_cues").Where(goqu.C("transcript_id").Eq(transcript.ID)).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete transcript cues: %w", err)
+	}
+
+	timed := transcript.Format.Timed()
+	for offset := 0; offset < len(transcript.Cues); offset += cueBatchSize {
+		batch := transcript.Cues[offset:min(offset+cueBatchSize, len(transcript.Cues))]
+		rows := make([]any, 0, len(batch))
+		for i, cue := range batch {
+			record := goqu.Record{
+				"transcript_id": transcript.ID,
+				"position":      offset + i,
+				"start_seconds": nil,
+				"end_seconds":   nil,
+				"speaker":       cue.Speaker,
+				"text":          cue.Text,
+			}
+			if timed {
+				record["start_seconds"] = cue.StartSeconds
+				record["end_seconds"] = cue.EndSeconds
+			}
+			rows = append(rows, record)
+		}
+
+		query, args, err := goqu.Insert("transcript_cues").Rows(rows...).ToSQL()
+		if err != nil {
+			return fmt.Errorf("failed to build insert query: %w", err)
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to insert transcript cues: %w", err)
+		}
+	}
+	transcript.CuesCount = int32(len(transcript.Cues))
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *transcriptRepo) Get(ctx context.Context, episodeID uuid.UUID, locale string) (*biz.Transcript, error) {
+	query, args, err := transcriptsWithCounts().
+		Where(
+			goqu.C("episode_id").Eq(episodeID),
+			goqu.C("locale").Eq(locale),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	transcript, err := scanTranscript(conn(ctx, r.db).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, biz.ErrTranscriptNotFound
+		}
+		return nil, fmt.Errorf("failed to scan transcript: %w", err)
+	}
+
+	return transcript, nil
+}
+
+func (r *transcriptRepo) Cues(ctx context.Context, transcriptID uuid.UUID) ([]*biz.TranscriptCue, error) {
+	query, args, err := goqu.Select(
+		goqu.L("coalesce(start_seconds, 0)"),
+		goqu.L("coalesce(end_seconds, 0)"),
+		"speaker",
+		"text",
+	).
+		From("transcript_cues").
+		Where(goqu.C("transcript_id").Eq(transcriptID)).
+		Order(goqu.C("position").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transcript cues: %w", err)
+	}
+
+	cues, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.TranscriptCue, error) {
+		var cue biz.TranscriptCue
+		err := row.Scan(&cue.StartSeconds, &cue.EndSeconds, &cue.Speaker, &cue.Text)
+		return &cue, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan transcript cues: %w", err)
+	}
+
+	return cues, nil
+}
+
+func (r *transcriptRepo) List(ctx context.Context, episodeID uuid.UUID) ([]*biz.Transcript, error) {
+	transcripts, err := r.ListByEpisodes(ctx, []uuid.UUID{episodeID})
+	if err != nil {
+		return nil, err
+	}
+
+	if transcripts[episodeID] == nil {
+		return []*biz.Transcript{}, nil
+	}
+	return transcripts[episodeID], nil
+}
+
+func (r *transcriptRepo) ListByEpisodes(ctx context.Context, episodeIDs []uuid.UUID) (map[uuid.UUID][]*biz.Transcript, error) {
+	if len(episodeIDs) == 0 {
+		return map[uuid.UUID][]*biz.Transcript{}, nil
+	}
+
+	query, args, err := transcriptsWithCounts().
+		Where(goqu.C("episode_id").In(episodeIDs)).
+		Order(goqu.C("episode_id").Asc(), goqu.C("locale").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transcripts: %w", err)
+	}
+
+	transcripts, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.Transcript, error) {
+		return scanTranscript(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan transcripts: %w", err)
+	}
+
+	byEpisode := make(map[uuid.UUID][]*biz.Transcript, len(episodeIDs))
+	for _, transcript := range transcripts {
+		byEpisode[transcript.EpisodeID] = append(byEpisode[transcript.EpisodeID], transcript)
+	}
+
+	return byEpisode, nil
+}
+
+func (r *transcriptRepo) Delete(ctx context.Context, episodeID uuid.UUID, locale string) error {
+	query, args, err := goqu.Delete("transcripts").
+		Where(
+			goqu.C("episode_id").Eq(episodeID),
+			goqu.C("locale").Eq(locale),
+		).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete transcript: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return biz.ErrTranscriptNotFound
+	}
+
+	return nil
+}
+
+func scanTranscript(row pgx.Row) (*biz.Transcript, error) {
+	var transcript biz.Transcript
+	err := row.Scan(
+		&transcript.ID,
+		&transcript.EpisodeID,
+		&transcript.Locale,
+		&transcript.Format,
+		&transcript.FileURL,
+		&transcript.CreatedAt,
+		&transcript.UpdatedAt,
+		&transcript.CuesCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &transcript, nil
+}
@@ -0,0 +1,146 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var translationColumns = []any{
+	"content_type",
+	"content_id",
+	"locale",
+	"title",
+	"description",
+	"created_at",
+	"updated_at",
+}
+
+type translationRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewTranslationRepository(db *pgxpool.Pool) biz.TranslationRepository {
+	return &translationRepo{
+		db: db,
+	}
+}
+
+func (r *translationRepo) Upsert(ctx context.Context, translation *biz.Translation) error {
+	query, args, err := goqu.Insert("translations").
+		Rows(goqu.Record{
+			"content_type": translation.ContentType,
+			"content_id":   translation.ContentID,
+			"locale":       translation.Locale,
+			"title":        translation.Title,
+			"description":  translation.Description,
+		}).
+		OnConflict(goqu.DoUpdate("content_type, content_id, locale", goqu.Record{
+			"title":       goqu.I("excluded.title"),
+			"description": goqu.I("excluded.description"),
+			"updated_at":  goqu.L("NOW()"),
+		})).
+		Returning("created_at", "updated_at").
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build upsert query: %w", err)
+	}
+
+	err = conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&translation.CreatedAt, &translation.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert translation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *translationRepo) Delete(ctx context.Context, contentType biz.ContentType, contentID uuid.UUID, locale string) error {
+	query, args, err := goqu.Delete("translations").
+		Where(
+			goqu.C("content_type").Eq(contentType),
+			goqu.C("content_id").Eq(contentID),
+			goqu.C("locale").Eq(locale),
+		).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete translation: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return biz.ErrTranslationNotFound
+	}
+
+	return nil
+}
+
+func (r *translationRepo) List(ctx context.Context, contentType biz.ContentType, contentID uuid.UUID) ([]*biz.Translation, error) {
+	query, args, err := goqu.Select(translationColumns...).
+		From("translations").
+		Where(
+			goqu.C("content_type").Eq(contentType),
+			goqu.C("content_id").Eq(contentID),
+		).
+		Order(goqu.C("locale").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	return r.query(ctx, query, args...)
+}
+
+func (r *translationRepo) ListForContent(ctx context.Context, contentType biz.ContentType, contentIDs []uuid.UUID, locales []string) ([]*biz.Translation, error) {
+	if len(contentIDs) == 0 || len(locales) == 0 {
+		return []*biz.Translation{}, nil
+	}
+
+	query, args, err := goqu.Select(translationColumns...).
+		From("translations").
+		Where(
+			goqu.C("content_type").Eq(contentType),
+			goqu.C("content_id").In(contentIDs),
+			goqu.C("locale").In(locales),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	return r.query(ctx, query, args...)
+}
+
+func (r *translationRepo) query(ctx context.Context, query string, args ...any) ([]*biz.Translation, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query translations: %w", err)
+	}
+
+	translations, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*biz.Translation, error) {
+		var t biz.Translation
+		err := row.Scan(
+			&t.ContentType,
+			&t.ContentID,
+			&t.Locale,
+			&t.Title,
+			&t.Description,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+		)
+		return &t, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan translations: %w", err)
+	}
+
+	return translations, nil
+}
@@ -0,0 +1,127 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+func TestTranslationRepo(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewTranslationRepository(helper.Pool)
+	programs := NewProgramRepository(helper.Pool)
+	episodes := NewEpisodeRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	program := &biz.Program{
+		Title:      "Tech Talk",
+		CategoryID: uuid.MustParse(GetTestCategoryID()),
+		Status:     biz.ProgramStatusPublished,
+		CreatedBy:  userID,
+		UpdatedBy:  userID,
+	}
+	AssertNoError(t, programs.Create(ctx, program), "creating program")
+	episode := &biz.Episode{
+		ProgramID:     program.ID,
+		Title:         "Pilot",
+		EpisodeNumber: 1,
+		SeasonNumber:  1,
+		Status:        biz.EpisodeStatusPublished,
+		CreatedBy:     userID,
+		UpdatedBy:     userID,
+	}
+	AssertNoError(t, episodes.Create(ctx, episode), "creating episode")
+
+	assertSearchable := func(t *testing.T, table string, id uuid.UUID, query string, want bool) {
+		t.Helper()
+		found, err := helper.Searchable(ctx, table, id, query)
+		AssertNoError(t, err, "searching "+table)
+		if found != want {
+			t.Errorf("Expected %s %s searchable by %q to be %v", table, id, query, want)
+		}
+	}
+
+	t.Run("Upsert", func(t *testing.T) {
+		translation := &biz.Translation{
+			ContentType: biz.ContentTypeProgram,
+			ContentID:   program.ID,
+			Locale:      "ar",
+			Title:       "حديث التقنية",
+			Description: "برنامج عن التقنية",
+		}
+		AssertNoError(t, repo.Upsert(ctx, translation), "translating program")
+		if translation.CreatedAt.IsZero() || translation.UpdatedAt.IsZero() {
+			t.Errorf("Expected the timestamps filled in, got %+v", translation)
+		}
+		assertSearchable(t, "programs", program.ID, "التقنية", true)
+		// The original title stays searchable
+		assertSearchable(t, "programs", program.ID, "Tech", true)
+
+		// Translating again replaces the translation in that locale
+		translation.Title = "نقاش تقني"
+		translation.Description = ""
+		AssertNoError(t, repo.Upsert(ctx, translation), "updating translation")
+		assertSearchable(t, "programs", program.ID, "نقاش", true)
+		assertSearchable(t, "programs", program.ID, "التقنية", false)
+
+		AssertNoError(t, repo.Upsert(ctx, &biz.Translation{
+			ContentType: biz.ContentTypeProgram,
+			ContentID:   program.ID,
+			Locale:      "fr",
+			Title:       "Parlons technologie",
+		}), "translating program")
+
+		translations, err := repo.List(ctx, biz.ContentTypeProgram, program.ID)
+		AssertNoError(t, err, "listing translations")
+		if len(translations) != 2 || translations[0].Locale != "ar" || translations[1].Locale != "fr" {
+			t.Fatalf("Expected the ar and fr translations, got %+v", translations)
+		}
+		if translations[0].Title != "نقاش تقني" || translations[0].Description != "" {
+			t.Errorf("Expected the updated ar translation, got %+v", translations[0])
+		}
+	})
+
+	t.Run("Episode", func(t *testing.T) {
+		AssertNoError(t, repo.Upsert(ctx, &biz.Translation{
+			ContentType: biz.ContentTypeEpisode,
+			ContentID:   episode.ID,
+			Locale:      "ar",
+			Title:       "الحلقة التجريبية",
+		}), "translating episode")
+		assertSearchable(t, "episodes", episode.ID, "التجريبية", true)
+		// Translations of the program are not the episode's
+		assertSearchable(t, "episodes", episode.ID, "نقاش", false)
+	})
+
+	t.Run("ListForContent", func(t *testing.T) {
+		translations, err := repo.ListForContent(ctx, biz.ContentTypeProgram, []uuid.UUID{program.ID, uuid.New()}, []string{"fr", "de"})
+		AssertNoError(t, err, "listing translations for content")
+		if len(translations) != 1 || translations[0].Locale != "fr" {
+			t.Errorf("Expected the fr translation, got %+v", translations)
+		}
+
+		translations, err = repo.ListForContent(ctx, biz.ContentTypeProgram, []uuid.UUID{program.ID}, nil)
+		AssertNoError(t, err, "listing translations without locales")
+		if len(translations) != 0 {
+			t.Errorf("Expected no translations, got %+v", translations)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		AssertNoError(t, repo.Delete(ctx, biz.ContentTypeProgram, program.ID, "ar"), "deleting translation")
+		assertSearchable(t, "programs", program.ID, "نقاش", false)
+		assertSearchable(t, "programs", program.ID, "Parlons", true)
+
+		err := repo.Delete(ctx, biz.ContentTypeProgram, program.ID, "ar")
+		if !errors.Is(err, biz.ErrTranslationNotFound) {
+			t.Errorf("Expected ErrTranslationNotFound, got %v", err)
+		}
+	})
+}
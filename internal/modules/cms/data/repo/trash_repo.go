@@ -0,0 +1,433 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// notDeleted keeps trashed rows out of a query.
+func notDeleted() exp.Expression {
+	return goqu.C("deleted_at").IsNull()
+}
+
+type trashRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewTrashRepository(db *pgxpool.Pool) biz.TrashRepository {
+	return &trashRepo{
+		db: db,
+	}
+}
+
+func (r *trashRepo) List(ctx context.Context, userID uuid.UUID, contentType *biz.ContentType, pagination biz.PaginationRequest) ([]*biz.TrashItem, *biz.PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	trashed := func(table string, contentType biz.ContentType, title string) *goqu.SelectDataset {
+		return goqu.From(table).
+			Select(
+				goqu.V(string(contentType)).As("content_type"),
+				"id",
+				goqu.C(title).As("title"),
+				"deleted_at",
+			).
+			Where(
+				goqu.C("created_by").Eq(userID),
+				goqu.C("deleted_at").IsNotNull(),
+			)
+	}
+
+	var parts []*goqu.SelectDataset
+	if contentType == nil || *contentType == biz.ContentTypeCategory {
+		parts = append(parts, trashed("categories", biz.ContentTypeCategory, "name"))
+	}
+	if contentType == nil || *contentType == biz.ContentTypeProgram {
+		parts = append(parts, trashed("programs", biz.ContentTypeProgram, "title"))
+	}
+	if contentType == nil || *contentType == biz.ContentTypeEpisode {
+		parts = append(parts, trashed("episodes", biz.ContentTypeEpisode, "title").
+			Where(goqu.C("deleted_with_program").IsFalse()))
+	}
+	if len(parts) == 0 {
+		return nil, nil, biz.ErrInvalidContentType
+	}
+
+	union := parts[0]
+	for _, part := range parts[1:] {
+		union = union.UnionAll(part)
+	}
+	trash := goqu.From(union.As("trash"))
+
+	query, args, err := trash.Select(goqu.COUNT("*")).ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var totalCount int32
+	if err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&totalCount); err != nil {
+		return nil, nil, fmt.Errorf("failed to count trash: %w", err)
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	query, args, err = trash.
+		Select("content_type", "id", "title", "deleted_at").
+		Order(goqu.C("deleted_at").Desc(), goqu.C("id").Asc()).
+		Limit(uint(pagination.PageSize)).
+		Offset(uint(offset)).
+		ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query trash: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*biz.TrashItem
+	for rows.Next() {
+		var item biz.TrashItem
+		if err := rows.Scan(&item.ContentType, &item.ID, &item.Title, &item.DeletedAt); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan trash item: %w", err)
+		}
+		items = append(items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return items, paginationResponse(pagination, totalCount), nil
+}
+
+func (r *trashRepo) Restore(ctx context.Context, userID uuid.UUID, contentType biz.ContentType, id uuid.UUID) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	switch contentType {
+	case biz.ContentTypeCategory:
+		err = restoreRow(ctx, tx, "categories", userID, id, goqu.I("parent.id").Eq(goqu.I("categories.parent_id")))
+	case biz.ContentTypeProgram:
+		err = restoreRow(ctx, tx, "programs", userID, id, goqu.L("EXISTS ?", goqu.From("program_categories").
+			Select(goqu.L("1")).
+			Where(
+				goqu.I("program_categories.program_id").Eq(goqu.I("programs.id")),
+				goqu.I("program_categories.category_id").Eq(goqu.I("parent.id")),
+			)))
+		if err == nil {
+			err = restoreEpisodesOf(ctx, tx, id)
+		}
+	case biz.ContentTypeEpisode:
+		err = restoreRow(ctx, tx, "episodes", userID, id, goqu.I("parent.id").Eq(goqu.I("episodes.program_id")))
+	default:
+		return biz.ErrInvalidContentType
+	}
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			// Something else took the name or number while this was in the trash
+			switch pgErr.ConstraintName {
+			case "categories_name_key":
+				return biz.ErrCategoryAlreadyExists
+			case "episodes_program_id_season_number_episode_number_key":
+				return biz.ErrEpisodeAlreadyExists
+			}
+		}
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit restore: %w", err)
+	}
+
+	return nil
+}
+
+// restoreRow takes one row of userID out of the trash. parent joins the row to the
+// rows it belongs to, aliased parent, none of which may be in the trash themselves.
+func restoreRow(ctx context.Context, tx pgx.Tx, table string, userID, id uuid.UUID, parent exp.Expression) error {
+	parentTable := "categories"
+	if table == "episodes" {
+		parentTable = "programs"
+	}
+	inTrash := goqu.L("EXISTS ?", goqu.From(goqu.T(parentTable).As("parent")).
+		Select(goqu.L("1")).
+		Where(parent, goqu.I("parent.deleted_at").IsNotNull()))
+
+	query, args, err := goqu.Update(table).
+		Set(goqu.Record{"deleted_at": nil}).
+		Where(
+			goqu.C("id").Eq(id),
+			goqu.C("created_by").Eq(userID),
+			goqu.C("deleted_at").IsNotNull(),
+		).
+		Returning(inTrash).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build restore query: %w", err)
+	}
+
+	var parentInTrash bool
+	if err := tx.QueryRow(ctx, query, args...).Scan(&parentInTrash); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return biz.ErrTrashItemNotFound
+		}
+		return fmt.Errorf("failed to restore from %s: %w", table, err)
+	}
+	if parentInTrash {
+		return biz.ErrParentInTrash
+	}
+
+	return nil
+}
+
+// restoreEpisodesOf brings back the episodes that went to the trash with a program,
+// leaving the ones deleted before it where they are.
+func restoreEpisodesOf(ctx context.Context, tx pgx.Tx, programID uuid.UUID) error {
+	query, args, err := goqu.Update("episodes").
+		Set(goqu.Record{"deleted_at": nil, "deleted_with_program": false}).
+		Where(
+			goqu.C("program_id").Eq(programID),
+			goqu.C("deleted_with_program").IsTrue(),
+		).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build restore episodes query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to restore episodes: %w", err)
+	}
+
+	return nil
+}
+
+func (r *trashRepo) Purge(ctx context.Context, filter biz.PurgeFilter) (*biz.PurgeResult, error) {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result := &biz.PurgeResult{}
+
+	programIDs, err := selectPurgeable(ctx, tx, "programs", biz.ContentTypeProgram, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Episodes go first, together with every episode of the programs being purged
+	episodeIDs, err := selectPurgeable(ctx, tx, "episodes", biz.ContentTypeEpisode, filter)
+	if err != nil {
+		return nil, err
+	}
+	var scope []exp.Expression
+	if len(episodeIDs) > 0 {
+		scope = append(scope, goqu.C("id").In(episodeIDs))
+	}
+	if len(programIDs) > 0 {
+		scope = append(scope, goqu.C("program_id").In(programIDs))
+	}
+	if len(scope) > 0 {
+		// Transcripts would go with their episodes, but their files have to be collected
+		query, args, err := goqu.Delete("transcripts").
+			Where(goqu.C("episode_id").In(goqu.From("episodes").Select("id").Where(goqu.Or(scope...)))).
+			Returning("file_url").
+			ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build purge transcripts query: %w", err)
+		}
+
+		rows, err := tx.Query(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to purge transcripts: %w", err)
+		}
+		fileURLs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return nil, fmt.Errorf("failed to purge transcripts: %w", err)
+		}
+		result.ObjectURLs = append(result.ObjectURLs, fileURLs...)
+
+		query, args, err = goqu.Delete("episodes").
+			Where(goqu.Or(scope...)).
+			Returning("id", "media_url", "thumbnail_url").
+			ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build purge episodes query: %w", err)
+		}
+
+		rows, err = tx.Query(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to purge episodes: %w", err)
+		}
+		episodeIDs = episodeIDs[:0]
+		for rows.Next() {
+			var id uuid.UUID
+			var mediaURL, thumbnailURL *string
+			if err := rows.Scan(&id, &mediaURL, &thumbnailURL); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan purged episode: %w", err)
+			}
+			episodeIDs = append(episodeIDs, id)
+			for _, url := range []*string{mediaURL, thumbnailURL} {
+				if url != nil && *url != "" {
+					result.ObjectURLs = append(result.ObjectURLs, *url)
+				}
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to purge episodes: %w", err)
+		}
+	}
+	result.Episodes = int32(len(episodeIDs))
+
+	if len(programIDs) > 0 {
+		query, args, err := goqu.Delete("programs").Where(goqu.C("id").In(programIDs)).ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build purge programs query: %w", err)
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return nil, fmt.Errorf("failed to purge programs: %w", err)
+		}
+	}
+	result.Programs = int32(len(programIDs))
+
+	// Categories are selected last, so the ones freed by the programs above go too. A
+	// category is only free once its subcategories are gone, so each round frees the
+	// level above it.
+	var purgedCategoryIDs []uuid.UUID
+	for {
+		categoryFilter := filter
+		if filter.Limit > 0 {
+			categoryFilter.Limit = filter.Limit - result.Categories
+			if categoryFilter.Limit <= 0 {
+				break
+			}
+		}
+		categoryIDs, err := selectPurgeable(ctx, tx, "categories", biz.ContentTypeCategory, categoryFilter)
+		if err != nil {
+			return nil, err
+		}
+		if len(categoryIDs) == 0 {
+			break
+		}
+
+		query, args, err := goqu.Delete("categories").Where(goqu.C("id").In(categoryIDs)).ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build purge categories query: %w", err)
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return nil, fmt.Errorf("failed to purge categories: %w", err)
+		}
+		result.Categories += int32(len(categoryIDs))
+		purgedCategoryIDs = append(purgedCategoryIDs, categoryIDs...)
+	}
+
+	if err := purgeHistory(ctx, tx, biz.ContentTypeProgram, programIDs); err != nil {
+		return nil, err
+	}
+	if err := purgeHistory(ctx, tx, biz.ContentTypeEpisode, episodeIDs); err != nil {
+		return nil, err
+	}
+	if err := purgeHistory(ctx, tx, biz.ContentTypeCategory, purgedCategoryIDs); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit purge: %w", err)
+	}
+
+	return result, nil
+}
+
+// selectPurgeable locks the trashed rows of table that filter selects. Categories still
+// used by a program or holding subcategories, trashed or not, are skipped.
+func selectPurgeable(ctx context.Context, tx pgx.Tx, table string, contentType biz.ContentType, filter biz.PurgeFilter) ([]uuid.UUID, error) {
+	if filter.ContentType != nil && *filter.ContentType != contentType {
+		return nil, nil
+	}
+
+	conditions := []exp.Expression{goqu.C("deleted_at").IsNotNull()}
+	if filter.UserID != nil {
+		conditions = append(conditions, goqu.C("created_by").Eq(*filter.UserID))
+	}
+	if filter.ID != nil {
+		conditions = append(conditions, goqu.C("id").Eq(*filter.ID))
+	}
+	if filter.DeletedBefore != nil {
+		conditions = append(conditions, goqu.C("deleted_at").Lt(*filter.DeletedBefore))
+	}
+	switch contentType {
+	case biz.ContentTypeEpisode:
+		// These follow their program
+		conditions = append(conditions, goqu.C("deleted_with_program").IsFalse())
+	case biz.ContentTypeCategory:
+		conditions = append(conditions,
+			goqu.L("NOT EXISTS ?", goqu.From("program_categories").
+				Select(goqu.L("1")).
+				Where(goqu.I("program_categories.category_id").Eq(goqu.I("categories.id")))),
+			goqu.L("NOT EXISTS ?", goqu.From(goqu.T("categories").As("child")).
+				Select(goqu.L("1")).
+				Where(goqu.I("child.parent_id").Eq(goqu.I("categories.id")))),
+		)
+	}
+
+	ds := goqu.From(table).
+		Select("id").
+		Where(conditions...).
+		Order(goqu.C("deleted_at").Asc()).
+		ForUpdate(exp.SkipLocked)
+	if filter.Limit > 0 {
+		ds = ds.Limit(uint(filter.Limit))
+	}
+
+	query, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select %s query: %w", table, err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select trashed %s: %w", table, err)
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+}
+
+// purgeHistory removes the revisions, status transitions, translations and credits of purged
+// content.
+func purgeHistory(ctx context.Context, tx pgx.Tx, contentType biz.ContentType, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	for _, table := range []string{"revisions", "status_transitions", "translations", "credits"} {
+		query, args, err := goqu.Delete(table).
+			Where(
+				goqu.C("content_type").Eq(contentType),
+				goqu.C("content_id").In(ids),
+			).
+			ToSQL()
+		if err != nil {
+			return fmt.Errorf("failed to build purge %s query: %w", table, err)
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to purge %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
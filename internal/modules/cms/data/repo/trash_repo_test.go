@@ -0,0 +1,261 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+func TestTrashRepo_RestoreProgram(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewTrashRepository(helper.Pool)
+	programRepo := NewProgramRepository(helper.Pool)
+	episodeRepo := NewEpisodeRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	program := &biz.Program{
+		Title:      "Restore Program",
+		CategoryID: uuid.MustParse(GetTestCategoryID()),
+		Status:     biz.ProgramStatusDraft,
+		CreatedBy:  userID,
+		UpdatedBy:  userID,
+	}
+	AssertNoError(t, programRepo.Create(ctx, program), "creating program")
+
+	var episodes []*biz.Episode
+	for i := int32(1); i <= 3; i++ {
+		episode := &biz.Episode{
+			ProgramID:     program.ID,
+			Title:         fmt.Sprintf("Restore Episode %d", i),
+			EpisodeNumber: i,
+			SeasonNumber:  1,
+			Status:        biz.EpisodeStatusDraft,
+			CreatedBy:     userID,
+			UpdatedBy:     userID,
+		}
+		AssertNoError(t, episodeRepo.Create(ctx, episode), "creating episode")
+		episodes = append(episodes, episode)
+	}
+
+	// The first episode goes to the trash on its own, the others with the program
+	trashedEarlier := episodes[0]
+	AssertNoError(t, episodeRepo.Delete(ctx, userID, trashedEarlier.ID), "trashing episode")
+	AssertNoError(t, programRepo.Delete(ctx, userID, program.ID), "trashing program")
+
+	deleted, err := helper.CountRows(ctx, "episodes", "program_id = $1 AND deleted_at IS NOT NULL", program.ID)
+	AssertNoError(t, err, "counting trashed episodes")
+	if deleted != 3 {
+		t.Fatalf("Expected the program to take its episodes to the trash, got %d trashed", deleted)
+	}
+
+	AssertNoError(t, repo.Restore(ctx, userID, biz.ContentTypeProgram, program.ID), "restoring program")
+
+	restored, err := helper.RowExists(ctx, "programs", "id = $1 AND deleted_at IS NULL", program.ID)
+	AssertNoError(t, err, "checking program")
+	if !restored {
+		t.Error("Expected the program to be restored")
+	}
+	for _, episode := range episodes[1:] {
+		restored, err := helper.RowExists(ctx, "episodes", "id = $1 AND deleted_at IS NULL AND NOT deleted_with_program", episode.ID)
+		AssertNoError(t, err, "checking episode")
+		if !restored {
+			t.Errorf("Expected episode %s deleted with the program to be restored", episode.Title)
+		}
+	}
+
+	stillTrashed, err := helper.RowExists(ctx, "episodes", "id = $1 AND deleted_at IS NOT NULL", trashedEarlier.ID)
+	AssertNoError(t, err, "checking episode trashed earlier")
+	if !stillTrashed {
+		t.Error("Expected the episode trashed before the program to stay in the trash")
+	}
+
+	episodeType := biz.ContentTypeEpisode
+	items, _, err := repo.List(ctx, userID, &episodeType, biz.PaginationRequest{})
+	AssertNoError(t, err, "listing trashed episodes")
+	if len(items) != 1 || items[0].ID != trashedEarlier.ID {
+		t.Errorf("Expected only episode %s in the trash, got %d items", trashedEarlier.ID, len(items))
+	}
+}
+
+func TestTrashRepo_PurgeObjectURLs(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewTrashRepository(helper.Pool)
+	programRepo := NewProgramRepository(helper.Pool)
+	episodeRepo := NewEpisodeRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	createProgram := func(title string) *biz.Program {
+		program := &biz.Program{
+			Title:      title,
+			CategoryID: uuid.MustParse(GetTestCategoryID()),
+			Status:     biz.ProgramStatusDraft,
+			CreatedBy:  userID,
+			UpdatedBy:  userID,
+		}
+		AssertNoError(t, programRepo.Create(ctx, program), "creating program "+title)
+		return program
+	}
+	createEpisode := func(program *biz.Program, number int32, mediaURL, thumbnailURL string) *biz.Episode {
+		episode := &biz.Episode{
+			ProgramID:     program.ID,
+			Title:         fmt.Sprintf("Purge Episode %d", number),
+			EpisodeNumber: number,
+			SeasonNumber:  1,
+			Status:        biz.EpisodeStatusDraft,
+			CreatedBy:     userID,
+			UpdatedBy:     userID,
+			MediaURL:      mediaURL,
+			ThumbnailURL:  thumbnailURL,
+		}
+		AssertNoError(t, episodeRepo.Create(ctx, episode), "creating episode")
+		return episode
+	}
+
+	trashedProgram := createProgram("Purged Program")
+	withFiles := createEpisode(trashedProgram, 1, "/thmanyah/media/one.mp3", "/thmanyah/thumbnails/one.jpg")
+	createEpisode(trashedProgram, 2, "", "")
+	transcript := &biz.Transcript{
+		ID:        uuid.New(),
+		EpisodeID: withFiles.ID,
+		Locale:    "ar",
+		Format:    biz.TranscriptFormatWebVTT,
+		FileURL:   "/thmanyah/transcripts/one.vtt",
+	}
+	AssertNoError(t, NewTranscriptRepository(helper.Pool).Upsert(ctx, transcript), "creating transcript")
+
+	liveProgram := createProgram("Live Program")
+	trashedEpisode := createEpisode(liveProgram, 1, "https://cdn.example.com/two.mp3", "")
+	kept := createEpisode(liveProgram, 2, "/thmanyah/media/kept.mp3", "")
+
+	AssertNoError(t, programRepo.Delete(ctx, userID, trashedProgram.ID), "trashing program")
+	AssertNoError(t, episodeRepo.Delete(ctx, userID, trashedEpisode.ID), "trashing episode")
+
+	result, err := repo.Purge(ctx, biz.PurgeFilter{UserID: &userID})
+	AssertNoError(t, err, "purging trash")
+
+	if result.Programs != 1 || result.Episodes != 3 || result.Categories != 0 {
+		t.Errorf("Expected 1 program and 3 episodes purged, got %+v", result)
+	}
+
+	want := []string{
+		"/thmanyah/media/one.mp3",
+		"/thmanyah/thumbnails/one.jpg",
+		"/thmanyah/transcripts/one.vtt",
+		"https://cdn.example.com/two.mp3",
+	}
+	got := slices.Sorted(slices.Values(result.ObjectURLs))
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected object URLs %v, got %v", want, got)
+	}
+
+	transcripts, err := helper.CountRows(ctx, "transcripts", "episode_id = $1", withFiles.ID)
+	AssertNoError(t, err, "counting transcripts")
+	if transcripts != 0 {
+		t.Errorf("Expected the transcript to be purged, got %d", transcripts)
+	}
+	exists, err := helper.RowExists(ctx, "episodes", "id = $1", kept.ID)
+	AssertNoError(t, err, "checking kept episode")
+	if !exists {
+		t.Error("Expected the episode outside the trash to be kept")
+	}
+}
+
+func TestTrashRepo_PurgeExpiredLimit(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewTrashRepository(helper.Pool)
+	programRepo := NewProgramRepository(helper.Pool)
+	episodeRepo := NewEpisodeRepository(helper.Pool)
+	categoryRepo := NewCategoryRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+
+	now := time.Now()
+	before := now.Add(-30 * time.Minute)
+	// Oldest first; the last one went to the trash after before
+	deletedAt := []time.Time{now.Add(-3 * time.Hour), now.Add(-2 * time.Hour), now.Add(-time.Hour), now}
+
+	trashAt := func(table string, id uuid.UUID, at time.Time) {
+		_, err := helper.Pool.Exec(ctx, "UPDATE "+table+" SET deleted_at = $1 WHERE id = $2", at, id)
+		AssertNoError(t, err, "trashing row of "+table)
+	}
+
+	live := &biz.Program{
+		Title:      "Live Program",
+		CategoryID: uuid.MustParse(GetTestCategoryID()),
+		Status:     biz.ProgramStatusDraft,
+		CreatedBy:  userID,
+		UpdatedBy:  userID,
+	}
+	AssertNoError(t, programRepo.Create(ctx, live), "creating program")
+
+	var programs, episodes, categories []uuid.UUID
+	for i, at := range deletedAt {
+		program := &biz.Program{
+			Title:      fmt.Sprintf("Expired Program %d", i),
+			CategoryID: uuid.MustParse(GetTestCategoryID()),
+			Status:     biz.ProgramStatusDraft,
+			CreatedBy:  userID,
+			UpdatedBy:  userID,
+		}
+		AssertNoError(t, programRepo.Create(ctx, program), "creating program")
+		trashAt("programs", program.ID, at)
+		programs = append(programs, program.ID)
+
+		episode := &biz.Episode{
+			ProgramID:     live.ID,
+			Title:         fmt.Sprintf("Expired Episode %d", i),
+			EpisodeNumber: int32(i + 1),
+			SeasonNumber:  1,
+			Status:        biz.EpisodeStatusDraft,
+			CreatedBy:     userID,
+			UpdatedBy:     userID,
+		}
+		AssertNoError(t, episodeRepo.Create(ctx, episode), "creating episode")
+		trashAt("episodes", episode.ID, at)
+		episodes = append(episodes, episode.ID)
+
+		category := &biz.Category{Name: fmt.Sprintf("Expired Category %d", i), Type: biz.CategoryTypePodcast, CreatedBy: userID}
+		AssertNoError(t, categoryRepo.Create(ctx, category), "creating category")
+		trashAt("categories", category.ID, at)
+		categories = append(categories, category.ID)
+	}
+
+	// What PurgeExpiredTrash asks for
+	result, err := repo.Purge(ctx, biz.PurgeFilter{DeletedBefore: &before, Limit: 2})
+	AssertNoError(t, err, "purging expired trash")
+	if result.Programs != 2 || result.Episodes != 2 || result.Categories != 2 {
+		t.Errorf("Expected 2 of each kind purged, got %+v", result)
+	}
+
+	for table, ids := range map[string][]uuid.UUID{"programs": programs, "episodes": episodes, "categories": categories} {
+		for i, id := range ids {
+			exists, err := helper.RowExists(ctx, table, "id = $1", id)
+			AssertNoError(t, err, "checking row of "+table)
+			// The two deleted longest ago go; the third waits for the next run and the
+			// last one has not expired
+			if wantKept := i >= 2; exists != wantKept {
+				t.Errorf("Expected row %d of %s kept=%t, got %t", i, table, wantKept, exists)
+			}
+		}
+	}
+
+	result, err = repo.Purge(ctx, biz.PurgeFilter{DeletedBefore: &before, Limit: 2})
+	AssertNoError(t, err, "purging expired trash again")
+	if result.Programs != 1 || result.Episodes != 1 || result.Categories != 1 {
+		t.Errorf("Expected the rest of the expired trash purged, got %+v", result)
+	}
+}
@@ -41,7 +41,7 @@ func (u *userRepo) GetUserWithPassword(ctx context.Context, email string) (*biz.
 	}
 
 	user := &biz.User{}
-	err = u.db.QueryRow(ctx, sql, params...).Scan(
+	err = conn(ctx, u.db).QueryRow(ctx, sql, params...).Scan(
 		&user.ID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
@@ -62,7 +62,7 @@ func (u *userRepo) GetUserWithPassword(ctx context.Context, email string) (*biz.
 
 func (u *userRepo) CreateUser(ctx context.Context, user *biz.User) (*biz.User, error) {
 	var existingUserID string
-	err := u.db.QueryRow(ctx, "SELECT id FROM users WHERE email = $1", user.Email).Scan(&existingUserID)
+	err := conn(ctx, u.db).QueryRow(ctx, "SELECT id FROM users WHERE email = $1", user.Email).Scan(&existingUserID)
 	if err == nil {
 		return nil, biz.ErrUserAlreadyExists
 	}
@@ -75,7 +75,7 @@ func (u *userRepo) CreateUser(ctx context.Context, user *biz.User) (*biz.User, e
 	user.CreatedAt = now
 	user.UpdatedAt = now
 
-	err = u.db.QueryRow(
+	err = conn(ctx, u.db).QueryRow(
 		ctx,
 		`INSERT INTO users (id, email, password, name, created_at, updated_at)
 			VALUES ($1, $2, $3, $4, $5, $6)
@@ -126,7 +126,7 @@ func (u *userRepo) GetUserByIdentifier(ctx context.Context, id string) (*biz.Use
 	}
 
 	user := &biz.User{}
-	err = u.db.QueryRow(ctx, sql, params...).Scan(
+	err = conn(ctx, u.db).QueryRow(ctx, sql, params...).Scan(
 		&user.ID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
@@ -171,7 +171,7 @@ func (u *userRepo) UpdateUser(ctx context.Context, userId uuid.UUID, user *biz.U
 	}
 
 	userData := &biz.User{}
-	err = u.db.QueryRow(ctx, sql, params...).Scan(
+	err = conn(ctx, u.db).QueryRow(ctx, sql, params...).Scan(
 		&userData.ID,
 		&userData.CreatedAt,
 		&userData.UpdatedAt,
@@ -0,0 +1,514 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lib/pq"
+)
+
+var subscriptionColumns = []any{
+	"id",
+	"url",
+	"event_types",
+	"secret",
+	"active",
+	"created_at",
+	"updated_at",
+	"created_by",
+}
+
+var deliveryColumns = []any{
+	"id",
+	"subscription_id",
+	"event_id",
+	"event_type",
+	"payload",
+	"status",
+	"attempts",
+	"next_attempt_at",
+	"last_attempt_at",
+	"response_status",
+	"last_error",
+	"created_at",
+	"updated_at",
+}
+
+type webhookRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewWebhookRepository(db *pgxpool.Pool) biz.WebhookRepository {
+	return &webhookRepo{
+		db: db,
+	}
+}
+
+// Subscriptions
+
+func (r *webhookRepo) CreateSubscription(ctx context.Context, subscription *biz.WebhookSubscription) error {
+	now := time.Now()
+	if subscription.ID == uuid.Nil {
+		subscription.ID = uuid.Must(uuid.NewV7())
+	}
+	subscription.CreatedAt = now
+	subscription.UpdatedAt = now
+
+	query, args, err := goqu.Insert("webhook_subscriptions").Rows(goqu.Record{
+		"id":          subscription.ID,
+		"url":         subscription.URL,
+		"event_types": pq.Array(subscription.EventTypes),
+		"secret":      subscription.Secret,
+		"active":      subscription.Active,
+		"created_at":  subscription.CreatedAt,
+		"updated_at":  subscription.UpdatedAt,
+		"created_by":  subscription.CreatedBy,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert webhook subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) UpdateSubscription(ctx context.Context, userID, id uuid.UUID, updates *biz.UpdateWebhookSubscriptionRequest) (*biz.WebhookSubscription, error) {
+	updateRecord := goqu.Record{
+		"updated_at": time.Now(),
+	}
+
+	if updates.URL != nil {
+		updateRecord["url"] = *updates.URL
+	}
+	if updates.EventTypes != nil {
+		updateRecord["event_types"] = pq.Array(*updates.EventTypes)
+	}
+	if updates.Secret != nil {
+		updateRecord["secret"] = *updates.Secret
+	}
+	if updates.Active != nil {
+		updateRecord["active"] = *updates.Active
+	}
+
+	query, args, err := goqu.Update("webhook_subscriptions").
+		Set(updateRecord).
+		Where(goqu.C("id").Eq(id)).
+		Where(goqu.C("created_by").Eq(userID)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, biz.ErrWebhookNotFound
+	}
+
+	return r.GetSubscription(ctx, id)
+}
+
+func (r *webhookRepo) DeleteSubscription(ctx context.Context, userID, id uuid.UUID) error {
+	query, args, err := goqu.Delete("webhook_subscriptions").
+		Where(goqu.C("id").Eq(id)).
+		Where(goqu.C("created_by").Eq(userID)).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return biz.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) GetSubscription(ctx context.Context, id uuid.UUID) (*biz.WebhookSubscription, error) {
+	query, args, err := goqu.Select(subscriptionColumns...).
+		From("webhook_subscriptions").
+		Where(goqu.C("id").Eq(id)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	subscription, err := scanSubscription(conn(ctx, r.db).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, biz.ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("failed to scan webhook subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// GetSubscriptionsByIDs returns the subscriptions matching ids in no particular order; unknown ids are skipped.
+func (r *webhookRepo) GetSubscriptionsByIDs(ctx context.Context, ids []uuid.UUID) ([]*biz.WebhookSubscription, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return r.querySubscriptions(ctx, goqu.Select(subscriptionColumns...).
+		From("webhook_subscriptions").
+		Where(goqu.C("id").In(ids)))
+}
+
+func (r *webhookRepo) ListSubscriptions(ctx context.Context, filter biz.WebhookSubscriptionFilter, pagination biz.PaginationRequest) ([]*biz.WebhookSubscription, *biz.PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	var conditions []exp.Expression
+	if filter.CreatedBy != nil {
+		conditions = append(conditions, goqu.C("created_by").Eq(*filter.CreatedBy))
+	}
+
+	totalCount, err := r.count(ctx, "webhook_subscriptions", conditions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	subscriptions, err := r.querySubscriptions(ctx, goqu.Select(subscriptionColumns...).
+		From("webhook_subscriptions").
+		Where(conditions...).
+		Order(goqu.C("created_at").Desc()).
+		Limit(uint(pagination.PageSize)).
+		Offset(uint(offset)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return subscriptions, paginationResponse(pagination, totalCount), nil
+}
+
+func (r *webhookRepo) FindSubscribers(ctx context.Context, owner uuid.UUID, eventType biz.WebhookEventType) ([]*biz.WebhookSubscription, error) {
+	return r.querySubscriptions(ctx, goqu.Select(subscriptionColumns...).
+		From("webhook_subscriptions").
+		Where(
+			goqu.C("created_by").Eq(owner),
+			goqu.C("active").IsTrue(),
+			goqu.L("? = ANY(event_types)", string(eventType)),
+		))
+}
+
+func (r *webhookRepo) querySubscriptions(ctx context.Context, ds *goqu.SelectDataset) ([]*biz.WebhookSubscription, error) {
+	query, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*biz.WebhookSubscription
+	for rows.Next() {
+		subscription, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook subscription: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, rows.Err()
+}
+
+func scanSubscription(row pgx.Row) (*biz.WebhookSubscription, error) {
+	var subscription biz.WebhookSubscription
+	err := row.Scan(
+		&subscription.ID,
+		&subscription.URL,
+		&subscription.EventTypes,
+		&subscription.Secret,
+		&subscription.Active,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+		&subscription.CreatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// Deliveries
+
+func (r *webhookRepo) CreateDeliveries(ctx context.Context, deliveries []*biz.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	rows := make([]any, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		if delivery.ID == uuid.Nil {
+			delivery.ID = uuid.Must(uuid.NewV7())
+		}
+		delivery.NextAttemptAt = &now
+		delivery.CreatedAt = now
+		delivery.UpdatedAt = now
+
+		rows = append(rows, goqu.Record{
+			"id":              delivery.ID,
+			"subscription_id": delivery.SubscriptionID,
+			"event_id":        delivery.EventID,
+			"event_type":      delivery.EventType,
+			"payload":         string(delivery.Payload),
+			"status":          delivery.Status,
+			"next_attempt_at": now,
+			"created_at":      now,
+			"updated_at":      now,
+		})
+	}
+
+	query, args, err := goqu.Insert("webhook_deliveries").Rows(rows...).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) GetDelivery(ctx context.Context, id uuid.UUID) (*biz.WebhookDelivery, error) {
+	query, args, err := goqu.Select(deliveryColumns...).
+		From("webhook_deliveries").
+		Where(goqu.C("id").Eq(id)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	delivery, err := scanDelivery(conn(ctx, r.db).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, biz.ErrWebhookDeliveryNotFound
+		}
+		return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (r *webhookRepo) ListDeliveries(ctx context.Context, filter biz.WebhookDeliveryFilter, pagination biz.PaginationRequest) ([]*biz.WebhookDelivery, *biz.PaginationResponse, error) {
+	pagination.SetDefaults()
+
+	var conditions []exp.Expression
+	if filter.SubscriptionID != nil {
+		conditions = append(conditions, goqu.C("subscription_id").Eq(*filter.SubscriptionID))
+	}
+	if filter.Status != nil {
+		conditions = append(conditions, goqu.C("status").Eq(*filter.Status))
+	}
+
+	totalCount, err := r.count(ctx, "webhook_deliveries", conditions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	deliveries, err := r.queryDeliveries(ctx, goqu.Select(deliveryColumns...).
+		From("webhook_deliveries").
+		Where(conditions...).
+		Order(goqu.C("created_at").Desc()).
+		Limit(uint(pagination.PageSize)).
+		Offset(uint(offset)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deliveries, paginationResponse(pagination, totalCount), nil
+}
+
+func (r *webhookRepo) ClaimDueDeliveries(ctx context.Context, limit int32, lease time.Duration) ([]*biz.WebhookDelivery, error) {
+	now := time.Now()
+
+	// SKIP LOCKED lets several dispatchers poll the same table without handing out a delivery twice
+	due := goqu.From("webhook_deliveries").
+		Select("id").
+		Where(
+			goqu.C("status").Eq(biz.WebhookDeliveryStatusPending),
+			goqu.C("next_attempt_at").Lte(now),
+		).
+		Order(goqu.C("next_attempt_at").Asc()).
+		Limit(uint(limit)).
+		ForUpdate(exp.SkipLocked)
+
+	query, args, err := goqu.Update("webhook_deliveries").
+		Set(goqu.Record{
+			"attempts":        goqu.L("attempts + 1"),
+			"last_attempt_at": now,
+			"next_attempt_at": now.Add(lease),
+			"updated_at":      now,
+		}).
+		Where(goqu.C("id").In(due)).
+		Returning(deliveryColumns...).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build claim query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*biz.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (r *webhookRepo) RecordAttempt(ctx context.Context, id uuid.UUID, attempt *biz.WebhookDeliveryAttempt) error {
+	query, args, err := goqu.Update("webhook_deliveries").
+		Set(goqu.Record{
+			"status":          attempt.Status,
+			"next_attempt_at": attempt.NextAttemptAt,
+			"response_status": attempt.ResponseStatus,
+			"last_error":      attempt.Error,
+			"updated_at":      time.Now(),
+		}).
+		Where(goqu.C("id").Eq(id)).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) ResetDelivery(ctx context.Context, id uuid.UUID) (*biz.WebhookDelivery, error) {
+	now := time.Now()
+
+	query, args, err := goqu.Update("webhook_deliveries").
+		Set(goqu.Record{
+			"status":          biz.WebhookDeliveryStatusPending,
+			"attempts":        0,
+			"next_attempt_at": now,
+			"updated_at":      now,
+		}).
+		Where(goqu.C("id").Eq(id)).
+		Returning(deliveryColumns...).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	delivery, err := scanDelivery(conn(ctx, r.db).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, biz.ErrWebhookDeliveryNotFound
+		}
+		return nil, fmt.Errorf("failed to reset webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (r *webhookRepo) queryDeliveries(ctx context.Context, ds *goqu.SelectDataset) ([]*biz.WebhookDelivery, error) {
+	query, args, err := ds.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*biz.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func scanDelivery(row pgx.Row) (*biz.WebhookDelivery, error) {
+	var delivery biz.WebhookDelivery
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.SubscriptionID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.LastAttemptAt,
+		&delivery.ResponseStatus,
+		&delivery.LastError,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+func (r *webhookRepo) count(ctx context.Context, table string, conditions []exp.Expression) (int32, error) {
+	query, args, err := goqu.Select(goqu.COUNT("*")).
+		From(table).
+		Where(conditions...).
+		ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var totalCount int32
+	if err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&totalCount); err != nil {
+		return 0, fmt.Errorf("failed to count %s: %w", table, err)
+	}
+
+	return totalCount, nil
+}
+
+func paginationResponse(pagination biz.PaginationRequest, totalCount int32) *biz.PaginationResponse {
+	return &biz.PaginationResponse{
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalCount: totalCount,
+		TotalPages: (totalCount + pagination.PageSize - 1) / pagination.PageSize,
+	}
+}
@@ -0,0 +1,171 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+func TestWebhookRepo_CRUDJourney(t *testing.T) {
+	helper := SetupTestDB(t)
+	defer helper.Cleanup(context.Background(), t)
+
+	ctx := context.Background()
+	repo := NewWebhookRepository(helper.Pool)
+	userID := uuid.MustParse(GetTestUserID())
+	otherUserID := uuid.MustParse(GetTestUserID2())
+
+	subscription := &biz.WebhookSubscription{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{string(biz.WebhookEventProgramCreated), string(biz.WebhookEventProgramPublished)},
+		Secret:     "secret",
+		Active:     true,
+		CreatedBy:  userID,
+	}
+
+	// Test 1: Create Subscription
+	t.Run("CreateSubscription", func(t *testing.T) {
+		err := repo.CreateSubscription(ctx, subscription)
+		AssertNoError(t, err, "creating webhook subscription")
+
+		if subscription.ID == uuid.Nil {
+			t.Error("Expected subscription ID to be set")
+		}
+
+		retrieved, err := repo.GetSubscription(ctx, subscription.ID)
+		AssertNoError(t, err, "getting webhook subscription")
+
+		if retrieved.URL != subscription.URL {
+			t.Errorf("Expected URL %s, got %s", subscription.URL, retrieved.URL)
+		}
+		if len(retrieved.EventTypes) != 2 {
+			t.Errorf("Expected 2 event types, got %d", len(retrieved.EventTypes))
+		}
+	})
+
+	// Test 2: Find Subscribers by owner and event type
+	t.Run("FindSubscribers", func(t *testing.T) {
+		subscribers, err := repo.FindSubscribers(ctx, userID, biz.WebhookEventProgramPublished)
+		AssertNoError(t, err, "finding subscribers")
+		if len(subscribers) != 1 {
+			t.Errorf("Expected 1 subscriber, got %d", len(subscribers))
+		}
+
+		subscribers, err = repo.FindSubscribers(ctx, userID, biz.WebhookEventEpisodeDeleted)
+		AssertNoError(t, err, "finding subscribers for unsubscribed event")
+		if len(subscribers) != 0 {
+			t.Errorf("Expected no subscribers for unsubscribed event, got %d", len(subscribers))
+		}
+
+		subscribers, err = repo.FindSubscribers(ctx, otherUserID, biz.WebhookEventProgramPublished)
+		AssertNoError(t, err, "finding subscribers for another owner")
+		if len(subscribers) != 0 {
+			t.Errorf("Expected no subscribers for another owner, got %d", len(subscribers))
+		}
+	})
+
+	// Test 3: Update Subscription (only the owner can)
+	t.Run("UpdateSubscription", func(t *testing.T) {
+		active := false
+		_, err := repo.UpdateSubscription(ctx, otherUserID, subscription.ID, &biz.UpdateWebhookSubscriptionRequest{Active: &active})
+		AssertError(t, err, "updating another user's subscription")
+
+		updated, err := repo.UpdateSubscription(ctx, userID, subscription.ID, &biz.UpdateWebhookSubscriptionRequest{Active: &active})
+		AssertNoError(t, err, "deactivating subscription")
+		if updated.Active {
+			t.Error("Expected subscription to be inactive")
+		}
+
+		subscribers, err := repo.FindSubscribers(ctx, userID, biz.WebhookEventProgramPublished)
+		AssertNoError(t, err, "finding subscribers after deactivation")
+		if len(subscribers) != 0 {
+			t.Errorf("Expected inactive subscription to be skipped, got %d", len(subscribers))
+		}
+
+		active = true
+		_, err = repo.UpdateSubscription(ctx, userID, subscription.ID, &biz.UpdateWebhookSubscriptionRequest{Active: &active})
+		AssertNoError(t, err, "reactivating subscription")
+	})
+
+	delivery := &biz.WebhookDelivery{
+		SubscriptionID: subscription.ID,
+		EventID:        uuid.New(),
+		EventType:      biz.WebhookEventProgramCreated,
+		Payload:        []byte(`{"type":"program.created"}`),
+		Status:         biz.WebhookDeliveryStatusPending,
+	}
+
+	// Test 4: Claim due deliveries once
+	t.Run("ClaimDueDeliveries", func(t *testing.T) {
+		err := repo.CreateDeliveries(ctx, []*biz.WebhookDelivery{delivery})
+		AssertNoError(t, err, "creating delivery")
+
+		claimed, err := repo.ClaimDueDeliveries(ctx, 10, time.Minute)
+		AssertNoError(t, err, "claiming deliveries")
+		if len(claimed) != 1 {
+			t.Fatalf("Expected 1 claimed delivery, got %d", len(claimed))
+		}
+		if claimed[0].Attempts != 1 {
+			t.Errorf("Expected attempts to be 1, got %d", claimed[0].Attempts)
+		}
+
+		claimed, err = repo.ClaimDueDeliveries(ctx, 10, time.Minute)
+		AssertNoError(t, err, "claiming deliveries again")
+		if len(claimed) != 0 {
+			t.Errorf("Expected leased delivery not to be claimed again, got %d", len(claimed))
+		}
+	})
+
+	// Test 5: Dead-letter and redeliver
+	t.Run("RecordAttemptAndReset", func(t *testing.T) {
+		status := int32(500)
+		message := "unexpected response status 500 Internal Server Error"
+		err := repo.RecordAttempt(ctx, delivery.ID, &biz.WebhookDeliveryAttempt{
+			Status:         biz.WebhookDeliveryStatusDead,
+			ResponseStatus: &status,
+			Error:          &message,
+		})
+		AssertNoError(t, err, "recording attempt")
+
+		dead := biz.WebhookDeliveryStatusDead
+		deliveries, pagination, err := repo.ListDeliveries(ctx, biz.WebhookDeliveryFilter{
+			SubscriptionID: &subscription.ID,
+			Status:         &dead,
+		}, biz.PaginationRequest{})
+		AssertNoError(t, err, "listing dead deliveries")
+		if pagination.TotalCount != 1 || len(deliveries) != 1 {
+			t.Fatalf("Expected 1 dead delivery, got %d", pagination.TotalCount)
+		}
+		if deliveries[0].ResponseStatus == nil || *deliveries[0].ResponseStatus != 500 {
+			t.Error("Expected response status 500 to be recorded")
+		}
+
+		reset, err := repo.ResetDelivery(ctx, delivery.ID)
+		AssertNoError(t, err, "resetting delivery")
+		if reset.Status != biz.WebhookDeliveryStatusPending || reset.Attempts != 0 {
+			t.Errorf("Expected pending delivery with no attempts, got %s with %d", reset.Status, reset.Attempts)
+		}
+
+		claimed, err := repo.ClaimDueDeliveries(ctx, 10, time.Minute)
+		AssertNoError(t, err, "claiming redelivered delivery")
+		if len(claimed) != 1 {
+			t.Errorf("Expected redelivered delivery to be claimed, got %d", len(claimed))
+		}
+	})
+
+	// Test 6: Delete Subscription removes its deliveries
+	t.Run("DeleteSubscription", func(t *testing.T) {
+		err := repo.DeleteSubscription(ctx, otherUserID, subscription.ID)
+		AssertError(t, err, "deleting another user's subscription")
+
+		err = repo.DeleteSubscription(ctx, userID, subscription.ID)
+		AssertNoError(t, err, "deleting subscription")
+
+		_, err = repo.GetDelivery(ctx, delivery.ID)
+		AssertError(t, err, "getting delivery of deleted subscription")
+	})
+}
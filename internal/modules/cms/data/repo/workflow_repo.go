@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type workflowRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewWorkflowRepository(db *pgxpool.Pool) biz.WorkflowRepository {
+	return &workflowRepo{
+		db: db,
+	}
+}
+
+func (r *workflowRepo) ListTransitions(ctx context.Context, contentType biz.ContentType, contentID uuid.UUID) ([]*biz.StatusTransition, error) {
+	query, args, err := goqu.Select(
+		"id",
+		"content_type",
+		"content_id",
+		"action",
+		"from_status",
+		"to_status",
+		"actor_id",
+		"comment",
+		"created_at",
+	).From("status_transitions").
+		Where(
+			goqu.C("content_type").Eq(contentType),
+			goqu.C("content_id").Eq(contentID),
+		).
+		Order(goqu.C("created_at").Asc(), goqu.C("id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query status transitions: %w", err)
+	}
+	defer rows.Close()
+
+	var transitions []*biz.StatusTransition
+	for rows.Next() {
+		var transition biz.StatusTransition
+		var comment *string
+		err := rows.Scan(
+			&transition.ID,
+			&transition.ContentType,
+			&transition.ContentID,
+			&transition.Action,
+			&transition.FromStatus,
+			&transition.ToStatus,
+			&transition.ActorID,
+			&comment,
+			&transition.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan status transition: %w", err)
+		}
+		if comment != nil {
+			transition.Comment = *comment
+		}
+		transitions = append(transitions, &transition)
+	}
+
+	return transitions, rows.Err()
+}
+
+// insertTransitions records transitions in the transaction that made them, so a status
+// never changes without its history.
+func insertTransitions(ctx context.Context, tx pgx.Tx, transitions ...*biz.StatusTransition) error {
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	rows := make([]any, 0, len(transitions))
+	for _, transition := range transitions {
+		if transition.ID == uuid.Nil {
+			transition.ID = uuid.Must(uuid.NewV7())
+		}
+		transition.CreatedAt = now
+
+		var comment *string
+		if transition.Comment != "" {
+			comment = &transition.Comment
+		}
+		rows = append(rows, goqu.Record{
+			"id":           transition.ID,
+			"content_type": transition.ContentType,
+			"content_id":   transition.ContentID,
+			"action":       transition.Action,
+			"from_status":  transition.FromStatus,
+			"to_status":    transition.ToStatus,
+			"actor_id":     transition.ActorID,
+			"comment":      comment,
+			"created_at":   now,
+		})
+	}
+
+	query, args, err := goqu.Insert("status_transitions").Rows(rows...).ToSQL()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert status transitions: %w", err)
+	}
+
+	return nil
+}
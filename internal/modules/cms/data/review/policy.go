@@ -0,0 +1,54 @@
+package review
+
+import (
+	"errors"
+	"fmt"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/google/uuid"
+)
+
+// policy is the review policy from the workflow config. Reviewers are listed by user
+// id; review_required needs at least one, since nothing could be published otherwise.
+type policy struct {
+	reviewRequired    bool
+	allowSelfApproval bool
+	reviewers         map[uuid.UUID]struct{}
+}
+
+func NewPolicy(c *conf.Workflow) (biz.ReviewPolicy, error) {
+	p := &policy{
+		reviewRequired:    c.GetReviewRequired(),
+		allowSelfApproval: c.GetAllowSelfApproval(),
+		reviewers:         make(map[uuid.UUID]struct{}, len(c.GetReviewerIds())),
+	}
+
+	for _, raw := range c.GetReviewerIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workflow reviewer id %q: %w", raw, err)
+		}
+		p.reviewers[id] = struct{}{}
+	}
+
+	if p.reviewRequired && len(p.reviewers) == 0 {
+		return nil, errors.New("workflow review_required needs at least one reviewer id")
+	}
+
+	return p, nil
+}
+
+func (p *policy) ReviewRequired() bool {
+	return p.reviewRequired
+}
+
+func (p *policy) IsReviewer(userID uuid.UUID) bool {
+	_, ok := p.reviewers[userID]
+	return ok
+}
+
+func (p *policy) AllowSelfApproval() bool {
+	return p.allowSelfApproval
+}
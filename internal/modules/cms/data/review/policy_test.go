@@ -0,0 +1,31 @@
+package review
+
+import (
+	"testing"
+
+	"thmanyah/internal/conf"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPolicy(t *testing.T) {
+	reviewer := uuid.New()
+
+	p, err := NewPolicy(&conf.Workflow{ReviewRequired: true, ReviewerIds: []string{reviewer.String()}})
+	require.NoError(t, err)
+	assert.True(t, p.ReviewRequired())
+	assert.True(t, p.IsReviewer(reviewer))
+	assert.False(t, p.IsReviewer(uuid.New()))
+
+	p, err = NewPolicy(&conf.Workflow{})
+	require.NoError(t, err)
+	assert.False(t, p.ReviewRequired())
+
+	_, err = NewPolicy(&conf.Workflow{ReviewRequired: true})
+	assert.Error(t, err, "nothing could be published without reviewers")
+
+	_, err = NewPolicy(&conf.Workflow{ReviewerIds: []string{"someone"}})
+	assert.Error(t, err)
+}
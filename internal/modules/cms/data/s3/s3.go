@@ -14,14 +14,24 @@ import (
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
+const tracerName = "thmanyah/internal/modules/cms/data/s3"
+
 type s3Client struct {
 	minioClient *minio.Client
 	config      *conf.S3
+
+	duration metric.Float64Histogram
+	bytes    metric.Int64Counter
+	tracer   trace.Tracer
 }
 
-func NewS3Client(ctx context.Context, c *conf.Data) (biz.S3Client, error) {
+func NewS3Client(ctx context.Context, c *conf.Data, meter metric.Meter, tp trace.TracerProvider) (biz.S3Client, error) {
 	minioClient, err := minio.New(c.S3.Host, &minio.Options{
 		Creds:  credentials.NewStaticV4(c.S3.AccessKey, c.S3.SecretKey, ""),
 		Region: c.S3.Region,
@@ -58,32 +68,71 @@ func NewS3Client(ctx context.Context, c *conf.Data) (biz.S3Client, error) {
 		_ = minioClient.SetBucketPolicy(ctx, bucket, policy)
 	}
 
+	duration, err := meter.Float64Histogram(
+		"s3_operation_duration",
+		metric.WithDescription("Latency of object storage operations"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := meter.Int64Counter(
+		"s3_transferred",
+		metric.WithDescription("Bytes uploaded to and downloaded from object storage"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &s3Client{
 		minioClient: minioClient,
 		config:      c.S3,
+		duration:    duration,
+		bytes:       bytes,
+		tracer:      tp.Tracer(tracerName),
 	}, nil
 }
 
-func (c *s3Client) GetObject(ctx context.Context, bucket, key string) (io.Reader, error) {
+func (c *s3Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	ctx, span := c.startSpan(ctx, "get_object", bucket, key)
+	start := time.Now()
 	resp, err := c.minioClient.GetObject(ctx, bucket, key, minio.GetObjectOptions{})
+	if err == nil {
+		// GetObject only sets up the request; Stat sends it, so that the latency covers
+		// the round trip and a missing object fails here rather than on the first read
+		if _, err = resp.Stat(); err != nil {
+			resp.Close()
+		}
+	}
+	c.observe(ctx, span, "get_object", start, err)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &countingReader{ctx: ctx, reader: resp, counter: c.bytes}, nil
 }
 
 func (c *s3Client) PutObject(ctx context.Context, bucket, key string, file multipart.File) error {
-	_, err := c.minioClient.PutObject(ctx, bucket, key, file, -1, minio.PutObjectOptions{})
+	ctx, span := c.startSpan(ctx, "put_object", bucket, key)
+	start := time.Now()
+	info, err := c.minioClient.PutObject(ctx, bucket, key, file, -1, minio.PutObjectOptions{})
+	c.observe(ctx, span, "put_object", start, err)
 	if err != nil {
 		return err
 	}
 
+	c.bytes.Add(ctx, info.Size, metric.WithAttributes(attribute.String("operation", "put_object")))
+
 	return nil
 }
 
 func (c *s3Client) DeleteObject(ctx context.Context, bucket, key string) error {
+	ctx, span := c.startSpan(ctx, "delete_object", bucket, key)
+	start := time.Now()
 	err := c.minioClient.RemoveObject(ctx, bucket, key, minio.RemoveObjectOptions{})
+	c.observe(ctx, span, "delete_object", start, err)
 	if err != nil {
 		return err
 	}
@@ -92,7 +141,10 @@ func (c *s3Client) DeleteObject(ctx context.Context, bucket, key string) error {
 }
 
 func (c *s3Client) GetObjectSignedURL(ctx context.Context, bucket, key string) (string, error) {
+	ctx, span := c.startSpan(ctx, "presign_get_object", bucket, key)
+	start := time.Now()
 	resp, err := c.minioClient.PresignedGetObject(ctx, bucket, key, time.Hour*24, url.Values{})
+	c.observe(ctx, span, "presign_get_object", start, err)
 	if err != nil {
 		return "", err
 	}
@@ -107,3 +159,51 @@ func (c *s3Client) GetObjectPublicURL(ctx context.Context, bucket, key string) s
 
 	return fmt.Sprintf("%s/%s/%s", c.config.Host, bucket, key)
 }
+
+func (c *s3Client) startSpan(ctx context.Context, operation, bucket, key string) (context.Context, trace.Span) {
+	return c.tracer.Start(ctx, "s3."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("s3.bucket", bucket),
+			attribute.String("s3.key", key),
+		),
+	)
+}
+
+// observe records the operation latency and ends its span.
+func (c *s3Client) observe(ctx context.Context, span trace.Span, operation string, start time.Time, err error) {
+	defer span.End()
+
+	result := "success"
+	if err != nil {
+		result = "error"
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	c.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		attribute.String("operation", operation),
+		attribute.String("result", result),
+	))
+}
+
+// countingReader reports downloaded bytes as the object is consumed,
+// since minio only fetches the body on the first Read.
+type countingReader struct {
+	ctx     context.Context
+	reader  io.ReadCloser
+	counter metric.Int64Counter
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.counter.Add(r.ctx, int64(n), metric.WithAttributes(attribute.String("operation", "get_object")))
+	}
+
+	return n, err
+}
+
+func (r *countingReader) Close() error {
+	return r.reader.Close()
+}
@@ -0,0 +1,296 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/modules/cms/biz"
+	"thmanyah/internal/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Headers sent with every delivery. Receivers verify the signature by computing
+// Sign(secret, timestamp, body) themselves and rejecting stale timestamps.
+const (
+	DeliveryHeader  = "X-Webhook-Delivery"
+	EventHeader     = "X-Webhook-Event"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+const (
+	defaultPollInterval   = 5 * time.Second
+	defaultBatchSize      = 20
+	defaultMaxAttempts    = 8
+	defaultInitialBackoff = 30 * time.Second
+	defaultMaxBackoff     = time.Hour
+	defaultRequestTimeout = 10 * time.Second
+)
+
+// Dispatcher is a background server that posts queued webhook deliveries and
+// schedules retries with exponential backoff until they succeed or run out of attempts.
+type Dispatcher struct {
+	repo   biz.WebhookRepository
+	client *http.Client
+	logger *log.Helper
+
+	enabled        bool
+	pollInterval   time.Duration
+	batchSize      int32
+	maxAttempts    int32
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	lease          time.Duration
+
+	deliveries metric.Int64Counter
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+func NewDispatcher(c *conf.Jobs, repo biz.WebhookRepository, meter metric.Meter, logger log.Logger) (*Dispatcher, error) {
+	deliveries, err := meter.Int64Counter("webhook_delivery_attempts", metric.WithDescription("Webhook delivery attempts by outcome"))
+	if err != nil {
+		return nil, err
+	}
+
+	w := c.GetWebhooks()
+	d := &Dispatcher{
+		repo:           repo,
+		logger:         log.NewHelper(logger),
+		enabled:        w.GetEnabled(),
+		pollInterval:   defaultPollInterval,
+		batchSize:      defaultBatchSize,
+		maxAttempts:    defaultMaxAttempts,
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		deliveries:     deliveries,
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+	if w.GetPollInterval() != nil {
+		d.pollInterval = w.GetPollInterval().AsDuration()
+	}
+	if w.GetBatchSize() > 0 {
+		d.batchSize = w.GetBatchSize()
+	}
+	if w.GetMaxAttempts() > 0 {
+		d.maxAttempts = w.GetMaxAttempts()
+	}
+	if w.GetInitialBackoff() != nil {
+		d.initialBackoff = w.GetInitialBackoff().AsDuration()
+	}
+	if w.GetMaxBackoff() != nil {
+		d.maxBackoff = w.GetMaxBackoff().AsDuration()
+	}
+
+	timeout := defaultRequestTimeout
+	if w.GetRequestTimeout() != nil {
+		timeout = w.GetRequestTimeout().AsDuration()
+	}
+	// A claimed delivery stays hidden long enough for its request to finish
+	d.lease = timeout + 30*time.Second
+	// Subscriptions are checked when they are saved, but their host may resolve elsewhere
+	// by the time a delivery is sent
+	dialer := &net.Dialer{Timeout: timeout, Control: utils.DialPublicOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	d.client = &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		// Redirects count as failures so a subscription cannot bounce deliveries elsewhere
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return d, nil
+}
+
+func (d *Dispatcher) Start(ctx context.Context) error {
+	defer close(d.done)
+
+	if !d.enabled {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-d.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	d.stopOnce.Do(func() { close(d.stop) })
+
+	select {
+	case <-d.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context) {
+	deliveries, err := d.repo.ClaimDueDeliveries(ctx, d.batchSize, d.lease)
+	if err != nil {
+		if ctx.Err() == nil {
+			d.logger.Errorf("Failed to claim webhook deliveries: %v", err)
+		}
+		return
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.SubscriptionID)
+	}
+	subscriptions, err := d.repo.GetSubscriptionsByIDs(ctx, ids)
+	if err != nil {
+		// The claimed deliveries come back once their lease runs out
+		d.logger.Errorf("Failed to load webhook subscriptions: %v", err)
+		return
+	}
+	byID := make(map[uuid.UUID]*biz.WebhookSubscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		byID[subscription.ID] = subscription
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.deliver(ctx, byID[delivery.SubscriptionID], delivery)
+		}()
+	}
+	wg.Wait()
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, subscription *biz.WebhookSubscription, delivery *biz.WebhookDelivery) {
+	var statusCode int
+	var err error
+	if subscription == nil || !subscription.Active {
+		err = fmt.Errorf("subscription is inactive")
+	} else {
+		statusCode, err = d.send(ctx, subscription, delivery)
+	}
+	if ctx.Err() != nil {
+		// Shutting down; the delivery is retried once its lease runs out
+		return
+	}
+
+	attempt := &biz.WebhookDeliveryAttempt{}
+	if statusCode != 0 {
+		code := int32(statusCode)
+		attempt.ResponseStatus = &code
+	}
+
+	switch {
+	case err == nil:
+		attempt.Status = biz.WebhookDeliveryStatusSucceeded
+	case subscription == nil || !subscription.Active || delivery.Attempts >= d.maxAttempts:
+		attempt.Status = biz.WebhookDeliveryStatusDead
+	default:
+		attempt.Status = biz.WebhookDeliveryStatusPending
+		next := time.Now().Add(d.backoff(delivery.Attempts))
+		attempt.NextAttemptAt = &next
+	}
+	if err != nil {
+		message := err.Error()
+		attempt.Error = &message
+	}
+
+	d.deliveries.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("event_type", string(delivery.EventType)),
+		attribute.String("status", string(attempt.Status)),
+	))
+
+	if err := d.repo.RecordAttempt(ctx, delivery.ID, attempt); err != nil {
+		d.logger.Errorf("Failed to record webhook delivery %s: %v", delivery.ID, err)
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, subscription *biz.WebhookSubscription, delivery *biz.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(EventHeader, string(delivery.EventType))
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// backoff is the delay after the given failed attempt: initial_backoff doubled per
+// earlier failure, capped at max_backoff.
+func (d *Dispatcher) backoff(attempt int32) time.Duration {
+	delay := d.initialBackoff
+	for i := int32(1); i < attempt && delay < d.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.maxBackoff)
+}
+
+// Sign returns the X-Webhook-Signature value for body: the hex-encoded HMAC-SHA256
+// of "<timestamp>.<body>" keyed with the subscription secret.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+func TestDispatcher_RefusesInternalAddresses(t *testing.T) {
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	d, err := NewDispatcher(&conf.Jobs{}, nil, noop.NewMeterProvider().Meter("test"), log.DefaultLogger)
+	require.NoError(t, err)
+
+	_, err = d.send(context.Background(), &biz.WebhookSubscription{URL: srv.URL, Secret: "secret"}, &biz.WebhookDelivery{})
+	assert.ErrorContains(t, err, "non-public address")
+	assert.False(t, called)
+}
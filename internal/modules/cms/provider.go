@@ -3,8 +3,12 @@ package cms
 import (
 	"github.com/google/wire"
 	"thmanyah/internal/modules/cms/biz"
+	"thmanyah/internal/modules/cms/data/audit"
+	"thmanyah/internal/modules/cms/data/pgnotify"
 	"thmanyah/internal/modules/cms/data/repo"
+	"thmanyah/internal/modules/cms/data/review"
 	"thmanyah/internal/modules/cms/data/s3"
+	"thmanyah/internal/modules/cms/data/webhook"
 	"thmanyah/internal/modules/cms/service"
 )
 
@@ -15,7 +19,25 @@ var ProviderSet = wire.NewSet(
 	repo.NewProgramRepository,
 	repo.NewEpisodeRepository,
 	repo.NewImportRepository,
+	repo.NewWebhookRepository,
+	repo.NewWorkflowRepository,
+	repo.NewRevisionRepository,
+	repo.NewTrashRepository,
+	repo.NewAuditRepository,
+	repo.NewTagRepository,
+	repo.NewTranslationRepository,
+	repo.NewSeasonRepository,
+	repo.NewChapterRepository,
+	repo.NewTranscriptRepository,
+	repo.NewPersonRepository,
+	repo.NewCreditRepository,
+	repo.NewTransactor,
+	review.NewPolicy,
+	audit.NewPolicy,
 	s3.NewS3Client,
+	webhook.NewDispatcher,
+	pgnotify.NewImportListener,
+	wire.Bind(new(biz.ImportNotifier), new(*pgnotify.ImportListener)),
 
 	// biz layer dependencies
 	biz.NewUseCase,
@@ -23,4 +45,8 @@ var ProviderSet = wire.NewSet(
 	// network layer dependencies
 	service.NewAuthService,
 	service.NewCmsService,
+	service.NewWebhookService,
+	service.NewEpisodeScheduler,
+	service.NewTrashPurger,
+	service.NewAuditor,
 )
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+
+	"thmanyah/internal/modules/cms/biz"
+	"thmanyah/internal/observability"
+	"thmanyah/internal/utils"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// auditedOperations are the operations that change state and are recorded in the
+// audit log. The episode upload is a plain HTTP route, named by its path.
+var auditedOperations = map[string]bool{
+	"/thmanyah.v1.AuthService/Login":             true,
+	"/thmanyah.v1.AuthService/Register":          true,
+	"/thmanyah.v1.AuthService/UpdateUserProfile": true,
+
+	"/thmanyah.v1.CmsService/CreateProgram":         true,
+	"/thmanyah.v1.CmsService/UpdateProgram":         true,
+	"/thmanyah.v1.CmsService/DeleteProgram":         true,
+	"/thmanyah.v1.CmsService/BulkUpdatePrograms":    true,
+	"/thmanyah.v1.CmsService/BulkDeletePrograms":    true,
+	"/thmanyah.v1.CmsService/SetProgramCategories":  true,
+	"/thmanyah.v1.CmsService/CreateCategory":        true,
+	"/thmanyah.v1.CmsService/UpdateCategory":        true,
+	"/thmanyah.v1.CmsService/DeleteCategory":        true,
+	"/thmanyah.v1.CmsService/MoveCategory":          true,
+	"/thmanyah.v1.CmsService/CreateEpisode":         true,
+	"/thmanyah.v1.CmsService/UpdateEpisode":         true,
+	"/thmanyah.v1.CmsService/DeleteEpisode":         true,
+	"/thmanyah.v1.CmsService/RescheduleEpisode":     true,
+	"/thmanyah.v1.CmsService/CancelEpisodeSchedule": true,
+	"/thmanyah.v1.CmsService/CreateSeason":          true,
+	"/thmanyah.v1.CmsService/UpdateSeason":          true,
+	"/thmanyah.v1.CmsService/DeleteSeason":          true,
+	"/thmanyah.v1.CmsService/ReorderEpisodes":       true,
+	"/thmanyah.v1.CmsService/CreateChapter":         true,
+	"/thmanyah.v1.CmsService/UpdateChapter":         true,
+	"/thmanyah.v1.CmsService/DeleteChapter":         true,
+	"/thmanyah.v1.CmsService/ReplaceChapters":       true,
+	"/thmanyah.v1.CmsService/ImportChapters":        true,
+	"/thmanyah.v1.CmsService/UploadTranscript":      true,
+	"/thmanyah.v1.CmsService/DeleteTranscript":      true,
+	"/thmanyah.v1.CmsService/CreatePerson":          true,
+	"/thmanyah.v1.CmsService/UpdatePerson":          true,
+	"/thmanyah.v1.CmsService/DeletePerson":          true,
+	"/thmanyah.v1.CmsService/CreateCredit":          true,
+	"/thmanyah.v1.CmsService/UpdateCredit":          true,
+	"/thmanyah.v1.CmsService/DeleteCredit":          true,
+	"/thmanyah.v1.CmsService/SubmitForReview":       true,
+	"/thmanyah.v1.CmsService/Approve":               true,
+	"/thmanyah.v1.CmsService/Reject":                true,
+	"/thmanyah.v1.CmsService/RestoreRevision":       true,
+	"/thmanyah.v1.CmsService/RestoreFromTrash":      true,
+	"/thmanyah.v1.CmsService/PurgeTrash":            true,
+	"/thmanyah.v1.CmsService/UpdateTag":             true,
+	"/thmanyah.v1.CmsService/RenameTag":             true,
+	"/thmanyah.v1.CmsService/MergeTags":             true,
+	"/thmanyah.v1.CmsService/SetTranslation":        true,
+	"/thmanyah.v1.CmsService/DeleteTranslation":     true,
+	"/thmanyah.v1.CmsService/ImportData":            true,
+	"/api/v1/cms/episodes/upload":                   true,
+
+	"/thmanyah.v1.WebhookService/CreateWebhook":    true,
+	"/thmanyah.v1.WebhookService/UpdateWebhook":    true,
+	"/thmanyah.v1.WebhookService/DeleteWebhook":    true,
+	"/thmanyah.v1.WebhookService/RedeliverWebhook": true,
+}
+
+// storageOperations are the audited operations that read or write object storage. They
+// run outside the audit transaction, so that no transaction is held open meanwhile.
+var storageOperations = map[string]bool{
+	"/thmanyah.v1.CmsService/UploadTranscript": true,
+	"/thmanyah.v1.CmsService/DeleteTranscript": true,
+	"/thmanyah.v1.CmsService/PurgeTrash":       true,
+	"/api/v1/cms/episodes/upload":              true,
+}
+
+// Auditor records every call of an audited operation in the audit log.
+type Auditor struct {
+	uc *biz.UseCase
+}
+
+func NewAuditor(uc *biz.UseCase) *Auditor {
+	return &Auditor{uc: uc}
+}
+
+// Server runs audited operations in a transaction together with their audit event, or
+// lets those in storageOperations store it with their own. It belongs after the JWT
+// middleware, so the event names the caller.
+func (a *Auditor) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || !auditedOperations[tr.Operation()] {
+				return handler(ctx, req)
+			}
+
+			event := &biz.AuditEvent{
+				PrincipalType: biz.PrincipalAnonymous,
+				Operation:     tr.Operation(),
+				IPAddress:     observability.ClientIP(ctx),
+				UserAgent:     tr.RequestHeader().Get("User-Agent"),
+				RequestID:     observability.RequestIDFromContext(ctx),
+			}
+			if userID, err := utils.GetUserID(ctx); err == nil {
+				event.ActorID = &userID
+				event.PrincipalType = biz.PrincipalUser
+			}
+
+			audited := a.uc.Audited
+			if storageOperations[tr.Operation()] {
+				audited = a.uc.AuditedOutsideTx
+			}
+
+			var res any
+			err := audited(ctx, event, func(ctx context.Context) error {
+				var err error
+				res, err = handler(ctx, req)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return res, nil
+		}
+	}
+}
@@ -2,20 +2,19 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"slices"
 
-	khttp "github.com/go-kratos/kratos/v2/transport/http"
-
-	"github.com/go-kratos/kratos/v2/errors"
 	v1 "thmanyah/api/grpc/v1"
+	"thmanyah/internal/geo"
 	"thmanyah/internal/modules/cms/biz"
 	"thmanyah/internal/utils"
 	"thmanyah/internal/utils/convert"
+	"thmanyah/internal/validation"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type CmsService struct {
@@ -36,7 +35,7 @@ func (s *CmsService) CreateProgram(ctx context.Context, req *v1.CreateProgramReq
 		return nil, err
 	}
 
-	categoryID, err := uuid.Parse(req.CategoryId)
+	categoryID, err := validation.ParseUUID("category_id", req.CategoryId)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +58,17 @@ func (s *CmsService) CreateProgram(ctx context.Context, req *v1.CreateProgramReq
 		program.SourceURL = &sourceUrl
 	}
 
+	if availability := convert.ProtoAvailabilityToBiz(req.Availability); availability != nil {
+		program.Availability = *availability
+	}
+
+	if len(req.CategoryIds) > 0 {
+		program.CategoryIDs, err = validation.ParseUUIDs("category_ids", req.CategoryIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = s.uc.CreateProgram(ctx, program)
 	if err != nil {
 		return nil, err
@@ -76,7 +86,7 @@ func (s *CmsService) CreateProgram(ctx context.Context, req *v1.CreateProgramReq
 }
 
 func (s *CmsService) UpdateProgram(ctx context.Context, req *v1.UpdateProgramRequest) (*v1.UpdateProgramResponse, error) {
-	programID, err := uuid.Parse(req.ProgramId)
+	programID, err := validation.ParseUUID("program_id", req.ProgramId)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +101,7 @@ func (s *CmsService) UpdateProgram(ctx context.Context, req *v1.UpdateProgramReq
 		updates.Description = req.Description
 	}
 	if req.CategoryId != nil {
-		categoryID, err := uuid.Parse(*req.CategoryId)
+		categoryID, err := validation.ParseUUID("category_id", *req.CategoryId)
 		if err != nil {
 			return nil, err
 		}
@@ -101,7 +111,7 @@ func (s *CmsService) UpdateProgram(ctx context.Context, req *v1.UpdateProgramReq
 		if bizStatus, exists := convert.ProtoToBizProgramStatus[*req.Status]; exists {
 			updates.Status = &bizStatus
 		} else {
-			return nil, fmt.Errorf("invalid program status: %v", *req.Status)
+			return nil, validation.InvalidEnum("status", *req.Status)
 		}
 	}
 	if req.ThumbnailUrl != nil {
@@ -120,6 +130,7 @@ func (s *CmsService) UpdateProgram(ctx context.Context, req *v1.UpdateProgramReq
 	if req.IsFeatured != nil {
 		updates.IsFeatured = req.IsFeatured
 	}
+	updates.Availability = convert.ProtoAvailabilityToBiz(req.Availability)
 
 	program, err := s.uc.UpdateProgram(ctx, programID, updates)
 	if err != nil {
@@ -132,7 +143,7 @@ func (s *CmsService) UpdateProgram(ctx context.Context, req *v1.UpdateProgramReq
 }
 
 func (s *CmsService) DeleteProgram(ctx context.Context, req *v1.DeleteProgramRequest) (*emptypb.Empty, error) {
-	programID, err := uuid.Parse(req.ProgramId)
+	programID, err := validation.ParseUUID("program_id", req.ProgramId)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +157,7 @@ func (s *CmsService) DeleteProgram(ctx context.Context, req *v1.DeleteProgramReq
 }
 
 func (s *CmsService) GetProgram(ctx context.Context, req *v1.GetProgramRequest) (*v1.GetProgramResponse, error) {
-	programID, err := uuid.Parse(req.ProgramId)
+	programID, err := validation.ParseUUID("program_id", req.ProgramId)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +176,7 @@ func (s *CmsService) ListPrograms(ctx context.Context, req *v1.ListProgramsReque
 	filter := biz.ProgramFilter{}
 
 	if req.CategoryId != "" {
-		categoryID, err := uuid.Parse(req.CategoryId)
+		categoryID, err := validation.ParseUUID("category_id", req.CategoryId)
 		if err != nil {
 			return nil, err
 		}
@@ -176,7 +187,7 @@ func (s *CmsService) ListPrograms(ctx context.Context, req *v1.ListProgramsReque
 		if bizStatus, exists := convert.ProtoToBizProgramStatus[req.Status]; exists {
 			filter.Status = &bizStatus
 		} else {
-			return nil, fmt.Errorf("invalid program status: %v", req.Status)
+			return nil, validation.InvalidEnum("status", req.Status)
 		}
 	}
 
@@ -215,14 +226,27 @@ func (s *CmsService) ListPrograms(ctx context.Context, req *v1.ListProgramsReque
 	}, nil
 }
 
+func (s *CmsService) BatchGetPrograms(ctx context.Context, req *v1.BatchGetProgramsRequest) (*v1.BatchGetProgramsResponse, error) {
+	programIDs, err := validation.ParseUUIDs("program_ids", req.ProgramIds)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.uc.BatchGetPrograms(ctx, programIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.BatchGetProgramsResponse{
+		Programs:    convert.ConvertPrograms(batch.Found),
+		NotFoundIds: convert.ConvertIDs(batch.NotFound),
+	}, nil
+}
+
 func (s *CmsService) BulkUpdatePrograms(ctx context.Context, req *v1.BulkUpdateProgramsRequest) (*v1.BulkUpdateProgramsResponse, error) {
-	programIDs := make([]uuid.UUID, len(req.ProgramIds))
-	for i, idStr := range req.ProgramIds {
-		programID, err := uuid.Parse(idStr)
-		if err != nil {
-			return nil, err
-		}
-		programIDs[i] = programID
+	programIDs, err := validation.ParseUUIDs("program_ids", req.ProgramIds)
+	if err != nil {
+		return nil, err
 	}
 
 	updates := &biz.BulkUpdateProgramsRequest{}
@@ -231,11 +255,11 @@ func (s *CmsService) BulkUpdatePrograms(ctx context.Context, req *v1.BulkUpdateP
 		if bizStatus, exists := convert.ProtoToBizProgramStatus[req.Status]; exists {
 			updates.Status = &bizStatus
 		} else {
-			return nil, fmt.Errorf("invalid program status: %v", req.Status)
+			return nil, validation.InvalidEnum("status", req.Status)
 		}
 	}
 	if req.CategoryId != "" {
-		categoryID, err := uuid.Parse(req.CategoryId)
+		categoryID, err := validation.ParseUUID("category_id", req.CategoryId)
 		if err != nil {
 			return nil, err
 		}
@@ -262,23 +286,44 @@ func (s *CmsService) BulkUpdatePrograms(ctx context.Context, req *v1.BulkUpdateP
 }
 
 func (s *CmsService) BulkDeletePrograms(ctx context.Context, req *v1.BulkDeleteProgramsRequest) (*emptypb.Empty, error) {
-	programIDs := make([]uuid.UUID, len(req.ProgramIds))
-	for i, idStr := range req.ProgramIds {
-		programID, err := uuid.Parse(idStr)
-		if err != nil {
-			return nil, err
-		}
-		programIDs[i] = programID
+	programIDs, err := validation.ParseUUIDs("program_ids", req.ProgramIds)
+	if err != nil {
+		return nil, err
 	}
 
-	err := s.uc.BulkDeletePrograms(ctx, programIDs)
-	if err != nil {
+	if err := s.uc.BulkDeletePrograms(ctx, programIDs); err != nil {
 		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+func (s *CmsService) SetProgramCategories(ctx context.Context, req *v1.SetProgramCategoriesRequest) (*v1.SetProgramCategoriesResponse, error) {
+	programID, err := validation.ParseUUID("program_id", req.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryID, err := validation.ParseUUID("primary_category_id", req.PrimaryCategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryIDs, err := validation.ParseUUIDs("category_ids", req.CategoryIds)
+	if err != nil {
+		return nil, err
+	}
+
+	program, err := s.uc.SetProgramCategories(ctx, programID, primaryID, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.SetProgramCategoriesResponse{
+		Program: convert.ConvertProgram(program),
+	}, nil
+}
+
 // Category operations
 
 func (s *CmsService) CreateCategory(ctx context.Context, req *v1.CreateCategoryRequest) (*v1.CreateCategoryResponse, error) {
@@ -292,7 +337,7 @@ func (s *CmsService) CreateCategory(ctx context.Context, req *v1.CreateCategoryR
 		if bizType, exists := convert.ProtoToBizCategoryType[req.Type]; exists {
 			categoryType = bizType
 		} else {
-			return nil, fmt.Errorf("invalid category type: %v", req.Type)
+			return nil, validation.InvalidEnum("type", req.Type)
 		}
 	}
 
@@ -304,6 +349,14 @@ func (s *CmsService) CreateCategory(ctx context.Context, req *v1.CreateCategoryR
 		CreatedBy:   userID,
 	}
 
+	if req.ParentId != "" {
+		parentID, err := validation.ParseUUID("parent_id", req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		category.ParentID = &parentID
+	}
+
 	err = s.uc.CreateCategory(ctx, category)
 	if err != nil {
 		return nil, err
@@ -321,7 +374,7 @@ func (s *CmsService) CreateCategory(ctx context.Context, req *v1.CreateCategoryR
 }
 
 func (s *CmsService) UpdateCategory(ctx context.Context, req *v1.UpdateCategoryRequest) (*v1.UpdateCategoryResponse, error) {
-	categoryID, err := uuid.Parse(req.CategoryId)
+	categoryID, err := validation.ParseUUID("category_id", req.CategoryId)
 	if err != nil {
 		return nil, err
 	}
@@ -339,7 +392,7 @@ func (s *CmsService) UpdateCategory(ctx context.Context, req *v1.UpdateCategoryR
 		if bizType, exists := convert.ProtoToBizCategoryType[*req.Type]; exists {
 			updates.Type = &bizType
 		} else {
-			return nil, fmt.Errorf("invalid category type: %v", *req.Type)
+			return nil, validation.InvalidEnum("type", *req.Type)
 		}
 	}
 	if len(req.Metadata) > 0 {
@@ -358,7 +411,7 @@ func (s *CmsService) UpdateCategory(ctx context.Context, req *v1.UpdateCategoryR
 }
 
 func (s *CmsService) DeleteCategory(ctx context.Context, req *v1.DeleteCategoryRequest) (*emptypb.Empty, error) {
-	categoryID, err := uuid.Parse(req.CategoryId)
+	categoryID, err := validation.ParseUUID("category_id", req.CategoryId)
 	if err != nil {
 		return nil, err
 	}
@@ -372,7 +425,7 @@ func (s *CmsService) DeleteCategory(ctx context.Context, req *v1.DeleteCategoryR
 }
 
 func (s *CmsService) GetCategory(ctx context.Context, req *v1.GetCategoryRequest) (*v1.GetCategoryResponse, error) {
-	categoryID, err := uuid.Parse(req.CategoryId)
+	categoryID, err := validation.ParseUUID("category_id", req.CategoryId)
 	if err != nil {
 		return nil, err
 	}
@@ -395,7 +448,7 @@ func (s *CmsService) ListCategories(ctx context.Context, req *v1.ListCategoriesR
 		if bizType, exists := convert.ProtoToBizCategoryType[req.Type]; exists {
 			filter.Type = &bizType
 		} else {
-			return nil, fmt.Errorf("invalid category type: %v", req.Type)
+			return nil, validation.InvalidEnum("type", req.Type)
 		}
 	}
 
@@ -426,6 +479,68 @@ func (s *CmsService) ListCategories(ctx context.Context, req *v1.ListCategoriesR
 	}, nil
 }
 
+func (s *CmsService) GetCategoryTree(ctx context.Context, req *v1.GetCategoryTreeRequest) (*v1.GetCategoryTreeResponse, error) {
+	var rootID *uuid.UUID
+	if req.RootId != "" {
+		id, err := validation.ParseUUID("root_id", req.RootId)
+		if err != nil {
+			return nil, err
+		}
+		rootID = &id
+	}
+
+	nodes, err := s.uc.GetCategoryTree(ctx, rootID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetCategoryTreeResponse{
+		Categories: convert.ConvertCategoryNodes(nodes),
+	}, nil
+}
+
+func (s *CmsService) MoveCategory(ctx context.Context, req *v1.MoveCategoryRequest) (*v1.MoveCategoryResponse, error) {
+	categoryID, err := validation.ParseUUID("category_id", req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentID *uuid.UUID
+	if req.ParentId != "" {
+		id, err := validation.ParseUUID("parent_id", req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		parentID = &id
+	}
+
+	category, err := s.uc.MoveCategory(ctx, categoryID, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MoveCategoryResponse{
+		Category: convert.ConvertCategory(category),
+	}, nil
+}
+
+func (s *CmsService) BatchGetCategories(ctx context.Context, req *v1.BatchGetCategoriesRequest) (*v1.BatchGetCategoriesResponse, error) {
+	categoryIDs, err := validation.ParseUUIDs("category_ids", req.CategoryIds)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.uc.BatchGetCategories(ctx, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.BatchGetCategoriesResponse{
+		Categories:  convert.ConvertCategories(batch.Found),
+		NotFoundIds: convert.ConvertIDs(batch.NotFound),
+	}, nil
+}
+
 // Episode operations
 
 func (s *CmsService) CreateEpisode(ctx context.Context, req *v1.CreateEpisodeRequest) (*v1.CreateEpisodeResponse, error) {
@@ -434,7 +549,7 @@ func (s *CmsService) CreateEpisode(ctx context.Context, req *v1.CreateEpisodeReq
 		return nil, err
 	}
 
-	programID, err := uuid.Parse(req.ProgramId)
+	programID, err := validation.ParseUUID("program_id", req.ProgramId)
 	if err != nil {
 		return nil, err
 	}
@@ -454,6 +569,9 @@ func (s *CmsService) CreateEpisode(ctx context.Context, req *v1.CreateEpisodeReq
 		UpdatedBy:     userID,
 		Status:        biz.EpisodeStatusDraft,
 	}
+	if availability := convert.ProtoAvailabilityToBiz(req.Availability); availability != nil {
+		episode.Availability = *availability
+	}
 
 	err = s.uc.CreateEpisode(ctx, episode)
 	if err != nil {
@@ -472,7 +590,7 @@ func (s *CmsService) CreateEpisode(ctx context.Context, req *v1.CreateEpisodeReq
 }
 
 func (s *CmsService) UpdateEpisode(ctx context.Context, req *v1.UpdateEpisodeRequest) (*v1.UpdateEpisodeResponse, error) {
-	episodeID, err := uuid.Parse(req.EpisodeId)
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
 	if err != nil {
 		return nil, err
 	}
@@ -499,7 +617,7 @@ func (s *CmsService) UpdateEpisode(ctx context.Context, req *v1.UpdateEpisodeReq
 		if bizStatus, exists := convert.ProtoToBizEpisodeStatus[*req.Status]; exists {
 			updates.Status = &bizStatus
 		} else {
-			return nil, fmt.Errorf("invalid episode status: %v", *req.Status)
+			return nil, validation.InvalidEnum("status", *req.Status)
 		}
 	}
 	if req.MediaUrl != nil {
@@ -519,6 +637,7 @@ func (s *CmsService) UpdateEpisode(ctx context.Context, req *v1.UpdateEpisodeReq
 		scheduledAt := req.ScheduledAt.AsTime()
 		updates.ScheduledAt = &scheduledAt
 	}
+	updates.Availability = convert.ProtoAvailabilityToBiz(req.Availability)
 
 	episode, err := s.uc.UpdateEpisode(ctx, episodeID, updates)
 	if err != nil {
@@ -530,57 +649,108 @@ func (s *CmsService) UpdateEpisode(ctx context.Context, req *v1.UpdateEpisodeReq
 	}, nil
 }
 
-func (s *CmsService) DeleteEpisode(ctx context.Context, req *v1.DeleteEpisodeRequest) (*emptypb.Empty, error) {
-	episodeID, err := uuid.Parse(req.EpisodeId)
+func (s *CmsService) RescheduleEpisode(ctx context.Context, req *v1.RescheduleEpisodeRequest) (*v1.RescheduleEpisodeResponse, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.uc.DeleteEpisode(ctx, episodeID)
+	episode, err := s.uc.RescheduleEpisode(ctx, episodeID, req.ScheduledAt.AsTime())
 	if err != nil {
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	return &v1.RescheduleEpisodeResponse{
+		Episode: convert.ConvertEpisode(episode),
+	}, nil
 }
 
-func (s *CmsService) GetEpisode(ctx context.Context, req *v1.GetEpisodeRequest) (*v1.GetEpisodeResponse, error) {
-	episodeID, err := uuid.Parse(req.EpisodeId)
+func (s *CmsService) CancelEpisodeSchedule(ctx context.Context, req *v1.CancelEpisodeScheduleRequest) (*v1.CancelEpisodeScheduleResponse, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
 	if err != nil {
 		return nil, err
 	}
 
-	episode, err := s.uc.GetEpisode(ctx, episodeID)
+	episode, err := s.uc.CancelEpisodeSchedule(ctx, episodeID)
 	if err != nil {
 		return nil, err
 	}
 
-	return &v1.GetEpisodeResponse{
+	return &v1.CancelEpisodeScheduleResponse{
 		Episode: convert.ConvertEpisode(episode),
 	}, nil
 }
 
-func (s *CmsService) ListEpisodes(ctx context.Context, req *v1.ListEpisodesRequest) (*v1.ListEpisodesResponse, error) {
-	programID, err := uuid.Parse(req.ProgramId)
+func (s *CmsService) SubmitForReview(ctx context.Context, req *v1.SubmitForReviewRequest) (*v1.ReviewResponse, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
 	if err != nil {
 		return nil, err
 	}
 
-	filter := biz.EpisodeFilter{
-		ProgramID: &programID,
+	result, err := s.uc.SubmitForReview(ctx, convert.ProtoToBizContentType[req.ContentType], contentID, req.Comment)
+	if err != nil {
+		return nil, err
 	}
 
-	// Only apply status filter if explicitly provided in the request
-	if req.Status != 0 {
-		if bizStatus, exists := convert.ProtoToBizEpisodeStatus[req.Status]; exists {
-			filter.Status = &bizStatus
-		} else {
-			return nil, fmt.Errorf("invalid episode status: %v", req.Status)
-		}
+	return convertReviewResult(result), nil
+}
+
+func (s *CmsService) Approve(ctx context.Context, req *v1.ApproveRequest) (*v1.ReviewResponse, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
 	}
 
-	if req.SearchQuery != "" {
-		filter.SearchQuery = &req.SearchQuery
+	result, err := s.uc.ApproveContent(ctx, convert.ProtoToBizContentType[req.ContentType], contentID, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertReviewResult(result), nil
+}
+
+func (s *CmsService) Reject(ctx context.Context, req *v1.RejectRequest) (*v1.ReviewResponse, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.uc.RejectContent(ctx, convert.ProtoToBizContentType[req.ContentType], contentID, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertReviewResult(result), nil
+}
+
+func (s *CmsService) ListStatusTransitions(ctx context.Context, req *v1.ListStatusTransitionsRequest) (*v1.ListStatusTransitionsResponse, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.uc.ListStatusTransitions(ctx, convert.ProtoToBizContentType[req.ContentType], contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListStatusTransitionsResponse{
+		Transitions: convert.ConvertStatusTransitions(transitions),
+	}, nil
+}
+
+func convertReviewResult(result *biz.WorkflowResult) *v1.ReviewResponse {
+	return &v1.ReviewResponse{
+		Program:    convert.ConvertProgram(result.Program),
+		Episode:    convert.ConvertEpisode(result.Episode),
+		Transition: convert.ConvertStatusTransition(result.Transition),
+	}
+}
+
+func (s *CmsService) ListRevisions(ctx context.Context, req *v1.ListRevisionsRequest) (*v1.ListRevisionsResponse, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
 	}
 
 	pagination := biz.PaginationRequest{
@@ -588,76 +758,1147 @@ func (s *CmsService) ListEpisodes(ctx context.Context, req *v1.ListEpisodesReque
 		PageSize: req.PageSize,
 	}
 
-	sort := biz.SortRequest{
-		SortBy:    req.SortBy,
-		SortOrder: req.SortOrder,
+	revisions, paginationResp, err := s.uc.ListRevisions(ctx, convert.ProtoToBizContentType[req.ContentType], contentID, pagination)
+	if err != nil {
+		return nil, err
 	}
 
-	episodes, paginationResp, err := s.uc.ListEpisodes(ctx, filter, pagination, sort)
+	return &v1.ListRevisionsResponse{
+		Revisions:  convert.ConvertRevisions(revisions),
+		TotalCount: paginationResp.TotalCount,
+		Page:       paginationResp.Page,
+		PageSize:   paginationResp.PageSize,
+	}, nil
+}
+
+func (s *CmsService) GetRevision(ctx context.Context, req *v1.GetRevisionRequest) (*v1.GetRevisionResponse, error) {
+	revisionID, err := validation.ParseUUID("revision_id", req.RevisionId)
 	if err != nil {
 		return nil, err
 	}
 
-	return &v1.ListEpisodesResponse{
-		Episodes:   convert.ConvertEpisodes(episodes),
+	revision, err := s.uc.GetRevision(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetRevisionResponse{
+		Revision: convert.ConvertRevision(revision),
+	}, nil
+}
+
+func (s *CmsService) DiffRevisions(ctx context.Context, req *v1.DiffRevisionsRequest) (*v1.DiffRevisionsResponse, error) {
+	fromID, err := validation.ParseUUID("from_revision_id", req.FromRevisionId)
+	if err != nil {
+		return nil, err
+	}
+	toID, err := validation.ParseUUID("to_revision_id", req.ToRevisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, changes, err := s.uc.DiffRevisions(ctx, fromID, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DiffRevisionsResponse{
+		FromVersion: from.Version,
+		ToVersion:   to.Version,
+		Changes:     convert.ConvertFieldChanges(changes),
+	}, nil
+}
+
+func (s *CmsService) RestoreRevision(ctx context.Context, req *v1.RestoreRevisionRequest) (*v1.RestoreRevisionResponse, error) {
+	revisionID, err := validation.ParseUUID("revision_id", req.RevisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	restore, err := s.uc.RestoreRevision(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RestoreRevisionResponse{
+		Program:  convert.ConvertProgram(restore.Program),
+		Episode:  convert.ConvertEpisode(restore.Episode),
+		Revision: convert.ConvertRevision(restore.Revision),
+	}, nil
+}
+
+func (s *CmsService) ListTrash(ctx context.Context, req *v1.ListTrashRequest) (*v1.ListTrashResponse, error) {
+	var contentType *biz.ContentType
+	if t, ok := convert.ProtoToBizContentType[req.ContentType]; ok {
+		contentType = &t
+	}
+
+	pagination := biz.PaginationRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	items, paginationResp, err := s.uc.ListTrash(ctx, contentType, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListTrashResponse{
+		Items:      convert.ConvertTrashItems(items),
 		TotalCount: paginationResp.TotalCount,
 		Page:       paginationResp.Page,
 		PageSize:   paginationResp.PageSize,
 	}, nil
 }
 
-// Import operations
+func (s *CmsService) RestoreFromTrash(ctx context.Context, req *v1.RestoreFromTrashRequest) (*v1.RestoreFromTrashResponse, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
+	}
 
-func (s *CmsService) ImportData(ctx context.Context, req *v1.ImportDataRequest) (*v1.ImportDataResponse, error) {
-	userID, err := utils.GetUserID(ctx)
+	restore, err := s.uc.RestoreFromTrash(ctx, convert.ProtoToBizContentType[req.ContentType], contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RestoreFromTrashResponse{
+		Category: convert.ConvertCategory(restore.Category),
+		Program:  convert.ConvertProgram(restore.Program),
+		Episode:  convert.ConvertEpisode(restore.Episode),
+	}, nil
+}
+
+func (s *CmsService) PurgeTrash(ctx context.Context, req *v1.PurgeTrashRequest) (*v1.PurgeTrashResponse, error) {
+	var contentType *biz.ContentType
+	if t, ok := convert.ProtoToBizContentType[req.ContentType]; ok {
+		contentType = &t
+	}
+
+	var contentID *uuid.UUID
+	if req.ContentId != nil {
+		id, err := validation.ParseUUID("content_id", *req.ContentId)
+		if err != nil {
+			return nil, err
+		}
+		contentID = &id
+	}
+
+	result, err := s.uc.PurgeTrash(ctx, contentType, contentID)
 	if err != nil {
 		return nil, err
 	}
 
-	categoryID, err := uuid.Parse(req.DefaultCategoryId)
+	return &v1.PurgeTrashResponse{
+		CategoriesPurged: result.Categories,
+		ProgramsPurged:   result.Programs,
+		EpisodesPurged:   result.Episodes,
+	}, nil
+}
+
+func (s *CmsService) ListAuditEvents(ctx context.Context, req *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error) {
+	filter, err := auditEventFilter(req)
 	if err != nil {
 		return nil, err
 	}
 
-	importData := &biz.ImportData{
-		SourceType:   req.SourceType,
-		SourceURL:    req.SourceUrl,
-		SourceConfig: convert.ConvertMetadata(req.SourceConfig),
-		CategoryID:   categoryID,
-		FieldMapping: convert.ConvertMetadata(req.FieldMapping),
-		CreatedBy:    userID,
+	pagination := biz.PaginationRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
 	}
 
-	result, err := s.uc.ImportData(ctx, importData)
+	events, paginationResp, err := s.uc.ListAuditEvents(ctx, filter, pagination)
 	if err != nil {
 		return nil, err
 	}
 
-	return &v1.ImportDataResponse{
-		ImportId:       result.ID.String(),
-		Status:         convert.BizImportStatusToProto(result.Status),
-		TotalItems:     result.TotalItems,
-		ProcessedItems: result.ProcessedItems,
-		SuccessCount:   result.SuccessCount,
-		ErrorCount:     result.ErrorCount,
-		Warnings:       result.Warnings,
+	return &v1.ListAuditEventsResponse{
+		Events:     convert.ConvertAuditEvents(events),
+		TotalCount: paginationResp.TotalCount,
+		Page:       paginationResp.Page,
+		PageSize:   paginationResp.PageSize,
 	}, nil
 }
 
-func (s *AuthService) UpdateAvatar(ctx khttp.Context, userId uuid.UUID) (*v1.EpisodeFileUpdateResponse, error) {
-	file, header, err := ctx.Request().FormFile("file")
+// ExportAuditEvents calls send with every audit event req matches, ignoring its page.
+// It backs the NDJSON export, which is served outside the generated handlers.
+func (s *CmsService) ExportAuditEvents(ctx context.Context, req *v1.ListAuditEventsRequest, send func(*v1.AuditEvent) error) error {
+	filter, err := auditEventFilter(req)
+	if err != nil {
+		return err
+	}
+
+	return s.uc.ExportAuditEvents(ctx, filter, func(event *biz.AuditEvent) error {
+		return send(convert.ConvertAuditEvent(event))
+	})
+}
+
+func auditEventFilter(req *v1.ListAuditEventsRequest) (biz.AuditEventFilter, error) {
+	var filter biz.AuditEventFilter
+	if req.ActorId != "" {
+		actorID, err := validation.ParseUUID("actor_id", req.ActorId)
+		if err != nil {
+			return filter, err
+		}
+		filter.ActorID = &actorID
+	}
+	if req.EntityId != "" {
+		entityID, err := validation.ParseUUID("entity_id", req.EntityId)
+		if err != nil {
+			return filter, err
+		}
+		filter.EntityID = &entityID
+	}
+	if req.PrincipalType != "" {
+		principalType := biz.PrincipalType(req.PrincipalType)
+		filter.PrincipalType = &principalType
+	}
+	if req.Operation != "" {
+		filter.Operation = &req.Operation
+	}
+	if req.EntityType != "" {
+		entityType := biz.EntityType(req.EntityType)
+		filter.EntityType = &entityType
+	}
+	if req.Outcome != "" {
+		outcome := biz.AuditOutcome(req.Outcome)
+		filter.Outcome = &outcome
+	}
+	if req.RequestId != "" {
+		filter.RequestID = &req.RequestId
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+
+	return filter, nil
+}
+
+func (s *CmsService) DeleteEpisode(ctx context.Context, req *v1.DeleteEpisodeRequest) (*emptypb.Empty, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.uc.DeleteEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CmsService) GetEpisode(ctx context.Context, req *v1.GetEpisodeRequest) (*v1.GetEpisodeResponse, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	episode, err := s.uc.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetEpisodeResponse{
+		Episode: convert.ConvertEpisode(episode),
+	}, nil
+}
+
+func (s *CmsService) ListEpisodes(ctx context.Context, req *v1.ListEpisodesRequest) (*v1.ListEpisodesResponse, error) {
+	programID, err := validation.ParseUUID("program_id", req.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := biz.EpisodeFilter{
+		ProgramID: &programID,
+	}
+
+	// Only apply status filter if explicitly provided in the request
+	if req.Status != 0 {
+		if bizStatus, exists := convert.ProtoToBizEpisodeStatus[req.Status]; exists {
+			filter.Status = &bizStatus
+		} else {
+			return nil, validation.InvalidEnum("status", req.Status)
+		}
+	}
+
+	if req.SearchQuery != "" {
+		filter.SearchQuery = &req.SearchQuery
+	}
+
+	if req.SeasonId != "" {
+		seasonID, err := validation.ParseUUID("season_id", req.SeasonId)
+		if err != nil {
+			return nil, err
+		}
+		filter.SeasonID = &seasonID
+	}
+	if req.SeasonNumber != 0 {
+		filter.SeasonNumber = &req.SeasonNumber
+	}
+
+	pagination := biz.PaginationRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	sort := biz.SortRequest{
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+	}
+
+	episodes, paginationResp, err := s.uc.ListEpisodes(ctx, filter, pagination, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListEpisodesResponse{
+		Episodes:   convert.ConvertEpisodes(episodes),
+		TotalCount: paginationResp.TotalCount,
+		Page:       paginationResp.Page,
+		PageSize:   paginationResp.PageSize,
+	}, nil
+}
+
+func (s *CmsService) BatchGetEpisodes(ctx context.Context, req *v1.BatchGetEpisodesRequest) (*v1.BatchGetEpisodesResponse, error) {
+	episodeIDs, err := validation.ParseUUIDs("episode_ids", req.EpisodeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.uc.BatchGetEpisodes(ctx, episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.BatchGetEpisodesResponse{
+		Episodes:    convert.ConvertEpisodes(batch.Found),
+		NotFoundIds: convert.ConvertIDs(batch.NotFound),
+	}, nil
+}
+
+// Season operations
+
+func (s *CmsService) CreateSeason(ctx context.Context, req *v1.CreateSeasonRequest) (*v1.CreateSeasonResponse, error) {
+	programID, err := validation.ParseUUID("program_id", req.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+
+	season := &biz.Season{
+		ProgramID:    programID,
+		SeasonNumber: req.SeasonNumber,
+		Title:        req.Title,
+		Description:  req.Description,
+		ThumbnailURL: req.ThumbnailUrl,
+		SortOrder:    req.SortOrder,
+	}
+	if req.ReleaseStartAt != nil {
+		start := req.ReleaseStartAt.AsTime()
+		season.ReleaseStartAt = &start
+	}
+	if req.ReleaseEndAt != nil {
+		end := req.ReleaseEndAt.AsTime()
+		season.ReleaseEndAt = &end
+	}
+
+	if err := s.uc.CreateSeason(ctx, season); err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateSeasonResponse{
+		Season: convert.ConvertSeason(season),
+	}, nil
+}
+
+func (s *CmsService) ListSeasons(ctx context.Context, req *v1.ListSeasonsRequest) (*v1.ListSeasonsResponse, error) {
+	programID, err := validation.ParseUUID("program_id", req.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons, err := s.uc.ListSeasons(ctx, programID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListSeasonsResponse{
+		Seasons: convert.ConvertSeasons(seasons),
+	}, nil
+}
+
+func (s *CmsService) GetSeason(ctx context.Context, req *v1.GetSeasonRequest) (*v1.GetSeasonResponse, error) {
+	seasonID, err := validation.ParseUUID("season_id", req.SeasonId)
+	if err != nil {
+		return nil, err
+	}
+
+	season, err := s.uc.GetSeason(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetSeasonResponse{
+		Season: convert.ConvertSeason(season),
+	}, nil
+}
+
+func (s *CmsService) UpdateSeason(ctx context.Context, req *v1.UpdateSeasonRequest) (*v1.UpdateSeasonResponse, error) {
+	seasonID, err := validation.ParseUUID("season_id", req.SeasonId)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := &biz.UpdateSeasonRequest{
+		SeasonNumber: req.SeasonNumber,
+		Title:        req.Title,
+		Description:  req.Description,
+		ThumbnailURL: req.ThumbnailUrl,
+		SortOrder:    req.SortOrder,
+	}
+	if req.ReleaseStartAt != nil {
+		start := req.ReleaseStartAt.AsTime()
+		updates.ReleaseStartAt = &start
+	}
+	if req.ReleaseEndAt != nil {
+		end := req.ReleaseEndAt.AsTime()
+		updates.ReleaseEndAt = &end
+	}
+
+	season, err := s.uc.UpdateSeason(ctx, seasonID, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateSeasonResponse{
+		Season: convert.ConvertSeason(season),
+	}, nil
+}
+
+func (s *CmsService) DeleteSeason(ctx context.Context, req *v1.DeleteSeasonRequest) (*emptypb.Empty, error) {
+	seasonID, err := validation.ParseUUID("season_id", req.SeasonId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.DeleteSeason(ctx, seasonID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CmsService) ReorderEpisodes(ctx context.Context, req *v1.ReorderEpisodesRequest) (*v1.ReorderEpisodesResponse, error) {
+	seasonID, err := validation.ParseUUID("season_id", req.SeasonId)
+	if err != nil {
+		return nil, err
+	}
+
+	episodeIDs, err := validation.ParseUUIDs("episode_ids", req.EpisodeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	episodes, err := s.uc.ReorderEpisodes(ctx, seasonID, episodeIDs, req.FirstNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ReorderEpisodesResponse{
+		Episodes: convert.ConvertEpisodes(episodes),
+	}, nil
+}
+
+func (s *CmsService) ListSeasonEpisodes(ctx context.Context, req *v1.ListSeasonEpisodesRequest) (*v1.ListSeasonEpisodesResponse, error) {
+	seasonID, err := validation.ParseUUID("season_id", req.SeasonId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := biz.EpisodeFilter{}
+	if req.Status != 0 {
+		if bizStatus, exists := convert.ProtoToBizEpisodeStatus[req.Status]; exists {
+			filter.Status = &bizStatus
+		} else {
+			return nil, validation.InvalidEnum("status", req.Status)
+		}
+	}
+
+	pagination := biz.PaginationRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	sort := biz.SortRequest{
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+	}
+
+	episodes, paginationResp, err := s.uc.ListSeasonEpisodes(ctx, seasonID, filter, pagination, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListSeasonEpisodesResponse{
+		Episodes:   convert.ConvertEpisodes(episodes),
+		TotalCount: paginationResp.TotalCount,
+		Page:       paginationResp.Page,
+		PageSize:   paginationResp.PageSize,
+	}, nil
+}
+
+// Chapter operations
+
+func (s *CmsService) ListChapters(ctx context.Context, req *v1.ListChaptersRequest) (*v1.ListChaptersResponse, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	chapters, err := s.uc.ListChapters(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListChaptersResponse{
+		Chapters: convert.ConvertChapters(chapters),
+	}, nil
+}
+
+func (s *CmsService) CreateChapter(ctx context.Context, req *v1.CreateChapterRequest) (*v1.CreateChapterResponse, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	chapter := chapterFromInput(episodeID, req.Chapter)
+	if err := s.uc.CreateChapter(ctx, chapter); err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateChapterResponse{
+		Chapter: convert.ConvertChapter(chapter),
+	}, nil
+}
+
+func (s *CmsService) UpdateChapter(ctx context.Context, req *v1.UpdateChapterRequest) (*v1.UpdateChapterResponse, error) {
+	chapterID, err := validation.ParseUUID("chapter_id", req.ChapterId)
+	if err != nil {
+		return nil, err
+	}
+
+	chapter, err := s.uc.UpdateChapter(ctx, chapterID, &biz.UpdateChapterRequest{
+		StartSeconds: req.StartSeconds,
+		EndSeconds:   req.EndSeconds,
+		Title:        req.Title,
+		ImageURL:     req.ImageUrl,
+		URL:          req.Url,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateChapterResponse{
+		Chapter: convert.ConvertChapter(chapter),
+	}, nil
+}
+
+func (s *CmsService) DeleteChapter(ctx context.Context, req *v1.DeleteChapterRequest) (*emptypb.Empty, error) {
+	chapterID, err := validation.ParseUUID("chapter_id", req.ChapterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.DeleteChapter(ctx, chapterID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CmsService) ReplaceChapters(ctx context.Context, req *v1.ReplaceChaptersRequest) (*v1.ReplaceChaptersResponse, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	chapters := make([]*biz.Chapter, 0, len(req.Chapters))
+	for _, input := range req.Chapters {
+		chapters = append(chapters, chapterFromInput(episodeID, input))
+	}
+
+	chapters, err = s.uc.ReplaceChapters(ctx, episodeID, chapters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ReplaceChaptersResponse{
+		Chapters: convert.ConvertChapters(chapters),
+	}, nil
+}
+
+func (s *CmsService) ImportChapters(ctx context.Context, req *v1.ImportChaptersRequest) (*v1.ImportChaptersResponse, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	chapters, err := s.uc.ImportChapters(ctx, episodeID, []byte(req.ChaptersJson))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ImportChaptersResponse{
+		Chapters: convert.ConvertChapters(chapters),
+	}, nil
+}
+
+// ExportChapters returns the chapters of an episode as a Podcasting 2.0 JSON chapters
+// document. It backs a plain HTTP route, since the document is not a protobuf message.
+func (s *CmsService) ExportChapters(ctx context.Context, episodeID string) ([]byte, error) {
+	id, err := validation.ParseUUID("episode_id", episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.uc.ExportChapters(ctx, id)
+}
+
+func chapterFromInput(episodeID uuid.UUID, input *v1.ChapterInput) *biz.Chapter {
+	return &biz.Chapter{
+		EpisodeID:    episodeID,
+		StartSeconds: input.StartSeconds,
+		EndSeconds:   input.EndSeconds,
+		Title:        input.Title,
+		ImageURL:     input.ImageUrl,
+		URL:          input.Url,
+	}
+}
+
+// Transcript operations
+
+func (s *CmsService) UploadTranscript(ctx context.Context, req *v1.UploadTranscriptRequest) (*v1.UploadTranscriptResponse, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	transcript, err := s.uc.UploadTranscript(ctx, episodeID, req.Locale, convert.ProtoToBizTranscriptFormat[req.Format], []byte(req.Content))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UploadTranscriptResponse{
+		Transcript: convert.ConvertTranscript(transcript),
+	}, nil
+}
+
+func (s *CmsService) ListTranscripts(ctx context.Context, req *v1.ListTranscriptsRequest) (*v1.ListTranscriptsResponse, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	transcripts, err := s.uc.ListTranscripts(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListTranscriptsResponse{
+		Transcripts: convert.ConvertTranscripts(transcripts),
+	}, nil
+}
+
+func (s *CmsService) DeleteTranscript(ctx context.Context, req *v1.DeleteTranscriptRequest) (*emptypb.Empty, error) {
+	episodeID, err := validation.ParseUUID("episode_id", req.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.DeleteTranscript(ctx, episodeID, req.Locale); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RenderTranscript returns the file of an episode transcript, converted to format
+// (vtt, srt, txt or json) unless it is empty. It backs plain HTTP routes, since the
+// file is not a protobuf message. Public routes only serve what the caller may listen to.
+func (s *CmsService) RenderTranscript(ctx context.Context, episodeID, locale, format string, public bool) (*biz.RenderedTranscript, error) {
+	id, err := validation.ParseUUID("episode_id", episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var listener *biz.Listener
+	if public {
+		listener = &biz.Listener{Country: geo.Country(ctx)}
+	}
+	return s.uc.RenderTranscript(ctx, id, locale, biz.TranscriptFormat(format), listener)
+}
+
+// Person operations
+
+func (s *CmsService) CreatePerson(ctx context.Context, req *v1.CreatePersonRequest) (*v1.CreatePersonResponse, error) {
+	person := &biz.Person{
+		Name:     req.Name,
+		Bio:      req.Bio,
+		PhotoURL: req.PhotoUrl,
+		Links:    convert.ConvertPersonLinks(req.Links),
+	}
+	if err := s.uc.CreatePerson(ctx, person); err != nil {
+		return nil, err
+	}
+
+	return &v1.CreatePersonResponse{
+		Person: convert.ConvertPerson(person),
+	}, nil
+}
+
+func (s *CmsService) GetPerson(ctx context.Context, req *v1.GetPersonRequest) (*v1.GetPersonResponse, error) {
+	personID, err := validation.ParseUUID("person_id", req.PersonId)
+	if err != nil {
+		return nil, err
+	}
+
+	person, err := s.uc.GetPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetPersonResponse{
+		Person: convert.ConvertPerson(person),
+	}, nil
+}
+
+func (s *CmsService) ListPeople(ctx context.Context, req *v1.ListPeopleRequest) (*v1.ListPeopleResponse, error) {
+	filter := biz.PersonFilter{}
+	if req.SearchQuery != "" {
+		filter.SearchQuery = &req.SearchQuery
+	}
+
+	pagination := biz.PaginationRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	people, paginationResp, err := s.uc.ListPeople(ctx, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListPeopleResponse{
+		People:     convert.ConvertPeople(people),
+		TotalCount: paginationResp.TotalCount,
+		Page:       paginationResp.Page,
+		PageSize:   paginationResp.PageSize,
+	}, nil
+}
+
+func (s *CmsService) UpdatePerson(ctx context.Context, req *v1.UpdatePersonRequest) (*v1.UpdatePersonResponse, error) {
+	personID, err := validation.ParseUUID("person_id", req.PersonId)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := &biz.UpdatePersonRequest{
+		Name:     req.Name,
+		Bio:      req.Bio,
+		PhotoURL: req.PhotoUrl,
+	}
+	if req.Links != nil {
+		links := convert.ConvertPersonLinks(req.Links.Links)
+		updates.Links = &links
+	}
+
+	person, err := s.uc.UpdatePerson(ctx, personID, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdatePersonResponse{
+		Person: convert.ConvertPerson(person),
+	}, nil
+}
+
+func (s *CmsService) DeletePerson(ctx context.Context, req *v1.DeletePersonRequest) (*emptypb.Empty, error) {
+	personID, err := validation.ParseUUID("person_id", req.PersonId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.DeletePerson(ctx, personID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Credit operations
+
+func (s *CmsService) CreateCredit(ctx context.Context, req *v1.CreateCreditRequest) (*v1.CreateCreditResponse, error) {
+	personID, err := validation.ParseUUID("person_id", req.PersonId)
+	if err != nil {
+		return nil, err
+	}
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
+	}
+
+	credit := &biz.Credit{
+		PersonID:    personID,
+		ContentType: convert.ProtoToBizContentType[req.ContentType],
+		ContentID:   contentID,
+		Role:        convert.ProtoToBizCreditRole[req.Role],
+		SortOrder:   req.SortOrder,
+	}
+	if err := s.uc.CreateCredit(ctx, credit); err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateCreditResponse{
+		Credit: convert.ConvertCredit(credit),
+	}, nil
+}
+
+func (s *CmsService) ListCredits(ctx context.Context, req *v1.ListCreditsRequest) (*v1.ListCreditsResponse, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
+	}
+
+	credits, err := s.uc.ListCredits(ctx, convert.ProtoToBizContentType[req.ContentType], contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListCreditsResponse{
+		Credits: convert.ConvertCredits(credits),
+	}, nil
+}
+
+func (s *CmsService) UpdateCredit(ctx context.Context, req *v1.UpdateCreditRequest) (*v1.UpdateCreditResponse, error) {
+	creditID, err := validation.ParseUUID("credit_id", req.CreditId)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := &biz.UpdateCreditRequest{
+		SortOrder: req.SortOrder,
+	}
+	if req.Role != nil {
+		role := convert.ProtoToBizCreditRole[*req.Role]
+		updates.Role = &role
+	}
+
+	credit, err := s.uc.UpdateCredit(ctx, creditID, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateCreditResponse{
+		Credit: convert.ConvertCredit(credit),
+	}, nil
+}
+
+func (s *CmsService) DeleteCredit(ctx context.Context, req *v1.DeleteCreditRequest) (*emptypb.Empty, error) {
+	creditID, err := validation.ParseUUID("credit_id", req.CreditId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.DeleteCredit(ctx, creditID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CmsService) ListCreditsByPerson(ctx context.Context, req *v1.ListCreditsByPersonRequest) (*v1.ListCreditsByPersonResponse, error) {
+	personID, err := validation.ParseUUID("person_id", req.PersonId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := biz.PaginationRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	credits, paginationResp, err := s.uc.ListCreditsByPerson(ctx, personID, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListCreditsByPersonResponse{
+		Credits:    convert.ConvertCredits(credits),
+		TotalCount: paginationResp.TotalCount,
+		Page:       paginationResp.Page,
+		PageSize:   paginationResp.PageSize,
+	}, nil
+}
+
+// Availability operations
+
+func (s *CmsService) CheckAvailability(ctx context.Context, req *v1.CheckAvailabilityRequest) (*v1.CheckAvailabilityResponse, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := s.uc.CheckAvailability(ctx, convert.ProtoToBizContentType[req.ContentType], contentID, req.Country)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CheckAvailabilityResponse{
+		Available:      check.Available(),
+		ContentType:    req.ContentType,
+		ContentId:      check.ContentID.String(),
+		Country:        check.Country,
+		CheckedAt:      timestamppb.New(check.At),
+		Reasons:        convert.ConvertUnavailableReasons(check.Reasons),
+		ProgramReasons: convert.ConvertUnavailableReasons(check.ProgramReasons),
+	}, nil
+}
+
+// Tag operations
+
+func (s *CmsService) ListTags(ctx context.Context, req *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	filter := biz.TagFilter{}
+	if req.SearchQuery != "" {
+		filter.SearchQuery = &req.SearchQuery
+	}
+
+	pagination := biz.PaginationRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	sort := biz.SortRequest{
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+	}
+
+	tags, paginationResp, err := s.uc.ListTags(ctx, filter, pagination, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListTagsResponse{
+		Tags:       convert.ConvertTags(tags),
+		TotalCount: paginationResp.TotalCount,
+		Page:       paginationResp.Page,
+		PageSize:   paginationResp.PageSize,
+	}, nil
+}
+
+func (s *CmsService) AutocompleteTags(ctx context.Context, req *v1.AutocompleteTagsRequest) (*v1.AutocompleteTagsResponse, error) {
+	tags, err := s.uc.AutocompleteTags(ctx, req.Prefix, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.AutocompleteTagsResponse{
+		Tags: convert.ConvertTags(tags),
+	}, nil
+}
+
+func (s *CmsService) UpdateTag(ctx context.Context, req *v1.UpdateTagRequest) (*v1.UpdateTagResponse, error) {
+	tagID, err := validation.ParseUUID("tag_id", req.TagId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The whole taxonomy entry is replaced, so missing fields clear it
+	translations := req.Translations
+	if translations == nil {
+		translations = map[string]string{}
+	}
+	aliases := req.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	tag, err := s.uc.UpdateTag(ctx, tagID, &biz.UpdateTagRequest{
+		Translations: &translations,
+		Aliases:      &aliases,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateTagResponse{
+		Tag: convert.ConvertTag(tag),
+	}, nil
+}
+
+func (s *CmsService) RenameTag(ctx context.Context, req *v1.RenameTagRequest) (*v1.RenameTagResponse, error) {
+	tagID, err := validation.ParseUUID("tag_id", req.TagId)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.uc.RenameTag(ctx, tagID, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RenameTagResponse{
+		Tag: convert.ConvertTag(tag),
+	}, nil
+}
+
+func (s *CmsService) MergeTags(ctx context.Context, req *v1.MergeTagsRequest) (*v1.MergeTagsResponse, error) {
+	tagID, err := validation.ParseUUID("tag_id", req.TagId)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceIDs, err := validation.ParseUUIDs("source_ids", req.SourceIds)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.uc.MergeTags(ctx, tagID, sourceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MergeTagsResponse{
+		Tag: convert.ConvertTag(tag),
+	}, nil
+}
+
+// Translation operations
+
+func (s *CmsService) ListTranslations(ctx context.Context, req *v1.ListTranslationsRequest) (*v1.ListTranslationsResponse, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.uc.ListTranslations(ctx, convert.ProtoToBizContentType[req.ContentType], contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListTranslationsResponse{
+		Translations: convert.ConvertTranslations(translations),
+	}, nil
+}
+
+func (s *CmsService) SetTranslation(ctx context.Context, req *v1.SetTranslationRequest) (*v1.SetTranslationResponse, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
+	}
+
+	translation := &biz.Translation{
+		ContentType: convert.ProtoToBizContentType[req.ContentType],
+		ContentID:   contentID,
+		Locale:      req.Locale,
+		Title:       req.Title,
+		Description: req.Description,
+	}
+	if err := s.uc.SetTranslation(ctx, translation); err != nil {
+		return nil, err
+	}
+
+	return &v1.SetTranslationResponse{
+		Translation: convert.ConvertTranslation(translation),
+	}, nil
+}
+
+func (s *CmsService) DeleteTranslation(ctx context.Context, req *v1.DeleteTranslationRequest) (*emptypb.Empty, error) {
+	contentID, err := validation.ParseUUID("content_id", req.ContentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.DeleteTranslation(ctx, convert.ProtoToBizContentType[req.ContentType], contentID, req.Locale); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Import operations
+
+func (s *CmsService) ImportData(ctx context.Context, req *v1.ImportDataRequest) (*v1.ImportDataResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryID, err := validation.ParseUUID("default_category_id", req.DefaultCategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	importData := &biz.ImportData{
+		SourceType:   req.SourceType,
+		SourceURL:    req.SourceUrl,
+		SourceConfig: convert.ConvertMetadata(req.SourceConfig),
+		CategoryID:   categoryID,
+		FieldMapping: convert.ConvertMetadata(req.FieldMapping),
+		CreatedBy:    userID,
+	}
+
+	result, err := s.uc.ImportData(ctx, importData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ImportDataResponse{
+		ImportId:       result.ID.String(),
+		Status:         convert.BizImportStatusToProto(result.Status),
+		TotalItems:     result.TotalItems,
+		ProcessedItems: result.ProcessedItems,
+		SuccessCount:   result.SuccessCount,
+		ErrorCount:     result.ErrorCount,
+		Warnings:       result.Warnings,
+	}, nil
+}
+
+func (s *CmsService) WatchImport(req *v1.WatchImportRequest, stream v1.CmsService_WatchImportServer) error {
+	return s.WatchImportEvents(stream.Context(), req, stream.Send)
+}
+
+// WatchImportEvents backs both WatchImport and the text/event-stream endpoint.
+func (s *CmsService) WatchImportEvents(ctx context.Context, req *v1.WatchImportRequest, send func(*v1.ImportEvent) error) error {
+	importID, err := validation.ParseUUID("import_id", req.ImportId)
+	if err != nil {
+		return err
+	}
+
+	return s.uc.WatchImport(ctx, importID, func(event *biz.ImportEvent) error {
+		return send(convert.ConvertImportEvent(event))
+	})
+}
+
+func (s *AuthService) UpdateAvatar(ctx context.Context, r *http.Request, userId uuid.UUID) (*v1.EpisodeFileUpdateResponse, error) {
+	file, header, err := r.FormFile("file")
 	if err != nil {
 		return nil, err
 	}
 
-	target := ctx.Request().FormValue("target")
-	episodeId, err := uuid.Parse(ctx.Request().FormValue("episode_id"))
+	target := r.FormValue("target")
+	episodeId, err := validation.ParseUUID("episode_id", r.FormValue("episode_id"))
 	if err != nil {
 		return nil, err
 	}
 
 	if !slices.Contains([]string{"thumbnail", "media"}, target) {
-		return nil, errors.Newf(http.StatusBadRequest, "INVALID_TARGET", "INVALID_TARGET")
+		return nil, validation.InvalidEnum("target", target)
 	}
 
 	fileUrl, err := s.uc.UpdateEpisodeFile(ctx, userId, episodeId, &biz.UpdateEpisodeFileRequest{
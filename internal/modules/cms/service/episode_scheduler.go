@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultSchedulerPollInterval = 15 * time.Second
+	defaultSchedulerBatchSize    = 50
+)
+
+// EpisodeScheduler is a background server that publishes scheduled episodes once their
+// scheduled_at has passed. Every replica runs one; the use case keeps them from
+// publishing the same episode twice.
+type EpisodeScheduler struct {
+	uc     *biz.UseCase
+	logger *log.Helper
+
+	enabled      bool
+	pollInterval time.Duration
+	batchSize    int32
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+func NewEpisodeScheduler(c *conf.Jobs, uc *biz.UseCase, logger log.Logger) *EpisodeScheduler {
+	sc := c.GetEpisodeScheduler()
+	s := &EpisodeScheduler{
+		uc:           uc,
+		logger:       log.NewHelper(logger),
+		enabled:      sc.GetEnabled(),
+		pollInterval: defaultSchedulerPollInterval,
+		batchSize:    defaultSchedulerBatchSize,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	if sc.GetPollInterval() != nil {
+		s.pollInterval = sc.GetPollInterval().AsDuration()
+	}
+	if sc.GetBatchSize() > 0 {
+		s.batchSize = sc.GetBatchSize()
+	}
+
+	return s
+}
+
+func (s *EpisodeScheduler) Start(ctx context.Context) error {
+	defer close(s.done)
+
+	if !s.enabled {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.publishDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *EpisodeScheduler) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stop) })
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// publishDue works through the backlog in batches so a burst of episodes due at the
+// same minute does not wait for several polls.
+func (s *EpisodeScheduler) publishDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		published, err := s.uc.PublishDueEpisodes(ctx, s.batchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.logger.Errorf("Failed to publish scheduled episodes: %v", err)
+			}
+			return
+		}
+		if published > 0 {
+			s.logger.Infof("Published %d scheduled episodes", published)
+		}
+		if published < int(s.batchSize) {
+			return
+		}
+	}
+}
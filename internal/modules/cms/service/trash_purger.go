@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultPurgerPollInterval  = time.Hour
+	defaultPurgerBatchSize     = 100
+	defaultPurgerRetentionDays = 30
+)
+
+// TrashPurger is a background server that deletes content for good once it has been in
+// the trash for the retention period, along with its uploaded files. Every replica runs
+// one; purged rows are locked, so replicas split the work instead of repeating it.
+type TrashPurger struct {
+	uc     *biz.UseCase
+	logger *log.Helper
+
+	enabled      bool
+	pollInterval time.Duration
+	batchSize    int32
+	retention    time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+func NewTrashPurger(c *conf.Jobs, uc *biz.UseCase, logger log.Logger) *TrashPurger {
+	pc := c.GetTrashPurger()
+	p := &TrashPurger{
+		uc:           uc,
+		logger:       log.NewHelper(logger),
+		enabled:      pc.GetEnabled(),
+		pollInterval: defaultPurgerPollInterval,
+		batchSize:    defaultPurgerBatchSize,
+		retention:    defaultPurgerRetentionDays * 24 * time.Hour,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	if pc.GetPollInterval() != nil {
+		p.pollInterval = pc.GetPollInterval().AsDuration()
+	}
+	if pc.GetBatchSize() > 0 {
+		p.batchSize = pc.GetBatchSize()
+	}
+	if pc.GetRetentionDays() > 0 {
+		p.retention = time.Duration(pc.GetRetentionDays()) * 24 * time.Hour
+	}
+
+	return p
+}
+
+func (p *TrashPurger) Start(ctx context.Context) error {
+	defer close(p.done)
+
+	if !p.enabled {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-p.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		p.purgeExpired(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrashPurger) Stop(ctx context.Context) error {
+	p.stopOnce.Do(func() { close(p.stop) })
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// purgeExpired works through the expired trash in batches, so a large backlog is cleared
+// in one poll without holding one long transaction.
+func (p *TrashPurger) purgeExpired(ctx context.Context) {
+	before := time.Now().Add(-p.retention)
+	for ctx.Err() == nil {
+		result, err := p.uc.PurgeExpiredTrash(ctx, before, p.batchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				p.logger.Errorf("Failed to purge expired trash: %v", err)
+			}
+			return
+		}
+		if result.Total() > 0 {
+			p.logger.Infof("Purged %d categories, %d programs and %d episodes from the trash",
+				result.Categories, result.Programs, result.Episodes)
+		}
+		if result.Categories < p.batchSize && result.Programs < p.batchSize && result.Episodes < p.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+
+	v1 "thmanyah/api/grpc/v1"
+	"thmanyah/internal/modules/cms/biz"
+	"thmanyah/internal/utils/convert"
+	"thmanyah/internal/validation"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type WebhookService struct {
+	v1.UnimplementedWebhookServiceServer
+
+	uc *biz.UseCase
+}
+
+func NewWebhookService(uc *biz.UseCase) *WebhookService {
+	return &WebhookService{uc: uc}
+}
+
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *v1.CreateWebhookRequest) (*v1.CreateWebhookResponse, error) {
+	subscription := &biz.WebhookSubscription{
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+	}
+
+	err := s.uc.CreateWebhookSubscription(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateWebhookResponse{
+		Webhook: convert.ConvertWebhook(subscription),
+		Secret:  subscription.Secret,
+	}, nil
+}
+
+func (s *WebhookService) UpdateWebhook(ctx context.Context, req *v1.UpdateWebhookRequest) (*v1.UpdateWebhookResponse, error) {
+	webhookID, err := validation.ParseUUID("webhook_id", req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := &biz.UpdateWebhookSubscriptionRequest{
+		URL:    req.Url,
+		Secret: req.Secret,
+		Active: req.Active,
+	}
+	if len(req.EventTypes) > 0 {
+		updates.EventTypes = &req.EventTypes
+	}
+
+	subscription, err := s.uc.UpdateWebhookSubscription(ctx, webhookID, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateWebhookResponse{
+		Webhook: convert.ConvertWebhook(subscription),
+	}, nil
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *v1.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	webhookID, err := validation.ParseUUID("webhook_id", req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.uc.DeleteWebhookSubscription(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *WebhookService) GetWebhook(ctx context.Context, req *v1.GetWebhookRequest) (*v1.GetWebhookResponse, error) {
+	webhookID, err := validation.ParseUUID("webhook_id", req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.uc.GetWebhookSubscription(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetWebhookResponse{
+		Webhook: convert.ConvertWebhook(subscription),
+	}, nil
+}
+
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *v1.ListWebhooksRequest) (*v1.ListWebhooksResponse, error) {
+	pagination := biz.PaginationRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	subscriptions, paginationResp, err := s.uc.ListWebhookSubscriptions(ctx, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListWebhooksResponse{
+		Webhooks:   convert.ConvertWebhooks(subscriptions),
+		TotalCount: paginationResp.TotalCount,
+		Page:       paginationResp.Page,
+		PageSize:   paginationResp.PageSize,
+	}, nil
+}
+
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *v1.ListWebhookDeliveriesRequest) (*v1.ListWebhookDeliveriesResponse, error) {
+	webhookID, err := validation.ParseUUID("webhook_id", req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	var status *biz.WebhookDeliveryStatus
+	if req.Status != nil {
+		bizStatus, exists := convert.ProtoToBizWebhookDeliveryStatus[*req.Status]
+		if !exists {
+			return nil, validation.InvalidEnum("status", *req.Status)
+		}
+		status = &bizStatus
+	}
+
+	pagination := biz.PaginationRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	deliveries, paginationResp, err := s.uc.ListWebhookDeliveries(ctx, webhookID, status, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListWebhookDeliveriesResponse{
+		Deliveries: convert.ConvertWebhookDeliveries(deliveries),
+		TotalCount: paginationResp.TotalCount,
+		Page:       paginationResp.Page,
+		PageSize:   paginationResp.PageSize,
+	}, nil
+}
+
+func (s *WebhookService) RedeliverWebhook(ctx context.Context, req *v1.RedeliverWebhookRequest) (*v1.RedeliverWebhookResponse, error) {
+	deliveryID, err := validation.ParseUUID("delivery_id", req.DeliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.uc.RedeliverWebhook(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RedeliverWebhookResponse{
+		Delivery: convert.ConvertWebhookDelivery(delivery),
+	}, nil
+}
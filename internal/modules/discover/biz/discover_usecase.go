@@ -3,16 +3,27 @@ package biz
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	cms "thmanyah/internal/modules/cms/biz"
 )
 
 type DiscoverUsecase struct {
-	searchRepo DiscoverRepository
-	cache      MemoryCache
-	logger     *log.Helper
+	searchRepo   DiscoverRepository
+	programRepo  cms.ProgramRepository
+	episodeRepo  cms.EpisodeRepository
+	categoryRepo cms.CategoryRepository
+	seasonRepo   cms.SeasonRepository
+	translations cms.TranslationRepository
+	chapters     cms.ChapterRepository
+	people       cms.PersonRepository
+	credits      cms.CreditRepository
+	cache        MemoryCache
+	logger       *log.Helper
 }
 
 // CachedSearchResult holds search results with total count for caching
@@ -21,15 +32,39 @@ type CachedSearchResult struct {
 	TotalCount int32
 }
 
-func NewDiscoverUsecase(searchRepo DiscoverRepository, cache MemoryCache, logger log.Logger) *DiscoverUsecase {
+func NewDiscoverUsecase(
+	searchRepo DiscoverRepository,
+	programRepo cms.ProgramRepository,
+	episodeRepo cms.EpisodeRepository,
+	categoryRepo cms.CategoryRepository,
+	seasonRepo cms.SeasonRepository,
+	translations cms.TranslationRepository,
+	chapters cms.ChapterRepository,
+	people cms.PersonRepository,
+	credits cms.CreditRepository,
+	cache MemoryCache,
+	logger log.Logger,
+) *DiscoverUsecase {
 	return &DiscoverUsecase{
-		searchRepo: searchRepo,
-		cache:      cache,
-		logger:     log.NewHelper(logger),
+		searchRepo:   searchRepo,
+		programRepo:  programRepo,
+		episodeRepo:  episodeRepo,
+		categoryRepo: categoryRepo,
+		seasonRepo:   seasonRepo,
+		translations: translations,
+		chapters:     chapters,
+		people:       people,
+		credits:      credits,
+		cache:        cache,
+		logger:       log.NewHelper(logger),
 	}
 }
 
-func (d *DiscoverUsecase) Search(ctx context.Context, query string, page, pageSize int32) (*SearchResults, int32, error) {
+// Search finds categories, programs and episodes matching query in any of their
+// translations. With a categoryID, only content in that category or its subcategories is
+// returned. Results are shown in the first of locales they are translated to, and only
+// include what is available to listeners in country.
+func (d *DiscoverUsecase) Search(ctx context.Context, query string, categoryID *uuid.UUID, locales []string, country string, page, pageSize int32) (*SearchResults, int32, error) {
 	if page <= 0 {
 		page = 1
 	}
@@ -38,38 +73,54 @@ func (d *DiscoverUsecase) Search(ctx context.Context, query string, page, pageSi
 	}
 
 	// Generate cache key based on query parameters
-	cacheKey := fmt.Sprintf("search:%s:page:%d:size:%d", query, page, pageSize)
+	cacheKey := fmt.Sprintf("search:%s:category:%s:locales:%s:country:%s:page:%d:size:%d", query, categoryKey(categoryID), localesKey(locales), countryKey(country), page, pageSize)
 	const cacheTTL = 5 * time.Minute // Cache for 5 minutes
 
-	if cached, found := d.cache.Get(cacheKey); found {
+	if cached, found := d.cache.Get(ctx, cacheKey); found {
 		if cachedResult, ok := cached.(*CachedSearchResult); ok {
 			return cachedResult.Results, cachedResult.TotalCount, nil
 		}
 		d.logger.Warn("Invalid cached data type for search results")
 	}
 
-	results, totalCount, err := d.searchRepo.Search(ctx, query, page, pageSize)
+	results, totalCount, err := d.searchRepo.Search(ctx, query, categoryID, country, page, pageSize)
 	if err != nil {
 		d.logger.Errorf("Failed to search: %v", err)
 		return nil, 0, err
 	}
 
+	if err := d.localizeCategories(ctx, results.Categories, locales); err != nil {
+		return nil, 0, err
+	}
+	if err := d.localizePrograms(ctx, results.Programs, locales); err != nil {
+		return nil, 0, err
+	}
+	if err := d.localizeEpisodes(ctx, results.Episodes, locales); err != nil {
+		return nil, 0, err
+	}
+	if err := d.attachChapters(ctx, results.Episodes); err != nil {
+		return nil, 0, err
+	}
+
 	cachedResult := &CachedSearchResult{
 		Results:    results,
 		TotalCount: totalCount,
 	}
-	if !d.cache.SetWithTTL(cacheKey, cachedResult, 1, cacheTTL) {
+	if ttl := d.availabilityTTL(ctx, cacheTTL); ttl > 0 && !d.cache.SetWithTTL(ctx, cacheKey, cachedResult, 1, ttl) {
 		d.logger.Warnf("Failed to cache search results for query: %s", query)
 	}
 
 	return results, totalCount, nil
 }
 
-func (d *DiscoverUsecase) Featured(ctx context.Context) ([]*cms.Program, error) {
-	const cacheKey = "featured_programs"
+// Featured returns the featured programs available to listeners in country, only those
+// in the category or its subcategories when categoryID is set, in the first of locales
+// they are translated to.
+func (d *DiscoverUsecase) Featured(ctx context.Context, categoryID *uuid.UUID, locales []string, country string) ([]*cms.Program, error) {
+	cacheKey := "featured_programs:category:" + categoryKey(categoryID) + ":locales:" + localesKey(locales) + ":country:" + countryKey(country)
 	const cacheTTL = 15 * time.Minute // Cache for 15 minutes
 
-	if cached, found := d.cache.Get(cacheKey); found {
+	if cached, found := d.cache.Get(ctx, cacheKey); found {
 		if programs, ok := cached.([]*cms.Program); ok {
 			return programs, nil
 		}
@@ -77,15 +128,411 @@ func (d *DiscoverUsecase) Featured(ctx context.Context) ([]*cms.Program, error)
 		d.logger.Warn("Invalid cached data type for featured programs")
 	}
 
-	programs, err := d.searchRepo.Featured(ctx)
+	programs, err := d.searchRepo.Featured(ctx, categoryID, country)
 	if err != nil {
 		d.logger.Errorf("Failed to get featured programs: %v", err)
 		return nil, err
 	}
 
-	if !d.cache.SetWithTTL(cacheKey, programs, 1, cacheTTL) {
+	if err := d.localizePrograms(ctx, programs, locales); err != nil {
+		return nil, err
+	}
+
+	if ttl := d.availabilityTTL(ctx, cacheTTL); ttl > 0 && !d.cache.SetWithTTL(ctx, cacheKey, programs, 1, ttl) {
 		d.logger.Warn("Failed to cache featured programs")
 	}
 
 	return programs, nil
 }
+
+// BatchGetPrograms loads published programs in request order, in the first of locales
+// they are translated to. Programs that are not published, or not available to
+// listeners in country, are reported as not found, exactly like unknown ones.
+func (d *DiscoverUsecase) BatchGetPrograms(ctx context.Context, ids []uuid.UUID, locales []string, country string) (*cms.Batch[*cms.Program], error) {
+	programs, err := d.programRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	published := publishedPrograms(programs, country)
+	if err := d.localizePrograms(ctx, published, locales); err != nil {
+		return nil, err
+	}
+	return cms.NewBatch(ids, published, func(p *cms.Program) uuid.UUID { return p.ID }), nil
+}
+
+// BatchGetEpisodes loads published episodes in request order, in the first of locales
+// they are translated to. Episodes that are not published, or not available to
+// listeners in country, are reported as not found, exactly like unknown ones; so are
+// episodes of programs that are not published or not available.
+func (d *DiscoverUsecase) BatchGetEpisodes(ctx context.Context, ids []uuid.UUID, locales []string, country string) (*cms.Batch[*cms.Episode], error) {
+	episodes, err := d.episodeRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	published, err := d.publishedEpisodes(ctx, episodes, country)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.localizeEpisodes(ctx, published, locales); err != nil {
+		return nil, err
+	}
+	if err := d.attachChapters(ctx, published); err != nil {
+		return nil, err
+	}
+	return cms.NewBatch(ids, published, func(e *cms.Episode) uuid.UUID { return e.ID }), nil
+}
+
+func (d *DiscoverUsecase) BatchGetCategories(ctx context.Context, ids []uuid.UUID, locales []string) (*cms.Batch[*cms.Category], error) {
+	categories, err := d.categoryRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.localizeCategories(ctx, categories, locales); err != nil {
+		return nil, err
+	}
+	return cms.NewBatch(ids, categories, func(c *cms.Category) uuid.UUID { return c.ID }), nil
+}
+
+// ProgramSeasons returns the published episodes of a published program grouped by
+// season, in the first of locales they are translated to. Seasons come by sort order and
+// episodes by number; seasons without published episodes are left out. Only the program
+// and episodes available to listeners in country are shown.
+func (d *DiscoverUsecase) ProgramSeasons(ctx context.Context, programID uuid.UUID, locales []string, country string) ([]*cms.SeasonEpisodes, error) {
+	cacheKey := fmt.Sprintf("program_seasons:%s:locales:%s:country:%s", programID, localesKey(locales), countryKey(country))
+	const cacheTTL = 5 * time.Minute
+
+	if cached, found := d.cache.Get(ctx, cacheKey); found {
+		if seasons, ok := cached.([]*cms.SeasonEpisodes); ok {
+			return seasons, nil
+		}
+		d.logger.Warn("Invalid cached data type for program seasons")
+	}
+
+	program, err := d.programRepo.GetByID(ctx, programID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if program.Status != cms.ProgramStatusPublished || !program.Availability.Available(now, country) {
+		return nil, cms.ErrProgramNotFound
+	}
+
+	seasons, err := d.seasonRepo.ListByProgram(ctx, programID)
+	if err != nil {
+		return nil, err
+	}
+
+	published := cms.EpisodeStatusPublished
+	filter := cms.EpisodeFilter{ProgramID: &programID, Status: &published}
+	sort := cms.SortRequest{SortBy: "episode_number", SortOrder: "asc"}
+	bySeason := make(map[uuid.UUID][]*cms.Episode, len(seasons))
+	var episodes []*cms.Episode
+	for page := int32(1); ; page++ {
+		batch, pagination, err := d.episodeRepo.List(ctx, filter, cms.PaginationRequest{Page: page, PageSize: 100}, sort)
+		if err != nil {
+			return nil, err
+		}
+		for _, episode := range batch {
+			if !episode.Availability.Available(now, country) {
+				continue
+			}
+			bySeason[episode.SeasonID] = append(bySeason[episode.SeasonID], episode)
+			episodes = append(episodes, episode)
+		}
+		if page >= pagination.TotalPages {
+			break
+		}
+	}
+
+	if err := d.localizeEpisodes(ctx, episodes, locales); err != nil {
+		return nil, err
+	}
+	if err := d.attachChapters(ctx, episodes); err != nil {
+		return nil, err
+	}
+
+	grouped := make([]*cms.SeasonEpisodes, 0, len(seasons))
+	for _, season := range seasons {
+		if seasonEpisodes := bySeason[season.ID]; len(seasonEpisodes) > 0 {
+			grouped = append(grouped, &cms.SeasonEpisodes{Season: season, Episodes: seasonEpisodes})
+		}
+	}
+
+	if ttl := d.availabilityTTL(ctx, cacheTTL); ttl > 0 && !d.cache.SetWithTTL(ctx, cacheKey, grouped, 1, ttl) {
+		d.logger.Warn("Failed to cache program seasons")
+	}
+
+	return grouped, nil
+}
+
+// PersonPage returns a person, in the first of locales they are translated to, with a
+// page of their credits on published programs and episodes available to listeners in
+// country, most recently added first. The programs and episodes come in the order of
+// the credits.
+func (d *DiscoverUsecase) PersonPage(ctx context.Context, personID uuid.UUID, locales []string, country string, pagination cms.PaginationRequest) (*PersonPage, error) {
+	pagination.SetDefaults()
+
+	cacheKey := fmt.Sprintf("person_page:%s:locales:%s:country:%s:page:%d:size:%d", personID, localesKey(locales), countryKey(country), pagination.Page, pagination.PageSize)
+	const cacheTTL = 5 * time.Minute
+
+	if cached, found := d.cache.Get(ctx, cacheKey); found {
+		if page, ok := cached.(*PersonPage); ok {
+			return page, nil
+		}
+		d.logger.Warn("Invalid cached data type for person page")
+	}
+
+	person, err := d.people.GetByID(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.localizePeople(ctx, []*cms.Person{person}, locales); err != nil {
+		return nil, err
+	}
+
+	credits, paginationResp, err := d.credits.ListByPerson(ctx, personID, &cms.Listener{Country: country}, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var programIDs, episodeIDs []uuid.UUID
+	for _, credit := range credits {
+		if credit.ContentType == cms.ContentTypeProgram {
+			programIDs = append(programIDs, credit.ContentID)
+		} else {
+			episodeIDs = append(episodeIDs, credit.ContentID)
+		}
+	}
+
+	programs, err := d.programRepo.GetByIDs(ctx, programIDs)
+	if err != nil {
+		return nil, err
+	}
+	episodes, err := d.episodeRepo.GetByIDs(ctx, episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	// The credits were filtered when listed, but the content may have changed since
+	programs = publishedPrograms(programs, country)
+	if episodes, err = d.publishedEpisodes(ctx, episodes, country); err != nil {
+		return nil, err
+	}
+	// A person can have more than one role on the same content
+	programBatch := cms.NewBatch(programIDs, programs, func(p *cms.Program) uuid.UUID { return p.ID })
+	episodeBatch := cms.NewBatch(episodeIDs, episodes, func(e *cms.Episode) uuid.UUID { return e.ID })
+	programs, episodes = programBatch.Found, episodeBatch.Found
+
+	hidden := make(map[uuid.UUID]bool, len(programBatch.NotFound)+len(episodeBatch.NotFound))
+	for _, id := range append(programBatch.NotFound, episodeBatch.NotFound...) {
+		hidden[id] = true
+	}
+	credits = slices.DeleteFunc(credits, func(c *cms.Credit) bool { return hidden[c.ContentID] })
+
+	if err := d.localizePrograms(ctx, programs, locales); err != nil {
+		return nil, err
+	}
+	if err := d.localizeEpisodes(ctx, episodes, locales); err != nil {
+		return nil, err
+	}
+	if err := d.attachChapters(ctx, episodes); err != nil {
+		return nil, err
+	}
+
+	page := &PersonPage{
+		Person:     person,
+		Credits:    credits,
+		Programs:   programs,
+		Episodes:   episodes,
+		Pagination: paginationResp,
+	}
+	if ttl := d.availabilityTTL(ctx, cacheTTL); ttl > 0 && !d.cache.SetWithTTL(ctx, cacheKey, page, 1, ttl) {
+		d.logger.Warn("Failed to cache person page")
+	}
+
+	return page, nil
+}
+
+// availabilityTTL shortens ttl so that cached content expires when the availability of
+// any program or episode next changes; what was filtered by availability would no longer
+// be right after that. It returns zero, and nothing should be cached, when that time
+// cannot be looked up.
+func (d *DiscoverUsecase) availabilityTTL(ctx context.Context, ttl time.Duration) time.Duration {
+	next, err := d.searchRepo.NextAvailabilityChange(ctx)
+	if err != nil {
+		d.logger.Warnf("Failed to get the next availability change: %v", err)
+		return 0
+	}
+	if next != nil {
+		ttl = min(ttl, time.Until(*next))
+	}
+	return ttl
+}
+
+func categoryKey(categoryID *uuid.UUID) string {
+	if categoryID == nil {
+		return "all"
+	}
+	return categoryID.String()
+}
+
+func localesKey(locales []string) string {
+	if len(locales) == 0 {
+		return "original"
+	}
+	return strings.Join(locales, ",")
+}
+
+func countryKey(country string) string {
+	if country == "" {
+		return "unknown"
+	}
+	return country
+}
+
+// publishedPrograms returns the programs that are published and available to listeners
+// in country.
+func publishedPrograms(programs []*cms.Program, country string) []*cms.Program {
+	now := time.Now()
+	published := make([]*cms.Program, 0, len(programs))
+	for _, program := range programs {
+		if program.Status == cms.ProgramStatusPublished && program.Availability.Available(now, country) {
+			published = append(published, program)
+		}
+	}
+	return published
+}
+
+// publishedEpisodes returns the episodes that are published and available to listeners
+// in country, on programs that are too.
+func (d *DiscoverUsecase) publishedEpisodes(ctx context.Context, episodes []*cms.Episode, country string) ([]*cms.Episode, error) {
+	published := make([]*cms.Episode, 0, len(episodes))
+	for _, episode := range episodes {
+		if episode.Status == cms.EpisodeStatusPublished {
+			published = append(published, episode)
+		}
+	}
+	return d.availableEpisodes(ctx, published, country)
+}
+
+// availableEpisodes keeps the episodes that are available to listeners in country now,
+// and whose program is published and available to them too.
+func (d *DiscoverUsecase) availableEpisodes(ctx context.Context, episodes []*cms.Episode, country string) ([]*cms.Episode, error) {
+	programIDs := make([]uuid.UUID, 0, len(episodes))
+	for _, episode := range episodes {
+		programIDs = append(programIDs, episode.ProgramID)
+	}
+	programs, err := d.programRepo.GetByIDs(ctx, programIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	availablePrograms := make(map[uuid.UUID]bool, len(programs))
+	for _, program := range programs {
+		availablePrograms[program.ID] = program.Status == cms.ProgramStatusPublished && program.Availability.Available(now, country)
+	}
+
+	available := make([]*cms.Episode, 0, len(episodes))
+	for _, episode := range episodes {
+		if availablePrograms[episode.ProgramID] && episode.Availability.Available(now, country) {
+			available = append(available, episode)
+		}
+	}
+	return available, nil
+}
+
+// localizePrograms replaces the title and description of programs by their translation
+// in the first of locales they have one in.
+func (d *DiscoverUsecase) localizePrograms(ctx context.Context, programs []*cms.Program, locales []string) error {
+	picked, err := pickTranslations(ctx, d.translations, cms.ContentTypeProgram, programs, func(p *cms.Program) uuid.UUID { return p.ID }, locales)
+	if err != nil {
+		return err
+	}
+	for _, program := range programs {
+		if t, ok := picked[program.ID]; ok {
+			program.Title, program.Description = t.Localize(program.Title, program.Description)
+		}
+	}
+	return nil
+}
+
+func (d *DiscoverUsecase) localizeEpisodes(ctx context.Context, episodes []*cms.Episode, locales []string) error {
+	picked, err := pickTranslations(ctx, d.translations, cms.ContentTypeEpisode, episodes, func(e *cms.Episode) uuid.UUID { return e.ID }, locales)
+	if err != nil {
+		return err
+	}
+	for _, episode := range episodes {
+		if t, ok := picked[episode.ID]; ok {
+			episode.Title, episode.Description = t.Localize(episode.Title, episode.Description)
+		}
+	}
+	return nil
+}
+
+func (d *DiscoverUsecase) localizeCategories(ctx context.Context, categories []*cms.Category, locales []string) error {
+	picked, err := pickTranslations(ctx, d.translations, cms.ContentTypeCategory, categories, func(c *cms.Category) uuid.UUID { return c.ID }, locales)
+	if err != nil {
+		return err
+	}
+	for _, category := range categories {
+		if t, ok := picked[category.ID]; ok {
+			category.Name, category.Description = t.Localize(category.Name, category.Description)
+		}
+	}
+	return nil
+}
+
+func (d *DiscoverUsecase) localizePeople(ctx context.Context, people []*cms.Person, locales []string) error {
+	picked, err := pickTranslations(ctx, d.translations, cms.ContentTypePerson, people, func(p *cms.Person) uuid.UUID { return p.ID }, locales)
+	if err != nil {
+		return err
+	}
+	for _, person := range people {
+		if t, ok := picked[person.ID]; ok {
+			person.Name, person.Bio = t.Localize(person.Name, person.Bio)
+		}
+	}
+	return nil
+}
+
+// attachChapters loads the chapters of episodes, by start.
+func (d *DiscoverUsecase) attachChapters(ctx context.Context, episodes []*cms.Episode) error {
+	if len(episodes) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(episodes))
+	for i, episode := range episodes {
+		ids[i] = episode.ID
+	}
+
+	chapters, err := d.chapters.ListByEpisodes(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, episode := range episodes {
+		episode.Chapters = chapters[episode.ID]
+	}
+	return nil
+}
+
+// pickTranslations loads the translations of items and picks, for each, the one in the
+// first of locales it has.
+func pickTranslations[T any](ctx context.Context, repo cms.TranslationRepository, contentType cms.ContentType, items []T, id func(T) uuid.UUID, locales []string) (map[uuid.UUID]*cms.Translation, error) {
+	if len(items) == 0 || len(locales) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		ids[i] = id(item)
+	}
+
+	translations, err := repo.ListForContent(ctx, contentType, ids, locales)
+	if err != nil {
+		return nil, err
+	}
+	return cms.PickTranslations(translations, locales), nil
+}
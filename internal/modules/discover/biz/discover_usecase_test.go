@@ -0,0 +1,222 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cms "thmanyah/internal/modules/cms/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePrograms struct {
+	cms.ProgramRepository
+	programs []*cms.Program
+}
+
+func (r *fakePrograms) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*cms.Program, error) {
+	var found []*cms.Program
+	for _, program := range r.programs {
+		for _, id := range ids {
+			if program.ID == id {
+				copied := *program
+				found = append(found, &copied)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+type fakeEpisodes struct {
+	cms.EpisodeRepository
+	episodes []*cms.Episode
+}
+
+func (r *fakeEpisodes) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*cms.Episode, error) {
+	var found []*cms.Episode
+	for _, episode := range r.episodes {
+		for _, id := range ids {
+			if episode.ID == id {
+				copied := *episode
+				found = append(found, &copied)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+type fakeChapters struct {
+	cms.ChapterRepository
+}
+
+func (fakeChapters) ListByEpisodes(ctx context.Context, episodeIDs []uuid.UUID) (map[uuid.UUID][]*cms.Chapter, error) {
+	return nil, nil
+}
+
+func newTestUsecase(programs []*cms.Program, episodes []*cms.Episode) *DiscoverUsecase {
+	return NewDiscoverUsecase(nil, &fakePrograms{programs: programs}, &fakeEpisodes{episodes: episodes},
+		nil, nil, nil, fakeChapters{}, nil, nil, nil, log.DefaultLogger)
+}
+
+func TestDiscoverUsecase_BatchGetEpisodes(t *testing.T) {
+	published := &cms.Program{ID: uuid.New(), Status: cms.ProgramStatusPublished}
+	blocked := &cms.Program{ID: uuid.New(), Status: cms.ProgramStatusPublished,
+		Availability: cms.Availability{BlockedCountries: []string{"SA"}}}
+	programs := []*cms.Program{published, blocked}
+	for _, status := range []cms.ProgramStatus{cms.ProgramStatusDraft, cms.ProgramStatusInReview, cms.ProgramStatusArchived} {
+		programs = append(programs, &cms.Program{ID: uuid.New(), Status: status})
+	}
+
+	var episodes []*cms.Episode
+	episode := func(program *cms.Program, status cms.EpisodeStatus) uuid.UUID {
+		e := &cms.Episode{ID: uuid.New(), ProgramID: program.ID, Status: status}
+		episodes = append(episodes, e)
+		return e.ID
+	}
+	visible := episode(published, cms.EpisodeStatusPublished)
+	hidden := []uuid.UUID{
+		episode(published, cms.EpisodeStatusDraft),
+		episode(blocked, cms.EpisodeStatusPublished),
+	}
+	// Published episodes of programs that are not published are not public either
+	for _, program := range programs[2:] {
+		hidden = append(hidden, episode(program, cms.EpisodeStatusPublished))
+	}
+
+	uc := newTestUsecase(programs, episodes)
+	ids := append([]uuid.UUID{visible}, hidden...)
+	batch, err := uc.BatchGetEpisodes(context.Background(), ids, nil, "SA")
+	require.NoError(t, err)
+
+	require.Len(t, batch.Found, 1)
+	assert.Equal(t, visible, batch.Found[0].ID)
+	assert.Equal(t, hidden, batch.NotFound)
+}
+
+type fakePeople struct {
+	cms.PersonRepository
+	people []*cms.Person
+}
+
+func (r *fakePeople) GetByID(ctx context.Context, id uuid.UUID) (*cms.Person, error) {
+	for _, person := range r.people {
+		if person.ID == id {
+			copied := *person
+			return &copied, nil
+		}
+	}
+	return nil, cms.ErrPersonNotFound
+}
+
+// fakeCredits lists every credit of a person, like the repository would if all of them
+// were on content the listener can see.
+type fakeCredits struct {
+	cms.CreditRepository
+	credits  []*cms.Credit
+	listener *cms.Listener
+}
+
+func (r *fakeCredits) ListByPerson(ctx context.Context, personID uuid.UUID, listener *cms.Listener, pagination cms.PaginationRequest) ([]*cms.Credit, *cms.PaginationResponse, error) {
+	r.listener = listener
+	var credits []*cms.Credit
+	for _, credit := range r.credits {
+		if credit.PersonID == personID {
+			credits = append(credits, credit)
+		}
+	}
+	return credits, &cms.PaginationResponse{Page: pagination.Page, PageSize: pagination.PageSize, TotalCount: int32(len(credits))}, nil
+}
+
+type fakeSearch struct {
+	DiscoverRepository
+}
+
+func (fakeSearch) NextAvailabilityChange(ctx context.Context) (*time.Time, error) {
+	return nil, nil
+}
+
+type fakeCache map[string]any
+
+func (c fakeCache) SetWithTTL(ctx context.Context, key string, value any, cost int64, ttl time.Duration) bool {
+	c[key] = value
+	return true
+}
+
+func (c fakeCache) Get(ctx context.Context, key string) (any, bool) {
+	value, ok := c[key]
+	return value, ok
+}
+
+func TestDiscoverUsecase_PersonPage(t *testing.T) {
+	person := &cms.Person{ID: uuid.New(), Name: "Host"}
+	saBlocked := cms.Availability{BlockedCountries: []string{"SA"}}
+
+	published := &cms.Program{ID: uuid.New(), Status: cms.ProgramStatusPublished}
+	programs := []*cms.Program{
+		published,
+		{ID: uuid.New(), Status: cms.ProgramStatusDraft},
+		{ID: uuid.New(), Status: cms.ProgramStatusArchived},
+		{ID: uuid.New(), Status: cms.ProgramStatusPublished, Availability: saBlocked},
+	}
+	visible := &cms.Episode{ID: uuid.New(), ProgramID: published.ID, Status: cms.EpisodeStatusPublished}
+	episodes := []*cms.Episode{
+		visible,
+		{ID: uuid.New(), ProgramID: published.ID, Status: cms.EpisodeStatusDraft},
+		{ID: uuid.New(), ProgramID: published.ID, Status: cms.EpisodeStatusPublished, Availability: saBlocked},
+		{ID: uuid.New(), ProgramID: programs[1].ID, Status: cms.EpisodeStatusPublished},
+		{ID: uuid.New(), ProgramID: programs[3].ID, Status: cms.EpisodeStatusPublished},
+	}
+
+	credit := func(contentType cms.ContentType, contentID uuid.UUID, role cms.CreditRole) *cms.Credit {
+		return &cms.Credit{ID: uuid.New(), PersonID: person.ID, ContentType: contentType, ContentID: contentID, Role: role}
+	}
+	var credits, want []*cms.Credit
+	// A person can have several roles on the same content
+	want = append(want,
+		credit(cms.ContentTypeEpisode, visible.ID, cms.CreditRoleGuest),
+		credit(cms.ContentTypeProgram, published.ID, cms.CreditRoleHost),
+		credit(cms.ContentTypeProgram, published.ID, cms.CreditRoleProducer),
+	)
+	credits = append(credits, want...)
+	for _, program := range programs[1:] {
+		credits = append(credits, credit(cms.ContentTypeProgram, program.ID, cms.CreditRoleHost))
+	}
+	for _, episode := range episodes[1:] {
+		credits = append(credits, credit(cms.ContentTypeEpisode, episode.ID, cms.CreditRoleGuest))
+	}
+	// Content deleted since the credits were listed
+	credits = append(credits, credit(cms.ContentTypeEpisode, uuid.New(), cms.CreditRoleGuest))
+
+	creditRepo := &fakeCredits{credits: credits}
+	cache := fakeCache{}
+	uc := NewDiscoverUsecase(fakeSearch{}, &fakePrograms{programs: programs}, &fakeEpisodes{episodes: episodes},
+		nil, nil, nil, fakeChapters{}, &fakePeople{people: []*cms.Person{person}}, creditRepo, cache, log.DefaultLogger)
+
+	page, err := uc.PersonPage(context.Background(), person.ID, nil, "SA", cms.PaginationRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, &cms.Listener{Country: "SA"}, creditRepo.listener)
+	assert.Equal(t, person.Name, page.Person.Name)
+	assert.Equal(t, want, page.Credits)
+	require.Len(t, page.Programs, 1)
+	assert.Equal(t, published.ID, page.Programs[0].ID)
+	require.Len(t, page.Episodes, 1)
+	assert.Equal(t, visible.ID, page.Episodes[0].ID)
+	assert.Len(t, cache, 1)
+
+	// Listeners elsewhere see the blocked content
+	page, err = uc.PersonPage(context.Background(), person.ID, nil, "AE", cms.PaginationRequest{})
+	require.NoError(t, err)
+	assert.Len(t, page.Credits, 6)
+	assert.Len(t, page.Programs, 2)
+	assert.Len(t, page.Episodes, 3)
+
+	_, err = uc.PersonPage(context.Background(), uuid.New(), nil, "SA", cms.PaginationRequest{})
+	assert.ErrorIs(t, err, cms.ErrPersonNotFound)
+}
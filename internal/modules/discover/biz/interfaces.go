@@ -4,15 +4,24 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
+
 	cms "thmanyah/internal/modules/cms/biz"
 )
 
 type DiscoverRepository interface {
-	Search(ctx context.Context, query string, page, pageSize int32) (*SearchResults, int32, error)
-	Featured(ctx context.Context) ([]*cms.Program, error)
+	// Search and Featured only return content in the category and its subcategories
+	// when categoryID is set. Programs and episodes are left out unless they are
+	// published and available to listeners in country, empty when it is not known;
+	// episodes need their program to be published and available too.
+	Search(ctx context.Context, query string, categoryID *uuid.UUID, country string, page, pageSize int32) (*SearchResults, int32, error)
+	Featured(ctx context.Context, categoryID *uuid.UUID, country string) ([]*cms.Program, error)
+	// NextAvailabilityChange returns when the next program or episode becomes available
+	// or stops being available, nil when none is scheduled to.
+	NextAvailabilityChange(ctx context.Context) (*time.Time, error)
 }
 
 type MemoryCache interface {
-	SetWithTTL(key string, value any, cost int64, ttl time.Duration) bool
-	Get(key string) (any, bool)
+	SetWithTTL(ctx context.Context, key string, value any, cost int64, ttl time.Duration) bool
+	Get(ctx context.Context, key string) (any, bool)
 }
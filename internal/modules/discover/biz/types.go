@@ -8,4 +8,17 @@ type SearchResults struct {
 	Categories []*cms.Category `json:"categories"`
 	Programs   []*cms.Program  `json:"programs"`
 	Episodes   []*cms.Episode  `json:"episodes"`
+	// TranscriptMatches has where the query was found in the transcripts of Episodes,
+	// for the episodes it was found in
+	TranscriptMatches []*cms.TranscriptMatch `json:"transcript_matches"`
+}
+
+// PersonPage is a person with a page of their credits on published content, and the
+// programs and episodes those credits are on.
+type PersonPage struct {
+	Person     *cms.Person             `json:"person"`
+	Credits    []*cms.Credit           `json:"credits"`
+	Programs   []*cms.Program          `json:"programs"`
+	Episodes   []*cms.Episode          `json:"episodes"`
+	Pagination *cms.PaginationResponse `json:"pagination"`
 }
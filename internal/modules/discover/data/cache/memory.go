@@ -1,33 +1,81 @@
 package cache
 
 import (
+	"context"
 	"time"
 
 	"github.com/dgraph-io/ristretto/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"thmanyah/internal/modules/discover/biz"
 )
 
+const tracerName = "thmanyah/internal/modules/discover/data/cache"
+
 type memory struct {
-	cache *ristretto.Cache[string, any]
+	cache  *ristretto.Cache[string, any]
+	tracer trace.Tracer
 }
 
-func NewMemoryCache() (biz.MemoryCache, error) {
+func NewMemoryCache(meter metric.Meter, tp trace.TracerProvider) (biz.MemoryCache, error) {
 	cache, err := ristretto.NewCache(&ristretto.Config[string, any]{
 		NumCounters: 1e7,
 		MaxCost:     1 << 30,
 		BufferItems: 64,
+		Metrics:     true,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &memory{cache: cache}, nil
+	if err := registerCacheMetrics(meter, cache); err != nil {
+		cache.Close()
+		return nil, err
+	}
+
+	return &memory{cache: cache, tracer: tp.Tracer(tracerName)}, nil
 }
 
-func (m *memory) SetWithTTL(key string, value any, cost int64, ttl time.Duration) bool {
+func (m *memory) SetWithTTL(ctx context.Context, key string, value any, cost int64, ttl time.Duration) bool {
+	_, span := m.tracer.Start(ctx, "cache.Set", trace.WithAttributes(attribute.String("cache.key", key)))
+	defer span.End()
+
 	return m.cache.SetWithTTL(key, value, cost, ttl)
 }
 
-func (m *memory) Get(key string) (any, bool) {
-	return m.cache.Get(key)
+func (m *memory) Get(ctx context.Context, key string) (any, bool) {
+	_, span := m.tracer.Start(ctx, "cache.Get", trace.WithAttributes(attribute.String("cache.key", key)))
+	defer span.End()
+
+	value, found := m.cache.Get(key)
+	span.SetAttributes(attribute.Bool("cache.hit", found))
+
+	return value, found
+}
+
+// registerCacheMetrics exports the ristretto counters, which are only collected when Config.Metrics is set.
+func registerCacheMetrics(meter metric.Meter, cache *ristretto.Cache[string, any]) error {
+	hits, err := meter.Int64ObservableCounter("cache_hits", metric.WithDescription("Memory cache hits"))
+	if err != nil {
+		return err
+	}
+	misses, err := meter.Int64ObservableCounter("cache_misses", metric.WithDescription("Memory cache misses"))
+	if err != nil {
+		return err
+	}
+	evictions, err := meter.Int64ObservableCounter("cache_evictions", metric.WithDescription("Keys evicted from the memory cache"))
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(hits, int64(cache.Metrics.Hits()))
+		o.ObserveInt64(misses, int64(cache.Metrics.Misses()))
+		o.ObserveInt64(evictions, int64(cache.Metrics.KeysEvicted()))
+
+		return nil
+	}, hits, misses, evictions)
+
+	return err
 }
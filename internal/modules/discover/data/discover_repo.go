@@ -2,14 +2,44 @@ package repo
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	cms "thmanyah/internal/modules/cms/biz"
 	"thmanyah/internal/modules/discover/biz"
 )
 
+// inCategoryTree is a subquery of the ids of the category in the given parameter and
+// its subcategories. A NULL parameter or a trashed category matches nothing; callers
+// check for the former first.
+func inCategoryTree(param string) string {
+	return fmt.Sprintf(`(
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM categories WHERE id = %s AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id FROM categories c JOIN subtree ON c.parent_id = subtree.id WHERE c.deleted_at IS NULL
+		)
+		SELECT id FROM subtree
+	)`, param)
+}
+
+// programCategoryIDs selects every category of a program, the primary one first.
+const programCategoryIDs = `ARRAY(
+			SELECT category_id FROM program_categories
+			WHERE program_categories.program_id = programs.id
+			ORDER BY is_primary DESC, category_id
+		) AS category_ids`
+
+// programsInCategoryTree is a subquery of the programs in the category in the given
+// parameter or its subcategories.
+func programsInCategoryTree(param string) string {
+	return "(SELECT program_id FROM program_categories WHERE category_id IN " + inCategoryTree(param) + ")"
+}
+
 type discoverRepo struct {
 	logger *log.Helper
 	db     *pgxpool.Pool
@@ -22,12 +52,13 @@ func NewDiscoverRepo(db *pgxpool.Pool, logger log.Logger) (biz.DiscoverRepositor
 	}, nil
 }
 
-func (s *discoverRepo) Search(ctx context.Context, query string, page, pageSize int32) (*biz.SearchResults, int32, error) {
+func (s *discoverRepo) Search(ctx context.Context, query string, categoryID *uuid.UUID, country string, page, pageSize int32) (*biz.SearchResults, int32, error) {
 	if query == "" {
 		return &biz.SearchResults{
-			Categories: []*cms.Category{},
-			Programs:   []*cms.Program{},
-			Episodes:   []*cms.Episode{},
+			Categories:        []*cms.Category{},
+			Programs:          []*cms.Program{},
+			Episodes:          []*cms.Episode{},
+			TranscriptMatches: []*cms.TranscriptMatch{},
 		}, 0, nil
 	}
 
@@ -41,7 +72,7 @@ func (s *discoverRepo) Search(ctx context.Context, query string, page, pageSize
 	}
 
 	// Search categories
-	categories, err := s.searchCategories(ctx, searchQuery)
+	categories, err := s.searchCategories(ctx, searchQuery, categoryID)
 	if err != nil {
 		s.logger.Errorf("Failed to search categories: %v", err)
 		return nil, 0, err
@@ -49,7 +80,7 @@ func (s *discoverRepo) Search(ctx context.Context, query string, page, pageSize
 	results.Categories = categories
 
 	// Search programs
-	programs, err := s.searchPrograms(ctx, searchQuery, pageSize, offset)
+	programs, err := s.searchPrograms(ctx, searchQuery, categoryID, country, pageSize, offset)
 	if err != nil {
 		s.logger.Errorf("Failed to search programs: %v", err)
 		return nil, 0, err
@@ -57,12 +88,13 @@ func (s *discoverRepo) Search(ctx context.Context, query string, page, pageSize
 	results.Programs = programs
 
 	// Search episodes
-	episodes, err := s.searchEpisodes(ctx, searchQuery, pageSize, offset)
+	episodes, matches, err := s.searchEpisodes(ctx, searchQuery, categoryID, country, pageSize, offset)
 	if err != nil {
 		s.logger.Errorf("Failed to search episodes: %v", err)
 		return nil, 0, err
 	}
 	results.Episodes = episodes
+	results.TranscriptMatches = matches
 
 	// Count total results
 	totalCount := int32(len(categories) + len(programs) + len(episodes))
@@ -70,16 +102,23 @@ func (s *discoverRepo) Search(ctx context.Context, query string, page, pageSize
 	return results, totalCount, nil
 }
 
-func (s *discoverRepo) searchCategories(ctx context.Context, query string) ([]*cms.Category, error) {
+func (s *discoverRepo) searchCategories(ctx context.Context, query string, categoryID *uuid.UUID) ([]*cms.Category, error) {
 	sql := `
-		SELECT id, name, description, type, created_at, updated_at, created_by, metadata
+		SELECT id, name, description, type, parent_id, created_at, updated_at, created_by, metadata
 		FROM categories 
-		WHERE to_tsvector('simple', unaccent(name || ' ' || coalesce(description, ''))) @@ plainto_tsquery('simple', unaccent($1))
+		WHERE (to_tsvector('simple', unaccent(name || ' ' || coalesce(description, ''))) @@ plainto_tsquery('simple', unaccent($1))
+		    OR EXISTS (
+		        SELECT 1 FROM translations t
+		        WHERE t.content_type = 'category' AND t.content_id = categories.id
+		          AND to_tsvector('simple', unaccent(t.title || ' ' || t.description)) @@ plainto_tsquery('simple', unaccent($1))
+		    ))
+		  AND deleted_at IS NULL
+		  AND ($2::uuid IS NULL OR id IN ` + inCategoryTree("$2") + `)
 		ORDER BY name
 		LIMIT 10
 	`
 
-	rows, err := s.db.Query(ctx, sql, query)
+	rows, err := s.db.Query(ctx, sql, query, categoryID)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +132,7 @@ func (s *discoverRepo) searchCategories(ctx context.Context, query string) ([]*c
 			&category.Name,
 			&category.Description,
 			&category.Type,
+			&category.ParentID,
 			&category.CreatedAt,
 			&category.UpdatedAt,
 			&category.CreatedBy,
@@ -107,18 +147,22 @@ func (s *discoverRepo) searchCategories(ctx context.Context, query string) ([]*c
 	return categories, rows.Err()
 }
 
-func (s *discoverRepo) searchPrograms(ctx context.Context, query string, limit, offset int32) ([]*cms.Program, error) {
+func (s *discoverRepo) searchPrograms(ctx context.Context, query string, categoryID *uuid.UUID, country string, limit, offset int32) ([]*cms.Program, error) {
 	sql := `
-		SELECT id, title, description, category_id, status, created_at, updated_at, 
+		SELECT id, title, description, category_id, ` + programCategoryIDs + `, status, created_at, updated_at, 
 		       published_at, created_by, updated_by, thumbnail_url, tags, metadata, 
-		       source_url, episodes_count, is_featured, view_count, rating
+		       source_url, episodes_count, is_featured, view_count, rating, availability
 		FROM programs 
 		WHERE search_vector @@ plainto_tsquery('simple', unaccent($1))
+		  AND status = 'PROGRAM_STATUS_PUBLISHED'
+		  AND deleted_at IS NULL
+		  AND ($4::uuid IS NULL OR id IN ` + programsInCategoryTree("$4") + `)
+		  AND content_available(availability, $5)
 		ORDER BY ts_rank(search_vector, plainto_tsquery('simple', unaccent($1))) DESC
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := s.db.Query(ctx, sql, query, limit, offset)
+	rows, err := s.db.Query(ctx, sql, query, limit, offset, categoryID, country)
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +176,7 @@ func (s *discoverRepo) searchPrograms(ctx context.Context, query string, limit,
 			&program.Title,
 			&program.Description,
 			&program.CategoryID,
+			&program.CategoryIDs,
 			&program.Status,
 			&program.CreatedAt,
 			&program.UpdatedAt,
@@ -146,6 +191,7 @@ func (s *discoverRepo) searchPrograms(ctx context.Context, query string, limit,
 			&program.IsFeatured,
 			&program.ViewCount,
 			&program.Rating,
+			&program.Availability,
 		)
 		if err != nil {
 			return nil, err
@@ -156,26 +202,50 @@ func (s *discoverRepo) searchPrograms(ctx context.Context, query string, limit,
 	return programs, rows.Err()
 }
 
-func (s *discoverRepo) searchEpisodes(ctx context.Context, query string, limit, offset int32) ([]*cms.Episode, error) {
+// searchEpisodes finds published episodes of published programs matching query in their
+// own text or in a cue of their transcripts. For those found in a transcript, the first
+// matching cue is returned too.
+func (s *discoverRepo) searchEpisodes(ctx context.Context, query string, categoryID *uuid.UUID, country string, limit, offset int32) ([]*cms.Episode, []*cms.TranscriptMatch, error) {
 	sql := `
-		SELECT id, program_id, title, description, duration_seconds, episode_number, season_number, 
-		       status, created_at, updated_at, published_at, scheduled_at, created_by, updated_by, 
-		       media_url, thumbnail_url, tags, metadata, view_count, rating
-		FROM episodes 
-		WHERE search_vector @@ plainto_tsquery('simple', unaccent($1))
-		ORDER BY ts_rank(search_vector, plainto_tsquery('simple', unaccent($1))) DESC
+		SELECT e.id, e.program_id, e.title, e.description, e.duration_seconds, e.episode_number, e.season_number, 
+		       e.status, e.created_at, e.updated_at, e.published_at, e.scheduled_at, e.created_by, e.updated_by, 
+		       e.media_url, e.thumbnail_url, e.tags, e.metadata, e.view_count, e.rating, e.season_id, e.availability,
+		       cue.locale, cue.start_seconds, cue.end_seconds, cue.text
+		FROM episodes e
+		LEFT JOIN LATERAL (
+		    SELECT t.locale, c.start_seconds, c.end_seconds, c.text
+		    FROM transcripts t
+		    JOIN transcript_cues c ON c.transcript_id = t.id
+		    WHERE t.episode_id = e.id
+		      AND c.search_vector @@ plainto_tsquery('simple', unaccent($1))
+		    ORDER BY t.locale, c.position
+		    LIMIT 1
+		) cue ON TRUE
+		WHERE (e.search_vector @@ plainto_tsquery('simple', unaccent($1)) OR cue.text IS NOT NULL)
+		  AND e.status = 'EPISODE_STATUS_PUBLISHED'
+		  AND e.deleted_at IS NULL
+		  AND ($4::uuid IS NULL OR e.program_id IN ` + programsInCategoryTree("$4") + `)
+		  AND content_available(e.availability, $5)
+		  AND e.program_id IN (
+		      SELECT id FROM programs
+		      WHERE status = 'PROGRAM_STATUS_PUBLISHED' AND deleted_at IS NULL AND content_available(availability, $5)
+		  )
+		ORDER BY ts_rank(e.search_vector, plainto_tsquery('simple', unaccent($1))) DESC
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := s.db.Query(ctx, sql, query, limit, offset)
+	rows, err := s.db.Query(ctx, sql, query, limit, offset, categoryID, country)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer rows.Close()
 
 	var episodes []*cms.Episode
+	matches := []*cms.TranscriptMatch{}
 	for rows.Next() {
 		episode := &cms.Episode{}
+		var locale, text *string
+		match := &cms.TranscriptMatch{}
 		err := rows.Scan(
 			&episode.ID,
 			&episode.ProgramID,
@@ -197,27 +267,44 @@ func (s *discoverRepo) searchEpisodes(ctx context.Context, query string, limit,
 			&episode.Metadata,
 			&episode.ViewCount,
 			&episode.Rating,
+			&episode.SeasonID,
+			&episode.Availability,
+			&locale,
+			&match.StartSeconds,
+			&match.EndSeconds,
+			&text,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		episodes = append(episodes, episode)
+
+		if text != nil {
+			match.EpisodeID = episode.ID
+			match.Locale = *locale
+			match.Text = *text
+			matches = append(matches, match)
+		}
 	}
 
-	return episodes, rows.Err()
+	return episodes, matches, rows.Err()
 }
 
-func (s *discoverRepo) Featured(ctx context.Context) ([]*cms.Program, error) {
+func (s *discoverRepo) Featured(ctx context.Context, categoryID *uuid.UUID, country string) ([]*cms.Program, error) {
 	sql := `
-		SELECT id, title, description, category_id, status, created_at, updated_at, 
+		SELECT id, title, description, category_id, ` + programCategoryIDs + `, status, created_at, updated_at, 
 		       published_at, created_by, updated_by, thumbnail_url, tags, metadata, 
-		       source_url, episodes_count, is_featured, view_count, rating
+		       source_url, episodes_count, is_featured, view_count, rating, availability
 		FROM programs 
 		WHERE is_featured = true
+		  AND status = 'PROGRAM_STATUS_PUBLISHED'
+		  AND deleted_at IS NULL
+		  AND ($1::uuid IS NULL OR id IN ` + programsInCategoryTree("$1") + `)
+		  AND content_available(availability, $2)
 		ORDER BY created_at DESC
 	`
 
-	rows, err := s.db.Query(ctx, sql)
+	rows, err := s.db.Query(ctx, sql, categoryID, country)
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +318,7 @@ func (s *discoverRepo) Featured(ctx context.Context) ([]*cms.Program, error) {
 			&program.Title,
 			&program.Description,
 			&program.CategoryID,
+			&program.CategoryIDs,
 			&program.Status,
 			&program.CreatedAt,
 			&program.UpdatedAt,
@@ -245,6 +333,7 @@ func (s *discoverRepo) Featured(ctx context.Context) ([]*cms.Program, error) {
 			&program.IsFeatured,
 			&program.ViewCount,
 			&program.Rating,
+			&program.Availability,
 		)
 		if err != nil {
 			return nil, err
@@ -254,3 +343,24 @@ func (s *discoverRepo) Featured(ctx context.Context) ([]*cms.Program, error) {
 
 	return programs, rows.Err()
 }
+
+func (s *discoverRepo) NextAvailabilityChange(ctx context.Context) (*time.Time, error) {
+	sql := `
+		SELECT min(at) FROM (
+			SELECT (availability ->> 'start_at')::timestamptz AS at FROM programs WHERE deleted_at IS NULL
+			UNION ALL
+			SELECT (availability ->> 'end_at')::timestamptz FROM programs WHERE deleted_at IS NULL
+			UNION ALL
+			SELECT (availability ->> 'start_at')::timestamptz FROM episodes WHERE deleted_at IS NULL
+			UNION ALL
+			SELECT (availability ->> 'end_at')::timestamptz FROM episodes WHERE deleted_at IS NULL
+		) boundaries
+		WHERE at > NOW()
+	`
+
+	var at *time.Time
+	if err := s.db.QueryRow(ctx, sql).Scan(&at); err != nil {
+		return nil, err
+	}
+	return at, nil
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 
 	pb "thmanyah/api/grpc/v1"
+	"thmanyah/internal/geo"
+	"thmanyah/internal/i18n"
 	cms "thmanyah/internal/modules/cms/biz"
 	"thmanyah/internal/modules/discover/biz"
 	"thmanyah/internal/utils/convert"
+	"thmanyah/internal/validation"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -27,7 +31,16 @@ func NewDiscoverService(discoverUc *biz.DiscoverUsecase, logger log.Logger) *Dis
 }
 
 func (s *DiscoverService) Featured(ctx context.Context, req *pb.FeaturedRequest) (*pb.FeaturedResponse, error) {
-	programs, err := s.discoverUc.Featured(ctx)
+	var categoryID *uuid.UUID
+	if req.CategoryId != "" {
+		id, err := validation.ParseUUID("category_id", req.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		categoryID = &id
+	}
+
+	programs, err := s.discoverUc.Featured(ctx, categoryID, i18n.ContentLocales(ctx, req.Locale), geo.Country(ctx))
 	if err != nil {
 		s.logger.Errorf("Failed to get featured programs: %v", err)
 		return nil, err
@@ -44,13 +57,14 @@ func (s *DiscoverService) Featured(ctx context.Context, req *pb.FeaturedRequest)
 func (s *DiscoverService) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
 	if req.Query == "" {
 		return &pb.SearchResponse{
-			Categories: []*pb.Category{},
-			Programs:   []*pb.Program{},
-			Episodes:   []*pb.Episode{},
-			TotalCount: 0,
-			Page:       req.Page,
-			PageSize:   req.PageSize,
-			TotalPages: 0,
+			Categories:        []*pb.Category{},
+			Programs:          []*pb.Program{},
+			Episodes:          []*pb.Episode{},
+			TotalCount:        0,
+			Page:              req.Page,
+			PageSize:          req.PageSize,
+			TotalPages:        0,
+			TranscriptMatches: []*pb.TranscriptMatch{},
 		}, nil
 	}
 
@@ -63,7 +77,16 @@ func (s *DiscoverService) Search(ctx context.Context, req *pb.SearchRequest) (*p
 		pageSize = 20
 	}
 
-	results, totalCount, err := s.discoverUc.Search(ctx, req.Query, page, pageSize)
+	var categoryID *uuid.UUID
+	if req.CategoryId != "" {
+		id, err := validation.ParseUUID("category_id", req.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		categoryID = &id
+	}
+
+	results, totalCount, err := s.discoverUc.Search(ctx, req.Query, categoryID, i18n.ContentLocales(ctx, req.Locale), geo.Country(ctx), page, pageSize)
 	if err != nil {
 		s.logger.Errorf("Failed to search: %v", err)
 		return nil, err
@@ -77,13 +100,121 @@ func (s *DiscoverService) Search(ctx context.Context, req *pb.SearchRequest) (*p
 	totalPages := (totalCount + pageSize - 1) / pageSize
 
 	return &pb.SearchResponse{
-		Categories: pbCategories,
-		Programs:   pbPrograms,
-		Episodes:   pbEpisodes,
-		TotalCount: totalCount,
-		Page:       page,
-		PageSize:   pageSize,
-		TotalPages: totalPages,
+		Categories:        pbCategories,
+		Programs:          pbPrograms,
+		Episodes:          pbEpisodes,
+		TotalCount:        totalCount,
+		Page:              page,
+		PageSize:          pageSize,
+		TotalPages:        totalPages,
+		TranscriptMatches: convert.ConvertTranscriptMatches(results.TranscriptMatches),
+	}, nil
+}
+
+func (s *DiscoverService) BatchGetPrograms(ctx context.Context, req *pb.BatchGetProgramsRequest) (*pb.BatchGetProgramsResponse, error) {
+	programIDs, err := validation.ParseUUIDs("program_ids", req.ProgramIds)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.discoverUc.BatchGetPrograms(ctx, programIDs, i18n.ContentLocales(ctx, req.Locale), geo.Country(ctx))
+	if err != nil {
+		s.logger.Errorf("Failed to batch get programs: %v", err)
+		return nil, err
+	}
+
+	return &pb.BatchGetProgramsResponse{
+		Programs:    s.convertProgramsToPB(batch.Found),
+		NotFoundIds: convert.ConvertIDs(batch.NotFound),
+	}, nil
+}
+
+func (s *DiscoverService) BatchGetEpisodes(ctx context.Context, req *pb.BatchGetEpisodesRequest) (*pb.BatchGetEpisodesResponse, error) {
+	episodeIDs, err := validation.ParseUUIDs("episode_ids", req.EpisodeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.discoverUc.BatchGetEpisodes(ctx, episodeIDs, i18n.ContentLocales(ctx, req.Locale), geo.Country(ctx))
+	if err != nil {
+		s.logger.Errorf("Failed to batch get episodes: %v", err)
+		return nil, err
+	}
+
+	return &pb.BatchGetEpisodesResponse{
+		Episodes:    s.convertEpisodesToPB(batch.Found),
+		NotFoundIds: convert.ConvertIDs(batch.NotFound),
+	}, nil
+}
+
+func (s *DiscoverService) BatchGetCategories(ctx context.Context, req *pb.BatchGetCategoriesRequest) (*pb.BatchGetCategoriesResponse, error) {
+	categoryIDs, err := validation.ParseUUIDs("category_ids", req.CategoryIds)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.discoverUc.BatchGetCategories(ctx, categoryIDs, i18n.ContentLocales(ctx, req.Locale))
+	if err != nil {
+		s.logger.Errorf("Failed to batch get categories: %v", err)
+		return nil, err
+	}
+
+	return &pb.BatchGetCategoriesResponse{
+		Categories:  s.convertCategoriesToPB(batch.Found),
+		NotFoundIds: convert.ConvertIDs(batch.NotFound),
+	}, nil
+}
+
+func (s *DiscoverService) ListProgramSeasons(ctx context.Context, req *pb.ListProgramSeasonsRequest) (*pb.ListProgramSeasonsResponse, error) {
+	programID, err := validation.ParseUUID("program_id", req.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons, err := s.discoverUc.ProgramSeasons(ctx, programID, i18n.ContentLocales(ctx, req.Locale), geo.Country(ctx))
+	if err != nil {
+		s.logger.Errorf("Failed to list program seasons: %v", err)
+		return nil, err
+	}
+
+	pbSeasons := make([]*pb.SeasonEpisodes, len(seasons))
+	for i, season := range seasons {
+		pbSeasons[i] = &pb.SeasonEpisodes{
+			Season:   convert.ConvertSeason(season.Season),
+			Episodes: s.convertEpisodesToPB(season.Episodes),
+		}
+	}
+
+	return &pb.ListProgramSeasonsResponse{
+		Seasons: pbSeasons,
+	}, nil
+}
+
+func (s *DiscoverService) GetPersonPage(ctx context.Context, req *pb.GetPersonPageRequest) (*pb.GetPersonPageResponse, error) {
+	personID, err := validation.ParseUUID("person_id", req.PersonId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := cms.PaginationRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	page, err := s.discoverUc.PersonPage(ctx, personID, i18n.ContentLocales(ctx, req.Locale), geo.Country(ctx), pagination)
+	if err != nil {
+		s.logger.Errorf("Failed to get person page: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetPersonPageResponse{
+		Person:     convert.ConvertPerson(page.Person),
+		Credits:    convert.ConvertCredits(page.Credits),
+		Programs:   s.convertProgramsToPB(page.Programs),
+		Episodes:   s.convertEpisodesToPB(page.Episodes),
+		TotalCount: page.Pagination.TotalCount,
+		Page:       page.Pagination.Page,
+		PageSize:   page.Pagination.PageSize,
 	}, nil
 }
 
@@ -100,6 +231,9 @@ func (s *DiscoverService) convertCategoriesToPB(categories []*cms.Category) []*p
 			CreatedBy:   category.CreatedBy.String(),
 			Metadata:    category.Metadata,
 		}
+		if category.ParentID != nil {
+			pbCategories[i].ParentId = category.ParentID.String()
+		}
 	}
 	return pbCategories
 }
@@ -124,6 +258,7 @@ func (s *DiscoverService) convertProgramsToPB(programs []*cms.Program) []*pb.Pro
 			IsFeatured:    program.IsFeatured,
 			ViewCount:     program.ViewCount,
 			Rating:        program.Rating,
+			CategoryIds:   convert.ConvertIDs(program.CategoryIDs),
 		}
 
 		if program.PublishedAt != nil {
@@ -160,6 +295,8 @@ func (s *DiscoverService) convertEpisodesToPB(episodes []*cms.Episode) []*pb.Epi
 			Metadata:        episode.Metadata,
 			ViewCount:       episode.ViewCount,
 			Rating:          episode.Rating,
+			SeasonId:        episode.SeasonID.String(),
+			Chapters:        convert.ConvertChapters(episode.Chapters),
 		}
 
 		if episode.PublishedAt != nil {
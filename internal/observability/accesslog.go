@@ -0,0 +1,199 @@
+package observability
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/utils"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AccessLog writes one structured line per request on both transports.
+type AccessLog struct {
+	logger          log.Logger
+	requestPayloads bool
+	proxies         utils.TrustedProxies
+}
+
+// NewAccessLog logs to logger. Client addresses forwarded by proxies are believed.
+func NewAccessLog(c *conf.Observability, proxies utils.TrustedProxies, logger log.Logger) *AccessLog {
+	return &AccessLog{
+		logger:          log.With(logger, "component", "access"),
+		requestPayloads: c.GetLogging().GetRequestPayloads(),
+		proxies:         proxies,
+	}
+}
+
+type accessLogKey struct{}
+
+type principalKey struct{}
+
+// Server logs operation, status, latency, principal, client ip and request size.
+// It must run inside RequestID and outside the auth middlewares; see RecordPrincipal.
+func (a *AccessLog) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			principal := new(string)
+			start := time.Now()
+			ctx = context.WithValue(ctx, accessLogKey{}, a)
+			res, err := handler(context.WithValue(ctx, principalKey{}, principal), req)
+
+			code := int32(200)
+			reason := ""
+			if err != nil {
+				e := errors.FromError(err)
+				code, reason = e.Code, e.Reason
+			}
+
+			keyvals := []any{
+				"msg", "request",
+				"kind", tr.Kind().String(),
+				"operation", tr.Operation(),
+				"code", code,
+				"reason", reason,
+				"latency", time.Since(start).Seconds(),
+				"principal", *principal,
+				"client_ip", a.clientIP(ctx, tr),
+				"request_size", requestSize(tr, req),
+			}
+			if a.requestPayloads {
+				keyvals = append(keyvals, "args", redactedPayload(req))
+			}
+			if err != nil {
+				keyvals = append(keyvals, "error", err.Error())
+			}
+
+			level := log.LevelInfo
+			if code >= 500 {
+				level = log.LevelError
+			}
+			_ = log.WithContext(ctx, a.logger).Log(level, keyvals...)
+
+			return res, err
+		}
+	}
+}
+
+// RecordPrincipal reports the authenticated user back to the access log.
+// It belongs after the JWT middleware, where the claims are available.
+func (a *AccessLog) RecordPrincipal() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			if principal, ok := ctx.Value(principalKey{}).(*string); ok {
+				if userID, err := utils.GetUserID(ctx); err == nil {
+					*principal = userID.String()
+				}
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// ClientIP is the address of the caller of the request ctx serves, as the access log
+// records it.
+func ClientIP(ctx context.Context) string {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	a, ok := ctx.Value(accessLogKey{}).(*AccessLog)
+	if !ok {
+		a = &AccessLog{}
+	}
+	return a.clientIP(ctx, tr)
+}
+
+// clientIP is the address of the connection, or the one trusted proxies reported in
+// X-Forwarded-For, or X-Real-IP when there is none.
+func (a *AccessLog) clientIP(ctx context.Context, tr transport.Transporter) string {
+	var remote string
+	var forwarded []string
+	if ht, ok := tr.(khttp.Transporter); ok {
+		r := ht.Request()
+		remote = r.RemoteAddr
+		if forwarded = r.Header.Values("X-Forwarded-For"); len(forwarded) == 0 {
+			forwarded = r.Header.Values("X-Real-IP")
+		}
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remote = p.Addr.String()
+	}
+
+	if addr, ok := a.proxies.ClientAddr(remote, forwarded); ok {
+		return addr.String()
+	}
+	return hostOnly(remote)
+}
+
+func hostOnly(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+func requestSize(tr transport.Transporter, req any) int64 {
+	if ht, ok := tr.(khttp.Transporter); ok && ht.Request().ContentLength >= 0 {
+		return ht.Request().ContentLength
+	}
+	if msg, ok := req.(proto.Message); ok {
+		return int64(proto.Size(msg))
+	}
+	return 0
+}
+
+// redactedPayload renders the request as JSON with sensitive string fields masked.
+func redactedPayload(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return ""
+	}
+
+	clone := proto.Clone(msg)
+	redactMessage(clone.ProtoReflect())
+
+	data, err := protojson.Marshal(clone)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func redactMessage(m protoreflect.Message) {
+	var sensitive []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() && isSensitive(string(fd.Name())):
+			sensitive = append(sensitive, fd)
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsMap():
+			redactMessage(v.Message())
+		}
+		return true
+	})
+
+	for _, fd := range sensitive {
+		m.Set(fd, protoreflect.ValueOfString(redactedValue))
+	}
+}
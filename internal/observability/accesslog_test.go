@@ -0,0 +1,253 @@
+package observability
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "thmanyah/api/grpc/v1"
+	"thmanyah/internal/conf"
+	"thmanyah/internal/utils"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// recordingLogger keeps the key values of every line logged.
+type recordingLogger struct {
+	lines  []map[string]any
+	levels []log.Level
+}
+
+func (l *recordingLogger) Log(level log.Level, keyvals ...any) error {
+	line := make(map[string]any, len(keyvals)/2)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		line[fmt.Sprint(keyvals[i])] = keyvals[i+1]
+	}
+	l.lines = append(l.lines, line)
+	l.levels = append(l.levels, level)
+	return nil
+}
+
+// serveHTTP runs r through a kratos HTTP server with the middlewares and filters of the
+// real one that the tests need, handled by handler.
+func serveHTTP(r *http.Request, ms []middleware.Middleware, filters []khttp.FilterFunc, handler middleware.Handler) *httptest.ResponseRecorder {
+	srv := khttp.NewServer(khttp.Middleware(ms...), khttp.Filter(filters...), khttp.ErrorEncoder(ErrorEncoder))
+	srv.Route("/").GET("/test", func(ctx khttp.Context) error {
+		res, err := ctx.Middleware(handler)(ctx, nil)
+		if err != nil {
+			return err
+		}
+		return ctx.String(http.StatusOK, fmt.Sprint(res))
+	})
+
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, r)
+	return w
+}
+
+func TestAccessLog_ClientIP(t *testing.T) {
+	proxies, err := utils.ParseTrustedProxies([]string{"10.0.0.0/8", "2001:db8::1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		proxies utils.TrustedProxies
+		remote  string
+		header  http.Header
+		want    string
+	}{
+		{name: "Direct", remote: "203.0.113.7:5123", want: "203.0.113.7"},
+		{
+			// Without trusted proxies, anyone could claim any address
+			name:   "ForwardedUntrusted",
+			remote: "203.0.113.7:5123",
+			header: http.Header{"X-Forwarded-For": {"198.51.100.1"}},
+			want:   "203.0.113.7",
+		},
+		{
+			name:    "ForwardedByProxy",
+			proxies: proxies,
+			remote:  "10.0.0.1:5123",
+			header:  http.Header{"X-Forwarded-For": {"203.0.113.7"}},
+			want:    "203.0.113.7",
+		},
+		{
+			// The client's own claim, left of the first untrusted hop, is ignored
+			name:    "ForwardedChain",
+			proxies: proxies,
+			remote:  "10.0.0.1:5123",
+			header:  http.Header{"X-Forwarded-For": {"198.51.100.1, 203.0.113.7", "10.0.0.2"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "ForwardedToUntrustedServer",
+			proxies: proxies,
+			remote:  "198.51.100.9:5123",
+			header:  http.Header{"X-Forwarded-For": {"203.0.113.7"}},
+			want:    "198.51.100.9",
+		},
+		{
+			name:    "RealIP",
+			proxies: proxies,
+			remote:  "10.0.0.1:5123",
+			header:  http.Header{"X-Real-Ip": {"203.0.113.8"}},
+			want:    "203.0.113.8",
+		},
+		{
+			name:    "ForwardedBeforeRealIP",
+			proxies: proxies,
+			remote:  "10.0.0.1:5123",
+			header:  http.Header{"X-Forwarded-For": {"203.0.113.7"}, "X-Real-Ip": {"203.0.113.8"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "IPv6",
+			proxies: proxies,
+			remote:  "[2001:db8::1]:443",
+			header:  http.Header{"X-Forwarded-For": {"2001:db8::2"}},
+			want:    "2001:db8::2",
+		},
+		{
+			name:    "MalformedHop",
+			proxies: proxies,
+			remote:  "10.0.0.1:5123",
+			header:  http.Header{"X-Forwarded-For": {"unknown"}},
+			want:    "10.0.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &recordingLogger{}
+			a := NewAccessLog(&conf.Observability{}, tt.proxies, logger)
+
+			r := httptest.NewRequest(http.MethodGet, "/test", nil)
+			r.RemoteAddr = tt.remote
+			for key, values := range tt.header {
+				r.Header[key] = values
+			}
+			var seen string
+			w := serveHTTP(r, []middleware.Middleware{a.Server()}, nil, func(ctx context.Context, req any) (any, error) {
+				seen = ClientIP(ctx)
+				return "ok", nil
+			})
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Len(t, logger.lines, 1)
+			assert.Equal(t, tt.want, logger.lines[0]["client_ip"])
+			assert.Equal(t, tt.want, seen, "handlers see the address the access log records")
+		})
+	}
+}
+
+func TestClientIP_OutsideRequest(t *testing.T) {
+	assert.Empty(t, ClientIP(context.Background()))
+}
+
+func TestAccessLog_Server(t *testing.T) {
+	userID := uuid.New()
+	req := &v1.RegisterRequest{Email: "listener@example.com", Password: "hunter2", ConfirmPassword: "hunter2"}
+
+	tests := []struct {
+		name     string
+		payloads bool
+		err      error
+		check    func(t *testing.T, line map[string]any, level log.Level)
+	}{
+		{
+			name:     "Redacted",
+			payloads: true,
+			check: func(t *testing.T, line map[string]any, level log.Level) {
+				args, _ := line["args"].(string)
+				assert.Contains(t, args, "listener@example.com")
+				assert.NotContains(t, args, "hunter2")
+				assert.Equal(t, 2, strings.Count(args, redactedValue), args)
+				assert.Equal(t, log.LevelInfo, level)
+				assert.Equal(t, int32(200), line["code"])
+				assert.Equal(t, userID.String(), line["principal"])
+				assert.Equal(t, "/thmanyah.v1.AuthService/Register", line["operation"])
+				assert.Equal(t, int64(proto.Size(req)), line["request_size"])
+			},
+		},
+		{
+			name: "WithoutPayloads",
+			check: func(t *testing.T, line map[string]any, level log.Level) {
+				assert.NotContains(t, line, "args")
+			},
+		},
+		{
+			name: "ClientError",
+			err:  errors.BadRequest("PASSWORDS_DIFFER", "passwords differ"),
+			check: func(t *testing.T, line map[string]any, level log.Level) {
+				assert.Equal(t, log.LevelInfo, level)
+				assert.Equal(t, int32(400), line["code"])
+				assert.Equal(t, "PASSWORDS_DIFFER", line["reason"])
+				assert.Contains(t, line["error"], "passwords differ")
+			},
+		},
+		{
+			name: "ServerError",
+			err:  errors.InternalServer("DATABASE", "database unavailable"),
+			check: func(t *testing.T, line map[string]any, level log.Level) {
+				assert.Equal(t, log.LevelError, level)
+				assert.Equal(t, int32(500), line["code"])
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &recordingLogger{}
+			a := NewAccessLog(&conf.Observability{
+				Logging: &conf.Observability_Logging{RequestPayloads: tt.payloads},
+			}, nil, logger)
+
+			// The principal is known once the JWT middleware has run
+			authenticated := func(handler middleware.Handler) middleware.Handler {
+				return func(ctx context.Context, req any) (any, error) {
+					claims := utils.NewClaimsBuilder().WithUserID(userID.String()).Build()
+					return handler(jwt.NewContext(ctx, claims), req)
+				}
+			}
+			handler := middleware.Chain(a.Server(), authenticated, a.RecordPrincipal())(func(ctx context.Context, req any) (any, error) {
+				return nil, tt.err
+			})
+			ctx := transport.NewServerContext(context.Background(), &testTransport{
+				operation: "/thmanyah.v1.AuthService/Register",
+				header:    headerCarrier{},
+			})
+			_, err := handler(ctx, req)
+			assert.Equal(t, tt.err, err)
+
+			require.Len(t, logger.lines, 1)
+			assert.Equal(t, "access", logger.lines[0]["component"])
+			tt.check(t, logger.lines[0], logger.levels[0])
+			// The request itself is left as it was
+			assert.Equal(t, "hunter2", req.Password)
+		})
+	}
+}
+
+func TestNewLogger_MasksSensitiveKeys(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(&conf.Observability_Logging{Format: "json"}, &buf)
+
+	_ = logger.Log(log.LevelInfo, "msg", "login", "email", "listener@example.com", "password", "hunter2", "refresh_token", "abc")
+
+	out := buf.String()
+	assert.Contains(t, out, "listener@example.com")
+	assert.NotContains(t, out, "hunter2")
+	assert.NotContains(t, out, "abc")
+	assert.Equal(t, 2, strings.Count(out, redactedValue), out)
+}
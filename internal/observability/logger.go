@@ -0,0 +1,99 @@
+package observability
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"thmanyah/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// redactedValue matches the mask kratos applies for log.FilterKey.
+const redactedValue = "***"
+
+// sensitiveKeys are masked when used as log keys, and in any request field whose name contains one of them.
+var sensitiveKeys = []string{"password", "token", "access_token", "refresh_token", "secret", "authorization", "cookie"}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewLogger builds the application logger from the logging config: text or json output,
+// filtered by level, with sensitive keys masked.
+func NewLogger(c *conf.Observability_Logging, w io.Writer) log.Logger {
+	var logger log.Logger
+	if strings.EqualFold(c.GetFormat(), "json") {
+		logger = newJSONLogger(w)
+	} else {
+		logger = log.NewStdLogger(w)
+	}
+
+	level := log.LevelInfo
+	if c.GetLevel() != "" {
+		level = log.ParseLevel(c.GetLevel())
+	}
+
+	return log.NewFilter(logger, log.FilterLevel(level), log.FilterKey(sensitiveKeys...))
+}
+
+// jsonLogger writes one JSON object per line, keeping the key order of the call.
+type jsonLogger struct {
+	w    io.Writer
+	pool *sync.Pool
+	mu   sync.Mutex
+}
+
+func newJSONLogger(w io.Writer) log.Logger {
+	return &jsonLogger{
+		w:    w,
+		pool: &sync.Pool{New: func() any { return new(bytes.Buffer) }},
+	}
+}
+
+func (l *jsonLogger) Log(level log.Level, keyvals ...any) error {
+	if len(keyvals)%2 != 0 {
+		keyvals = append(keyvals, "KEYVALS UNPAIRED")
+	}
+
+	buf := l.pool.Get().(*bytes.Buffer)
+	defer l.pool.Put(buf)
+	buf.Reset()
+
+	buf.WriteString(`{"level":`)
+	writeJSON(buf, level.String())
+	for i := 0; i < len(keyvals); i += 2 {
+		buf.WriteByte(',')
+		writeJSON(buf, fmt.Sprint(keyvals[i]))
+		buf.WriteByte(':')
+		writeJSON(buf, keyvals[i+1])
+	}
+	buf.WriteString("}\n")
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err := l.w.Write(buf.Bytes())
+	return err
+}
+
+func writeJSON(buf *bytes.Buffer, v any) {
+	if err, ok := v.(error); ok {
+		v = err.Error()
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		data, _ = json.Marshal(fmt.Sprint(v))
+	}
+	buf.Write(data)
+}
@@ -0,0 +1,99 @@
+package observability
+
+import (
+	"context"
+	"net/http"
+
+	"thmanyah/internal/conf"
+
+	kmetrics "github.com/go-kratos/kratos/v2/middleware/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+const meterName = "thmanyah"
+
+const defaultMetricsPath = "/metrics"
+
+// Metrics owns the meter provider shared by the servers, the data layer and the use cases.
+// When metrics are disabled Meter is a no-op meter and Handler is nil.
+type Metrics struct {
+	Meter   metric.Meter
+	Handler http.Handler
+	Path    string
+
+	serverSeconds  metric.Float64Histogram
+	serverRequests metric.Int64Counter
+}
+
+func NewMetrics(c *conf.Observability) (*Metrics, func(), error) {
+	if !c.GetMetrics().GetEnabled() {
+		return &Metrics{Meter: noop.NewMeterProvider().Meter(meterName)}, func() {}, nil
+	}
+
+	registry := prometheus.NewRegistry()
+	exporter, err := otelprom.New(otelprom.WithRegisterer(registry), otelprom.WithoutScopeInfo())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter))
+	meter := provider.Meter(meterName)
+
+	serverSeconds, err := meter.Float64Histogram(
+		"server_request_duration",
+		metric.WithDescription("Latency of server requests per operation"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serverRequests, err := meter.Int64Counter(
+		"server_requests",
+		metric.WithDescription("Server requests per operation, code and reason"),
+		metric.WithUnit("{call}"),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := c.GetMetrics().GetPath()
+	if path == "" {
+		path = defaultMetricsPath
+	}
+
+	cleanup := func() {
+		_ = provider.Shutdown(context.Background())
+	}
+
+	return &Metrics{
+		Meter:          meter,
+		Handler:        promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+		Path:           path,
+		serverSeconds:  serverSeconds,
+		serverRequests: serverRequests,
+	}, cleanup, nil
+}
+
+// ProvideMeter exposes the shared meter to the data and biz layers.
+func ProvideMeter(m *Metrics) metric.Meter {
+	return m.Meter
+}
+
+// ServerOptions returns the kratos metrics middleware options recording RED metrics per operation.
+func (m *Metrics) ServerOptions() []kmetrics.Option {
+	var opts []kmetrics.Option
+	if m.serverSeconds != nil {
+		opts = append(opts, kmetrics.WithSeconds(m.serverSeconds))
+	}
+	if m.serverRequests != nil {
+		opts = append(opts, kmetrics.WithRequests(m.serverRequests))
+	}
+	return opts
+}
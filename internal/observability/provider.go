@@ -0,0 +1,13 @@
+package observability
+
+import (
+	"github.com/google/wire"
+)
+
+var ProviderSet = wire.NewSet(
+	NewMetrics,
+	ProvideMeter,
+	NewTracing,
+	ProvideTracerProvider,
+	NewAccessLog,
+)
@@ -0,0 +1,110 @@
+package observability
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the correlation id on requests and responses.
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDMetadataKey is the error metadata key the request id is exposed under.
+const requestIDMetadataKey = "request_id"
+
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the id assigned to the current request, if any.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// RequestIDValuer adds the request id to every log line written with the request context.
+func RequestIDValuer() log.Valuer {
+	return func(ctx context.Context) any {
+		return RequestIDFromContext(ctx)
+	}
+}
+
+// RequestID propagates the caller's X-Request-ID or generates one, echoes it in the
+// reply headers and attaches it to error metadata so it appears in error bodies.
+func RequestID() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			id := validRequestID(tr.RequestHeader().Get(RequestIDHeader))
+			tr.ReplyHeader().Set(RequestIDHeader, id)
+
+			res, err := handler(context.WithValue(ctx, requestIDKey{}, id), req)
+			if err != nil {
+				return nil, withRequestID(err, id)
+			}
+
+			return res, nil
+		}
+	}
+}
+
+// RequestIDFilter assigns the request id at the net/http level, so that responses
+// rejected before the middleware chain runs (decode errors, unknown routes) carry it too.
+func RequestIDFilter() khttp.FilterFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := validRequestID(r.Header.Get(RequestIDHeader))
+			r.Header.Set(RequestIDHeader, id)
+			w.Header().Set(RequestIDHeader, id)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ErrorEncoder is the kratos default error encoder with the request id added to the body.
+func ErrorEncoder(w http.ResponseWriter, r *http.Request, err error) {
+	if id := r.Header.Get(RequestIDHeader); id != "" {
+		err = withRequestID(err, id)
+	}
+
+	khttp.DefaultErrorEncoder(w, r, err)
+}
+
+func withRequestID(err error, id string) error {
+	e := errors.FromError(err)
+
+	md := make(map[string]string, len(e.Metadata)+1)
+	for k, v := range e.Metadata {
+		md[k] = v
+	}
+	md[requestIDMetadataKey] = id
+
+	return e.WithMetadata(md)
+}
+
+// validRequestID keeps a caller supplied id when it is reasonably sized printable ASCII
+// and generates a new one otherwise.
+func validRequestID(id string) string {
+	if id == "" || len(id) > maxRequestIDLength {
+		return uuid.NewString()
+	}
+
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return uuid.NewString()
+		}
+	}
+
+	return id
+}
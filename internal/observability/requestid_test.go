@@ -0,0 +1,146 @@
+package observability
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		// keep tells whether the caller's id is used, rather than a new one
+		keep bool
+	}{
+		{name: "Missing"},
+		{name: "Kept", id: "req-1234", keep: true},
+		{name: "KeptUUID", id: "0b7d7a36-2f0f-4cc1-9a8e-9c9b2f1a7c11", keep: true},
+		{name: "LongestKept", id: strings.Repeat("a", maxRequestIDLength), keep: true},
+		{name: "TooLong", id: strings.Repeat("a", maxRequestIDLength+1)},
+		{name: "Space", id: "req 1234"},
+		{name: "NotASCII", id: "طلب"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/test", nil)
+			if tt.id != "" {
+				r.Header.Set(RequestIDHeader, tt.id)
+			}
+
+			var seen string
+			w := serveHTTP(r, []middleware.Middleware{RequestID()}, nil, func(ctx context.Context, req any) (any, error) {
+				seen = RequestIDFromContext(ctx)
+				return "ok", nil
+			})
+
+			id := w.Header().Get(RequestIDHeader)
+			assert.Equal(t, id, seen, "the handler sees the id of the reply")
+			if tt.keep {
+				assert.Equal(t, tt.id, id)
+			} else {
+				_, err := uuid.Parse(id)
+				assert.NoError(t, err, "a new id is a uuid, got %q", id)
+			}
+		})
+	}
+}
+
+func TestRequestID_Unique(t *testing.T) {
+	ids := map[string]bool{}
+	for range 10 {
+		w := serveHTTP(httptest.NewRequest(http.MethodGet, "/test", nil), []middleware.Middleware{RequestID()}, nil, func(ctx context.Context, req any) (any, error) {
+			return "ok", nil
+		})
+		ids[w.Header().Get(RequestIDHeader)] = true
+	}
+	assert.Len(t, ids, 10)
+}
+
+// errorBody decodes the kratos error in the body of w.
+func errorBody(t *testing.T, w *httptest.ResponseRecorder) *errors.Error {
+	t.Helper()
+	var body errors.Error
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body), w.Body.String())
+	return &body
+}
+
+func TestRequestID_Errors(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/test", nil)
+	r.Header.Set(RequestIDHeader, "req-1234")
+	w := serveHTTP(r, []middleware.Middleware{RequestID()}, nil, func(ctx context.Context, req any) (any, error) {
+		return nil, errors.NotFound("PROGRAM_NOT_FOUND", "program not found").WithMetadata(map[string]string{"id": "42"})
+	})
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "req-1234", w.Header().Get(RequestIDHeader))
+	body := errorBody(t, w)
+	assert.Equal(t, "PROGRAM_NOT_FOUND", body.Reason)
+	assert.Equal(t, map[string]string{"id": "42", requestIDMetadataKey: "req-1234"}, body.Metadata)
+}
+
+func TestRequestIDFilter(t *testing.T) {
+	filters := []khttp.FilterFunc{RequestIDFilter()}
+
+	t.Run("SharedWithMiddleware", func(t *testing.T) {
+		var seen string
+		w := serveHTTP(httptest.NewRequest(http.MethodGet, "/test", nil), []middleware.Middleware{RequestID()}, filters, func(ctx context.Context, req any) (any, error) {
+			seen = RequestIDFromContext(ctx)
+			return "ok", nil
+		})
+
+		// The filter assigns the id, which the middleware then keeps
+		assert.NotEmpty(t, seen)
+		assert.Equal(t, seen, w.Header().Get(RequestIDHeader))
+	})
+
+	t.Run("UnknownRoute", func(t *testing.T) {
+		// Rejected before any middleware runs, the reply still carries the id
+		r := httptest.NewRequest(http.MethodGet, "/missing", nil)
+		r.Header.Set(RequestIDHeader, "req-5678")
+		w := serveHTTP(r, []middleware.Middleware{RequestID()}, filters, func(ctx context.Context, req any) (any, error) {
+			return "ok", nil
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "req-5678", w.Header().Get(RequestIDHeader))
+	})
+
+	t.Run("InvalidReplaced", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/test", nil)
+		r.Header.Set(RequestIDHeader, "req 5678")
+		var seen string
+		w := serveHTTP(r, []middleware.Middleware{RequestID()}, filters, func(ctx context.Context, req any) (any, error) {
+			seen = RequestIDFromContext(ctx)
+			return "ok", nil
+		})
+
+		assert.NotEqual(t, "req 5678", seen)
+		assert.Equal(t, seen, w.Header().Get(RequestIDHeader))
+	})
+}
+
+func TestErrorEncoder(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/test", nil)
+	r.Header.Set(RequestIDHeader, "req-1234")
+	w := httptest.NewRecorder()
+	ErrorEncoder(w, r, errors.BadRequest("INVALID", "invalid"))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "req-1234", errorBody(t, w).Metadata[requestIDMetadataKey])
+
+	// Without an id, the error is encoded as it is
+	w = httptest.NewRecorder()
+	ErrorEncoder(w, httptest.NewRequest(http.MethodGet, "/test", nil), errors.BadRequest("INVALID", "invalid"))
+	assert.NotContains(t, errorBody(t, w).Metadata, requestIDMetadataKey)
+}
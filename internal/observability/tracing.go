@@ -0,0 +1,103 @@
+package observability
+
+import (
+	"context"
+
+	"thmanyah/internal/conf"
+
+	ktracing "github.com/go-kratos/kratos/v2/middleware/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const defaultServiceName = "thmanyah"
+
+// Tracing owns the tracer provider shared by the servers, the data layer and the use cases.
+// When tracing is disabled Provider is a no-op provider.
+type Tracing struct {
+	Provider trace.TracerProvider
+
+	sdk *sdktrace.TracerProvider
+}
+
+func NewTracing(c *conf.Observability) (*Tracing, func(), error) {
+	if !c.GetTracing().GetEnabled() {
+		return &Tracing{Provider: noop.NewTracerProvider()}, func() {}, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(c.GetTracing().GetEndpoint())}
+	if c.GetTracing().GetInsecure() {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	// The exporter dials lazily, so an unreachable collector does not block startup.
+	exporter, err := otlptracegrpc.New(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t, cleanup, err := NewTracingWithExporter(c, exporter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	otel.SetTracerProvider(t.Provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return t, cleanup, nil
+}
+
+// NewTracingWithExporter builds a tracer provider around the given exporter,
+// e.g. a tracetest.InMemoryExporter in tests.
+func NewTracingWithExporter(c *conf.Observability, exporter sdktrace.SpanExporter) (*Tracing, func(), error) {
+	serviceName := c.GetTracing().GetServiceName()
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sampler := sdktrace.AlwaysSample()
+	if c.GetTracing().SamplingRatio != nil {
+		sampler = sdktrace.TraceIDRatioBased(c.GetTracing().GetSamplingRatio())
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+	)
+
+	cleanup := func() {
+		_ = provider.Shutdown(context.Background())
+	}
+
+	return &Tracing{Provider: provider, sdk: provider}, cleanup, nil
+}
+
+// ProvideTracerProvider exposes the shared tracer provider to the data and biz layers.
+func ProvideTracerProvider(t *Tracing) trace.TracerProvider {
+	return t.Provider
+}
+
+// ServerOptions returns the kratos tracing middleware options.
+func (t *Tracing) ServerOptions() []ktracing.Option {
+	return []ktracing.Option{ktracing.WithTracerProvider(t.Provider)}
+}
+
+// ForceFlush exports all finished spans that are still buffered.
+func (t *Tracing) ForceFlush(ctx context.Context) error {
+	if t.sdk == nil {
+		return nil
+	}
+	return t.sdk.ForceFlush(ctx)
+}
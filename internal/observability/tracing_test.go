@@ -0,0 +1,102 @@
+package observability
+
+import (
+	"context"
+	"testing"
+
+	"thmanyah/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"google.golang.org/protobuf/proto"
+)
+
+type headerCarrier map[string][]string
+
+func (h headerCarrier) Get(key string) string {
+	if v := h[key]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (h headerCarrier) Set(key, value string)      { h[key] = []string{value} }
+func (h headerCarrier) Add(key, value string)      { h[key] = append(h[key], value) }
+func (h headerCarrier) Values(key string) []string { return h[key] }
+
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type testTransport struct {
+	operation string
+	header    headerCarrier
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return t.operation }
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+func (t *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+func serve(t *testing.T, tr *Tracing, operation string) {
+	t.Helper()
+
+	ctx := transport.NewServerContext(context.Background(), &testTransport{operation: operation, header: headerCarrier{}})
+	handler := tracing.Server(tr.ServerOptions()...)(func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+
+	_, err := handler(ctx, nil)
+	require.NoError(t, err)
+}
+
+func TestTracing_RecordsServerSpans(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tr, cleanup, err := NewTracingWithExporter(&conf.Observability{
+		Tracing: &conf.Observability_Tracing{Enabled: true},
+	}, exporter)
+	require.NoError(t, err)
+	defer cleanup()
+
+	serve(t, tr, "/api.v1.DiscoverService/Search")
+	require.NoError(t, tr.ForceFlush(context.Background()))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	require.Equal(t, "/api.v1.DiscoverService/Search", spans[0].Name)
+
+	serviceName, ok := spans[0].Resource.Set().Value(semconv.ServiceNameKey)
+	require.True(t, ok)
+	require.Equal(t, defaultServiceName, serviceName.AsString())
+}
+
+func TestTracing_SamplingRatio(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tr, cleanup, err := NewTracingWithExporter(&conf.Observability{
+		Tracing: &conf.Observability_Tracing{Enabled: true, SamplingRatio: proto.Float64(0)},
+	}, exporter)
+	require.NoError(t, err)
+	defer cleanup()
+
+	serve(t, tr, "/api.v1.DiscoverService/Featured")
+	require.NoError(t, tr.ForceFlush(context.Background()))
+
+	require.Empty(t, exporter.GetSpans())
+}
+
+func TestTracing_Disabled(t *testing.T) {
+	tr, cleanup, err := NewTracing(&conf.Observability{})
+	require.NoError(t, err)
+	defer cleanup()
+
+	serve(t, tr, "/api.v1.DiscoverService/Search")
+	require.NoError(t, tr.ForceFlush(context.Background()))
+}
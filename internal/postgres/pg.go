@@ -5,10 +5,13 @@ import (
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"thmanyah/internal/conf"
 )
 
-func NewPgPool(ctx context.Context, conf *conf.Data) (*pgxpool.Pool, error) {
+func NewPgPool(ctx context.Context, conf *conf.Data, meter metric.Meter, tp trace.TracerProvider) (*pgxpool.Pool, error) {
 	connString := fmt.Sprintf(
 		"host=%s user=%s password=%s dbname=%s port=%d sslmode=disable",
 		conf.Postgres.Host,
@@ -22,11 +25,62 @@ func NewPgPool(ctx context.Context, conf *conf.Data) (*pgxpool.Pool, error) {
 	if err != nil {
 		return nil, err
 	}
+	poolConfig.ConnConfig.Tracer = newQueryTracer(tp, conf.Postgres.Dbname)
 
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := registerPoolMetrics(meter, pool); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
 	return pool, nil
 }
+
+// registerPoolMetrics exports pgxpool.Stat as observable instruments, sampled on every scrape.
+func registerPoolMetrics(meter metric.Meter, pool *pgxpool.Pool) error {
+	totalConns, err := meter.Int64ObservableGauge("db_pool_connections", metric.WithDescription("Open connections by state"))
+	if err != nil {
+		return err
+	}
+	maxConns, err := meter.Int64ObservableGauge("db_pool_max_connections", metric.WithDescription("Maximum size of the pool"))
+	if err != nil {
+		return err
+	}
+	acquires, err := meter.Int64ObservableCounter("db_pool_acquires", metric.WithDescription("Connection acquires by result"))
+	if err != nil {
+		return err
+	}
+	acquireSeconds, err := meter.Float64ObservableCounter("db_pool_acquire_duration", metric.WithDescription("Cumulative time spent acquiring connections"), metric.WithUnit("s"))
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		stat := pool.Stat()
+
+		o.ObserveInt64(totalConns, int64(stat.AcquiredConns()), metric.WithAttributes(stateAttr("acquired")))
+		o.ObserveInt64(totalConns, int64(stat.IdleConns()), metric.WithAttributes(stateAttr("idle")))
+		o.ObserveInt64(totalConns, int64(stat.ConstructingConns()), metric.WithAttributes(stateAttr("constructing")))
+		o.ObserveInt64(maxConns, int64(stat.MaxConns()))
+		o.ObserveInt64(acquires, stat.AcquireCount(), metric.WithAttributes(resultAttr("success")))
+		o.ObserveInt64(acquires, stat.EmptyAcquireCount(), metric.WithAttributes(resultAttr("empty")))
+		o.ObserveInt64(acquires, stat.CanceledAcquireCount(), metric.WithAttributes(resultAttr("canceled")))
+		o.ObserveFloat64(acquireSeconds, stat.AcquireDuration().Seconds())
+
+		return nil
+	}, totalConns, maxConns, acquires, acquireSeconds)
+
+	return err
+}
+
+func stateAttr(state string) attribute.KeyValue {
+	return attribute.String("state", state)
+}
+
+func resultAttr(result string) attribute.KeyValue {
+	return attribute.String("result", result)
+}
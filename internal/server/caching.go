@@ -0,0 +1,255 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	http2 "net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/geo"
+
+	"github.com/andybalholm/brotli"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultCompressionMinSize = 1024
+
+// NewResponseEncoder extends CustomResponseEncoder with conditional GET support
+// (strong ETag, Last-Modified from the newest updated_at, 304 replies), per operation
+// Cache-Control rules and gzip/brotli compression above a size threshold.
+func NewResponseEncoder(c *conf.Server_HTTP) http.EncodeResponseFunc {
+	rules := make(map[string]*conf.Server_HTTP_CacheRule, len(c.GetCacheRules()))
+	for _, rule := range c.GetCacheRules() {
+		rules[rule.GetOperation()] = rule
+	}
+
+	compress := c.GetCompression().GetEnabled()
+	minSize := c.GetCompression().GetMinSize()
+	if minSize <= 0 {
+		minSize = defaultCompressionMinSize
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, v interface{}) error {
+		pb, ok := v.(proto.Message)
+		if !ok {
+			return CustomResponseEncoder(w, r, v)
+		}
+
+		data, err := jsonMarshalOptions.Marshal(pb)
+		if err != nil {
+			return err
+		}
+
+		header := w.Header()
+		header.Set("Content-Type", "application/json")
+
+		encoding := ""
+		if compress && int64(len(data)) >= minSize {
+			header.Add("Vary", "Accept-Encoding")
+			encoding = negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		}
+
+		if r.Method == http2.MethodGet || r.Method == http2.MethodHead {
+			// Replies to signed-in callers differ from anonymous ones
+			header.Add("Vary", "Authorization, Cookie")
+
+			// Replies that depend on the address of the caller, such as ones filtered by the
+			// country geo.Country found from it, are only cached by the caller
+			private := header.Get("Cache-Control") == geo.PrivateCacheControl
+
+			value, ok := "", false
+			if tr, found := transport.FromServerContext(r.Context()); found {
+				var rule *conf.Server_HTTP_CacheRule
+				if rule, ok = rules[tr.Operation()]; ok {
+					value = cacheControl(rule, private)
+				}
+			}
+			if !ok && hasCredentials(r) {
+				// Without a rule, shared caches could still store the reply heuristically
+				value, ok = "private, no-store", true
+			}
+			if ok {
+				header.Set("Cache-Control", value)
+			}
+
+			etag := strongETag(data, encoding)
+			header.Set("ETag", etag)
+
+			modified, hasModified := lastModified(pb.ProtoReflect())
+			if hasModified {
+				header.Set("Last-Modified", modified.UTC().Format(http2.TimeFormat))
+			}
+
+			if notModified(r, etag, modified, hasModified) {
+				header.Del("Content-Type")
+				w.WriteHeader(http2.StatusNotModified)
+				return nil
+			}
+		}
+
+		if encoding == "" {
+			_, err = w.Write(data)
+			return err
+		}
+
+		compressed, err := compressBody(data, encoding)
+		if err != nil {
+			return err
+		}
+
+		header.Set("Content-Encoding", encoding)
+		_, err = w.Write(compressed)
+		return err
+	}
+}
+
+// hasCredentials tells whether r is authenticated by a bearer token or may be by the
+// session cookie.
+func hasCredentials(r *http.Request) bool {
+	return r.Header.Get("Authorization") != "" || r.Header.Get("Cookie") != ""
+}
+
+// cacheControl renders rule, keeping the reply out of shared caches when private.
+func cacheControl(rule *conf.Server_HTTP_CacheRule, private bool) string {
+	private = private || rule.GetPrivate()
+	directives := []string{"public"}
+	if private {
+		directives[0] = "private"
+	}
+
+	if rule.GetMaxAge() != nil {
+		directives = append(directives, "max-age="+seconds(rule.GetMaxAge().AsDuration()))
+	}
+	if rule.GetSMaxage() != nil && !private {
+		directives = append(directives, "s-maxage="+seconds(rule.GetSMaxage().AsDuration()))
+	}
+	if rule.GetStaleWhileRevalidate() != nil {
+		directives = append(directives, "stale-while-revalidate="+seconds(rule.GetStaleWhileRevalidate().AsDuration()))
+	}
+
+	return strings.Join(directives, ", ")
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(d.Seconds()), 10)
+}
+
+// strongETag hashes the uncompressed body; compressed representations get their own tag.
+func strongETag(data []byte, encoding string) string {
+	sum := sha256.Sum256(data)
+	tag := hex.EncodeToString(sum[:16])
+	if encoding != "" {
+		tag += "-" + encoding
+	}
+
+	return `"` + tag + `"`
+}
+
+// lastModified returns the newest updated_at timestamp found anywhere in the response.
+func lastModified(m protoreflect.Message) (time.Time, bool) {
+	var latest time.Time
+	found := false
+
+	observe := func(t time.Time, ok bool) {
+		if ok && (!found || t.After(latest)) {
+			latest, found = t, true
+		}
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+			return true
+		}
+
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				observe(lastModified(list.Get(i).Message()))
+			}
+			return true
+		}
+
+		if ts, ok := v.Message().Interface().(*timestamppb.Timestamp); ok {
+			if fd.Name() == "updated_at" {
+				observe(ts.AsTime(), true)
+			}
+			return true
+		}
+
+		observe(lastModified(v.Message()))
+		return true
+	})
+
+	return latest, found
+}
+
+// notModified evaluates If-None-Match, falling back to If-Modified-Since as RFC 9110 requires.
+func notModified(r *http.Request, etag string, modified time.Time, hasModified bool) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && hasModified {
+		since, err := http2.ParseTime(ims)
+		return err == nil && !modified.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
+
+// negotiateEncoding prefers brotli over gzip and honours q=0 exclusions.
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := make(map[string]bool)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := strings.ReplaceAll(params, " ", "")
+		accepted[strings.ToLower(name)] = q != "q=0" && q != "q=0.0" && q != "q=0.00" && q != "q=0.000"
+	}
+
+	switch {
+	case accepted["br"]:
+		return "br"
+	case accepted["gzip"]:
+		return "gzip"
+	}
+
+	return ""
+}
+
+func compressBody(data []byte, encoding string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var err error
+	switch encoding {
+	case "br":
+		bw := brotli.NewWriterLevel(&buf, brotli.DefaultCompression)
+		if _, err = bw.Write(data); err == nil {
+			err = bw.Close()
+		}
+	default:
+		gw := gzip.NewWriter(&buf)
+		if _, err = gw.Write(data); err == nil {
+			err = gw.Close()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	http2 "net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	pb "thmanyah/api/grpc/v1"
+	"thmanyah/internal/conf"
+	"thmanyah/internal/geo"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestResponseEncoder_CacheControl(t *testing.T) {
+	encode := NewResponseEncoder(&conf.Server_HTTP{})
+
+	tests := []struct {
+		name   string
+		header http2.Header
+		want   string
+	}{
+		{name: "Anonymous", header: http2.Header{}, want: ""},
+		{name: "Bearer", header: http2.Header{"Authorization": {"Bearer token"}}, want: "private, no-store"},
+		{name: "Cookie", header: http2.Header{"Cookie": {"jwt=session"}}, want: "private, no-store"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http2.MethodGet, "/api/v1/cms/programs/1", nil)
+			r.Header = tt.header
+			w := httptest.NewRecorder()
+
+			require.NoError(t, encode(w, r, &pb.GetProgramResponse{}))
+			assert.Equal(t, tt.want, w.Header().Get("Cache-Control"))
+			assert.Contains(t, w.Header().Values("Vary"), "Authorization, Cookie")
+			assert.NotEmpty(t, w.Header().Get("ETag"))
+		})
+	}
+}
+
+// fakeResolver puts every address in SA.
+type fakeResolver struct{}
+
+func (fakeResolver) Country(netip.Addr) (string, bool) {
+	return "SA", true
+}
+
+func TestResponseEncoder_GeoFiltered(t *testing.T) {
+	encode := NewResponseEncoder(&conf.Server_HTTP{
+		CacheRules: []*conf.Server_HTTP_CacheRule{{
+			Operation:            "/featured",
+			MaxAge:               durationpb.New(time.Minute),
+			SMaxage:              durationpb.New(5 * time.Minute),
+			StaleWhileRevalidate: durationpb.New(10 * time.Minute),
+		}},
+	})
+	locator := geo.NewLocator(&conf.Server{
+		Geolocation: &conf.Server_Geolocation{CountryHeader: "CF-IPCountry"},
+	}, nil, fakeResolver{})
+
+	srv := khttp.NewServer(khttp.ResponseEncoder(encode), khttp.Middleware(locator.Server()))
+	srv.Route("/").GET("/featured", func(ctx khttp.Context) error {
+		h := ctx.Middleware(func(ctx context.Context, req any) (any, error) {
+			return &pb.FeaturedResponse{Programs: []*pb.Program{{Title: geo.Country(ctx)}}}, nil
+		})
+		out, err := h(ctx, nil)
+		if err != nil {
+			return err
+		}
+		return ctx.Result(http2.StatusOK, out)
+	})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	tests := []struct {
+		name         string
+		header       http2.Header
+		cacheControl string
+	}{
+		{
+			// The country of the CDN header is the same for everyone sharing the reply
+			name:         "CountryHeader",
+			header:       http2.Header{"Cf-Ipcountry": {"AE"}},
+			cacheControl: "public, max-age=60, s-maxage=300, stale-while-revalidate=600",
+		},
+		{
+			// Without it, the country comes from the address, which Vary cannot describe
+			name:         "ClientAddress",
+			header:       http2.Header{},
+			cacheControl: "private, max-age=60, stale-while-revalidate=600",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http2.NewRequest(http2.MethodGet, ts.URL+"/featured", nil)
+			require.NoError(t, err)
+			req.Header = tt.header
+
+			resp, err := http2.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, http2.StatusOK, resp.StatusCode)
+			assert.Equal(t, tt.cacheControl, resp.Header.Get("Cache-Control"))
+			assert.Contains(t, resp.Header.Values("Vary"), "CF-IPCountry")
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+	http2 "net/http"
+
+	v1 "thmanyah/api/grpc/v1"
+	"thmanyah/internal/modules/cms/service"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// chaptersContentType is the media type of Podcasting 2.0 JSON chapters, as podcast
+// feeds announce it.
+const chaptersContentType = "application/json+chapters"
+
+func registerChaptersExport(r *http.Router, cmsService *service.CmsService) {
+	r.GET("/api/v1/cms/episodes/{episode_id}/chapters.json", func(outerContext http.Context) error {
+		var req v1.ListChaptersRequest
+		if err := outerContext.BindVars(&req); err != nil {
+			return err
+		}
+
+		h := outerContext.Middleware(func(ctx context.Context, req any) (any, error) {
+			return cmsService.ExportChapters(ctx, req.(*v1.ListChaptersRequest).EpisodeId)
+		})
+
+		res, err := h(outerContext, &req)
+		if err != nil {
+			return err
+		}
+
+		return outerContext.Blob(http2.StatusOK, chaptersContentType, res.([]byte))
+	})
+}
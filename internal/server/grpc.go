@@ -1,26 +1,69 @@
 package server
 
 import (
+	"context"
+
 	v1 "thmanyah/api/grpc/v1"
 	"thmanyah/internal/conf"
+	"thmanyah/internal/geo"
+	"thmanyah/internal/i18n"
 	"thmanyah/internal/modules/cms/service"
 	discover "thmanyah/internal/modules/discover/service"
+	"thmanyah/internal/observability"
+	"thmanyah/internal/validation"
+	"thmanyah/keys"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	grpcgo "google.golang.org/grpc"
 )
 
 func NewGRPCServer(
 	c *conf.Server,
+	keysStore *keys.Store,
 	authService *service.AuthService,
 	cmsService *service.CmsService,
+	webhookService *service.WebhookService,
 	discoverService *discover.DiscoverService,
+	auditor *service.Auditor,
+	translator *i18n.Translator,
+	locator *geo.Locator,
+	m *observability.Metrics,
+	t *observability.Tracing,
+	accessLog *observability.AccessLog,
 	_ log.Logger,
 ) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			tracing.Server(t.ServerOptions()...),
+			metrics.Server(m.ServerOptions()...),
+			observability.RequestID(),
+			translator.Server(),
+			locator.Server(),
+			accessLog.Server(),
+			validation.Validator(),
+			JWTMiddleware(keysStore),
+			accessLog.RecordPrincipal(),
+			auditor.Server(),
+		),
+		grpc.StreamInterceptor(streamMiddleware(
+			recovery.Recovery(),
+			observability.RequestID(),
+			translator.Server(),
+			locator.Server(),
+			accessLog.Server(),
+			JWTMiddleware(keysStore),
+			accessLog.RecordPrincipal(),
+		)),
+		// Runs outside the kratos interceptor, which can only attach ErrorInfo to statuses
+		grpc.Options(
+			grpcgo.UnaryInterceptor(validation.UnaryServerInterceptor()),
+			grpcgo.StreamInterceptor(validation.StreamServerInterceptor()),
 		),
 	}
 	if c.Grpc.Addr != "" {
@@ -32,6 +75,29 @@ func NewGRPCServer(
 	srv := grpc.NewServer(opts...)
 	v1.RegisterAuthServiceServer(srv, authService)
 	v1.RegisterCmsServiceServer(srv, cmsService)
+	v1.RegisterWebhookServiceServer(srv, webhookService)
 	v1.RegisterDiscoverServiceServer(srv, discoverService)
 	return srv
 }
+
+// streamMiddleware runs middleware once around a streaming handler, which then sees the
+// context they hand down, e.g. with the caller's claims. grpc.StreamMiddleware does not
+// do this: kratos only runs it around each message sent or received.
+func streamMiddleware(m ...middleware.Middleware) grpcgo.StreamServerInterceptor {
+	chain := middleware.Chain(m...)
+	return func(srv any, ss grpcgo.ServerStream, _ *grpcgo.StreamServerInfo, handler grpcgo.StreamHandler) error {
+		_, err := chain(func(ctx context.Context, _ any) (any, error) {
+			return nil, handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		})(ss.Context(), nil)
+		return err
+	}
+}
+
+type contextStream struct {
+	grpcgo.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
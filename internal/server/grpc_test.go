@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "thmanyah/api/grpc/v1"
+	"thmanyah/internal/conf"
+	"thmanyah/internal/geo"
+	"thmanyah/internal/i18n"
+	"thmanyah/internal/modules/cms/biz"
+	"thmanyah/internal/modules/cms/service"
+	discover "thmanyah/internal/modules/discover/service"
+	"thmanyah/internal/observability"
+	"thmanyah/internal/utils"
+	"thmanyah/keys"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeImportRepo returns the imports in turn on each GetByID, then the last one again.
+type fakeImportRepo struct {
+	biz.ImportRepository
+
+	mu      sync.Mutex
+	imports []*biz.ImportData
+}
+
+func (r *fakeImportRepo) GetByID(ctx context.Context, id uuid.UUID) (*biz.ImportData, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := *r.imports[0]
+	if len(r.imports) > 1 {
+		r.imports = r.imports[1:]
+	}
+	return &current, nil
+}
+
+// fakeImportNotifier signals every change it is handed.
+type fakeImportNotifier struct {
+	changes chan struct{}
+}
+
+func (n *fakeImportNotifier) Subscribe(uuid.UUID) (<-chan struct{}, func()) {
+	return n.changes, func() {}
+}
+
+func startGRPCServer(t *testing.T, store *keys.Store, uc *biz.UseCase) v1.CmsServiceClient {
+	t.Helper()
+
+	c := &conf.Server{Grpc: &conf.Server_GRPC{Addr: "127.0.0.1:0"}}
+	translator, err := i18n.NewTranslator(c)
+	require.NoError(t, err)
+	m, _, err := observability.NewMetrics(&conf.Observability{})
+	require.NoError(t, err)
+	tr, cleanup, err := observability.NewTracing(&conf.Observability{})
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	srv := NewGRPCServer(c, store,
+		&service.AuthService{},
+		service.NewCmsService(uc),
+		&service.WebhookService{},
+		&discover.DiscoverService{},
+		service.NewAuditor(uc),
+		translator,
+		geo.NewLocator(c, nil, nil),
+		m, tr,
+		observability.NewAccessLog(&conf.Observability{}, nil, log.DefaultLogger),
+		log.DefaultLogger,
+	)
+	endpoint, err := srv.Endpoint()
+	require.NoError(t, err)
+
+	go func() { _ = srv.Start(context.Background()) }()
+	t.Cleanup(func() { _ = srv.Stop(context.Background()) })
+
+	conn, err := grpc.NewClient(endpoint.Host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return v1.NewCmsServiceClient(conn)
+}
+
+func TestGRPC_WatchImport(t *testing.T) {
+	store := keys.NewKeyStore()
+	userID := uuid.New()
+	importID := uuid.New()
+
+	processing := &biz.ImportData{ID: importID, CreatedBy: userID, Status: biz.ImportStatusProcessing, TotalItems: 2}
+	halfway := &biz.ImportData{ID: importID, CreatedBy: userID, Status: biz.ImportStatusProcessing, TotalItems: 2, ProcessedItems: 1, SuccessCount: 1}
+	completed := &biz.ImportData{ID: importID, CreatedBy: userID, Status: biz.ImportStatusCompleted, TotalItems: 2, ProcessedItems: 2, SuccessCount: 2}
+
+	notifier := &fakeImportNotifier{changes: make(chan struct{}, 2)}
+	notifier.changes <- struct{}{}
+	notifier.changes <- struct{}{}
+
+	uc, err := biz.NewUseCase(nil, nil, nil, nil,
+		&fakeImportRepo{imports: []*biz.ImportData{processing, halfway, completed}},
+		nil, store, nil, notifier,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		noop.NewMeterProvider().Meter("test"), log.DefaultLogger)
+	require.NoError(t, err)
+
+	client := startGRPCServer(t, store, uc)
+	req := &v1.WatchImportRequest{ImportId: importID.String()}
+
+	t.Run("Authenticated", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, utils.NewClaimsBuilder().WithUserID(userID.String()).Build()).
+			SignedString(store.PrivateKey())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+
+		stream, err := client.WatchImport(ctx, req)
+		require.NoError(t, err)
+
+		var statuses []v1.ImportStatus
+		var processed []int32
+		for {
+			event, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			assert.Equal(t, importID.String(), event.ImportId)
+			statuses = append(statuses, event.Status)
+			processed = append(processed, event.ProcessedItems)
+		}
+
+		require.NotEmpty(t, statuses)
+		assert.Equal(t, v1.ImportStatus_IMPORT_STATUS_COMPLETED, statuses[len(statuses)-1])
+		assert.Equal(t, []int32{0, 1, 2}, processed)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		stream, err := client.WatchImport(ctx, req)
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
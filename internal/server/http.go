@@ -10,18 +10,24 @@ import (
 	v1 "thmanyah/api/grpc/v1"
 	"thmanyah/embeds"
 	"thmanyah/internal/conf"
+	"thmanyah/internal/geo"
+	"thmanyah/internal/gql"
+	"thmanyah/internal/i18n"
 	"thmanyah/internal/modules/cms/service"
 	discover "thmanyah/internal/modules/discover/service"
+	"thmanyah/internal/observability"
 	"thmanyah/internal/utils"
+	"thmanyah/internal/validation"
 	"thmanyah/keys"
 	"thmanyah/third_party/swaggerui"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
-	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport"
 	jwt2 "github.com/golang-jwt/jwt/v5"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -57,11 +63,11 @@ func JWTMiddleware(keysStore *keys.Store) middleware.Middleware {
 			func(token *jwt2.Token) (interface{}, error) {
 				claims, ok := token.Claims.(jwt2.MapClaims)
 				if !ok {
-					return nil, errors.Unauthorized("unauthorized", "Invalid token")
+					return nil, errors.Unauthorized("UNAUTHORIZED", "Invalid token")
 				}
 
 				if claims["user_id"] == "" {
-					return nil, errors.Unauthorized("unauthorized", "Invalid token")
+					return nil, errors.Unauthorized("UNAUTHORIZED", "Invalid token")
 				}
 
 				return keysStore.PublicKey(), nil
@@ -79,23 +85,42 @@ func NewHTTPServer(
 	keysStore *keys.Store,
 	authService *service.AuthService,
 	cmsservice *service.CmsService,
+	webhookService *service.WebhookService,
 	discoverService *discover.DiscoverService,
+	auditor *service.Auditor,
+	graphqlHandler *gql.Handler,
+	translator *i18n.Translator,
+	locator *geo.Locator,
+	m *observability.Metrics,
+	t *observability.Tracing,
+	accessLog *observability.AccessLog,
 	logger log.Logger,
 ) *http.Server {
 	h := log.NewHelper(logger)
+	encodeError := validation.ErrorEncoder(observability.ErrorEncoder)
 
 	var opts = []http.ServerOption{
 		http.Middleware(
+			tracing.Server(t.ServerOptions()...),
+			metrics.Server(m.ServerOptions()...),
+			observability.RequestID(),
+			translator.Server(),
+			locator.Server(),
+			accessLog.Server(),
 			ratelimit.Server(),
+			NewCSRFMiddleware(c.Http.GetCsrf(), WithCookieName("jwt")),
 			NewCookieAuthMiddleware(h),
 			NewWebLoginMiddleware(
 				keysStore,
 				WithCookieName("jwt"),
 				WithCookieMaxAge(86400),
+				WithCSRF(c.Http.GetCsrf()),
 			),
 			recovery.Recovery(),
-			validate.Validator(),
+			validation.Validator(),
 			JWTMiddleware(keysStore),
+			accessLog.RecordPrincipal(),
+			auditor.Server(),
 			func(handler middleware.Handler) middleware.Handler {
 				return func(ctx context.Context, req any) (any, error) {
 					res, err := handler(ctx, req)
@@ -107,6 +132,14 @@ func NewHTTPServer(
 				}
 			},
 		),
+		http.Filter(
+			NewCORSFilter(c.Http, h),
+			NewSecurityHeadersFilter(c.Http.GetSecurityHeaders()),
+			observability.RequestIDFilter(),
+		),
+		http.ErrorEncoder(func(w http2.ResponseWriter, r *http2.Request, err error) {
+			encodeError(w, r, translator.HTTPError(r, err))
+		}),
 	}
 	if c.Http.Network != "" {
 		opts = append(opts, http.Network(c.Http.Network))
@@ -118,7 +151,7 @@ func NewHTTPServer(
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 
-	opts = append(opts, http.ResponseEncoder(CustomResponseEncoder))
+	opts = append(opts, http.ResponseEncoder(NewResponseEncoder(c.Http)))
 	srv := http.NewServer(
 		opts...,
 	)
@@ -127,6 +160,10 @@ func NewHTTPServer(
 
 	srv.HandlePrefix("/q/", openAPIHandler)
 
+	if m.Handler != nil {
+		srv.Handle(m.Path, m.Handler)
+	}
+
 	r := srv.Route("/")
 
 	r.PUT("/api/v1/cms/episodes/upload", func(outerContext http.Context) error {
@@ -136,7 +173,7 @@ func NewHTTPServer(
 				return nil, err
 			}
 
-			return authService.UpdateAvatar(outerContext, userId)
+			return authService.UpdateAvatar(ctx, outerContext.Request(), userId)
 		})
 
 		res, err := h(outerContext, nil)
@@ -152,8 +189,34 @@ func NewHTTPServer(
 		return outerContext.JSON(http2.StatusOK, response)
 	})
 
+	if graphqlHandler.Enabled() {
+		r.POST(graphqlHandler.Path(), func(outerContext http.Context) error {
+			var req gql.Request
+			if err := outerContext.Bind(&req); err != nil {
+				return errors.BadRequest("INVALID_GRAPHQL_REQUEST", err.Error())
+			}
+
+			h := outerContext.Middleware(func(ctx context.Context, req any) (any, error) {
+				return graphqlHandler.Execute(ctx, req.(*gql.Request)), nil
+			})
+
+			res, err := h(outerContext, &req)
+			if err != nil {
+				return err
+			}
+
+			return outerContext.JSON(http2.StatusOK, res)
+		})
+	}
+
+	registerImportEvents(r, cmsservice)
+	registerAuditExport(r, cmsservice)
+	registerChaptersExport(r, cmsservice)
+	registerTranscriptFiles(r, cmsservice)
+
 	v1.RegisterAuthServiceHTTPServer(srv, authService)
 	v1.RegisterCmsServiceHTTPServer(srv, cmsservice)
+	v1.RegisterWebhookServiceHTTPServer(srv, webhookService)
 	v1.RegisterDiscoverServiceHTTPServer(srv, discoverService)
 
 	return srv
@@ -165,6 +228,12 @@ func JWTWhiteListMatcher() selector.MatchFunc {
 	whiteList["/thmanyah.v1.AuthService/Login"] = true
 	whiteList["/thmanyah.v1.DiscoverService/Search"] = true
 	whiteList["/thmanyah.v1.DiscoverService/Featured"] = true
+	whiteList["/thmanyah.v1.DiscoverService/BatchGetPrograms"] = true
+	whiteList["/thmanyah.v1.DiscoverService/BatchGetEpisodes"] = true
+	whiteList["/thmanyah.v1.DiscoverService/BatchGetCategories"] = true
+	whiteList["/thmanyah.v1.DiscoverService/ListProgramSeasons"] = true
+	whiteList["/thmanyah.v1.DiscoverService/GetPersonPage"] = true
+	whiteList["/api/v1/discover/episodes/{episode_id}/transcripts/{locale}"] = true
 
 	return func(ctx context.Context, operation string) bool {
 		return !whiteList[operation]
@@ -272,10 +341,16 @@ func NewWebLoginMiddleware(keyStore *keys.Store, opts ...Option) middleware.Midd
 				}
 
 				tr.ReplyHeader().Set("Set-Cookie", cookie.String())
-				tr.ReplyHeader().Set("X-Content-Type-Options", "nosniff")
-				tr.ReplyHeader().Set("X-Frame-Options", "DENY")
 				tr.ReplyHeader().Set("Cache-Control", "no-store")
 
+				if options.csrf.GetEnabled() {
+					csrfCookie, err := newCSRFCookie(options.csrf, cookie)
+					if err != nil {
+						return res, errors.InternalServer("generate csrf token failed", err.Error())
+					}
+					tr.ReplyHeader().Add("Set-Cookie", csrfCookie.String())
+				}
+
 			}
 
 			return res, err
@@ -290,6 +365,7 @@ type Options struct {
 	secureCookie bool
 	cookieDomain string
 	cookiePath   string
+	csrf         *conf.Server_HTTP_CSRF
 }
 
 type Option func(*Options)
@@ -306,6 +382,12 @@ func WithCookieMaxAge(maxAge int) Option {
 	}
 }
 
+func WithCSRF(csrf *conf.Server_HTTP_CSRF) Option {
+	return func(o *Options) {
+		o.csrf = csrf
+	}
+}
+
 func getSameSiteMode(isProduction bool) http2.SameSite {
 	if isProduction {
 		return http2.SameSiteStrictMode
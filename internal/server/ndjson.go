@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	http2 "net/http"
+
+	v1 "thmanyah/api/grpc/v1"
+	"thmanyah/internal/modules/cms/service"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// ndjsonWriter writes one JSON document per line. Like sseWriter, it sends the headers
+// with the first line so that failures before it are answered with a regular error.
+type ndjsonWriter struct {
+	w       http2.ResponseWriter
+	started bool
+}
+
+func (n *ndjsonWriter) Send(data []byte) error {
+	if !n.started {
+		n.started = true
+		n.w.Header().Set("Content-Type", "application/x-ndjson")
+		n.w.Header().Set("Cache-Control", "no-store")
+		n.w.WriteHeader(http2.StatusOK)
+	}
+
+	if _, err := n.w.Write(data); err != nil {
+		return err
+	}
+	_, err := n.w.Write([]byte("\n"))
+	return err
+}
+
+// exportAuditEvents serves every audit event the request matches as NDJSON, newest
+// first. An export without matches is an empty body.
+func exportAuditEvents(ctx context.Context, w http2.ResponseWriter, cmsService *service.CmsService, req *v1.ListAuditEventsRequest) error {
+	out := &ndjsonWriter{w: w}
+
+	err := cmsService.ExportAuditEvents(ctx, req, func(event *v1.AuditEvent) error {
+		data, err := jsonMarshalOptions.Marshal(event)
+		if err != nil {
+			return err
+		}
+		return out.Send(data)
+	})
+	if !out.started {
+		if err != nil {
+			return err
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http2.StatusOK)
+		return nil
+	}
+	// Once streaming, there is no response left to report an error on; the export is cut short
+	return nil
+}
+
+func registerAuditExport(r *http.Router, cmsService *service.CmsService) {
+	r.GET("/api/v1/cms/audit/events/export", func(outerContext http.Context) error {
+		var req v1.ListAuditEventsRequest
+		if err := outerContext.BindQuery(&req); err != nil {
+			return err
+		}
+
+		h := outerContext.Middleware(func(ctx context.Context, req any) (any, error) {
+			return nil, exportAuditEvents(ctx, outerContext.Response(), cmsService, req.(*v1.ListAuditEventsRequest))
+		})
+
+		_, err := h(outerContext, &req)
+		return err
+	})
+}
@@ -0,0 +1,23 @@
+package server
+
+import (
+	http2 "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNDJSONWriter(t *testing.T) {
+	w := httptest.NewRecorder()
+	out := &ndjsonWriter{w: w}
+
+	require.NoError(t, out.Send([]byte(`{"operation":"DeleteProgram"}`)))
+	require.NoError(t, out.Send([]byte(`{"operation":"CreateProgram"}`)))
+
+	assert.Equal(t, http2.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "{\"operation\":\"DeleteProgram\"}\n{\"operation\":\"CreateProgram\"}\n", w.Body.String())
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	http2 "net/http"
+	"slices"
+	"strconv"
+
+	"thmanyah/internal/conf"
+	"thmanyah/internal/observability"
+	"thmanyah/internal/utils"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/gorilla/handlers"
+)
+
+const (
+	defaultCSRFCookieName = "csrf_token"
+	defaultCSRFHeaderName = "X-CSRF-Token"
+
+	defaultContentSecurityPolicy = "default-src 'self'; script-src 'self' https://cdnjs.cloudflare.com; " +
+		"style-src 'self' 'unsafe-inline'; img-src 'self' data:; frame-ancestors 'none'"
+	defaultFrameOptions   = "DENY"
+	defaultReferrerPolicy = "strict-origin-when-cross-origin"
+)
+
+var defaultCORSMethods = []string{
+	http2.MethodGet, http2.MethodHead, http2.MethodPost, http2.MethodPut, http2.MethodPatch, http2.MethodDelete,
+}
+
+// NewCORSFilter answers preflight requests and adds the CORS headers for allowed origins.
+// A wildcard origin cannot be combined with credentials, so credentials are dropped in that case.
+func NewCORSFilter(h *conf.Server_HTTP, logger *log.Helper) http.FilterFunc {
+	c := h.GetCors()
+	methods := c.GetAllowedMethods()
+	if len(methods) == 0 {
+		methods = defaultCORSMethods
+	}
+
+	opts := []handlers.CORSOption{
+		handlers.AllowedOrigins(c.GetAllowedOrigins()),
+		handlers.AllowedMethods(methods),
+		handlers.AllowedHeaders(append([]string{"Content-Type", "Authorization", observability.RequestIDHeader, csrfHeaderName(h.GetCsrf())}, c.GetAllowedHeaders()...)),
+		handlers.ExposedHeaders(append([]string{observability.RequestIDHeader, "ETag", "Last-Modified"}, c.GetExposedHeaders()...)),
+	}
+
+	if c.GetAllowCredentials() {
+		if slices.Contains(c.GetAllowedOrigins(), "*") {
+			logger.Warn("cors: ignoring allow_credentials with a wildcard origin")
+		} else {
+			opts = append(opts, handlers.AllowCredentials())
+		}
+	}
+
+	if c.GetMaxAge() != nil {
+		opts = append(opts, handlers.MaxAge(int(c.GetMaxAge().AsDuration().Seconds())))
+	}
+
+	return handlers.CORS(opts...)
+}
+
+// NewSecurityHeadersFilter sets CSP, HSTS, framing and referrer headers on every response.
+func NewSecurityHeadersFilter(c *conf.Server_HTTP_SecurityHeaders) http.FilterFunc {
+	csp := c.GetContentSecurityPolicy()
+	if csp == "" {
+		csp = defaultContentSecurityPolicy
+	}
+	frameOptions := c.GetFrameOptions()
+	if frameOptions == "" {
+		frameOptions = defaultFrameOptions
+	}
+	referrerPolicy := c.GetReferrerPolicy()
+	if referrerPolicy == "" {
+		referrerPolicy = defaultReferrerPolicy
+	}
+
+	hsts := ""
+	if c.GetHstsMaxAge() != nil {
+		hsts = "max-age=" + strconv.Itoa(int(c.GetHstsMaxAge().AsDuration().Seconds())) + "; includeSubDomains"
+	}
+
+	return func(next http2.Handler) http2.Handler {
+		return http2.HandlerFunc(func(w http2.ResponseWriter, r *http2.Request) {
+			header := w.Header()
+			header.Set("Content-Security-Policy", csp)
+			header.Set("X-Content-Type-Options", "nosniff")
+			header.Set("X-Frame-Options", frameOptions)
+			header.Set("Referrer-Policy", referrerPolicy)
+			if hsts != "" {
+				header.Set("Strict-Transport-Security", hsts)
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// NewCSRFMiddleware rejects mutating requests authenticated by the session cookie unless
+// they echo the CSRF cookie in the CSRF header (double submit). Bearer token requests are
+// not exposed to CSRF and pass through. It must run before NewCookieAuthMiddleware, which
+// turns the cookie into an Authorization header.
+func NewCSRFMiddleware(c *conf.Server_HTTP_CSRF, opts ...Option) middleware.Middleware {
+	options := &Options{cookieName: "jwt"}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	cookieName := csrfCookieName(c)
+	headerName := csrfHeaderName(c)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if !c.GetEnabled() {
+				return handler(ctx, req)
+			}
+
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, errors.InternalServer("invalid transport", "invalid transport")
+			}
+
+			ht, ok := tr.(http.Transporter)
+			if !ok || isSafeMethod(ht.Request().Method) || tr.RequestHeader().Get("Authorization") != "" {
+				return handler(ctx, req)
+			}
+
+			cookies := utils.ParseCookies(tr.RequestHeader().Get("Cookie"))
+			if _, exists := cookies[options.cookieName]; !exists {
+				return handler(ctx, req)
+			}
+
+			expected := cookies[cookieName]
+			actual := tr.RequestHeader().Get(headerName)
+			if expected == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) != 1 {
+				return nil, errors.Forbidden("CSRF_TOKEN_MISMATCH", "missing or invalid CSRF token")
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// newCSRFCookie issues the token the browser client must echo in the CSRF header.
+// It is readable from scripts on purpose, unlike the session cookie.
+func newCSRFCookie(c *conf.Server_HTTP_CSRF, session *http2.Cookie) (*http2.Cookie, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	return &http2.Cookie{
+		Name:     csrfCookieName(c),
+		Value:    base64.RawURLEncoding.EncodeToString(token),
+		Path:     session.Path,
+		MaxAge:   session.MaxAge,
+		Secure:   session.Secure,
+		SameSite: session.SameSite,
+	}, nil
+}
+
+func csrfCookieName(c *conf.Server_HTTP_CSRF) string {
+	if c.GetCookieName() != "" {
+		return c.GetCookieName()
+	}
+	return defaultCSRFCookieName
+}
+
+func csrfHeaderName(c *conf.Server_HTTP_CSRF) string {
+	if c.GetHeaderName() != "" {
+		return c.GetHeaderName()
+	}
+	return defaultCSRFHeaderName
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http2.MethodGet, http2.MethodHead, http2.MethodOptions:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	http2 "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"thmanyah/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/stretchr/testify/assert"
+)
+
+// serveThrough runs a request through a kratos HTTP server with only m in its chain and
+// returns the status code.
+func serveThrough(m middleware.Middleware, method string, header http2.Header) int {
+	srv := http.NewServer(http.Middleware(m))
+	handler := func(ctx http.Context) error {
+		h := ctx.Middleware(func(context.Context, any) (any, error) { return "ok", nil })
+		if _, err := h(ctx, nil); err != nil {
+			return err
+		}
+		return ctx.String(http2.StatusOK, "ok")
+	}
+	r := srv.Route("/")
+	r.GET("/test", handler)
+	r.POST("/test", handler)
+
+	req := httptest.NewRequest(method, "/test", nil)
+	req.Header = header
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestCSRFMiddleware(t *testing.T) {
+	enabled := &conf.Server_HTTP_CSRF{Enabled: true}
+	custom := &conf.Server_HTTP_CSRF{Enabled: true, CookieName: "xsrf", HeaderName: "X-XSRF"}
+
+	tests := []struct {
+		name   string
+		conf   *conf.Server_HTTP_CSRF
+		method string
+		header http2.Header
+		want   int
+	}{
+		{
+			name:   "disabled",
+			conf:   &conf.Server_HTTP_CSRF{},
+			method: http2.MethodPost,
+			header: http2.Header{"Cookie": {"jwt=session"}},
+			want:   http2.StatusOK,
+		},
+		{
+			name:   "safe method",
+			conf:   enabled,
+			method: http2.MethodGet,
+			header: http2.Header{"Cookie": {"jwt=session"}},
+			want:   http2.StatusOK,
+		},
+		{
+			name:   "bearer token",
+			conf:   enabled,
+			method: http2.MethodPost,
+			header: http2.Header{"Cookie": {"jwt=session"}, "Authorization": {"Bearer token"}},
+			want:   http2.StatusOK,
+		},
+		{
+			name:   "no session cookie",
+			conf:   enabled,
+			method: http2.MethodPost,
+			header: http2.Header{"Cookie": {"csrf_token=abc"}},
+			want:   http2.StatusOK,
+		},
+		{
+			name:   "token echoed",
+			conf:   enabled,
+			method: http2.MethodPost,
+			header: http2.Header{"Cookie": {"jwt=session; csrf_token=abc"}, "X-Csrf-Token": {"abc"}},
+			want:   http2.StatusOK,
+		},
+		{
+			name:   "token mismatch",
+			conf:   enabled,
+			method: http2.MethodPost,
+			header: http2.Header{"Cookie": {"jwt=session; csrf_token=abc"}, "X-Csrf-Token": {"abd"}},
+			want:   http2.StatusForbidden,
+		},
+		{
+			name:   "header missing",
+			conf:   enabled,
+			method: http2.MethodPost,
+			header: http2.Header{"Cookie": {"jwt=session; csrf_token=abc"}},
+			want:   http2.StatusForbidden,
+		},
+		{
+			name:   "cookie missing",
+			conf:   enabled,
+			method: http2.MethodPost,
+			header: http2.Header{"Cookie": {"jwt=session"}, "X-Csrf-Token": {""}},
+			want:   http2.StatusForbidden,
+		},
+		{
+			name:   "custom names",
+			conf:   custom,
+			method: http2.MethodPost,
+			header: http2.Header{"Cookie": {"jwt=session; xsrf=abc"}, "X-Xsrf": {"abc"}},
+			want:   http2.StatusOK,
+		},
+		{
+			name:   "custom names ignore defaults",
+			conf:   custom,
+			method: http2.MethodPost,
+			header: http2.Header{"Cookie": {"jwt=session; csrf_token=abc"}, "X-Csrf-Token": {"abc"}},
+			want:   http2.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, serveThrough(NewCSRFMiddleware(tt.conf), tt.method, tt.header))
+		})
+	}
+}
+
+func TestCSRFMiddleware_SessionCookieName(t *testing.T) {
+	m := NewCSRFMiddleware(&conf.Server_HTTP_CSRF{Enabled: true}, WithCookieName("session"))
+
+	assert.Equal(t, http2.StatusForbidden, serveThrough(m, http2.MethodPost, http2.Header{"Cookie": {"session=abc"}}))
+	assert.Equal(t, http2.StatusOK, serveThrough(m, http2.MethodPost, http2.Header{"Cookie": {"jwt=abc"}}))
+}
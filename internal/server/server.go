@@ -1,10 +1,19 @@
 package server
 
 import (
+	"thmanyah/internal/conf"
+	"thmanyah/internal/utils"
+
 	"github.com/google/wire"
 )
 
 var ProviderSet = wire.NewSet(
 	NewGRPCServer,
 	NewHTTPServer,
+	NewTrustedProxies,
 )
+
+// NewTrustedProxies reads the proxies whose reports of client addresses are believed.
+func NewTrustedProxies(c *conf.Server) (utils.TrustedProxies, error) {
+	return utils.ParseTrustedProxies(c.GetTrustedProxies())
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	http2 "net/http"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "thmanyah/api/grpc/v1"
+	"thmanyah/internal/modules/cms/service"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const sseKeepAliveInterval = 15 * time.Second
+
+// sseWriter writes server-sent events. Headers go out with the first event so that
+// failures before it are still answered with a regular error response.
+type sseWriter struct {
+	mu      sync.Mutex
+	w       http2.ResponseWriter
+	rc      *http2.ResponseController
+	started bool
+	lastID  int
+}
+
+func newSSEWriter(w http2.ResponseWriter) *sseWriter {
+	return &sseWriter{w: w, rc: http2.NewResponseController(w)}
+}
+
+func (s *sseWriter) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+
+	header := s.w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	// Stop reverse proxies from buffering the stream
+	header.Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http2.StatusOK)
+}
+
+func (s *sseWriter) Send(event string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.start()
+	s.lastID++
+	if _, err := fmt.Fprintf(s.w, "id: %d\nevent: %s\ndata: %s\n\n", s.lastID, event, data); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// KeepAlive sends a comment so idle connections are not closed by proxies.
+func (s *sseWriter) KeepAlive() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		return nil
+	}
+	if _, err := fmt.Fprint(s.w, ": keep-alive\n\n"); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+func (s *sseWriter) Started() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.started
+}
+
+// streamImportEvents serves WatchImport as text/event-stream. The event name is the
+// lower-case event type (progress, warning or error) and the data its JSON encoding.
+// The stream ends with the import or at the server timeout, after which EventSource
+// clients reconnect and receive the current state again.
+func streamImportEvents(ctx context.Context, w http2.ResponseWriter, cmsService *service.CmsService, req *v1.WatchImportRequest) error {
+	sse := newSSEWriter(w)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(sseKeepAliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				_ = sse.KeepAlive()
+			}
+		}
+	}()
+
+	err := cmsService.WatchImportEvents(ctx, req, func(event *v1.ImportEvent) error {
+		data, err := jsonMarshalOptions.Marshal(event)
+		if err != nil {
+			return err
+		}
+		name := strings.ToLower(strings.TrimPrefix(event.Type.String(), "IMPORT_EVENT_TYPE_"))
+		return sse.Send(name, data)
+	})
+	if !sse.Started() {
+		return err
+	}
+	// Once streaming, there is no response left to report an error on; the client reconnects
+	return nil
+}
+
+func registerImportEvents(r *http.Router, cmsService *service.CmsService) {
+	r.GET("/api/v1/cms/imports/{import_id}/events", func(outerContext http.Context) error {
+		var req v1.WatchImportRequest
+		if err := outerContext.BindVars(&req); err != nil {
+			return err
+		}
+
+		h := outerContext.Middleware(func(ctx context.Context, req any) (any, error) {
+			return nil, streamImportEvents(ctx, outerContext.Response(), cmsService, req.(*v1.WatchImportRequest))
+		})
+
+		_, err := h(outerContext, &req)
+		return err
+	})
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	http2 "net/http"
+
+	"thmanyah/internal/modules/cms/biz"
+	"thmanyah/internal/modules/cms/service"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// transcriptFileRequest asks for the transcript of an episode in a locale, converted to
+// format (vtt, srt, txt or json) unless it is empty.
+type transcriptFileRequest struct {
+	EpisodeID string `json:"episode_id"`
+	Locale    string `json:"locale"`
+	Format    string `json:"format"`
+}
+
+// registerTranscriptFiles serves transcript files: to the CMS for any episode, and
+// publicly, through discover, for published episodes available to the caller only.
+func registerTranscriptFiles(r *http.Router, cmsService *service.CmsService) {
+	r.GET("/api/v1/cms/episodes/{episode_id}/transcripts/{locale}/file", transcriptFileHandler(cmsService, false))
+	r.GET("/api/v1/discover/episodes/{episode_id}/transcripts/{locale}", transcriptFileHandler(cmsService, true))
+}
+
+func transcriptFileHandler(cmsService *service.CmsService, public bool) http.HandlerFunc {
+	return func(outerContext http.Context) error {
+		var req transcriptFileRequest
+		if err := outerContext.BindVars(&req); err != nil {
+			return err
+		}
+		if err := outerContext.BindQuery(&req); err != nil {
+			return err
+		}
+
+		h := outerContext.Middleware(func(ctx context.Context, req any) (any, error) {
+			r := req.(*transcriptFileRequest)
+			return cmsService.RenderTranscript(ctx, r.EpisodeID, r.Locale, r.Format, public)
+		})
+
+		res, err := h(outerContext, &req)
+		if err != nil {
+			return err
+		}
+
+		transcript := res.(*biz.RenderedTranscript)
+		return outerContext.Blob(http2.StatusOK, transcript.Format.ContentType(), transcript.Content)
+	}
+}
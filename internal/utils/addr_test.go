@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := map[string]bool{
+		"8.8.8.8":              true,
+		"2606:4700:4700::1111": true,
+		"127.0.0.1":            false,
+		"::1":                  false,
+		"::ffff:127.0.0.1":     false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"169.254.169.254":      false,
+		"fe80::1":              false,
+		"fc00::1":              false,
+		"0.0.0.0":              false,
+		"::":                   false,
+		"100.64.0.1":           false,
+		"224.0.0.1":            false,
+		"64:ff9b::7f00:1":      false,
+		"255.255.255.255":      false,
+	}
+
+	for addr, want := range tests {
+		assert.Equal(t, want, IsPublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestDialPublicOnly(t *testing.T) {
+	assert.NoError(t, DialPublicOnly("tcp", "8.8.8.8:443", nil))
+	assert.Error(t, DialPublicOnly("tcp", "127.0.0.1:8000", nil))
+	assert.Error(t, DialPublicOnly("tcp6", "[::1]:8000", nil))
+	assert.Error(t, DialPublicOnly("tcp", "169.254.169.254:80", nil))
+}
@@ -1,6 +1,10 @@
 package convert
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	v1 "thmanyah/api/grpc/v1"
 	"thmanyah/internal/modules/cms/biz"
@@ -29,12 +33,16 @@ var (
 		v1.ProgramStatus_PROGRAM_STATUS_DRAFT:     biz.ProgramStatusDraft,
 		v1.ProgramStatus_PROGRAM_STATUS_PUBLISHED: biz.ProgramStatusPublished,
 		v1.ProgramStatus_PROGRAM_STATUS_ARCHIVED:  biz.ProgramStatusArchived,
+		v1.ProgramStatus_PROGRAM_STATUS_IN_REVIEW: biz.ProgramStatusInReview,
+		v1.ProgramStatus_PROGRAM_STATUS_APPROVED:  biz.ProgramStatusApproved,
 	}
 
 	BizToProtoProgramStatus = map[biz.ProgramStatus]v1.ProgramStatus{
 		biz.ProgramStatusDraft:     v1.ProgramStatus_PROGRAM_STATUS_DRAFT,
 		biz.ProgramStatusPublished: v1.ProgramStatus_PROGRAM_STATUS_PUBLISHED,
 		biz.ProgramStatusArchived:  v1.ProgramStatus_PROGRAM_STATUS_ARCHIVED,
+		biz.ProgramStatusInReview:  v1.ProgramStatus_PROGRAM_STATUS_IN_REVIEW,
+		biz.ProgramStatusApproved:  v1.ProgramStatus_PROGRAM_STATUS_APPROVED,
 	}
 
 	ProtoToBizEpisodeStatus = map[v1.EpisodeStatus]biz.EpisodeStatus{
@@ -42,6 +50,8 @@ var (
 		v1.EpisodeStatus_EPISODE_STATUS_PUBLISHED: biz.EpisodeStatusPublished,
 		v1.EpisodeStatus_EPISODE_STATUS_SCHEDULED: biz.EpisodeStatusScheduled,
 		v1.EpisodeStatus_EPISODE_STATUS_ARCHIVED:  biz.EpisodeStatusArchived,
+		v1.EpisodeStatus_EPISODE_STATUS_IN_REVIEW: biz.EpisodeStatusInReview,
+		v1.EpisodeStatus_EPISODE_STATUS_APPROVED:  biz.EpisodeStatusApproved,
 	}
 
 	BizToProtoEpisodeStatus = map[biz.EpisodeStatus]v1.EpisodeStatus{
@@ -49,6 +59,8 @@ var (
 		biz.EpisodeStatusPublished: v1.EpisodeStatus_EPISODE_STATUS_PUBLISHED,
 		biz.EpisodeStatusScheduled: v1.EpisodeStatus_EPISODE_STATUS_SCHEDULED,
 		biz.EpisodeStatusArchived:  v1.EpisodeStatus_EPISODE_STATUS_ARCHIVED,
+		biz.EpisodeStatusInReview:  v1.EpisodeStatus_EPISODE_STATUS_IN_REVIEW,
+		biz.EpisodeStatusApproved:  v1.EpisodeStatus_EPISODE_STATUS_APPROVED,
 	}
 
 	ProtoToBizImportStatus = map[v1.ImportStatus]biz.ImportStatus{
@@ -64,6 +76,72 @@ var (
 		biz.ImportStatusCompleted:  v1.ImportStatus_IMPORT_STATUS_COMPLETED,
 		biz.ImportStatusFailed:     v1.ImportStatus_IMPORT_STATUS_FAILED,
 	}
+
+	ProtoToBizTranscriptFormat = map[v1.TranscriptFormat]biz.TranscriptFormat{
+		v1.TranscriptFormat_TRANSCRIPT_FORMAT_WEBVTT: biz.TranscriptFormatWebVTT,
+		v1.TranscriptFormat_TRANSCRIPT_FORMAT_SRT:    biz.TranscriptFormatSRT,
+		v1.TranscriptFormat_TRANSCRIPT_FORMAT_TEXT:   biz.TranscriptFormatText,
+		v1.TranscriptFormat_TRANSCRIPT_FORMAT_JSON:   biz.TranscriptFormatJSON,
+	}
+
+	BizToProtoTranscriptFormat = map[biz.TranscriptFormat]v1.TranscriptFormat{
+		biz.TranscriptFormatWebVTT: v1.TranscriptFormat_TRANSCRIPT_FORMAT_WEBVTT,
+		biz.TranscriptFormatSRT:    v1.TranscriptFormat_TRANSCRIPT_FORMAT_SRT,
+		biz.TranscriptFormatText:   v1.TranscriptFormat_TRANSCRIPT_FORMAT_TEXT,
+		biz.TranscriptFormatJSON:   v1.TranscriptFormat_TRANSCRIPT_FORMAT_JSON,
+	}
+
+	ProtoToBizCreditRole = map[v1.CreditRole]biz.CreditRole{
+		v1.CreditRole_CREDIT_ROLE_HOST:     biz.CreditRoleHost,
+		v1.CreditRole_CREDIT_ROLE_GUEST:    biz.CreditRoleGuest,
+		v1.CreditRole_CREDIT_ROLE_PRODUCER: biz.CreditRoleProducer,
+	}
+
+	BizToProtoCreditRole = map[biz.CreditRole]v1.CreditRole{
+		biz.CreditRoleHost:     v1.CreditRole_CREDIT_ROLE_HOST,
+		biz.CreditRoleGuest:    v1.CreditRole_CREDIT_ROLE_GUEST,
+		biz.CreditRoleProducer: v1.CreditRole_CREDIT_ROLE_PRODUCER,
+	}
+
+	BizToProtoImportEventType = map[biz.ImportEventType]v1.ImportEventType{
+		biz.ImportEventProgress: v1.ImportEventType_IMPORT_EVENT_TYPE_PROGRESS,
+		biz.ImportEventWarning:  v1.ImportEventType_IMPORT_EVENT_TYPE_WARNING,
+		biz.ImportEventError:    v1.ImportEventType_IMPORT_EVENT_TYPE_ERROR,
+	}
+
+	ProtoToBizContentType = map[v1.ContentType]biz.ContentType{
+		v1.ContentType_CONTENT_TYPE_PROGRAM:  biz.ContentTypeProgram,
+		v1.ContentType_CONTENT_TYPE_EPISODE:  biz.ContentTypeEpisode,
+		v1.ContentType_CONTENT_TYPE_CATEGORY: biz.ContentTypeCategory,
+		v1.ContentType_CONTENT_TYPE_PERSON:   biz.ContentTypePerson,
+	}
+
+	BizToProtoContentType = map[biz.ContentType]v1.ContentType{
+		biz.ContentTypeProgram:  v1.ContentType_CONTENT_TYPE_PROGRAM,
+		biz.ContentTypeEpisode:  v1.ContentType_CONTENT_TYPE_EPISODE,
+		biz.ContentTypeCategory: v1.ContentType_CONTENT_TYPE_CATEGORY,
+		biz.ContentTypePerson:   v1.ContentType_CONTENT_TYPE_PERSON,
+	}
+
+	BizToProtoUnavailableReason = map[biz.UnavailableReason]v1.UnavailableReason{
+		biz.UnavailableNotPublished:      v1.UnavailableReason_UNAVAILABLE_REASON_NOT_PUBLISHED,
+		biz.UnavailableNotStarted:        v1.UnavailableReason_UNAVAILABLE_REASON_NOT_STARTED,
+		biz.UnavailableEnded:             v1.UnavailableReason_UNAVAILABLE_REASON_ENDED,
+		biz.UnavailableCountryNotAllowed: v1.UnavailableReason_UNAVAILABLE_REASON_COUNTRY_NOT_ALLOWED,
+		biz.UnavailableCountryBlocked:    v1.UnavailableReason_UNAVAILABLE_REASON_COUNTRY_BLOCKED,
+	}
+
+	ProtoToBizWebhookDeliveryStatus = map[v1.WebhookDeliveryStatus]biz.WebhookDeliveryStatus{
+		v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:   biz.WebhookDeliveryStatusPending,
+		v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED: biz.WebhookDeliveryStatusSucceeded,
+		v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:      biz.WebhookDeliveryStatusDead,
+	}
+
+	BizToProtoWebhookDeliveryStatus = map[biz.WebhookDeliveryStatus]v1.WebhookDeliveryStatus{
+		biz.WebhookDeliveryStatusPending:   v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+		biz.WebhookDeliveryStatusSucceeded: v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED,
+		biz.WebhookDeliveryStatusDead:      v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD,
+	}
 )
 
 func ConvertFullUser(user *biz.User) *v1.User {
@@ -137,8 +215,13 @@ func ConvertProgram(p *biz.Program) *v1.Program {
 		EpisodesCount: p.EpisodesCount,
 		ViewCount:     p.ViewCount,
 		Rating:        p.Rating,
+		CategoryIds:   ConvertIDs(p.CategoryIDs),
+		Availability:  ConvertAvailability(p.Availability),
 	}
 
+	// Editors see why nobody can listen; where listeners are is left to CheckAvailability
+	program.UnavailableReasons = ConvertUnavailableReasons(p.UnavailableReasons(time.Now(), ""))
+
 	if p.PublishedAt != nil {
 		program.PublishedAt = timestamppb.New(*p.PublishedAt)
 	}
@@ -168,6 +251,14 @@ func ConvertPrograms(programs []*biz.Program) []*v1.Program {
 	return result
 }
 
+func ConvertIDs(ids []uuid.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result
+}
+
 func ConvertCategory(c *biz.Category) *v1.Category {
 	if c == nil {
 		return nil
@@ -190,6 +281,10 @@ func ConvertCategory(c *biz.Category) *v1.Category {
 		}
 	}
 
+	if c.ParentID != nil {
+		category.ParentId = c.ParentID.String()
+	}
+
 	return category
 }
 
@@ -205,6 +300,25 @@ func ConvertCategories(categories []*biz.Category) []*v1.Category {
 	return result
 }
 
+func ConvertCategoryNode(n *biz.CategoryNode) *v1.CategoryNode {
+	if n == nil {
+		return nil
+	}
+
+	return &v1.CategoryNode{
+		Category: ConvertCategory(n.Category),
+		Children: ConvertCategoryNodes(n.Children),
+	}
+}
+
+func ConvertCategoryNodes(nodes []*biz.CategoryNode) []*v1.CategoryNode {
+	result := make([]*v1.CategoryNode, 0, len(nodes))
+	for _, n := range nodes {
+		result = append(result, ConvertCategoryNode(n))
+	}
+	return result
+}
+
 func ConvertEpisode(e *biz.Episode) *v1.Episode {
 	if e == nil {
 		return nil
@@ -229,8 +343,13 @@ func ConvertEpisode(e *biz.Episode) *v1.Episode {
 		Metadata:        make(map[string]string),
 		ViewCount:       e.ViewCount,
 		Rating:          e.Rating,
+		SeasonId:        e.SeasonID.String(),
+		Chapters:        ConvertChapters(e.Chapters),
+		Availability:    ConvertAvailability(e.Availability),
 	}
 
+	episode.UnavailableReasons = ConvertUnavailableReasons(e.UnavailableReasons(time.Now(), ""))
+
 	if e.PublishedAt != nil {
 		episode.PublishedAt = timestamppb.New(*e.PublishedAt)
 	}
@@ -248,6 +367,55 @@ func ConvertEpisode(e *biz.Episode) *v1.Episode {
 	return episode
 }
 
+// ConvertAvailability leaves out availability that puts no limits on content.
+func ConvertAvailability(a biz.Availability) *v1.Availability {
+	if a.StartAt == nil && a.EndAt == nil && len(a.AllowedCountries) == 0 && len(a.BlockedCountries) == 0 {
+		return nil
+	}
+
+	availability := &v1.Availability{
+		AllowedCountries: a.AllowedCountries,
+		BlockedCountries: a.BlockedCountries,
+	}
+	if a.StartAt != nil {
+		availability.StartAt = timestamppb.New(*a.StartAt)
+	}
+	if a.EndAt != nil {
+		availability.EndAt = timestamppb.New(*a.EndAt)
+	}
+	return availability
+}
+
+// ProtoAvailabilityToBiz returns nil when a is not set, so that updates leave the
+// availability of content as it is.
+func ProtoAvailabilityToBiz(a *v1.Availability) *biz.Availability {
+	if a == nil {
+		return nil
+	}
+
+	availability := &biz.Availability{
+		AllowedCountries: a.AllowedCountries,
+		BlockedCountries: a.BlockedCountries,
+	}
+	if a.StartAt != nil {
+		start := a.StartAt.AsTime()
+		availability.StartAt = &start
+	}
+	if a.EndAt != nil {
+		end := a.EndAt.AsTime()
+		availability.EndAt = &end
+	}
+	return availability
+}
+
+func ConvertUnavailableReasons(reasons []biz.UnavailableReason) []v1.UnavailableReason {
+	result := make([]v1.UnavailableReason, 0, len(reasons))
+	for _, reason := range reasons {
+		result = append(result, BizToProtoUnavailableReason[reason])
+	}
+	return result
+}
+
 func ConvertEpisodes(episodes []*biz.Episode) []*v1.Episode {
 	if episodes == nil {
 		return nil
@@ -259,3 +427,462 @@ func ConvertEpisodes(episodes []*biz.Episode) []*v1.Episode {
 	}
 	return result
 }
+
+func ConvertImportEvent(e *biz.ImportEvent) *v1.ImportEvent {
+	if e == nil || e.Import == nil {
+		return nil
+	}
+
+	return &v1.ImportEvent{
+		Type:           BizToProtoImportEventType[e.Type],
+		ImportId:       e.Import.ID.String(),
+		Status:         BizImportStatusToProto(e.Import.Status),
+		TotalItems:     e.Import.TotalItems,
+		ProcessedItems: e.Import.ProcessedItems,
+		SuccessCount:   e.Import.SuccessCount,
+		ErrorCount:     e.Import.ErrorCount,
+		Message:        e.Message,
+		UpdatedAt:      timestamppb.New(e.Import.UpdatedAt),
+	}
+}
+
+func ConvertWebhook(w *biz.WebhookSubscription) *v1.Webhook {
+	if w == nil {
+		return nil
+	}
+
+	return &v1.Webhook{
+		Id:         w.ID.String(),
+		Url:        w.URL,
+		EventTypes: w.EventTypes,
+		Active:     w.Active,
+		CreatedAt:  timestamppb.New(w.CreatedAt),
+		UpdatedAt:  timestamppb.New(w.UpdatedAt),
+		CreatedBy:  w.CreatedBy.String(),
+	}
+}
+
+func ConvertWebhooks(webhooks []*biz.WebhookSubscription) []*v1.Webhook {
+	if webhooks == nil {
+		return nil
+	}
+
+	result := make([]*v1.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		result = append(result, ConvertWebhook(w))
+	}
+	return result
+}
+
+func ConvertWebhookDelivery(d *biz.WebhookDelivery) *v1.WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+
+	delivery := &v1.WebhookDelivery{
+		Id:             d.ID.String(),
+		WebhookId:      d.SubscriptionID.String(),
+		EventId:        d.EventID.String(),
+		EventType:      string(d.EventType),
+		Status:         BizToProtoWebhookDeliveryStatus[d.Status],
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		Payload:        string(d.Payload),
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+	}
+
+	if d.NextAttemptAt != nil && d.Status == biz.WebhookDeliveryStatusPending {
+		delivery.NextAttemptAt = timestamppb.New(*d.NextAttemptAt)
+	}
+
+	if d.LastAttemptAt != nil {
+		delivery.LastAttemptAt = timestamppb.New(*d.LastAttemptAt)
+	}
+
+	return delivery
+}
+
+func ConvertWebhookDeliveries(deliveries []*biz.WebhookDelivery) []*v1.WebhookDelivery {
+	if deliveries == nil {
+		return nil
+	}
+
+	result := make([]*v1.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		result = append(result, ConvertWebhookDelivery(d))
+	}
+	return result
+}
+
+func ConvertStatusTransition(t *biz.StatusTransition) *v1.StatusTransition {
+	if t == nil {
+		return nil
+	}
+
+	transition := &v1.StatusTransition{
+		Id:          t.ID.String(),
+		ContentType: BizToProtoContentType[t.ContentType],
+		ContentId:   t.ContentID.String(),
+		Action:      string(t.Action),
+		FromStatus:  t.FromStatus,
+		ToStatus:    t.ToStatus,
+		Comment:     t.Comment,
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+	}
+
+	if t.ActorID != nil {
+		transition.ActorId = t.ActorID.String()
+	}
+
+	return transition
+}
+
+func ConvertStatusTransitions(transitions []*biz.StatusTransition) []*v1.StatusTransition {
+	if transitions == nil {
+		return nil
+	}
+
+	result := make([]*v1.StatusTransition, 0, len(transitions))
+	for _, t := range transitions {
+		result = append(result, ConvertStatusTransition(t))
+	}
+	return result
+}
+
+func ConvertRevision(r *biz.Revision) *v1.Revision {
+	if r == nil {
+		return nil
+	}
+
+	// Snapshots are decoded JSON, so they always convert
+	snapshot, _ := structpb.NewStruct(r.Snapshot)
+
+	revision := &v1.Revision{
+		Id:            r.ID.String(),
+		ContentType:   BizToProtoContentType[r.ContentType],
+		ContentId:     r.ContentID.String(),
+		Version:       r.Version,
+		Snapshot:      snapshot,
+		ChangedFields: r.ChangedFields,
+		RestoredFrom:  r.RestoredFrom,
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+	}
+
+	if r.AuthorID != nil {
+		revision.AuthorId = r.AuthorID.String()
+	}
+
+	return revision
+}
+
+func ConvertRevisions(revisions []*biz.Revision) []*v1.Revision {
+	if revisions == nil {
+		return nil
+	}
+
+	result := make([]*v1.Revision, 0, len(revisions))
+	for _, r := range revisions {
+		result = append(result, ConvertRevision(r))
+	}
+	return result
+}
+
+func ConvertTrashItem(t *biz.TrashItem) *v1.TrashItem {
+	if t == nil {
+		return nil
+	}
+
+	return &v1.TrashItem{
+		ContentType: BizToProtoContentType[t.ContentType],
+		Id:          t.ID.String(),
+		Title:       t.Title,
+		DeletedAt:   timestamppb.New(t.DeletedAt),
+	}
+}
+
+func ConvertTrashItems(items []*biz.TrashItem) []*v1.TrashItem {
+	if items == nil {
+		return nil
+	}
+
+	result := make([]*v1.TrashItem, 0, len(items))
+	for _, t := range items {
+		result = append(result, ConvertTrashItem(t))
+	}
+	return result
+}
+
+func ConvertAuditEvent(e *biz.AuditEvent) *v1.AuditEvent {
+	if e == nil {
+		return nil
+	}
+
+	event := &v1.AuditEvent{
+		Id:            e.ID.String(),
+		PrincipalType: string(e.PrincipalType),
+		Operation:     e.Operation,
+		EntityType:    string(e.EntityType),
+		IpAddress:     e.IPAddress,
+		UserAgent:     e.UserAgent,
+		RequestId:     e.RequestID,
+		Outcome:       string(e.Outcome),
+		ErrorReason:   e.ErrorReason,
+		CreatedAt:     timestamppb.New(e.CreatedAt),
+	}
+
+	if e.ActorID != nil {
+		event.ActorId = e.ActorID.String()
+	}
+	if e.EntityID != nil {
+		event.EntityId = e.EntityID.String()
+	}
+	// Summaries are decoded JSON, so they always convert
+	if e.Before != nil {
+		event.Before, _ = structpb.NewStruct(e.Before)
+	}
+	if e.After != nil {
+		event.After, _ = structpb.NewStruct(e.After)
+	}
+
+	return event
+}
+
+func ConvertAuditEvents(events []*biz.AuditEvent) []*v1.AuditEvent {
+	if events == nil {
+		return nil
+	}
+
+	result := make([]*v1.AuditEvent, 0, len(events))
+	for _, e := range events {
+		result = append(result, ConvertAuditEvent(e))
+	}
+	return result
+}
+
+func ConvertTag(t *biz.Tag) *v1.Tag {
+	if t == nil {
+		return nil
+	}
+
+	return &v1.Tag{
+		Id:           t.ID.String(),
+		Name:         t.Name,
+		Slug:         t.Slug,
+		Translations: t.Translations,
+		Aliases:      t.Aliases,
+		ProgramCount: t.ProgramCount,
+		EpisodeCount: t.EpisodeCount,
+		CreatedAt:    timestamppb.New(t.CreatedAt),
+		UpdatedAt:    timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func ConvertTags(tags []*biz.Tag) []*v1.Tag {
+	result := make([]*v1.Tag, 0, len(tags))
+	for _, t := range tags {
+		result = append(result, ConvertTag(t))
+	}
+	return result
+}
+
+func ConvertSeason(season *biz.Season) *v1.Season {
+	if season == nil {
+		return nil
+	}
+
+	result := &v1.Season{
+		Id:            season.ID.String(),
+		ProgramId:     season.ProgramID.String(),
+		SeasonNumber:  season.SeasonNumber,
+		Title:         season.Title,
+		Description:   season.Description,
+		ThumbnailUrl:  season.ThumbnailURL,
+		SortOrder:     season.SortOrder,
+		EpisodesCount: season.EpisodesCount,
+		CreatedAt:     timestamppb.New(season.CreatedAt),
+		UpdatedAt:     timestamppb.New(season.UpdatedAt),
+	}
+	if season.ReleaseStartAt != nil {
+		result.ReleaseStartAt = timestamppb.New(*season.ReleaseStartAt)
+	}
+	if season.ReleaseEndAt != nil {
+		result.ReleaseEndAt = timestamppb.New(*season.ReleaseEndAt)
+	}
+	return result
+}
+
+func ConvertSeasons(seasons []*biz.Season) []*v1.Season {
+	result := make([]*v1.Season, 0, len(seasons))
+	for _, season := range seasons {
+		result = append(result, ConvertSeason(season))
+	}
+	return result
+}
+
+func ConvertChapter(chapter *biz.Chapter) *v1.Chapter {
+	if chapter == nil {
+		return nil
+	}
+
+	return &v1.Chapter{
+		Id:           chapter.ID.String(),
+		EpisodeId:    chapter.EpisodeID.String(),
+		StartSeconds: chapter.StartSeconds,
+		EndSeconds:   chapter.EndSeconds,
+		Title:        chapter.Title,
+		ImageUrl:     chapter.ImageURL,
+		Url:          chapter.URL,
+		CreatedAt:    timestamppb.New(chapter.CreatedAt),
+		UpdatedAt:    timestamppb.New(chapter.UpdatedAt),
+	}
+}
+
+func ConvertChapters(chapters []*biz.Chapter) []*v1.Chapter {
+	result := make([]*v1.Chapter, 0, len(chapters))
+	for _, chapter := range chapters {
+		result = append(result, ConvertChapter(chapter))
+	}
+	return result
+}
+
+func ConvertTranscript(transcript *biz.Transcript) *v1.Transcript {
+	if transcript == nil {
+		return nil
+	}
+
+	return &v1.Transcript{
+		Id:        transcript.ID.String(),
+		EpisodeId: transcript.EpisodeID.String(),
+		Locale:    transcript.Locale,
+		Format:    BizToProtoTranscriptFormat[transcript.Format],
+		FileUrl:   transcript.FileURL,
+		CuesCount: transcript.CuesCount,
+		CreatedAt: timestamppb.New(transcript.CreatedAt),
+		UpdatedAt: timestamppb.New(transcript.UpdatedAt),
+	}
+}
+
+func ConvertTranscripts(transcripts []*biz.Transcript) []*v1.Transcript {
+	result := make([]*v1.Transcript, 0, len(transcripts))
+	for _, transcript := range transcripts {
+		result = append(result, ConvertTranscript(transcript))
+	}
+	return result
+}
+
+func ConvertTranscriptMatches(matches []*biz.TranscriptMatch) []*v1.TranscriptMatch {
+	result := make([]*v1.TranscriptMatch, 0, len(matches))
+	for _, match := range matches {
+		result = append(result, &v1.TranscriptMatch{
+			EpisodeId:    match.EpisodeID.String(),
+			Locale:       match.Locale,
+			StartSeconds: match.StartSeconds,
+			EndSeconds:   match.EndSeconds,
+			Text:         match.Text,
+		})
+	}
+	return result
+}
+
+func ConvertPerson(person *biz.Person) *v1.Person {
+	if person == nil {
+		return nil
+	}
+
+	links := make([]*v1.PersonLink, 0, len(person.Links))
+	for _, link := range person.Links {
+		links = append(links, &v1.PersonLink{Title: link.Title, Url: link.URL})
+	}
+
+	return &v1.Person{
+		Id:        person.ID.String(),
+		Name:      person.Name,
+		Bio:       person.Bio,
+		PhotoUrl:  person.PhotoURL,
+		Links:     links,
+		CreatedBy: person.CreatedBy.String(),
+		CreatedAt: timestamppb.New(person.CreatedAt),
+		UpdatedAt: timestamppb.New(person.UpdatedAt),
+	}
+}
+
+func ConvertPeople(people []*biz.Person) []*v1.Person {
+	result := make([]*v1.Person, 0, len(people))
+	for _, person := range people {
+		result = append(result, ConvertPerson(person))
+	}
+	return result
+}
+
+func ConvertPersonLinks(links []*v1.PersonLink) biz.PersonLinks {
+	result := make(biz.PersonLinks, 0, len(links))
+	for _, link := range links {
+		result = append(result, biz.PersonLink{Title: link.Title, URL: link.Url})
+	}
+	return result
+}
+
+func ConvertCredit(credit *biz.Credit) *v1.Credit {
+	if credit == nil {
+		return nil
+	}
+
+	return &v1.Credit{
+		Id:          credit.ID.String(),
+		PersonId:    credit.PersonID.String(),
+		ContentType: BizToProtoContentType[credit.ContentType],
+		ContentId:   credit.ContentID.String(),
+		Role:        BizToProtoCreditRole[credit.Role],
+		SortOrder:   credit.SortOrder,
+		CreatedAt:   timestamppb.New(credit.CreatedAt),
+		UpdatedAt:   timestamppb.New(credit.UpdatedAt),
+		Person:      ConvertPerson(credit.Person),
+	}
+}
+
+func ConvertCredits(credits []*biz.Credit) []*v1.Credit {
+	result := make([]*v1.Credit, 0, len(credits))
+	for _, credit := range credits {
+		result = append(result, ConvertCredit(credit))
+	}
+	return result
+}
+
+func ConvertTranslation(t *biz.Translation) *v1.Translation {
+	if t == nil {
+		return nil
+	}
+
+	return &v1.Translation{
+		ContentType: BizToProtoContentType[t.ContentType],
+		ContentId:   t.ContentID.String(),
+		Locale:      t.Locale,
+		Title:       t.Title,
+		Description: t.Description,
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		UpdatedAt:   timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func ConvertTranslations(translations []*biz.Translation) []*v1.Translation {
+	result := make([]*v1.Translation, 0, len(translations))
+	for _, t := range translations {
+		result = append(result, ConvertTranslation(t))
+	}
+	return result
+}
+
+func ConvertFieldChanges(changes []biz.FieldChange) []*v1.FieldChange {
+	result := make([]*v1.FieldChange, 0, len(changes))
+	for _, c := range changes {
+		from, _ := structpb.NewValue(c.From)
+		to, _ := structpb.NewValue(c.To)
+		result = append(result, &v1.FieldChange{
+			Field: c.Field,
+			From:  from,
+			To:    to,
+		})
+	}
+	return result
+}
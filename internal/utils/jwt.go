@@ -37,25 +37,25 @@ func (c *ClaimsBuilder) Build() jwt.MapClaims {
 func GetUserID(ctx context.Context) (uuid.UUID, error) {
 	claims, ok := jwt2.FromContext(ctx)
 	if !ok {
-		return uuid.Nil, errors.Unauthorized("unauthorized", "unauthorized")
+		return uuid.Nil, errors.Unauthorized("UNAUTHORIZED", "unauthorized")
 	}
 
 	claimsMap, ok := claims.(jwt.MapClaims)
 	if !ok {
-		return uuid.Nil, errors.Unauthorized("unauthorized", "unauthorized")
+		return uuid.Nil, errors.Unauthorized("UNAUTHORIZED", "unauthorized")
 	}
 
 	if claimsMap == nil {
-		return uuid.Nil, errors.Unauthorized("unauthorized", "unauthorized")
+		return uuid.Nil, errors.Unauthorized("UNAUTHORIZED", "unauthorized")
 	}
 
 	userId, ok := claimsMap["user_id"]
 	if !ok {
-		return uuid.Nil, errors.Unauthorized("unauthorized", "unauthorized")
+		return uuid.Nil, errors.Unauthorized("UNAUTHORIZED", "unauthorized")
 	}
 
 	if userId == "" {
-		return uuid.Nil, errors.Unauthorized("unauthorized", "unauthorized")
+		return uuid.Nil, errors.Unauthorized("UNAUTHORIZED", "unauthorized")
 	}
 
 	return uuid.Parse(userId.(string))
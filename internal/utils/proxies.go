@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// TrustedProxies are the address ranges of the proxies in front of the server, whose
+// reports of the client address can be believed.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies parses CIDR ranges such as 10.0.0.0/8. A bare address stands for
+// itself alone.
+func ParseTrustedProxies(ranges []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(ranges))
+	for _, r := range ranges {
+		if !strings.Contains(r, "/") {
+			addr, err := netip.ParseAddr(r)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", r, err)
+			}
+			r = netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()).String()
+		}
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", r, err)
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return proxies, nil
+}
+
+func (t TrustedProxies) trusts(addr netip.Addr) bool {
+	for _, prefix := range t {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientAddr finds the address of the client from remote, the address of the
+// connection, and forwarded, the values of a header such as X-Forwarded-For that each
+// proxy appends the address it got the request from to. Starting at the connection, the
+// hops are followed right to left for as long as they come from trusted proxies; the
+// first one that does not is the client. Anything further left was sent by the client
+// and cannot be believed. Without trusted proxies, the header is never read.
+func (t TrustedProxies) ClientAddr(remote string, forwarded []string) (netip.Addr, bool) {
+	addr, ok := parseHost(remote)
+	if !ok {
+		return netip.Addr{}, false
+	}
+
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hops := strings.Split(forwarded[i], ",")
+		for j := len(hops) - 1; j >= 0; j-- {
+			if !t.trusts(addr) {
+				return addr, true
+			}
+			hop, ok := parseHost(strings.TrimSpace(hops[j]))
+			if !ok {
+				// A trusted proxy would not have added it, so the client did
+				return addr, true
+			}
+			addr = hop
+		}
+	}
+	return addr, true
+}
+
+// parseHost parses an address with or without a port.
+func parseHost(host string) (netip.Addr, bool) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	addr, err := netip.ParseAddr(host)
+	return addr.Unmap(), err == nil
+}
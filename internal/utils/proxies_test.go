@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.7", "::ffff:172.16.0.1", "fd00::/8"})
+	require.NoError(t, err)
+	assert.Equal(t, "[10.0.0.0/8 192.168.1.7/32 172.16.0.1/32 fd00::/8]", fmt.Sprint(proxies))
+
+	_, err = ParseTrustedProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+	_, err = ParseTrustedProxies([]string{"proxy.internal"})
+	assert.Error(t, err)
+}
+
+func TestTrustedProxies_ClientAddr(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "fd00::/8"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		proxies   TrustedProxies
+		remote    string
+		forwarded []string
+		want      string
+	}{
+		{
+			name:   "NoHeader",
+			remote: "10.0.0.1:5000",
+			want:   "10.0.0.1",
+		},
+		{
+			name:      "UntrustedConnection",
+			remote:    "203.0.113.9:5000",
+			forwarded: []string{"198.51.100.1"},
+			want:      "203.0.113.9",
+		},
+		{
+			name:      "NoTrustedProxies",
+			proxies:   TrustedProxies{},
+			remote:    "10.0.0.1:5000",
+			forwarded: []string{"198.51.100.1"},
+			want:      "10.0.0.1",
+		},
+		{
+			name:      "OneProxy",
+			remote:    "10.0.0.1:5000",
+			forwarded: []string{"198.51.100.1"},
+			want:      "198.51.100.1",
+		},
+		{
+			name:      "SpoofedByClient",
+			remote:    "10.0.0.1:5000",
+			forwarded: []string{"1.2.3.4, 198.51.100.1"},
+			want:      "198.51.100.1",
+		},
+		{
+			name:      "ChainOfProxies",
+			remote:    "10.0.0.1:5000",
+			forwarded: []string{"1.2.3.4, 198.51.100.1, 10.0.0.2", "10.0.0.3"},
+			want:      "198.51.100.1",
+		},
+		{
+			name:      "OnlyProxies",
+			remote:    "10.0.0.1:5000",
+			forwarded: []string{"10.0.0.2, 10.0.0.3"},
+			want:      "10.0.0.2",
+		},
+		{
+			name:      "Garbage",
+			remote:    "10.0.0.1:5000",
+			forwarded: []string{"198.51.100.1, unknown"},
+			want:      "10.0.0.1",
+		},
+		{
+			name:      "IPv6WithPort",
+			remote:    "[fd00::1]:5000",
+			forwarded: []string{"[2001:db8::1]:443"},
+			want:      "2001:db8::1",
+		},
+		{
+			name:      "Mapped",
+			remote:    "[::ffff:10.0.0.1]:5000",
+			forwarded: []string{"::ffff:198.51.100.1"},
+			want:      "198.51.100.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := proxies
+			if tt.proxies != nil {
+				p = tt.proxies
+			}
+			addr, ok := p.ClientAddr(tt.remote, tt.forwarded)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, addr.String())
+		})
+	}
+
+	_, ok := proxies.ClientAddr("@", nil)
+	assert.False(t, ok)
+}
@@ -0,0 +1,140 @@
+package validation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+type validator interface {
+	Validate() error
+}
+
+type allValidator interface {
+	ValidateAll() error
+}
+
+// Validator replaces the kratos validate middleware: it reports every rule a request
+// breaks, not just the first, as field violations.
+func Validator() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			var err error
+			switch v := req.(type) {
+			case allValidator:
+				err = v.ValidateAll()
+			case validator:
+				err = v.Validate()
+			}
+			if err != nil {
+				return nil, NewError(ReasonValidator, FromValidator(err))
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// GRPCStatus is the status kratos would send for err, with its field violations added
+// as a google.rpc.BadRequest detail.
+func GRPCStatus(err error) (*status.Status, bool) {
+	violations, ok := FromError(err)
+	if !ok {
+		return nil, false
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Reason:      v.Rule,
+			Description: v.Description,
+		})
+	}
+
+	s, detailErr := errors.FromError(err).GRPCStatus().WithDetails(badRequest)
+	if detailErr != nil {
+		return nil, false
+	}
+	return s, true
+}
+
+// UnaryServerInterceptor must be the outermost interceptor: kratos turns errors into
+// statuses itself and has no place for details other than ErrorInfo.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		res, err := handler(ctx, req)
+		if s, ok := GRPCStatus(err); ok {
+			return res, s.Err()
+		}
+		return res, err
+	}
+}
+
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if s, ok := GRPCStatus(err); ok {
+			return s.Err()
+		}
+		return err
+	}
+}
+
+type fieldViolation struct {
+	Field       string `json:"field"`
+	Reason      string `json:"reason"`
+	Description string `json:"description"`
+}
+
+// errorBody is the kratos error body with the field violations of google.rpc.BadRequest.
+type errorBody struct {
+	Code            int32             `json:"code"`
+	Reason          string            `json:"reason"`
+	Message         string            `json:"message"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	FieldViolations []fieldViolation  `json:"field_violations"`
+}
+
+// ErrorEncoder writes errors with field violations itself and leaves the rest to next.
+func ErrorEncoder(next khttp.EncodeErrorFunc) khttp.EncodeErrorFunc {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		violations, ok := FromError(err)
+		if !ok {
+			next(w, r, err)
+			return
+		}
+
+		se := errors.FromError(err)
+		body := errorBody{
+			Code:     se.Code,
+			Reason:   se.Reason,
+			Message:  se.Message,
+			Metadata: se.Metadata,
+		}
+		for _, v := range violations {
+			body.FieldViolations = append(body.FieldViolations, fieldViolation{
+				Field:       v.Field,
+				Reason:      v.Rule,
+				Description: v.Description,
+			})
+		}
+
+		codec, _ := khttp.CodecForRequest(r, "Accept")
+		data, marshalErr := codec.Marshal(body)
+		if marshalErr != nil {
+			next(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/"+codec.Name())
+		w.WriteHeader(int(se.Code))
+		_, _ = w.Write(data)
+	}
+}
@@ -0,0 +1,219 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// Reasons of the 400 errors that carry field violations: rule failures found by the
+// protoc-gen-validate rules, and arguments the service layer could not parse.
+const (
+	ReasonValidator       = "VALIDATOR"
+	ReasonInvalidArgument = "INVALID_ARGUMENT"
+)
+
+// Rules name the constraint a field broke. They are stable and double as message
+// catalog keys, with Params filling the {0}, {1} placeholders.
+const (
+	RuleRequired   = "required"
+	RuleMinLen     = "string.min_len"
+	RuleMaxLen     = "string.max_len"
+	RuleLenRange   = "string.len_range"
+	RulePattern    = "string.pattern"
+	RuleUUID       = "string.uuid"
+	RuleURI        = "string.uri"
+	RuleGTE        = "number.gte"
+	RuleLTE        = "number.lte"
+	RuleGT         = "number.gt"
+	RuleLT         = "number.lt"
+	RuleGTNow      = "timestamp.gt_now"
+	RuleMinItems   = "repeated.min_items"
+	RuleMaxItems   = "repeated.max_items"
+	RuleItemsRange = "repeated.items_range"
+	RuleEnum       = "enum.defined_only"
+	RuleInvalid    = "invalid"
+)
+
+// embeddedReason is what protoc-gen-validate reports for a nested message; the real
+// failures sit on its cause.
+const embeddedReason = "embedded message failed validation"
+
+// Violation is one field that failed validation.
+type Violation struct {
+	// Field is the JSON path of the field, e.g. program_ids[2] or program.title.
+	Field       string
+	Rule        string
+	Params      []string
+	Description string
+}
+
+// Violations is the cause of every error with ReasonValidator or ReasonInvalidArgument.
+type Violations []Violation
+
+func (v Violations) Error() string {
+	messages := make([]string, 0, len(v))
+	for _, violation := range v {
+		messages = append(messages, violation.Field+": "+violation.Description)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// FromError returns the field violations carried by err, if any.
+func FromError(err error) (Violations, bool) {
+	var violations Violations
+	if !errors.As(err, &violations) || len(violations) == 0 {
+		return nil, false
+	}
+	return violations, true
+}
+
+// NewError builds a 400 error for violations. Its message names the first violation,
+// which is all that clients unaware of field violations can show.
+func NewError(reason string, violations Violations) *errors.Error {
+	return errors.BadRequest(reason, violations[:1].Error()).WithCause(violations)
+}
+
+// InvalidArgument reports a request field the service layer could not use.
+func InvalidArgument(field, rule, description string, params ...string) error {
+	return NewError(ReasonInvalidArgument, Violations{{
+		Field:       field,
+		Rule:        rule,
+		Params:      params,
+		Description: description,
+	}})
+}
+
+// ParseUUID parses value as the UUID held by field, reporting failures as an invalid argument.
+func ParseUUID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, InvalidArgument(field, RuleUUID, "value must be a valid UUID")
+	}
+	return id, nil
+}
+
+// ParseUUIDs parses the UUIDs held by a repeated field, reporting the first failure as
+// an invalid argument at its index.
+func ParseUUIDs(field string, values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(values))
+	for i, value := range values {
+		id, err := ParseUUID(fmt.Sprintf("%s[%d]", field, i), value)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// InvalidEnum reports an enum value with no counterpart in the business layer.
+func InvalidEnum(field string, value any) error {
+	return InvalidArgument(field, RuleEnum, fmt.Sprintf("value %v is not allowed", value))
+}
+
+// protoc-gen-validate only reports rule failures as text, so the rule is recovered
+// from the reason it writes.
+var pgvReasons = []struct {
+	pattern *regexp.Regexp
+	rule    string
+}{
+	{regexp.MustCompile(`^value is required$`), RuleRequired},
+	{regexp.MustCompile(`^value length must be at least (\d+) runes$`), RuleMinLen},
+	{regexp.MustCompile(`^value length must be at most (\d+) runes$`), RuleMaxLen},
+	{regexp.MustCompile(`^value length must be between (\d+) and (\d+) runes, inclusive$`), RuleLenRange},
+	{regexp.MustCompile(`^value does not match regex pattern `), RulePattern},
+	{regexp.MustCompile(`^value must be a valid UUID`), RuleUUID},
+	{regexp.MustCompile(`^value must be (?:a valid URI|absolute)`), RuleURI},
+	{regexp.MustCompile(`^value must be greater than now$`), RuleGTNow},
+	{regexp.MustCompile(`^value must be greater than or equal to (\S+)$`), RuleGTE},
+	{regexp.MustCompile(`^value must be less than or equal to (\S+)$`), RuleLTE},
+	{regexp.MustCompile(`^value must be greater than (\S+)$`), RuleGT},
+	{regexp.MustCompile(`^value must be less than (\S+)$`), RuleLT},
+	{regexp.MustCompile(`^value must contain at least (\d+) item\(s\)$`), RuleMinItems},
+	{regexp.MustCompile(`^value must contain no more than (\d+) item\(s\)$`), RuleMaxItems},
+	{regexp.MustCompile(`^value must contain between (\d+) and (\d+) items, inclusive$`), RuleItemsRange},
+	{regexp.MustCompile(`^value must be one of the defined enum values$`), RuleEnum},
+}
+
+// fieldError is implemented by every protoc-gen-validate error type.
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is implemented by the errors ValidateAll returns.
+type multiError interface {
+	AllErrors() []error
+}
+
+// FromValidator converts an error from Validate or ValidateAll into violations.
+func FromValidator(err error) Violations {
+	var errs []error
+	if multi, ok := err.(multiError); ok {
+		errs = multi.AllErrors()
+	} else {
+		errs = []error{err}
+	}
+
+	var violations Violations
+	for _, err := range errs {
+		violations = append(violations, fromFieldError(err, "")...)
+	}
+	return violations
+}
+
+func fromFieldError(err error, prefix string) Violations {
+	fe, ok := err.(fieldError)
+	if !ok {
+		return Violations{{Field: prefix, Rule: RuleInvalid, Description: err.Error()}}
+	}
+
+	field := jsonFieldName(fe.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+
+	// A nested message reports its own failures as the cause
+	if fe.Reason() == embeddedReason && fe.Cause() != nil {
+		if multi, ok := fe.Cause().(multiError); ok {
+			var violations Violations
+			for _, cause := range multi.AllErrors() {
+				violations = append(violations, fromFieldError(cause, field)...)
+			}
+			return violations
+		}
+		return fromFieldError(fe.Cause(), field)
+	}
+
+	violation := Violation{Field: field, Rule: RuleInvalid, Description: fe.Reason()}
+	for _, r := range pgvReasons {
+		if match := r.pattern.FindStringSubmatch(fe.Reason()); match != nil {
+			violation.Rule = r.rule
+			violation.Params = match[1:]
+			break
+		}
+	}
+	return Violations{violation}
+}
+
+// jsonFieldName turns a generated field name such as ProgramIds or Categories[2] into
+// the JSON name clients send, program_ids or categories[2].
+func jsonFieldName(field string) string {
+	var b strings.Builder
+	for i, r := range field {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
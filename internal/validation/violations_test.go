@@ -0,0 +1,238 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "thmanyah/api/grpc/v1"
+	"thmanyah/internal/modules/cms/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestValidator_ReportsEveryViolation(t *testing.T) {
+	req := &pb.CreateProgramRequest{
+		CategoryIds:  []string{"550e8400-e29b-41d4-a716-446655440000", "not-a-uuid"},
+		Availability: &pb.Availability{AllowedCountries: []string{"SA", "SAU"}},
+	}
+
+	_, err := Validator()(func(context.Context, any) (any, error) {
+		t.Fatal("handler must not run for an invalid request")
+		return nil, nil
+	})(context.Background(), req)
+	require.Error(t, err)
+
+	e := errors.FromError(err)
+	assert.Equal(t, int32(http.StatusBadRequest), e.Code)
+	assert.Equal(t, ReasonValidator, e.Reason)
+
+	violations, ok := FromError(err)
+	require.True(t, ok)
+
+	fields := make(map[string]string, len(violations))
+	for _, v := range violations {
+		fields[v.Field] = v.Rule
+	}
+	assert.Equal(t, map[string]string{
+		"title":                             RuleMinLen,
+		"category_id":                       RuleMinLen,
+		"category_ids[1]":                   RuleUUID,
+		"availability.allowed_countries[1]": RulePattern,
+	}, fields)
+	assert.Equal(t, violations[0].Field+": "+violations[0].Description, e.Message)
+}
+
+func TestValidator_PassesValidRequests(t *testing.T) {
+	req := &pb.CreateProgramRequest{Title: "title", CategoryId: "550e8400-e29b-41d4-a716-446655440000"}
+
+	res, err := Validator()(func(context.Context, any) (any, error) {
+		return "ok", nil
+	})(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+}
+
+func TestValidator_BatchGetLimits(t *testing.T) {
+	requests := map[string]func(ids []string) any{
+		"program_ids":  func(ids []string) any { return &pb.BatchGetProgramsRequest{ProgramIds: ids} },
+		"category_ids": func(ids []string) any { return &pb.BatchGetCategoriesRequest{CategoryIds: ids} },
+		"episode_ids":  func(ids []string) any { return &pb.BatchGetEpisodesRequest{EpisodeIds: ids} },
+	}
+	tests := []struct {
+		name  string
+		count int
+		rule  string
+	}{
+		{"empty", 0, RuleItemsRange},
+		{"at the limit", biz.MaxBatchGetIDs, ""},
+		{"over the limit", biz.MaxBatchGetIDs + 1, RuleItemsRange},
+	}
+
+	validate := Validator()(func(context.Context, any) (any, error) {
+		return "ok", nil
+	})
+	for field, newRequest := range requests {
+		for _, tt := range tests {
+			t.Run(field+" "+tt.name, func(t *testing.T) {
+				ids := make([]string, tt.count)
+				for i := range ids {
+					ids[i] = "550e8400-e29b-41d4-a716-446655440000"
+				}
+
+				_, err := validate(context.Background(), newRequest(ids))
+				if tt.rule == "" {
+					require.NoError(t, err)
+					return
+				}
+				violations, ok := FromError(err)
+				require.True(t, ok)
+				require.Len(t, violations, 1)
+				assert.Equal(t, field, violations[0].Field)
+				assert.Equal(t, tt.rule, violations[0].Rule)
+			})
+		}
+	}
+}
+
+type fakeFieldError struct {
+	field, reason string
+	cause         error
+}
+
+func (e fakeFieldError) Field() string  { return e.field }
+func (e fakeFieldError) Reason() string { return e.reason }
+func (e fakeFieldError) Cause() error   { return e.cause }
+func (e fakeFieldError) Error() string  { return e.field + ": " + e.reason }
+
+func TestFromValidator_Rules(t *testing.T) {
+	tests := []struct {
+		reason string
+		rule   string
+		params []string
+	}{
+		{"value is required", RuleRequired, []string{}},
+		{"value length must be at least 3 runes", RuleMinLen, []string{"3"}},
+		{"value length must be at most 255 runes", RuleMaxLen, []string{"255"}},
+		{"value length must be between 2 and 8 runes, inclusive", RuleLenRange, []string{"2", "8"}},
+		{`value does not match regex pattern "^[a-z]+$"`, RulePattern, []string{}},
+		{"value must be a valid UUID | caused by: invalid uuid format", RuleUUID, []string{}},
+		{"value must be absolute", RuleURI, []string{}},
+		{"value must be greater than now", RuleGTNow, []string{}},
+		{"value must be greater than or equal to 1", RuleGTE, []string{"1"}},
+		{"value must be less than or equal to 100", RuleLTE, []string{"100"}},
+		{"value must be greater than 0", RuleGT, []string{"0"}},
+		{"value must be less than 10", RuleLT, []string{"10"}},
+		{"value must contain at least 1 item(s)", RuleMinItems, []string{"1"}},
+		{"value must contain no more than 100 item(s)", RuleMaxItems, []string{"100"}},
+		{"value must contain between 1 and 100 items, inclusive", RuleItemsRange, []string{"1", "100"}},
+		{"value must be one of the defined enum values", RuleEnum, []string{}},
+		{"something else entirely", RuleInvalid, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			violations := FromValidator(fakeFieldError{field: "PageSize", reason: tt.reason})
+			require.Len(t, violations, 1)
+			assert.Equal(t, "page_size", violations[0].Field)
+			assert.Equal(t, tt.rule, violations[0].Rule)
+			assert.Equal(t, tt.params, violations[0].Params)
+			assert.Equal(t, tt.reason, violations[0].Description)
+		})
+	}
+}
+
+func TestFromValidator_NestedCause(t *testing.T) {
+	err := fakeFieldError{
+		field:  "Program",
+		reason: embeddedReason,
+		cause:  fakeFieldError{field: "Title", reason: "value is required"},
+	}
+
+	violations := FromValidator(err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "program.title", violations[0].Field)
+	assert.Equal(t, RuleRequired, violations[0].Rule)
+}
+
+func TestFromValidator_NotAFieldError(t *testing.T) {
+	violations := FromValidator(assert.AnError)
+	require.Len(t, violations, 1)
+	assert.Equal(t, RuleInvalid, violations[0].Rule)
+	assert.Equal(t, assert.AnError.Error(), violations[0].Description)
+}
+
+func TestJSONFieldName(t *testing.T) {
+	tests := map[string]string{
+		"Title":         "title",
+		"ProgramIds":    "program_ids",
+		"Categories[2]": "categories[2]",
+		"SourceUrl":     "source_url",
+	}
+	for field, want := range tests {
+		assert.Equal(t, want, jsonFieldName(field), field)
+	}
+}
+
+func TestParseUUIDs(t *testing.T) {
+	ids, err := ParseUUIDs("program_ids", []string{"550e8400-e29b-41d4-a716-446655440000"})
+	require.NoError(t, err)
+	assert.Len(t, ids, 1)
+
+	_, err = ParseUUIDs("program_ids", []string{"550e8400-e29b-41d4-a716-446655440000", "nope"})
+	violations, ok := FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, ReasonInvalidArgument, errors.FromError(err).Reason)
+	assert.Equal(t, Violations{{Field: "program_ids[1]", Rule: RuleUUID, Description: "value must be a valid UUID"}}, violations)
+}
+
+func TestFromError_WithoutViolations(t *testing.T) {
+	_, ok := FromError(errors.BadRequest("INVALID", "invalid"))
+	assert.False(t, ok)
+	_, ok = FromError(nil)
+	assert.False(t, ok)
+}
+
+func TestGRPCStatus(t *testing.T) {
+	s, ok := GRPCStatus(InvalidArgument("episode_id", RuleUUID, "value must be a valid UUID"))
+	require.True(t, ok)
+
+	var badRequest *errdetails.BadRequest
+	for _, detail := range s.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = br
+		}
+	}
+	require.NotNil(t, badRequest)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "episode_id", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, RuleUUID, badRequest.FieldViolations[0].Reason)
+
+	_, ok = GRPCStatus(errors.NotFound("NOT_FOUND", "not found"))
+	assert.False(t, ok)
+}
+
+func TestErrorEncoder(t *testing.T) {
+	var fallback bool
+	encode := ErrorEncoder(func(w http.ResponseWriter, r *http.Request, err error) {
+		fallback = true
+	})
+
+	rec := httptest.NewRecorder()
+	encode(rec, httptest.NewRequest(http.MethodGet, "/", nil), InvalidArgument("page", RuleGTE, "value must be greater than or equal to 1", "1"))
+	assert.False(t, fallback)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var body errorBody
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, ReasonInvalidArgument, body.Reason)
+	assert.Equal(t, []fieldViolation{{Field: "page", Reason: RuleGTE, Description: "value must be greater than or equal to 1"}}, body.FieldViolations)
+
+	encode(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil), errors.NotFound("NOT_FOUND", "not found"))
+	assert.True(t, fallback)
+}
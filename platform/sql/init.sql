@@ -1,34 +1,79 @@
 CREATE EXTENSION IF NOT EXISTS pg_trgm;
 CREATE EXTENSION IF NOT EXISTS unaccent;
 
-CREATE TYPE category_type AS ENUM (
-    'CATEGORY_TYPE_PODCAST',
-    'CATEGORY_TYPE_DOCUMENTARY',
-    'CATEGORY_TYPE_SPORTS_EVENT',
-    'CATEGORY_TYPE_EDUCATIONAL',
-    'CATEGORY_TYPE_NEWS',
-    'CATEGORY_TYPE_ENTERTAINMENT'
-    );
-
-CREATE TYPE program_status AS ENUM (
-    'PROGRAM_STATUS_DRAFT',
-    'PROGRAM_STATUS_PUBLISHED',
-    'PROGRAM_STATUS_ARCHIVED'
-    );
-
-CREATE TYPE episode_status AS ENUM (
-    'EPISODE_STATUS_DRAFT',
-    'EPISODE_STATUS_PUBLISHED',
-    'EPISODE_STATUS_SCHEDULED',
-    'EPISODE_STATUS_ARCHIVED'
-    );
-
-CREATE TYPE import_status AS ENUM (
-    'IMPORT_STATUS_PENDING',
-    'IMPORT_STATUS_PROCESSING',
-    'IMPORT_STATUS_COMPLETED',
-    'IMPORT_STATUS_FAILED'
-    );
+DO
+$$
+BEGIN
+    CREATE TYPE category_type AS ENUM (
+        'CATEGORY_TYPE_PODCAST',
+        'CATEGORY_TYPE_DOCUMENTARY',
+        'CATEGORY_TYPE_SPORTS_EVENT',
+        'CATEGORY_TYPE_EDUCATIONAL',
+        'CATEGORY_TYPE_NEWS',
+        'CATEGORY_TYPE_ENTERTAINMENT'
+        );
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END
+$$;
+
+DO
+$$
+BEGIN
+    CREATE TYPE program_status AS ENUM (
+        'PROGRAM_STATUS_DRAFT',
+        'PROGRAM_STATUS_PUBLISHED',
+        'PROGRAM_STATUS_ARCHIVED',
+        'PROGRAM_STATUS_IN_REVIEW',
+        'PROGRAM_STATUS_APPROVED'
+        );
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END
+$$;
+
+DO
+$$
+BEGIN
+    CREATE TYPE episode_status AS ENUM (
+        'EPISODE_STATUS_DRAFT',
+        'EPISODE_STATUS_PUBLISHED',
+        'EPISODE_STATUS_SCHEDULED',
+        'EPISODE_STATUS_ARCHIVED',
+        'EPISODE_STATUS_IN_REVIEW',
+        'EPISODE_STATUS_APPROVED'
+        );
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END
+$$;
+
+DO
+$$
+BEGIN
+    CREATE TYPE import_status AS ENUM (
+        'IMPORT_STATUS_PENDING',
+        'IMPORT_STATUS_PROCESSING',
+        'IMPORT_STATUS_COMPLETED',
+        'IMPORT_STATUS_FAILED'
+        );
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END
+$$;
+
+DO
+$$
+BEGIN
+    CREATE TYPE webhook_delivery_status AS ENUM (
+        'WEBHOOK_DELIVERY_STATUS_PENDING',
+        'WEBHOOK_DELIVERY_STATUS_SUCCEEDED',
+        'WEBHOOK_DELIVERY_STATUS_DEAD'
+        );
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END
+$$;
 
 CREATE TABLE IF NOT EXISTS users
 (
@@ -48,11 +93,12 @@ CREATE TABLE IF NOT EXISTS categories
     name        VARCHAR(255)  NOT NULL,
     description TEXT,
     type        category_type NOT NULL,
+    parent_id   UUID REFERENCES categories (id),
     created_at  TIMESTAMP DEFAULT NOW(),
     updated_at  TIMESTAMP DEFAULT NOW(),
     created_by  UUID  NOT NULL REFERENCES users (id) ON DELETE CASCADE,
     metadata    JSONB     DEFAULT '{}'::jsonb,
-    UNIQUE (name)
+    deleted_at  TIMESTAMP
 );
 
 CREATE TABLE IF NOT EXISTS programs
@@ -75,7 +121,28 @@ CREATE TABLE IF NOT EXISTS programs
     is_featured    BOOLEAN                 DEFAULT FALSE,
     view_count     INTEGER                 DEFAULT 0,
     rating         DECIMAL(3, 2)           DEFAULT 0.0,
-    search_vector  TSVECTOR
+    -- Licensing limits, see content_available
+    availability   JSONB          NOT NULL DEFAULT '{}'::jsonb,
+    search_vector  TSVECTOR,
+    deleted_at     TIMESTAMP
+);
+
+-- Seasons of a program. Episodes keep their season_number; a trigger points their
+-- season_id at the season with that number, adding the season when it is new.
+CREATE TABLE IF NOT EXISTS seasons
+(
+    id               UUID PRIMARY KEY,
+    program_id       UUID      NOT NULL REFERENCES programs (id) ON DELETE CASCADE,
+    season_number    INTEGER   NOT NULL,
+    title            TEXT      NOT NULL DEFAULT '',
+    description      TEXT      NOT NULL DEFAULT '',
+    thumbnail_url    TEXT      NOT NULL DEFAULT '',
+    release_start_at TIMESTAMP,
+    release_end_at   TIMESTAMP,
+    sort_order       INTEGER   NOT NULL DEFAULT 0,
+    created_at       TIMESTAMP DEFAULT NOW(),
+    updated_at       TIMESTAMP DEFAULT NOW(),
+    UNIQUE (program_id, season_number)
 );
 
 CREATE TABLE IF NOT EXISTS episodes
@@ -87,6 +154,7 @@ CREATE TABLE IF NOT EXISTS episodes
     duration_seconds INTEGER                 DEFAULT 0,
     episode_number   INTEGER        NOT NULL,
     season_number    INTEGER        NOT NULL DEFAULT 1,
+    season_id        UUID           NOT NULL REFERENCES seasons (id),
     status           episode_status NOT NULL DEFAULT 'EPISODE_STATUS_DRAFT',
     created_at       TIMESTAMP               DEFAULT NOW(),
     updated_at       TIMESTAMP               DEFAULT NOW(),
@@ -101,8 +169,83 @@ CREATE TABLE IF NOT EXISTS episodes
     view_count       INTEGER                 DEFAULT 0,
     rating           DECIMAL(3, 2)           DEFAULT 0.0,
     file_size_bytes  BIGINT                  DEFAULT 0,
+    availability     JSONB          NOT NULL DEFAULT '{}'::jsonb,
     search_vector    TSVECTOR,
-    UNIQUE (program_id, season_number, episode_number)
+    deleted_at       TIMESTAMP,
+    -- Set when the episode went to the trash with its program, so restoring the
+    -- program brings it back too
+    deleted_with_program BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- Chapters of an episode, in seconds from its start. Without end_seconds, a chapter
+-- lasts until the next one starts.
+CREATE TABLE IF NOT EXISTS chapters
+(
+    id            UUID PRIMARY KEY,
+    episode_id    UUID             NOT NULL REFERENCES episodes (id) ON DELETE CASCADE,
+    start_seconds DOUBLE PRECISION NOT NULL,
+    end_seconds   DOUBLE PRECISION,
+    title         TEXT             NOT NULL,
+    image_url     TEXT             NOT NULL DEFAULT '',
+    url           TEXT             NOT NULL DEFAULT '',
+    created_at    TIMESTAMP DEFAULT NOW(),
+    updated_at    TIMESTAMP DEFAULT NOW()
+);
+
+-- Transcripts of an episode, one per locale. The uploaded file is kept in storage;
+-- its cues are stored here to convert and search it.
+CREATE TABLE IF NOT EXISTS transcripts
+(
+    id         UUID PRIMARY KEY,
+    episode_id UUID      NOT NULL REFERENCES episodes (id) ON DELETE CASCADE,
+    locale     TEXT      NOT NULL,
+    format     TEXT      NOT NULL,
+    file_url   TEXT      NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (episode_id, locale)
+);
+
+-- Cues of plain text transcripts have no timing
+CREATE TABLE IF NOT EXISTS transcript_cues
+(
+    transcript_id UUID    NOT NULL REFERENCES transcripts (id) ON DELETE CASCADE,
+    position      INTEGER NOT NULL,
+    start_seconds DOUBLE PRECISION,
+    end_seconds   DOUBLE PRECISION,
+    speaker       TEXT    NOT NULL DEFAULT '',
+    text          TEXT    NOT NULL,
+    search_vector TSVECTOR,
+    PRIMARY KEY (transcript_id, position)
+);
+
+-- The people directory: hosts, guests and producers, shared by all programs. links is a
+-- list of {"title", "url"} objects.
+CREATE TABLE IF NOT EXISTS people
+(
+    id         UUID PRIMARY KEY,
+    name       TEXT      NOT NULL,
+    bio        TEXT      NOT NULL DEFAULT '',
+    photo_url  TEXT      NOT NULL DEFAULT '',
+    links      JSONB     NOT NULL DEFAULT '[]',
+    created_by UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- People credited on a program or episode, in a role. Like translations, content_id has
+-- no foreign key and purging content removes its credits.
+CREATE TABLE IF NOT EXISTS credits
+(
+    id           UUID PRIMARY KEY,
+    person_id    UUID      NOT NULL REFERENCES people (id) ON DELETE CASCADE,
+    content_type TEXT      NOT NULL,
+    content_id   UUID      NOT NULL,
+    role         TEXT      NOT NULL,
+    sort_order   INTEGER   NOT NULL DEFAULT 0,
+    created_at   TIMESTAMP DEFAULT NOW(),
+    updated_at   TIMESTAMP DEFAULT NOW(),
+    UNIQUE (person_id, content_type, content_id, role)
 );
 
 CREATE TABLE IF NOT EXISTS imports
@@ -127,6 +270,169 @@ CREATE TABLE IF NOT EXISTS imports
     metadata        JSONB                  DEFAULT '{}'::jsonb  -- this helps to map data from external source structure to internal structure
 );
 
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    id          UUID PRIMARY KEY,
+    url         TEXT      NOT NULL,
+    event_types TEXT[]    NOT NULL DEFAULT '{}',
+    secret      TEXT      NOT NULL,
+    active      BOOLEAN   NOT NULL DEFAULT TRUE,
+    created_at  TIMESTAMP          DEFAULT NOW(),
+    updated_at  TIMESTAMP          DEFAULT NOW(),
+    created_by  UUID      NOT NULL REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- One row per event per subscription; it also serves as the delivery log
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              UUID PRIMARY KEY,
+    subscription_id UUID                    NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id        UUID                    NOT NULL,
+    event_type      TEXT                    NOT NULL,
+    payload         JSONB                   NOT NULL,
+    status          webhook_delivery_status NOT NULL DEFAULT 'WEBHOOK_DELIVERY_STATUS_PENDING',
+    attempts        INTEGER                 NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP                        DEFAULT NOW(),
+    last_attempt_at TIMESTAMP,
+    response_status INTEGER,
+    last_error      TEXT,
+    created_at      TIMESTAMP                        DEFAULT NOW(),
+    updated_at      TIMESTAMP                        DEFAULT NOW()
+);
+
+-- Every category a program appears in. The primary one mirrors programs.category_id and
+-- is kept in sync by a trigger; the others are set through SetProgramCategories.
+CREATE TABLE IF NOT EXISTS program_categories
+(
+    program_id  UUID    NOT NULL REFERENCES programs (id) ON DELETE CASCADE,
+    category_id UUID    NOT NULL REFERENCES categories (id),
+    is_primary  BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (program_id, category_id)
+);
+
+-- History of every program and episode status change. content_id has no foreign key
+-- because it points at either table. Purging content from the trash removes its history.
+CREATE TABLE IF NOT EXISTS status_transitions
+(
+    id           UUID PRIMARY KEY,
+    content_type TEXT      NOT NULL,
+    content_id   UUID      NOT NULL,
+    action       TEXT      NOT NULL,
+    from_status  TEXT      NOT NULL,
+    to_status    TEXT      NOT NULL,
+    actor_id     UUID REFERENCES users (id) ON DELETE SET NULL,
+    comment      TEXT,
+    created_at   TIMESTAMP DEFAULT NOW()
+);
+
+-- Immutable snapshots of programs and episodes, one per change. Like status_transitions,
+-- content_id has no foreign key and purging content removes its revisions.
+CREATE TABLE IF NOT EXISTS revisions
+(
+    id             UUID PRIMARY KEY,
+    content_type   TEXT      NOT NULL,
+    content_id     UUID      NOT NULL,
+    version        INTEGER   NOT NULL,
+    snapshot       JSONB     NOT NULL,
+    changed_fields TEXT[]    NOT NULL DEFAULT '{}',
+    author_id      UUID REFERENCES users (id) ON DELETE SET NULL,
+    restored_from  INTEGER,
+    created_at     TIMESTAMP DEFAULT NOW(),
+    UNIQUE (content_type, content_id, version)
+);
+
+-- One row per call of a mutating operation. Rows are never updated or deleted, and
+-- actor_id and entity_id have no foreign keys so the log outlives what it mentions.
+CREATE TABLE IF NOT EXISTS audit_events
+(
+    id             UUID PRIMARY KEY,
+    actor_id       UUID,
+    principal_type TEXT      NOT NULL,
+    operation      TEXT      NOT NULL,
+    entity_type    TEXT      NOT NULL DEFAULT '',
+    entity_id      UUID,
+    before         JSONB,
+    after          JSONB,
+    ip_address     TEXT      NOT NULL DEFAULT '',
+    user_agent     TEXT      NOT NULL DEFAULT '',
+    request_id     TEXT      NOT NULL DEFAULT '',
+    outcome        TEXT      NOT NULL,
+    error_reason   TEXT      NOT NULL DEFAULT '',
+    created_at     TIMESTAMP DEFAULT NOW()
+);
+
+-- The tag taxonomy. Programs and episodes keep the canonical names in their tags arrays;
+-- slug and aliases are the lookup keys incoming tags are matched against.
+CREATE TABLE IF NOT EXISTS tags
+(
+    id           UUID PRIMARY KEY,
+    name         TEXT      NOT NULL,
+    slug         TEXT      NOT NULL UNIQUE,
+    translations JSONB     NOT NULL DEFAULT '{}',
+    aliases      TEXT[]    NOT NULL DEFAULT '{}',
+    created_at   TIMESTAMP DEFAULT NOW(),
+    updated_at   TIMESTAMP DEFAULT NOW()
+);
+
+-- Titles and descriptions of categories, programs and episodes in other locales; title
+-- holds the name of a category. Like revisions, content_id has no foreign key and purging
+-- content removes its translations.
+CREATE TABLE IF NOT EXISTS translations
+(
+    content_type TEXT      NOT NULL,
+    content_id   UUID      NOT NULL,
+    locale       TEXT      NOT NULL,
+    title        TEXT      NOT NULL,
+    description  TEXT      NOT NULL DEFAULT '',
+    created_at   TIMESTAMP DEFAULT NOW(),
+    updated_at   TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (content_type, content_id, locale)
+);
+
+-- Databases created by an earlier version of this file keep their tables, so what was
+-- added to them since is added below. Every statement is a no-op on an up to date database.
+
+-- Editorial review
+ALTER TYPE program_status ADD VALUE IF NOT EXISTS 'PROGRAM_STATUS_IN_REVIEW';
+ALTER TYPE program_status ADD VALUE IF NOT EXISTS 'PROGRAM_STATUS_APPROVED';
+ALTER TYPE episode_status ADD VALUE IF NOT EXISTS 'EPISODE_STATUS_IN_REVIEW';
+ALTER TYPE episode_status ADD VALUE IF NOT EXISTS 'EPISODE_STATUS_APPROVED';
+
+-- Soft delete: trashed rows keep their place until purged, and give up their unique names
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_name_key;
+ALTER TABLE programs ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS deleted_with_program BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE episodes DROP CONSTRAINT IF EXISTS episodes_program_id_season_number_episode_number_key;
+
+-- Hierarchical categories and multi-category programs. Programs get the primary row the
+-- trigger would have added on insert.
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES categories (id);
+INSERT INTO program_categories (program_id, category_id, is_primary)
+SELECT id, category_id, TRUE
+FROM programs p
+WHERE NOT EXISTS (SELECT 1 FROM program_categories pc WHERE pc.program_id = p.id)
+ON CONFLICT DO NOTHING;
+
+-- Seasons: every season number in use becomes a season, which its episodes then point at
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS season_id UUID REFERENCES seasons (id);
+INSERT INTO seasons (id, program_id, season_number)
+SELECT gen_random_uuid(), program_id, season_number
+FROM (SELECT DISTINCT program_id, season_number FROM episodes WHERE season_id IS NULL) numbers
+ON CONFLICT (program_id, season_number) DO NOTHING;
+UPDATE episodes e
+SET season_id = s.id
+FROM seasons s
+WHERE e.season_id IS NULL
+  AND s.program_id = e.program_id
+  AND s.season_number = e.season_number;
+ALTER TABLE episodes ALTER COLUMN season_id SET NOT NULL;
+
+-- Availability windows and geo-restrictions
+ALTER TABLE programs ADD COLUMN IF NOT EXISTS availability JSONB NOT NULL DEFAULT '{}'::jsonb;
+ALTER TABLE episodes ADD COLUMN IF NOT EXISTS availability JSONB NOT NULL DEFAULT '{}'::jsonb;
+
 -- Performance Indexes for Programs table
 CREATE INDEX IF NOT EXISTS idx_programs_category_id ON programs (category_id);
 CREATE INDEX IF NOT EXISTS idx_programs_status ON programs (status);
@@ -135,24 +441,101 @@ CREATE INDEX IF NOT EXISTS idx_programs_created_at ON programs (created_at);
 CREATE INDEX IF NOT EXISTS idx_programs_view_count ON programs (view_count);
 CREATE INDEX IF NOT EXISTS idx_programs_search_vector ON programs USING gin (search_vector);
 CREATE INDEX IF NOT EXISTS idx_programs_tags_gin ON programs USING gin (tags);
+CREATE INDEX IF NOT EXISTS idx_programs_deleted_at ON programs (deleted_at) WHERE deleted_at IS NOT NULL;
 
 -- Performance Indexes for Episodes table
 CREATE INDEX IF NOT EXISTS idx_episodes_program_id ON episodes (program_id);
 CREATE INDEX IF NOT EXISTS idx_episodes_status ON episodes (status);
 CREATE INDEX IF NOT EXISTS idx_episodes_season ON episodes (season_number);
+CREATE INDEX IF NOT EXISTS idx_episodes_season_id ON episodes (season_id);
 CREATE INDEX IF NOT EXISTS idx_episodes_number ON episodes (episode_number);
 CREATE INDEX IF NOT EXISTS idx_episodes_created_at ON episodes (created_at);
 CREATE INDEX IF NOT EXISTS idx_episodes_search_vector ON episodes USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS idx_episodes_tags_gin ON episodes USING gin (tags);
+CREATE INDEX IF NOT EXISTS idx_episodes_deleted_at ON episodes (deleted_at) WHERE deleted_at IS NOT NULL;
+-- Trashed episodes give up their number, so a replacement can take it
+CREATE UNIQUE INDEX IF NOT EXISTS episodes_program_id_season_number_episode_number_key
+    ON episodes (program_id, season_number, episode_number) WHERE deleted_at IS NULL;
+
+-- Performance Indexes for Chapters table
+CREATE INDEX IF NOT EXISTS idx_chapters_episode_start ON chapters (episode_id, start_seconds);
+
+-- Performance Indexes for Transcript Cues table
+CREATE INDEX IF NOT EXISTS idx_transcript_cues_search_vector ON transcript_cues USING gin (search_vector);
+
+-- Performance Indexes for People and Credits tables
+CREATE INDEX IF NOT EXISTS idx_people_name_prefix ON people (lower(name) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_credits_content ON credits (content_type, content_id, sort_order);
+CREATE INDEX IF NOT EXISTS idx_credits_person ON credits (person_id);
 
 -- Performance Indexes for Categories table
 CREATE INDEX IF NOT EXISTS idx_categories_type ON categories (type);
 CREATE INDEX IF NOT EXISTS idx_categories_name_gin ON categories USING gin (to_tsvector('english', name));
+CREATE INDEX IF NOT EXISTS idx_categories_deleted_at ON categories (deleted_at) WHERE deleted_at IS NOT NULL;
+-- Trashed categories give up their name, so a new one can take it
+CREATE UNIQUE INDEX IF NOT EXISTS categories_name_key ON categories (name) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories (parent_id);
+
+-- Performance Indexes for Program Categories table
+CREATE INDEX IF NOT EXISTS idx_program_categories_category_id ON program_categories (category_id);
+CREATE UNIQUE INDEX IF NOT EXISTS program_categories_primary_key ON program_categories (program_id) WHERE is_primary;
 
 -- Performance Indexes for Imports table
 CREATE INDEX IF NOT EXISTS idx_imports_status ON imports (status);
 CREATE INDEX IF NOT EXISTS idx_imports_created_at ON imports (created_at);
 
+-- Performance Indexes for Webhooks tables
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_created_by ON webhook_subscriptions (created_by);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription ON webhook_deliveries (subscription_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at)
+    WHERE status = 'WEBHOOK_DELIVERY_STATUS_PENDING';
+
+-- Performance Indexes for Status Transitions table
+CREATE INDEX IF NOT EXISTS idx_status_transitions_content ON status_transitions (content_type, content_id, created_at);
+
+-- Performance Indexes for Tags table
+CREATE INDEX IF NOT EXISTS idx_tags_aliases_gin ON tags USING gin (aliases);
+CREATE INDEX IF NOT EXISTS idx_tags_name_prefix ON tags (lower(name) text_pattern_ops);
+
+-- Performance Indexes for Audit Events table
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events (actor_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_entity ON audit_events (entity_type, entity_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_request_id ON audit_events (request_id);
+
+-- Whether content with the availability rules in rules can be shown now to a listener in
+-- country, an ISO 3166-1 alpha-2 code or NULL when unknown. rules has optional start_at
+-- and end_at timestamps and allowed_countries and blocked_countries arrays; a listener
+-- whose country is unknown is kept out of content only allowed in some countries.
+CREATE OR REPLACE FUNCTION content_available(rules JSONB, country TEXT)
+    RETURNS BOOLEAN AS
+$$
+SELECT (rules ->> 'start_at' IS NULL OR (rules ->> 'start_at')::timestamptz <= NOW())
+   AND (rules ->> 'end_at' IS NULL OR (rules ->> 'end_at')::timestamptz > NOW())
+   AND (jsonb_array_length(coalesce(rules -> 'allowed_countries', '[]')) = 0
+    OR coalesce(rules -> 'allowed_countries' ? country, FALSE))
+   AND NOT coalesce(rules -> 'blocked_countries' ? country, FALSE);
+$$ LANGUAGE sql STABLE;
+
 -- Functions and triggers for automatic search vector updates
+-- Names of the people credited on a program or episode, in every locale they have
+CREATE OR REPLACE FUNCTION credited_names(kind TEXT, id UUID)
+    RETURNS TEXT AS
+$$
+SELECT coalesce(string_agg(name, ' '), '')
+FROM (SELECT p.name
+      FROM credits c
+               JOIN people p ON p.id = c.person_id
+      WHERE c.content_type = kind
+        AND c.content_id = id
+      UNION ALL
+      SELECT t.title
+      FROM credits c
+               JOIN translations t ON t.content_type = 'person' AND t.content_id = c.person_id
+      WHERE c.content_type = kind
+        AND c.content_id = id) names;
+$$ LANGUAGE sql STABLE;
+
 CREATE OR REPLACE FUNCTION update_programs_search_vector()
     RETURNS TRIGGER AS
 $$
@@ -160,7 +543,14 @@ BEGIN
     NEW.search_vector :=
             setweight(to_tsvector('simple', unaccent(coalesce(NEW.title, ''))), 'A') ||
             setweight(to_tsvector('simple', unaccent(coalesce(NEW.description, ''))), 'B') ||
-            setweight(to_tsvector('simple', unaccent(array_to_string(NEW.tags, ' '))), 'C');
+            setweight(to_tsvector('simple', unaccent(array_to_string(NEW.tags, ' '))), 'C') ||
+            setweight(to_tsvector('simple', unaccent(coalesce(
+                    (SELECT string_agg(title, ' ') FROM translations
+                     WHERE content_type = 'program' AND content_id = NEW.id), ''))), 'A') ||
+            setweight(to_tsvector('simple', unaccent(coalesce(
+                    (SELECT string_agg(description, ' ') FROM translations
+                     WHERE content_type = 'program' AND content_id = NEW.id), ''))), 'B') ||
+            setweight(to_tsvector('simple', unaccent(credited_names('program', NEW.id))), 'B');
     RETURN NEW;
 END;
 $$ LANGUAGE plpgsql;
@@ -172,20 +562,251 @@ BEGIN
     NEW.search_vector :=
             setweight(to_tsvector('simple', unaccent(coalesce(NEW.title, ''))), 'A') ||
             setweight(to_tsvector('simple', unaccent(coalesce(NEW.description, ''))), 'B') ||
-            setweight(to_tsvector('simple', unaccent(array_to_string(NEW.tags, ' '))), 'C');
+            setweight(to_tsvector('simple', unaccent(array_to_string(NEW.tags, ' '))), 'C') ||
+            setweight(to_tsvector('simple', unaccent(coalesce(
+                    (SELECT string_agg(title, ' ') FROM translations
+                     WHERE content_type = 'episode' AND content_id = NEW.id), ''))), 'A') ||
+            setweight(to_tsvector('simple', unaccent(coalesce(
+                    (SELECT string_agg(description, ' ') FROM translations
+                     WHERE content_type = 'episode' AND content_id = NEW.id), ''))), 'B') ||
+            setweight(to_tsvector('simple', unaccent(credited_names('episode', NEW.id))), 'B');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Recomputes the search vector of the program or episode a translation belongs to, or of
+-- those the translated person is credited on
+CREATE OR REPLACE FUNCTION refresh_translated_search_vector()
+    RETURNS TRIGGER AS
+$$
+DECLARE
+    changed translations%ROWTYPE;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := OLD;
+    ELSE
+        changed := NEW;
+    END IF;
+    IF changed.content_type = 'program' THEN
+        UPDATE programs SET search_vector = NULL WHERE id = changed.content_id;
+    ELSIF changed.content_type = 'episode' THEN
+        UPDATE episodes SET search_vector = NULL WHERE id = changed.content_id;
+    ELSIF changed.content_type = 'person' THEN
+        PERFORM refresh_person_search_vectors(changed.content_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Recomputes the search vectors of the programs and episodes a person is credited on
+CREATE OR REPLACE FUNCTION refresh_person_search_vectors(person UUID)
+    RETURNS VOID AS
+$$
+BEGIN
+    UPDATE programs
+    SET search_vector = NULL
+    WHERE id IN (SELECT content_id FROM credits WHERE person_id = person AND content_type = 'program');
+    UPDATE episodes
+    SET search_vector = NULL
+    WHERE id IN (SELECT content_id FROM credits WHERE person_id = person AND content_type = 'episode');
+END;
+$$ LANGUAGE plpgsql;
+
+-- Recomputes the search vector of the program or episode a credit is on
+CREATE OR REPLACE FUNCTION refresh_credited_search_vector()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE programs SET search_vector = NULL WHERE OLD.content_type = 'program' AND id = OLD.content_id;
+        UPDATE episodes SET search_vector = NULL WHERE OLD.content_type = 'episode' AND id = OLD.content_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE programs SET search_vector = NULL WHERE NEW.content_type = 'program' AND id = NEW.content_id;
+        UPDATE episodes SET search_vector = NULL WHERE NEW.content_type = 'episode' AND id = NEW.content_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Recomputes the search vectors a renamed person is in
+CREATE OR REPLACE FUNCTION refresh_person_search_vectors_on_rename()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    PERFORM refresh_person_search_vectors(NEW.id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_transcript_cues_search_vector()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.search_vector := to_tsvector('simple', unaccent(NEW.text));
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Points an episode at the season of its program with its season_number, adding the
+-- season when the program has none yet
+CREATE OR REPLACE FUNCTION assign_episode_season()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO seasons (id, program_id, season_number)
+    VALUES (gen_random_uuid(), NEW.program_id, NEW.season_number)
+    ON CONFLICT (program_id, season_number) DO NOTHING;
+    SELECT id
+    INTO NEW.season_id
+    FROM seasons
+    WHERE program_id = NEW.program_id
+      AND season_number = NEW.season_number;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Carries a new season number over to the episodes of the season
+CREATE OR REPLACE FUNCTION renumber_season_episodes()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    UPDATE episodes SET season_number = NEW.season_number WHERE season_id = NEW.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Keeps the primary row of program_categories in line with programs.category_id
+CREATE OR REPLACE FUNCTION sync_program_primary_category()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    DELETE FROM program_categories
+    WHERE program_id = NEW.id
+      AND is_primary
+      AND category_id <> NEW.category_id;
+    INSERT INTO program_categories (program_id, category_id, is_primary)
+    VALUES (NEW.id, NEW.category_id, TRUE)
+    ON CONFLICT (program_id, category_id) DO UPDATE SET is_primary = TRUE;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- The SQL twin of biz.TagSlug, for tags used before the taxonomy existed: letters and
+-- digits in lower case, with every run of other characters turned into a single dash. It
+-- drops the Latin and Arabic combining marks rather than every one.
+CREATE OR REPLACE FUNCTION tag_slug(tag TEXT)
+    RETURNS TEXT AS
+$$
+SELECT trim(BOTH '-' FROM regexp_replace(
+        regexp_replace(lower(tag), '[\u0300-\u036f\u0610-\u061a\u064b-\u065f\u0670\u06d6-\u06dc\u06df-\u06e4\u06e7\u06e8\u06ea-\u06ed]', '', 'g'),
+        '[^[:alnum:]]+', '-', 'g'));
+$$ LANGUAGE sql IMMUTABLE;
+
+-- The names of the tags standing for tag_names, in their order and without duplicates.
+-- Like the tags repository, a tag whose slug matches wins over one with the alias.
+CREATE OR REPLACE FUNCTION canonical_tags(tag_names TEXT[])
+    RETURNS TEXT[] AS
+$$
+SELECT coalesce(array_agg(canonical.name ORDER BY canonical.position), '{}')
+FROM (SELECT c.name, min(u.position) AS position
+      FROM unnest(tag_names) WITH ORDINALITY AS u(name, position)
+               CROSS JOIN LATERAL (SELECT t.name
+                                   FROM tags t
+                                   WHERE t.slug = tag_slug(u.name)
+                                      OR tag_slug(u.name) = ANY (t.aliases)
+                                   ORDER BY t.slug = tag_slug(u.name) DESC
+                                   LIMIT 1) c
+      GROUP BY c.name) canonical;
+$$ LANGUAGE sql STABLE;
+
+-- Notifies import watchers on every replica; the payload is the import ID
+CREATE OR REPLACE FUNCTION notify_import_progress()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    PERFORM pg_notify('import_progress', NEW.id::text);
     RETURN NEW;
 END;
 $$ LANGUAGE plpgsql;
 
 -- Create triggers
-CREATE TRIGGER programs_search_vector_update
+CREATE OR REPLACE TRIGGER programs_search_vector_update
     BEFORE INSERT OR UPDATE
     ON programs
     FOR EACH ROW
 EXECUTE FUNCTION update_programs_search_vector();
 
-CREATE TRIGGER episodes_search_vector_update
+CREATE OR REPLACE TRIGGER episodes_search_vector_update
     BEFORE INSERT OR UPDATE
     ON episodes
     FOR EACH ROW
 EXECUTE FUNCTION update_episodes_search_vector();
+
+CREATE OR REPLACE TRIGGER translations_search_vector_refresh
+    AFTER INSERT OR UPDATE OR DELETE
+    ON translations
+    FOR EACH ROW
+EXECUTE FUNCTION refresh_translated_search_vector();
+
+CREATE OR REPLACE TRIGGER credits_search_vector_refresh
+    AFTER INSERT OR UPDATE OR DELETE
+    ON credits
+    FOR EACH ROW
+EXECUTE FUNCTION refresh_credited_search_vector();
+
+CREATE OR REPLACE TRIGGER people_search_vector_refresh
+    AFTER UPDATE OF name
+    ON people
+    FOR EACH ROW
+    WHEN (OLD.name IS DISTINCT FROM NEW.name)
+EXECUTE FUNCTION refresh_person_search_vectors_on_rename();
+
+CREATE OR REPLACE TRIGGER transcript_cues_search_vector_update
+    BEFORE INSERT OR UPDATE
+    ON transcript_cues
+    FOR EACH ROW
+EXECUTE FUNCTION update_transcript_cues_search_vector();
+
+CREATE OR REPLACE TRIGGER episodes_season_assign
+    BEFORE INSERT OR UPDATE OF program_id, season_number
+    ON episodes
+    FOR EACH ROW
+EXECUTE FUNCTION assign_episode_season();
+
+CREATE OR REPLACE TRIGGER seasons_renumber
+    AFTER UPDATE OF season_number
+    ON seasons
+    FOR EACH ROW
+    WHEN (OLD.season_number IS DISTINCT FROM NEW.season_number)
+EXECUTE FUNCTION renumber_season_episodes();
+
+CREATE OR REPLACE TRIGGER programs_primary_category_sync
+    AFTER INSERT OR UPDATE OF category_id
+    ON programs
+    FOR EACH ROW
+EXECUTE FUNCTION sync_program_primary_category();
+
+CREATE OR REPLACE TRIGGER imports_progress_notify
+    AFTER UPDATE
+    ON imports
+    FOR EACH ROW
+EXECUTE FUNCTION notify_import_progress();
+
+-- Tags used before the taxonomy existed join it, and content is tagged with their names
+INSERT INTO tags (id, name, slug)
+SELECT DISTINCT ON (tag_slug(used.name)) gen_random_uuid(), trim(used.name), tag_slug(used.name)
+FROM (SELECT unnest(tags) AS name
+      FROM programs
+      UNION ALL
+      SELECT unnest(tags)
+      FROM episodes) used
+WHERE tag_slug(used.name) <> ''
+  AND NOT EXISTS (SELECT 1
+                  FROM tags t
+                  WHERE t.slug = tag_slug(used.name)
+                     OR tag_slug(used.name) = ANY (t.aliases))
+ORDER BY tag_slug(used.name), used.name
+ON CONFLICT (slug) DO NOTHING;
+UPDATE programs SET tags = canonical_tags(tags) WHERE tags <> canonical_tags(tags);
+UPDATE episodes SET tags = canonical_tags(tags) WHERE tags <> canonical_tags(tags);